@@ -2,10 +2,13 @@ package main
 
 import (
 	"fmt"
+	"math"
+	"net"
 	"os"
 
 	_ "github.com/lib/pq"
 	"github.com/piotrkowalczuk/charon/internal/charond"
+	"github.com/piotrkowalczuk/charon/internal/password"
 	"github.com/piotrkowalczuk/charon/internal/service/logger"
 	"go.uber.org/zap"
 )
@@ -33,6 +36,24 @@ func main() {
 	rpcListener := initListener(log, config.host, config.port)
 	debugListener := initListener(log, config.host, config.port+1)
 
+	var oidcListener net.Listener
+	if config.oidc.port > 0 {
+		oidcListener = initListener(log, config.host, config.oidc.port)
+		if config.oidc.issuer == "" {
+			config.oidc.issuer = fmt.Sprintf("http://%s:%d", config.host, config.oidc.port)
+		}
+	}
+
+	// Parameters are narrowed down to the types argon2 accepts, values that do not fit would be silently truncated.
+	if argon := config.password.argon2id; config.password.strategy == password.StrategyArgon2id {
+		if argon.threads < 1 || argon.threads > math.MaxUint8 {
+			log.Fatal("argon2id number of threads out of range", zap.Uint("threads", argon.threads))
+		}
+		if argon.time > math.MaxUint32 || argon.memory > math.MaxUint32 {
+			log.Fatal("argon2id parameters out of range", zap.Uint("time", argon.time), zap.Uint("memory", argon.memory))
+		}
+	}
+
 	// TODO: update and make it optional
 	//grpclog.SetLogger(sklog.NewGRPCLogger(logger))
 
@@ -44,13 +65,58 @@ func main() {
 		Monitoring:           config.monitoring.enabled,
 		PostgresAddress:      config.postgres.address + "&application_name=charond_" + version,
 		PostgresDebug:        config.postgres.debug,
+		PasswordStrategy:     config.password.strategy,
 		PasswordBCryptCost:   config.password.bcrypt.cost,
+		LDAPAddress:          config.ldap.address,
+		LDAPBindDN:           config.ldap.bindDN,
+		LDAPTLS:              config.ldap.tls.enabled,
+		TOTPIssuer:           config.totp.issuer,
+		LoginFreeAttempts:    config.login.freeAttempts,
+		LoginDelay:           config.login.delay,
+		LoginMaxDelay:        config.login.maxDelay,
+		LoginWindow:          config.login.window,
+		LoginLockout:         config.login.lockout,
+		PasswordResetTTL:     config.passwordReset.tokenTTL,
+		PasswordResetLimit:   config.passwordReset.limit,
+		PasswordResetWindow:  config.passwordReset.window,
+		NotifierFile:         config.notifier.file,
+		Registration:         config.registration.enabled,
+		RegistrationTTL:      config.registration.tokenTTL,
+		RegistrationLimit:    config.registration.limit,
+		RegistrationWindow:   config.registration.window,
+		GrantSweepInterval:   config.grants.sweepInterval,
+		UserRetention:        config.users.retention,
+		UserPurgeInterval:    config.users.purgeInterval,
+		ActorCacheTTL:        config.actor.cacheTTL,
+		ActorCacheSize:       config.actor.cacheSize,
+		SessionStore:         config.session.store,
+		SessionTTL:           config.session.ttl,
+		JWTKeys:              config.jwt.keys,
+		JWTTTL:               config.jwt.ttl,
+		JWTIssuer:            config.jwt.issuer,
+		JWTReloadInterval:    config.jwt.reloadInterval,
+		OIDCIssuer:           config.oidc.issuer,
+		OIDCCodeTTL:          config.oidc.codeTTL,
+		FederationConfig:     config.federation.config,
+		FederationInterval:   config.federation.interval,
+		RefreshTokenRotation: config.refreshToken.rotation,
 		MnemosyneAddress:     config.mnemosyned.address,
 		MnemosyneTLS:         config.mnemosyned.tls.enabled,
 		MnemosyneTLSCertFile: config.mnemosyned.tls.certFile,
 		Logger:               log.Named("daemon"),
 		RPCListener:          rpcListener,
 		DebugListener:        debugListener,
+		OIDCListener:         oidcListener,
+		PasswordArgon2id: charond.Argon2idOpts{
+			Time:    uint32(config.password.argon2id.time),
+			Memory:  uint32(config.password.argon2id.memory),
+			Threads: uint8(config.password.argon2id.threads),
+		},
+		PasswordSCrypt: charond.SCryptOpts{
+			Cost:            config.password.scrypt.cost,
+			BlockSize:       config.password.scrypt.blockSize,
+			Parallelization: config.password.scrypt.parallelization,
+		},
 	})
 
 	if err := daemon.Run(); err != nil {
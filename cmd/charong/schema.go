@@ -8,9 +8,15 @@ func databaseSchema() *pqt.Schema {
 	userID := id()
 	user := databaseTableUser(userID)
 	group := databaseTableGroup(user)
-	permission, groupPermissions, userPermissions := databaseTablePermission(user, group)
+	permission, groupPermissions, userPermissions, userResourcePermissions := databaseTablePermission(userID, group)
 	userGroups := databaseTableUserGroups(user, group)
 	refreshToken := databaseTableRefreshToken(userID)
+	loginFailure := databaseTableLoginFailure()
+	auditEvent := databaseTableAuditEvent()
+	session := databaseTableSession()
+	oauthClient, oauthConsent, oauthAuthorizationCode := databaseTableOAuth(userID)
+	userIdentity := databaseTableUserIdentity(userID)
+	apiKey := databaseTableAPIKey(userID)
 
 	return pqt.NewSchema("charon", pqt.WithSchemaIfNotExists()).
 		AddTable(user).
@@ -19,7 +25,16 @@ func databaseSchema() *pqt.Schema {
 		AddTable(userGroups).
 		AddTable(groupPermissions).
 		AddTable(userPermissions).
-		AddTable(refreshToken)
+		AddTable(userResourcePermissions).
+		AddTable(refreshToken).
+		AddTable(loginFailure).
+		AddTable(auditEvent).
+		AddTable(session).
+		AddTable(oauthClient).
+		AddTable(oauthConsent).
+		AddTable(oauthAuthorizationCode).
+		AddTable(userIdentity).
+		AddTable(apiKey)
 }
 
 func databaseTableUser(id *pqt.Column) *pqt.Table {
@@ -33,8 +48,22 @@ func databaseTableUser(id *pqt.Column) *pqt.Table {
 		AddColumn(pqt.NewColumn("is_active", pqt.TypeBool(), pqt.WithNotNull(), pqt.WithDefault("FALSE"))).
 		AddColumn(pqt.NewColumn("is_staff", pqt.TypeBool(), pqt.WithNotNull(), pqt.WithDefault("FALSE"))).
 		AddColumn(pqt.NewColumn("is_confirmed", pqt.TypeBool(), pqt.WithNotNull(), pqt.WithDefault("FALSE"))).
+		// Service accounts do not have a password, they authenticate with API keys.
+		AddColumn(pqt.NewColumn("is_service_account", pqt.TypeBool(), pqt.WithNotNull(), pqt.WithDefault("FALSE"))).
 		AddColumn(pqt.NewColumn("confirmation_token", pqt.TypeBytea())).
-		AddColumn(pqt.NewColumn("last_login_at", pqt.TypeTimestampTZ()))
+		AddColumn(pqt.NewColumn("confirmation_token_expire_at", pqt.TypeTimestampTZ())).
+		AddColumn(pqt.NewColumn("last_login_at", pqt.TypeTimestampTZ())).
+		AddColumn(pqt.NewColumn("two_factor_secret", pqt.TypeBytea())).
+		AddColumn(pqt.NewColumn("two_factor_confirmed_at", pqt.TypeTimestampTZ())).
+		AddColumn(pqt.NewColumn("two_factor_last_step", pqt.TypeIntegerBig())).
+		AddColumn(pqt.NewColumn("two_factor_recovery_codes", pqt.TypeBytea())).
+		AddColumn(pqt.NewColumn("two_factor_challenge", pqt.TypeBytea())).
+		AddColumn(pqt.NewColumn("two_factor_challenge_expire_at", pqt.TypeTimestampTZ())).
+		AddColumn(pqt.NewColumn("locked_at", pqt.TypeTimestampTZ())).
+		AddColumn(pqt.NewColumn("password_reset_token", pqt.TypeBytea())).
+		AddColumn(pqt.NewColumn("password_reset_token_expire_at", pqt.TypeTimestampTZ())).
+		// Soft-deleted users are kept until purged, they are hidden and cannot log in.
+		AddColumn(pqt.NewColumn("deleted_at", pqt.TypeTimestampTZ()))
 
 	ownerable(t, pqt.SelfReference())
 	timestampable(t)
@@ -48,24 +77,29 @@ func databaseTableGroup(user *pqt.Table) *pqt.Table {
 		AddColumn(pqt.NewColumn("description", pqt.TypeText()))
 
 	identifierable(t)
+	// Members of a group are members of its parent as well, including permissions granted to it.
+	t.AddRelationship(pqt.ManyToOne(pqt.SelfReference(), pqt.WithColumnName("parent_id"), pqt.WithInversedName("Parent")))
 	ownerable(t, user)
 	timestampable(t)
 
 	return t
 }
 
-func databaseTablePermission(user, group *pqt.Table) (*pqt.Table, *pqt.Table, *pqt.Table) {
+func databaseTablePermission(refUserID *pqt.Column, group *pqt.Table) (*pqt.Table, *pqt.Table, *pqt.Table, *pqt.Table) {
+	user := refUserID.Table
 	subsystem := notNullText("subsystem", "")
 	module := notNullText("module", "")
 	action := notNullText("action", "")
 
+	permissionID := id()
+
 	permission := pqt.NewTable("permission", pqt.WithTableIfNotExists()).
+		AddColumn(permissionID).
 		AddColumn(subsystem).
 		AddColumn(module).
 		AddColumn(action).
 		AddUnique(subsystem, module, action)
 
-	identifierable(permission)
 	timestampable(permission)
 
 	// USER PERMISSIONS
@@ -83,7 +117,10 @@ func databaseTablePermission(user, group *pqt.Table) (*pqt.Table, *pqt.Table, *p
 			pqt.Columns{subsystem, module, action},
 		),
 	), pqt.WithNotNull())
+	// Denied link overrides any grant of the same permission.
+	userPermissions.AddColumn(pqt.NewColumn("denied", pqt.TypeBool(), pqt.WithNotNull(), pqt.WithDefault("FALSE")))
 
+	validity(userPermissions)
 	ownerable(userPermissions, user)
 
 	timestampable(userPermissions)
@@ -102,18 +139,39 @@ func databaseTablePermission(user, group *pqt.Table) (*pqt.Table, *pqt.Table, *p
 				},
 				pqt.Columns{subsystem, module, action},
 			),
-		), pqt.WithNotNull())
+		), pqt.WithNotNull()).
+		AddColumn(pqt.NewColumn("denied", pqt.TypeBool(), pqt.WithNotNull(), pqt.WithDefault("FALSE")))
 
 	ownerable(groupPermissions, user)
 	timestampable(groupPermissions)
 
-	return permission, groupPermissions, userPermissions
+	// USER RESOURCE PERMISSIONS
+	// Permission granted on a single resource, e.g. comment 42, rather than on all of them.
+	userID := pqt.NewColumn("user_id", pqt.TypeIntegerBig(), pqt.WithNotNull(), pqt.WithReference(refUserID))
+	resourcePermissionID := pqt.NewColumn("permission_id", pqt.TypeIntegerBig(), pqt.WithNotNull(), pqt.WithReference(permissionID))
+	resourceType := notNullText("resource_type", "")
+	resourceID := notNullText("resource_id", "")
+
+	userResourcePermissions := pqt.NewTable("user_resource_permissions", pqt.WithTableIfNotExists()).
+		AddColumn(userID).
+		AddColumn(resourcePermissionID).
+		AddColumn(resourceType).
+		AddColumn(resourceID).
+		AddUnique(userID, resourcePermissionID, resourceType, resourceID)
+
+	ownerable(userResourcePermissions, user)
+	timestampable(userResourcePermissions)
+
+	return permission, groupPermissions, userPermissions, userResourcePermissions
 }
 
 func databaseTableUserGroups(user, group *pqt.Table) *pqt.Table {
 	t := pqt.NewTable("user_groups", pqt.WithTableIfNotExists()).
-		AddRelationship(pqt.ManyToMany(user, group, pqt.WithBidirectional()), pqt.WithNotNull())
+		AddRelationship(pqt.ManyToMany(user, group, pqt.WithBidirectional()), pqt.WithNotNull()).
+		// Issuer of the identity provider whose group mapping created the membership, if any.
+		AddColumn(pqt.NewColumn("mapped_from", pqt.TypeText()))
 
+	validity(t)
 	ownerable(t, user)
 	timestampable(t)
 
@@ -121,12 +179,17 @@ func databaseTableUserGroups(user, group *pqt.Table) *pqt.Table {
 }
 
 func databaseTableRefreshToken(refUserID *pqt.Column) *pqt.Table {
-	token := pqt.NewColumn("token", pqt.TypeText(), pqt.WithNotNull(), pqt.WithUnique())
+	// Token itself is not stored, only its prefix, that identifies it, and its hash.
+	prefix := pqt.NewColumn("prefix", pqt.TypeText(), pqt.WithNotNull(), pqt.WithUnique())
 	userID := pqt.NewColumn("user_id", pqt.TypeIntegerBig(), pqt.WithNotNull(), pqt.WithReference(refUserID))
 	expireAt := pqt.NewColumn("expire_at", pqt.TypeTimestampTZ())
 
 	t := pqt.NewTable("refresh_token", pqt.WithTableIfNotExists()).
-		AddColumn(token).
+		AddColumn(prefix).
+		AddColumn(pqt.NewColumn("secret", pqt.TypeBytea(), pqt.WithNotNull())).
+		// Family is a prefix of the token the chain of rotated tokens started with.
+		AddColumn(pqt.NewColumn("family", pqt.TypeText(), pqt.WithNotNull())).
+		AddColumn(pqt.NewColumn("rotated_at", pqt.TypeTimestampTZ())).
 		AddColumn(pqt.NewColumn("revoked", pqt.TypeBool(), pqt.WithNotNull(), pqt.WithDefault("false"))).
 		AddColumn(expireAt).
 		AddColumn(pqt.NewColumn("last_used_at", pqt.TypeTimestampTZ())).
@@ -139,6 +202,139 @@ func databaseTableRefreshToken(refUserID *pqt.Column) *pqt.Table {
 	return t
 }
 
+func databaseTableLoginFailure() *pqt.Table {
+	kind := pqt.NewColumn("kind", pqt.TypeText(), pqt.WithNotNull())
+	subject := pqt.NewColumn("subject", pqt.TypeText(), pqt.WithNotNull())
+
+	t := pqt.NewTable("login_failure", pqt.WithTableIfNotExists()).
+		AddColumn(kind).
+		AddColumn(subject).
+		AddColumn(pqt.NewColumn("failures", pqt.TypeIntegerBig(), pqt.WithNotNull(), pqt.WithDefault("0"))).
+		AddColumn(pqt.NewColumn("last_failure_at", pqt.TypeTimestampTZ(), pqt.WithNotNull(), pqt.WithDefault("NOW()"))).
+		AddUnique(kind, subject)
+
+	identifierable(t)
+	timestampable(t)
+
+	return t
+}
+
+func databaseTableAuditEvent() *pqt.Table {
+	// Actor and target are not referenced, events have to outlive entities they describe.
+	t := pqt.NewTable("audit_event", pqt.WithTableIfNotExists()).
+		AddColumn(pqt.NewColumn("actor_id", pqt.TypeIntegerBig())).
+		AddColumn(pqt.NewColumn("rpc", pqt.TypeText(), pqt.WithNotNull())).
+		AddColumn(pqt.NewColumn("target_kind", pqt.TypeText(), pqt.WithNotNull())).
+		AddColumn(pqt.NewColumn("target_id", pqt.TypeIntegerBig())).
+		AddColumn(pqt.NewColumn("before", pqt.TypeBytea())).
+		AddColumn(pqt.NewColumn("after", pqt.TypeBytea())).
+		AddColumn(pqt.NewColumn("peer", pqt.TypeText(), pqt.WithNotNull(), pqt.WithDefault("''"))).
+		AddColumn(pqt.NewColumn("created_at", pqt.TypeTimestampTZ(), pqt.WithNotNull(), pqt.WithDefault("NOW()")))
+
+	identifierable(t)
+
+	return t
+}
+
+func databaseTableSession() *pqt.Table {
+	// Subject is not referenced, it is an opaque identifier, the same one mnemosyne stores.
+	t := pqt.NewTable("session", pqt.WithTableIfNotExists()).
+		AddColumn(pqt.NewColumn("access_token", pqt.TypeText(), pqt.WithNotNull(), pqt.WithUnique())).
+		AddColumn(pqt.NewColumn("subject_id", pqt.TypeText(), pqt.WithNotNull())).
+		AddColumn(pqt.NewColumn("subject_client", pqt.TypeText(), pqt.WithNotNull(), pqt.WithDefault("''"))).
+		AddColumn(pqt.NewColumn("refresh_token", pqt.TypeText(), pqt.WithNotNull(), pqt.WithDefault("''"))).
+		AddColumn(pqt.NewColumn("bag", pqt.TypeBytea())).
+		AddColumn(pqt.NewColumn("expire_at", pqt.TypeTimestampTZ(), pqt.WithNotNull())).
+		AddColumn(pqt.NewColumn("created_at", pqt.TypeTimestampTZ(), pqt.WithNotNull(), pqt.WithDefault("NOW()")))
+
+	identifierable(t)
+
+	return t
+}
+
+// databaseTableOAuth returns registry of OpenID Connect clients,
+// consents users gave them and authorization codes waiting to be exchanged for tokens.
+// Lists of scopes and redirect URIs are space separated, the same way OAuth2 encodes scopes.
+func databaseTableOAuth(refUserID *pqt.Column) (*pqt.Table, *pqt.Table, *pqt.Table) {
+	clientPK := id()
+	client := pqt.NewTable("oauth_client", pqt.WithTableIfNotExists()).
+		AddColumn(clientPK).
+		AddColumn(pqt.NewColumn("client_id", pqt.TypeText(), pqt.WithNotNull(), pqt.WithUnique())).
+		// Secret is stored hashed, public clients do not have any and have to use PKCE.
+		AddColumn(pqt.NewColumn("secret", pqt.TypeBytea())).
+		AddColumn(pqt.NewColumn("name", pqt.TypeText(), pqt.WithNotNull())).
+		AddColumn(pqt.NewColumn("redirect_uris", pqt.TypeText(), pqt.WithNotNull()))
+
+	ownerable(client, refUserID.Table)
+	timestampable(client)
+
+	consentUserID := pqt.NewColumn("user_id", pqt.TypeIntegerBig(), pqt.WithNotNull(), pqt.WithReference(refUserID))
+	consentClientID := pqt.NewColumn("client_id", pqt.TypeIntegerBig(), pqt.WithNotNull(), pqt.WithReference(clientPK))
+	consent := pqt.NewTable("oauth_consent", pqt.WithTableIfNotExists()).
+		AddColumn(consentUserID).
+		AddColumn(consentClientID).
+		AddColumn(pqt.NewColumn("scope", pqt.TypeText(), pqt.WithNotNull())).
+		AddUnique(consentUserID, consentClientID)
+
+	identifierable(consent)
+	timestampable(consent)
+
+	code := pqt.NewTable("oauth_authorization_code", pqt.WithTableIfNotExists()).
+		// Code is stored hashed, the same way client secret is.
+		AddColumn(pqt.NewColumn("code", pqt.TypeText(), pqt.WithNotNull(), pqt.WithUnique())).
+		AddColumn(pqt.NewColumn("client_id", pqt.TypeIntegerBig(), pqt.WithNotNull(), pqt.WithReference(clientPK))).
+		AddColumn(pqt.NewColumn("user_id", pqt.TypeIntegerBig(), pqt.WithNotNull(), pqt.WithReference(refUserID))).
+		AddColumn(pqt.NewColumn("redirect_uri", pqt.TypeText(), pqt.WithNotNull())).
+		AddColumn(pqt.NewColumn("scope", pqt.TypeText(), pqt.WithNotNull())).
+		AddColumn(pqt.NewColumn("nonce", pqt.TypeText(), pqt.WithNotNull(), pqt.WithDefault("''"))).
+		AddColumn(pqt.NewColumn("code_challenge", pqt.TypeText(), pqt.WithNotNull(), pqt.WithDefault("''"))).
+		AddColumn(pqt.NewColumn("auth_time", pqt.TypeTimestampTZ(), pqt.WithNotNull())).
+		AddColumn(pqt.NewColumn("expire_at", pqt.TypeTimestampTZ(), pqt.WithNotNull())).
+		AddColumn(pqt.NewColumn("created_at", pqt.TypeTimestampTZ(), pqt.WithNotNull(), pqt.WithDefault("NOW()")))
+
+	identifierable(code)
+
+	return client, consent, code
+}
+
+// databaseTableUserIdentity links users with their accounts at upstream OpenID Connect identity providers.
+// Subject is unique only within its issuer.
+func databaseTableUserIdentity(refUserID *pqt.Column) *pqt.Table {
+	issuer := pqt.NewColumn("issuer", pqt.TypeText(), pqt.WithNotNull())
+	subject := pqt.NewColumn("subject", pqt.TypeText(), pqt.WithNotNull())
+	t := pqt.NewTable("user_identity", pqt.WithTableIfNotExists()).
+		AddColumn(pqt.NewColumn("user_id", pqt.TypeIntegerBig(), pqt.WithNotNull(), pqt.WithReference(refUserID))).
+		AddColumn(issuer).
+		AddColumn(subject).
+		AddUnique(issuer, subject)
+
+	identifierable(t)
+	timestampable(t)
+
+	return t
+}
+
+// databaseTableAPIKey returns table of keys machine clients authenticate with.
+// Key consists of a public prefix, which identifies it, and a secret, which is stored hashed.
+// Permissions are newline separated, a key grants only those of them that its user is granted as well.
+func databaseTableAPIKey(refUserID *pqt.Column) *pqt.Table {
+	t := pqt.NewTable("api_key", pqt.WithTableIfNotExists()).
+		AddColumn(pqt.NewColumn("prefix", pqt.TypeText(), pqt.WithNotNull(), pqt.WithUnique())).
+		AddColumn(pqt.NewColumn("secret", pqt.TypeBytea(), pqt.WithNotNull())).
+		AddColumn(pqt.NewColumn("user_id", pqt.TypeIntegerBig(), pqt.WithNotNull(), pqt.WithReference(refUserID))).
+		AddColumn(pqt.NewColumn("permissions", pqt.TypeText(), pqt.WithNotNull(), pqt.WithDefault("''"))).
+		AddColumn(pqt.NewColumn("revoked", pqt.TypeBool(), pqt.WithNotNull(), pqt.WithDefault("false"))).
+		AddColumn(pqt.NewColumn("expire_at", pqt.TypeTimestampTZ())).
+		AddColumn(pqt.NewColumn("last_used_at", pqt.TypeTimestampTZ())).
+		AddColumn(pqt.NewColumn("notes", pqt.TypeText()))
+
+	identifierable(t)
+	ownerable(t, refUserID.Table)
+	timestampable(t)
+
+	return t
+}
+
 func id() *pqt.Column {
 	return pqt.NewColumn("id", pqt.TypeSerialBig(), pqt.WithPrimaryKey())
 }
@@ -157,6 +353,12 @@ func timestampable(t *pqt.Table) {
 		AddColumn(pqt.NewColumn("updated_at", pqt.TypeTimestampTZ(), pqt.WithDefault("NOW()", pqt.EventUpdate)))
 }
 
+// validity makes links stored in the table time-bound, a null bound leaves the window open on that side.
+func validity(t *pqt.Table) {
+	t.AddColumn(pqt.NewColumn("valid_from", pqt.TypeTimestampTZ())).
+		AddColumn(pqt.NewColumn("valid_until", pqt.TypeTimestampTZ()))
+}
+
 func notNullText(name, short string) *pqt.Column {
 	if short == "" {
 		short = name
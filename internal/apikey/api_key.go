@@ -0,0 +1,52 @@
+// Package apikey generates keys machine clients authenticate with.
+// Key has a form of charon_<prefix>_<secret>. Prefix is public, it identifies the key and is stored as is.
+// Secret is stored hashed. Constant part makes leaked keys easy to detect by secret scanners.
+package apikey
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+)
+
+const (
+	scheme       = "charon"
+	prefixLength = 6
+	secretLength = 32
+)
+
+// Random generates new key, it returns the key, its prefix and hash of its secret.
+func Random() (key, prefix string, hash []byte, err error) {
+	buf := make([]byte, prefixLength+secretLength)
+	if _, err = rand.Read(buf); err != nil {
+		return "", "", nil, err
+	}
+
+	prefix = hex.EncodeToString(buf[:prefixLength])
+	secret := hex.EncodeToString(buf[prefixLength:])
+
+	return scheme + "_" + prefix + "_" + secret, prefix, Hash(secret), nil
+}
+
+// Split returns prefix and secret of given key.
+func Split(key string) (prefix, secret string, ok bool) {
+	parts := strings.Split(key, "_")
+	if len(parts) != 3 || parts[0] != scheme || parts[1] == "" || parts[2] == "" {
+		return "", "", false
+	}
+	return parts[1], parts[2], true
+}
+
+// Hash returns digest the secret is persisted as.
+// Secret is random and long enough, so it does not need to be salted nor stretched.
+func Hash(secret string) []byte {
+	sum := sha256.Sum256([]byte(secret))
+	return sum[:]
+}
+
+// Compare returns true if given secret matches given hash, it takes constant time.
+func Compare(hash []byte, secret string) bool {
+	return subtle.ConstantTimeCompare(hash, Hash(secret)) == 1
+}
@@ -0,0 +1,46 @@
+package apikey
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRandom(t *testing.T) {
+	key, prefix, hash, err := Random()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if !strings.HasPrefix(key, "charon_"+prefix+"_") {
+		t.Fatalf("key does not start with its prefix: %s", key)
+	}
+
+	gotPrefix, secret, ok := Split(key)
+	if !ok {
+		t.Fatalf("key cannot be split: %s", key)
+	}
+	if gotPrefix != prefix {
+		t.Errorf("wrong prefix, expected %s but got %s", prefix, gotPrefix)
+	}
+	if !Compare(hash, secret) {
+		t.Error("secret should match its hash")
+	}
+	if Compare(hash, secret+"0") {
+		t.Error("other secret should not match the hash")
+	}
+
+	other, _, _, err := Random()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if other == key {
+		t.Error("keys should be unique")
+	}
+}
+
+func TestSplit(t *testing.T) {
+	for _, key := range []string{"", "charon", "charon_abc", "charon__abc", "charon_abc_", "other_abc_def", "charon_a_b_c"} {
+		if _, _, ok := Split(key); ok {
+			t.Errorf("key should not be split: %q", key)
+		}
+	}
+}
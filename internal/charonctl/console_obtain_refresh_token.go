@@ -31,7 +31,7 @@ func (cort *consoleObtainRefreshToken) ObtainRefreshToken(ctx context.Context, a
 		}
 	}
 
-	_, err = cort.refreshToken.Create(ctx, &charonrpc.CreateRefreshTokenRequest{
+	res, err := cort.refreshToken.Create(ctx, &charonrpc.CreateRefreshTokenRequest{
 		Notes:    &ntypes.String{Chars: arg.Notes, Valid: arg.Notes != ""},
 		ExpireAt: expireAt,
 	})
@@ -39,23 +39,19 @@ func (cort *consoleObtainRefreshToken) ObtainRefreshToken(ctx context.Context, a
 		return err
 	}
 
-	res, err := cort.refreshToken.List(ctx, &charonrpc.ListRefreshTokensRequest{})
+	// Token is stored hashed, this is the only chance to print it.
+	rt := res.RefreshToken
+	eat, err := ptypes.Timestamp(rt.ExpireAt)
 	if err != nil {
-		return err
+		fmt.Printf("%-36s ", "never")
+	} else {
+		fmt.Printf("%-36s ", eat.String())
 	}
-	for _, rt := range res.RefreshTokens {
-		eat, err := ptypes.Timestamp(rt.ExpireAt)
-		if err != nil {
-			fmt.Printf("%-36s ", "never")
-		} else {
-			fmt.Printf("%-36s ", eat.String())
-		}
 
-		fmt.Printf("%s", rt.Token)
-		if rt.Notes != nil && rt.Notes.Valid {
-			fmt.Printf(" - %s", rt.Notes.StringOr(""))
-		}
-		fmt.Print("\n")
+	fmt.Printf("%s", rt.Token)
+	if rt.Notes != nil && rt.Notes.Valid {
+		fmt.Printf(" - %s", rt.Notes.StringOr(""))
 	}
+	fmt.Print("\n")
 	return nil
 }
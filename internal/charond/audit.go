@@ -0,0 +1,231 @@
+package charond
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/mapping"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	"github.com/piotrkowalczuk/ntypes"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// auditEntry describes a change made by a mutating RPC.
+// Before and after are snapshots of the target: entities, protobuf messages or values encoded as JSON objects.
+// Entities are mapped to their public representation first, so that no credentials end up in the audit log.
+type auditEntry struct {
+	targetKind string
+	targetID   int64
+	before     interface{}
+	after      interface{}
+	// skip can be set by change if it turned out that nothing was modified.
+	skip bool
+}
+
+// audit calls change within a transaction and, if it succeeds, persists given entry as an audit event
+// within the same transaction. Change is expected to complete the entry, e.g. by setting after snapshot.
+func (h *handler) audit(ctx context.Context, act *session.Actor, entry *auditEntry, change func(context.Context) error) error {
+	return h.repository.transactor.Transaction(ctx, func(ctx context.Context) error {
+		if err := change(ctx); err != nil {
+			return err
+		}
+		if entry.skip {
+			return nil
+		}
+
+		before, after, err := auditDiff(entry.before, entry.after)
+		if err != nil {
+			return grpcerr.E(codes.Internal, "audit event encoding failure", err)
+		}
+		rpc, _ := grpc.Method(ctx)
+		ent := &model.AuditEventEntity{
+			RPC:        rpc,
+			TargetKind: entry.targetKind,
+			TargetID:   ntypes.Int64{Int64: entry.targetID, Valid: entry.targetID > 0},
+			Before:     before,
+			After:      after,
+			Peer:       peerHost(ctx),
+		}
+		if act != nil && act.User != nil {
+			ent.ActorID = ntypes.Int64{Int64: act.User.ID, Valid: true}
+		}
+		if _, err = h.repository.auditEvent.Insert(ctx, ent); err != nil {
+			return grpcerr.E(codes.Internal, "audit event cannot be persisted", err)
+		}
+		return nil
+	})
+}
+
+// auditDiff encodes given snapshots, leaving out fields that are equal in both of them.
+func auditDiff(before, after interface{}) ([]byte, []byte, error) {
+	b, err := auditDocument(before)
+	if err != nil {
+		return nil, nil, err
+	}
+	a, err := auditDocument(after)
+	if err != nil {
+		return nil, nil, err
+	}
+	if b != nil && a != nil {
+		for k, v := range b {
+			if w, ok := a[k]; ok && reflect.DeepEqual(v, w) {
+				delete(b, k)
+				delete(a, k)
+			}
+		}
+	}
+
+	bb, err := auditEncode(b)
+	if err != nil {
+		return nil, nil, err
+	}
+	ab, err := auditEncode(a)
+	if err != nil {
+		return nil, nil, err
+	}
+	return bb, ab, nil
+}
+
+// auditPermissions returns snapshot of a set of permissions that is independent of their order.
+func auditPermissions(permissions charon.Permissions) map[string]interface{} {
+	res := make([]string, 0, len(permissions))
+	for _, p := range permissions {
+		res = append(res, p.String())
+	}
+	sort.Strings(res)
+
+	return map[string]interface{}{"permissions": res}
+}
+
+// auditResourcePermissions works like auditPermissions, the resource permissions are granted on is recorded as well.
+func auditResourcePermissions(resourceType, resourceID string, permissions charon.Permissions) map[string]interface{} {
+	snapshot := auditPermissions(permissions)
+	snapshot["resource"] = map[string]interface{}{"type": resourceType, "id": resourceID}
+
+	return snapshot
+}
+
+// auditPermissionsWithDenials works like auditPermissions, denials are recorded with the denial prefix.
+func auditPermissionsWithDenials(granted, denied charon.Permissions) map[string]interface{} {
+	all := make(charon.Permissions, 0, len(granted)+len(denied))
+	all = append(all, granted...)
+	for _, p := range denied {
+		all = append(all, p.Deny())
+	}
+
+	return auditPermissions(all)
+}
+
+// auditPermissionsValidity extends permissions snapshot with time windows of time-bound links.
+func auditPermissionsValidity(snapshot map[string]interface{}, validity map[charon.Permission]model.Validity) map[string]interface{} {
+	if len(validity) == 0 {
+		return snapshot
+	}
+	windows := make(map[string]interface{}, len(validity))
+	for p, v := range validity {
+		windows[p.String()] = auditValidity(v)
+	}
+	snapshot["validity"] = windows
+
+	return snapshot
+}
+
+// auditGroupsValidity extends groups snapshot with time windows of time-bound memberships.
+func auditGroupsValidity(snapshot map[string]interface{}, validity map[int64]model.Validity) map[string]interface{} {
+	if len(validity) == 0 {
+		return snapshot
+	}
+	windows := make(map[string]interface{}, len(validity))
+	for id, v := range validity {
+		windows[strconv.FormatInt(id, 10)] = auditValidity(v)
+	}
+	snapshot["validity"] = windows
+
+	return snapshot
+}
+
+func auditValidity(v model.Validity) map[string]interface{} {
+	window := make(map[string]interface{}, 2)
+	if v.From.Valid {
+		window["valid_from"] = v.From.Time.UTC().Format(time.RFC3339Nano)
+	}
+	if v.Until.Valid {
+		window["valid_until"] = v.Until.Time.UTC().Format(time.RFC3339Nano)
+	}
+	return window
+}
+
+// auditGroups returns snapshot of a set of group ids that is independent of their order.
+func auditGroups(ids []int64) map[string]interface{} {
+	res := append(make([]int64, 0, len(ids)), ids...)
+	sort.Slice(res, func(i, j int) bool { return res[i] < res[j] })
+
+	return map[string]interface{}{"groups": res}
+}
+
+var auditMarshaler = jsonpb.Marshaler{OrigName: true, EmitDefaults: true}
+
+func auditDocument(snapshot interface{}) (map[string]interface{}, error) {
+	if snapshot == nil {
+		return nil, nil
+	}
+	if v := reflect.ValueOf(snapshot); v.Kind() == reflect.Ptr && v.IsNil() {
+		return nil, nil
+	}
+
+	snapshot, err := auditSnapshot(snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf []byte
+	if msg, ok := snapshot.(proto.Message); ok {
+		var b bytes.Buffer
+		err = auditMarshaler.Marshal(&b, msg)
+		buf = b.Bytes()
+	} else {
+		buf, err = json.Marshal(snapshot)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	doc := make(map[string]interface{})
+	if err = json.Unmarshal(buf, &doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+func auditSnapshot(snapshot interface{}) (interface{}, error) {
+	switch ent := snapshot.(type) {
+	case *model.UserEntity:
+		return mapping.ReverseUser(ent)
+	case *model.GroupEntity:
+		return mapping.ReverseGroup(ent)
+	case *model.RefreshTokenEntity:
+		return mapping.ReverseRefreshToken(ent)
+	case *model.OauthClientEntity:
+		return mapping.ReverseOAuthClient(ent)
+	default:
+		return snapshot, nil
+	}
+}
+
+func auditEncode(doc map[string]interface{}) ([]byte, error) {
+	if doc == nil {
+		return nil, nil
+	}
+	return json.Marshal(doc)
+}
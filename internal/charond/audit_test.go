@@ -0,0 +1,87 @@
+package charond
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/model/modelmock"
+	"github.com/stretchr/testify/mock"
+)
+
+// newTransactorMock returns transactor that calls given functions directly, without any transaction.
+func newTransactorMock() *modelmock.Transactor {
+	m := &modelmock.Transactor{}
+	m.On("Transaction", mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+	return m
+}
+
+func auditEventMatcher(kind string, targetID int64) interface{} {
+	return mock.MatchedBy(func(ent *model.AuditEventEntity) bool {
+		return ent.TargetKind == kind && ent.TargetID.Int64Or(0) == targetID
+	})
+}
+
+func TestAuditDiff(t *testing.T) {
+	cases := map[string]struct {
+		before, after       interface{}
+		expBefore, expAfter string
+	}{
+		"none": {},
+		"typed-nil": {
+			before:   (*model.UserEntity)(nil),
+			after:    map[string]interface{}{"name": "test"},
+			expAfter: `{"name":"test"}`,
+		},
+		"created": {
+			after:    map[string]interface{}{"a": 1, "b": []string{"x"}},
+			expAfter: `{"a":1,"b":["x"]}`,
+		},
+		"deleted": {
+			before:    map[string]interface{}{"a": 1},
+			expBefore: `{"a":1}`,
+		},
+		"modified": {
+			before:    map[string]interface{}{"a": 1, "b": true, "c": "same"},
+			after:     map[string]interface{}{"a": 2, "b": true, "c": "same", "d": false},
+			expBefore: `{"a":1}`,
+			expAfter:  `{"a":2,"d":false}`,
+		},
+		"entity": {
+			before: &model.UserEntity{ID: 1, Username: "john", Password: []byte("secret")},
+			after: &model.UserEntity{
+				ID:       1,
+				Username: "john",
+				Password: []byte("secret"),
+				LockedAt: pq.NullTime{Valid: true},
+			},
+			expBefore: `{"is_locked":false}`,
+			expAfter:  `{"is_locked":true}`,
+		},
+		"refresh-token": {
+			before:    &model.RefreshTokenEntity{Prefix: "abc", Secret: []byte("secret")},
+			after:     &model.RefreshTokenEntity{Prefix: "abc", Secret: []byte("secret"), Revoked: true},
+			expBefore: `{"revoked":false}`,
+			expAfter:  `{"revoked":true}`,
+		},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			before, after, err := auditDiff(c.before, c.after)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if string(before) != c.expBefore {
+				t.Errorf("wrong before, expected %s but got %s", c.expBefore, before)
+			}
+			if string(after) != c.expAfter {
+				t.Errorf("wrong after, expected %s but got %s", c.expAfter, after)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package charond
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"io"
 	"net"
 	"net/http"
@@ -13,8 +14,7 @@ import (
 	"github.com/piotrkowalczuk/charon"
 	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
 	"github.com/piotrkowalczuk/charon/internal/grpcerr"
-	"github.com/piotrkowalczuk/mnemosyne"
-	"github.com/piotrkowalczuk/mnemosyne/mnemosynerpc"
+	"github.com/piotrkowalczuk/charon/internal/session"
 	"github.com/piotrkowalczuk/promgrpc/v3"
 	"github.com/piotrkowalczuk/zapstackdriver/zapstackdrivergrpc"
 	"github.com/prometheus/client_golang/prometheus"
@@ -24,7 +24,6 @@ import (
 	"golang.org/x/net/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
-	"google.golang.org/grpc/metadata"
 )
 
 // DaemonOpts ...
@@ -36,17 +35,70 @@ type DaemonOpts struct {
 	TLSKeyFile           string
 	PostgresAddress      string
 	PostgresDebug        bool
+	PasswordStrategy     string
 	PasswordBCryptCost   int
+	PasswordArgon2id     Argon2idOpts
+	PasswordSCrypt       SCryptOpts
+	LDAPAddress          string
+	LDAPBindDN           string
+	LDAPTLS              bool
+	TOTPIssuer           string
+	LoginFreeAttempts    int64
+	LoginDelay           time.Duration
+	LoginMaxDelay        time.Duration
+	LoginWindow          time.Duration
+	LoginLockout         int64
+	PasswordResetTTL     time.Duration
+	PasswordResetLimit   int64
+	PasswordResetWindow  time.Duration
+	NotifierFile         string
+	Registration         bool
+	RegistrationTTL      time.Duration
+	RegistrationLimit    int64
+	RegistrationWindow   time.Duration
+	GrantSweepInterval   time.Duration
+	UserRetention        time.Duration
+	UserPurgeInterval    time.Duration
+	ActorCacheTTL        time.Duration
+	ActorCacheSize       int
+	SessionStore         string
+	SessionTTL           time.Duration
+	JWTKeys              string
+	JWTTTL               time.Duration
+	JWTIssuer            string
+	JWTReloadInterval    time.Duration
+	OIDCIssuer           string
+	OIDCCodeTTL          time.Duration
+	FederationConfig     string
+	FederationInterval   time.Duration
+	RefreshTokenRotation bool
 	MnemosyneAddress     string
 	MnemosyneTLS         bool
 	MnemosyneTLSCertFile string
 	Logger               *zap.Logger
 	RPCListener          net.Listener
 	DebugListener        net.Listener
+	OIDCListener         net.Listener
+}
+
+// Argon2idOpts represent set of parameters used by argon2id password strategy.
+type Argon2idOpts struct {
+	Time    uint32
+	Memory  uint32
+	Threads uint8
+}
+
+// SCryptOpts represent set of parameters used by scrypt password strategy.
+type SCryptOpts struct {
+	Cost            int
+	BlockSize       int
+	Parallelization int
 }
 
 // TestDaemonOpts represent set of options that can be passed to the TestDaemon constructor.
 type TestDaemonOpts struct {
+	// SessionStore is mnemosyne by default, postgres store does not need MnemosyneAddress.
+	SessionStore     string
 	MnemosyneAddress string
 	PostgresAddress  string
 	PostgresDebug    bool
@@ -58,8 +110,12 @@ type Daemon struct {
 	logger        *zap.Logger
 	rpcListener   net.Listener
 	debugListener net.Listener
+	oidcListener  net.Listener
+	// mnemosyneConn is nil, unless mnemosyne is the session store.
 	mnemosyneConn *grpc.ClientConn
-	mnemosyne     mnemosynerpc.SessionManagerClient
+	sessionStore  session.Store
+	// cancel stops background jobs.
+	cancel context.CancelFunc
 }
 
 // NewDaemon ...
@@ -69,6 +125,7 @@ func NewDaemon(opts DaemonOpts) *Daemon {
 		logger:        opts.Logger,
 		rpcListener:   opts.RPCListener,
 		debugListener: opts.DebugListener,
+		oidcListener:  opts.OIDCListener,
 	}
 
 	return d
@@ -85,11 +142,14 @@ func TestDaemon(t *testing.T, opts TestDaemonOpts) (net.Addr, io.Closer) {
 		Test:               true,
 		Monitoring:         false,
 		MnemosyneAddress:   opts.MnemosyneAddress,
+		SessionStore:       opts.SessionStore,
+		SessionTTL:         time.Hour,
 		Logger:             zap.L(), // TODO: implement properly
 		PostgresAddress:    opts.PostgresAddress,
 		PostgresDebug:      opts.PostgresDebug,
 		RPCListener:        l,
 		PasswordBCryptCost: bcrypt.MinCost,
+		PasswordResetTTL:   time.Hour,
 	})
 	if err := d.Run(); err != nil {
 		t.Fatalf("charon daemon start error: %s", err.Error())
@@ -116,22 +176,22 @@ func (d *Daemon) Run() (err error) {
 	serverOpts := []grpc.ServerOption{
 		grpc.MaxConcurrentStreams(100),
 		grpc.StatsHandler(interceptor),
-		// No stream endpoint available at the moment.
 		grpc.UnaryInterceptor(unaryServerInterceptors(
 			grpcerr.UnaryServerInterceptor(),
 			func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-				if md, ok := metadata.FromIncomingContext(ctx); ok {
-					ctx = metadata.NewOutgoingContext(ctx, metadata.MD{
-						mnemosyne.AccessTokenMetadataKey: md[mnemosyne.AccessTokenMetadataKey],
-						"request_id":                     md["request_id"],
-					})
-				}
-
-				return handler(ctx, req)
+				return handler(forwardMetadata(ctx), req)
 			},
 			zapstackdrivergrpc.UnaryServerInterceptor(d.logger),
 			interceptor.UnaryServer(),
 		)),
+		grpc.StreamInterceptor(streamServerInterceptors(
+			grpcerr.StreamServerInterceptor(),
+			func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+				return handler(srv, &serverStream{ServerStream: ss, ctx: forwardMetadata(ss.Context())})
+			},
+			zapstackdrivergrpc.StreamServerInterceptor(d.logger),
+			interceptor.StreamServer(),
+		)),
 	}
 	if d.opts.TLS {
 		serverCreds, err := credentials.NewServerTLSFromFile(d.opts.TLSCertFile, d.opts.TLSKeyFile)
@@ -162,9 +222,22 @@ func (d *Daemon) Run() (err error) {
 	}
 	repos := newRepositories(db)
 
-	d.mnemosyne, d.mnemosyneConn = initMnemosyne(d.opts.MnemosyneAddress, d.logger, clientOpts)
+	d.sessionStore, d.mnemosyneConn, err = initSessionStore(d.opts, repos, d.logger, clientOpts)
+	if err != nil {
+		return err
+	}
+	keys, err := initKeyRing(d.opts, d.logger.Named("key_ring"))
+	if err != nil {
+		return err
+	}
+	if d.oidcListener != nil && keys == nil {
+		return errors.New("openid connect provider requires jwt keys to sign id tokens")
+	}
+	if keys != nil {
+		d.sessionStore = initTokenStore(d.opts, d.sessionStore, keys, repos, d.logger)
+	}
 
-	passwordHasher := initHasher(d.opts.PasswordBCryptCost, d.logger)
+	passwordHasher := initHasher(d.opts, d.logger)
 	if d.opts.Test {
 		if err = createDummyTestUser(
 			context.TODO(),
@@ -179,14 +252,48 @@ func (d *Daemon) Run() (err error) {
 
 	permissionReg := initPermissionRegistry(repos.permission, charon.AllPermissions, d.logger)
 
+	var background context.Context
+	background, d.cancel = context.WithCancel(context.Background())
+	if sweeper := initGrantSweeper(d.opts, repos, d.logger.Named("grant_sweeper")); sweeper != nil {
+		go sweeper.run(background)
+	}
+	if purger := initUserPurger(d.opts, repos, d.logger.Named("user_purger")); purger != nil {
+		go purger.run(background)
+	}
+	broker, err := initWatchBroker(d.opts, d.logger.Named("watch_broker"))
+	if err != nil {
+		d.cancel()
+		return err
+	}
+	go broker.run(background)
+	if keys != nil && d.opts.JWTReloadInterval > 0 {
+		go keys.run(background, d.opts.JWTReloadInterval)
+	}
+
+	actorCache := initActorCache(d.opts, d.sessionStore, repos, d.logger)
+	if actorCache != nil {
+		go broker.invalidate(background, actorCache)
+	}
+
+	loginMetrics := newLoginMetrics()
+
 	gRPCServer := grpc.NewServer(serverOpts...)
 	server := &rpcServer{
-		opts:               d.opts,
-		logger:             d.logger.Named("rpc_server"),
-		session:            d.mnemosyne,
-		passwordHasher:     passwordHasher,
-		permissionRegistry: permissionReg,
-		repository:         repos,
+		opts:                 d.opts,
+		logger:               d.logger.Named("rpc_server"),
+		session:              d.sessionStore,
+		passwordHasher:       passwordHasher,
+		externalAuth:         initExternalAuthenticator(d.opts, d.logger),
+		federation:           initFederation(d.opts, repos, d.logger),
+		loginThrottler:       initLoginThrottler(d.opts, repos),
+		loginMetrics:         loginMetrics,
+		notifier:             initNotifier(d.opts, d.logger),
+		registrationLimiter:  initRegistrationLimiter(d.opts, repos),
+		passwordResetLimiter: initPasswordResetLimiter(d.opts, repos),
+		permissionRegistry:   permissionReg,
+		watchBroker:          broker,
+		actorCache:           actorCache,
+		repository:           repos,
 	}
 
 	charonrpc.RegisterAuthServer(gRPCServer, newAuth(server))
@@ -194,9 +301,17 @@ func (d *Daemon) Run() (err error) {
 	charonrpc.RegisterGroupManagerServer(gRPCServer, newGroupManager(server))
 	charonrpc.RegisterPermissionManagerServer(gRPCServer, newPermissionManager(server))
 	charonrpc.RegisterRefreshTokenManagerServer(gRPCServer, newRefreshTokenManager(server))
+	charonrpc.RegisterAPIKeyManagerServer(gRPCServer, newAPIKeyManager(server))
+	charonrpc.RegisterAuditManagerServer(gRPCServer, newAuditManager(server))
+	charonrpc.RegisterOAuthClientManagerServer(gRPCServer, newOAuthClientManager(server))
+	charonrpc.RegisterSessionManagerServer(gRPCServer, newSessionManager(server))
 
 	if !d.opts.Test {
 		prometheus.DefaultRegisterer.Register(interceptor)
+		prometheus.DefaultRegisterer.Register(loginMetrics)
+		if actorCache != nil {
+			prometheus.DefaultRegisterer.Register(actorCache)
+		}
 		promgrpc.RegisterInterceptor(gRPCServer, interceptor)
 	}
 
@@ -224,6 +339,9 @@ func (d *Daemon) Run() (err error) {
 			mux.Handle("/debug/pprof/profile", http.HandlerFunc(pprof.Profile))
 			mux.Handle("/debug/pprof/symbol", http.HandlerFunc(pprof.Symbol))
 			mux.Handle("/debug/pprof/trace", http.HandlerFunc(pprof.Trace))
+			if keys != nil {
+				mux.Handle("/.well-known/jwks.json", keys)
+			}
 			mux.Handle("/metrics", promhttp.HandlerFor(prometheus.DefaultGatherer, promhttp.HandlerOpts{}))
 			mux.Handle("/healthz", &healthHandler{
 				logger:   d.logger,
@@ -253,19 +371,41 @@ func (d *Daemon) Run() (err error) {
 		}()
 	}
 
+	if d.oidcListener != nil {
+		provider := newOIDCProvider(d.opts, server, keys)
+		go provider.run(background, time.Minute)
+		go func() {
+			d.logger.Info("openid connect provider is running", zap.Stringer("address", d.oidcListener.Addr()), zap.String("issuer", d.opts.OIDCIssuer))
+
+			if err := http.Serve(d.oidcListener, provider); err != nil {
+				d.logger.Error("openid connect provider stopped with an error", zap.Error(err))
+			}
+		}()
+	}
+
 	return
 }
 
 // Close implements io.Closer interface.
 func (d *Daemon) Close() (err error) {
-	if err = d.mnemosyneConn.Close(); err != nil {
-		return
+	if d.cancel != nil {
+		d.cancel()
+	}
+	if d.mnemosyneConn != nil {
+		if err = d.mnemosyneConn.Close(); err != nil {
+			return
+		}
 	}
 	if err = d.rpcListener.Close(); err != nil {
 		return
 	}
 	if d.debugListener != nil {
-		err = d.debugListener.Close()
+		if err = d.debugListener.Close(); err != nil {
+			return
+		}
+	}
+	if d.oidcListener != nil {
+		err = d.oidcListener.Close()
 	}
 	return
 }
@@ -6,16 +6,27 @@ import (
 
 	"github.com/piotrkowalczuk/charon/internal/model"
 	"github.com/piotrkowalczuk/charon/internal/password"
+	"github.com/piotrkowalczuk/charon/internal/refreshtoken"
 )
 
 type repositories struct {
 	user             model.UserProvider
 	userGroups       model.UserGroupsProvider
 	userPermissions  model.UserPermissionsProvider
+	userResources    model.UserResourcePermissionsProvider
 	permission       model.PermissionProvider
 	group            model.GroupProvider
 	groupPermissions model.GroupPermissionsProvider
 	refreshToken     model.RefreshTokenProvider
+	loginFailure     model.LoginFailureProvider
+	auditEvent       model.AuditEventProvider
+	session          model.SessionProvider
+	oauthClient      model.OauthClientProvider
+	oauthConsent     model.OauthConsentProvider
+	oauthCode        model.OauthAuthorizationCodeProvider
+	userIdentity     model.UserIdentityProvider
+	apiKey           model.APIKeyProvider
+	transactor       model.Transactor
 }
 
 func newRepositories(db *sql.DB) repositories {
@@ -23,10 +34,20 @@ func newRepositories(db *sql.DB) repositories {
 		user:             model.NewUserRepository(db),
 		userGroups:       model.NewUserGroupsRepository(db),
 		userPermissions:  model.NewUserPermissionsRepository(db),
+		userResources:    model.NewUserResourcePermissionsRepository(db),
 		permission:       model.NewPermissionRepository(db),
 		group:            model.NewGroupRepository(db),
 		groupPermissions: model.NewGroupPermissionsRepository(db),
 		refreshToken:     model.NewRefreshTokenRepository(db),
+		loginFailure:     model.NewLoginFailureRepository(db),
+		auditEvent:       model.NewAuditEventRepository(db),
+		session:          model.NewSessionRepository(db),
+		oauthClient:      model.NewOauthClientRepository(db),
+		oauthConsent:     model.NewOauthConsentRepository(db),
+		oauthCode:        model.NewOauthAuthorizationCodeRepository(db),
+		userIdentity:     model.NewUserIdentityRepository(db),
+		apiKey:           model.NewAPIKeyRepository(db),
+		transactor:       model.NewTransactor(db),
 	}
 }
 
@@ -46,10 +67,227 @@ func execQueries(db *sql.DB, queries ...string) (err error) {
 	return
 }
 
+// upgradeQueries bring databases created by previous versions up to date with model.SQL.
+// Tables are created with IF NOT EXISTS clause, so newly added columns would be missing otherwise.
+var upgradeQueries = []string{
+	`ALTER TABLE ` + model.TableUser + `
+		ADD COLUMN IF NOT EXISTS ` + model.TableUserColumnTwoFactorSecret + ` BYTEA,
+		ADD COLUMN IF NOT EXISTS ` + model.TableUserColumnTwoFactorConfirmedAt + ` TIMESTAMPTZ,
+		ADD COLUMN IF NOT EXISTS ` + model.TableUserColumnTwoFactorLastStep + ` BIGINT,
+		ADD COLUMN IF NOT EXISTS ` + model.TableUserColumnTwoFactorRecoveryCodes + ` BYTEA,
+		ADD COLUMN IF NOT EXISTS ` + model.TableUserColumnTwoFactorChallenge + ` BYTEA,
+		ADD COLUMN IF NOT EXISTS ` + model.TableUserColumnTwoFactorChallengeExpireAt + ` TIMESTAMPTZ`,
+	`ALTER TABLE ` + model.TableUser + `
+		ADD COLUMN IF NOT EXISTS ` + model.TableUserColumnLockedAt + ` TIMESTAMPTZ`,
+	`ALTER TABLE ` + model.TableUser + `
+		ADD COLUMN IF NOT EXISTS ` + model.TableUserColumnPasswordResetToken + ` BYTEA,
+		ADD COLUMN IF NOT EXISTS ` + model.TableUserColumnPasswordResetTokenExpireAt + ` TIMESTAMPTZ`,
+	`ALTER TABLE ` + model.TableUser + `
+		ADD COLUMN IF NOT EXISTS ` + model.TableUserColumnConfirmationTokenExpireAt + ` TIMESTAMPTZ`,
+	`ALTER TABLE ` + model.TableGroup + `
+		ADD COLUMN IF NOT EXISTS ` + model.TableGroupColumnParentID + ` BIGINT
+			CONSTRAINT "` + model.TableGroupConstraintParentIDForeignKey + `" REFERENCES ` + model.TableGroup + ` (` + model.TableGroupColumnID + `)`,
+	`ALTER TABLE ` + model.TableUserPermissions + `
+		ADD COLUMN IF NOT EXISTS ` + model.TableUserPermissionsColumnDenied + ` BOOL NOT NULL DEFAULT FALSE`,
+	`ALTER TABLE ` + model.TableGroupPermissions + `
+		ADD COLUMN IF NOT EXISTS ` + model.TableGroupPermissionsColumnDenied + ` BOOL NOT NULL DEFAULT FALSE`,
+	`ALTER TABLE ` + model.TableUserPermissions + `
+		ADD COLUMN IF NOT EXISTS ` + model.TableUserPermissionsColumnValidFrom + ` TIMESTAMPTZ,
+		ADD COLUMN IF NOT EXISTS ` + model.TableUserPermissionsColumnValidUntil + ` TIMESTAMPTZ`,
+	`ALTER TABLE ` + model.TableUserGroups + `
+		ADD COLUMN IF NOT EXISTS ` + model.TableUserGroupsColumnValidFrom + ` TIMESTAMPTZ,
+		ADD COLUMN IF NOT EXISTS ` + model.TableUserGroupsColumnValidUntil + ` TIMESTAMPTZ`,
+	`ALTER TABLE ` + model.TableUser + `
+		ADD COLUMN IF NOT EXISTS ` + model.TableUserColumnIsServiceAccount + ` BOOL NOT NULL DEFAULT FALSE`,
+	`ALTER TABLE ` + model.TableRefreshToken + `
+		ADD COLUMN IF NOT EXISTS ` + model.TableRefreshTokenColumnPrefix + ` TEXT,
+		ADD COLUMN IF NOT EXISTS ` + model.TableRefreshTokenColumnSecret + ` BYTEA,
+		ADD COLUMN IF NOT EXISTS ` + model.TableRefreshTokenColumnFamily + ` TEXT,
+		ADD COLUMN IF NOT EXISTS ` + model.TableRefreshTokenColumnRotatedAt + ` TIMESTAMPTZ`,
+	`ALTER TABLE ` + model.TableUser + `
+		ADD COLUMN IF NOT EXISTS ` + model.TableUserColumnDeletedAt + ` TIMESTAMPTZ`,
+	`ALTER TABLE ` + model.TableUserGroups + `
+		ADD COLUMN IF NOT EXISTS ` + model.TableUserGroupsColumnMappedFrom + ` TEXT`,
+}
+
+// hashRefreshTokensQueries finish what migrateRefreshTokens started, once plain tokens are gone.
+var hashRefreshTokensQueries = []string{
+	`ALTER TABLE ` + model.TableRefreshToken + `
+		ALTER COLUMN ` + model.TableRefreshTokenColumnPrefix + ` SET NOT NULL,
+		ALTER COLUMN ` + model.TableRefreshTokenColumnSecret + ` SET NOT NULL,
+		ALTER COLUMN ` + model.TableRefreshTokenColumnFamily + ` SET NOT NULL`,
+	`CREATE UNIQUE INDEX IF NOT EXISTS "` + model.TableRefreshTokenConstraintPrefixUnique + `"
+		ON ` + model.TableRefreshToken + ` (` + model.TableRefreshTokenColumnPrefix + `)`,
+}
+
+// migrateRefreshTokens replaces refresh tokens stored in plain text by previous versions with their hashes.
+// It is done here, not in SQL, because Postgres older than 11 has no built-in SHA-256 function.
+// Each token becomes the first member of its own family.
+func migrateRefreshTokens(db *sql.DB) error {
+	var legacy bool
+	err := db.QueryRow(`
+		SELECT EXISTS (
+			SELECT 1 FROM information_schema.columns
+			WHERE table_schema = 'charon' AND table_name = 'refresh_token' AND column_name = 'token'
+		)`).Scan(&legacy)
+	if err != nil || !legacy {
+		return err
+	}
+
+	return model.RunInTransaction(context.Background(), db, func(tx *sql.Tx) error {
+		rows, err := tx.Query(`SELECT token FROM ` + model.TableRefreshToken + ` WHERE ` + model.TableRefreshTokenColumnPrefix + ` IS NULL`)
+		if err != nil {
+			return err
+		}
+		var tokens []string
+		for rows.Next() {
+			var token string
+			if err = rows.Scan(&token); err != nil {
+				rows.Close()
+				return err
+			}
+			tokens = append(tokens, token)
+		}
+		rows.Close()
+		if err = rows.Err(); err != nil {
+			return err
+		}
+
+		for _, token := range tokens {
+			prefix := refreshtoken.Prefix(token)
+			_, err = tx.Exec(`
+				UPDATE `+model.TableRefreshToken+`
+				SET `+model.TableRefreshTokenColumnPrefix+` = $2, `+model.TableRefreshTokenColumnSecret+` = $3, `+model.TableRefreshTokenColumnFamily+` = $2
+				WHERE token = $1
+			`, token, prefix, refreshtoken.Hash(token))
+			if err != nil {
+				return err
+			}
+		}
+		_, err = tx.Exec(`ALTER TABLE ` + model.TableRefreshToken + ` DROP COLUMN token`)
+		return err
+	}, 1)
+}
+
+// watchQueries install triggers that publish changes affecting authorization on watchChannel.
+// Payload is a JSON object holding kind, that matches charonrpc.WatchEvent_Kind name, and user id.
+// Postgres folds identical notifications sent within a single transaction into one.
+var watchQueries = []string{
+	`CREATE OR REPLACE FUNCTION charon.watch_notify(TEXT, BIGINT) RETURNS VOID AS $$
+	BEGIN
+		PERFORM pg_notify('` + watchChannel + `', json_build_object('kind', $1, 'user_id', $2)::TEXT);
+	END;
+	$$ LANGUAGE plpgsql`,
+	`CREATE OR REPLACE FUNCTION charon.watch_user() RETURNS TRIGGER AS $$
+	BEGIN
+		PERFORM charon.watch_notify(TG_ARGV[0], NEW.` + model.TableUserColumnID + `);
+		RETURN NULL;
+	END;
+	$$ LANGUAGE plpgsql`,
+	`CREATE OR REPLACE FUNCTION charon.watch_user_link() RETURNS TRIGGER AS $$
+	BEGIN
+		IF TG_OP = 'DELETE' THEN
+			PERFORM charon.watch_notify(TG_ARGV[0], OLD.user_id);
+		ELSE
+			PERFORM charon.watch_notify(TG_ARGV[0], NEW.user_id);
+		END IF;
+		RETURN NULL;
+	END;
+	$$ LANGUAGE plpgsql`,
+	// Members of descendant groups are notified as well, since they inherit from the group.
+	`CREATE OR REPLACE FUNCTION charon.watch_group_members(TEXT, BIGINT) RETURNS VOID AS $$
+	DECLARE
+		member BIGINT;
+	BEGIN
+		FOR member IN
+			WITH RECURSIVE descendant (id) AS (
+				SELECT $2
+				UNION
+				SELECT g.` + model.TableGroupColumnID + ` FROM ` + model.TableGroup + ` AS g
+				INNER JOIN descendant AS d ON g.` + model.TableGroupColumnParentID + ` = d.id
+			)
+			SELECT DISTINCT ug.` + model.TableUserGroupsColumnUserID + `
+			FROM ` + model.TableUserGroups + ` AS ug
+			INNER JOIN descendant AS d ON ug.` + model.TableUserGroupsColumnGroupID + ` = d.id
+		LOOP
+			PERFORM charon.watch_notify($1, member);
+		END LOOP;
+	END;
+	$$ LANGUAGE plpgsql`,
+	`CREATE OR REPLACE FUNCTION charon.watch_group() RETURNS TRIGGER AS $$
+	BEGIN
+		PERFORM charon.watch_group_members(TG_ARGV[0], NEW.` + model.TableGroupColumnID + `);
+		RETURN NULL;
+	END;
+	$$ LANGUAGE plpgsql`,
+	`CREATE OR REPLACE FUNCTION charon.watch_group_link() RETURNS TRIGGER AS $$
+	BEGIN
+		IF TG_OP = 'DELETE' THEN
+			PERFORM charon.watch_group_members(TG_ARGV[0], OLD.group_id);
+		ELSE
+			PERFORM charon.watch_group_members(TG_ARGV[0], NEW.group_id);
+		END IF;
+		RETURN NULL;
+	END;
+	$$ LANGUAGE plpgsql`,
+	watchTrigger("watch_flags", model.TableUser, "UPDATE", `
+		OLD.`+model.TableUserColumnIsActive+` IS DISTINCT FROM NEW.`+model.TableUserColumnIsActive+`
+		OR OLD.`+model.TableUserColumnIsSuperuser+` IS DISTINCT FROM NEW.`+model.TableUserColumnIsSuperuser+`
+		OR OLD.`+model.TableUserColumnIsStaff+` IS DISTINCT FROM NEW.`+model.TableUserColumnIsStaff+`
+		OR OLD.`+model.TableUserColumnIsConfirmed+` IS DISTINCT FROM NEW.`+model.TableUserColumnIsConfirmed+`
+		OR OLD.`+model.TableUserColumnDeletedAt+` IS DISTINCT FROM NEW.`+model.TableUserColumnDeletedAt+`
+		OR OLD.`+model.TableUserColumnLockedAt+` IS DISTINCT FROM NEW.`+model.TableUserColumnLockedAt,
+		"charon.watch_user('FLAGS')",
+	),
+	watchTrigger("watch_permissions", model.TableUserPermissions, "INSERT OR UPDATE OR DELETE", "",
+		"charon.watch_user_link('PERMISSIONS')",
+	),
+	watchTrigger("watch_permissions", model.TableUserResourcePermissions, "INSERT OR UPDATE OR DELETE", "",
+		"charon.watch_user_link('PERMISSIONS')",
+	),
+	watchTrigger("watch_groups", model.TableUserGroups, "INSERT OR UPDATE OR DELETE", "",
+		"charon.watch_user_link('GROUPS')",
+	),
+	watchTrigger("watch_permissions", model.TableGroupPermissions, "INSERT OR UPDATE OR DELETE", "",
+		"charon.watch_group_link('PERMISSIONS')",
+	),
+	watchTrigger("watch_groups", model.TableGroup, "UPDATE", `
+		OLD.`+model.TableGroupColumnParentID+` IS DISTINCT FROM NEW.`+model.TableGroupColumnParentID,
+		"charon.watch_group('GROUPS')",
+	),
+	watchTrigger("watch_revoked", model.TableRefreshToken, "UPDATE", `
+		NEW.`+model.TableRefreshTokenColumnRevoked+` AND NOT OLD.`+model.TableRefreshTokenColumnRevoked,
+		"charon.watch_user_link('REFRESH_TOKEN_REVOKED')",
+	),
+	watchTrigger("watch_revoked", model.TableAPIKey, "UPDATE", `
+		NEW.`+model.TableAPIKeyColumnRevoked+` AND NOT OLD.`+model.TableAPIKeyColumnRevoked,
+		"charon.watch_user_link('API_KEY_REVOKED')",
+	),
+	watchTrigger("watch_deleted", model.TableAPIKey, "DELETE", "",
+		"charon.watch_user_link('API_KEY_REVOKED')",
+	),
+}
+
+// watchTrigger (re)creates row level trigger, condition is optional.
+func watchTrigger(name, table, events, condition, function string) string {
+	query := `DROP TRIGGER IF EXISTS ` + name + ` ON ` + table + `;
+	CREATE TRIGGER ` + name + ` AFTER ` + events + ` ON ` + table + ` FOR EACH ROW`
+	if condition != "" {
+		query += ` WHEN (` + condition + `)`
+	}
+	return query + ` EXECUTE PROCEDURE ` + function
+}
+
 func setupDatabase(db *sql.DB) error {
+	if err := execQueries(db, append([]string{model.SQL}, upgradeQueries...)...); err != nil {
+		return err
+	}
+	if err := migrateRefreshTokens(db); err != nil {
+		return err
+	}
 	return execQueries(
 		db,
-		model.SQL,
+		append(hashRefreshTokensQueries, watchQueries...)...,
 	)
 }
 
@@ -70,6 +308,11 @@ func createDummyTestUser(ctx context.Context, usrProvider model.UserProvider, rf
 		return err
 	}
 
-	_, err = rftProvider.Create(ctx, &model.RefreshTokenEntity{Token: "test", UserID: usr.ID})
+	_, err = rftProvider.Create(ctx, &model.RefreshTokenEntity{
+		Prefix: refreshtoken.Prefix("test"),
+		Secret: refreshtoken.Hash("test"),
+		Family: refreshtoken.Prefix("test"),
+		UserID: usr.ID,
+	})
 	return err
 }
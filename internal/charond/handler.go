@@ -2,7 +2,6 @@ package charond
 
 import (
 	"github.com/piotrkowalczuk/charon/internal/session"
-	"github.com/piotrkowalczuk/mnemosyne/mnemosynerpc"
 	"go.uber.org/zap"
 )
 
@@ -12,7 +11,9 @@ type handler struct {
 	opts       DaemonOpts
 	logger     *zap.Logger
 	repository repositories
-	session    mnemosynerpc.SessionManagerClient
+	session    session.Store
+	// actorCache is nil if caching is disabled.
+	actorCache *session.CachingActorProvider
 }
 
 func newHandler(rs *rpcServer) *handler {
@@ -25,8 +26,22 @@ func newHandler(rs *rpcServer) *handler {
 			Client:             rs.session,
 			UserProvider:       rs.repository.user,
 			PermissionProvider: rs.repository.permission,
+			APIKeyProvider:     rs.repository.apiKey,
 		},
 	}
 
+	if rs.actorCache != nil {
+		h.ActorProvider = rs.actorCache
+		h.actorCache = rs.actorCache
+	}
+
 	return h
 }
+
+// invalidateActor evicts cached actors of given user, so that changes to the user take effect at once.
+// Changes made using triggers are propagated through the change broker, others have to be reported this way.
+func (h *handler) invalidateActor(userID int64) {
+	if h.actorCache != nil {
+		h.actorCache.Invalidate(userID)
+	}
+}
@@ -56,10 +56,20 @@ func (sh *actorHandler) Actor(ctx context.Context, r *wrappers.StringValue) (*ch
 		return nil, grpcerr.E(codes.Internal, "actor list of permissions failure", err)
 	}
 
-	permissions := make([]string, 0, len(permissionEntities))
+	deniedEntities, err := sh.repository.permission.FindDeniedByUserID(ctx, id)
+	switch err {
+	case nil, sql.ErrNoRows:
+	default:
+		return nil, grpcerr.E(codes.Internal, "actor list of denied permissions failure", err)
+	}
+
+	permissions := make([]string, 0, len(permissionEntities)+len(deniedEntities))
 	for _, e := range permissionEntities {
 		permissions = append(permissions, e.Permission().String())
 	}
+	for _, e := range deniedEntities {
+		permissions = append(permissions, e.Permission().Deny().String())
+	}
 
 	return &charonrpc.ActorResponse{
 		Id:          int64(ent.ID),
@@ -3,6 +3,7 @@ package charond
 import (
 	"context"
 	"database/sql"
+	"reflect"
 	"testing"
 
 	"github.com/golang/protobuf/ptypes/wrappers"
@@ -27,9 +28,10 @@ func TestActorHandler_Actor_Unit(t *testing.T) {
 	permissionMock := &modelmock.PermissionProvider{}
 
 	cases := map[string]struct {
-		fn  func(*testing.T)
-		tok string
-		err error
+		fn          func(*testing.T)
+		tok         string
+		err         error
+		permissions []string
 	}{
 		"session-through-context": {
 			fn: func(t *testing.T) {
@@ -54,6 +56,19 @@ func TestActorHandler_Actor_Unit(t *testing.T) {
 						},
 					}, nil).
 					Once()
+				permissionMock.On("FindDeniedByUserID", mock.Anything, int64(1)).
+					Return([]*model.PermissionEntity{
+						{
+							Subsystem: charon.GroupCanCreate.Subsystem(),
+							Module:    charon.GroupCanCreate.Module(),
+							Action:    charon.GroupCanCreate.Action(),
+						},
+					}, nil).
+					Once()
+			},
+			permissions: []string{
+				charon.GroupCanRetrieve.String(),
+				charon.GroupCanCreate.Deny().String(),
 			},
 		},
 		"session-through-context-missing-subject-id": {
@@ -86,6 +101,9 @@ func TestActorHandler_Actor_Unit(t *testing.T) {
 				permissionMock.On("FindByUserID", mock.Anything, int64(1)).
 					Return([]*model.PermissionEntity{}, nil).
 					Once()
+				permissionMock.On("FindDeniedByUserID", mock.Anything, int64(1)).
+					Return(nil, sql.ErrNoRows).
+					Once()
 			},
 		},
 		"session-through-value-user-does-not-exists": {
@@ -200,7 +218,7 @@ func TestActorHandler_Actor_Unit(t *testing.T) {
 				},
 			}
 
-			_, err := h.Actor(context.TODO(), &wrappers.StringValue{Value: c.tok})
+			res, err := h.Actor(context.TODO(), &wrappers.StringValue{Value: c.tok})
 			if !mock.AssertExpectationsForObjects(t, sessionMock, userMock, permissionMock) {
 				return
 			}
@@ -213,6 +231,9 @@ func TestActorHandler_Actor_Unit(t *testing.T) {
 			if err != nil {
 				t.Fatalf("unexpected error: %s", err.Error())
 			}
+			if c.permissions != nil && !reflect.DeepEqual(c.permissions, res.Permissions) {
+				t.Errorf("wrong permissions, expected %v but got %v", c.permissions, res.Permissions)
+			}
 		})
 	}
 }
@@ -31,7 +31,7 @@ func (bth *belongsToHandler) BelongsTo(ctx context.Context, req *charonrpc.Belon
 		return nil, err
 	}
 
-	belongs, err := bth.repository.userGroups.Exists(ctx, req.UserId, req.GroupId)
+	belongs, err := bth.repository.userGroups.BelongsTo(ctx, req.UserId, req.GroupId)
 	if err != nil {
 		return nil, grpcerr.E(codes.Internal, "user group fetch failure", err)
 	}
@@ -46,7 +46,7 @@ func (bth *belongsToHandler) firewall(req *charonrpc.BelongsToRequest, act *sess
 	if act.User.IsSuperuser {
 		return nil
 	}
-	if act.Permissions.Contains(charon.UserGroupCanCheckBelongingAsStranger) {
+	if act.Permissions.Match(charon.UserGroupCanCheckBelongingAsStranger) {
 		return nil
 	}
 
@@ -70,7 +70,7 @@ func TestBelongsToHandler_BelongsTo_Unit(t *testing.T) {
 						IsSuperuser: true,
 					}}, nil).
 					Once()
-				userGroupsProviderMock.On("Exists", mock.Anything, int64(2), int64(5)).Return(true, nil).
+				userGroupsProviderMock.On("BelongsTo", mock.Anything, int64(2), int64(5)).Return(true, nil).
 					Once()
 			},
 			req: charonrpc.BelongsToRequest{
@@ -85,7 +85,7 @@ func TestBelongsToHandler_BelongsTo_Unit(t *testing.T) {
 						ID: 1,
 					}}, nil).
 					Once()
-				userGroupsProviderMock.On("Exists", mock.Anything, int64(1), int64(5)).Return(true, nil).
+				userGroupsProviderMock.On("BelongsTo", mock.Anything, int64(1), int64(5)).Return(true, nil).
 					Once()
 			},
 			req: charonrpc.BelongsToRequest{
@@ -105,7 +105,7 @@ func TestBelongsToHandler_BelongsTo_Unit(t *testing.T) {
 						},
 					}, nil).
 					Once()
-				userGroupsProviderMock.On("Exists", mock.Anything, int64(2), int64(5)).Return(true, nil).
+				userGroupsProviderMock.On("BelongsTo", mock.Anything, int64(2), int64(5)).Return(true, nil).
 					Once()
 			},
 			req: charonrpc.BelongsToRequest{
@@ -138,7 +138,7 @@ func TestBelongsToHandler_BelongsTo_Unit(t *testing.T) {
 						},
 					}, nil).
 					Once()
-				userGroupsProviderMock.On("Exists", mock.Anything, int64(1), int64(5)).Return(false, context.DeadlineExceeded).
+				userGroupsProviderMock.On("BelongsTo", mock.Anything, int64(1), int64(5)).Return(false, context.DeadlineExceeded).
 					Once()
 			},
 			req: charonrpc.BelongsToRequest{
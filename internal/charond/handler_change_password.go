@@ -0,0 +1,69 @@
+package charond
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/password"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+
+	"google.golang.org/grpc/codes"
+)
+
+type changePasswordHandler struct {
+	*handler
+	hasher password.Hasher
+}
+
+// ChangePassword replaces password of the current user, it requires the current password to be provided.
+func (cph *changePasswordHandler) ChangePassword(ctx context.Context, req *charonrpc.ChangePasswordRequest) (*wrappers.BoolValue, error) {
+	if req.CurrentPassword == "" {
+		return nil, grpcerr.E(codes.InvalidArgument, "password cannot be changed, empty current password")
+	}
+	if len(req.NewPassword) < 8 {
+		return nil, grpcerr.E(codes.InvalidArgument, "password needs to be at least 8 characters long")
+	}
+
+	act, err := cph.Actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if act.User.ID == 0 {
+		return nil, grpcerr.E(codes.PermissionDenied, "password can be changed only by authenticated user")
+	}
+	if bytes.Equal(act.User.Password, model.ExternalPassword) {
+		return nil, grpcerr.E(codes.FailedPrecondition, "password cannot be changed, it is managed by external authenticator")
+	}
+	if act.User.IsServiceAccount || bytes.Equal(act.User.Password, model.NoPassword) {
+		return nil, grpcerr.E(codes.FailedPrecondition, "password cannot be changed, user does not have any")
+	}
+	if !cph.hasher.Compare(act.User.Password, []byte(req.CurrentPassword)) {
+		return nil, grpcerr.E(codes.PermissionDenied, "password cannot be changed, invalid current password")
+	}
+
+	hashed, err := cph.hasher.Hash([]byte(req.NewPassword))
+	if err != nil {
+		return nil, grpcerr.E(codes.Internal, "password hashing failure", err)
+	}
+
+	entry := &auditEntry{
+		targetKind: model.AuditEventTargetUser,
+		targetID:   act.User.ID,
+		after:      map[string]interface{}{"password": "changed"},
+	}
+	err = cph.audit(ctx, act, entry, func(ctx context.Context) error {
+		if err := cph.repository.user.ChangePassword(ctx, act.User.ID, string(hashed)); err != nil {
+			return grpcerr.E(codes.Internal, "password cannot be changed", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	cph.invalidateActor(act.User.ID)
+
+	return &wrappers.BoolValue{Value: true}, nil
+}
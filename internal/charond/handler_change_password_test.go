@@ -0,0 +1,150 @@
+package charond
+
+import (
+	"context"
+	"testing"
+
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/model/modelmock"
+	"github.com/piotrkowalczuk/charon/internal/password/passwordmock"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	"github.com/piotrkowalczuk/charon/internal/session/sessionmock"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+)
+
+func TestChangePasswordHandler_ChangePassword_Unit(t *testing.T) {
+	actorProviderMock := &sessionmock.ActorProvider{}
+	userProviderMock := &modelmock.UserProvider{}
+	auditEventProviderMock := &modelmock.AuditEventProvider{}
+	hasherMock := &passwordmock.Hasher{}
+
+	h := changePasswordHandler{
+		handler: &handler{
+			ActorProvider: actorProviderMock,
+			repository: repositories{
+				user:       userProviderMock,
+				auditEvent: auditEventProviderMock,
+				transactor: newTransactorMock(),
+			},
+		},
+		hasher: hasherMock,
+	}
+	actor := &session.Actor{User: &model.UserEntity{ID: 1, Password: []byte("old-hash")}}
+
+	cases := map[string]struct {
+		init func(*testing.T)
+		req  charonrpc.ChangePasswordRequest
+		err  error
+	}{
+		"empty-current-password": {
+			init: func(t *testing.T) {},
+			req:  charonrpc.ChangePasswordRequest{NewPassword: "newpassword"},
+			err:  grpcerr.E(codes.InvalidArgument),
+		},
+		"new-password-too-short": {
+			init: func(t *testing.T) {},
+			req:  charonrpc.ChangePasswordRequest{CurrentPassword: "oldpassword", NewPassword: "short"},
+			err:  grpcerr.E(codes.InvalidArgument),
+		},
+		"local": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{}, IsLocal: true}, nil).
+					Once()
+			},
+			req: charonrpc.ChangePasswordRequest{CurrentPassword: "oldpassword", NewPassword: "newpassword"},
+			err: grpcerr.E(codes.PermissionDenied),
+		},
+		"external-password": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1, Password: model.ExternalPassword}}, nil).
+					Once()
+			},
+			req: charonrpc.ChangePasswordRequest{CurrentPassword: "oldpassword", NewPassword: "newpassword"},
+			err: grpcerr.E(codes.FailedPrecondition),
+		},
+		"no-password": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1, Password: model.NoPassword}}, nil).
+					Once()
+			},
+			req: charonrpc.ChangePasswordRequest{CurrentPassword: "oldpassword", NewPassword: "newpassword"},
+			err: grpcerr.E(codes.FailedPrecondition),
+		},
+		"invalid-current-password": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(actor, nil).
+					Once()
+				hasherMock.On("Compare", []byte("old-hash"), []byte("oldpassword")).
+					Return(false).
+					Once()
+			},
+			req: charonrpc.ChangePasswordRequest{CurrentPassword: "oldpassword", NewPassword: "newpassword"},
+			err: grpcerr.E(codes.PermissionDenied),
+		},
+		"query-timeout": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(actor, nil).
+					Once()
+				hasherMock.On("Compare", []byte("old-hash"), []byte("oldpassword")).
+					Return(true).
+					Once()
+				hasherMock.On("Hash", []byte("newpassword")).
+					Return([]byte("new-hash"), nil).
+					Once()
+				userProviderMock.On("ChangePassword", mock.Anything, int64(1), "new-hash").
+					Return(context.DeadlineExceeded).
+					Once()
+			},
+			req: charonrpc.ChangePasswordRequest{CurrentPassword: "oldpassword", NewPassword: "newpassword"},
+			err: grpcerr.E(codes.DeadlineExceeded),
+		},
+		"success": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(actor, nil).
+					Once()
+				hasherMock.On("Compare", []byte("old-hash"), []byte("oldpassword")).
+					Return(true).
+					Once()
+				hasherMock.On("Hash", []byte("newpassword")).
+					Return([]byte("new-hash"), nil).
+					Once()
+				userProviderMock.On("ChangePassword", mock.Anything, int64(1), "new-hash").
+					Return(nil).
+					Once()
+			},
+			req: charonrpc.ChangePasswordRequest{CurrentPassword: "oldpassword", NewPassword: "newpassword"},
+		},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			defer recoverTest(t)
+
+			actorProviderMock.ExpectedCalls = nil
+			userProviderMock.ExpectedCalls = nil
+			auditEventProviderMock.ExpectedCalls = nil
+			hasherMock.ExpectedCalls = nil
+
+			c.init(t)
+			audit := auditEventProviderMock.On("Insert", mock.Anything, auditEventMatcher(model.AuditEventTargetUser, 1)).
+				Return(&model.AuditEventEntity{}, nil)
+			if c.err != nil {
+				audit.Maybe()
+			}
+
+			_, err := h.ChangePassword(context.Background(), &c.req)
+			assertError(t, c.err, err)
+
+			mock.AssertExpectationsForObjects(t, actorProviderMock, userProviderMock, auditEventProviderMock, hasherMock)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package charond
+
+import (
+	"context"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/totp"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+
+	"google.golang.org/grpc/codes"
+)
+
+// recoveryCodesNumber is how many recovery codes are generated at once.
+const recoveryCodesNumber = 10
+
+type confirmTOTPHandler struct {
+	*handler
+}
+
+// ConfirmTOTP finishes two-factor authentication enrolment of the current user.
+// The first valid code proves that the secret was stored by the authenticator application.
+func (cth *confirmTOTPHandler) ConfirmTOTP(ctx context.Context, req *charonrpc.ConfirmTOTPRequest) (*charonrpc.ConfirmTOTPResponse, error) {
+	if req.Code == "" {
+		return nil, grpcerr.E(codes.InvalidArgument, "two-factor authentication cannot be confirmed, empty code")
+	}
+
+	act, err := cth.Actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if act.User.ID == 0 {
+		return nil, grpcerr.E(codes.PermissionDenied, "two-factor authentication can be enabled only by authenticated user")
+	}
+	if act.User.TwoFactorConfirmedAt.Valid {
+		return nil, grpcerr.E(codes.FailedPrecondition, "two-factor authentication is already enabled")
+	}
+	if len(act.User.TwoFactorSecret) == 0 {
+		return nil, grpcerr.E(codes.FailedPrecondition, "two-factor authentication cannot be confirmed, secret was not generated")
+	}
+
+	step, ok := totp.Validate(act.User.TwoFactorSecret, req.Code, time.Now())
+	if !ok {
+		return nil, grpcerr.E(codes.InvalidArgument, "two-factor authentication cannot be confirmed, invalid code")
+	}
+
+	recoveryCodes, hashes, err := totp.GenerateRecoveryCodes(recoveryCodesNumber)
+	if err != nil {
+		return nil, grpcerr.E(codes.Internal, "recovery codes generation failure", err)
+	}
+	var (
+		confirmed = *act.User
+		entry     = &auditEntry{
+			targetKind: model.AuditEventTargetUser,
+			targetID:   act.User.ID,
+			before:     act.User,
+		}
+	)
+	err = cth.audit(ctx, act, entry, func(ctx context.Context) error {
+		aff, err := cth.repository.user.ConfirmTwoFactor(ctx, act.User.ID, step, hashes)
+		if err != nil {
+			return grpcerr.E(codes.Internal, "two-factor authentication confirmation failure", err)
+		}
+		if aff == 0 {
+			return grpcerr.E(codes.FailedPrecondition, "two-factor authentication is already enabled")
+		}
+		confirmed.TwoFactorConfirmedAt = pq.NullTime{Time: time.Now(), Valid: true}
+		entry.after = &confirmed
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	cth.invalidateActor(act.User.ID)
+
+	return &charonrpc.ConfirmTOTPResponse{RecoveryCodes: recoveryCodes}, nil
+}
@@ -0,0 +1,139 @@
+package charond
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/model/modelmock"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	"github.com/piotrkowalczuk/charon/internal/session/sessionmock"
+	"github.com/piotrkowalczuk/charon/internal/totp"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+)
+
+func TestConfirmTOTPHandler_ConfirmTOTP_Unit(t *testing.T) {
+	actorProviderMock := &sessionmock.ActorProvider{}
+	userProviderMock := &modelmock.UserProvider{}
+	auditEventProviderMock := &modelmock.AuditEventProvider{}
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	code := totp.Code(secret, totp.Step(time.Now()))
+
+	h := confirmTOTPHandler{
+		handler: &handler{
+			ActorProvider: actorProviderMock,
+			repository: repositories{
+				user:       userProviderMock,
+				auditEvent: auditEventProviderMock,
+				transactor: newTransactorMock(),
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		init func(*testing.T)
+		req  charonrpc.ConfirmTOTPRequest
+		err  error
+	}{
+		"empty-code": {
+			init: func(t *testing.T) {},
+			err:  grpcerr.E(codes.InvalidArgument),
+		},
+		"local": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{}, IsLocal: true}, nil).
+					Once()
+			},
+			req: charonrpc.ConfirmTOTPRequest{Code: code},
+			err: grpcerr.E(codes.PermissionDenied),
+		},
+		"already-enabled": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{
+						ID:                   1,
+						TwoFactorSecret:      secret,
+						TwoFactorConfirmedAt: pq.NullTime{Time: time.Now(), Valid: true},
+					}}, nil).
+					Once()
+			},
+			req: charonrpc.ConfirmTOTPRequest{Code: code},
+			err: grpcerr.E(codes.FailedPrecondition),
+		},
+		"secret-not-generated": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1}}, nil).
+					Once()
+			},
+			req: charonrpc.ConfirmTOTPRequest{Code: code},
+			err: grpcerr.E(codes.FailedPrecondition),
+		},
+		"invalid-code": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1, TwoFactorSecret: secret}}, nil).
+					Once()
+			},
+			req: charonrpc.ConfirmTOTPRequest{Code: "abcdef"},
+			err: grpcerr.E(codes.InvalidArgument),
+		},
+		"query-timeout": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1, TwoFactorSecret: secret}}, nil).
+					Once()
+				userProviderMock.On("ConfirmTwoFactor", mock.Anything, int64(1), mock.Anything, mock.Anything).
+					Return(int64(0), context.DeadlineExceeded).
+					Once()
+			},
+			req: charonrpc.ConfirmTOTPRequest{Code: code},
+			err: grpcerr.E(codes.DeadlineExceeded),
+		},
+		"success": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1, TwoFactorSecret: secret}}, nil).
+					Once()
+				userProviderMock.On("ConfirmTwoFactor", mock.Anything, int64(1), mock.Anything, mock.Anything).
+					Return(int64(1), nil).
+					Once()
+			},
+			req: charonrpc.ConfirmTOTPRequest{Code: code},
+		},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			defer recoverTest(t)
+
+			actorProviderMock.ExpectedCalls = nil
+			userProviderMock.ExpectedCalls = nil
+			auditEventProviderMock.ExpectedCalls = nil
+
+			c.init(t)
+			audit := auditEventProviderMock.On("Insert", mock.Anything, auditEventMatcher(model.AuditEventTargetUser, 1)).
+				Return(&model.AuditEventEntity{}, nil)
+			if c.err != nil {
+				audit.Maybe()
+			}
+
+			res, err := h.ConfirmTOTP(context.Background(), &c.req)
+			assertError(t, c.err, err)
+			if c.err == nil && len(res.RecoveryCodes) != recoveryCodesNumber {
+				t.Errorf("wrong number of recovery codes, expected %d but got %d", recoveryCodesNumber, len(res.RecoveryCodes))
+			}
+
+			mock.AssertExpectationsForObjects(t, actorProviderMock, userProviderMock, auditEventProviderMock)
+		})
+	}
+}
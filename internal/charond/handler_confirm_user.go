@@ -0,0 +1,47 @@
+package charond
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/password"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+
+	"google.golang.org/grpc/codes"
+)
+
+type confirmUserHandler struct {
+	*handler
+}
+
+// Confirm confirms and activates user that given confirmation token was issued for.
+func (cuh *confirmUserHandler) Confirm(ctx context.Context, req *charonrpc.ConfirmUserRequest) (*wrappers.BoolValue, error) {
+	if req.Token == "" {
+		return nil, grpcerr.E(codes.InvalidArgument, "user cannot be confirmed, empty token")
+	}
+
+	entry := &auditEntry{
+		targetKind: model.AuditEventTargetUser,
+		after:      map[string]interface{}{"is_confirmed": true, "is_active": true},
+	}
+	// Whoever knows the token acts on behalf of the user, so there is no actor to record.
+	err := cuh.audit(ctx, nil, entry, func(ctx context.Context) error {
+		usr, err := cuh.repository.user.RegistrationConfirmation(ctx, password.HashToken(req.Token))
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return grpcerr.E(codes.Unauthenticated, "confirmation token is invalid or expired")
+			}
+			return grpcerr.E(codes.Internal, "user cannot be confirmed", err)
+		}
+		entry.targetID = usr.ID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &wrappers.BoolValue{Value: true}, nil
+}
@@ -0,0 +1,88 @@
+package charond
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/model/modelmock"
+	"github.com/piotrkowalczuk/charon/internal/password"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+)
+
+func TestConfirmUserHandler_Confirm_Unit(t *testing.T) {
+	userProviderMock := &modelmock.UserProvider{}
+	auditEventProviderMock := &modelmock.AuditEventProvider{}
+
+	h := confirmUserHandler{
+		handler: &handler{
+			repository: repositories{
+				user:       userProviderMock,
+				auditEvent: auditEventProviderMock,
+				transactor: newTransactorMock(),
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		init func(*testing.T)
+		req  charonrpc.ConfirmUserRequest
+		err  error
+	}{
+		"empty-token": {
+			init: func(t *testing.T) {},
+			err:  grpcerr.E(codes.InvalidArgument),
+		},
+		"invalid-token": {
+			init: func(t *testing.T) {
+				userProviderMock.On("RegistrationConfirmation", mock.Anything, password.HashToken("token")).
+					Return(nil, sql.ErrNoRows).
+					Once()
+			},
+			req: charonrpc.ConfirmUserRequest{Token: "token"},
+			err: grpcerr.E(codes.Unauthenticated),
+		},
+		"query-timeout": {
+			init: func(t *testing.T) {
+				userProviderMock.On("RegistrationConfirmation", mock.Anything, password.HashToken("token")).
+					Return(nil, context.DeadlineExceeded).
+					Once()
+			},
+			req: charonrpc.ConfirmUserRequest{Token: "token"},
+			err: grpcerr.E(codes.DeadlineExceeded),
+		},
+		"success": {
+			init: func(t *testing.T) {
+				userProviderMock.On("RegistrationConfirmation", mock.Anything, password.HashToken("token")).
+					Return(&model.UserEntity{ID: 1, IsConfirmed: true, IsActive: true}, nil).
+					Once()
+			},
+			req: charonrpc.ConfirmUserRequest{Token: "token"},
+		},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			defer recoverTest(t)
+
+			userProviderMock.ExpectedCalls = nil
+			auditEventProviderMock.ExpectedCalls = nil
+
+			c.init(t)
+			audit := auditEventProviderMock.On("Insert", mock.Anything, auditEventMatcher(model.AuditEventTargetUser, 1)).
+				Return(&model.AuditEventEntity{}, nil)
+			if c.err != nil {
+				audit.Maybe()
+			}
+
+			_, err := h.Confirm(context.Background(), &c.req)
+			assertError(t, c.err, err)
+
+			mock.AssertExpectationsForObjects(t, userProviderMock, auditEventProviderMock)
+		})
+	}
+}
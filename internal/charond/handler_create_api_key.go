@@ -0,0 +1,141 @@
+package charond
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/lib/pq"
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/charon/internal/apikey"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/mapping"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/piotrkowalczuk/ntypes"
+	"google.golang.org/grpc/codes"
+)
+
+type createAPIKeyHandler struct {
+	*handler
+}
+
+// Create issues a key for the actor or, if user id is given, for another user (e.g. a service account).
+// Key is returned in plain text only once.
+func (cakh *createAPIKeyHandler) Create(ctx context.Context, req *charonrpc.CreateAPIKeyRequest) (*charonrpc.CreateAPIKeyResponse, error) {
+	if len(req.Permissions) == 0 {
+		return nil, grpcerr.E(codes.InvalidArgument, "api key has to be restricted to at least one permission")
+	}
+	permissions := charon.NewPermissions(req.Permissions...)
+	for _, p := range permissions {
+		subsystem, module, action := p.Split()
+		if p.IsDenial() || subsystem == "" || module == "" || action == "" {
+			return nil, grpcerr.E(codes.InvalidArgument, "api key cannot be restricted to malformed permission: "+p.String())
+		}
+	}
+	var (
+		expireAt time.Time
+		err      error
+	)
+	if req.ExpireAt != nil {
+		if expireAt, err = ptypes.Timestamp(req.ExpireAt); err != nil {
+			return nil, grpcerr.E(codes.InvalidArgument, "invalid format of expire at", err)
+		}
+		if !expireAt.After(time.Now()) {
+			return nil, grpcerr.E(codes.InvalidArgument, "api key cannot expire in the past")
+		}
+	}
+
+	act, err := cakh.Actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID := req.UserId.Int64Or(act.User.ID)
+	if err = cakh.firewall(act, userID, permissions); err != nil {
+		return nil, err
+	}
+	usr, err := cakh.repository.user.FindOneByID(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, grpcerr.E(codes.NotFound, "such user does not exist")
+		}
+		return nil, grpcerr.E(codes.Internal, "user fetch failure", err)
+	}
+	if err = impersonationFirewall(act, usr, "api key"); err != nil {
+		return nil, err
+	}
+
+	key, prefix, hash, err := apikey.Random()
+	if err != nil {
+		return nil, grpcerr.E(codes.Internal, "api key generation failure", err)
+	}
+
+	var (
+		ent   *model.APIKeyEntity
+		entry = &auditEntry{targetKind: model.AuditEventTargetAPIKey}
+	)
+	err = cakh.audit(ctx, act, entry, func(ctx context.Context) error {
+		ent, err = cakh.repository.apiKey.Insert(ctx, &model.APIKeyEntity{
+			Prefix:      prefix,
+			Secret:      hash,
+			UserID:      userID,
+			Permissions: model.APIKeyPermissions(permissions),
+			ExpireAt: pq.NullTime{
+				Time:  expireAt.UTC(),
+				Valid: !expireAt.IsZero(),
+			},
+			Notes:     allocNilString(req.Notes),
+			CreatedBy: ntypes.Int64{Int64: act.User.ID, Valid: true},
+		})
+		if err != nil {
+			switch model.ErrorConstraint(err) {
+			case model.TableAPIKeyConstraintPrefixUnique:
+				return grpcerr.E(codes.AlreadyExists, "api key with such prefix already exists")
+			case model.TableAPIKeyConstraintUserIDForeignKey:
+				return grpcerr.E(codes.NotFound, "such user does not exist")
+			default:
+				return grpcerr.E(codes.Internal, "api key persistence failure", err)
+			}
+		}
+		entry.targetID = ent.ID
+		entry.after = ent
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := mapping.ReverseAPIKey(ent)
+	if err != nil {
+		return nil, grpcerr.E(codes.Internal, "api key entity mapping failure", err)
+	}
+	return &charonrpc.CreateAPIKeyResponse{
+		ApiKey: msg,
+		Key:    key,
+	}, nil
+}
+
+// firewall makes sure that the actor cannot issue a key more powerful than itself.
+// Key is never issued within a session started with another key, it could be used to outlive the original one.
+func (cakh *createAPIKeyHandler) firewall(act *session.Actor, userID int64, permissions charon.Permissions) error {
+	if act.ByAPIKey() {
+		return grpcerr.E(codes.PermissionDenied, "api key cannot be created within a session started with an api key")
+	}
+	if act.User.IsSuperuser {
+		return nil
+	}
+	switch {
+	case act.User.ID == userID && act.Permissions.Match(charon.APIKeyCanCreateAsOwner):
+	case act.User.ID != userID && act.Permissions.Match(charon.APIKeyCanCreateAsStranger):
+	default:
+		return grpcerr.E(codes.PermissionDenied, "api key cannot be created, missing permission")
+	}
+	for _, p := range permissions {
+		if !act.Permissions.Match(p) {
+			return grpcerr.E(codes.PermissionDenied, "api key cannot be granted permission the actor does not have: "+p.String())
+		}
+	}
+	return nil
+}
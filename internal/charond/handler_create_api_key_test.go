@@ -0,0 +1,230 @@
+package charond
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/charon/internal/apikey"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/model/modelmock"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	"github.com/piotrkowalczuk/charon/internal/session/sessionmock"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/piotrkowalczuk/mnemosyne/mnemosynerpc"
+	"github.com/piotrkowalczuk/ntypes"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+func TestCreateAPIKeyHandler_Create_Unit(t *testing.T) {
+	actorProviderMock := &sessionmock.ActorProvider{}
+	userProviderMock := &modelmock.UserProvider{}
+	apiKeyProviderMock := &modelmock.APIKeyProvider{}
+	auditEventProviderMock := &modelmock.AuditEventProvider{}
+
+	h := createAPIKeyHandler{
+		handler: &handler{
+			logger:        zap.L(),
+			ActorProvider: actorProviderMock,
+			repository: repositories{
+				user:       userProviderMock,
+				apiKey:     apiKeyProviderMock,
+				auditEvent: auditEventProviderMock,
+				transactor: newTransactorMock(),
+			},
+		},
+	}
+
+	owner := func() {
+		actorProviderMock.On("Actor", mock.Anything).
+			Return(&session.Actor{
+				User:        &model.UserEntity{ID: 1},
+				Permissions: charon.Permissions{charon.APIKeyCanCreateAsOwner, charon.UserCanRetrieveAsStranger},
+			}, nil).
+			Once()
+	}
+	stranger := func() {
+		actorProviderMock.On("Actor", mock.Anything).
+			Return(&session.Actor{
+				User:        &model.UserEntity{ID: 1},
+				Permissions: charon.Permissions{charon.APIKeyCanCreateAsStranger, charon.UserCanRetrieveAsStranger},
+			}, nil).
+			Once()
+	}
+	inserted := func(userID int64) {
+		userProviderMock.On("FindOneByID", mock.Anything, userID).
+			Return(&model.UserEntity{ID: userID}, nil).
+			Once()
+		apiKeyProviderMock.On("Insert", mock.Anything, mock.MatchedBy(func(ent *model.APIKeyEntity) bool {
+			return ent.UserID == userID && ent.Prefix != "" && len(ent.Secret) > 0 && ent.CreatedBy.Int64Or(0) == 1
+		})).
+			Return(func(_ context.Context, ent *model.APIKeyEntity) *model.APIKeyEntity {
+				ent.ID = 10
+				return ent
+			}, nil).
+			Once()
+	}
+
+	cases := map[string]struct {
+		req  charonrpc.CreateAPIKeyRequest
+		init func(*testing.T)
+		err  error
+	}{
+		"missing-permissions": {
+			init: func(t *testing.T) {},
+			req:  charonrpc.CreateAPIKeyRequest{},
+			err:  grpcerr.E(codes.InvalidArgument),
+		},
+		"denial": {
+			init: func(t *testing.T) {},
+			req:  charonrpc.CreateAPIKeyRequest{Permissions: []string{charon.UserCanRetrieveAsStranger.Deny().String()}},
+			err:  grpcerr.E(codes.InvalidArgument),
+		},
+		"malformed-permission": {
+			init: func(t *testing.T) {},
+			req:  charonrpc.CreateAPIKeyRequest{Permissions: []string{"charon:user"}},
+			err:  grpcerr.E(codes.InvalidArgument),
+		},
+		"as-owner": {
+			init: func(t *testing.T) {
+				owner()
+				inserted(1)
+			},
+			req: charonrpc.CreateAPIKeyRequest{Permissions: []string{charon.UserCanRetrieveAsStranger.String()}},
+		},
+		"as-owner-escalation": {
+			init: func(t *testing.T) {
+				owner()
+			},
+			req: charonrpc.CreateAPIKeyRequest{Permissions: []string{charon.UserCanDeleteAsStranger.String()}},
+			err: grpcerr.E(codes.PermissionDenied),
+		},
+		"as-owner-for-stranger": {
+			init: func(t *testing.T) {
+				owner()
+			},
+			req: charonrpc.CreateAPIKeyRequest{
+				UserId:      &ntypes.Int64{Int64: 2, Valid: true},
+				Permissions: []string{charon.UserCanRetrieveAsStranger.String()},
+			},
+			err: grpcerr.E(codes.PermissionDenied),
+		},
+		"as-superuser-for-stranger": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1, IsSuperuser: true}}, nil).
+					Once()
+				inserted(2)
+			},
+			req: charonrpc.CreateAPIKeyRequest{
+				UserId:      &ntypes.Int64{Int64: 2, Valid: true},
+				Permissions: []string{charon.UserCanDeleteAsStranger.String()},
+			},
+		},
+		"as-stranger": {
+			init: func(t *testing.T) {
+				stranger()
+				inserted(2)
+			},
+			req: charonrpc.CreateAPIKeyRequest{
+				UserId:      &ntypes.Int64{Int64: 2, Valid: true},
+				Permissions: []string{charon.UserCanRetrieveAsStranger.String()},
+			},
+		},
+		"as-stranger-for-superuser": {
+			init: func(t *testing.T) {
+				stranger()
+				userProviderMock.On("FindOneByID", mock.Anything, int64(2)).
+					Return(&model.UserEntity{ID: 2, IsSuperuser: true}, nil).
+					Once()
+			},
+			req: charonrpc.CreateAPIKeyRequest{
+				UserId:      &ntypes.Int64{Int64: 2, Valid: true},
+				Permissions: []string{charon.UserCanRetrieveAsStranger.String()},
+			},
+			err: grpcerr.E(codes.PermissionDenied),
+		},
+		"as-stranger-for-staff": {
+			init: func(t *testing.T) {
+				stranger()
+				userProviderMock.On("FindOneByID", mock.Anything, int64(2)).
+					Return(&model.UserEntity{ID: 2, IsStaff: true}, nil).
+					Once()
+			},
+			req: charonrpc.CreateAPIKeyRequest{
+				UserId:      &ntypes.Int64{Int64: 2, Valid: true},
+				Permissions: []string{charon.UserCanRetrieveAsStranger.String()},
+			},
+			err: grpcerr.E(codes.PermissionDenied),
+		},
+		"within-api-key-session": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{
+						User:        &model.UserEntity{ID: 1},
+						Session:     &mnemosynerpc.Session{Bag: map[string]string{session.BagAPIKey: "prefix"}},
+						Permissions: charon.Permissions{charon.APIKeyCanCreateAsOwner, charon.UserCanRetrieveAsStranger},
+					}, nil).
+					Once()
+			},
+			req: charonrpc.CreateAPIKeyRequest{Permissions: []string{charon.UserCanRetrieveAsStranger.String()}},
+			err: grpcerr.E(codes.PermissionDenied),
+		},
+		"user-does-not-exists": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1, IsSuperuser: true}}, nil).
+					Once()
+				userProviderMock.On("FindOneByID", mock.Anything, int64(2)).
+					Return(nil, sql.ErrNoRows).
+					Once()
+			},
+			req: charonrpc.CreateAPIKeyRequest{
+				UserId:      &ntypes.Int64{Int64: 2, Valid: true},
+				Permissions: []string{charon.UserCanDeleteAsStranger.String()},
+			},
+			err: grpcerr.E(codes.NotFound),
+		},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			actorProviderMock.ExpectedCalls = []*mock.Call{}
+			userProviderMock.ExpectedCalls = []*mock.Call{}
+			apiKeyProviderMock.ExpectedCalls = []*mock.Call{}
+			auditEventProviderMock.ExpectedCalls = []*mock.Call{}
+
+			c.init(t)
+			audit := auditEventProviderMock.On("Insert", mock.Anything, auditEventMatcher(model.AuditEventTargetAPIKey, 10)).
+				Return(&model.AuditEventEntity{}, nil)
+			if c.err != nil {
+				audit.Maybe()
+			}
+
+			res, err := h.Create(context.Background(), &c.req)
+			if c.err != nil {
+				if !grpcerr.Match(c.err, err) {
+					t.Fatalf("errors do not match, got '%v'", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			prefix, _, ok := apikey.Split(res.Key)
+			if !ok || prefix != res.ApiKey.Prefix {
+				t.Errorf("key does not match its prefix: %s", res.Key)
+			}
+			if strings.Join(res.ApiKey.Permissions, ",") != strings.Join(c.req.Permissions, ",") {
+				t.Errorf("wrong permissions: %v", res.ApiKey.Permissions)
+			}
+
+			mock.AssertExpectationsForObjects(t, actorProviderMock, userProviderMock, apiKeyProviderMock, auditEventProviderMock)
+		})
+	}
+}
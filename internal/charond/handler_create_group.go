@@ -28,14 +28,26 @@ func (cgh *createGroupHandler) Create(ctx context.Context, req *charonrpc.Create
 		return nil, err
 	}
 
-	ent, err := cgh.repository.group.Create(ctx, act.User.ID, req.Name, req.Description)
-	if err != nil {
-		switch model.ErrorConstraint(err) {
-		case model.TableGroupConstraintNameUnique:
-			return nil, grpcerr.E(codes.AlreadyExists, "group with given name already exists")
-		default:
-			return nil, grpcerr.E(codes.Internal, "group fetch failure", err)
+	var (
+		ent   *model.GroupEntity
+		entry = &auditEntry{targetKind: model.AuditEventTargetGroup}
+	)
+	err = cgh.audit(ctx, act, entry, func(ctx context.Context) error {
+		ent, err = cgh.repository.group.Create(ctx, act.User.ID, req.Name, req.Description)
+		if err != nil {
+			switch model.ErrorConstraint(err) {
+			case model.TableGroupConstraintNameUnique:
+				return grpcerr.E(codes.AlreadyExists, "group with given name already exists")
+			default:
+				return grpcerr.E(codes.Internal, "group fetch failure", err)
+			}
 		}
+		entry.targetID = ent.ID
+		entry.after = ent
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return cgh.response(ent)
@@ -45,7 +57,7 @@ func (cgh *createGroupHandler) firewall(req *charonrpc.CreateGroupRequest, act *
 	if act.User.IsSuperuser {
 		return nil
 	}
-	if act.Permissions.Contains(charon.GroupCanCreate) {
+	if act.Permissions.Match(charon.GroupCanCreate) {
 		return nil
 	}
 
@@ -96,12 +96,15 @@ func TestCreateGroupHandler_Create_E2E(t *testing.T) {
 func TestCreateGroupHandler_Create_Unit(t *testing.T) {
 	actorProviderMock := &sessionmock.ActorProvider{}
 	groupProviderMock := &modelmock.GroupProvider{}
+	auditEventProviderMock := &modelmock.AuditEventProvider{}
 
 	h := createGroupHandler{
 		handler: &handler{
 			ActorProvider: actorProviderMock,
 			repository: repositories{
-				group: groupProviderMock,
+				group:      groupProviderMock,
+				auditEvent: auditEventProviderMock,
+				transactor: newTransactorMock(),
 			},
 		},
 	}
@@ -246,8 +249,14 @@ func TestCreateGroupHandler_Create_Unit(t *testing.T) {
 			// reset mocks between cases
 			actorProviderMock.ExpectedCalls = []*mock.Call{}
 			groupProviderMock.ExpectedCalls = []*mock.Call{}
+			auditEventProviderMock.ExpectedCalls = []*mock.Call{}
 
 			c.init(t)
+			audit := auditEventProviderMock.On("Insert", mock.Anything, auditEventMatcher(model.AuditEventTargetGroup, 0)).
+				Return(&model.AuditEventEntity{}, nil)
+			if c.err != nil {
+				audit.Maybe()
+			}
 
 			_, err := h.Create(context.Background(), &c.req)
 			if c.err != nil {
@@ -258,7 +267,7 @@ func TestCreateGroupHandler_Create_Unit(t *testing.T) {
 				t.Fatal(err)
 			}
 
-			mock.AssertExpectationsForObjects(t, actorProviderMock, groupProviderMock)
+			mock.AssertExpectationsForObjects(t, actorProviderMock, groupProviderMock, auditEventProviderMock)
 		})
 
 	}
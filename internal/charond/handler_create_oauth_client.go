@@ -0,0 +1,121 @@
+package charond
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/mapping"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/piotrkowalczuk/ntypes"
+	"google.golang.org/grpc/codes"
+)
+
+type createOAuthClientHandler struct {
+	*handler
+}
+
+func (coch *createOAuthClientHandler) Create(ctx context.Context, req *charonrpc.CreateOAuthClientRequest) (*charonrpc.CreateOAuthClientResponse, error) {
+	if req.Name == "" {
+		return nil, grpcerr.E(codes.InvalidArgument, "oauth client name is required")
+	}
+	if len(req.RedirectUris) == 0 {
+		return nil, grpcerr.E(codes.InvalidArgument, "at least one redirect uri is required")
+	}
+	for _, uri := range req.RedirectUris {
+		if err := validateRedirectURI(uri); err != nil {
+			return nil, grpcerr.E(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	act, err := coch.Actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = coch.firewall(req, act); err != nil {
+		return nil, err
+	}
+
+	clientID, err := oauthRandom(12)
+	if err != nil {
+		return nil, grpcerr.E(codes.Internal, "client id generation failure", err)
+	}
+	var secret string
+	if req.Confidential {
+		if secret, err = oauthRandom(32); err != nil {
+			return nil, grpcerr.E(codes.Internal, "client secret generation failure", err)
+		}
+	}
+
+	var (
+		ent   *model.OauthClientEntity
+		entry = &auditEntry{targetKind: model.AuditEventTargetOAuthClient}
+	)
+	err = coch.audit(ctx, act, entry, func(ctx context.Context) error {
+		ent, err = coch.repository.oauthClient.Insert(ctx, &model.OauthClientEntity{
+			ClientID:     clientID,
+			Secret:       oauthHash(secret),
+			Name:         req.Name,
+			RedirectUris: strings.Join(req.RedirectUris, " "),
+			CreatedBy:    ntypes.Int64{Int64: act.User.ID, Valid: true},
+		})
+		if err != nil {
+			switch model.ErrorConstraint(err) {
+			case model.TableOauthClientConstraintClientIDUnique:
+				return grpcerr.E(codes.AlreadyExists, "such oauth client already exists")
+			default:
+				return grpcerr.E(codes.Internal, "oauth client persistence failure", err)
+			}
+		}
+		entry.targetID = ent.ID
+		entry.after = ent
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := mapping.ReverseOAuthClient(ent)
+	if err != nil {
+		return nil, grpcerr.E(codes.Internal, "oauth client entity mapping failure", err)
+	}
+	return &charonrpc.CreateOAuthClientResponse{
+		Client: msg,
+		Secret: secret,
+	}, nil
+}
+
+func (coch *createOAuthClientHandler) firewall(req *charonrpc.CreateOAuthClientRequest, act *session.Actor) error {
+	if act.User.IsSuperuser {
+		return nil
+	}
+	if act.Permissions.Match(charon.OAuthClientCanCreate) {
+		return nil
+	}
+
+	return grpcerr.E(codes.PermissionDenied, "oauth client cannot be created, missing permission")
+}
+
+// validateRedirectURI accepts absolute URIs without a fragment, as OAuth2 requires.
+// Plain http is allowed only for loopback addresses, used by native applications.
+func validateRedirectURI(uri string) error {
+	u, err := url.Parse(uri)
+	if err != nil || !u.IsAbs() || u.Host == "" || strings.ContainsAny(uri, " \t\n") {
+		return grpcerr.E("redirect uri has to be an absolute url: " + uri)
+	}
+	if u.Fragment != "" {
+		return grpcerr.E("redirect uri cannot contain a fragment: " + uri)
+	}
+	if u.Scheme == "http" {
+		switch u.Hostname() {
+		case "localhost", "127.0.0.1", "::1":
+		default:
+			return grpcerr.E("redirect uri has to use https: " + uri)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+package charond
+
+import (
+	"context"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/model/modelmock"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	"github.com/piotrkowalczuk/charon/internal/session/sessionmock"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+func TestCreateOAuthClientHandler_Create_E2E(t *testing.T) {
+	suite := &endToEndSuite{}
+	suite.setup(t)
+	defer suite.teardown(t)
+
+	ctx := testRPCServerLogin(t, suite)
+
+	res, err := suite.charon.oauthClient.Create(timeout(ctx), &charonrpc.CreateOAuthClientRequest{
+		Name:         "app",
+		RedirectUris: []string{"https://example.com/cb"},
+		Confidential: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if res.Secret == "" || res.Client.ClientId == "" {
+		t.Fatalf("client credentials expected, got: %v", res)
+	}
+
+	got, err := suite.charon.oauthClient.Get(timeout(ctx), &charonrpc.GetOAuthClientRequest{Id: res.Client.Id})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if got.Client.ClientId != res.Client.ClientId || !got.Client.Confidential {
+		t.Errorf("wrong client: %v", got.Client)
+	}
+
+	del, err := suite.charon.oauthClient.Delete(timeout(ctx), &charonrpc.DeleteOAuthClientRequest{Id: res.Client.Id})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if !del.Value {
+		t.Error("client expected to be removed")
+	}
+}
+
+func TestCreateOAuthClientHandler_Create_Unit(t *testing.T) {
+	actorProviderMock := &sessionmock.ActorProvider{}
+	oauthClientProviderMock := &modelmock.OauthClientProvider{}
+	auditEventProviderMock := &modelmock.AuditEventProvider{}
+
+	h := createOAuthClientHandler{
+		handler: &handler{
+			logger:        zap.L(),
+			ActorProvider: actorProviderMock,
+			repository: repositories{
+				oauthClient: oauthClientProviderMock,
+				auditEvent:  auditEventProviderMock,
+				transactor:  newTransactorMock(),
+			},
+		},
+	}
+	inserted := func(_ context.Context, ent *model.OauthClientEntity) *model.OauthClientEntity {
+		return ent
+	}
+
+	cases := map[string]struct {
+		req  charonrpc.CreateOAuthClientRequest
+		init func(*testing.T)
+		// confidential is checked only if request succeeds.
+		confidential bool
+		err          error
+	}{
+		"missing-name": {
+			init: func(t *testing.T) {},
+			req:  charonrpc.CreateOAuthClientRequest{RedirectUris: []string{"https://example.com/cb"}},
+			err:  grpcerr.E(codes.InvalidArgument),
+		},
+		"missing-redirect-uris": {
+			init: func(t *testing.T) {},
+			req:  charonrpc.CreateOAuthClientRequest{Name: "app"},
+			err:  grpcerr.E(codes.InvalidArgument),
+		},
+		"relative-redirect-uri": {
+			init: func(t *testing.T) {},
+			req:  charonrpc.CreateOAuthClientRequest{Name: "app", RedirectUris: []string{"/cb"}},
+			err:  grpcerr.E(codes.InvalidArgument),
+		},
+		"redirect-uri-with-fragment": {
+			init: func(t *testing.T) {},
+			req:  charonrpc.CreateOAuthClientRequest{Name: "app", RedirectUris: []string{"https://example.com/cb#x"}},
+			err:  grpcerr.E(codes.InvalidArgument),
+		},
+		"insecure-redirect-uri": {
+			init: func(t *testing.T) {},
+			req:  charonrpc.CreateOAuthClientRequest{Name: "app", RedirectUris: []string{"http://example.com/cb"}},
+			err:  grpcerr.E(codes.InvalidArgument),
+		},
+		"missing-permission": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1}}, nil).
+					Once()
+			},
+			req: charonrpc.CreateOAuthClientRequest{Name: "app", RedirectUris: []string{"https://example.com/cb"}},
+			err: grpcerr.E(codes.PermissionDenied),
+		},
+		"public": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{
+						User:        &model.UserEntity{ID: 1},
+						Permissions: charon.Permissions{charon.OAuthClientCanCreate},
+					}, nil).
+					Once()
+				oauthClientProviderMock.On("Insert", mock.Anything, mock.MatchedBy(func(ent *model.OauthClientEntity) bool {
+					return ent.Name == "app" && len(ent.Secret) == 0 && ent.RedirectUris == "http://127.0.0.1:8080/cb https://example.com/cb"
+				})).
+					Return(inserted, nil).
+					Once()
+			},
+			req: charonrpc.CreateOAuthClientRequest{Name: "app", RedirectUris: []string{"http://127.0.0.1:8080/cb", "https://example.com/cb"}},
+		},
+		"confidential": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1, IsSuperuser: true}}, nil).
+					Once()
+				oauthClientProviderMock.On("Insert", mock.Anything, mock.MatchedBy(func(ent *model.OauthClientEntity) bool {
+					return len(ent.Secret) == sha256.Size && ent.ClientID != ""
+				})).
+					Return(inserted, nil).
+					Once()
+			},
+			req:          charonrpc.CreateOAuthClientRequest{Name: "app", RedirectUris: []string{"https://example.com/cb"}, Confidential: true},
+			confidential: true,
+		},
+		"already-exists": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1, IsSuperuser: true}}, nil).
+					Once()
+				oauthClientProviderMock.On("Insert", mock.Anything, mock.Anything).
+					Return(nil, &pq.Error{Constraint: model.TableOauthClientConstraintClientIDUnique}).
+					Once()
+			},
+			req: charonrpc.CreateOAuthClientRequest{Name: "app", RedirectUris: []string{"https://example.com/cb"}},
+			err: grpcerr.E(codes.AlreadyExists),
+		},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			actorProviderMock.ExpectedCalls = []*mock.Call{}
+			oauthClientProviderMock.ExpectedCalls = []*mock.Call{}
+			auditEventProviderMock.ExpectedCalls = []*mock.Call{}
+
+			c.init(t)
+			audit := auditEventProviderMock.On("Insert", mock.Anything, auditEventMatcher(model.AuditEventTargetOAuthClient, 0)).
+				Return(&model.AuditEventEntity{}, nil)
+			if c.err != nil {
+				audit.Maybe()
+			}
+
+			res, err := h.Create(context.Background(), &c.req)
+			if c.err != nil {
+				if !grpcerr.Match(c.err, err) {
+					t.Fatalf("errors do not match, got '%v'", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.Client.Confidential != c.confidential || (res.Secret != "") != c.confidential {
+				t.Errorf("wrong confidentiality, secret: %q, client: %v", res.Secret, res.Client)
+			}
+
+			mock.AssertExpectationsForObjects(t, actorProviderMock, oauthClientProviderMock, auditEventProviderMock)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package charond
 
 import (
+	"database/sql"
 	"time"
 
 	"github.com/golang/protobuf/ptypes"
@@ -36,7 +37,8 @@ func (crth *createRefreshTokenHandler) Create(ctx context.Context, req *charonrp
 	if err != nil {
 		return nil, err
 	}
-	if err = crth.firewall(req, act); err != nil {
+	userID := req.UserId.Int64Or(act.User.ID)
+	if err = crth.firewall(ctx, act, userID); err != nil {
 		return nil, err
 	}
 
@@ -45,48 +47,98 @@ func (crth *createRefreshTokenHandler) Create(ctx context.Context, req *charonrp
 		return nil, grpcerr.E(codes.Internal, "refresh token generation failure", err)
 	}
 
-	ent, err := crth.repository.refreshToken.Create(ctx, &model.RefreshTokenEntity{
-		UserID: act.User.ID,
-		Token:  tkn,
-		ExpireAt: pq.NullTime{
-			Time:  expireAt.UTC(),
-			Valid: !expireAt.IsZero(),
-		},
-		CreatedBy: ntypes.Int64{Int64: act.User.ID, Valid: true},
-		Notes:     allocNilString(req.Notes),
+	var (
+		ent   *model.RefreshTokenEntity
+		entry = &auditEntry{targetKind: model.AuditEventTargetRefreshToken}
+	)
+	err = crth.audit(ctx, act, entry, func(ctx context.Context) error {
+		ent, err = crth.repository.refreshToken.Create(ctx, &model.RefreshTokenEntity{
+			UserID: userID,
+			Prefix: refreshtoken.Prefix(tkn),
+			Secret: refreshtoken.Hash(tkn),
+			Family: refreshtoken.Prefix(tkn),
+			ExpireAt: pq.NullTime{
+				Time:  expireAt.UTC(),
+				Valid: !expireAt.IsZero(),
+			},
+			CreatedBy: ntypes.Int64{Int64: act.User.ID, Valid: true},
+			Notes:     allocNilString(req.Notes),
+		})
+		if err != nil {
+			switch model.ErrorConstraint(err) {
+			case model.TableRefreshTokenConstraintCreatedByForeignKey:
+				return grpcerr.E(codes.NotFound, "such user does not exist")
+			case model.TableRefreshTokenConstraintPrefixUnique:
+				return grpcerr.E(codes.AlreadyExists, "such refresh token already exists")
+			case model.TableRefreshTokenConstraintUserIDForeignKey:
+				return grpcerr.E(codes.NotFound, "such user does not exist")
+			default:
+				return grpcerr.E(codes.Internal, "refresh token persistence failure", err)
+			}
+		}
+		entry.targetID = ent.UserID
+		entry.after = ent
+		return nil
 	})
 	if err != nil {
-		switch model.ErrorConstraint(err) {
-		case model.TableRefreshTokenConstraintCreatedByForeignKey:
-			return nil, grpcerr.E(codes.NotFound, "such user does not exist")
-		case model.TableRefreshTokenConstraintTokenUnique:
-			return nil, grpcerr.E(codes.AlreadyExists, "such refresh token already exists")
-		case model.TableRefreshTokenConstraintUserIDForeignKey:
-			return nil, grpcerr.E(codes.NotFound, "such user does not exist")
-		default:
-			return nil, grpcerr.E(codes.Internal, "refresh token persistence failure", err)
-		}
+		return nil, err
 	}
 
-	return crth.response(ent)
+	return crth.response(ent, tkn)
 }
 
-func (crth *createRefreshTokenHandler) firewall(req *charonrpc.CreateRefreshTokenRequest, act *session.Actor) error {
+// firewall allows to issue a token on behalf of another user only to those who can create tokens as a stranger.
+// Token is never issued within a session started with an API key, it would not carry the key restrictions.
+func (crth *createRefreshTokenHandler) firewall(ctx context.Context, act *session.Actor, userID int64) error {
+	if act.ByAPIKey() {
+		return grpcerr.E(codes.PermissionDenied, "refresh token cannot be created within a session started with an api key")
+	}
 	if act.User.IsSuperuser {
 		return nil
 	}
-	if act.Permissions.Contains(charon.RefreshTokenCanCreate) {
+	if act.User.ID != userID {
+		if !act.Permissions.Match(charon.RefreshTokenCanCreateAsStranger) {
+			return grpcerr.E(codes.PermissionDenied, "refresh token cannot be created for another user, missing permission")
+		}
+		usr, err := crth.repository.user.FindOneByID(ctx, userID)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return grpcerr.E(codes.NotFound, "such user does not exist")
+			}
+			return grpcerr.E(codes.Internal, "user retrieval failure", err)
+		}
+		return impersonationFirewall(act, usr, "refresh token")
+	}
+	if act.Permissions.Match(charon.RefreshTokenCanCreate) {
 		return nil
 	}
 
 	return grpcerr.E(codes.PermissionDenied, "refresh token cannot be created, missing permission")
 }
 
-func (crth *createRefreshTokenHandler) response(ent *model.RefreshTokenEntity) (*charonrpc.CreateRefreshTokenResponse, error) {
+// impersonationFirewall guards credentials issued for another user, whoever holds such credential acts as the user.
+// They cannot be issued for a superuser other than by a superuser,
+// nor for a staff user by someone who cannot modify staff users.
+func impersonationFirewall(act *session.Actor, usr *model.UserEntity, credential string) error {
+	if act.User.IsSuperuser || act.User.ID == usr.ID {
+		return nil
+	}
+	if usr.IsSuperuser {
+		return grpcerr.E(codes.PermissionDenied, credential+" can be created for a superuser only by a superuser")
+	}
+	if usr.IsStaff && !act.Permissions.Match(charon.UserCanModifyStaffAsStranger) {
+		return grpcerr.E(codes.PermissionDenied, credential+" cannot be created for a staff user, missing permission")
+	}
+	return nil
+}
+
+// response is the only place the token is exposed, afterwards it is known only by its prefix.
+func (crth *createRefreshTokenHandler) response(ent *model.RefreshTokenEntity, token string) (*charonrpc.CreateRefreshTokenResponse, error) {
 	msg, err := mapping.ReverseRefreshToken(ent)
 	if err != nil {
 		return nil, grpcerr.E(codes.Internal, "refresh token entity mapping failure", err)
 	}
+	msg.Token = token
 	return &charonrpc.CreateRefreshTokenResponse{
 		RefreshToken: msg,
 	}, nil
@@ -2,6 +2,7 @@ package charond
 
 import (
 	"context"
+	"database/sql"
 	"testing"
 
 	"time"
@@ -15,6 +16,7 @@ import (
 	"github.com/piotrkowalczuk/charon/internal/session"
 	"github.com/piotrkowalczuk/charon/internal/session/sessionmock"
 	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/piotrkowalczuk/mnemosyne/mnemosynerpc"
 	"github.com/piotrkowalczuk/ntypes"
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc/codes"
@@ -56,6 +58,8 @@ func TestCreateRefreshTokenHandler_Create_E2E(t *testing.T) {
 func TestCreateRefreshTokenHandler_Create_Unit(t *testing.T) {
 	actorProviderMock := &sessionmock.ActorProvider{}
 	refreshTokenProviderMock := &modelmock.RefreshTokenProvider{}
+	userProviderMock := &modelmock.UserProvider{}
+	auditEventProviderMock := &modelmock.AuditEventProvider{}
 
 	cases := map[string]struct {
 		req  charonrpc.CreateRefreshTokenRequest
@@ -122,7 +126,7 @@ func TestCreateRefreshTokenHandler_Create_Unit(t *testing.T) {
 					Return(&session.Actor{User: &model.UserEntity{IsSuperuser: true}}, nil).
 					Once()
 				refreshTokenProviderMock.On("Create", mock.Anything, mock.Anything).
-					Return(nil, &pq.Error{Constraint: model.TableRefreshTokenConstraintTokenUnique}).
+					Return(nil, &pq.Error{Constraint: model.TableRefreshTokenConstraintPrefixUnique}).
 					Once()
 			},
 			err: grpcerr.E(codes.AlreadyExists),
@@ -176,6 +180,111 @@ func TestCreateRefreshTokenHandler_Create_Unit(t *testing.T) {
 				}, nil).Once()
 			},
 		},
+		"cannot-create-within-api-key-session": {
+			req: charonrpc.CreateRefreshTokenRequest{},
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{
+						Permissions: charon.Permissions{charon.RefreshTokenCanCreate},
+						User:        &model.UserEntity{ID: 1},
+						Session:     &mnemosynerpc.Session{Bag: map[string]string{session.BagAPIKey: "prefix"}},
+					}, nil).
+					Once()
+			},
+			err: grpcerr.E(codes.PermissionDenied),
+		},
+		"cannot-create-for-stranger": {
+			req: charonrpc.CreateRefreshTokenRequest{UserId: &ntypes.Int64{Int64: 2, Valid: true}},
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{
+						Permissions: charon.Permissions{charon.RefreshTokenCanCreate},
+						User:        &model.UserEntity{ID: 1},
+					}, nil).
+					Once()
+			},
+			err: grpcerr.E(codes.PermissionDenied),
+		},
+		"can-create-for-stranger-with-permission": {
+			req: charonrpc.CreateRefreshTokenRequest{UserId: &ntypes.Int64{Int64: 2, Valid: true}},
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{
+						Permissions: charon.Permissions{charon.RefreshTokenCanCreateAsStranger},
+						User:        &model.UserEntity{ID: 1},
+					}, nil).
+					Once()
+				userProviderMock.On("FindOneByID", mock.Anything, int64(2)).
+					Return(&model.UserEntity{ID: 2}, nil).
+					Once()
+				refreshTokenProviderMock.On("Create", mock.Anything, mock.MatchedBy(func(ent *model.RefreshTokenEntity) bool {
+					return ent.UserID == 2 && ent.CreatedBy.Int64Or(0) == 1
+				})).Return(&model.RefreshTokenEntity{
+					ExpireAt: pq.NullTime{Time: time.Now(), Valid: true},
+				}, nil).Once()
+			},
+		},
+		"cannot-create-for-superuser-as-stranger": {
+			req: charonrpc.CreateRefreshTokenRequest{UserId: &ntypes.Int64{Int64: 2, Valid: true}},
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{
+						Permissions: charon.Permissions{
+							charon.RefreshTokenCanCreateAsStranger,
+							charon.UserCanModifyStaffAsStranger,
+						},
+						User: &model.UserEntity{ID: 1},
+					}, nil).
+					Once()
+				userProviderMock.On("FindOneByID", mock.Anything, int64(2)).
+					Return(&model.UserEntity{ID: 2, IsSuperuser: true}, nil).
+					Once()
+			},
+			err: grpcerr.E(codes.PermissionDenied),
+		},
+		"cannot-create-for-staff-without-permission": {
+			req: charonrpc.CreateRefreshTokenRequest{UserId: &ntypes.Int64{Int64: 2, Valid: true}},
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{
+						Permissions: charon.Permissions{charon.RefreshTokenCanCreateAsStranger},
+						User:        &model.UserEntity{ID: 1},
+					}, nil).
+					Once()
+				userProviderMock.On("FindOneByID", mock.Anything, int64(2)).
+					Return(&model.UserEntity{ID: 2, IsStaff: true}, nil).
+					Once()
+			},
+			err: grpcerr.E(codes.PermissionDenied),
+		},
+		"cannot-create-for-user-that-does-not-exist": {
+			req: charonrpc.CreateRefreshTokenRequest{UserId: &ntypes.Int64{Int64: 2, Valid: true}},
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{
+						Permissions: charon.Permissions{charon.RefreshTokenCanCreateAsStranger},
+						User:        &model.UserEntity{ID: 1},
+					}, nil).
+					Once()
+				userProviderMock.On("FindOneByID", mock.Anything, int64(2)).
+					Return(nil, sql.ErrNoRows).
+					Once()
+			},
+			err: grpcerr.E(codes.NotFound),
+		},
+		"can-create-for-stranger-as-superuser": {
+			req: charonrpc.CreateRefreshTokenRequest{UserId: &ntypes.Int64{Int64: 2, Valid: true}},
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1, IsSuperuser: true}}, nil).
+					Once()
+				refreshTokenProviderMock.On("Create", mock.Anything, mock.MatchedBy(func(ent *model.RefreshTokenEntity) bool {
+					return ent.UserID == 2
+				})).Return(&model.RefreshTokenEntity{
+					ExpireAt: pq.NullTime{Time: time.Now(), Valid: true},
+				}, nil).Once()
+			},
+		},
 	}
 
 	h := createRefreshTokenHandler{
@@ -183,6 +292,9 @@ func TestCreateRefreshTokenHandler_Create_Unit(t *testing.T) {
 			ActorProvider: actorProviderMock,
 			repository: repositories{
 				refreshToken: refreshTokenProviderMock,
+				user:         userProviderMock,
+				auditEvent:   auditEventProviderMock,
+				transactor:   newTransactorMock(),
 			},
 		},
 	}
@@ -193,8 +305,15 @@ func TestCreateRefreshTokenHandler_Create_Unit(t *testing.T) {
 
 			actorProviderMock.ExpectedCalls = nil
 			refreshTokenProviderMock.ExpectedCalls = nil
+			userProviderMock.ExpectedCalls = nil
+			auditEventProviderMock.ExpectedCalls = nil
 
 			c.init(t)
+			audit := auditEventProviderMock.On("Insert", mock.Anything, auditEventMatcher(model.AuditEventTargetRefreshToken, 0)).
+				Return(&model.AuditEventEntity{}, nil)
+			if c.err != nil {
+				audit.Maybe()
+			}
 
 			_, err := h.Create(context.TODO(), &c.req)
 			if c.err != nil {
@@ -206,7 +325,7 @@ func TestCreateRefreshTokenHandler_Create_Unit(t *testing.T) {
 			if err != nil {
 				t.Fatal(err)
 			}
-			if !mock.AssertExpectationsForObjects(t, actorProviderMock, refreshTokenProviderMock) {
+			if !mock.AssertExpectationsForObjects(t, actorProviderMock, refreshTokenProviderMock, userProviderMock, auditEventProviderMock) {
 				return
 			}
 		})
@@ -24,7 +24,13 @@ func (cuh *createUserHandler) Create(ctx context.Context, req *charonrpc.CreateU
 	if len(req.Username) < 3 {
 		return nil, grpcerr.E(codes.InvalidArgument, "username needs to be at least 3 characters long")
 	}
-	if len(req.SecurePassword) == 0 {
+	serviceAccount := req.IsServiceAccount.BoolOr(false)
+	switch {
+	case serviceAccount:
+		if len(req.SecurePassword) > 0 || req.PlainPassword != "" {
+			return nil, grpcerr.E(codes.InvalidArgument, "service account cannot have a password")
+		}
+	case len(req.SecurePassword) == 0:
 		if len(req.PlainPassword) < 8 {
 			return nil, grpcerr.E(codes.InvalidArgument, "password needs to be at least 8 characters long")
 		}
@@ -52,12 +58,15 @@ func (cuh *createUserHandler) Create(ctx context.Context, req *charonrpc.CreateU
 		}
 	}
 
-	if len(req.SecurePassword) == 0 {
+	switch {
+	case serviceAccount:
+		req.SecurePassword = model.NoPassword
+	case len(req.SecurePassword) == 0:
 		req.SecurePassword, err = cuh.hasher.Hash([]byte(req.PlainPassword))
 		if err != nil {
 			return nil, grpcerr.E(codes.Internal, "password hashing failure", err)
 		}
-	} else {
+	default:
 		if !act.User.IsSuperuser {
 			return nil, grpcerr.E(codes.PermissionDenied, "only superuser can create an user with manually defined secure password")
 		}
@@ -67,24 +76,38 @@ func (cuh *createUserHandler) Create(ctx context.Context, req *charonrpc.CreateU
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "confirmation token generation failure: %s", err)
 	}
-	ent, err := cuh.repository.user.Create(ctx, &model.UserEntity{
-		Username:          req.Username,
-		Password:          req.SecurePassword,
-		FirstName:         req.FirstName,
-		LastName:          req.LastName,
-		ConfirmationToken: token[:],
-		IsSuperuser:       req.IsSuperuser.BoolOr(false),
-		IsStaff:           req.IsStaff.BoolOr(false),
-		IsActive:          req.IsActive.BoolOr(false),
-		IsConfirmed:       req.IsConfirmed.BoolOr(false),
+	var (
+		ent   *model.UserEntity
+		entry = &auditEntry{targetKind: model.AuditEventTargetUser}
+	)
+	err = cuh.audit(ctx, act, entry, func(ctx context.Context) error {
+		ent, err = cuh.repository.user.Create(ctx, &model.UserEntity{
+			Username:          req.Username,
+			Password:          req.SecurePassword,
+			FirstName:         req.FirstName,
+			LastName:          req.LastName,
+			ConfirmationToken: token[:],
+			IsSuperuser:       req.IsSuperuser.BoolOr(false),
+			IsStaff:           req.IsStaff.BoolOr(false),
+			IsActive:          req.IsActive.BoolOr(false),
+			// Service account has no address its creation could be confirmed with.
+			IsConfirmed:      req.IsConfirmed.BoolOr(serviceAccount),
+			IsServiceAccount: serviceAccount,
+		})
+		if err != nil {
+			switch model.ErrorConstraint(err) {
+			case model.TableUserConstraintUsernameUnique:
+				return grpcerr.E(codes.AlreadyExists, "user with such username already exists")
+			default:
+				return grpcerr.E(codes.Internal, "user cannot be persisted", err)
+			}
+		}
+		entry.targetID = ent.ID
+		entry.after = ent
+		return nil
 	})
 	if err != nil {
-		switch model.ErrorConstraint(err) {
-		case model.TableUserConstraintUsernameUnique:
-			return nil, grpcerr.E(codes.AlreadyExists, "user with such username already exists")
-		default:
-			return nil, grpcerr.E(codes.Internal, "user cannot be persisted", err)
-		}
+		return nil, err
 	}
 
 	return cuh.response(ent)
@@ -97,10 +120,10 @@ func (cuh *createUserHandler) firewall(req *charonrpc.CreateUserRequest, act *se
 	if req.IsSuperuser.BoolOr(false) {
 		return grpcerr.E(codes.PermissionDenied, "user is not allowed to create superuser")
 	}
-	if req.IsStaff.BoolOr(false) && !act.Permissions.Contains(charon.UserCanCreateStaff) {
+	if req.IsStaff.BoolOr(false) && !act.Permissions.Match(charon.UserCanCreateStaff) {
 		return grpcerr.E(codes.PermissionDenied, "user is not allowed to create staff user")
 	}
-	if !act.Permissions.Contains(charon.UserCanCreateStaff, charon.UserCanCreate) {
+	if !act.Permissions.Match(charon.UserCanCreateStaff, charon.UserCanCreate) {
 		return grpcerr.E(codes.PermissionDenied, "user is not allowed to create another user")
 	}
 
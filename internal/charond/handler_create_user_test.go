@@ -1,6 +1,7 @@
 package charond
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"testing"
@@ -27,6 +28,7 @@ import (
 func TestCreateUserHandler_Create_Unit(t *testing.T) {
 	actorProviderMock := &sessionmock.ActorProvider{}
 	userProviderMock := &modelmock.UserProvider{}
+	auditEventProviderMock := &modelmock.AuditEventProvider{}
 	hasherMock := &passwordmock.Hasher{}
 
 	h := createUserHandler{
@@ -34,7 +36,9 @@ func TestCreateUserHandler_Create_Unit(t *testing.T) {
 		handler: &handler{
 			ActorProvider: actorProviderMock,
 			repository: repositories{
-				user: userProviderMock,
+				user:       userProviderMock,
+				auditEvent: auditEventProviderMock,
+				transactor: newTransactorMock(),
 			},
 		},
 	}
@@ -48,7 +52,10 @@ func TestCreateUserHandler_Create_Unit(t *testing.T) {
 				Return([]byte{1, 2, 3}, nil).
 				Once()
 			userProviderMock.On("Create", mock.Anything, mock.Anything, mock.Anything).
-				Return(&model.UserEntity{}, nil).
+				Return(&model.UserEntity{ID: 1}, nil).
+				Once()
+			auditEventProviderMock.On("Insert", mock.Anything, auditEventMatcher(model.AuditEventTargetUser, 1)).
+				Return(&model.AuditEventEntity{}, nil).
 				Once()
 		}
 	}
@@ -271,6 +278,37 @@ func TestCreateUserHandler_Create_Unit(t *testing.T) {
 				},
 			}),
 		},
+		"service-account-with-password": {
+			req: charonrpc.CreateUserRequest{
+				Username:         "username",
+				PlainPassword:    "password",
+				IsServiceAccount: ntypes.True(),
+			},
+			init: func(_ *testing.T, _ *charonrpc.CreateUserRequest) {},
+			err:  grpcerr.E(codes.InvalidArgument),
+		},
+		"success-service-account-as-user": {
+			req: charonrpc.CreateUserRequest{
+				Username:         "username",
+				IsServiceAccount: ntypes.True(),
+			},
+			init: func(t *testing.T, r *charonrpc.CreateUserRequest) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{
+						User:        &model.UserEntity{ID: 2},
+						Permissions: charon.Permissions{charon.UserCanCreate},
+					}, nil).
+					Once()
+				userProviderMock.On("Create", mock.Anything, mock.MatchedBy(func(ent *model.UserEntity) bool {
+					return ent.IsServiceAccount && ent.IsConfirmed && bytes.Equal(ent.Password, model.NoPassword)
+				})).
+					Return(&model.UserEntity{ID: 1, IsServiceAccount: true}, nil).
+					Once()
+				auditEventProviderMock.On("Insert", mock.Anything, auditEventMatcher(model.AuditEventTargetUser, 1)).
+					Return(&model.AuditEventEntity{}, nil).
+					Once()
+			},
+		},
 		"storage-returns-broken-entity": {
 			req: charonrpc.CreateUserRequest{
 				Username:      "username",
@@ -356,6 +394,7 @@ func TestCreateUserHandler_Create_Unit(t *testing.T) {
 			hasherMock.ExpectedCalls = nil
 			userProviderMock.ExpectedCalls = nil
 			actorProviderMock.ExpectedCalls = nil
+			auditEventProviderMock.ExpectedCalls = nil
 
 			c.init(t, &c.req)
 
@@ -369,7 +408,7 @@ func TestCreateUserHandler_Create_Unit(t *testing.T) {
 			if err != nil {
 				t.Fatal(err)
 			}
-			if !mock.AssertExpectationsForObjects(t, hasherMock, userProviderMock, actorProviderMock) {
+			if !mock.AssertExpectationsForObjects(t, hasherMock, userProviderMock, actorProviderMock, auditEventProviderMock) {
 				return
 			}
 		})
@@ -0,0 +1,73 @@
+package charond
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"google.golang.org/grpc/codes"
+)
+
+type deleteAPIKeyHandler struct {
+	*handler
+}
+
+func (dakh *deleteAPIKeyHandler) Delete(ctx context.Context, req *charonrpc.DeleteAPIKeyRequest) (*wrappers.BoolValue, error) {
+	if req.Id <= 0 {
+		return nil, grpcerr.E(codes.InvalidArgument, "api key cannot be deleted, invalid id")
+	}
+
+	act, err := dakh.Actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ent, err := dakh.repository.apiKey.FindOneByID(ctx, req.Id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, grpcerr.E(codes.NotFound, "api key cannot be removed, does not exists")
+		}
+		return nil, grpcerr.E(codes.Internal, "api key fetch failure", err)
+	}
+	if err = dakh.firewall(act, ent); err != nil {
+		return nil, err
+	}
+
+	var (
+		aff   int64
+		entry = &auditEntry{targetKind: model.AuditEventTargetAPIKey, targetID: ent.ID, before: ent}
+	)
+	err = dakh.audit(ctx, act, entry, func(ctx context.Context) error {
+		if aff, err = dakh.repository.apiKey.DeleteOneByID(ctx, req.Id); err != nil {
+			return grpcerr.E(codes.Internal, "api key deletion failure", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &wrappers.BoolValue{
+		Value: aff > 0,
+	}, nil
+}
+
+func (dakh *deleteAPIKeyHandler) firewall(act *session.Actor, ent *model.APIKeyEntity) error {
+	if act.User.IsSuperuser {
+		return nil
+	}
+	if act.Permissions.Match(charon.APIKeyCanDeleteAsStranger) {
+		return nil
+	}
+	if act.Permissions.Match(charon.APIKeyCanDeleteAsOwner) {
+		if act.User.ID == ent.UserID {
+			return nil
+		}
+		return grpcerr.E(codes.PermissionDenied, "api key cannot be removed by stranger, missing permission")
+	}
+	return grpcerr.E(codes.PermissionDenied, "api key cannot be removed, missing permission")
+}
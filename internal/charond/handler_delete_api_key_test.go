@@ -0,0 +1,120 @@
+package charond
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/model/modelmock"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	"github.com/piotrkowalczuk/charon/internal/session/sessionmock"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+func TestDeleteAPIKeyHandler_Delete_Unit(t *testing.T) {
+	actorProviderMock := &sessionmock.ActorProvider{}
+	apiKeyProviderMock := &modelmock.APIKeyProvider{}
+	auditEventProviderMock := &modelmock.AuditEventProvider{}
+
+	h := deleteAPIKeyHandler{
+		handler: &handler{
+			logger:        zap.L(),
+			ActorProvider: actorProviderMock,
+			repository: repositories{
+				apiKey:     apiKeyProviderMock,
+				auditEvent: auditEventProviderMock,
+				transactor: newTransactorMock(),
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		req  charonrpc.DeleteAPIKeyRequest
+		init func(*testing.T)
+		err  error
+	}{
+		"invalid-id": {
+			init: func(t *testing.T) {},
+			req:  charonrpc.DeleteAPIKeyRequest{},
+			err:  grpcerr.E(codes.InvalidArgument),
+		},
+		"does-not-exists": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1, IsSuperuser: true}}, nil).
+					Once()
+				apiKeyProviderMock.On("FindOneByID", mock.Anything, int64(10)).
+					Return(nil, sql.ErrNoRows).
+					Once()
+			},
+			req: charonrpc.DeleteAPIKeyRequest{Id: 10},
+			err: grpcerr.E(codes.NotFound),
+		},
+		"missing-permission": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1}}, nil).
+					Once()
+				apiKeyProviderMock.On("FindOneByID", mock.Anything, int64(10)).
+					Return(&model.APIKeyEntity{ID: 10, UserID: 1}, nil).
+					Once()
+			},
+			req: charonrpc.DeleteAPIKeyRequest{Id: 10},
+			err: grpcerr.E(codes.PermissionDenied),
+		},
+		"as-stranger": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{
+						User:        &model.UserEntity{ID: 1},
+						Permissions: charon.Permissions{charon.APIKeyCanDeleteAsStranger},
+					}, nil).
+					Once()
+				apiKeyProviderMock.On("FindOneByID", mock.Anything, int64(10)).
+					Return(&model.APIKeyEntity{ID: 10, UserID: 2}, nil).
+					Once()
+				apiKeyProviderMock.On("DeleteOneByID", mock.Anything, int64(10)).
+					Return(int64(1), nil).
+					Once()
+			},
+			req: charonrpc.DeleteAPIKeyRequest{Id: 10},
+		},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			actorProviderMock.ExpectedCalls = []*mock.Call{}
+			apiKeyProviderMock.ExpectedCalls = []*mock.Call{}
+			auditEventProviderMock.ExpectedCalls = []*mock.Call{}
+
+			c.init(t)
+			audit := auditEventProviderMock.On("Insert", mock.Anything, auditEventMatcher(model.AuditEventTargetAPIKey, 10)).
+				Return(&model.AuditEventEntity{}, nil)
+			if c.err != nil {
+				audit.Maybe()
+			}
+
+			res, err := h.Delete(context.Background(), &c.req)
+			if c.err != nil {
+				if !grpcerr.Match(c.err, err) {
+					t.Fatalf("errors do not match, got '%v'", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !res.Value {
+				t.Error("api key expected to be removed")
+			}
+
+			mock.AssertExpectationsForObjects(t, actorProviderMock, apiKeyProviderMock, auditEventProviderMock)
+		})
+	}
+}
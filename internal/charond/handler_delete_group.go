@@ -2,6 +2,7 @@ package charond
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/piotrkowalczuk/charon"
@@ -29,23 +30,37 @@ func (dgh *deleteGroupHandler) Delete(ctx context.Context, req *charonrpc.Delete
 		return nil, err
 	}
 
-	aff, err := dgh.repository.group.DeleteOneByID(ctx, req.Id)
-	if err != nil {
-		//if err == sql.ErrNoRows {
-		//	return nil, grpcerr.E(codes.NotFound, "group deletion failure", err)
-		//}
-		switch model.ErrorConstraint(err) {
-		case model.TableUserGroupsConstraintGroupIDForeignKey:
-			return nil, grpcerr.E(codes.FailedPrecondition, "group cannot be removed, users are assigned to it")
-		case model.TableGroupPermissionsConstraintGroupIDForeignKey:
-			return nil, grpcerr.E(codes.FailedPrecondition, "group cannot be removed, permissions are assigned to it")
-		default:
-			return nil, grpcerr.E(codes.Internal, "group deletion failure", err)
+	var (
+		aff   int64
+		entry = &auditEntry{targetKind: model.AuditEventTargetGroup, targetID: req.Id}
+	)
+	err = dgh.audit(ctx, act, entry, func(ctx context.Context) error {
+		if entry.before, err = dgh.repository.group.FindOneByID(ctx, req.Id); err != nil {
+			if err == sql.ErrNoRows {
+				return grpcerr.E(codes.NotFound, "group cannot be removed, does not exists")
+			}
+			return grpcerr.E(codes.Internal, "group fetch failure", err)
 		}
-	}
-
-	if aff == 0 {
-		return nil, grpcerr.E(codes.NotFound, "group cannot be removed, does not exists")
+		aff, err = dgh.repository.group.DeleteOneByID(ctx, req.Id)
+		if err != nil {
+			switch model.ErrorConstraint(err) {
+			case model.TableUserGroupsConstraintGroupIDForeignKey:
+				return grpcerr.E(codes.FailedPrecondition, "group cannot be removed, users are assigned to it")
+			case model.TableGroupPermissionsConstraintGroupIDForeignKey:
+				return grpcerr.E(codes.FailedPrecondition, "group cannot be removed, permissions are assigned to it")
+			case model.TableGroupConstraintParentIDForeignKey:
+				return grpcerr.E(codes.FailedPrecondition, "group cannot be removed, other groups are nested in it")
+			default:
+				return grpcerr.E(codes.Internal, "group deletion failure", err)
+			}
+		}
+		if aff == 0 {
+			return grpcerr.E(codes.NotFound, "group cannot be removed, does not exists")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return &wrappers.BoolValue{
@@ -57,7 +72,7 @@ func (dgh *deleteGroupHandler) firewall(req *charonrpc.DeleteGroupRequest, act *
 	if act.User.IsSuperuser {
 		return nil
 	}
-	if act.Permissions.Contains(charon.GroupCanDelete) {
+	if act.Permissions.Match(charon.GroupCanDelete) {
 		return nil
 	}
 
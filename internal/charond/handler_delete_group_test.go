@@ -6,6 +6,7 @@ import (
 	"math"
 	"net"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/lib/pq"
@@ -94,12 +95,15 @@ func TestDeleteGroupHandler_Delete_E2E(t *testing.T) {
 func TestDeleteGroupHandler_Delete_Unit(t *testing.T) {
 	actorProviderMock := &sessionmock.ActorProvider{}
 	groupProviderMock := &modelmock.GroupProvider{}
+	auditEventProviderMock := &modelmock.AuditEventProvider{}
 
 	h := deleteGroupHandler{
 		handler: &handler{
 			ActorProvider: actorProviderMock,
 			repository: repositories{
-				group: groupProviderMock,
+				group:      groupProviderMock,
+				auditEvent: auditEventProviderMock,
+				transactor: newTransactorMock(),
 			},
 		},
 	}
@@ -234,6 +238,9 @@ func TestDeleteGroupHandler_Delete_Unit(t *testing.T) {
 				Once()
 			groupProviderMock.On("DeleteOneByID", mock.Anything, int64(5)).Return(int64(1), nil).
 				Once()
+			auditEventProviderMock.On("Insert", mock.Anything, auditEventMatcher(model.AuditEventTargetGroup, 5)).
+				Return(&model.AuditEventEntity{}, nil).
+				Once()
 			_, err := h.Delete(context.Background(), &charonrpc.DeleteGroupRequest{Id: 5})
 			if err != nil {
 				t.Fatalf("unexpected error: %s", err.Error())
@@ -245,10 +252,14 @@ func TestDeleteGroupHandler_Delete_Unit(t *testing.T) {
 		// reset mocks between cases
 		actorProviderMock.ExpectedCalls = nil
 		groupProviderMock.ExpectedCalls = []*mock.Call{}
+		auditEventProviderMock.ExpectedCalls = nil
+		groupProviderMock.On("FindOneByID", mock.Anything, int64(5)).
+			Return(&model.GroupEntity{ID: 5, Name: "name", CreatedAt: time.Now()}, nil).
+			Maybe()
 
 		t.Run(hint, c)
 
-		if !mock.AssertExpectationsForObjects(t, actorProviderMock, groupProviderMock) {
+		if !mock.AssertExpectationsForObjects(t, actorProviderMock, groupProviderMock, auditEventProviderMock) {
 			return
 		}
 	}
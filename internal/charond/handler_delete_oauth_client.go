@@ -0,0 +1,73 @@
+package charond
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"google.golang.org/grpc/codes"
+)
+
+type deleteOAuthClientHandler struct {
+	*handler
+}
+
+func (doch *deleteOAuthClientHandler) Delete(ctx context.Context, req *charonrpc.DeleteOAuthClientRequest) (*wrappers.BoolValue, error) {
+	if req.Id <= 0 {
+		return nil, grpcerr.E(codes.InvalidArgument, "oauth client cannot be deleted, invalid id")
+	}
+
+	act, err := doch.Actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = doch.firewall(act); err != nil {
+		return nil, err
+	}
+
+	var (
+		aff   int64
+		entry = &auditEntry{targetKind: model.AuditEventTargetOAuthClient, targetID: req.Id}
+	)
+	err = doch.audit(ctx, act, entry, func(ctx context.Context) error {
+		if entry.before, err = doch.repository.oauthClient.FindOneByID(ctx, req.Id); err != nil {
+			if err == sql.ErrNoRows {
+				return grpcerr.E(codes.NotFound, "oauth client cannot be removed, does not exists")
+			}
+			return grpcerr.E(codes.Internal, "oauth client fetch failure", err)
+		}
+		if _, err = doch.repository.oauthConsent.DeleteByClientID(ctx, req.Id); err != nil {
+			return grpcerr.E(codes.Internal, "oauth client consents removal failure", err)
+		}
+		if _, err = doch.repository.oauthCode.DeleteByClientID(ctx, req.Id); err != nil {
+			return grpcerr.E(codes.Internal, "oauth client authorization codes removal failure", err)
+		}
+		if aff, err = doch.repository.oauthClient.DeleteOneByID(ctx, req.Id); err != nil {
+			return grpcerr.E(codes.Internal, "oauth client deletion failure", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &wrappers.BoolValue{
+		Value: aff > 0,
+	}, nil
+}
+
+func (doch *deleteOAuthClientHandler) firewall(act *session.Actor) error {
+	if act.User.IsSuperuser {
+		return nil
+	}
+	if act.Permissions.Match(charon.OAuthClientCanDelete) {
+		return nil
+	}
+
+	return grpcerr.E(codes.PermissionDenied, "oauth client cannot be removed, missing permission")
+}
@@ -0,0 +1,129 @@
+package charond
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/model/modelmock"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	"github.com/piotrkowalczuk/charon/internal/session/sessionmock"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+func TestDeleteOAuthClientHandler_Delete_Unit(t *testing.T) {
+	actorProviderMock := &sessionmock.ActorProvider{}
+	oauthClientProviderMock := &modelmock.OauthClientProvider{}
+	oauthConsentProviderMock := &modelmock.OauthConsentProvider{}
+	oauthCodeProviderMock := &modelmock.OauthAuthorizationCodeProvider{}
+	auditEventProviderMock := &modelmock.AuditEventProvider{}
+
+	h := deleteOAuthClientHandler{
+		handler: &handler{
+			logger:        zap.L(),
+			ActorProvider: actorProviderMock,
+			repository: repositories{
+				oauthClient:  oauthClientProviderMock,
+				oauthConsent: oauthConsentProviderMock,
+				oauthCode:    oauthCodeProviderMock,
+				auditEvent:   auditEventProviderMock,
+				transactor:   newTransactorMock(),
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		req  charonrpc.DeleteOAuthClientRequest
+		init func(*testing.T)
+		err  error
+	}{
+		"invalid-id": {
+			init: func(t *testing.T) {},
+			req:  charonrpc.DeleteOAuthClientRequest{},
+			err:  grpcerr.E(codes.InvalidArgument),
+		},
+		"missing-permission": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1}}, nil).
+					Once()
+			},
+			req: charonrpc.DeleteOAuthClientRequest{Id: 2},
+			err: grpcerr.E(codes.PermissionDenied),
+		},
+		"does-not-exists": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1, IsSuperuser: true}}, nil).
+					Once()
+				oauthClientProviderMock.On("FindOneByID", mock.Anything, int64(2)).
+					Return(nil, sql.ErrNoRows).
+					Once()
+			},
+			req: charonrpc.DeleteOAuthClientRequest{Id: 2},
+			err: grpcerr.E(codes.NotFound),
+		},
+		"removes-consents-and-codes": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{
+						User:        &model.UserEntity{ID: 1},
+						Permissions: charon.Permissions{charon.OAuthClientCanDelete},
+					}, nil).
+					Once()
+				oauthClientProviderMock.On("FindOneByID", mock.Anything, int64(2)).
+					Return(&model.OauthClientEntity{ID: 2}, nil).
+					Once()
+				oauthConsentProviderMock.On("DeleteByClientID", mock.Anything, int64(2)).
+					Return(int64(3), nil).
+					Once()
+				oauthCodeProviderMock.On("DeleteByClientID", mock.Anything, int64(2)).
+					Return(int64(0), nil).
+					Once()
+				oauthClientProviderMock.On("DeleteOneByID", mock.Anything, int64(2)).
+					Return(int64(1), nil).
+					Once()
+			},
+			req: charonrpc.DeleteOAuthClientRequest{Id: 2},
+		},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			actorProviderMock.ExpectedCalls = []*mock.Call{}
+			oauthClientProviderMock.ExpectedCalls = []*mock.Call{}
+			oauthConsentProviderMock.ExpectedCalls = []*mock.Call{}
+			oauthCodeProviderMock.ExpectedCalls = []*mock.Call{}
+			auditEventProviderMock.ExpectedCalls = []*mock.Call{}
+
+			c.init(t)
+			audit := auditEventProviderMock.On("Insert", mock.Anything, auditEventMatcher(model.AuditEventTargetOAuthClient, 2)).
+				Return(&model.AuditEventEntity{}, nil)
+			if c.err != nil {
+				audit.Maybe()
+			}
+
+			res, err := h.Delete(context.Background(), &c.req)
+			if c.err != nil {
+				if !grpcerr.Match(c.err, err) {
+					t.Fatalf("errors do not match, got '%v'", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !res.Value {
+				t.Error("oauth client expected to be removed")
+			}
+
+			mock.AssertExpectationsForObjects(t, actorProviderMock, oauthClientProviderMock, oauthConsentProviderMock, oauthCodeProviderMock, auditEventProviderMock)
+		})
+	}
+}
@@ -38,15 +38,42 @@ func (duh *deleteUserHandler) Delete(ctx context.Context, req *charonrpc.DeleteU
 		return nil, err
 	}
 
-	aff, err := duh.repository.user.DeleteOneByID(ctx, req.Id)
+	var (
+		aff   int64
+		entry = &auditEntry{
+			targetKind: model.AuditEventTargetUser,
+			targetID:   ent.ID,
+			before:     ent,
+		}
+	)
+	err = duh.audit(ctx, act, entry, func(ctx context.Context) error {
+		if req.Cascade {
+			if err := detachUser(ctx, duh.repository, req.Id); err != nil {
+				return err
+			}
+		}
+		if req.Soft {
+			after, err := duh.repository.user.SoftDeleteOneByID(ctx, req.Id)
+			if err != nil {
+				if err == sql.ErrNoRows {
+					entry.skip = true
+					return nil
+				}
+				return grpcerr.E(codes.Internal, "user cannot be soft deleted", err)
+			}
+			aff, entry.after = 1, after
+			return nil
+		}
+		aff, err = removeUser(ctx, duh.repository, req.Id)
+		return err
+	})
 	if err != nil {
-		switch model.ErrorConstraint(err) {
-		case model.TableUserGroupsConstraintUserIDForeignKey:
-			return nil, grpcerr.E(codes.FailedPrecondition, "user cannot be removed, groups are assigned to it")
-		case model.TableUserPermissionsConstraintUserIDForeignKey:
-			return nil, grpcerr.E(codes.FailedPrecondition, "user cannot be removed, permissions are assigned to it")
-		default:
-			return nil, grpcerr.E(codes.Internal, "user cannot be removed", err)
+		return nil, err
+	}
+	// Sessions are abandoned only once removal is committed, they cannot be brought back if it is rolled back.
+	if aff > 0 {
+		if err = duh.abandonUserSessions(ctx, req.Id); err != nil {
+			return nil, err
 		}
 	}
 
@@ -57,33 +84,94 @@ func (duh *deleteUserHandler) firewall(req *charonrpc.DeleteUserRequest, act *se
 	if act.User.ID == ent.ID {
 		return grpcerr.E(codes.PermissionDenied, "user is not permitted to remove himself")
 	}
+	return deletionFirewall(act, ent, "removed")
+}
+
+// deletionFirewall checks if actor is permitted to delete given user.
+// Restoration is guarded by the same permissions, operation is used in error messages only.
+func deletionFirewall(act *session.Actor, ent *model.UserEntity, operation string) error {
 	if act.User.IsSuperuser {
 		return nil
 	}
 	if ent.IsSuperuser {
-		return grpcerr.E(codes.PermissionDenied, "only superuser can remove other superuser")
+		return grpcerr.E(codes.PermissionDenied, "superuser can be "+operation+" only by other superuser")
 	}
 	if ent.IsStaff {
 		switch {
 		case act.User.ID == ent.CreatedBy.Int64Or(0):
-			if !act.Permissions.Contains(charon.UserCanDeleteStaffAsOwner) {
-				return grpcerr.E(codes.PermissionDenied, "staff user cannot be removed by owner, missing permission")
+			if !act.Permissions.Match(charon.UserCanDeleteStaffAsOwner) {
+				return grpcerr.E(codes.PermissionDenied, "staff user cannot be "+operation+" by owner, missing permission")
 			}
 			return nil
-		case !act.Permissions.Contains(charon.UserCanDeleteStaffAsStranger):
-			return grpcerr.E(codes.PermissionDenied, "staff user cannot be removed by stranger, missing permission")
+		case !act.Permissions.Match(charon.UserCanDeleteStaffAsStranger):
+			return grpcerr.E(codes.PermissionDenied, "staff user cannot be "+operation+" by stranger, missing permission")
 		}
 		return nil
 	}
 
 	if act.User.ID == ent.CreatedBy.Int64Or(0) {
-		if !act.Permissions.Contains(charon.UserCanDeleteAsOwner) {
-			return grpcerr.E(codes.PermissionDenied, "user cannot be removed by owner, missing permission")
+		if !act.Permissions.Match(charon.UserCanDeleteAsOwner) {
+			return grpcerr.E(codes.PermissionDenied, "user cannot be "+operation+" by owner, missing permission")
 		}
 		return nil
 	}
-	if !act.Permissions.Contains(charon.UserCanDeleteAsStranger) {
-		return grpcerr.E(codes.PermissionDenied, "user cannot be removed by stranger, missing permission")
+	if !act.Permissions.Match(charon.UserCanDeleteAsStranger) {
+		return grpcerr.E(codes.PermissionDenied, "user cannot be "+operation+" by stranger, missing permission")
 	}
 	return nil
 }
+
+// detachUser removes groups, permissions and refresh tokens of the user, it takes part in a transaction carried by the context.
+func detachUser(ctx context.Context, r repositories, id int64) error {
+	if _, err := r.userGroups.DeleteByUserID(ctx, id); err != nil {
+		return grpcerr.E(codes.Internal, "user groups removal failure", err)
+	}
+	if _, err := r.userPermissions.DeleteByUserID(ctx, id); err != nil {
+		return grpcerr.E(codes.Internal, "user permissions removal failure", err)
+	}
+	if _, err := r.userResources.DeleteByUserID(ctx, id); err != nil {
+		return grpcerr.E(codes.Internal, "user resource permissions removal failure", err)
+	}
+	if _, err := r.refreshToken.DeleteByUserID(ctx, id); err != nil {
+		return grpcerr.E(codes.Internal, "user refresh tokens removal failure", err)
+	}
+	return nil
+}
+
+// removeUser hard deletes the user, it takes part in a transaction carried by the context.
+// Consents, authorization codes, identities and API keys are meaningless without the user and are removed as well,
+// unlike groups, permissions or refresh tokens that prevent removal until detachUser is called.
+// Rows the user created or modified are kept, only references to the user as their author or modifier are cleared.
+func removeUser(ctx context.Context, r repositories, id int64) (int64, error) {
+	if _, err := r.oauthConsent.DeleteByUserID(ctx, id); err != nil {
+		return 0, grpcerr.E(codes.Internal, "user oauth consents removal failure", err)
+	}
+	if _, err := r.oauthCode.DeleteByUserID(ctx, id); err != nil {
+		return 0, grpcerr.E(codes.Internal, "user oauth authorization codes removal failure", err)
+	}
+	if _, err := r.userIdentity.DeleteByUserID(ctx, id); err != nil {
+		return 0, grpcerr.E(codes.Internal, "user identities removal failure", err)
+	}
+	if _, err := r.apiKey.DeleteByUserID(ctx, id); err != nil {
+		return 0, grpcerr.E(codes.Internal, "user api keys removal failure", err)
+	}
+	if _, err := r.user.Disown(ctx, id); err != nil {
+		return 0, grpcerr.E(codes.Internal, "user authorship removal failure", err)
+	}
+	aff, err := r.user.DeleteOneByID(ctx, id)
+	if err != nil {
+		switch model.ErrorConstraint(err) {
+		case model.TableUserGroupsConstraintUserIDForeignKey:
+			return 0, grpcerr.E(codes.FailedPrecondition, "user cannot be removed, groups are assigned to it")
+		case model.TableUserPermissionsConstraintUserIDForeignKey:
+			return 0, grpcerr.E(codes.FailedPrecondition, "user cannot be removed, permissions are assigned to it")
+		case model.TableUserResourcePermissionsConstraintUserIDForeignKey:
+			return 0, grpcerr.E(codes.FailedPrecondition, "user cannot be removed, resource permissions are assigned to it")
+		case model.TableRefreshTokenConstraintUserIDForeignKey:
+			return 0, grpcerr.E(codes.FailedPrecondition, "user cannot be removed, refresh tokens are issued for it")
+		default:
+			return 0, grpcerr.E(codes.Internal, "user cannot be removed", err)
+		}
+	}
+	return aff, nil
+}
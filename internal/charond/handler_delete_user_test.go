@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"math"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/lib/pq"
@@ -15,9 +16,12 @@ import (
 	"github.com/piotrkowalczuk/charon/internal/session"
 	"github.com/piotrkowalczuk/charon/internal/session/sessionmock"
 	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/piotrkowalczuk/mnemosyne/mnemosynerpc"
+	"github.com/piotrkowalczuk/mnemosyne/mnemosynetest"
 	"github.com/piotrkowalczuk/ntypes"
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestDeleteUserHandler_Delete_E2E(t *testing.T) {
@@ -34,12 +38,14 @@ func TestDeleteUserHandler_Delete_E2E(t *testing.T) {
 	_, users := suite.createUsers(t, timeout(ctx))
 	_, groups := suite.createGroups(t, timeout(ctx))
 
-	_, err = suite.charon.user.SetGroups(timeout(ctx), &charonrpc.SetUserGroupsRequest{
-		UserId: users[1],
-		Groups: groups[:len(groups)/2],
-	})
-	if err != nil {
-		t.Fatalf("unexpected error: %s", err.Error())
+	for _, id := range []int64{users[1], users[3]} {
+		_, err = suite.charon.user.SetGroups(timeout(ctx), &charonrpc.SetUserGroupsRequest{
+			UserId: id,
+			Groups: groups[:len(groups)/2],
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
 	}
 	cases := map[string]func(t *testing.T){
 		"not-assigned": func(t *testing.T) {
@@ -65,6 +71,48 @@ func TestDeleteUserHandler_Delete_E2E(t *testing.T) {
 			})
 			assertErrorCode(t, err, codes.FailedPrecondition, "user cannot be removed, groups are assigned to it")
 		},
+		"groups-assigned-with-cascade": func(t *testing.T) {
+			done, err := suite.charon.user.Delete(timeout(ctx), &charonrpc.DeleteUserRequest{
+				Id:      users[3],
+				Cascade: true,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if !done.Value {
+				t.Error("user expected to be removed")
+			}
+		},
+		"soft-deleted-and-restored": func(t *testing.T) {
+			done, err := suite.charon.user.Delete(timeout(ctx), &charonrpc.DeleteUserRequest{
+				Id:   users[2],
+				Soft: true,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if !done.Value {
+				t.Error("user expected to be soft deleted")
+			}
+			_, err = suite.charon.user.Get(timeout(ctx), &charonrpc.GetUserRequest{Id: users[2]})
+			assertErrorCode(t, err, codes.NotFound, "user does not exists")
+
+			got, err := suite.charon.user.Get(timeout(ctx), &charonrpc.GetUserRequest{Id: users[2], IncludeDeleted: true})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if got.User.DeletedAt == nil {
+				t.Error("deleted at expected to be set")
+			}
+
+			restored, err := suite.charon.user.Restore(timeout(ctx), &charonrpc.RestoreUserRequest{Id: users[2]})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if restored.User.DeletedAt != nil {
+				t.Error("deleted at expected to be cleared")
+			}
+		},
 		"permissions-assigned": func(t *testing.T) {
 			t.Skip("TODO: implement")
 
@@ -83,20 +131,59 @@ func TestDeleteUserHandler_Delete_E2E(t *testing.T) {
 func TestDeleteUserHandler_Delete_Unit(t *testing.T) {
 	actorProviderMock := &sessionmock.ActorProvider{}
 	userProviderMock := &modelmock.UserProvider{}
+	auditEventProviderMock := &modelmock.AuditEventProvider{}
+	oauthConsentProviderMock := &modelmock.OauthConsentProvider{}
+	oauthCodeProviderMock := &modelmock.OauthAuthorizationCodeProvider{}
+	userIdentityProviderMock := &modelmock.UserIdentityProvider{}
+	apiKeyProviderMock := &modelmock.APIKeyProvider{}
+	userGroupsProviderMock := &modelmock.UserGroupsProvider{}
+	userPermissionsProviderMock := &modelmock.UserPermissionsProvider{}
+	userResourcesProviderMock := &modelmock.UserResourcePermissionsProvider{}
+	refreshTokenProviderMock := &modelmock.RefreshTokenProvider{}
+	sessionMock := &mnemosynetest.SessionManagerClient{}
 
 	h := deleteUserHandler{
 		handler: &handler{
 			ActorProvider: actorProviderMock,
+			session:       sessionMock,
 			repository: repositories{
-				user: userProviderMock,
+				user:            userProviderMock,
+				auditEvent:      auditEventProviderMock,
+				oauthConsent:    oauthConsentProviderMock,
+				oauthCode:       oauthCodeProviderMock,
+				userIdentity:    userIdentityProviderMock,
+				apiKey:          apiKeyProviderMock,
+				userGroups:      userGroupsProviderMock,
+				userPermissions: userPermissionsProviderMock,
+				userResources:   userResourcesProviderMock,
+				refreshToken:    refreshTokenProviderMock,
+				transactor:      newTransactorMock(),
 			},
 		},
 	}
 
+	superuser := func() {
+		actorProviderMock.On("Actor", mock.Anything).
+			Return(&session.Actor{User: &model.UserEntity{ID: 11, IsSuperuser: true}}, nil).
+			Once()
+		userProviderMock.On("FindOneByID", mock.Anything, int64(10)).Return(&model.UserEntity{ID: 10}, nil).
+			Once()
+	}
+	detached := func(err error) {
+		userGroupsProviderMock.On("DeleteByUserID", mock.Anything, int64(10)).Return(int64(1), err).Once()
+		if err != nil {
+			return
+		}
+		userPermissionsProviderMock.On("DeleteByUserID", mock.Anything, int64(10)).Return(int64(1), nil).Once()
+		userResourcesProviderMock.On("DeleteByUserID", mock.Anything, int64(10)).Return(int64(0), nil).Once()
+		refreshTokenProviderMock.On("DeleteByUserID", mock.Anything, int64(10)).Return(int64(2), nil).Once()
+	}
+
 	cases := map[string]struct {
 		init func(*testing.T, *charonrpc.DeleteUserRequest)
 		req  charonrpc.DeleteUserRequest
 		err  error
+		done bool
 	}{
 		"invalid-id": {
 			init: func(_ *testing.T, _ *charonrpc.DeleteUserRequest) {},
@@ -241,9 +328,130 @@ func TestDeleteUserHandler_Delete_Unit(t *testing.T) {
 					Once()
 				userProviderMock.On("DeleteOneByID", mock.Anything, int64(10)).Return(int64(1), nil).
 					Once()
+				sessionMock.On("Delete", mock.Anything, &mnemosynerpc.DeleteRequest{SubjectId: "charon:user:10"}).
+					Return(&wrappers.Int64Value{Value: 2}, nil).
+					Once()
+				auditEventProviderMock.On("Insert", mock.Anything, auditEventMatcher(model.AuditEventTargetUser, int64(10))).
+					Return(&model.AuditEventEntity{}, nil).
+					Once()
 			},
 			req: charonrpc.DeleteUserRequest{Id: 10},
 		},
+		// Removal is committed first, sessions of a user that does not exist are of no use anyway.
+		"sessions-cannot-be-abandoned-after-removal": {
+			init: func(t *testing.T, r *charonrpc.DeleteUserRequest) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 11, IsSuperuser: true}}, nil).
+					Once()
+				userProviderMock.On("FindOneByID", mock.Anything, int64(10)).Return(&model.UserEntity{ID: 10}, nil).
+					Once()
+				userProviderMock.On("DeleteOneByID", mock.Anything, int64(10)).Return(int64(1), nil).
+					Once()
+				auditEventProviderMock.On("Insert", mock.Anything, auditEventMatcher(model.AuditEventTargetUser, int64(10))).
+					Return(&model.AuditEventEntity{}, nil).
+					Once()
+				sessionMock.On("Delete", mock.Anything, &mnemosynerpc.DeleteRequest{SubjectId: "charon:user:10"}).
+					Return(nil, status.Error(codes.Unavailable, "mnemosyne unavailable")).
+					Once()
+			},
+			req: charonrpc.DeleteUserRequest{Id: 10},
+			err: grpcerr.E(codes.Unavailable),
+		},
+		"sessions-are-not-abandoned-if-audit-fails": {
+			init: func(t *testing.T, r *charonrpc.DeleteUserRequest) {
+				superuser()
+				userProviderMock.On("SoftDeleteOneByID", mock.Anything, int64(10)).
+					Return(&model.UserEntity{ID: 10, DeletedAt: pq.NullTime{Time: time.Now(), Valid: true}}, nil).
+					Once()
+				auditEventProviderMock.On("Insert", mock.Anything, auditEventMatcher(model.AuditEventTargetUser, int64(10))).
+					Return(nil, sql.ErrConnDone).
+					Once()
+				sessionMock.On("Delete", mock.Anything, mock.Anything).
+					Run(func(mock.Arguments) { t.Error("sessions should not be abandoned if deletion is rolled back") }).
+					Return(&wrappers.Int64Value{}, nil).
+					Maybe()
+			},
+			req: charonrpc.DeleteUserRequest{Id: 10, Soft: true},
+			err: grpcerr.E(codes.Internal),
+		},
+		"cannot-remove-if-authorship-cannot-be-removed": {
+			init: func(t *testing.T, r *charonrpc.DeleteUserRequest) {
+				superuser()
+				userProviderMock.On("Disown", mock.Anything, int64(10)).Return(int64(0), sql.ErrConnDone).
+					Once()
+			},
+			req: charonrpc.DeleteUserRequest{Id: 10},
+			err: grpcerr.E(codes.Internal),
+		},
+		"can-soft-delete": {
+			init: func(t *testing.T, r *charonrpc.DeleteUserRequest) {
+				superuser()
+				userProviderMock.On("SoftDeleteOneByID", mock.Anything, int64(10)).
+					Return(&model.UserEntity{ID: 10, DeletedAt: pq.NullTime{Time: time.Now(), Valid: true}}, nil).
+					Once()
+				sessionMock.On("Delete", mock.Anything, &mnemosynerpc.DeleteRequest{SubjectId: "charon:user:10"}).
+					Return(&wrappers.Int64Value{Value: 1}, nil).
+					Once()
+				auditEventProviderMock.On("Insert", mock.Anything, auditEventMatcher(model.AuditEventTargetUser, int64(10))).
+					Return(&model.AuditEventEntity{}, nil).
+					Once()
+			},
+			req:  charonrpc.DeleteUserRequest{Id: 10, Soft: true},
+			done: true,
+		},
+		"soft-delete-of-deleted-user-does-nothing": {
+			init: func(t *testing.T, r *charonrpc.DeleteUserRequest) {
+				superuser()
+				userProviderMock.On("SoftDeleteOneByID", mock.Anything, int64(10)).Return(nil, sql.ErrNoRows).
+					Once()
+			},
+			req: charonrpc.DeleteUserRequest{Id: 10, Soft: true},
+		},
+		"can-remove-with-cascade": {
+			init: func(t *testing.T, r *charonrpc.DeleteUserRequest) {
+				superuser()
+				detached(nil)
+				userProviderMock.On("DeleteOneByID", mock.Anything, int64(10)).Return(int64(1), nil).
+					Once()
+				auditEventProviderMock.On("Insert", mock.Anything, auditEventMatcher(model.AuditEventTargetUser, int64(10))).
+					Return(&model.AuditEventEntity{}, nil).
+					Once()
+			},
+			req:  charonrpc.DeleteUserRequest{Id: 10, Cascade: true},
+			done: true,
+		},
+		"can-soft-delete-with-cascade": {
+			init: func(t *testing.T, r *charonrpc.DeleteUserRequest) {
+				superuser()
+				detached(nil)
+				userProviderMock.On("SoftDeleteOneByID", mock.Anything, int64(10)).
+					Return(&model.UserEntity{ID: 10, DeletedAt: pq.NullTime{Time: time.Now(), Valid: true}}, nil).
+					Once()
+				auditEventProviderMock.On("Insert", mock.Anything, auditEventMatcher(model.AuditEventTargetUser, int64(10))).
+					Return(&model.AuditEventEntity{}, nil).
+					Once()
+			},
+			req:  charonrpc.DeleteUserRequest{Id: 10, Soft: true, Cascade: true},
+			done: true,
+		},
+		"cannot-remove-with-cascade-if-groups-cannot-be-removed": {
+			init: func(t *testing.T, r *charonrpc.DeleteUserRequest) {
+				superuser()
+				detached(context.DeadlineExceeded)
+			},
+			req: charonrpc.DeleteUserRequest{Id: 10, Cascade: true},
+			err: grpcerr.E(codes.DeadlineExceeded),
+		},
+		"cannot-remove-if-user-have-refresh-tokens": {
+			init: func(t *testing.T, r *charonrpc.DeleteUserRequest) {
+				superuser()
+				userProviderMock.On("DeleteOneByID", mock.Anything, int64(10)).Return(int64(0), &pq.Error{
+					Constraint: model.TableRefreshTokenConstraintUserIDForeignKey,
+				}).Once()
+			},
+			req: charonrpc.DeleteUserRequest{Id: 10},
+			err: grpcerr.E(codes.FailedPrecondition),
+		},
 		"cannot-remove-as-stranger": {
 			init: func(t *testing.T, r *charonrpc.DeleteUserRequest) {
 				actorProviderMock.On("Actor", mock.Anything).
@@ -433,6 +641,9 @@ func TestDeleteUserHandler_Delete_Unit(t *testing.T) {
 				userProviderMock.On("DeleteOneByID", mock.Anything, int64(11)).
 					Return(int64(1), nil).
 					Once()
+				auditEventProviderMock.On("Insert", mock.Anything, auditEventMatcher(model.AuditEventTargetUser, int64(11))).
+					Return(&model.AuditEventEntity{}, nil).
+					Once()
 			},
 			req: charonrpc.DeleteUserRequest{Id: 11},
 		},
@@ -455,6 +666,9 @@ func TestDeleteUserHandler_Delete_Unit(t *testing.T) {
 				userProviderMock.On("DeleteOneByID", mock.Anything, int64(11)).
 					Return(int64(1), nil).
 					Once()
+				auditEventProviderMock.On("Insert", mock.Anything, auditEventMatcher(model.AuditEventTargetUser, int64(11))).
+					Return(&model.AuditEventEntity{}, nil).
+					Once()
 			},
 			req: charonrpc.DeleteUserRequest{Id: 11},
 		},
@@ -476,6 +690,9 @@ func TestDeleteUserHandler_Delete_Unit(t *testing.T) {
 				userProviderMock.On("DeleteOneByID", mock.Anything, int64(11)).
 					Return(int64(1), nil).
 					Once()
+				auditEventProviderMock.On("Insert", mock.Anything, auditEventMatcher(model.AuditEventTargetUser, int64(11))).
+					Return(&model.AuditEventEntity{}, nil).
+					Once()
 			},
 			req: charonrpc.DeleteUserRequest{Id: 11},
 		},
@@ -486,19 +703,42 @@ func TestDeleteUserHandler_Delete_Unit(t *testing.T) {
 			// reset mocks between cases
 			actorProviderMock.ExpectedCalls = []*mock.Call{}
 			userProviderMock.ExpectedCalls = []*mock.Call{}
+			auditEventProviderMock.ExpectedCalls = []*mock.Call{}
+			sessionMock.ExpectedCalls = []*mock.Call{}
+			userGroupsProviderMock.ExpectedCalls = []*mock.Call{}
+			userPermissionsProviderMock.ExpectedCalls = []*mock.Call{}
+			userResourcesProviderMock.ExpectedCalls = []*mock.Call{}
+			refreshTokenProviderMock.ExpectedCalls = []*mock.Call{}
+			oauthConsentProviderMock.On("DeleteByUserID", mock.Anything, c.req.Id).Return(int64(0), nil).Maybe()
+			oauthCodeProviderMock.On("DeleteByUserID", mock.Anything, c.req.Id).Return(int64(0), nil).Maybe()
+			userIdentityProviderMock.On("DeleteByUserID", mock.Anything, c.req.Id).Return(int64(0), nil).Maybe()
+			apiKeyProviderMock.On("DeleteByUserID", mock.Anything, c.req.Id).Return(int64(0), nil).Maybe()
 
 			c.init(t, &c.req)
+			sessionMock.On("Delete", mock.Anything, mock.Anything).Return(&wrappers.Int64Value{}, nil).Maybe()
+			userProviderMock.On("Disown", mock.Anything, c.req.Id).Return(int64(1), nil).Maybe()
 
-			_, err := h.Delete(context.Background(), &c.req)
+			res, err := h.Delete(context.Background(), &c.req)
 			if c.err != nil {
 				if !grpcerr.Match(c.err, err) {
 					t.Fatalf("errors do not match, got '%v'", err)
 				}
 			} else if err != nil {
 				t.Fatal(err)
+			} else if c.done && !res.Value {
+				t.Error("user expected to be removed")
 			}
 
-			mock.AssertExpectationsForObjects(t, actorProviderMock, userProviderMock)
+			mock.AssertExpectationsForObjects(t,
+				actorProviderMock,
+				userProviderMock,
+				auditEventProviderMock,
+				sessionMock,
+				userGroupsProviderMock,
+				userPermissionsProviderMock,
+				userResourcesProviderMock,
+				refreshTokenProviderMock,
+			)
 		})
 	}
 }
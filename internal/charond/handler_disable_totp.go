@@ -0,0 +1,98 @@
+package charond
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/lib/pq"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/service"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+
+	"google.golang.org/grpc/codes"
+)
+
+type disableTOTPHandler struct {
+	*handler
+}
+
+// DisableTOTP turns two-factor authentication off.
+// Users can do it themselves, if they prove possession of the second factor.
+// Superuser can do it for any other user, e.g. if the second factor was lost.
+func (dth *disableTOTPHandler) DisableTOTP(ctx context.Context, req *charonrpc.DisableTOTPRequest) (*wrappers.BoolValue, error) {
+	if req.UserId < 0 {
+		return nil, grpcerr.E(codes.InvalidArgument, "two-factor authentication cannot be disabled, invalid user id")
+	}
+
+	act, err := dth.Actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ent := act.User
+	if req.UserId != 0 && req.UserId != act.User.ID {
+		if ent, err = dth.repository.user.FindOneByID(ctx, req.UserId); err != nil {
+			if err == sql.ErrNoRows {
+				return nil, grpcerr.E(codes.NotFound, "user does not exists")
+			}
+			return nil, grpcerr.E(codes.Internal, "user retrieval failure", err)
+		}
+	}
+	// Code verified by the firewall is used up and two-factor authentication gets disabled,
+	// cached actors would be stale either way.
+	defer dth.invalidateActor(ent.ID)
+
+	if err = dth.firewall(ctx, req, act, ent); err != nil {
+		return nil, err
+	}
+
+	var (
+		aff      int64
+		disabled = *ent
+		entry    = &auditEntry{
+			targetKind: model.AuditEventTargetUser,
+			targetID:   ent.ID,
+			before:     ent,
+		}
+	)
+	err = dth.audit(ctx, act, entry, func(ctx context.Context) error {
+		if aff, err = dth.repository.user.DisableTwoFactor(ctx, ent.ID); err != nil {
+			return grpcerr.E(codes.Internal, "two-factor authentication cannot be disabled", err)
+		}
+		disabled.TwoFactorConfirmedAt = pq.NullTime{}
+		entry.after = &disabled
+		entry.skip = aff == 0
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &wrappers.BoolValue{Value: aff > 0}, nil
+}
+
+func (dth *disableTOTPHandler) firewall(ctx context.Context, req *charonrpc.DisableTOTPRequest, act *session.Actor, ent *model.UserEntity) error {
+	if act.User.ID == ent.ID {
+		if ent.ID == 0 {
+			return grpcerr.E(codes.PermissionDenied, "two-factor authentication can be disabled only by authenticated user")
+		}
+		if !ent.TwoFactorConfirmedAt.Valid {
+			return nil
+		}
+		ok, err := service.VerifySecondFactor(ctx, dth.repository.user, ent, req.Code)
+		if err != nil {
+			return grpcerr.E(codes.Internal, "second factor verification failure", err)
+		}
+		if !ok {
+			return grpcerr.E(codes.PermissionDenied, "two-factor authentication cannot be disabled, invalid second factor code")
+		}
+		return nil
+	}
+	if !act.User.IsSuperuser {
+		return grpcerr.E(codes.PermissionDenied, "two-factor authentication of other user can be disabled only by superuser")
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+package charond
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/model/modelmock"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	"github.com/piotrkowalczuk/charon/internal/session/sessionmock"
+	"github.com/piotrkowalczuk/charon/internal/totp"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+)
+
+func TestDisableTOTPHandler_DisableTOTP_Unit(t *testing.T) {
+	actorProviderMock := &sessionmock.ActorProvider{}
+	userProviderMock := &modelmock.UserProvider{}
+	auditEventProviderMock := &modelmock.AuditEventProvider{}
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	code := totp.Code(secret, totp.Step(time.Now()))
+	enabled := func(id int64) *model.UserEntity {
+		return &model.UserEntity{
+			ID:                   id,
+			TwoFactorSecret:      secret,
+			TwoFactorConfirmedAt: pq.NullTime{Time: time.Now(), Valid: true},
+		}
+	}
+
+	h := disableTOTPHandler{
+		handler: &handler{
+			ActorProvider: actorProviderMock,
+			repository: repositories{
+				user:       userProviderMock,
+				auditEvent: auditEventProviderMock,
+				transactor: newTransactorMock(),
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		init func(*testing.T)
+		req  charonrpc.DisableTOTPRequest
+		err  error
+	}{
+		"invalid-user-id": {
+			init: func(t *testing.T) {},
+			req:  charonrpc.DisableTOTPRequest{UserId: -1},
+			err:  grpcerr.E(codes.InvalidArgument),
+		},
+		"local": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{}, IsLocal: true}, nil).
+					Once()
+			},
+			err: grpcerr.E(codes.PermissionDenied),
+		},
+		"self-without-code": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: enabled(1)}, nil).
+					Once()
+			},
+			err: grpcerr.E(codes.PermissionDenied),
+		},
+		"self-code-used-already": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: enabled(1)}, nil).
+					Once()
+				userProviderMock.On("UseTwoFactorStep", mock.Anything, int64(1), mock.Anything).
+					Return(int64(0), nil).
+					Once()
+			},
+			req: charonrpc.DisableTOTPRequest{Code: code},
+			err: grpcerr.E(codes.PermissionDenied),
+		},
+		"self": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: enabled(1)}, nil).
+					Once()
+				userProviderMock.On("UseTwoFactorStep", mock.Anything, int64(1), mock.Anything).
+					Return(int64(1), nil).
+					Once()
+				userProviderMock.On("DisableTwoFactor", mock.Anything, int64(1)).
+					Return(int64(1), nil).
+					Once()
+				auditEventProviderMock.On("Insert", mock.Anything, auditEventMatcher(model.AuditEventTargetUser, 1)).
+					Return(&model.AuditEventEntity{}, nil).
+					Once()
+			},
+			req: charonrpc.DisableTOTPRequest{Code: code},
+		},
+		"self-enrolment-not-confirmed": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1, TwoFactorSecret: secret}}, nil).
+					Once()
+				userProviderMock.On("DisableTwoFactor", mock.Anything, int64(1)).
+					Return(int64(1), nil).
+					Once()
+				auditEventProviderMock.On("Insert", mock.Anything, auditEventMatcher(model.AuditEventTargetUser, 1)).
+					Return(&model.AuditEventEntity{}, nil).
+					Once()
+			},
+		},
+		"other-as-stranger": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1}}, nil).
+					Once()
+				userProviderMock.On("FindOneByID", mock.Anything, int64(2)).
+					Return(enabled(2), nil).
+					Once()
+			},
+			req: charonrpc.DisableTOTPRequest{UserId: 2},
+			err: grpcerr.E(codes.PermissionDenied),
+		},
+		"other-not-found": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1, IsSuperuser: true}}, nil).
+					Once()
+				userProviderMock.On("FindOneByID", mock.Anything, int64(2)).
+					Return(nil, sql.ErrNoRows).
+					Once()
+			},
+			req: charonrpc.DisableTOTPRequest{UserId: 2},
+			err: grpcerr.E(codes.NotFound),
+		},
+		"other-as-superuser": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1, IsSuperuser: true}}, nil).
+					Once()
+				userProviderMock.On("FindOneByID", mock.Anything, int64(2)).
+					Return(enabled(2), nil).
+					Once()
+				userProviderMock.On("DisableTwoFactor", mock.Anything, int64(2)).
+					Return(int64(1), nil).
+					Once()
+				auditEventProviderMock.On("Insert", mock.Anything, auditEventMatcher(model.AuditEventTargetUser, 2)).
+					Return(&model.AuditEventEntity{}, nil).
+					Once()
+			},
+			req: charonrpc.DisableTOTPRequest{UserId: 2},
+		},
+		"query-timeout": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1, IsSuperuser: true}}, nil).
+					Once()
+				userProviderMock.On("FindOneByID", mock.Anything, int64(2)).
+					Return(enabled(2), nil).
+					Once()
+				userProviderMock.On("DisableTwoFactor", mock.Anything, int64(2)).
+					Return(int64(0), context.DeadlineExceeded).
+					Once()
+			},
+			req: charonrpc.DisableTOTPRequest{UserId: 2},
+			err: grpcerr.E(codes.DeadlineExceeded),
+		},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			defer recoverTest(t)
+
+			actorProviderMock.ExpectedCalls = nil
+			userProviderMock.ExpectedCalls = nil
+			auditEventProviderMock.ExpectedCalls = nil
+
+			c.init(t)
+
+			_, err := h.DisableTOTP(context.Background(), &c.req)
+			assertError(t, c.err, err)
+
+			mock.AssertExpectationsForObjects(t, actorProviderMock, userProviderMock, auditEventProviderMock)
+		})
+	}
+}
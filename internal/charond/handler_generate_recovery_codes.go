@@ -0,0 +1,72 @@
+package charond
+
+import (
+	"context"
+
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/service"
+	"github.com/piotrkowalczuk/charon/internal/totp"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+
+	"google.golang.org/grpc/codes"
+)
+
+type generateRecoveryCodesHandler struct {
+	*handler
+}
+
+// GenerateRecoveryCodes replaces all recovery codes of the current user with new ones.
+func (grch *generateRecoveryCodesHandler) GenerateRecoveryCodes(ctx context.Context, req *charonrpc.GenerateRecoveryCodesRequest) (*charonrpc.GenerateRecoveryCodesResponse, error) {
+	if req.Code == "" {
+		return nil, grpcerr.E(codes.InvalidArgument, "recovery codes cannot be generated, empty code")
+	}
+
+	act, err := grch.Actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if act.User.ID == 0 {
+		return nil, grpcerr.E(codes.PermissionDenied, "recovery codes can be generated only by authenticated user")
+	}
+	if !act.User.TwoFactorConfirmedAt.Valid {
+		return nil, grpcerr.E(codes.FailedPrecondition, "two-factor authentication is not enabled")
+	}
+
+	// Verified code is used up and recovery codes are replaced, cached actor would be stale either way.
+	defer grch.invalidateActor(act.User.ID)
+
+	ok, err := service.VerifySecondFactor(ctx, grch.repository.user, act.User, req.Code)
+	if err != nil {
+		return nil, grpcerr.E(codes.Internal, "second factor verification failure", err)
+	}
+	if !ok {
+		return nil, grpcerr.E(codes.PermissionDenied, "recovery codes cannot be generated, invalid second factor code")
+	}
+
+	recoveryCodes, hashes, err := totp.GenerateRecoveryCodes(recoveryCodesNumber)
+	if err != nil {
+		return nil, grpcerr.E(codes.Internal, "recovery codes generation failure", err)
+	}
+	entry := &auditEntry{
+		targetKind: model.AuditEventTargetUser,
+		targetID:   act.User.ID,
+		after:      map[string]interface{}{"recovery_codes": len(recoveryCodes)},
+	}
+	err = grch.audit(ctx, act, entry, func(ctx context.Context) error {
+		// Previous codes are compared, so concurrent use of any of them makes this call fail.
+		aff, err := grch.repository.user.ReplaceTwoFactorRecoveryCodes(ctx, act.User.ID, act.User.TwoFactorRecoveryCodes, hashes)
+		if err != nil {
+			return grpcerr.E(codes.Internal, "recovery codes persistence failure", err)
+		}
+		if aff == 0 {
+			return grpcerr.E(codes.Aborted, "recovery codes were modified concurrently")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &charonrpc.GenerateRecoveryCodesResponse{RecoveryCodes: recoveryCodes}, nil
+}
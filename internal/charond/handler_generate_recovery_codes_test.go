@@ -0,0 +1,159 @@
+package charond
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/model/modelmock"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	"github.com/piotrkowalczuk/charon/internal/session/sessionmock"
+	"github.com/piotrkowalczuk/charon/internal/totp"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+)
+
+func TestGenerateRecoveryCodesHandler_GenerateRecoveryCodes_Unit(t *testing.T) {
+	actorProviderMock := &sessionmock.ActorProvider{}
+	userProviderMock := &modelmock.UserProvider{}
+	auditEventProviderMock := &modelmock.AuditEventProvider{}
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	recoveryCodes, hashes, err := totp.GenerateRecoveryCodes(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	left, _ := totp.MatchRecoveryCode(hashes, recoveryCodes[0])
+	code := totp.Code(secret, totp.Step(time.Now()))
+	enabled := func() *model.UserEntity {
+		return &model.UserEntity{
+			ID:                     1,
+			TwoFactorSecret:        secret,
+			TwoFactorConfirmedAt:   pq.NullTime{Time: time.Now(), Valid: true},
+			TwoFactorRecoveryCodes: hashes,
+		}
+	}
+
+	h := generateRecoveryCodesHandler{
+		handler: &handler{
+			ActorProvider: actorProviderMock,
+			repository: repositories{
+				user:       userProviderMock,
+				auditEvent: auditEventProviderMock,
+				transactor: newTransactorMock(),
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		init func(*testing.T)
+		req  charonrpc.GenerateRecoveryCodesRequest
+		err  error
+	}{
+		"empty-code": {
+			init: func(t *testing.T) {},
+			err:  grpcerr.E(codes.InvalidArgument),
+		},
+		"local": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{}, IsLocal: true}, nil).
+					Once()
+			},
+			req: charonrpc.GenerateRecoveryCodesRequest{Code: code},
+			err: grpcerr.E(codes.PermissionDenied),
+		},
+		"not-enabled": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1, TwoFactorSecret: secret}}, nil).
+					Once()
+			},
+			req: charonrpc.GenerateRecoveryCodesRequest{Code: code},
+			err: grpcerr.E(codes.FailedPrecondition),
+		},
+		"invalid-code": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: enabled()}, nil).
+					Once()
+			},
+			req: charonrpc.GenerateRecoveryCodesRequest{Code: "abcdef"},
+			err: grpcerr.E(codes.PermissionDenied),
+		},
+		"modified-concurrently": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: enabled()}, nil).
+					Once()
+				userProviderMock.On("UseTwoFactorStep", mock.Anything, int64(1), mock.Anything).
+					Return(int64(1), nil).
+					Once()
+				userProviderMock.On("ReplaceTwoFactorRecoveryCodes", mock.Anything, int64(1), hashes, mock.Anything).
+					Return(int64(0), nil).
+					Once()
+			},
+			req: charonrpc.GenerateRecoveryCodesRequest{Code: code},
+			err: grpcerr.E(codes.Aborted),
+		},
+		"using-code": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: enabled()}, nil).
+					Once()
+				userProviderMock.On("UseTwoFactorStep", mock.Anything, int64(1), mock.Anything).
+					Return(int64(1), nil).
+					Once()
+				userProviderMock.On("ReplaceTwoFactorRecoveryCodes", mock.Anything, int64(1), hashes, mock.Anything).
+					Return(int64(1), nil).
+					Once()
+			},
+			req: charonrpc.GenerateRecoveryCodesRequest{Code: code},
+		},
+		"using-recovery-code": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: enabled()}, nil).
+					Once()
+				userProviderMock.On("ReplaceTwoFactorRecoveryCodes", mock.Anything, int64(1), hashes, left).
+					Return(int64(1), nil).
+					Once()
+				userProviderMock.On("ReplaceTwoFactorRecoveryCodes", mock.Anything, int64(1), left, mock.Anything).
+					Return(int64(1), nil).
+					Once()
+			},
+			req: charonrpc.GenerateRecoveryCodesRequest{Code: recoveryCodes[0]},
+		},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			defer recoverTest(t)
+
+			actorProviderMock.ExpectedCalls = nil
+			userProviderMock.ExpectedCalls = nil
+			auditEventProviderMock.ExpectedCalls = nil
+
+			c.init(t)
+			audit := auditEventProviderMock.On("Insert", mock.Anything, auditEventMatcher(model.AuditEventTargetUser, 1)).
+				Return(&model.AuditEventEntity{}, nil)
+			if c.err != nil {
+				audit.Maybe()
+			}
+
+			res, err := h.GenerateRecoveryCodes(context.Background(), &c.req)
+			assertError(t, c.err, err)
+			if c.err == nil && len(res.RecoveryCodes) != recoveryCodesNumber {
+				t.Errorf("wrong number of recovery codes, expected %d but got %d", recoveryCodesNumber, len(res.RecoveryCodes))
+			}
+
+			mock.AssertExpectationsForObjects(t, actorProviderMock, userProviderMock, auditEventProviderMock)
+		})
+	}
+}
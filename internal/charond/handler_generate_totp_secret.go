@@ -0,0 +1,49 @@
+package charond
+
+import (
+	"context"
+
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/totp"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+
+	"google.golang.org/grpc/codes"
+)
+
+type generateTOTPSecretHandler struct {
+	*handler
+	issuer string
+}
+
+// GenerateTOTPSecret starts two-factor authentication enrolment of the current user.
+// Generated secret replaces any previous one, until it is confirmed using ConfirmTOTP.
+func (gtsh *generateTOTPSecretHandler) GenerateTOTPSecret(ctx context.Context, req *charonrpc.GenerateTOTPSecretRequest) (*charonrpc.GenerateTOTPSecretResponse, error) {
+	act, err := gtsh.Actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if act.User.ID == 0 {
+		return nil, grpcerr.E(codes.PermissionDenied, "two-factor authentication can be enabled only by authenticated user")
+	}
+	if act.User.TwoFactorConfirmedAt.Valid {
+		return nil, grpcerr.E(codes.FailedPrecondition, "two-factor authentication is already enabled")
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, grpcerr.E(codes.Internal, "secret generation failure", err)
+	}
+	aff, err := gtsh.repository.user.SetTwoFactorSecret(ctx, act.User.ID, secret)
+	if err != nil {
+		return nil, grpcerr.E(codes.Internal, "secret persistence failure", err)
+	}
+	if aff == 0 {
+		return nil, grpcerr.E(codes.FailedPrecondition, "two-factor authentication is already enabled")
+	}
+	gtsh.invalidateActor(act.User.ID)
+
+	return &charonrpc.GenerateTOTPSecretResponse{
+		Secret: totp.EncodeSecret(secret),
+		Uri:    totp.URI(gtsh.issuer, act.User.Username, secret),
+	}, nil
+}
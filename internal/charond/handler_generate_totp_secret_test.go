@@ -0,0 +1,126 @@
+package charond
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/model/modelmock"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	"github.com/piotrkowalczuk/charon/internal/session/sessionmock"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+)
+
+func TestGenerateTOTPSecretHandler_GenerateTOTPSecret_Unit(t *testing.T) {
+	actorProviderMock := &sessionmock.ActorProvider{}
+	userProviderMock := &modelmock.UserProvider{}
+
+	h := generateTOTPSecretHandler{
+		handler: &handler{
+			ActorProvider: actorProviderMock,
+			repository: repositories{
+				user: userProviderMock,
+			},
+		},
+		issuer: "charon",
+	}
+
+	cases := map[string]struct {
+		init func(*testing.T)
+		err  error
+	}{
+		"actor-not-found": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(nil, grpcerr.E(codes.Unauthenticated, "actor not found")).
+					Once()
+			},
+			err: grpcerr.E(codes.Unauthenticated),
+		},
+		"local": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{}, IsLocal: true}, nil).
+					Once()
+			},
+			err: grpcerr.E(codes.PermissionDenied),
+		},
+		"already-enabled": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{
+						ID:                   1,
+						TwoFactorConfirmedAt: pq.NullTime{Time: time.Now(), Valid: true},
+					}}, nil).
+					Once()
+			},
+			err: grpcerr.E(codes.FailedPrecondition),
+		},
+		"enabled-concurrently": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1}}, nil).
+					Once()
+				userProviderMock.On("SetTwoFactorSecret", mock.Anything, int64(1), mock.Anything).
+					Return(int64(0), nil).
+					Once()
+			},
+			err: grpcerr.E(codes.FailedPrecondition),
+		},
+		"query-timeout": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1}}, nil).
+					Once()
+				userProviderMock.On("SetTwoFactorSecret", mock.Anything, int64(1), mock.Anything).
+					Return(int64(0), context.DeadlineExceeded).
+					Once()
+			},
+			err: grpcerr.E(codes.DeadlineExceeded),
+		},
+		"success": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1, Username: "john@example.com"}}, nil).
+					Once()
+				userProviderMock.On("SetTwoFactorSecret", mock.Anything, int64(1), mock.Anything).
+					Return(int64(1), nil).
+					Once()
+			},
+		},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			defer recoverTest(t)
+
+			actorProviderMock.ExpectedCalls = nil
+			userProviderMock.ExpectedCalls = nil
+
+			c.init(t)
+
+			res, err := h.GenerateTOTPSecret(context.Background(), &charonrpc.GenerateTOTPSecretRequest{})
+			assertError(t, c.err, err)
+			if c.err == nil {
+				u, err := url.Parse(res.Uri)
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err.Error())
+				}
+				if u.Query().Get("secret") != res.Secret {
+					t.Errorf("uri secret does not match, expected %s but got %s", res.Secret, u.Query().Get("secret"))
+				}
+				if u.Query().Get("issuer") != "charon" {
+					t.Errorf("wrong issuer: %s", u.Query().Get("issuer"))
+				}
+			}
+
+			mock.AssertExpectationsForObjects(t, actorProviderMock, userProviderMock)
+		})
+	}
+}
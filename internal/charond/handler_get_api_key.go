@@ -0,0 +1,64 @@
+package charond
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/mapping"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"google.golang.org/grpc/codes"
+)
+
+type getAPIKeyHandler struct {
+	*handler
+}
+
+func (gakh *getAPIKeyHandler) Get(ctx context.Context, req *charonrpc.GetAPIKeyRequest) (*charonrpc.GetAPIKeyResponse, error) {
+	if req.Id <= 0 {
+		return nil, grpcerr.E(codes.InvalidArgument, "missing api key id")
+	}
+	act, err := gakh.Actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ent, err := gakh.repository.apiKey.FindOneByID(ctx, req.Id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, grpcerr.E(codes.NotFound, "api key does not exists")
+		}
+		return nil, grpcerr.E(codes.Internal, "api key cannot be fetched", err)
+	}
+	if err = gakh.firewall(act, ent); err != nil {
+		return nil, err
+	}
+
+	msg, err := mapping.ReverseAPIKey(ent)
+	if err != nil {
+		return nil, grpcerr.E(codes.Internal, "api key entity mapping failure", err)
+	}
+	return &charonrpc.GetAPIKeyResponse{
+		ApiKey: msg,
+	}, nil
+}
+
+func (gakh *getAPIKeyHandler) firewall(act *session.Actor, ent *model.APIKeyEntity) error {
+	if act.User.IsSuperuser {
+		return nil
+	}
+	if act.Permissions.Match(charon.APIKeyCanRetrieveAsStranger) {
+		return nil
+	}
+	if act.Permissions.Match(charon.APIKeyCanRetrieveAsOwner) {
+		if act.User.ID == ent.UserID {
+			return nil
+		}
+		return grpcerr.E(codes.PermissionDenied, "api key cannot be retrieved by stranger, missing permission")
+	}
+
+	return grpcerr.E(codes.PermissionDenied, "api key cannot be retrieved, missing permission")
+}
@@ -0,0 +1,123 @@
+package charond
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/model/modelmock"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	"github.com/piotrkowalczuk/charon/internal/session/sessionmock"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+)
+
+func TestGetAPIKeyHandler_Get_Unit(t *testing.T) {
+	actorProviderMock := &sessionmock.ActorProvider{}
+	apiKeyProviderMock := &modelmock.APIKeyProvider{}
+
+	h := getAPIKeyHandler{
+		handler: &handler{
+			ActorProvider: actorProviderMock,
+			repository: repositories{
+				apiKey: apiKeyProviderMock,
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		req  charonrpc.GetAPIKeyRequest
+		init func(*testing.T)
+		err  error
+	}{
+		"invalid-id": {
+			init: func(t *testing.T) {},
+			req:  charonrpc.GetAPIKeyRequest{},
+			err:  grpcerr.E(codes.InvalidArgument),
+		},
+		"does-not-exists": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1, IsSuperuser: true}}, nil).
+					Once()
+				apiKeyProviderMock.On("FindOneByID", mock.Anything, int64(10)).
+					Return(nil, sql.ErrNoRows).
+					Once()
+			},
+			req: charonrpc.GetAPIKeyRequest{Id: 10},
+			err: grpcerr.E(codes.NotFound),
+		},
+		"as-owner": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{
+						User:        &model.UserEntity{ID: 1},
+						Permissions: charon.Permissions{charon.APIKeyCanRetrieveAsOwner},
+					}, nil).
+					Once()
+				apiKeyProviderMock.On("FindOneByID", mock.Anything, int64(10)).
+					Return(&model.APIKeyEntity{ID: 10, UserID: 1}, nil).
+					Once()
+			},
+			req: charonrpc.GetAPIKeyRequest{Id: 10},
+		},
+		"as-owner-of-stranger": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{
+						User:        &model.UserEntity{ID: 1},
+						Permissions: charon.Permissions{charon.APIKeyCanRetrieveAsOwner},
+					}, nil).
+					Once()
+				apiKeyProviderMock.On("FindOneByID", mock.Anything, int64(10)).
+					Return(&model.APIKeyEntity{ID: 10, UserID: 2}, nil).
+					Once()
+			},
+			req: charonrpc.GetAPIKeyRequest{Id: 10},
+			err: grpcerr.E(codes.PermissionDenied),
+		},
+		"as-stranger": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{
+						User:        &model.UserEntity{ID: 1},
+						Permissions: charon.Permissions{charon.APIKeyCanRetrieveAsStranger},
+					}, nil).
+					Once()
+				apiKeyProviderMock.On("FindOneByID", mock.Anything, int64(10)).
+					Return(&model.APIKeyEntity{ID: 10, UserID: 2}, nil).
+					Once()
+			},
+			req: charonrpc.GetAPIKeyRequest{Id: 10},
+		},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			actorProviderMock.ExpectedCalls = []*mock.Call{}
+			apiKeyProviderMock.ExpectedCalls = []*mock.Call{}
+
+			c.init(t)
+
+			res, err := h.Get(context.Background(), &c.req)
+			if c.err != nil {
+				if !grpcerr.Match(c.err, err) {
+					t.Fatalf("errors do not match, got '%v'", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.ApiKey.Id != c.req.Id {
+				t.Errorf("wrong api key: %d", res.ApiKey.Id)
+			}
+
+			mock.AssertExpectationsForObjects(t, actorProviderMock, apiKeyProviderMock)
+		})
+	}
+}
@@ -44,7 +44,7 @@ func (ggh *getGroupHandler) firewall(req *charonrpc.GetGroupRequest, act *sessio
 	if act.User.IsSuperuser {
 		return nil
 	}
-	if act.Permissions.Contains(charon.GroupCanRetrieve) {
+	if act.Permissions.Match(charon.GroupCanRetrieve) {
 		return nil
 	}
 
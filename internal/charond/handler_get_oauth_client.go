@@ -0,0 +1,57 @@
+package charond
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/mapping"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"google.golang.org/grpc/codes"
+)
+
+type getOAuthClientHandler struct {
+	*handler
+}
+
+func (goch *getOAuthClientHandler) Get(ctx context.Context, req *charonrpc.GetOAuthClientRequest) (*charonrpc.GetOAuthClientResponse, error) {
+	if req.Id <= 0 {
+		return nil, grpcerr.E(codes.InvalidArgument, "missing oauth client id")
+	}
+	act, err := goch.Actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = goch.firewall(act); err != nil {
+		return nil, err
+	}
+
+	ent, err := goch.repository.oauthClient.FindOneByID(ctx, req.Id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, grpcerr.E(codes.NotFound, "oauth client does not exists")
+		}
+		return nil, grpcerr.E(codes.Internal, "oauth client cannot be fetched", err)
+	}
+
+	msg, err := mapping.ReverseOAuthClient(ent)
+	if err != nil {
+		return nil, grpcerr.E(codes.Internal, "oauth client entity mapping failure", err)
+	}
+	return &charonrpc.GetOAuthClientResponse{
+		Client: msg,
+	}, nil
+}
+
+func (goch *getOAuthClientHandler) firewall(act *session.Actor) error {
+	if act.User.IsSuperuser {
+		return nil
+	}
+	if act.Permissions.Match(charon.OAuthClientCanRetrieve) {
+		return nil
+	}
+
+	return grpcerr.E(codes.PermissionDenied, "oauth client cannot be retrieved, missing permission")
+}
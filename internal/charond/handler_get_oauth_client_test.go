@@ -0,0 +1,104 @@
+package charond
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/model/modelmock"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	"github.com/piotrkowalczuk/charon/internal/session/sessionmock"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+func TestGetOAuthClientHandler_Get_Unit(t *testing.T) {
+	actorProviderMock := &sessionmock.ActorProvider{}
+	oauthClientProviderMock := &modelmock.OauthClientProvider{}
+
+	h := getOAuthClientHandler{
+		handler: &handler{
+			logger:        zap.L(),
+			ActorProvider: actorProviderMock,
+			repository: repositories{
+				oauthClient: oauthClientProviderMock,
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		req  charonrpc.GetOAuthClientRequest
+		init func(*testing.T)
+		err  error
+	}{
+		"invalid-id": {
+			init: func(t *testing.T) {},
+			err:  grpcerr.E(codes.InvalidArgument),
+		},
+		"missing-permission": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1}}, nil).
+					Once()
+			},
+			req: charonrpc.GetOAuthClientRequest{Id: 2},
+			err: grpcerr.E(codes.PermissionDenied),
+		},
+		"does-not-exists": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1, IsSuperuser: true}}, nil).
+					Once()
+				oauthClientProviderMock.On("FindOneByID", mock.Anything, int64(2)).
+					Return(nil, sql.ErrNoRows).
+					Once()
+			},
+			req: charonrpc.GetOAuthClientRequest{Id: 2},
+			err: grpcerr.E(codes.NotFound),
+		},
+		"with-permission": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{
+						User:        &model.UserEntity{ID: 1},
+						Permissions: charon.Permissions{charon.OAuthClientCanRetrieve},
+					}, nil).
+					Once()
+				oauthClientProviderMock.On("FindOneByID", mock.Anything, int64(2)).
+					Return(&model.OauthClientEntity{ID: 2, Secret: []byte("hash"), RedirectUris: "https://example.com/cb"}, nil).
+					Once()
+			},
+			req: charonrpc.GetOAuthClientRequest{Id: 2},
+		},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			actorProviderMock.ExpectedCalls = []*mock.Call{}
+			oauthClientProviderMock.ExpectedCalls = []*mock.Call{}
+
+			c.init(t)
+
+			res, err := h.Get(context.Background(), &c.req)
+			if c.err != nil {
+				if !grpcerr.Match(c.err, err) {
+					t.Fatalf("errors do not match, got '%v'", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !res.Client.Confidential || len(res.Client.RedirectUris) != 1 {
+				t.Errorf("wrong client: %v", res.Client)
+			}
+
+			mock.AssertExpectationsForObjects(t, actorProviderMock, oauthClientProviderMock)
+		})
+	}
+}
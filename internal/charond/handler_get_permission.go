@@ -46,7 +46,7 @@ func (gph *getPermissionHandler) firewall(req *charonrpc.GetPermissionRequest, a
 	if act.User.IsSuperuser {
 		return nil
 	}
-	if act.Permissions.Contains(charon.PermissionCanRetrieve) {
+	if act.Permissions.Match(charon.PermissionCanRetrieve) {
 		return nil
 	}
 
@@ -34,6 +34,9 @@ func (guh *getUserHandler) Get(ctx context.Context, req *charonrpc.GetUserReques
 		}
 		return nil, grpcerr.E(codes.Internal, "user cannot be fetched", err)
 	}
+	if ent.DeletedAt.Valid && !req.IncludeDeleted {
+		return nil, grpcerr.E(codes.NotFound, "user does not exists")
+	}
 	if err = guh.firewall(req, act, ent); err != nil {
 		return nil, err
 	}
@@ -50,23 +53,23 @@ func (guh *getUserHandler) firewall(req *charonrpc.GetUserRequest, act *session.
 	}
 	if ent.IsStaff {
 		if ent.CreatedBy.Int64Or(0) == act.User.ID {
-			if !act.Permissions.Contains(charon.UserCanRetrieveStaffAsOwner) {
+			if !act.Permissions.Match(charon.UserCanRetrieveStaffAsOwner) {
 				return grpcerr.E(codes.PermissionDenied, "staff user cannot be retrieved as an owner, missing permission")
 			}
 			return nil
 		}
-		if !act.Permissions.Contains(charon.UserCanRetrieveStaffAsStranger) {
+		if !act.Permissions.Match(charon.UserCanRetrieveStaffAsStranger) {
 			return grpcerr.E(codes.PermissionDenied, "staff user cannot be retrieved as a stranger, missing permission")
 		}
 		return nil
 	}
 	if ent.CreatedBy.Int64Or(0) == act.User.ID {
-		if !act.Permissions.Contains(charon.UserCanRetrieveAsOwner) {
+		if !act.Permissions.Match(charon.UserCanRetrieveAsOwner) {
 			return grpcerr.E(codes.PermissionDenied, "user cannot be retrieved as an owner, missing permission")
 		}
 		return nil
 	}
-	if !act.Permissions.Contains(charon.UserCanRetrieveAsStranger) {
+	if !act.Permissions.Match(charon.UserCanRetrieveAsStranger) {
 		return grpcerr.E(codes.PermissionDenied, "user cannot be retrieved as a stranger, missing permission")
 	}
 	return nil
@@ -7,6 +7,7 @@ import (
 
 	"database/sql"
 
+	"github.com/lib/pq"
 	"github.com/piotrkowalczuk/charon"
 	"github.com/piotrkowalczuk/charon/internal/grpcerr"
 	"github.com/piotrkowalczuk/charon/internal/model"
@@ -67,6 +68,37 @@ func TestGetUserHandler_Get_Unit(t *testing.T) {
 				}, nil)
 			},
 		},
+		{
+			req: charonrpc.GetUserRequest{Id: 2},
+			init: func(_ *testing.T) {
+				userProviderMock.On("FindOneByID", mock.Anything, mock.Anything).Return(&model.UserEntity{
+					ID:        2,
+					CreatedBy: ntypes.Int64{Int64: 3, Valid: true},
+				}, nil)
+				actorProviderMock.On("Actor", mock.Anything).Return(&session.Actor{
+					User: &model.UserEntity{ID: 1},
+					Permissions: charon.Permissions{
+						"charon:user:*",
+					},
+				}, nil)
+			},
+		},
+		{
+			req: charonrpc.GetUserRequest{Id: 2},
+			init: func(_ *testing.T) {
+				userProviderMock.On("FindOneByID", mock.Anything, mock.Anything).Return(&model.UserEntity{
+					ID:        2,
+					CreatedBy: ntypes.Int64{Int64: 3, Valid: true},
+				}, nil)
+				actorProviderMock.On("Actor", mock.Anything).Return(&session.Actor{
+					User: &model.UserEntity{ID: 1},
+					Permissions: charon.Permissions{
+						"charon:group:*",
+					},
+				}, nil)
+			},
+			err: grpcerr.E(codes.PermissionDenied),
+		},
 		{
 			req: charonrpc.GetUserRequest{Id: 2},
 			init: func(_ *testing.T) {
@@ -182,6 +214,31 @@ func TestGetUserHandler_Get_Unit(t *testing.T) {
 				}, nil)
 			},
 		},
+		{
+			req: charonrpc.GetUserRequest{Id: 2},
+			init: func(_ *testing.T) {
+				userProviderMock.On("FindOneByID", mock.Anything, mock.Anything).Return(&model.UserEntity{
+					ID:        2,
+					DeletedAt: pq.NullTime{Time: time.Now(), Valid: true},
+				}, nil)
+				actorProviderMock.On("Actor", mock.Anything).Return(&session.Actor{
+					User: &model.UserEntity{ID: 1, IsSuperuser: true},
+				}, nil)
+			},
+			err: grpcerr.E(codes.NotFound),
+		},
+		{
+			req: charonrpc.GetUserRequest{Id: 2, IncludeDeleted: true},
+			init: func(_ *testing.T) {
+				userProviderMock.On("FindOneByID", mock.Anything, mock.Anything).Return(&model.UserEntity{
+					ID:        2,
+					DeletedAt: pq.NullTime{Time: time.Now(), Valid: true},
+				}, nil)
+				actorProviderMock.On("Actor", mock.Anything).Return(&session.Actor{
+					User: &model.UserEntity{ID: 1, IsSuperuser: true},
+				}, nil)
+			},
+		},
 		{
 			req: charonrpc.GetUserRequest{Id: 2},
 			init: func(_ *testing.T) {
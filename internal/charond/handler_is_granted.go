@@ -32,7 +32,15 @@ func (ig *isGrantedHandler) IsGranted(ctx context.Context, req *charonrpc.IsGran
 		return nil, err
 	}
 
-	granted, err := ig.repository.user.IsGranted(ctx, req.UserId, charon.Permission(req.Permission))
+	var granted bool
+	if res := req.Resource; res != nil {
+		if res.Type == "" || res.Id == "" {
+			return nil, grpcerr.E(codes.InvalidArgument, "resource type and id cannot be empty")
+		}
+		granted, err = ig.repository.user.IsGrantedOnResource(ctx, req.UserId, charon.Permission(req.Permission), res.Type, res.Id)
+	} else {
+		granted, err = ig.repository.user.IsGranted(ctx, req.UserId, charon.Permission(req.Permission))
+	}
 	if err != nil {
 		return nil, grpcerr.E(codes.Internal, "is granted repository call failure", err)
 	}
@@ -47,7 +55,7 @@ func (ig *isGrantedHandler) firewall(req *charonrpc.IsGrantedRequest, act *sessi
 	if act.User.IsSuperuser {
 		return nil
 	}
-	if act.Permissions.Contains(charon.UserPermissionCanCheckGrantingAsStranger) {
+	if act.Permissions.Match(charon.UserPermissionCanCheckGrantingAsStranger) {
 		return nil
 	}
 
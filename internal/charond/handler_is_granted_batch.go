@@ -0,0 +1,97 @@
+package charond
+
+import (
+	"context"
+
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+
+	"google.golang.org/grpc/codes"
+)
+
+type isGrantedBatchHandler struct {
+	*handler
+}
+
+func (igb *isGrantedBatchHandler) IsGrantedBatch(ctx context.Context, req *charonrpc.IsGrantedBatchRequest) (*charonrpc.IsGrantedBatchResponse, error) {
+	if len(req.Permissions) == 0 {
+		return nil, grpcerr.E(codes.InvalidArgument, "permissions cannot be empty")
+	}
+	permissions := make(charon.Permissions, 0, len(req.Permissions))
+	for _, p := range req.Permissions {
+		if p == "" {
+			return nil, grpcerr.E(codes.InvalidArgument, "permission cannot be empty")
+		}
+		permissions = append(permissions, charon.Permission(p))
+	}
+
+	if req.UserId == 0 && len(req.UserIds) == 0 {
+		return nil, grpcerr.E(codes.InvalidArgument, "user id or user ids needs to be provided")
+	}
+	candidates := req.UserIds
+	if req.UserId != 0 {
+		candidates = append([]int64{req.UserId}, req.UserIds...)
+	}
+	ids := make([]int64, 0, len(candidates))
+	seen := make(map[int64]struct{}, len(candidates))
+	for _, id := range candidates {
+		if id < 1 {
+			return nil, grpcerr.E(codes.InvalidArgument, "user id needs to be greater than zero")
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, id)
+	}
+
+	act, err := igb.Actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range ids {
+		if err = igb.firewall(id, act); err != nil {
+			return nil, err
+		}
+	}
+
+	granted, err := igb.repository.user.IsGrantedMany(ctx, ids, permissions)
+	if err != nil {
+		return nil, grpcerr.E(codes.Internal, "is granted many repository call failure", err)
+	}
+
+	res := &charonrpc.IsGrantedBatchResponse{
+		Users: make(map[int64]*charonrpc.IsGrantedBatchResponse_Grants, len(ids)),
+	}
+	for _, id := range ids {
+		grants := &charonrpc.IsGrantedBatchResponse_Grants{
+			Granted: make(map[string]bool, len(permissions)),
+		}
+		for _, p := range permissions {
+			grants.Granted[p.String()] = granted[id][p]
+		}
+		res.Users[id] = grants
+	}
+	if req.UserId > 0 {
+		res.Granted = res.Users[req.UserId].Granted
+	}
+
+	return res, nil
+}
+
+// firewall applies the same rules as isGrantedHandler does, to every user separately.
+func (igb *isGrantedBatchHandler) firewall(userID int64, act *session.Actor) error {
+	if act.User.ID == userID {
+		return nil
+	}
+	if act.User.IsSuperuser {
+		return nil
+	}
+	if act.Permissions.Match(charon.UserPermissionCanCheckGrantingAsStranger) {
+		return nil
+	}
+
+	return grpcerr.E(codes.PermissionDenied, "granting cannot be checked, missing permission")
+}
@@ -0,0 +1,198 @@
+package charond
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/model/modelmock"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	"github.com/piotrkowalczuk/charon/internal/session/sessionmock"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+func TestIsGrantedBatchHandler_IsGrantedBatch_E2E(t *testing.T) {
+	suite := &endToEndSuite{}
+	suite.setup(t)
+	defer suite.teardown(t)
+
+	ctx := testRPCServerLogin(t, suite)
+
+	_, err := suite.charon.user.SetPermissions(ctx, &charonrpc.SetUserPermissionsRequest{
+		UserId:      1,
+		Permissions: []string{charon.PermissionCanRetrieve.String()},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := suite.charon.auth.IsGrantedBatch(ctx, &charonrpc.IsGrantedBatchRequest{
+		UserId: 1,
+		Permissions: []string{
+			charon.PermissionCanRetrieve.String(),
+			charon.PermissionCanDelete.String(),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Granted[charon.PermissionCanRetrieve.String()] {
+		t.Error("should be granted")
+	}
+	if res.Granted[charon.PermissionCanDelete.String()] {
+		t.Error("should not be granted")
+	}
+}
+
+func TestIsGrantedBatchHandler_IsGrantedBatch_Unit(t *testing.T) {
+	actorProviderMock := &sessionmock.ActorProvider{}
+	userProviderMock := &modelmock.UserProvider{}
+
+	permissions := charon.Permissions{"a:b:c", "a:b:d"}
+
+	cases := map[string]struct {
+		init func(*testing.T)
+		req  charonrpc.IsGrantedBatchRequest
+		res  *charonrpc.IsGrantedBatchResponse
+		err  error
+	}{
+		"missing-user-id": {
+			init: func(_ *testing.T) {},
+			req:  charonrpc.IsGrantedBatchRequest{Permissions: permissions.Strings()},
+			err:  grpcerr.E(codes.InvalidArgument),
+		},
+		"invalid-user-ids": {
+			init: func(_ *testing.T) {},
+			req:  charonrpc.IsGrantedBatchRequest{UserId: 1, UserIds: []int64{0}, Permissions: permissions.Strings()},
+			err:  grpcerr.E(codes.InvalidArgument),
+		},
+		"missing-permissions": {
+			init: func(_ *testing.T) {},
+			req:  charonrpc.IsGrantedBatchRequest{UserId: 1},
+			err:  grpcerr.E(codes.InvalidArgument),
+		},
+		"empty-permission": {
+			init: func(_ *testing.T) {},
+			req:  charonrpc.IsGrantedBatchRequest{UserId: 1, Permissions: []string{"a:b:c", ""}},
+			err:  grpcerr.E(codes.InvalidArgument),
+		},
+		"session-does-not-exists": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(nil, grpcerr.E(codes.Unauthenticated, "session does not exists")).
+					Once()
+			},
+			req: charonrpc.IsGrantedBatchRequest{UserId: 1, Permissions: permissions.Strings()},
+			err: grpcerr.E(codes.Unauthenticated),
+		},
+		"cannot-check-as-a-stranger-if-missing-permission": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1, IsStaff: true}}, nil).
+					Once()
+			},
+			req: charonrpc.IsGrantedBatchRequest{UserId: 1, UserIds: []int64{2}, Permissions: permissions.Strings()},
+			err: grpcerr.E(codes.PermissionDenied),
+		},
+		"same-user-id": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1}}, nil).
+					Once()
+				userProviderMock.On("IsGrantedMany", mock.Anything, []int64{1}, permissions).
+					Return(map[int64]map[charon.Permission]bool{1: {"a:b:c": true, "a:b:d": false}}, nil).
+					Once()
+			},
+			req: charonrpc.IsGrantedBatchRequest{UserId: 1, UserIds: []int64{1}, Permissions: permissions.Strings()},
+			res: &charonrpc.IsGrantedBatchResponse{
+				Granted: map[string]bool{"a:b:c": true, "a:b:d": false},
+				Users: map[int64]*charonrpc.IsGrantedBatchResponse_Grants{
+					1: {Granted: map[string]bool{"a:b:c": true, "a:b:d": false}},
+				},
+			},
+		},
+		"many-users-as-a-stranger-if-have-permission": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{
+						Permissions: charon.Permissions{charon.UserPermissionCanCheckGrantingAsStranger},
+						User:        &model.UserEntity{ID: 3},
+					}, nil).
+					Once()
+				userProviderMock.On("IsGrantedMany", mock.Anything, []int64{1, 2}, permissions).
+					Return(map[int64]map[charon.Permission]bool{1: {"a:b:c": true}}, nil).
+					Once()
+			},
+			req: charonrpc.IsGrantedBatchRequest{UserIds: []int64{1, 2}, Permissions: permissions.Strings()},
+			res: &charonrpc.IsGrantedBatchResponse{
+				Users: map[int64]*charonrpc.IsGrantedBatchResponse_Grants{
+					1: {Granted: map[string]bool{"a:b:c": true, "a:b:d": false}},
+					2: {Granted: map[string]bool{"a:b:c": false, "a:b:d": false}},
+				},
+			},
+		},
+		"superuser": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 3, IsSuperuser: true}}, nil).
+					Once()
+				userProviderMock.On("IsGrantedMany", mock.Anything, []int64{2, 1}, permissions).
+					Return(map[int64]map[charon.Permission]bool{}, nil).
+					Once()
+			},
+			req: charonrpc.IsGrantedBatchRequest{UserId: 2, UserIds: []int64{1, 2}, Permissions: permissions.Strings()},
+			res: &charonrpc.IsGrantedBatchResponse{
+				Granted: map[string]bool{"a:b:c": false, "a:b:d": false},
+				Users: map[int64]*charonrpc.IsGrantedBatchResponse_Grants{
+					1: {Granted: map[string]bool{"a:b:c": false, "a:b:d": false}},
+					2: {Granted: map[string]bool{"a:b:c": false, "a:b:d": false}},
+				},
+			},
+		},
+		"request-canceled": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1}}, nil).
+					Once()
+				userProviderMock.On("IsGrantedMany", mock.Anything, []int64{1}, permissions).
+					Return(nil, context.Canceled).
+					Once()
+			},
+			req: charonrpc.IsGrantedBatchRequest{UserId: 1, Permissions: permissions.Strings()},
+			err: grpcerr.E(codes.Canceled),
+		},
+	}
+
+	h := isGrantedBatchHandler{
+		handler: &handler{
+			logger:        zap.L(),
+			ActorProvider: actorProviderMock,
+			repository: repositories{
+				user: userProviderMock,
+			},
+		},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			actorProviderMock.ExpectedCalls = nil
+			userProviderMock.ExpectedCalls = nil
+
+			c.init(t)
+
+			res, err := h.IsGrantedBatch(context.TODO(), &c.req)
+			assertError(t, c.err, err)
+			if c.res != nil && !reflect.DeepEqual(c.res, res) {
+				t.Errorf("wrong response, expected %v but got %v", c.res, res)
+			}
+
+			mock.AssertExpectationsForObjects(t, actorProviderMock, userProviderMock)
+		})
+	}
+}
@@ -129,6 +129,35 @@ func TestIsGrantedHandler_IsGranted_Unit(t *testing.T) {
 			},
 			req: charonrpc.IsGrantedRequest{UserId: 1, Permission: "123:123:123"},
 		},
+		"resource-missing-id": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1}}, nil).
+					Maybe()
+			},
+			req: charonrpc.IsGrantedRequest{
+				UserId:     1,
+				Permission: "123:123:123",
+				Resource:   &charonrpc.Resource{Type: "comment"},
+			},
+			err: grpcerr.E(codes.InvalidArgument),
+		},
+		"resource": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1}}, nil).
+					Once()
+
+				userProviderMock.On("IsGrantedOnResource", mock.Anything, int64(1), charon.Permission("123:123:123"), "comment", "42").
+					Return(true, nil).
+					Once()
+			},
+			req: charonrpc.IsGrantedRequest{
+				UserId:     1,
+				Permission: "123:123:123",
+				Resource:   &charonrpc.Resource{Type: "comment", Id: "42"},
+			},
+		},
 		"request-canceled": {
 			init: func(t *testing.T) {
 				actorProviderMock.On("Actor", mock.Anything).
@@ -0,0 +1,64 @@
+package charond
+
+import (
+	"context"
+
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/mapping"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/piotrkowalczuk/qtypes"
+	"google.golang.org/grpc/codes"
+)
+
+type listAPIKeysHandler struct {
+	*handler
+}
+
+func (lakh *listAPIKeysHandler) List(ctx context.Context, req *charonrpc.ListAPIKeysRequest) (*charonrpc.ListAPIKeysResponse, error) {
+	act, err := lakh.Actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = lakh.firewall(req, act); err != nil {
+		return nil, err
+	}
+
+	ents, err := lakh.repository.apiKey.Find(ctx, &model.APIKeyFindExpr{
+		Limit:   req.GetLimit().Int64Or(10),
+		Offset:  req.GetOffset().Int64Or(0),
+		OrderBy: mapping.OrderBy(req.GetOrderBy()),
+		Where:   mapping.APIKeyQuery(req.GetQuery()),
+	})
+	if err != nil {
+		return nil, grpcerr.E(codes.Internal, "find api key query failed", err)
+	}
+
+	msg, err := mapping.ReverseAPIKeys(ents)
+	if err != nil {
+		return nil, grpcerr.E(codes.Internal, "api key reverse mapping failure", err)
+	}
+	return &charonrpc.ListAPIKeysResponse{
+		ApiKeys: msg,
+	}, nil
+}
+
+func (lakh *listAPIKeysHandler) firewall(req *charonrpc.ListAPIKeysRequest, act *session.Actor) error {
+	if act.User.IsSuperuser {
+		return nil
+	}
+	if act.Permissions.Match(charon.APIKeyCanRetrieveAsStranger) {
+		return nil
+	}
+	if act.Permissions.Match(charon.APIKeyCanRetrieveAsOwner) {
+		if req.Query == nil {
+			req.Query = &charonrpc.APIKeyQuery{}
+		}
+		req.Query.UserId = qtypes.EqualInt64(act.User.ID)
+		return nil
+	}
+
+	return grpcerr.E(codes.PermissionDenied, "list of api keys cannot be retrieved, missing permission")
+}
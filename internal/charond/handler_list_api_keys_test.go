@@ -0,0 +1,98 @@
+package charond
+
+import (
+	"context"
+	"testing"
+
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/model/modelmock"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	"github.com/piotrkowalczuk/charon/internal/session/sessionmock"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/piotrkowalczuk/qtypes"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+)
+
+func TestListAPIKeysHandler_List_Unit(t *testing.T) {
+	actorProviderMock := &sessionmock.ActorProvider{}
+	apiKeyProviderMock := &modelmock.APIKeyProvider{}
+
+	h := listAPIKeysHandler{
+		handler: &handler{
+			ActorProvider: actorProviderMock,
+			repository: repositories{
+				apiKey: apiKeyProviderMock,
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		req  charonrpc.ListAPIKeysRequest
+		init func(*testing.T)
+		err  error
+	}{
+		"as-no-one": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1}}, nil).
+					Once()
+			},
+			err: grpcerr.E(codes.PermissionDenied),
+		},
+		"as-owner": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{
+						User:        &model.UserEntity{ID: 1},
+						Permissions: charon.Permissions{charon.APIKeyCanRetrieveAsOwner},
+					}, nil).
+					Once()
+				apiKeyProviderMock.On("Find", mock.Anything, mock.MatchedBy(func(expr *model.APIKeyFindExpr) bool {
+					return expr.Where.UserID != nil && expr.Where.UserID.Type == qtypes.QueryType_EQUAL && expr.Where.UserID.Values[0] == 1
+				})).
+					Return([]*model.APIKeyEntity{{ID: 10, UserID: 1}}, nil).
+					Once()
+			},
+			// Owner is not allowed to look at keys of others, the query gets overridden.
+			req: charonrpc.ListAPIKeysRequest{Query: &charonrpc.APIKeyQuery{UserId: qtypes.EqualInt64(2)}},
+		},
+		"as-superuser": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1, IsSuperuser: true}}, nil).
+					Once()
+				apiKeyProviderMock.On("Find", mock.Anything, mock.Anything).
+					Return([]*model.APIKeyEntity{{ID: 10, UserID: 2}}, nil).
+					Once()
+			},
+		},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			actorProviderMock.ExpectedCalls = []*mock.Call{}
+			apiKeyProviderMock.ExpectedCalls = []*mock.Call{}
+
+			c.init(t)
+
+			res, err := h.List(context.Background(), &c.req)
+			if c.err != nil {
+				if !grpcerr.Match(c.err, err) {
+					t.Fatalf("errors do not match, got '%v'", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(res.ApiKeys) != 1 {
+				t.Errorf("wrong number of api keys: %d", len(res.ApiKeys))
+			}
+
+			mock.AssertExpectationsForObjects(t, actorProviderMock, apiKeyProviderMock)
+		})
+	}
+}
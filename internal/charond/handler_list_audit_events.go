@@ -0,0 +1,56 @@
+package charond
+
+import (
+	"context"
+
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/mapping"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"google.golang.org/grpc/codes"
+)
+
+type listAuditEventsHandler struct {
+	*handler
+}
+
+func (laeh *listAuditEventsHandler) List(ctx context.Context, req *charonrpc.ListAuditEventsRequest) (*charonrpc.ListAuditEventsResponse, error) {
+	act, err := laeh.Actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = laeh.firewall(act); err != nil {
+		return nil, err
+	}
+
+	ents, err := laeh.repository.auditEvent.Find(ctx, &model.AuditEventFindExpr{
+		Limit:   req.GetLimit().Int64Or(10),
+		Offset:  req.GetOffset().Int64Or(0),
+		OrderBy: mapping.OrderBy(req.GetOrderBy()),
+		Where:   mapping.AuditEventQuery(req.GetQuery()),
+	})
+	if err != nil {
+		return nil, grpcerr.E(codes.Internal, "find audit events query failed", err)
+	}
+
+	msg, err := mapping.ReverseAuditEvents(ents)
+	if err != nil {
+		return nil, grpcerr.E(codes.Internal, "audit event reverse mapping failure", err)
+	}
+	return &charonrpc.ListAuditEventsResponse{
+		AuditEvents: msg,
+	}, nil
+}
+
+func (laeh *listAuditEventsHandler) firewall(act *session.Actor) error {
+	if act.User.IsSuperuser {
+		return nil
+	}
+	if act.Permissions.Match(charon.AuditEventCanRetrieve) {
+		return nil
+	}
+
+	return grpcerr.E(codes.PermissionDenied, "list of audit events cannot be retrieved, missing permission")
+}
@@ -0,0 +1,148 @@
+package charond
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/model/modelmock"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	"github.com/piotrkowalczuk/charon/internal/session/sessionmock"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/piotrkowalczuk/qtypes"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+)
+
+func TestListAuditEventsHandler_List_E2E(t *testing.T) {
+	suite := &endToEndSuite{}
+	suite.setup(t)
+	defer suite.teardown(t)
+
+	ctx := testRPCServerLogin(t, suite)
+
+	grp, err := suite.charon.group.Create(ctx, &charonrpc.CreateGroupRequest{Name: "audited"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := suite.charon.audit.List(ctx, &charonrpc.ListAuditEventsRequest{
+		Query: &charonrpc.AuditEventQuery{
+			TargetKind: qtypes.EqualString(model.AuditEventTargetGroup),
+			TargetId:   qtypes.EqualInt64(grp.Group.Id),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.AuditEvents) != 1 {
+		t.Fatalf("wrong number of audit events, expected 1 got %d", len(res.AuditEvents))
+	}
+	if res.AuditEvents[0].Rpc != "/charon.rpc.charond.v1.GroupManager/Create" {
+		t.Errorf("wrong rpc: %s", res.AuditEvents[0].Rpc)
+	}
+	if !res.AuditEvents[0].ActorId.GetValid() {
+		t.Error("actor id expected to be set")
+	}
+}
+
+func TestListAuditEventsHandler_List_Unit(t *testing.T) {
+	actorProviderMock := &sessionmock.ActorProvider{}
+	auditEventMock := &modelmock.AuditEventProvider{}
+
+	cases := map[string]struct {
+		init func(*testing.T)
+		req  charonrpc.ListAuditEventsRequest
+		err  error
+	}{
+		"session-does-not-exists": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(nil, grpcerr.E(codes.Unauthenticated, "session does not exists")).
+					Once()
+			},
+			err: grpcerr.E(codes.Unauthenticated),
+		},
+		"reverse-mapping-failure": {
+			init: func(*testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).Return(&session.Actor{
+					User: &model.UserEntity{ID: 1, IsSuperuser: true},
+				}, nil)
+				auditEventMock.On("Find", mock.Anything, mock.Anything).Return([]*model.AuditEventEntity{
+					{ID: 1, CreatedAt: brokenDate()},
+				}, nil)
+			},
+			err: grpcerr.E(codes.Internal),
+		},
+		"context-canceled": {
+			init: func(*testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).Return(&session.Actor{
+					User: &model.UserEntity{ID: 1, IsSuperuser: true},
+				}, nil)
+				auditEventMock.On("Find", mock.Anything, mock.Anything).Return(nil, context.Canceled)
+			},
+			err: grpcerr.E(codes.Canceled),
+		},
+		"as-superuser": {
+			init: func(*testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).Return(&session.Actor{
+					User: &model.UserEntity{ID: 1, IsSuperuser: true},
+				}, nil)
+				auditEventMock.On("Find", mock.Anything, mock.Anything).Return([]*model.AuditEventEntity{
+					{ID: 1, RPC: "/charon.rpc.charond.v1.UserManager/Delete", CreatedAt: time.Now()},
+				}, nil)
+			},
+		},
+		"with-permission": {
+			init: func(*testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).Return(&session.Actor{
+					User:        &model.UserEntity{ID: 1},
+					Permissions: charon.Permissions{charon.AuditEventCanRetrieve},
+				}, nil)
+				auditEventMock.On("Find", mock.Anything, mock.Anything).Return([]*model.AuditEventEntity{
+					{ID: 1, RPC: "/charon.rpc.charond.v1.UserManager/Delete", CreatedAt: time.Now()},
+				}, nil)
+			},
+		},
+		"as-staff": {
+			init: func(*testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).Return(&session.Actor{
+					User: &model.UserEntity{ID: 2, IsStaff: true},
+				}, nil)
+			},
+			err: grpcerr.E(codes.PermissionDenied),
+		},
+	}
+
+	h := listAuditEventsHandler{
+		handler: &handler{
+			ActorProvider: actorProviderMock,
+			repository: repositories{
+				auditEvent: auditEventMock,
+			},
+		},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			actorProviderMock.ExpectedCalls = nil
+			auditEventMock.ExpectedCalls = nil
+
+			c.init(t)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			_, err := h.List(ctx, &c.req)
+			assertError(t, c.err, err)
+
+			mock.AssertExpectationsForObjects(t,
+				actorProviderMock,
+				auditEventMock,
+			)
+		})
+	}
+}
@@ -53,7 +53,7 @@ func (lgph *listGroupPermissionsHandler) firewall(req *charonrpc.ListGroupPermis
 	if act.User.IsSuperuser {
 		return nil
 	}
-	if act.Permissions.Contains(charon.GroupPermissionCanRetrieve) {
+	if act.Permissions.Match(charon.GroupPermissionCanRetrieve) {
 		return nil
 	}
 
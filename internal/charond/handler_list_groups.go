@@ -9,6 +9,7 @@ import (
 	"github.com/piotrkowalczuk/charon/internal/mapping"
 	"github.com/piotrkowalczuk/charon/internal/model"
 	"github.com/piotrkowalczuk/charon/internal/session"
+	"github.com/piotrkowalczuk/ntypes"
 
 	"google.golang.org/grpc/codes"
 )
@@ -26,35 +27,58 @@ func (lgh *listGroupsHandler) List(ctx context.Context, req *charonrpc.ListGroup
 		return nil, err
 	}
 
+	pg, err := mapping.NewPaging(req, model.TableGroupColumnID, mapping.GroupPageColumns...)
+	if err != nil {
+		return nil, grpcerr.E(codes.InvalidArgument, "list of groups cannot be paged", err)
+	}
+
+	var total *ntypes.Int64
+	if req.IncludeTotal {
+		n, err := lgh.repository.group.Count(ctx, &model.GroupCountExpr{})
+		if err != nil {
+			return nil, grpcerr.E(codes.Internal, "count groups query failed", err)
+		}
+		total = &ntypes.Int64{Int64: n, Valid: true}
+	}
+	where, err := mapping.GroupPage(nil, pg)
+	if err != nil {
+		return nil, grpcerr.E(codes.InvalidArgument, "list of groups cannot be paged", err)
+	}
+
 	ents, err := lgh.repository.group.Find(ctx, &model.GroupFindExpr{
-		Limit:   req.GetLimit().Int64Or(10),
-		Offset:  req.GetOffset().Int64Or(0),
-		OrderBy: mapping.OrderBy(req.GetOrderBy()),
+		Limit:   pg.Limit,
+		Offset:  pg.Offset,
+		OrderBy: pg.OrderBy,
+		Where:   where,
 	})
 	if err != nil {
 		return nil, grpcerr.E(codes.Internal, "find group query failed", err)
 	}
 
-	return lgh.response(ents)
+	return lgh.response(ents, pg, total)
 }
 
 func (lgh *listGroupsHandler) firewall(req *charonrpc.ListGroupsRequest, act *session.Actor) error {
 	if act.User.IsSuperuser {
 		return nil
 	}
-	if act.Permissions.Contains(charon.GroupCanRetrieve) {
+	if act.Permissions.Match(charon.GroupCanRetrieve) {
 		return nil
 	}
 
 	return grpcerr.E(codes.PermissionDenied, "list of groups cannot be retrieved, missing permission")
 }
 
-func (lgh *listGroupsHandler) response(ents []*model.GroupEntity) (*charonrpc.ListGroupsResponse, error) {
+func (lgh *listGroupsHandler) response(ents []*model.GroupEntity, pg *mapping.Paging, total *ntypes.Int64) (*charonrpc.ListGroupsResponse, error) {
 	msg, err := mapping.ReverseGroups(ents)
 	if err != nil {
 		return nil, grpcerr.E(codes.Internal, "group entities mapping failure", err)
 
 	}
+	next, err := mapping.GroupNextPageToken(pg, ents)
+	if err != nil {
+		return nil, grpcerr.E(codes.Internal, "next page token cannot be issued", err)
+	}
 
-	return &charonrpc.ListGroupsResponse{Groups: msg}, nil
+	return &charonrpc.ListGroupsResponse{Groups: msg, NextPageToken: next, Total: total}, nil
 }
@@ -81,6 +81,44 @@ func TestListGroupsHandler_List_E2E(t *testing.T) {
 			t.Errorf("wrong group name, expected %s but got %s", exp, res.Groups[0].Name)
 		}
 	})
+	t.Run("page-token", func(t *testing.T) {
+		req := &charonrpc.ListGroupsRequest{
+			Limit:        ntypes.NewInt64(2),
+			OrderBy:      []*charonrpc.Order{{Name: "name", Descending: true}},
+			IncludeTotal: true,
+		}
+		var names []string
+		for {
+			res, err := suite.charon.group.List(ctx, req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(names) == 0 && res.Total.Int64Or(0) != int64(max) {
+				t.Errorf("wrong total, expected %d but got %d", max, res.Total.Int64Or(0))
+			}
+			for _, g := range res.Groups {
+				names = append(names, g.Name)
+			}
+			if res.NextPageToken == "" {
+				break
+			}
+			if len(names) == 2 {
+				// Row inserted in the meantime, before the current position, does not shift the next page.
+				if _, err := suite.charon.group.Create(ctx, &charonrpc.CreateGroupRequest{Name: "group-9"}); err != nil {
+					t.Fatal(err)
+				}
+			}
+			req.PageToken = res.NextPageToken
+		}
+		if len(names) != max {
+			t.Fatalf("wrong number of entities, expected %d but got %d: %v", max, len(names), names)
+		}
+		for i, name := range names {
+			if exp := fmt.Sprintf("group-%d", max-1-i); name != exp {
+				t.Errorf("wrong group name, expected %s but got %s", exp, name)
+			}
+		}
+	})
 }
 
 func TestListGroupsHandler_List_Unit(t *testing.T) {
@@ -128,7 +166,7 @@ func TestListGroupsHandler_List_Unit(t *testing.T) {
 				groupProviderMock.On("Find", mock.Anything, &model.GroupFindExpr{
 					Limit:   10,
 					Offset:  0,
-					OrderBy: []model.RowOrder{},
+					OrderBy: []model.RowOrder{{Name: "id"}},
 				}).Return([]*model.GroupEntity{{
 					ID:   1,
 					Name: "example",
@@ -147,7 +185,7 @@ func TestListGroupsHandler_List_Unit(t *testing.T) {
 				groupProviderMock.On("Find", mock.Anything, &model.GroupFindExpr{
 					Limit:   10,
 					Offset:  0,
-					OrderBy: []model.RowOrder{},
+					OrderBy: []model.RowOrder{{Name: "id"}},
 				}).Return([]*model.GroupEntity{{
 					ID:   1,
 					Name: "example",
@@ -166,7 +204,7 @@ func TestListGroupsHandler_List_Unit(t *testing.T) {
 				groupProviderMock.On("Find", mock.Anything, &model.GroupFindExpr{
 					Limit:   10,
 					Offset:  0,
-					OrderBy: []model.RowOrder{},
+					OrderBy: []model.RowOrder{{Name: "id"}},
 				}).Return([]*model.GroupEntity{{
 					ID:        1,
 					Name:      "example",
@@ -176,6 +214,53 @@ func TestListGroupsHandler_List_Unit(t *testing.T) {
 			req: charonrpc.ListGroupsRequest{},
 			err: grpcerr.E(codes.Internal),
 		},
+		"cannot-order-by-unknown-column": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{
+						User: &model.UserEntity{ID: 1, IsSuperuser: true},
+					}, nil).
+					Once()
+			},
+			req: charonrpc.ListGroupsRequest{OrderBy: []*charonrpc.Order{{Name: "description"}}},
+			err: grpcerr.E(codes.InvalidArgument),
+		},
+		"cannot-combine-offset-with-page-token": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{
+						User: &model.UserEntity{ID: 1, IsSuperuser: true},
+					}, nil).
+					Once()
+			},
+			req: charonrpc.ListGroupsRequest{Offset: ntypes.NewInt64(1), PageToken: "abc"},
+			err: grpcerr.E(codes.InvalidArgument),
+		},
+		"malformed-page-token": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{
+						User: &model.UserEntity{ID: 1, IsSuperuser: true},
+					}, nil).
+					Once()
+			},
+			req: charonrpc.ListGroupsRequest{PageToken: "abc"},
+			err: grpcerr.E(codes.InvalidArgument),
+		},
+		"total-count-failure": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{
+						User: &model.UserEntity{ID: 1, IsSuperuser: true},
+					}, nil).
+					Once()
+				groupProviderMock.On("Count", mock.Anything, &model.GroupCountExpr{}).
+					Return(int64(0), context.Canceled).
+					Once()
+			},
+			req: charonrpc.ListGroupsRequest{IncludeTotal: true},
+			err: grpcerr.E(codes.Canceled),
+		},
 		"storage-query-cancellation": {
 			init: func(t *testing.T) {
 				actorProviderMock.On("Actor", mock.Anything).
@@ -187,7 +272,7 @@ func TestListGroupsHandler_List_Unit(t *testing.T) {
 				groupProviderMock.On("Find", mock.Anything, &model.GroupFindExpr{
 					Limit:   10,
 					Offset:  0,
-					OrderBy: []model.RowOrder{},
+					OrderBy: []model.RowOrder{{Name: "id"}},
 				}).Return(nil, context.Canceled)
 			},
 			req: charonrpc.ListGroupsRequest{},
@@ -0,0 +1,55 @@
+package charond
+
+import (
+	"context"
+
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/mapping"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"google.golang.org/grpc/codes"
+)
+
+type listOAuthClientsHandler struct {
+	*handler
+}
+
+func (loch *listOAuthClientsHandler) List(ctx context.Context, req *charonrpc.ListOAuthClientsRequest) (*charonrpc.ListOAuthClientsResponse, error) {
+	act, err := loch.Actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = loch.firewall(act); err != nil {
+		return nil, err
+	}
+
+	ents, err := loch.repository.oauthClient.Find(ctx, &model.OauthClientFindExpr{
+		Limit:   req.GetLimit().Int64Or(10),
+		Offset:  req.GetOffset().Int64Or(0),
+		OrderBy: mapping.OrderBy(req.GetOrderBy()),
+	})
+	if err != nil {
+		return nil, grpcerr.E(codes.Internal, "find oauth clients query failed", err)
+	}
+
+	msg, err := mapping.ReverseOAuthClients(ents)
+	if err != nil {
+		return nil, grpcerr.E(codes.Internal, "oauth client reverse mapping failure", err)
+	}
+	return &charonrpc.ListOAuthClientsResponse{
+		Clients: msg,
+	}, nil
+}
+
+func (loch *listOAuthClientsHandler) firewall(act *session.Actor) error {
+	if act.User.IsSuperuser {
+		return nil
+	}
+	if act.Permissions.Match(charon.OAuthClientCanRetrieve) {
+		return nil
+	}
+
+	return grpcerr.E(codes.PermissionDenied, "list of oauth clients cannot be retrieved, missing permission")
+}
@@ -0,0 +1,88 @@
+package charond
+
+import (
+	"context"
+	"testing"
+
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/model/modelmock"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	"github.com/piotrkowalczuk/charon/internal/session/sessionmock"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+func TestListOAuthClientsHandler_List_Unit(t *testing.T) {
+	actorProviderMock := &sessionmock.ActorProvider{}
+	oauthClientProviderMock := &modelmock.OauthClientProvider{}
+
+	h := listOAuthClientsHandler{
+		handler: &handler{
+			logger:        zap.L(),
+			ActorProvider: actorProviderMock,
+			repository: repositories{
+				oauthClient: oauthClientProviderMock,
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		init func(*testing.T)
+		exp  int
+		err  error
+	}{
+		"missing-permission": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1}}, nil).
+					Once()
+			},
+			err: grpcerr.E(codes.PermissionDenied),
+		},
+		"with-permission": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{
+						User:        &model.UserEntity{ID: 1},
+						Permissions: charon.Permissions{charon.OAuthClientCanRetrieve},
+					}, nil).
+					Once()
+				oauthClientProviderMock.On("Find", mock.Anything, mock.MatchedBy(func(expr *model.OauthClientFindExpr) bool {
+					return expr.Limit == 10 && expr.Offset == 0
+				})).
+					Return([]*model.OauthClientEntity{{ID: 1}, {ID: 2}}, nil).
+					Once()
+			},
+			exp: 2,
+		},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			actorProviderMock.ExpectedCalls = []*mock.Call{}
+			oauthClientProviderMock.ExpectedCalls = []*mock.Call{}
+
+			c.init(t)
+
+			res, err := h.List(context.Background(), &charonrpc.ListOAuthClientsRequest{})
+			if c.err != nil {
+				if !grpcerr.Match(c.err, err) {
+					t.Fatalf("errors do not match, got '%v'", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(res.Clients) != c.exp {
+				t.Errorf("wrong number of clients, expected %d but got %d", c.exp, len(res.Clients))
+			}
+
+			mock.AssertExpectationsForObjects(t, actorProviderMock, oauthClientProviderMock)
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/piotrkowalczuk/charon/internal/mapping"
 	"github.com/piotrkowalczuk/charon/internal/model"
 	"github.com/piotrkowalczuk/charon/internal/session"
+	"github.com/piotrkowalczuk/ntypes"
 
 	"google.golang.org/grpc/codes"
 )
@@ -26,26 +27,51 @@ func (lph *listPermissionsHandler) List(ctx context.Context, req *charonrpc.List
 		return nil, err
 	}
 
+	pg, err := mapping.NewPaging(req, model.TablePermissionColumnID, mapping.PermissionPageColumns...)
+	if err != nil {
+		return nil, grpcerr.E(codes.InvalidArgument, "list of permissions cannot be paged", err)
+	}
+
+	cri := &model.PermissionCriteria{
+		Subsystem: req.Subsystem,
+		Module:    req.Module,
+		Action:    req.Action,
+	}
+	var total *ntypes.Int64
+	if req.IncludeTotal {
+		n, err := lph.repository.permission.Count(ctx, &model.PermissionCountExpr{Where: cri})
+		if err != nil {
+			return nil, grpcerr.E(codes.Internal, "count permissions query failed", err)
+		}
+		total = &ntypes.Int64{Int64: n, Valid: true}
+	}
+	where, err := mapping.PermissionPage(cri, pg)
+	if err != nil {
+		return nil, grpcerr.E(codes.InvalidArgument, "list of permissions cannot be paged", err)
+	}
+
 	entities, err := lph.repository.permission.Find(ctx, &model.PermissionFindExpr{
-		Offset:  req.Offset.Int64Or(0),
-		Limit:   req.Limit.Int64Or(10),
-		OrderBy: mapping.OrderBy(req.OrderBy),
-		Where: &model.PermissionCriteria{
-			Subsystem: req.Subsystem,
-			Module:    req.Module,
-			Action:    req.Action,
-		},
+		Offset:  pg.Offset,
+		Limit:   pg.Limit,
+		OrderBy: pg.OrderBy,
+		Where:   where,
 	})
 	if err != nil {
 		return nil, grpcerr.E(codes.Internal, "find permission query failed", err)
 	}
+	next, err := mapping.PermissionNextPageToken(pg, entities)
+	if err != nil {
+		return nil, grpcerr.E(codes.Internal, "next page token cannot be issued", err)
+	}
 
 	permissions := make([]string, 0, len(entities))
 	for _, e := range entities {
 		permissions = append(permissions, e.Permission().String())
 	}
 	return &charonrpc.ListPermissionsResponse{
-		Permissions: permissions,
+		Permissions:   permissions,
+		NextPageToken: next,
+		Total:         total,
 	}, nil
 }
 
@@ -53,7 +79,7 @@ func (lph *listPermissionsHandler) firewall(req *charonrpc.ListPermissionsReques
 	if act.User.IsSuperuser {
 		return nil
 	}
-	if act.Permissions.Contains(charon.PermissionCanRetrieve) {
+	if act.Permissions.Match(charon.PermissionCanRetrieve) {
 		return nil
 	}
 
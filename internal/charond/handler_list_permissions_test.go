@@ -136,7 +136,7 @@ func TestListPermissionsHandler_List_Unit(t *testing.T) {
 					Where:   &model.PermissionCriteria{},
 					Limit:   10,
 					Offset:  0,
-					OrderBy: []model.RowOrder{},
+					OrderBy: []model.RowOrder{{Name: "id"}},
 				}).Return([]*model.PermissionEntity{{
 					ID:        1,
 					Subsystem: "subsystem",
@@ -158,7 +158,7 @@ func TestListPermissionsHandler_List_Unit(t *testing.T) {
 					Where:   &model.PermissionCriteria{},
 					Limit:   10,
 					Offset:  0,
-					OrderBy: []model.RowOrder{},
+					OrderBy: []model.RowOrder{{Name: "id"}},
 				}).Return([]*model.PermissionEntity{{
 					ID:        1,
 					Subsystem: "subsystem",
@@ -180,7 +180,7 @@ func TestListPermissionsHandler_List_Unit(t *testing.T) {
 					Where:   &model.PermissionCriteria{},
 					Limit:   10,
 					Offset:  0,
-					OrderBy: []model.RowOrder{},
+					OrderBy: []model.RowOrder{{Name: "id"}},
 				}).Return(nil, context.Canceled)
 			},
 			req: charonrpc.ListPermissionsRequest{},
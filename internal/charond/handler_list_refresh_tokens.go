@@ -7,6 +7,7 @@ import (
 	"github.com/piotrkowalczuk/charon/internal/mapping"
 	"github.com/piotrkowalczuk/charon/internal/model"
 	"github.com/piotrkowalczuk/charon/internal/session"
+	"github.com/piotrkowalczuk/ntypes"
 	"github.com/piotrkowalczuk/qtypes"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
@@ -25,11 +26,30 @@ func (lrth *listRefreshTokensHandler) List(ctx context.Context, req *charonrpc.L
 		return nil, err
 	}
 
+	pg, err := mapping.NewPaging(req, model.TableRefreshTokenColumnPrefix, mapping.RefreshTokenPageColumns...)
+	if err != nil {
+		return nil, grpcerr.E(codes.InvalidArgument, "list of refresh tokens cannot be paged", err)
+	}
+
+	cri := mapping.RefreshTokenQuery(req.GetQuery())
+	var total *ntypes.Int64
+	if req.IncludeTotal {
+		n, err := lrth.repository.refreshToken.Count(ctx, &model.RefreshTokenCountExpr{Where: cri})
+		if err != nil {
+			return nil, grpcerr.E(codes.Internal, "count refresh tokens query failed", err)
+		}
+		total = &ntypes.Int64{Int64: n, Valid: true}
+	}
+	where, err := mapping.RefreshTokenPage(cri, pg)
+	if err != nil {
+		return nil, grpcerr.E(codes.InvalidArgument, "list of refresh tokens cannot be paged", err)
+	}
+
 	ents, err := lrth.repository.refreshToken.Find(ctx, &model.RefreshTokenFindExpr{
-		Limit:   req.GetLimit().Int64Or(10),
-		Offset:  req.GetOffset().Int64Or(0),
-		OrderBy: mapping.OrderBy(req.GetOrderBy()),
-		Where:   mapping.RefreshTokenQuery(req.GetQuery()),
+		Limit:   pg.Limit,
+		Offset:  pg.Offset,
+		OrderBy: pg.OrderBy,
+		Where:   where,
 	})
 	if err != nil {
 		return nil, grpcerr.E(codes.Internal, "find refresh token query failed", err)
@@ -39,8 +59,14 @@ func (lrth *listRefreshTokensHandler) List(ctx context.Context, req *charonrpc.L
 	if err != nil {
 		return nil, grpcerr.E(codes.Internal, "refresh token reverse mapping failure")
 	}
+	next, err := mapping.RefreshTokenNextPageToken(pg, ents)
+	if err != nil {
+		return nil, grpcerr.E(codes.Internal, "next page token cannot be issued", err)
+	}
 	return &charonrpc.ListRefreshTokensResponse{
 		RefreshTokens: msg,
+		NextPageToken: next,
+		Total:         total,
 	}, nil
 }
 
@@ -48,10 +74,10 @@ func (lrth *listRefreshTokensHandler) firewall(req *charonrpc.ListRefreshTokensR
 	if act.User.IsSuperuser {
 		return nil
 	}
-	if act.Permissions.Contains(charon.RefreshTokenCanRetrieveAsStranger) {
+	if act.Permissions.Match(charon.RefreshTokenCanRetrieveAsStranger) {
 		return nil
 	}
-	if act.Permissions.Contains(charon.RefreshTokenCanRetrieveAsOwner) {
+	if act.Permissions.Match(charon.RefreshTokenCanRetrieveAsOwner) {
 		if req.Query == nil {
 			req.Query = &charonrpc.RefreshTokenQuery{}
 		}
@@ -69,7 +69,7 @@ func TestListRefreshTokensHandler_List_Unit(t *testing.T) {
 				refreshTokenMock.On("Find", mock.Anything, mock.Anything).Return([]*model.RefreshTokenEntity{
 					{
 						UserID:    1,
-						Token:     "abc",
+						Prefix:    "abc",
 						CreatedAt: brokenDate(),
 					},
 				}, nil)
@@ -97,7 +97,7 @@ func TestListRefreshTokensHandler_List_Unit(t *testing.T) {
 				refreshTokenMock.On("Find", mock.Anything, mock.Anything).Return([]*model.RefreshTokenEntity{
 					{
 						UserID: 1,
-						Token:  "abc",
+						Prefix: "abc",
 					},
 				}, nil)
 			},
@@ -111,7 +111,7 @@ func TestListRefreshTokensHandler_List_Unit(t *testing.T) {
 				refreshTokenMock.On("Find", mock.Anything, mock.Anything).Return([]*model.RefreshTokenEntity{
 					{
 						UserID: 1,
-						Token:  "abc",
+						Prefix: "abc",
 					},
 				}, nil)
 			},
@@ -126,7 +126,7 @@ func TestListRefreshTokensHandler_List_Unit(t *testing.T) {
 				refreshTokenMock.On("Find", mock.Anything, mock.Anything).Return([]*model.RefreshTokenEntity{
 					{
 						UserID: 1,
-						Token:  "abc",
+						Prefix: "abc",
 					},
 				}, nil)
 			},
@@ -0,0 +1,64 @@
+package charond
+
+import (
+	"context"
+
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"google.golang.org/grpc/codes"
+)
+
+type listResourcesHandler struct {
+	*handler
+}
+
+func (lrh *listResourcesHandler) ListResources(ctx context.Context, req *charonrpc.ListResourcesRequest) (*charonrpc.ListResourcesResponse, error) {
+	if req.Permission == "" {
+		return nil, grpcerr.E(codes.InvalidArgument, "permission cannot be empty")
+	}
+	if req.UserId < 1 {
+		return nil, grpcerr.E(codes.InvalidArgument, "user id needs to be greater than zero")
+	}
+	if req.ResourceType == "" {
+		return nil, grpcerr.E(codes.InvalidArgument, "resource type cannot be empty")
+	}
+
+	act, err := lrh.Actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = lrh.firewall(req, act); err != nil {
+		return nil, err
+	}
+
+	permission := charon.Permission(req.Permission)
+	all, err := lrh.repository.user.IsGranted(ctx, req.UserId, permission)
+	if err != nil {
+		return nil, grpcerr.E(codes.Internal, "is granted repository call failure", err)
+	}
+	ids, err := lrh.repository.userResources.FindResourceIDs(ctx, req.UserId, req.ResourceType, permission)
+	if err != nil {
+		return nil, grpcerr.E(codes.Internal, "list of resources cannot be retrieved", err)
+	}
+
+	return &charonrpc.ListResourcesResponse{
+		Ids: ids,
+		All: all,
+	}, nil
+}
+
+func (lrh *listResourcesHandler) firewall(req *charonrpc.ListResourcesRequest, act *session.Actor) error {
+	if act.User.ID == req.UserId {
+		return nil
+	}
+	if act.User.IsSuperuser {
+		return nil
+	}
+	if act.Permissions.Match(charon.UserPermissionCanCheckGrantingAsStranger) {
+		return nil
+	}
+
+	return grpcerr.E(codes.PermissionDenied, "list of resources cannot be retrieved, missing permission")
+}
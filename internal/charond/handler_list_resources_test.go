@@ -0,0 +1,125 @@
+package charond
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/model/modelmock"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	"github.com/piotrkowalczuk/charon/internal/session/sessionmock"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+func TestListResourcesHandler_ListResources_Unit(t *testing.T) {
+	actorProviderMock := &sessionmock.ActorProvider{}
+	userProviderMock := &modelmock.UserProvider{}
+	userResourcesMock := &modelmock.UserResourcePermissionsProvider{}
+
+	cases := map[string]struct {
+		init func(*testing.T)
+		req  charonrpc.ListResourcesRequest
+		res  *charonrpc.ListResourcesResponse
+		err  error
+	}{
+		"missing-permission": {
+			init: func(_ *testing.T) {},
+			req:  charonrpc.ListResourcesRequest{UserId: 1, ResourceType: "comment"},
+			err:  grpcerr.E(codes.InvalidArgument),
+		},
+		"missing-resource-type": {
+			init: func(_ *testing.T) {},
+			req:  charonrpc.ListResourcesRequest{UserId: 1, Permission: "123:123:123"},
+			err:  grpcerr.E(codes.InvalidArgument),
+		},
+		"cannot-list-as-a-stranger-if-missing-permission": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 2}}, nil).
+					Once()
+			},
+			req: charonrpc.ListResourcesRequest{UserId: 1, Permission: "123:123:123", ResourceType: "comment"},
+			err: grpcerr.E(codes.PermissionDenied),
+		},
+		"resources": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1}}, nil).
+					Once()
+				userProviderMock.On("IsGranted", mock.Anything, int64(1), charon.Permission("123:123:123")).
+					Return(false, nil).
+					Once()
+				userResourcesMock.On("FindResourceIDs", mock.Anything, int64(1), "comment", charon.Permission("123:123:123")).
+					Return([]string{"1", "42"}, nil).
+					Once()
+			},
+			req: charonrpc.ListResourcesRequest{UserId: 1, Permission: "123:123:123", ResourceType: "comment"},
+			res: &charonrpc.ListResourcesResponse{Ids: []string{"1", "42"}},
+		},
+		"all-as-a-stranger-with-permission": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{
+						Permissions: charon.Permissions{charon.UserPermissionCanCheckGrantingAsStranger},
+						User:        &model.UserEntity{ID: 2},
+					}, nil).
+					Once()
+				userProviderMock.On("IsGranted", mock.Anything, int64(1), charon.Permission("123:123:123")).
+					Return(true, nil).
+					Once()
+				userResourcesMock.On("FindResourceIDs", mock.Anything, int64(1), "comment", charon.Permission("123:123:123")).
+					Return([]string{}, nil).
+					Once()
+			},
+			req: charonrpc.ListResourcesRequest{UserId: 1, Permission: "123:123:123", ResourceType: "comment"},
+			res: &charonrpc.ListResourcesResponse{Ids: []string{}, All: true},
+		},
+		"request-canceled": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 2, IsSuperuser: true}}, nil).
+					Once()
+				userProviderMock.On("IsGranted", mock.Anything, int64(1), charon.Permission("123:123:123")).
+					Return(false, context.Canceled).
+					Once()
+			},
+			req: charonrpc.ListResourcesRequest{UserId: 1, Permission: "123:123:123", ResourceType: "comment"},
+			err: grpcerr.E(codes.Canceled),
+		},
+	}
+
+	h := listResourcesHandler{
+		handler: &handler{
+			logger:        zap.L(),
+			ActorProvider: actorProviderMock,
+			repository: repositories{
+				user:          userProviderMock,
+				userResources: userResourcesMock,
+			},
+		},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			actorProviderMock.ExpectedCalls = nil
+			userProviderMock.ExpectedCalls = nil
+			userResourcesMock.ExpectedCalls = nil
+
+			c.init(t)
+
+			res, err := h.ListResources(context.TODO(), &c.req)
+			assertError(t, c.err, err)
+			if c.res != nil && !reflect.DeepEqual(c.res, res) {
+				t.Errorf("wrong response, expected:\n%v\nbut got:\n%v", c.res, res)
+			}
+
+			mock.AssertExpectationsForObjects(t, actorProviderMock, userProviderMock, userResourcesMock)
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package charond
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/piotrkowalczuk/mnemosyne"
+	"github.com/piotrkowalczuk/mnemosyne/mnemosynerpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+// errSessionsNotListable is returned if session store cannot narrow sessions down to a single user.
+// Visiting all sessions page by page instead would cost as much as there are sessions of all users,
+// and pages shift under concurrent logins, so some sessions of the user could be missed.
+var errSessionsNotListable = grpcerr.E(codes.FailedPrecondition, "sessions of a single user cannot be retrieved, session store does not support it")
+
+type listSessionsHandler struct {
+	*handler
+}
+
+// List returns sessions of a single user, the one the request is made with is marked as current.
+func (lsh *listSessionsHandler) List(ctx context.Context, req *charonrpc.ListSessionsRequest) (*charonrpc.ListSessionsResponse, error) {
+	if req.UserId == 0 {
+		return nil, grpcerr.E(codes.InvalidArgument, "sessions cannot be listed, missing user id")
+	}
+
+	act, err := lsh.Actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = lsh.firewall(req, act); err != nil {
+		return nil, err
+	}
+
+	sessions, err := lsh.userSessions(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	current := callerAccessToken(ctx)
+	msg := make([]*charonrpc.Session, 0, len(sessions))
+	for _, ses := range sessions {
+		msg = append(msg, reverseSession(ses, req.UserId, current))
+	}
+	return &charonrpc.ListSessionsResponse{Sessions: msg}, nil
+}
+
+func (lsh *listSessionsHandler) firewall(req *charonrpc.ListSessionsRequest, act *session.Actor) error {
+	if act.User.IsSuperuser {
+		return nil
+	}
+	if act.Permissions.Match(charon.SessionCanRetrieveAsStranger) {
+		return nil
+	}
+	if act.Permissions.Match(charon.SessionCanRetrieveAsOwner) {
+		if act.User.ID == req.UserId {
+			return nil
+		}
+		return grpcerr.E(codes.PermissionDenied, "sessions cannot be listed by stranger, missing permission")
+	}
+	return grpcerr.E(codes.PermissionDenied, "sessions cannot be listed, missing permission")
+}
+
+// userSessions returns sessions of given user.
+// Session store has to be able to narrow sessions down to a single subject, otherwise errSessionsNotListable is returned.
+func (h *handler) userSessions(ctx context.Context, userID int64) ([]*mnemosynerpc.Session, error) {
+	sl, ok := h.session.(session.SubjectLister)
+	if !ok {
+		return nil, errSessionsNotListable
+	}
+	sessions, err := sl.ListBySubject(ctx, session.ActorIDFromInt64(userID).String())
+	if err != nil {
+		return nil, grpcerr.E(codes.Internal, "sessions fetch failure", err)
+	}
+	return sessions, nil
+}
+
+// callerAccessToken returns access token the request is made with, if any.
+func callerAccessToken(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md[mnemosyne.AccessTokenMetadataKey]; len(v) > 0 {
+			return v[0]
+		}
+	}
+	return ""
+}
+
+// reverseSession maps session leaving out its access token, the one of the caller is marked as current.
+func reverseSession(ses *mnemosynerpc.Session, userID int64, current string) *charonrpc.Session {
+	msg := &charonrpc.Session{
+		Id:           session.ID(ses.AccessToken),
+		UserId:       userID,
+		Client:       ses.SubjectClient,
+		RemoteAddr:   ses.Bag[session.BagRemoteAddr],
+		ExpireAt:     ses.ExpireAt,
+		RefreshToken: ses.RefreshToken,
+		ApiKey:       ses.Bag[session.BagAPIKey],
+		Current:      current != "" && ses.AccessToken == current,
+	}
+	if at, err := time.Parse(time.RFC3339, ses.Bag[session.BagLastActivity]); err == nil {
+		msg.LastActivityAt, _ = ptypes.TimestampProto(at)
+	}
+	return msg
+}
@@ -0,0 +1,204 @@
+package charond
+
+import (
+	"context"
+	"testing"
+
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/model/modelmock"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	"github.com/piotrkowalczuk/charon/internal/session/sessionmock"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/piotrkowalczuk/mnemosyne"
+	"github.com/piotrkowalczuk/mnemosyne/mnemosynerpc"
+	"github.com/piotrkowalczuk/mnemosyne/mnemosynetest"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// listingSessionStore is a session store that can narrow sessions down to a single subject.
+type listingSessionStore struct {
+	*mnemosynetest.SessionManagerClient
+	*sessionmock.SubjectLister
+}
+
+func TestListSessionsHandler_List_Unit(t *testing.T) {
+	sessionMock := &mnemosynetest.SessionManagerClient{}
+	subjectListerMock := &sessionmock.SubjectLister{}
+	actorProviderMock := &sessionmock.ActorProvider{}
+
+	h := listSessionsHandler{
+		handler: &handler{
+			logger:        zap.L(),
+			ActorProvider: actorProviderMock,
+			session:       listingSessionStore{sessionMock, subjectListerMock},
+		},
+	}
+
+	actor := func(act *session.Actor) {
+		actorProviderMock.On("Actor", mock.Anything).Return(act, nil).Once()
+	}
+	listed := func() {
+		subjectListerMock.On("ListBySubject", mock.Anything, session.ActorIDFromInt64(1).String()).
+			Return([]*mnemosynerpc.Session{
+				{
+					AccessToken:   "current",
+					SubjectId:     session.ActorIDFromInt64(1).String(),
+					SubjectClient: "web",
+					Bag: map[string]string{
+						session.BagRemoteAddr:   "10.0.0.1",
+						session.BagLastActivity: "2026-10-18T12:00:00Z",
+					},
+				},
+				{
+					AccessToken:  "other",
+					SubjectId:    session.ActorIDFromInt64(1).String(),
+					RefreshToken: "abc",
+				},
+			}, nil).
+			Once()
+	}
+
+	cases := map[string]struct {
+		req      charonrpc.ListSessionsRequest
+		init     func(*testing.T)
+		err      error
+		sessions int
+	}{
+		"missing-user-id": {
+			init: func(t *testing.T) {},
+			err:  grpcerr.E(codes.InvalidArgument),
+		},
+		"cannot-list-as-a-stranger": {
+			init: func(t *testing.T) {
+				actor(&session.Actor{
+					User:        &model.UserEntity{ID: 2},
+					Permissions: charon.Permissions{charon.SessionCanRetrieveAsOwner},
+				})
+			},
+			req: charonrpc.ListSessionsRequest{UserId: 1},
+			err: grpcerr.E(codes.PermissionDenied),
+		},
+		"cannot-list-without-permission": {
+			init: func(t *testing.T) {
+				actor(&session.Actor{User: &model.UserEntity{ID: 1}})
+			},
+			req: charonrpc.ListSessionsRequest{UserId: 1},
+			err: grpcerr.E(codes.PermissionDenied),
+		},
+		"store-failure": {
+			init: func(t *testing.T) {
+				actor(&session.Actor{User: &model.UserEntity{ID: 2, IsSuperuser: true}})
+				subjectListerMock.On("ListBySubject", mock.Anything, mock.Anything).
+					Return(nil, status.Error(codes.Unavailable, "database unavailable")).
+					Once()
+			},
+			req: charonrpc.ListSessionsRequest{UserId: 1},
+			err: grpcerr.E(codes.Unavailable),
+		},
+		"as-an-owner": {
+			init: func(t *testing.T) {
+				actor(&session.Actor{
+					User:        &model.UserEntity{ID: 1},
+					Permissions: charon.Permissions{charon.SessionCanRetrieveAsOwner},
+				})
+				listed()
+			},
+			req:      charonrpc.ListSessionsRequest{UserId: 1},
+			sessions: 2,
+		},
+		"as-a-stranger": {
+			init: func(t *testing.T) {
+				actor(&session.Actor{
+					User:        &model.UserEntity{ID: 2},
+					Permissions: charon.Permissions{charon.SessionCanRetrieveAsStranger},
+				})
+				listed()
+			},
+			req:      charonrpc.ListSessionsRequest{UserId: 1},
+			sessions: 2,
+		},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			sessionMock.ExpectedCalls = []*mock.Call{}
+			subjectListerMock.ExpectedCalls = []*mock.Call{}
+			actorProviderMock.ExpectedCalls = []*mock.Call{}
+
+			c.init(t)
+
+			ctx := metadata.NewIncomingContext(context.TODO(), metadata.Pairs(mnemosyne.AccessTokenMetadataKey, "current"))
+			res, err := h.List(ctx, &c.req)
+			if c.err != nil {
+				if !grpcerr.Match(c.err, err) {
+					t.Fatalf("errors do not match, got '%v'", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if len(res.Sessions) != c.sessions {
+				t.Fatalf("wrong number of sessions, expected %d but got %d", c.sessions, len(res.Sessions))
+			}
+
+			current, other := res.Sessions[0], res.Sessions[1]
+			if !current.Current || other.Current {
+				t.Error("only the session of the caller should be marked as current")
+			}
+			if current.Id != session.ID("current") || current.Id == "current" {
+				t.Errorf("wrong id: %s", current.Id)
+			}
+			if current.RemoteAddr != "10.0.0.1" || current.Client != "web" || current.LastActivityAt.GetSeconds() == 0 {
+				t.Errorf("wrong session: %v", current)
+			}
+			if other.RefreshToken != "abc" || other.LastActivityAt != nil {
+				t.Errorf("wrong session: %v", other)
+			}
+
+			mock.AssertExpectationsForObjects(t, sessionMock, subjectListerMock, actorProviderMock)
+		})
+	}
+}
+
+func TestHandler_userSessions_subjectLister(t *testing.T) {
+	sessionProviderMock := &modelmock.SessionProvider{}
+	h := &handler{
+		session: &session.PostgresStore{Repository: sessionProviderMock},
+	}
+
+	// Sessions are narrowed down by the store, nothing else is listed.
+	sessionProviderMock.On("List", mock.Anything, &model.SessionListExpr{SubjectID: session.ActorIDFromInt64(1).String()}).
+		Return([]*model.SessionEntity{
+			{AccessToken: "a", SubjectID: session.ActorIDFromInt64(1).String()},
+		}, nil).
+		Once()
+
+	sessions, err := h.userSessions(context.TODO(), 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(sessions) != 1 || sessions[0].AccessToken != "a" {
+		t.Errorf("wrong sessions: %v", sessions)
+	}
+
+	mock.AssertExpectationsForObjects(t, sessionProviderMock)
+}
+
+func TestHandler_userSessions_notListable(t *testing.T) {
+	sessionMock := &mnemosynetest.SessionManagerClient{}
+	h := &handler{session: sessionMock}
+
+	// Mnemosyne cannot narrow sessions down, visiting all of them is not an option.
+	if _, err := h.userSessions(context.TODO(), 1); !grpcerr.Match(grpcerr.E(codes.FailedPrecondition), err) {
+		t.Fatalf("errors do not match, got '%v'", err)
+	}
+
+	mock.AssertExpectationsForObjects(t, sessionMock)
+}
@@ -2,12 +2,15 @@ package charond
 
 import (
 	"context"
+	"time"
 
 	"github.com/piotrkowalczuk/charon"
 	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
 	"github.com/piotrkowalczuk/charon/internal/grpcerr"
 	"github.com/piotrkowalczuk/charon/internal/mapping"
+	"github.com/piotrkowalczuk/charon/internal/model"
 	"github.com/piotrkowalczuk/charon/internal/session"
+	"github.com/piotrkowalczuk/qtypes"
 
 	"google.golang.org/grpc/codes"
 )
@@ -38,7 +41,35 @@ func (lugh *listUserGroupsHandler) ListGroups(ctx context.Context, req *charonrp
 		return nil, grpcerr.E(codes.Internal, "user group entities mapping failure", err)
 	}
 
-	return &charonrpc.ListUserGroupsResponse{Groups: msg}, nil
+	links, err := lugh.repository.userGroups.Find(ctx, &model.UserGroupsFindExpr{
+		Where: &model.UserGroupsCriteria{
+			UserID: qtypes.EqualInt64(req.Id),
+		},
+	})
+	if err != nil {
+		return nil, grpcerr.E(codes.Internal, "find user groups query failed", err)
+	}
+
+	var (
+		now      = time.Now()
+		validity map[int64]*charonrpc.Validity
+	)
+	for _, link := range links {
+		v := model.Validity{From: link.ValidFrom, Until: link.ValidUntil}
+		if !v.IsTimeBound() || !v.InEffect(now) {
+			continue
+		}
+		window, err := mapping.ReverseValidity(v.From, v.Until)
+		if err != nil {
+			return nil, grpcerr.E(codes.Internal, "user group validity mapping failure", err)
+		}
+		if validity == nil {
+			validity = make(map[int64]*charonrpc.Validity)
+		}
+		validity[link.GroupID] = window
+	}
+
+	return &charonrpc.ListUserGroupsResponse{Groups: msg, Validity: validity}, nil
 }
 
 func (lugh *listUserGroupsHandler) firewall(req *charonrpc.ListUserGroupsRequest, act *session.Actor) error {
@@ -47,7 +78,7 @@ func (lugh *listUserGroupsHandler) firewall(req *charonrpc.ListUserGroupsRequest
 		return nil
 	case act.User.ID == req.Id:
 		return nil
-	case act.Permissions.Contains(charon.UserGroupCanRetrieve):
+	case act.Permissions.Match(charon.UserGroupCanRetrieve):
 		return nil
 	}
 
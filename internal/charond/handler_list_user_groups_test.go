@@ -3,7 +3,9 @@ package charond
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/lib/pq"
 	"github.com/piotrkowalczuk/charon"
 	"github.com/piotrkowalczuk/charon/internal/grpcerr"
 	"github.com/piotrkowalczuk/charon/internal/model"
@@ -52,10 +54,13 @@ func TestListUserGroupsHandler_ListGroups_Unit(t *testing.T) {
 	actorProviderMock := &sessionmock.ActorProvider{}
 	groupProviderMock := &modelmock.GroupProvider{}
 
+	userGroupsProviderMock := &modelmock.UserGroupsProvider{}
+
 	cases := map[string]struct {
-		init func(*testing.T)
-		req  charonrpc.ListUserGroupsRequest
-		err  error
+		init     func(*testing.T)
+		req      charonrpc.ListUserGroupsRequest
+		err      error
+		validity []int64
 	}{
 		"missing-user-id": {
 			init: func(t *testing.T) {
@@ -100,6 +105,9 @@ func TestListUserGroupsHandler_ListGroups_Unit(t *testing.T) {
 					ID:   1,
 					Name: "example",
 				}}, nil)
+				userGroupsProviderMock.On("Find", mock.Anything, mock.Anything).
+					Return([]*model.UserGroupsEntity{}, nil).
+					Once()
 			},
 			req: charonrpc.ListUserGroupsRequest{Id: 1},
 		},
@@ -114,6 +122,9 @@ func TestListUserGroupsHandler_ListGroups_Unit(t *testing.T) {
 					ID:   1,
 					Name: "example",
 				}}, nil)
+				userGroupsProviderMock.On("Find", mock.Anything, mock.Anything).
+					Return([]*model.UserGroupsEntity{}, nil).
+					Once()
 			},
 			req: charonrpc.ListUserGroupsRequest{Id: 12},
 		},
@@ -129,9 +140,34 @@ func TestListUserGroupsHandler_ListGroups_Unit(t *testing.T) {
 					ID:   1,
 					Name: "example",
 				}}, nil)
+				userGroupsProviderMock.On("Find", mock.Anything, mock.Anything).
+					Return([]*model.UserGroupsEntity{}, nil).
+					Once()
 			},
 			req: charonrpc.ListUserGroupsRequest{Id: 1},
 		},
+		"time-bound": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{
+						User: &model.UserEntity{ID: 10, IsSuperuser: true},
+					}, nil).
+					Once()
+				groupProviderMock.On("FindByUserID", mock.Anything, int64(1)).Return([]*model.GroupEntity{{
+					ID:   1,
+					Name: "example",
+				}}, nil)
+				userGroupsProviderMock.On("Find", mock.Anything, mock.Anything).
+					Return([]*model.UserGroupsEntity{
+						{UserID: 1, GroupID: 1, ValidUntil: pq.NullTime{Time: time.Now().Add(time.Hour), Valid: true}},
+						{UserID: 1, GroupID: 2, ValidFrom: pq.NullTime{Time: time.Now().Add(time.Hour), Valid: true}},
+						{UserID: 1, GroupID: 3},
+					}, nil).
+					Once()
+			},
+			req:      charonrpc.ListUserGroupsRequest{Id: 1},
+			validity: []int64{1},
+		},
 		"reverse-mapping-failure": {
 			init: func(t *testing.T) {
 				actorProviderMock.On("Actor", mock.Anything).
@@ -168,7 +204,8 @@ func TestListUserGroupsHandler_ListGroups_Unit(t *testing.T) {
 			logger:        zap.L(),
 			ActorProvider: actorProviderMock,
 			repository: repositories{
-				group: groupProviderMock,
+				group:      groupProviderMock,
+				userGroups: userGroupsProviderMock,
 			},
 		},
 	}
@@ -182,13 +219,24 @@ func TestListUserGroupsHandler_ListGroups_Unit(t *testing.T) {
 			}()
 			actorProviderMock.ExpectedCalls = nil
 			groupProviderMock.ExpectedCalls = nil
+			userGroupsProviderMock.ExpectedCalls = nil
 
 			c.init(t)
 
-			_, err := h.ListGroups(context.TODO(), &c.req)
+			res, err := h.ListGroups(context.TODO(), &c.req)
 			assertError(t, c.err, err)
+			if err == nil {
+				if len(res.Validity) != len(c.validity) {
+					t.Errorf("wrong number of time-bound memberships, expected %d but got %d", len(c.validity), len(res.Validity))
+				}
+				for _, id := range c.validity {
+					if res.Validity[id].GetValidUntil() == nil {
+						t.Errorf("missing validity of group %d", id)
+					}
+				}
+			}
 
-			mock.AssertExpectationsForObjects(t, actorProviderMock, groupProviderMock)
+			mock.AssertExpectationsForObjects(t, actorProviderMock, groupProviderMock, userGroupsProviderMock)
 		})
 	}
 }
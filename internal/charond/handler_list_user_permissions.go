@@ -2,11 +2,15 @@ package charond
 
 import (
 	"context"
+	"time"
 
 	"github.com/piotrkowalczuk/charon"
 	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
 	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/mapping"
+	"github.com/piotrkowalczuk/charon/internal/model"
 	"github.com/piotrkowalczuk/charon/internal/session"
+	"github.com/piotrkowalczuk/qtypes"
 	"google.golang.org/grpc/codes"
 )
 
@@ -36,8 +40,37 @@ func (luph *listUserPermissionsHandler) ListPermissions(ctx context.Context, req
 		perms = append(perms, p.Permission().String())
 	}
 
+	links, err := luph.repository.userPermissions.Find(ctx, &model.UserPermissionsFindExpr{
+		Where: &model.UserPermissionsCriteria{
+			UserID: qtypes.EqualInt64(req.Id),
+		},
+	})
+	if err != nil {
+		return nil, grpcerr.E(codes.Internal, "find user permissions query failed", err)
+	}
+
+	var (
+		now      = time.Now()
+		validity map[string]*charonrpc.Validity
+	)
+	for _, link := range links {
+		v := model.Validity{From: link.ValidFrom, Until: link.ValidUntil}
+		if link.Denied || !v.IsTimeBound() || !v.InEffect(now) {
+			continue
+		}
+		msg, err := mapping.ReverseValidity(v.From, v.Until)
+		if err != nil {
+			return nil, grpcerr.E(codes.Internal, "user permission validity mapping failure", err)
+		}
+		if validity == nil {
+			validity = make(map[string]*charonrpc.Validity)
+		}
+		validity[link.PermissionSubsystem+":"+link.PermissionModule+":"+link.PermissionAction] = msg
+	}
+
 	return &charonrpc.ListUserPermissionsResponse{
 		Permissions: perms,
+		Validity:    validity,
 	}, nil
 }
 
@@ -48,7 +81,7 @@ func (luph *listUserPermissionsHandler) firewall(req *charonrpc.ListUserPermissi
 	if act.User.ID == req.Id {
 		return nil
 	}
-	if act.Permissions.Contains(charon.UserPermissionCanRetrieve) {
+	if act.Permissions.Match(charon.UserPermissionCanRetrieve) {
 		return nil
 	}
 
@@ -3,9 +3,11 @@ package charond
 import (
 	"context"
 	"testing"
+	"time"
 
 	"net"
 
+	"github.com/lib/pq"
 	"github.com/piotrkowalczuk/charon"
 	"github.com/piotrkowalczuk/charon/internal/grpcerr"
 	"github.com/piotrkowalczuk/charon/internal/model"
@@ -55,11 +57,13 @@ func TestListUserPermissionsHandler_ListPermissions_E2E(t *testing.T) {
 func TestListUserPermissionsHandler_ListPermissions_Unit(t *testing.T) {
 	actorProviderMock := &sessionmock.ActorProvider{}
 	permissionProviderMock := &modelmock.PermissionProvider{}
+	userPermissionsProviderMock := &modelmock.UserPermissionsProvider{}
 
 	cases := map[string]struct {
-		init func(*testing.T)
-		req  charonrpc.ListUserPermissionsRequest
-		err  error
+		init     func(*testing.T)
+		req      charonrpc.ListUserPermissionsRequest
+		err      error
+		validity []string
 	}{
 		"missing-user-id": {
 			init: func(t *testing.T) {
@@ -108,6 +112,9 @@ func TestListUserPermissionsHandler_ListPermissions_Unit(t *testing.T) {
 						Action:    "act",
 					}}, nil).
 					Once()
+				userPermissionsProviderMock.On("Find", mock.Anything, mock.Anything).
+					Return([]*model.UserPermissionsEntity{}, nil).
+					Once()
 			},
 			req: charonrpc.ListUserPermissionsRequest{Id: 1},
 		},
@@ -126,6 +133,9 @@ func TestListUserPermissionsHandler_ListPermissions_Unit(t *testing.T) {
 						Action:    "act",
 					}}, nil).
 					Once()
+				userPermissionsProviderMock.On("Find", mock.Anything, mock.Anything).
+					Return([]*model.UserPermissionsEntity{}, nil).
+					Once()
 			},
 			req: charonrpc.ListUserPermissionsRequest{Id: 12},
 		},
@@ -145,9 +155,55 @@ func TestListUserPermissionsHandler_ListPermissions_Unit(t *testing.T) {
 						Action:    "act",
 					}}, nil).
 					Once()
+				userPermissionsProviderMock.On("Find", mock.Anything, mock.Anything).
+					Return([]*model.UserPermissionsEntity{}, nil).
+					Once()
 			},
 			req: charonrpc.ListUserPermissionsRequest{Id: 1},
 		},
+		"time-bound": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{
+						User: &model.UserEntity{ID: 10, IsSuperuser: true},
+					}, nil).
+					Once()
+				permissionProviderMock.On("FindByUserID", mock.Anything, int64(1)).
+					Return([]*model.PermissionEntity{{
+						ID:        1,
+						Subsystem: "sub",
+						Module:    "mod",
+						Action:    "act",
+					}}, nil).
+					Once()
+				userPermissionsProviderMock.On("Find", mock.Anything, mock.Anything).
+					Return([]*model.UserPermissionsEntity{
+						{
+							UserID:              1,
+							PermissionSubsystem: "sub",
+							PermissionModule:    "mod",
+							PermissionAction:    "act",
+							ValidUntil:          pq.NullTime{Time: time.Now().Add(time.Hour), Valid: true},
+						},
+						{
+							UserID:              1,
+							PermissionSubsystem: "sub",
+							PermissionModule:    "mod",
+							PermissionAction:    "expired",
+							ValidUntil:          pq.NullTime{Time: time.Now().Add(-time.Hour), Valid: true},
+						},
+						{
+							UserID:              1,
+							PermissionSubsystem: "sub",
+							PermissionModule:    "mod",
+							PermissionAction:    "permanent",
+						},
+					}, nil).
+					Once()
+			},
+			req:      charonrpc.ListUserPermissionsRequest{Id: 1},
+			validity: []string{"sub:mod:act"},
+		},
 		"storage-query-cancellation": {
 			init: func(t *testing.T) {
 				actorProviderMock.On("Actor", mock.Anything).
@@ -169,7 +225,8 @@ func TestListUserPermissionsHandler_ListPermissions_Unit(t *testing.T) {
 			logger:        zap.L(),
 			ActorProvider: actorProviderMock,
 			repository: repositories{
-				permission: permissionProviderMock,
+				permission:      permissionProviderMock,
+				userPermissions: userPermissionsProviderMock,
 			},
 		},
 	}
@@ -184,13 +241,24 @@ func TestListUserPermissionsHandler_ListPermissions_Unit(t *testing.T) {
 
 			actorProviderMock.ExpectedCalls = nil
 			permissionProviderMock.ExpectedCalls = nil
+			userPermissionsProviderMock.ExpectedCalls = nil
 
 			c.init(t)
 
-			_, err := h.ListPermissions(context.TODO(), &c.req)
+			res, err := h.ListPermissions(context.TODO(), &c.req)
 			assertError(t, c.err, err)
+			if err == nil {
+				if len(res.Validity) != len(c.validity) {
+					t.Errorf("wrong number of time-bound permissions, expected %d but got %d", len(c.validity), len(res.Validity))
+				}
+				for _, p := range c.validity {
+					if res.Validity[p].GetValidUntil() == nil {
+						t.Errorf("missing validity of %s", p)
+					}
+				}
+			}
 
-			mock.AssertExpectationsForObjects(t, actorProviderMock, permissionProviderMock)
+			mock.AssertExpectationsForObjects(t, actorProviderMock, permissionProviderMock, userPermissionsProviderMock)
 		})
 	}
 }
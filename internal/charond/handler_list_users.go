@@ -27,33 +27,57 @@ func (luh *listUsersHandler) List(ctx context.Context, req *charonrpc.ListUsersR
 	if err = luh.firewall(req, act); err != nil {
 		return nil, err
 	}
+	pg, err := mapping.NewPaging(req, model.TableUserColumnID, mapping.UserPageColumns...)
+	if err != nil {
+		return nil, grpcerr.E(codes.InvalidArgument, "list of users cannot be paged", err)
+	}
 
 	cri := &model.UserCriteria{
-		IsSuperuser: allocNilBool(req.IsSuperuser),
-		IsStaff:     allocNilBool(req.IsStaff),
-		CreatedBy:   req.CreatedBy,
+		IsSuperuser:      allocNilBool(req.IsSuperuser),
+		IsStaff:          allocNilBool(req.IsStaff),
+		IsServiceAccount: allocNilBool(req.IsServiceAccount),
+		CreatedBy:        req.CreatedBy,
+		// Soft deleted users are left out, unless only they are requested.
+		DeletedAt: &qtypes.Timestamp{
+			Type:     qtypes.QueryType_NULL,
+			Valid:    true,
+			Negation: req.IsDeleted.BoolOr(false),
+		},
 	}
 
 	if !act.User.IsSuperuser {
 		cri.IsSuperuser = *ntypes.False()
 	}
-	if !act.Permissions.Contains(charon.UserCanRetrieveStaffAsStranger) {
+	if !act.Permissions.Match(charon.UserCanRetrieveStaffAsStranger) {
 		cri.IsStaff = *ntypes.False()
 	}
-	if act.Permissions.Contains(charon.UserCanRetrieveAsOwner, charon.UserCanRetrieveStaffAsOwner) {
+	if act.Permissions.Match(charon.UserCanRetrieveAsOwner, charon.UserCanRetrieveStaffAsOwner) {
 		cri.CreatedBy = qtypes.EqualInt64(act.User.ID)
 	}
 
+	var total *ntypes.Int64
+	if req.IncludeTotal {
+		n, err := luh.repository.user.CountBy(ctx, &model.UserCountExpr{Where: cri})
+		if err != nil {
+			return nil, grpcerr.E(codes.Internal, "count users query failed", err)
+		}
+		total = &ntypes.Int64{Int64: n, Valid: true}
+	}
+	where, err := mapping.UserPage(cri, pg)
+	if err != nil {
+		return nil, grpcerr.E(codes.InvalidArgument, "list of users cannot be paged", err)
+	}
+
 	ents, err := luh.repository.user.Find(ctx, &model.UserFindExpr{
-		OrderBy: mapping.OrderBy(req.OrderBy),
-		Offset:  req.Offset.Int64Or(0),
-		Limit:   req.Limit.Int64Or(10),
-		Where:   cri,
+		OrderBy: pg.OrderBy,
+		Offset:  pg.Offset,
+		Limit:   pg.Limit,
+		Where:   where,
 	})
 	if err != nil {
 		return nil, grpcerr.E(codes.Internal, "find users query failed", err)
 	}
-	return luh.response(ents)
+	return luh.response(ents, pg, total)
 }
 
 func (luh *listUsersHandler) firewall(req *charonrpc.ListUsersRequest, act *session.Actor) error {
@@ -66,35 +90,41 @@ func (luh *listUsersHandler) firewall(req *charonrpc.ListUsersRequest, act *sess
 	// STAFF USERS
 	if req.IsStaff.BoolOr(false) {
 		if req.CreatedBy != nil && req.CreatedBy.Value() == act.User.ID {
-			if !act.Permissions.Contains(charon.UserCanRetrieveStaffAsStranger, charon.UserCanRetrieveStaffAsOwner) {
+			if !act.Permissions.Match(charon.UserCanRetrieveStaffAsStranger, charon.UserCanRetrieveStaffAsOwner) {
 				return grpcerr.E(codes.PermissionDenied, "list of staff users cannot be retrieved as an owner, missing permission")
 			}
 			return nil
 		}
-		if !act.Permissions.Contains(charon.UserCanRetrieveStaffAsStranger) {
+		if !act.Permissions.Match(charon.UserCanRetrieveStaffAsStranger) {
 			return grpcerr.E(codes.PermissionDenied, "list of staff users cannot be retrieved as a stranger, missing permission")
 		}
 		return nil
 	}
 	// NON STAFF USERS
 	if req.CreatedBy != nil && req.CreatedBy.Value() == act.User.ID {
-		if !act.Permissions.Contains(charon.UserCanRetrieveAsStranger, charon.UserCanRetrieveAsOwner) {
+		if !act.Permissions.Match(charon.UserCanRetrieveAsStranger, charon.UserCanRetrieveAsOwner) {
 			return grpcerr.E(codes.PermissionDenied, "list of users cannot be retrieved as an owner, missing permission")
 		}
 		return nil
 	}
-	if !act.Permissions.Contains(charon.UserCanRetrieveAsStranger) {
+	if !act.Permissions.Match(charon.UserCanRetrieveAsStranger) {
 		return grpcerr.E(codes.PermissionDenied, "list of users cannot be retrieved as a stranger, missing permission")
 	}
 	return nil
 }
 
-func (luh *listUsersHandler) response(ents []*model.UserEntity) (*charonrpc.ListUsersResponse, error) {
+func (luh *listUsersHandler) response(ents []*model.UserEntity, pg *mapping.Paging, total *ntypes.Int64) (*charonrpc.ListUsersResponse, error) {
 	msg, err := mapping.ReverseUsers(ents)
 	if err != nil {
 		return nil, grpcerr.E(codes.Internal, "user reverse mapping failure")
 	}
+	next, err := mapping.UserNextPageToken(pg, ents)
+	if err != nil {
+		return nil, grpcerr.E(codes.Internal, "next page token cannot be issued", err)
+	}
 	return &charonrpc.ListUsersResponse{
-		Users: msg,
+		Users:         msg,
+		NextPageToken: next,
+		Total:         total,
 	}, nil
 }
@@ -20,6 +20,7 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 )
 
 func TestListUsersHandler_List_E2E(t *testing.T) {
@@ -90,6 +91,53 @@ func TestListUsersHandler_List_E2E(t *testing.T) {
 			t.Errorf("wrong group name, expected %s but got %s", exp, res.Users[0])
 		}
 	})
+	t.Run("page-token", func(t *testing.T) {
+		req := &charonrpc.ListUsersRequest{
+			IsSuperuser:  ntypes.False(),
+			Limit:        ntypes.NewInt64(3),
+			OrderBy:      []*charonrpc.Order{{Name: model.TableUserColumnUsername}},
+			IncludeTotal: true,
+		}
+		var usernames []string
+		for {
+			res, err := suite.charon.user.List(ctx, req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.Total.Int64Or(0) != int64(max) {
+				t.Errorf("wrong total, expected %d but got %d", max, res.Total.Int64Or(0))
+			}
+			for _, u := range res.Users {
+				usernames = append(usernames, u.Username)
+			}
+			if res.NextPageToken == "" {
+				break
+			}
+			req.PageToken = res.NextPageToken
+		}
+		if len(usernames) != max {
+			t.Fatalf("wrong number of entities, expected %d but got %d: %v", max, len(usernames), usernames)
+		}
+		for i, username := range usernames {
+			if exp := fmt.Sprintf("username-%d", i); username != exp {
+				t.Errorf("wrong username, expected %s but got %s", exp, username)
+			}
+		}
+	})
+	t.Run("page-token-for-different-order", func(t *testing.T) {
+		res, err := suite.charon.user.List(ctx, &charonrpc.ListUsersRequest{Limit: ntypes.NewInt64(1)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = suite.charon.user.List(ctx, &charonrpc.ListUsersRequest{
+			Limit:     ntypes.NewInt64(1),
+			OrderBy:   []*charonrpc.Order{{Name: model.TableUserColumnUsername}},
+			PageToken: res.NextPageToken,
+		})
+		if st, _ := status.FromError(err); st.Code() != codes.InvalidArgument {
+			t.Errorf("wrong error code, expected %s but got %v", codes.InvalidArgument, err)
+		}
+	})
 }
 
 func TestListUsersHandler_List_Unit(t *testing.T) {
@@ -110,6 +158,31 @@ func TestListUsersHandler_List_Unit(t *testing.T) {
 			req: charonrpc.ListUsersRequest{},
 			err: grpcerr.E(codes.Unauthenticated),
 		},
+		"next-page-token-if-page-is-full": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).Return(&session.Actor{
+					User: &model.UserEntity{ID: 2, IsSuperuser: true},
+				}, nil)
+				userProviderMock.On("CountBy", mock.Anything, mock.Anything).Return(int64(5), nil).Once()
+				userProviderMock.On("Find", mock.Anything, mock.MatchedBy(func(expr *model.UserFindExpr) bool {
+					return expr.Limit == 1 && len(expr.OrderBy) == 2 && expr.OrderBy[1].Name == model.TableUserColumnID
+				})).Return([]*model.UserEntity{{ID: 1, Username: "username"}}, nil)
+			},
+			req: charonrpc.ListUsersRequest{
+				Limit:        ntypes.NewInt64(1),
+				OrderBy:      []*charonrpc.Order{{Name: model.TableUserColumnUsername}},
+				IncludeTotal: true,
+			},
+		},
+		"cannot-order-by-unknown-column": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).Return(&session.Actor{
+					User: &model.UserEntity{ID: 2, IsSuperuser: true},
+				}, nil)
+			},
+			req: charonrpc.ListUsersRequest{OrderBy: []*charonrpc.Order{{Name: model.TableUserColumnPassword}}},
+			err: grpcerr.E(codes.InvalidArgument),
+		},
 		"storage-query-cancel": {
 			init: func(t *testing.T) {
 				actorProviderMock.On("Actor", mock.Anything).Return(&session.Actor{
@@ -152,6 +225,28 @@ func TestListUsersHandler_List_Unit(t *testing.T) {
 			},
 			req: charonrpc.ListUsersRequest{IsSuperuser: ntypes.True()},
 		},
+		"deleted-are-left-out-by-default": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).Return(&session.Actor{
+					User: &model.UserEntity{ID: 2, IsSuperuser: true},
+				}, nil)
+				userProviderMock.On("Find", mock.Anything, mock.MatchedBy(func(expr *model.UserFindExpr) bool {
+					return expr.Where.DeletedAt.Type == qtypes.QueryType_NULL && !expr.Where.DeletedAt.Negation
+				})).Return([]*model.UserEntity{}, nil)
+			},
+			req: charonrpc.ListUsersRequest{},
+		},
+		"only-deleted-if-requested": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).Return(&session.Actor{
+					User: &model.UserEntity{ID: 2, IsSuperuser: true},
+				}, nil)
+				userProviderMock.On("Find", mock.Anything, mock.MatchedBy(func(expr *model.UserFindExpr) bool {
+					return expr.Where.DeletedAt.Type == qtypes.QueryType_NULL && expr.Where.DeletedAt.Negation
+				})).Return([]*model.UserEntity{}, nil)
+			},
+			req: charonrpc.ListUsersRequest{IsDeleted: ntypes.True()},
+		},
 		"cannot-retrieve-superuser": {
 			init: func(t *testing.T) {
 				actorProviderMock.On("Actor", mock.Anything).Return(&session.Actor{
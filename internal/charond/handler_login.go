@@ -2,23 +2,39 @@ package charond
 
 import (
 	"context"
-	"fmt"
+	"time"
 
 	"github.com/piotrkowalczuk/charon/internal/service"
 	"go.uber.org/zap"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/piotrkowalczuk/charon/internal/apikey"
 	"github.com/piotrkowalczuk/charon/internal/grpcerr"
 	"github.com/piotrkowalczuk/charon/internal/session"
+	"github.com/piotrkowalczuk/charon/internal/totp"
 	"github.com/piotrkowalczuk/mnemosyne/mnemosynerpc"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
+// refreshTokenHeader carries refresh token that replaced the one used to log in, if rotation is enabled.
+const refreshTokenHeader = "charon-refresh-token"
+
+// secondFactorChallengeLifetime is how long a user has to provide the second factor after successful password check.
+const secondFactorChallengeLifetime = 5 * time.Minute
+
 type loginHandler struct {
 	*handler
 	userFinderFactory *service.UserFinderFactory
+	// throttler is optional, if nil failed attempts are not limited.
+	throttler *service.LoginThrottler
+	metrics   *loginMetrics
 }
 
 // Login implements charonrpc AuthClient interface.
@@ -34,22 +50,84 @@ func (lh *loginHandler) Login(ctx context.Context, r *charonrpc.LoginRequest) (*
 
 	var (
 		userFinder   service.UserFinder
-		refreshToken string
+		refreshToken *service.RefreshTokenUserFinder
+		username     string
+		apiKey       string
+		firstFactor  bool
+		throttled    bool
 	)
 	switch str := r.GetStrategy().(type) {
 	case *charonrpc.LoginRequest_UsernameAndPassword:
+		username = str.UsernameAndPassword.GetUsername()
 		userFinder = lh.userFinderFactory.ByUsernameAndPassword(
-			str.UsernameAndPassword.GetUsername(),
+			username,
 			str.UsernameAndPassword.GetPassword(),
 		)
+		firstFactor = true
+		throttled = true
 	case *charonrpc.LoginRequest_RefreshToken:
-		refreshToken = str.RefreshToken.GetRefreshToken()
-		userFinder = lh.userFinderFactory.ByRefreshToken(refreshToken)
+		refreshToken = lh.userFinderFactory.ByRefreshToken(str.RefreshToken.GetRefreshToken())
+		userFinder = refreshToken
+	case *charonrpc.LoginRequest_Totp:
+		// Username is not known upfront, so only the remote address is throttled.
+		userFinder = lh.userFinderFactory.ByTOTP(
+			str.Totp.GetChallenge(),
+			str.Totp.GetCode(),
+		)
+		throttled = true
+	case *charonrpc.LoginRequest_IdToken:
+		// Identity provider is responsible for throttling of its users,
+		// but second factor enabled in charon is required nonetheless, like after password check.
+		userFinder = lh.userFinderFactory.ByIDToken(str.IdToken.GetIdToken())
+		firstFactor = true
+	case *charonrpc.LoginRequest_ApiKey:
+		// Keys are random and long enough to not require throttling.
+		userFinder = lh.userFinderFactory.ByAPIKey(str.ApiKey.GetKey())
+		apiKey, _, _ = apikey.Split(str.ApiKey.GetKey())
+	default:
+		return nil, grpcerr.E(codes.InvalidArgument, "missing login strategy")
+	}
+	throttled = throttled && lh.throttler != nil
+
+	peer := peerHost(ctx)
+	if throttled {
+		wait, err := lh.throttler.Check(ctx, username, peer)
+		if err != nil {
+			return nil, grpcerr.E(codes.Internal, "login throttling failure", err)
+		}
+		if wait > 0 {
+			lh.metrics.throttled.Inc()
+			return nil, grpcerr.E(codes.ResourceExhausted, "too many failed login attempts, try again later", &errdetails.RetryInfo{
+				RetryDelay: ptypes.DurationProto(wait),
+			})
+		}
 	}
 
 	usr, err := userFinder.FindUser(ctx)
+	// Soft deleted user is indistinguishable from the one that does not exist.
+	if err == nil && usr.DeletedAt.Valid {
+		err = grpcerr.E(codes.Unauthenticated, "user with such username or password does not exists")
+	}
+	if err == nil && usr.LockedAt.Valid {
+		err = service.ErrUserLocked
+	}
 	if err != nil {
-		fmt.Println("error", err)
+		if refreshToken != nil {
+			lh.abandonRevoked(ctx, refreshToken.Revoked())
+		}
+		if err == service.ErrUserLocked {
+			lh.metrics.locked.Inc()
+			return nil, err
+		}
+		if e, ok := err.(*grpcerr.Error); ok && e.Code == codes.Unauthenticated && throttled {
+			locked, ferr := lh.throttler.Failure(ctx, username, peer)
+			if ferr != nil {
+				return nil, grpcerr.E(codes.Internal, "login failure registration failure", ferr)
+			}
+			if locked {
+				lh.logger.Info("user has been locked after too many failed login attempts", zap.String("username", username))
+			}
+		}
 		return nil, err
 	}
 
@@ -61,21 +139,61 @@ func (lh *loginHandler) Login(ctx context.Context, r *charonrpc.LoginRequest) (*
 		return nil, grpcerr.E(codes.Unauthenticated, "user is not active")
 	}
 
+	if firstFactor && usr.TwoFactorConfirmedAt.Valid {
+		challenge, hash, err := totp.GenerateChallenge()
+		if err != nil {
+			return nil, grpcerr.E(codes.Internal, "second factor challenge generation failure", err)
+		}
+		if _, err = lh.repository.user.SetTwoFactorChallenge(ctx, usr.ID, hash, time.Now().Add(secondFactorChallengeLifetime)); err != nil {
+			return nil, grpcerr.E("second factor challenge persistence failure", err)
+		}
+
+		lh.logger.Debug("user second factor has been requested", zap.Int64("user_id", usr.ID))
+
+		return nil, grpcerr.E(codes.Unauthenticated, "second factor required", &charonrpc.SecondFactorRequired{
+			Challenge: challenge,
+		})
+	}
+
+	if throttled {
+		if err = lh.throttler.Success(ctx, usr.Username, peer); err != nil {
+			return nil, grpcerr.E(codes.Internal, "login failures reset failure", err)
+		}
+	}
+
+	bag := map[string]string{
+		"username":   usr.Username,
+		"first_name": usr.FirstName,
+		"last_name":  usr.LastName,
+	}
+	if apiKey != "" {
+		// Session is restricted to permissions of the key, as long as the key is usable.
+		bag[session.BagAPIKey] = apiKey
+	}
+	if peer != "" {
+		bag[session.BagRemoteAddr] = peer
+	}
+	// Session is bound with the prefix, so that it can be abandoned once the token is revoked.
+	var boundTo string
+	if refreshToken != nil {
+		boundTo = refreshToken.Prefix()
+	}
 	res, err := lh.session.Start(ctx, &mnemosynerpc.StartRequest{
 		Session: &mnemosynerpc.Session{
 			SubjectId:     session.ActorIDFromInt64(usr.ID).String(),
 			SubjectClient: r.Client,
-			RefreshToken:  refreshToken,
-			Bag: map[string]string{
-				"username":   usr.Username,
-				"first_name": usr.FirstName,
-				"last_name":  usr.LastName,
-			},
+			RefreshToken:  boundTo,
+			Bag:           bag,
 		},
 	})
 	if err != nil {
 		return nil, grpcerr.E("session start on login failure", err)
 	}
+	if refreshToken != nil && refreshToken.Successor() != "" {
+		if err = grpc.SetHeader(ctx, metadata.Pairs(refreshTokenHeader, refreshToken.Successor())); err != nil {
+			return nil, grpcerr.E(codes.Internal, "rotated refresh token cannot be sent", err)
+		}
+	}
 
 	lh.logger.Debug("user session has been started", zap.Int64("user_id", usr.ID))
 
@@ -88,3 +206,14 @@ func (lh *loginHandler) Login(ctx context.Context, r *charonrpc.LoginRequest) (*
 
 	return &wrappers.StringValue{Value: res.Session.AccessToken}, nil
 }
+
+// abandonRevoked ends sessions bound with refresh tokens revoked due to detected reuse.
+// It is best effort, the tokens are revoked already and cannot be used to start new sessions.
+func (lh *loginHandler) abandonRevoked(ctx context.Context, prefixes []string) {
+	for _, prefix := range prefixes {
+		_, err := lh.session.Delete(ctx, &mnemosynerpc.DeleteRequest{RefreshToken: prefix})
+		if err != nil && status.Code(err) != codes.NotFound {
+			lh.logger.Error("session of revoked refresh token cannot be abandoned", zap.String("prefix", prefix), zap.Error(err))
+		}
+	}
+}
@@ -1,24 +1,36 @@
 package charond
 
 import (
+	"bytes"
 	"context"
+	"database/sql"
+	"errors"
+	"net"
 	"testing"
 	"time"
 
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/lib/pq"
 	"github.com/piotrkowalczuk/mnemosyne/mnemosynerpc"
 	"go.uber.org/zap"
 
 	"github.com/piotrkowalczuk/charon/internal/model"
 
+	"github.com/piotrkowalczuk/charon/internal/apikey"
 	"github.com/piotrkowalczuk/charon/internal/grpcerr"
 	"github.com/piotrkowalczuk/charon/internal/model/modelmock"
 	"github.com/piotrkowalczuk/charon/internal/password"
+	"github.com/piotrkowalczuk/charon/internal/refreshtoken"
 	"github.com/piotrkowalczuk/charon/internal/service"
+	"github.com/piotrkowalczuk/charon/internal/service/servicemock"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	"github.com/piotrkowalczuk/charon/internal/totp"
 	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
 	"github.com/piotrkowalczuk/mnemosyne/mnemosynetest"
 	"github.com/piotrkowalczuk/ntypes"
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 )
 
@@ -26,6 +38,7 @@ func TestLoginHandler_Login_Unit(t *testing.T) {
 	sessionMock := &mnemosynetest.SessionManagerClient{}
 	userProviderMock := &modelmock.UserProvider{}
 	refreshTokenProviderMock := &modelmock.RefreshTokenProvider{}
+	externalAuthenticatorMock := &servicemock.ExternalAuthenticator{}
 	hasher, err := password.NewBCryptHasher(5)
 	if err != nil {
 		t.Fatal(err)
@@ -34,6 +47,24 @@ func TestLoginHandler_Login_Unit(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
+	totpSecret, err := totp.GenerateSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	totpRecoveryCodes, totpRecoveryCodesHashes, err := totp.GenerateRecoveryCodes(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	totpUser := model.UserEntity{
+		ID:                     1,
+		Username:               "test",
+		Password:               pass,
+		IsConfirmed:            true,
+		IsActive:               true,
+		TwoFactorSecret:        totpSecret,
+		TwoFactorConfirmedAt:   pq.NullTime{Time: time.Now(), Valid: true},
+		TwoFactorRecoveryCodes: totpRecoveryCodesHashes,
+	}
 
 	cases := map[string]struct {
 		init func(*testing.T)
@@ -75,6 +106,165 @@ func TestLoginHandler_Login_Unit(t *testing.T) {
 			req: charonrpc.LoginRequest{Username: "test", Password: "test"},
 			err: grpcerr.E(codes.DeadlineExceeded),
 		},
+		"password-rehash-failure": {
+			init: func(t *testing.T) {
+				weaker, err := password.NewBCryptHasher(4)
+				if err != nil {
+					t.Fatal(err)
+				}
+				weakerPass, err := weaker.Hash([]byte("test"))
+				if err != nil {
+					t.Fatal(err)
+				}
+				usr := model.UserEntity{
+					ID:          1,
+					Username:    "test",
+					Password:    weakerPass,
+					IsConfirmed: true,
+					IsActive:    true,
+				}
+				userProviderMock.On("FindOneByUsername", mock.Anything, usr.Username).Return(&usr, nil)
+				userProviderMock.On("ChangePassword", mock.Anything, usr.ID, mock.Anything).Return(context.DeadlineExceeded)
+				// Login carries on despite the failure and reaches the session store.
+				sessionMock.On("Start", mock.Anything, mock.Anything).Return(nil, status.Errorf(codes.Canceled, "example error"))
+			},
+			req: charonrpc.LoginRequest{Username: "test", Password: "test"},
+			err: grpcerr.E(codes.Canceled),
+		},
+		"external-password-mismatch": {
+			init: func(t *testing.T) {
+				usr := model.UserEntity{
+					ID:          1,
+					Username:    "test",
+					Password:    model.ExternalPassword,
+					IsConfirmed: true,
+					IsActive:    true,
+				}
+				userProviderMock.On("FindOneByUsername", mock.Anything, usr.Username).Return(&usr, nil)
+				externalAuthenticatorMock.On("Authenticate", mock.Anything, "test", "test").Return(false, nil)
+			},
+			req: charonrpc.LoginRequest{Username: "test", Password: "test"},
+			err: grpcerr.E(codes.Unauthenticated),
+		},
+		"external-authenticator-failure": {
+			init: func(t *testing.T) {
+				usr := model.UserEntity{
+					ID:          1,
+					Username:    "test",
+					Password:    model.ExternalPassword,
+					IsConfirmed: true,
+					IsActive:    true,
+				}
+				userProviderMock.On("FindOneByUsername", mock.Anything, usr.Username).Return(&usr, nil)
+				externalAuthenticatorMock.On("Authenticate", mock.Anything, "test", "test").Return(false, errors.New("connection refused"))
+			},
+			req: charonrpc.LoginRequest{Username: "test", Password: "test"},
+			err: grpcerr.E(codes.Unavailable),
+		},
+		"external-password": {
+			init: func(t *testing.T) {
+				usr := model.UserEntity{
+					ID:          1,
+					Username:    "test",
+					Password:    model.ExternalPassword,
+					IsConfirmed: true,
+					IsActive:    true,
+				}
+				userProviderMock.On("FindOneByUsername", mock.Anything, usr.Username).Return(&usr, nil)
+				externalAuthenticatorMock.On("Authenticate", mock.Anything, "test", "test").Return(true, nil)
+				sessionMock.On("Start", mock.Anything, mock.Anything).Return(&mnemosynerpc.StartResponse{
+					Session: &mnemosynerpc.Session{
+						AccessToken: "access_token",
+					},
+				}, nil)
+				userProviderMock.On("UpdateLastLoginAt", mock.Anything, usr.ID).Return(int64(1), nil)
+			},
+			req: charonrpc.LoginRequest{Username: "test", Password: "test"},
+		},
+		"locked": {
+			init: func(t *testing.T) {
+				usr := model.UserEntity{
+					ID:          1,
+					Username:    "test",
+					Password:    pass,
+					IsConfirmed: true,
+					IsActive:    true,
+					LockedAt:    pq.NullTime{Time: time.Now(), Valid: true},
+				}
+				userProviderMock.On("FindOneByUsername", mock.Anything, usr.Username).Return(&usr, nil)
+			},
+			req: charonrpc.LoginRequest{Username: "test", Password: "test"},
+			err: grpcerr.E(codes.Unauthenticated),
+		},
+		"deleted": {
+			init: func(t *testing.T) {
+				usr := model.UserEntity{
+					ID:          1,
+					Username:    "test",
+					Password:    pass,
+					IsConfirmed: true,
+					IsActive:    true,
+					DeletedAt:   pq.NullTime{Time: time.Now(), Valid: true},
+				}
+				userProviderMock.On("FindOneByUsername", mock.Anything, usr.Username).Return(&usr, nil)
+			},
+			req: charonrpc.LoginRequest{Username: "test", Password: "test"},
+			err: grpcerr.E(codes.Unauthenticated),
+		},
+		"missing-strategy": {
+			init: func(t *testing.T) {},
+			req:  charonrpc.LoginRequest{},
+			err:  grpcerr.E(codes.InvalidArgument),
+		},
+		"totp-challenge-expired": {
+			init: func(t *testing.T) {
+				userProviderMock.On("ConsumeTwoFactorChallenge", mock.Anything, totp.HashChallenge("challenge")).Return(nil, sql.ErrNoRows)
+			},
+			req: totpLoginRequest("challenge", "123456"),
+			err: grpcerr.E(codes.Unauthenticated),
+		},
+		"totp-invalid-code": {
+			init: func(t *testing.T) {
+				userProviderMock.On("ConsumeTwoFactorChallenge", mock.Anything, totp.HashChallenge("challenge")).Return(&totpUser, nil)
+			},
+			req: totpLoginRequest("challenge", "abcdef"),
+			err: grpcerr.E(codes.Unauthenticated),
+		},
+		"totp-code-used-already": {
+			init: func(t *testing.T) {
+				userProviderMock.On("ConsumeTwoFactorChallenge", mock.Anything, totp.HashChallenge("challenge")).Return(&totpUser, nil)
+				userProviderMock.On("UseTwoFactorStep", mock.Anything, totpUser.ID, mock.Anything).Return(int64(0), nil)
+			},
+			req: totpLoginRequest("challenge", totp.Code(totpSecret, totp.Step(time.Now()))),
+			err: grpcerr.E(codes.Unauthenticated),
+		},
+		"totp-code": {
+			init: func(t *testing.T) {
+				userProviderMock.On("ConsumeTwoFactorChallenge", mock.Anything, totp.HashChallenge("challenge")).Return(&totpUser, nil)
+				userProviderMock.On("UseTwoFactorStep", mock.Anything, totpUser.ID, mock.Anything).Return(int64(1), nil)
+				sessionMock.On("Start", mock.Anything, mock.Anything).Return(&mnemosynerpc.StartResponse{
+					Session: &mnemosynerpc.Session{
+						AccessToken: "access_token",
+					},
+				}, nil)
+				userProviderMock.On("UpdateLastLoginAt", mock.Anything, totpUser.ID).Return(int64(1), nil)
+			},
+			req: totpLoginRequest("challenge", totp.Code(totpSecret, totp.Step(time.Now()))),
+		},
+		"totp-recovery-code": {
+			init: func(t *testing.T) {
+				left, _ := totp.MatchRecoveryCode(totpUser.TwoFactorRecoveryCodes, totpRecoveryCodes[0])
+				userProviderMock.On("ConsumeTwoFactorChallenge", mock.Anything, totp.HashChallenge("challenge")).Return(&totpUser, nil)
+				userProviderMock.On("ReplaceTwoFactorRecoveryCodes", mock.Anything, totpUser.ID, totpUser.TwoFactorRecoveryCodes, left).Return(int64(1), nil)
+				sessionMock.On("Start", mock.Anything, mock.Anything).Return(&mnemosynerpc.StartResponse{
+					Session: &mnemosynerpc.Session{
+						AccessToken: "access_token",
+					},
+				}, nil)
+				userProviderMock.On("UpdateLastLoginAt", mock.Anything, totpUser.ID).Return(int64(1), nil)
+			},
+			req: totpLoginRequest("challenge", totpRecoveryCodes[0]),
+		},
 	}
 
 	h := loginHandler{
@@ -85,10 +275,12 @@ func TestLoginHandler_Login_Unit(t *testing.T) {
 				user: userProviderMock,
 			},
 		},
+		metrics: newLoginMetrics(),
 		userFinderFactory: &service.UserFinderFactory{
 			UserRepository:         userProviderMock,
 			RefreshTokenRepository: refreshTokenProviderMock,
 			Hasher:                 hasher,
+			ExternalAuthenticator:  externalAuthenticatorMock,
 		},
 	}
 
@@ -99,6 +291,7 @@ func TestLoginHandler_Login_Unit(t *testing.T) {
 			sessionMock.ExpectedCalls = nil
 			userProviderMock.ExpectedCalls = nil
 			refreshTokenProviderMock.ExpectedCalls = nil
+			externalAuthenticatorMock.ExpectedCalls = nil
 
 			c.init(t)
 
@@ -112,11 +305,325 @@ func TestLoginHandler_Login_Unit(t *testing.T) {
 				sessionMock,
 				userProviderMock,
 				refreshTokenProviderMock,
+				externalAuthenticatorMock,
 			)
 		})
 	}
 }
 
+func TestLoginHandler_Login_secondFactorRequired_Unit(t *testing.T) {
+	sessionMock := &mnemosynetest.SessionManagerClient{}
+	userProviderMock := &modelmock.UserProvider{}
+	hasher, err := password.NewBCryptHasher(5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pass, err := hasher.Hash([]byte("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	h := loginHandler{
+		handler: &handler{
+			logger:  zap.L(),
+			session: sessionMock,
+			repository: repositories{
+				user: userProviderMock,
+			},
+		},
+		metrics: newLoginMetrics(),
+		userFinderFactory: &service.UserFinderFactory{
+			UserRepository: userProviderMock,
+			Hasher:         hasher,
+		},
+	}
+
+	usr := model.UserEntity{
+		ID:                   1,
+		Username:             "test",
+		Password:             pass,
+		IsConfirmed:          true,
+		IsActive:             true,
+		TwoFactorConfirmedAt: pq.NullTime{Time: time.Now(), Valid: true},
+	}
+	var hash []byte
+	userProviderMock.On("FindOneByUsername", mock.Anything, usr.Username).Return(&usr, nil)
+	userProviderMock.On("SetTwoFactorChallenge", mock.Anything, usr.ID, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			hash = args.Get(2).([]byte)
+		}).
+		Return(int64(1), nil)
+
+	_, err = h.Login(context.Background(), &charonrpc.LoginRequest{Username: "test", Password: "test"})
+	e, ok := err.(*grpcerr.Error)
+	if !ok {
+		t.Fatalf("wrong error type: %T", err)
+	}
+	if e.Code != codes.Unauthenticated {
+		t.Fatalf("wrong error code, expected %s but got %s", codes.Unauthenticated, e.Code)
+	}
+	if len(e.Details) != 1 {
+		t.Fatalf("wrong number of details: %d", len(e.Details))
+	}
+	sfr, ok := e.Details[0].(*charonrpc.SecondFactorRequired)
+	if !ok {
+		t.Fatalf("wrong detail type: %T", e.Details[0])
+	}
+	if !bytes.Equal(totp.HashChallenge(sfr.Challenge), hash) {
+		t.Error("persisted challenge hash does not match returned challenge")
+	}
+
+	mock.AssertExpectationsForObjects(t, sessionMock, userProviderMock)
+}
+
+func TestLoginHandler_Login_idTokenWithoutFederation_Unit(t *testing.T) {
+	h := loginHandler{
+		handler: &handler{
+			logger: zap.L(),
+		},
+		metrics:           newLoginMetrics(),
+		userFinderFactory: &service.UserFinderFactory{},
+	}
+
+	_, err := h.Login(context.Background(), &charonrpc.LoginRequest{
+		Strategy: &charonrpc.LoginRequest_IdToken{IdToken: &charonrpc.IDTokenStrategy{IdToken: "token"}},
+	})
+	if e, ok := err.(*grpcerr.Error); !ok || e.Code != codes.FailedPrecondition {
+		t.Fatalf("expected failed precondition error, got: %v", err)
+	}
+}
+
+func TestLoginHandler_Login_apiKey_Unit(t *testing.T) {
+	sessionMock := &mnemosynetest.SessionManagerClient{}
+	userProviderMock := &modelmock.UserProvider{}
+	apiKeyProviderMock := &modelmock.APIKeyProvider{}
+	key, prefix, hash, err := apikey.Random()
+	if err != nil {
+		t.Fatal(err)
+	}
+	usr := model.UserEntity{ID: 1, Username: "service", IsConfirmed: true, IsActive: true, IsServiceAccount: true}
+
+	apiKeyProviderMock.On("FindOneByPrefix", mock.Anything, prefix).
+		Return(&model.APIKeyEntity{ID: 2, UserID: usr.ID, Secret: hash}, nil).
+		Once()
+	apiKeyProviderMock.On("UpdateLastUsedAt", mock.Anything, int64(2)).Return(int64(1), nil).Once()
+	userProviderMock.On("FindOneByID", mock.Anything, usr.ID).Return(&usr, nil).Once()
+	userProviderMock.On("UpdateLastLoginAt", mock.Anything, usr.ID).Return(int64(1), nil).Once()
+	sessionMock.On("Start", mock.Anything, mock.MatchedBy(func(req *mnemosynerpc.StartRequest) bool {
+		return req.Session.Bag[session.BagAPIKey] == prefix
+	})).
+		Return(&mnemosynerpc.StartResponse{Session: &mnemosynerpc.Session{AccessToken: "access_token"}}, nil).
+		Once()
+
+	h := loginHandler{
+		handler: &handler{
+			logger:  zap.L(),
+			session: sessionMock,
+			repository: repositories{
+				user: userProviderMock,
+			},
+		},
+		metrics: newLoginMetrics(),
+		userFinderFactory: &service.UserFinderFactory{
+			UserRepository:   userProviderMock,
+			APIKeyRepository: apiKeyProviderMock,
+		},
+	}
+
+	if _, err = h.Login(context.Background(), &charonrpc.LoginRequest{
+		Strategy: &charonrpc.LoginRequest_ApiKey{ApiKey: &charonrpc.APIKeyStrategy{Key: key}},
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	mock.AssertExpectationsForObjects(t, sessionMock, userProviderMock, apiKeyProviderMock)
+}
+
+func TestLoginHandler_Login_refreshTokenReuse_Unit(t *testing.T) {
+	sessionMock := &mnemosynetest.SessionManagerClient{}
+	refreshTokenProviderMock := &modelmock.RefreshTokenProvider{}
+	token, err := refreshtoken.Random()
+	if err != nil {
+		t.Fatal(err)
+	}
+	prefix := refreshtoken.Prefix(token)
+
+	refreshTokenProviderMock.On("FindOneByPrefix", mock.Anything, prefix).
+		Return(&model.RefreshTokenEntity{
+			UserID:    1,
+			Prefix:    prefix,
+			Secret:    refreshtoken.Hash(token),
+			Family:    prefix,
+			RotatedAt: pq.NullTime{Time: time.Now(), Valid: true},
+		}, nil).
+		Once()
+	refreshTokenProviderMock.On("RevokeFamily", mock.Anything, prefix).Return([]string{prefix, "successor"}, nil).Once()
+	for _, p := range []string{prefix, "successor"} {
+		p := p
+		sessionMock.On("Delete", mock.Anything, mock.MatchedBy(func(req *mnemosynerpc.DeleteRequest) bool {
+			return req.RefreshToken == p
+		})).
+			Return(&wrappers.Int64Value{Value: 1}, nil).
+			Once()
+	}
+
+	h := loginHandler{
+		handler: &handler{
+			logger:  zap.L(),
+			session: sessionMock,
+		},
+		metrics: newLoginMetrics(),
+		userFinderFactory: &service.UserFinderFactory{
+			RefreshTokenRepository: refreshTokenProviderMock,
+		},
+	}
+
+	_, err = h.Login(context.Background(), &charonrpc.LoginRequest{
+		Strategy: &charonrpc.LoginRequest_RefreshToken{RefreshToken: &charonrpc.RefreshTokenStrategy{RefreshToken: token}},
+	})
+	if e, ok := err.(*grpcerr.Error); !ok || e.Code != codes.Unauthenticated {
+		t.Fatalf("expected unauthenticated error, got: %v", err)
+	}
+
+	mock.AssertExpectationsForObjects(t, sessionMock, refreshTokenProviderMock)
+}
+
+func TestLoginHandler_Login_throttling_Unit(t *testing.T) {
+	sessionMock := &mnemosynetest.SessionManagerClient{}
+	userProviderMock := &modelmock.UserProvider{}
+	loginFailureProviderMock := &modelmock.LoginFailureProvider{}
+	hasher, err := password.NewBCryptHasher(5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pass, err := hasher.Hash([]byte("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	usr := model.UserEntity{
+		ID:          1,
+		Username:    "test",
+		Password:    pass,
+		IsConfirmed: true,
+		IsActive:    true,
+	}
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 50000},
+	})
+
+	cases := map[string]struct {
+		init func(*testing.T)
+		req  charonrpc.LoginRequest
+		code codes.Code
+	}{
+		"throttled": {
+			init: func(t *testing.T) {
+				loginFailureProviderMock.On("FindOneByKindAndSubject", mock.Anything, model.LoginFailureKindUsername, "test").
+					Return(nil, sql.ErrNoRows)
+				loginFailureProviderMock.On("FindOneByKindAndSubject", mock.Anything, model.LoginFailureKindPeer, "10.0.0.1").
+					Return(&model.LoginFailureEntity{Failures: 5, LastFailureAt: time.Now()}, nil)
+			},
+			req:  charonrpc.LoginRequest{Username: "test", Password: "test"},
+			code: codes.ResourceExhausted,
+		},
+		"failure": {
+			init: func(t *testing.T) {
+				loginFailureProviderMock.On("FindOneByKindAndSubject", mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, sql.ErrNoRows)
+				userProviderMock.On("FindOneByUsername", mock.Anything, usr.Username).Return(&usr, nil)
+				loginFailureProviderMock.On("Register", mock.Anything, model.LoginFailureKindUsername, "test", mock.Anything).
+					Return(&model.LoginFailureEntity{Failures: 10}, nil)
+				loginFailureProviderMock.On("Register", mock.Anything, model.LoginFailureKindPeer, "10.0.0.1", mock.Anything).
+					Return(&model.LoginFailureEntity{Failures: 1}, nil)
+				userProviderMock.On("Lock", mock.Anything, "test").Return(int64(1), nil)
+			},
+			req:  charonrpc.LoginRequest{Username: "test", Password: "wrong"},
+			code: codes.Unauthenticated,
+		},
+		"success": {
+			init: func(t *testing.T) {
+				loginFailureProviderMock.On("FindOneByKindAndSubject", mock.Anything, model.LoginFailureKindUsername, "test").
+					Return(&model.LoginFailureEntity{Failures: 3, LastFailureAt: time.Now()}, nil)
+				loginFailureProviderMock.On("FindOneByKindAndSubject", mock.Anything, model.LoginFailureKindPeer, "10.0.0.1").
+					Return(nil, sql.ErrNoRows)
+				userProviderMock.On("FindOneByUsername", mock.Anything, usr.Username).Return(&usr, nil)
+				loginFailureProviderMock.On("Reset", mock.Anything, model.LoginFailureKindUsername, "test").Return(int64(1), nil)
+				loginFailureProviderMock.On("Reset", mock.Anything, model.LoginFailureKindPeer, "10.0.0.1").Return(int64(0), nil)
+				sessionMock.On("Start", mock.Anything, mock.Anything).Return(&mnemosynerpc.StartResponse{
+					Session: &mnemosynerpc.Session{
+						AccessToken: "access_token",
+					},
+				}, nil)
+				userProviderMock.On("UpdateLastLoginAt", mock.Anything, usr.ID).Return(int64(1), nil)
+			},
+			req: charonrpc.LoginRequest{Username: "test", Password: "test"},
+		},
+	}
+
+	h := loginHandler{
+		handler: &handler{
+			logger:  zap.L(),
+			session: sessionMock,
+			repository: repositories{
+				user:         userProviderMock,
+				loginFailure: loginFailureProviderMock,
+			},
+		},
+		metrics: newLoginMetrics(),
+		userFinderFactory: &service.UserFinderFactory{
+			UserRepository: userProviderMock,
+			Hasher:         hasher,
+		},
+		throttler: &service.LoginThrottler{
+			Repository:       loginFailureProviderMock,
+			UserRepository:   userProviderMock,
+			FreeAttempts:     3,
+			Delay:            time.Second,
+			MaxDelay:         time.Minute,
+			Window:           time.Hour,
+			LockoutThreshold: 10,
+		},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			defer recoverTest(t)
+
+			sessionMock.ExpectedCalls = nil
+			userProviderMock.ExpectedCalls = nil
+			loginFailureProviderMock.ExpectedCalls = nil
+
+			c.init(t)
+
+			_, err := h.Login(ctx, &c.req)
+			if c.code == codes.OK {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err.Error())
+				}
+			} else if e, ok := err.(*grpcerr.Error); !ok || e.Code != c.code {
+				t.Fatalf("wrong error, expected code %s but got: %v", c.code, err)
+			}
+
+			mock.AssertExpectationsForObjects(t,
+				sessionMock,
+				userProviderMock,
+				loginFailureProviderMock,
+			)
+		})
+	}
+}
+
+func totpLoginRequest(challenge, code string) charonrpc.LoginRequest {
+	return charonrpc.LoginRequest{
+		Strategy: &charonrpc.LoginRequest_Totp{
+			Totp: &charonrpc.TOTPStrategy{
+				Challenge: challenge,
+				Code:      code,
+			},
+		},
+	}
+}
+
 func TestLoginHandler_Login_E2E(t *testing.T) {
 	suite := &endToEndSuite{}
 	suite.setup(t)
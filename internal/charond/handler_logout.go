@@ -26,6 +26,9 @@ func (lh *logoutHandler) Logout(ctx context.Context, r *charonrpc.LogoutRequest)
 	if err != nil {
 		return nil, err
 	}
+	if lh.actorCache != nil {
+		lh.actorCache.Forget(r.AccessToken)
+	}
 
 	return &empty.Empty{}, nil
 }
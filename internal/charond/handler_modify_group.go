@@ -22,7 +22,7 @@ func (mgh *modifyGroupHandler) Modify(ctx context.Context, req *charonrpc.Modify
 	if req.Id <= 0 {
 		return nil, grpcerr.E(codes.InvalidArgument, "group id is missing")
 	}
-	if !req.GetName().GetValid() && !req.GetDescription().GetValid() {
+	if !req.GetName().GetValid() && !req.GetDescription().GetValid() && !req.GetParentId().GetValid() {
 		return nil, grpcerr.E(codes.InvalidArgument, "nothing to be modified")
 	}
 	act, err := mgh.Actor(ctx)
@@ -34,26 +34,84 @@ func (mgh *modifyGroupHandler) Modify(ctx context.Context, req *charonrpc.Modify
 		return nil, err
 	}
 
-	group, err := mgh.repository.group.UpdateOneByID(ctx, req.Id, &model.GroupPatch{
-		UpdatedBy:   ntypes.Int64{Int64: act.User.ID, Valid: true},
-		Name:        allocNilString(req.Name),
-		Description: allocNilString(req.Description),
+	var (
+		group *model.GroupEntity
+		entry = &auditEntry{targetKind: model.AuditEventTargetGroup, targetID: req.Id}
+	)
+	err = mgh.audit(ctx, act, entry, func(ctx context.Context) error {
+		if entry.before, err = mgh.repository.group.FindOneByID(ctx, req.Id); err != nil {
+			if err == sql.ErrNoRows {
+				return grpcerr.E(codes.NotFound, "group does not exists")
+			}
+			return grpcerr.E(codes.Internal, "find group by id query failed", err)
+		}
+		if req.GetParentId().GetValid() {
+			if err = mgh.setParent(ctx, req.Id, req.GetParentId().GetInt64()); err != nil {
+				return err
+			}
+		}
+		group, err = mgh.repository.group.UpdateOneByID(ctx, req.Id, &model.GroupPatch{
+			UpdatedBy:   ntypes.Int64{Int64: act.User.ID, Valid: true},
+			Name:        allocNilString(req.Name),
+			Description: allocNilString(req.Description),
+		})
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return grpcerr.E(codes.NotFound, "group does not exists")
+			}
+			return grpcerr.E(codes.Internal, "update group by id query failed", err)
+		}
+		entry.after = group
+		return nil
 	})
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, grpcerr.E(codes.NotFound, "group does not exists")
-		}
-		return nil, grpcerr.E(codes.Internal, "update group by id query failed", err)
+		return nil, err
 	}
 
 	return mgh.response(group)
 }
 
+// setParent nests group in given parent, unless it would make groups form a cycle.
+// Parent id lower than one makes the group a top level group.
+func (mgh *modifyGroupHandler) setParent(ctx context.Context, id, parentID int64) error {
+	if parentID <= 0 {
+		if _, err := mgh.repository.group.SetParent(ctx, id, ntypes.Int64{}); err != nil {
+			return grpcerr.E(codes.Internal, "group parent cannot be removed", err)
+		}
+		return nil
+	}
+	if parentID == id {
+		return grpcerr.E(codes.InvalidArgument, "group cannot be its own parent")
+	}
+
+	// Otherwise concurrent modifications could form a cycle, each one checked against the state from before the others.
+	if err := mgh.repository.group.LockHierarchy(ctx); err != nil {
+		return grpcerr.E(codes.Internal, "group hierarchy cannot be locked", err)
+	}
+	ancestors, err := mgh.repository.group.FindAncestorIDs(ctx, parentID)
+	if err != nil {
+		return grpcerr.E(codes.Internal, "group ancestors fetch failure", err)
+	}
+	for _, ancestorID := range ancestors {
+		if ancestorID == id {
+			return grpcerr.E(codes.FailedPrecondition, "group cannot be nested in its own subgroup")
+		}
+	}
+
+	if _, err = mgh.repository.group.SetParent(ctx, id, ntypes.Int64{Int64: parentID, Valid: true}); err != nil {
+		if model.ErrorConstraint(err) == model.TableGroupConstraintParentIDForeignKey {
+			return grpcerr.E(codes.NotFound, "parent group does not exists")
+		}
+		return grpcerr.E(codes.Internal, "group parent cannot be set", err)
+	}
+	return nil
+}
+
 func (mgh *modifyGroupHandler) firewall(req *charonrpc.ModifyGroupRequest, act *session.Actor) error {
 	if act.User.IsSuperuser {
 		return nil
 	}
-	if act.Permissions.Contains(charon.GroupCanModify) {
+	if act.Permissions.Match(charon.GroupCanModify) {
 		return nil
 	}
 
@@ -8,6 +8,7 @@ import (
 
 	"database/sql"
 
+	"github.com/lib/pq"
 	"github.com/piotrkowalczuk/charon"
 	"github.com/piotrkowalczuk/charon/internal/grpcerr"
 	"github.com/piotrkowalczuk/charon/internal/model"
@@ -56,6 +57,7 @@ func TestModifyGroupHandler_Modify_E2E(t *testing.T) {
 func TestModifyGroupHandler_Modify_Unit(t *testing.T) {
 	actorProviderMock := &sessionmock.ActorProvider{}
 	groupProviderMock := &modelmock.GroupProvider{}
+	auditEventProviderMock := &modelmock.AuditEventProvider{}
 
 	cases := map[string]struct {
 		init func(*testing.T)
@@ -154,6 +156,99 @@ func TestModifyGroupHandler_Modify_Unit(t *testing.T) {
 			},
 			req: charonrpc.ModifyGroupRequest{Id: 1, Name: ntypes.NewString("123")},
 		},
+		"parent-self": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).Return(&session.Actor{
+					User: &model.UserEntity{ID: 2, IsSuperuser: true},
+				}, nil).Once()
+			},
+			req: charonrpc.ModifyGroupRequest{Id: 1, ParentId: ntypes.NewInt64(1)},
+			err: grpcerr.E(codes.InvalidArgument),
+		},
+		"parent-hierarchy-lock-failure": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).Return(&session.Actor{
+					User: &model.UserEntity{ID: 2, IsSuperuser: true},
+				}, nil).Once()
+				groupProviderMock.On("LockHierarchy", mock.Anything).
+					Return(sql.ErrConnDone).
+					Once()
+			},
+			req: charonrpc.ModifyGroupRequest{Id: 1, ParentId: ntypes.NewInt64(3)},
+			err: grpcerr.E(codes.Internal),
+		},
+		"parent-cycle": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).Return(&session.Actor{
+					User: &model.UserEntity{ID: 2, IsSuperuser: true},
+				}, nil).Once()
+				groupProviderMock.On("LockHierarchy", mock.Anything).
+					Return(nil).
+					Once()
+				groupProviderMock.On("FindAncestorIDs", mock.Anything, int64(3)).
+					Return([]int64{4, 1}, nil).
+					Once()
+			},
+			req: charonrpc.ModifyGroupRequest{Id: 1, ParentId: ntypes.NewInt64(3)},
+			err: grpcerr.E(codes.FailedPrecondition),
+		},
+		"parent-does-not-exists": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).Return(&session.Actor{
+					User: &model.UserEntity{ID: 2, IsSuperuser: true},
+				}, nil).Once()
+				groupProviderMock.On("LockHierarchy", mock.Anything).
+					Return(nil).
+					Once()
+				groupProviderMock.On("FindAncestorIDs", mock.Anything, int64(3)).
+					Return(nil, nil).
+					Once()
+				groupProviderMock.On("SetParent", mock.Anything, int64(1), ntypes.Int64{Int64: 3, Valid: true}).
+					Return(int64(0), &pq.Error{Constraint: model.TableGroupConstraintParentIDForeignKey}).
+					Once()
+			},
+			req: charonrpc.ModifyGroupRequest{Id: 1, ParentId: ntypes.NewInt64(3)},
+			err: grpcerr.E(codes.NotFound),
+		},
+		"parent": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).Return(&session.Actor{
+					User: &model.UserEntity{ID: 2, IsSuperuser: true},
+				}, nil).Once()
+				groupProviderMock.On("LockHierarchy", mock.Anything).
+					Return(nil).
+					Once()
+				groupProviderMock.On("FindAncestorIDs", mock.Anything, int64(3)).
+					Return([]int64{4}, nil).
+					Once()
+				groupProviderMock.On("SetParent", mock.Anything, int64(1), ntypes.Int64{Int64: 3, Valid: true}).
+					Return(int64(1), nil).
+					Once()
+				groupProviderMock.On("UpdateOneByID", mock.Anything, int64(1), mock.Anything).Return(&model.GroupEntity{
+					ID:        1,
+					Name:      "name",
+					ParentID:  ntypes.Int64{Int64: 3, Valid: true},
+					CreatedAt: time.Now(),
+				}, nil).Once()
+			},
+			req: charonrpc.ModifyGroupRequest{Id: 1, ParentId: ntypes.NewInt64(3)},
+		},
+		"parent-removal": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).Return(&session.Actor{
+					User: &model.UserEntity{ID: 2, IsSuperuser: true},
+				}, nil).Once()
+				groupProviderMock.On("SetParent", mock.Anything, int64(1), ntypes.Int64{}).
+					Return(int64(1), nil).
+					Once()
+				groupProviderMock.On("UpdateOneByID", mock.Anything, int64(1), mock.Anything).Return(&model.GroupEntity{
+					ID:        1,
+					Name:      "name",
+					CreatedAt: time.Now(),
+				}, nil).Once()
+			},
+			req: charonrpc.ModifyGroupRequest{Id: 1, ParentId: ntypes.NewInt64(0)},
+		},
 		"cannot-modify-without-permissions": {
 			init: func(t *testing.T) {
 				actorProviderMock.On("Actor", mock.Anything).Return(&session.Actor{
@@ -170,7 +265,9 @@ func TestModifyGroupHandler_Modify_Unit(t *testing.T) {
 			logger:        zap.L(),
 			ActorProvider: actorProviderMock,
 			repository: repositories{
-				group: groupProviderMock,
+				group:      groupProviderMock,
+				auditEvent: auditEventProviderMock,
+				transactor: newTransactorMock(),
 			},
 		},
 	}
@@ -181,13 +278,22 @@ func TestModifyGroupHandler_Modify_Unit(t *testing.T) {
 
 			actorProviderMock.ExpectedCalls = nil
 			groupProviderMock.ExpectedCalls = nil
+			auditEventProviderMock.ExpectedCalls = nil
 
 			c.init(t)
+			groupProviderMock.On("FindOneByID", mock.Anything, int64(1)).
+				Return(&model.GroupEntity{ID: 1, Name: "name", CreatedAt: time.Now()}, nil).
+				Maybe()
+			audit := auditEventProviderMock.On("Insert", mock.Anything, auditEventMatcher(model.AuditEventTargetGroup, 1)).
+				Return(&model.AuditEventEntity{}, nil)
+			if c.err != nil {
+				audit.Maybe()
+			}
 
 			_, err := h.Modify(context.TODO(), &c.req)
 			assertError(t, c.err, err)
 
-			mock.AssertExpectationsForObjects(t, actorProviderMock, groupProviderMock)
+			mock.AssertExpectationsForObjects(t, actorProviderMock, groupProviderMock, auditEventProviderMock)
 		})
 	}
 }
@@ -45,34 +45,51 @@ func (muh *modifyUserHandler) Modify(ctx context.Context, req *charonrpc.ModifyU
 		return nil, err
 	}
 
-	ent, err = muh.repository.user.UpdateOneByID(ctx, req.Id, &model.UserPatch{
-		FirstName:   allocNilString(req.FirstName),
-		IsActive:    allocNilBool(req.IsActive),
-		IsConfirmed: allocNilBool(req.IsConfirmed),
-		IsStaff:     allocNilBool(req.IsStaff),
-		IsSuperuser: allocNilBool(req.IsSuperuser),
-		LastName:    allocNilString(req.LastName),
-		Password:    req.SecurePassword,
-		UpdatedBy:   ntypes.Int64{Int64: act.User.ID, Valid: act.User.ID != 0},
-		Username:    allocNilString(req.Username),
-	})
-	if err != nil {
-		switch model.ErrorConstraint(err) {
-		case model.TableUserConstraintUsernameUnique:
-			return nil, grpcerr.E(codes.AlreadyExists, "user with such username already exists")
-		default:
-			if err == sql.ErrNoRows {
-				return nil, grpcerr.E(codes.NotFound, "user does not exists")
+	wasActive := ent.IsActive
+	entry := &auditEntry{
+		targetKind: model.AuditEventTargetUser,
+		targetID:   ent.ID,
+		before:     ent,
+	}
+	err = muh.audit(ctx, act, entry, func(ctx context.Context) error {
+		ent, err = muh.repository.user.UpdateOneByID(ctx, req.Id, &model.UserPatch{
+			FirstName:   allocNilString(req.FirstName),
+			IsActive:    allocNilBool(req.IsActive),
+			IsConfirmed: allocNilBool(req.IsConfirmed),
+			IsStaff:     allocNilBool(req.IsStaff),
+			IsSuperuser: allocNilBool(req.IsSuperuser),
+			LastName:    allocNilString(req.LastName),
+			Password:    req.SecurePassword,
+			UpdatedBy:   ntypes.Int64{Int64: act.User.ID, Valid: act.User.ID != 0},
+			Username:    allocNilString(req.Username),
+		})
+		if err != nil {
+			switch model.ErrorConstraint(err) {
+			case model.TableUserConstraintUsernameUnique:
+				return grpcerr.E(codes.AlreadyExists, "user with such username already exists")
+			default:
+				if err == sql.ErrNoRows {
+					return grpcerr.E(codes.NotFound, "user does not exists")
+				}
+				return grpcerr.E(codes.Internal, "update user by id query failed", err)
 			}
-			return nil, grpcerr.E(codes.Internal, "update user by id query failed", err)
 		}
+		entry.after = ent
+		// Deactivated user should not be able to act on behalf of sessions started before.
+		if wasActive && !ent.IsActive {
+			return muh.abandonUserSessions(ctx, ent.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return muh.response(ent)
 }
 
 func (muh *modifyUserHandler) firewall(req *charonrpc.ModifyUserRequest, act *session.Actor) bool {
-	return act.User.IsSuperuser || act.Permissions.Contains(
+	return act.User.IsSuperuser || act.Permissions.Match(
 		charon.UserCanModifyAsStranger,
 		charon.UserCanModifyAsOwner,
 		charon.UserCanModifyStaffAsStranger,
@@ -93,29 +110,29 @@ func (muh *modifyUserHandler) firewallEntity(req *charonrpc.ModifyUserRequest, e
 	// STAFF USERS
 	if ent.IsStaff {
 		if ent.CreatedBy.Int64Or(0) == act.User.ID {
-			if !act.Permissions.Contains(charon.UserCanModifyStaffAsStranger, charon.UserCanModifyStaffAsOwner) {
+			if !act.Permissions.Match(charon.UserCanModifyStaffAsStranger, charon.UserCanModifyStaffAsOwner) {
 				return grpcerr.E(codes.PermissionDenied, "staff user cannot be modified as an owner, missing permission")
 			}
 			return nil
 		}
-		if !act.Permissions.Contains(charon.UserCanModifyStaffAsStranger) {
+		if !act.Permissions.Match(charon.UserCanModifyStaffAsStranger) {
 			return grpcerr.E(codes.PermissionDenied, "staff user cannot be modified as an stranger, missing permission")
 		}
 		return nil
 	}
 	if req.IsStaff.BoolOr(false) {
-		if !act.Permissions.Contains(charon.UserCanCreateStaff) {
+		if !act.Permissions.Match(charon.UserCanCreateStaff) {
 			return grpcerr.E(codes.PermissionDenied, "regular user cannot be promoted to staff, missing permission")
 		}
 	}
 	// NON STAFF USERS
 	if ent.CreatedBy.Int64Or(0) == act.User.ID {
-		if !act.Permissions.Contains(charon.UserCanModifyAsStranger, charon.UserCanModifyAsOwner) {
+		if !act.Permissions.Match(charon.UserCanModifyAsStranger, charon.UserCanModifyAsOwner) {
 			return grpcerr.E(codes.PermissionDenied, "user cannot be modified as an owner, missing permission")
 		}
 		return nil
 	}
-	if !act.Permissions.Contains(charon.UserCanModifyAsStranger) {
+	if !act.Permissions.Match(charon.UserCanModifyAsStranger) {
 		return grpcerr.E(codes.PermissionDenied, "user cannot be modified as a stranger, missing permission")
 	}
 	return nil
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/lib/pq"
 	"github.com/piotrkowalczuk/charon"
 	"github.com/piotrkowalczuk/charon/internal/grpcerr"
@@ -14,6 +15,8 @@ import (
 	"github.com/piotrkowalczuk/charon/internal/session"
 	"github.com/piotrkowalczuk/charon/internal/session/sessionmock"
 	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/piotrkowalczuk/mnemosyne/mnemosynerpc"
+	"github.com/piotrkowalczuk/mnemosyne/mnemosynetest"
 	"github.com/piotrkowalczuk/ntypes"
 	"github.com/stretchr/testify/mock"
 	"go.uber.org/zap"
@@ -52,12 +55,47 @@ func TestModifyUserHandler_Modify_E2E(t *testing.T) {
 func TestModifyUserHandler_Modify_Unit(t *testing.T) {
 	actorProviderMock := &sessionmock.ActorProvider{}
 	userProviderMock := &modelmock.UserProvider{}
+	auditEventProviderMock := &modelmock.AuditEventProvider{}
+	sessionMock := &mnemosynetest.SessionManagerClient{}
+
+	deactivated := func() {
+		actorProviderMock.On("Actor", mock.Anything).Return(&session.Actor{
+			User: &model.UserEntity{ID: 2, IsSuperuser: true},
+		}, nil).Once()
+		userProviderMock.On("FindOneByID", mock.Anything, int64(1)).
+			Return(&model.UserEntity{ID: 1, IsActive: true}, nil).
+			Once()
+		userProviderMock.On("UpdateOneByID", mock.Anything, int64(1), mock.MatchedBy(func(p *model.UserPatch) bool {
+			return p.IsActive.Valid && !p.IsActive.Bool
+		})).
+			Return(&model.UserEntity{ID: 1, IsActive: false}, nil).
+			Once()
+	}
 
 	cases := map[string]struct {
 		init func(*testing.T)
 		req  charonrpc.ModifyUserRequest
 		err  error
 	}{
+		"deactivation-logs-user-out": {
+			init: func(t *testing.T) {
+				deactivated()
+				sessionMock.On("Delete", mock.Anything, &mnemosynerpc.DeleteRequest{SubjectId: "charon:user:1"}).
+					Return(&wrappers.Int64Value{Value: 3}, nil).
+					Once()
+			},
+			req: charonrpc.ModifyUserRequest{Id: 1, IsActive: ntypes.False()},
+		},
+		"deactivation-session-removal-failure": {
+			init: func(t *testing.T) {
+				deactivated()
+				sessionMock.On("Delete", mock.Anything, &mnemosynerpc.DeleteRequest{SubjectId: "charon:user:1"}).
+					Return(nil, status.Error(codes.Unavailable, "mnemosyne unavailable")).
+					Once()
+			},
+			req: charonrpc.ModifyUserRequest{Id: 1, IsActive: ntypes.False()},
+			err: grpcerr.E(codes.Unavailable),
+		},
 		"user-id-missing": {
 			init: func(t *testing.T) {
 			},
@@ -428,8 +466,11 @@ func TestModifyUserHandler_Modify_Unit(t *testing.T) {
 		handler: &handler{
 			logger:        zap.L(),
 			ActorProvider: actorProviderMock,
+			session:       sessionMock,
 			repository: repositories{
-				user: userProviderMock,
+				user:       userProviderMock,
+				auditEvent: auditEventProviderMock,
+				transactor: newTransactorMock(),
 			},
 		},
 	}
@@ -440,13 +481,20 @@ func TestModifyUserHandler_Modify_Unit(t *testing.T) {
 
 			actorProviderMock.ExpectedCalls = nil
 			userProviderMock.ExpectedCalls = nil
+			auditEventProviderMock.ExpectedCalls = nil
+			sessionMock.ExpectedCalls = nil
 
 			c.init(t)
+			audit := auditEventProviderMock.On("Insert", mock.Anything, auditEventMatcher(model.AuditEventTargetUser, 1)).
+				Return(&model.AuditEventEntity{}, nil)
+			if c.err != nil {
+				audit.Maybe()
+			}
 
 			_, err := h.Modify(context.TODO(), &c.req)
 			assertError(t, c.err, err)
 
-			mock.AssertExpectationsForObjects(t, actorProviderMock, userProviderMock)
+			mock.AssertExpectationsForObjects(t, actorProviderMock, userProviderMock, auditEventProviderMock, sessionMock)
 		})
 	}
 }
@@ -17,14 +17,29 @@ type registerPermissionsHandler struct {
 
 func (rph *registerPermissionsHandler) Register(ctx context.Context, req *charonrpc.RegisterPermissionsRequest) (*charonrpc.RegisterPermissionsResponse, error) {
 	permissions := charon.NewPermissions(req.Permissions...)
-	created, untouched, removed, err := rph.registry.Register(ctx, permissions)
-	if err != nil {
-		switch err {
-		case model.ErrEmptySliceOfPermissions, model.ErrEmptySubsystem, model.ErrorInconsistentSubsystem:
-			return nil, grpcerr.E(codes.InvalidArgument, err)
-		default:
-			return nil, grpcerr.E(codes.Internal, "permission registration failure", err)
+	var (
+		created, untouched, removed int64
+		entry                       = &auditEntry{
+			targetKind: model.AuditEventTargetPermission,
+			after:      auditPermissions(permissions),
+		}
+	)
+	// Registration is done by services, not by users, so there is no actor to record.
+	err := rph.audit(ctx, nil, entry, func(ctx context.Context) (err error) {
+		created, untouched, removed, err = rph.registry.Register(ctx, permissions)
+		if err != nil {
+			switch err {
+			case model.ErrEmptySliceOfPermissions, model.ErrEmptySubsystem, model.ErrorInconsistentSubsystem:
+				return grpcerr.E(codes.InvalidArgument, err)
+			default:
+				return grpcerr.E(codes.Internal, "permission registration failure", err)
+			}
 		}
+		entry.skip = created == 0 && removed == 0
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return &charonrpc.RegisterPermissionsResponse{
@@ -80,6 +80,7 @@ func TestRegisterPermissionsHandler_Register_E2E(t *testing.T) {
 }
 func TestRegisterPermissionsHandler_Register_Unit(t *testing.T) {
 	registryMock := &modelmock.PermissionRegistry{}
+	auditEventProviderMock := &modelmock.AuditEventProvider{}
 
 	cases := map[string]struct {
 		init func(*testing.T)
@@ -126,18 +127,30 @@ func TestRegisterPermissionsHandler_Register_Unit(t *testing.T) {
 	}
 
 	h := registerPermissionsHandler{
+		handler: &handler{
+			repository: repositories{
+				auditEvent: auditEventProviderMock,
+				transactor: newTransactorMock(),
+			},
+		},
 		registry: registryMock,
 	}
 	for hint, c := range cases {
 		t.Run(hint, func(t *testing.T) {
 			registryMock.ExpectedCalls = nil
+			auditEventProviderMock.ExpectedCalls = nil
 
 			c.init(t)
+			audit := auditEventProviderMock.On("Insert", mock.Anything, auditEventMatcher(model.AuditEventTargetPermission, 0)).
+				Return(&model.AuditEventEntity{}, nil)
+			if c.err != nil {
+				audit.Maybe()
+			}
 
 			_, err := h.Register(context.TODO(), &c.req)
 			assertError(t, c.err, err)
 
-			mock.AssertExpectationsForObjects(t, registryMock)
+			mock.AssertExpectationsForObjects(t, registryMock, auditEventProviderMock)
 		})
 	}
 }
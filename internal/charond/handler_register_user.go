@@ -0,0 +1,107 @@
+package charond
+
+import (
+	"context"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/mapping"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/password"
+	"github.com/piotrkowalczuk/charon/internal/service"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+
+	"google.golang.org/grpc/codes"
+)
+
+type registerUserHandler struct {
+	*handler
+	enabled  bool
+	hasher   password.Hasher
+	notifier service.Notifier
+	limiter  *service.RegistrationLimiter
+	// ttl is the period of time a confirmation token is valid for.
+	ttl time.Duration
+}
+
+// Register creates user that is neither confirmed nor active and hands over confirmation token to the notifier.
+// It does not require authentication, so the number of registrations coming from a single address is limited.
+func (ruh *registerUserHandler) Register(ctx context.Context, req *charonrpc.RegisterUserRequest) (*charonrpc.RegisterUserResponse, error) {
+	if !ruh.enabled {
+		return nil, grpcerr.E(codes.PermissionDenied, "registration is disabled")
+	}
+	if len(req.Username) < 3 {
+		return nil, grpcerr.E(codes.InvalidArgument, "username needs to be at least 3 characters long")
+	}
+	if len(req.PlainPassword) < 8 {
+		return nil, grpcerr.E(codes.InvalidArgument, "password needs to be at least 8 characters long")
+	}
+
+	if ruh.limiter != nil {
+		ok, err := ruh.limiter.Allow(ctx, peerHost(ctx))
+		if err != nil {
+			return nil, grpcerr.E(codes.Internal, "registration limiting failure", err)
+		}
+		if !ok {
+			return nil, grpcerr.E(codes.ResourceExhausted, "too many registration attempts, try again later")
+		}
+	}
+
+	hashed, err := ruh.hasher.Hash([]byte(req.PlainPassword))
+	if err != nil {
+		return nil, grpcerr.E(codes.Internal, "password hashing failure", err)
+	}
+	token, hash, err := password.GenerateToken()
+	if err != nil {
+		return nil, grpcerr.E(codes.Internal, "confirmation token generation failure", err)
+	}
+	expireAt := time.Now().Add(ruh.ttl)
+
+	var (
+		ent   *model.UserEntity
+		entry = &auditEntry{targetKind: model.AuditEventTargetUser}
+	)
+	err = ruh.audit(ctx, nil, entry, func(ctx context.Context) error {
+		ent, err = ruh.repository.user.Create(ctx, &model.UserEntity{
+			Username:                  req.Username,
+			Password:                  hashed,
+			FirstName:                 req.FirstName,
+			LastName:                  req.LastName,
+			ConfirmationToken:         hash,
+			ConfirmationTokenExpireAt: pq.NullTime{Time: expireAt, Valid: true},
+		})
+		if err != nil {
+			switch model.ErrorConstraint(err) {
+			case model.TableUserConstraintUsernameUnique:
+				return grpcerr.E(codes.AlreadyExists, "user with such username already exists")
+			default:
+				return grpcerr.E(codes.Internal, "user cannot be persisted", err)
+			}
+		}
+		entry.targetID = ent.ID
+		entry.after = ent
+
+		// Delivery happens within the transaction, so that the user is not created if the token cannot reach them.
+		err = ruh.notifier.Notify(ctx, &service.Notification{
+			Kind:     service.NotificationRegistrationConfirmation,
+			UserID:   ent.ID,
+			Username: ent.Username,
+			Token:    token,
+			ExpireAt: expireAt,
+		})
+		if err != nil {
+			return grpcerr.E(codes.Internal, "confirmation token cannot be delivered", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := mapping.ReverseUser(ent)
+	if err != nil {
+		return nil, grpcerr.E(codes.Internal, "user entity mapping failure", err)
+	}
+	return &charonrpc.RegisterUserResponse{User: msg}, nil
+}
@@ -0,0 +1,192 @@
+package charond
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/model/modelmock"
+	"github.com/piotrkowalczuk/charon/internal/password"
+	"github.com/piotrkowalczuk/charon/internal/password/passwordmock"
+	"github.com/piotrkowalczuk/charon/internal/service"
+	"github.com/piotrkowalczuk/charon/internal/service/servicemock"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+)
+
+func TestRegisterUserHandler_Register_Unit(t *testing.T) {
+	userProviderMock := &modelmock.UserProvider{}
+	loginFailureProviderMock := &modelmock.LoginFailureProvider{}
+	auditEventProviderMock := &modelmock.AuditEventProvider{}
+	hasherMock := &passwordmock.Hasher{}
+	notifierMock := &servicemock.Notifier{}
+
+	h := registerUserHandler{
+		handler: &handler{
+			repository: repositories{
+				user:       userProviderMock,
+				auditEvent: auditEventProviderMock,
+				transactor: newTransactorMock(),
+			},
+		},
+		enabled:  true,
+		hasher:   hasherMock,
+		notifier: notifierMock,
+		limiter: &service.RegistrationLimiter{
+			Repository: loginFailureProviderMock,
+			Limit:      1,
+			Window:     time.Hour,
+		},
+		ttl: time.Hour,
+	}
+	req := charonrpc.RegisterUserRequest{
+		Username:      "john@example.com",
+		PlainPassword: "password",
+		FirstName:     "John",
+		LastName:      "Snow",
+	}
+	// userMatcher ensures that the user is created unconfirmed, inactive and with expiring token.
+	var hash []byte
+	userMatcher := mock.MatchedBy(func(ent *model.UserEntity) bool {
+		hash = ent.ConfirmationToken
+		return ent.Username == req.Username &&
+			string(ent.Password) == "hashed" &&
+			!ent.IsConfirmed && !ent.IsActive && !ent.IsSuperuser && !ent.IsStaff &&
+			ent.ConfirmationTokenExpireAt.Valid && ent.ConfirmationTokenExpireAt.Time.After(time.Now())
+	})
+	// tokenMatcher ensures that the token handed over to the notifier is the one that got persisted.
+	tokenMatcher := mock.MatchedBy(func(n *service.Notification) bool {
+		return n.Kind == service.NotificationRegistrationConfirmation &&
+			n.UserID == 1 &&
+			string(password.HashToken(n.Token)) == string(hash)
+	})
+	allow := func(failures int64) {
+		loginFailureProviderMock.On("Register", mock.Anything, model.LoginFailureKindRegistration, "127.0.0.1", mock.Anything).
+			Return(&model.LoginFailureEntity{Failures: failures}, nil).
+			Once()
+	}
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 50000},
+	})
+	created := &model.UserEntity{
+		ID:                        1,
+		Username:                  req.Username,
+		ConfirmationTokenExpireAt: pq.NullTime{Time: time.Now().Add(time.Hour), Valid: true},
+	}
+
+	cases := map[string]struct {
+		init    func(*testing.T)
+		req     charonrpc.RegisterUserRequest
+		enabled bool
+		err     error
+	}{
+		"disabled": {
+			init: func(t *testing.T) {},
+			req:  req,
+			err:  grpcerr.E(codes.PermissionDenied),
+		},
+		"short-username": {
+			init:    func(t *testing.T) {},
+			req:     charonrpc.RegisterUserRequest{Username: "jo", PlainPassword: "password"},
+			enabled: true,
+			err:     grpcerr.E(codes.InvalidArgument),
+		},
+		"short-password": {
+			init:    func(t *testing.T) {},
+			req:     charonrpc.RegisterUserRequest{Username: "john@example.com", PlainPassword: "pass"},
+			enabled: true,
+			err:     grpcerr.E(codes.InvalidArgument),
+		},
+		"limit-exceeded": {
+			init: func(t *testing.T) {
+				allow(2)
+			},
+			req:     req,
+			enabled: true,
+			err:     grpcerr.E(codes.ResourceExhausted),
+		},
+		"already-exists": {
+			init: func(t *testing.T) {
+				allow(1)
+				hasherMock.On("Hash", []byte("password")).
+					Return([]byte("hashed"), nil).
+					Once()
+				userProviderMock.On("Create", mock.Anything, userMatcher).
+					Return(nil, &pq.Error{Constraint: model.TableUserConstraintUsernameUnique}).
+					Once()
+			},
+			req:     req,
+			enabled: true,
+			err:     grpcerr.E(codes.AlreadyExists),
+		},
+		"notifier-failure": {
+			init: func(t *testing.T) {
+				allow(1)
+				hasherMock.On("Hash", []byte("password")).
+					Return([]byte("hashed"), nil).
+					Once()
+				userProviderMock.On("Create", mock.Anything, userMatcher).
+					Return(created, nil).
+					Once()
+				notifierMock.On("Notify", mock.Anything, tokenMatcher).
+					Return(errors.New("disk full")).
+					Once()
+			},
+			req:     req,
+			enabled: true,
+			err:     grpcerr.E(codes.Internal),
+		},
+		"success": {
+			init: func(t *testing.T) {
+				allow(1)
+				hasherMock.On("Hash", []byte("password")).
+					Return([]byte("hashed"), nil).
+					Once()
+				userProviderMock.On("Create", mock.Anything, userMatcher).
+					Return(created, nil).
+					Once()
+				notifierMock.On("Notify", mock.Anything, tokenMatcher).
+					Return(nil).
+					Once()
+			},
+			req:     req,
+			enabled: true,
+		},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			defer recoverTest(t)
+
+			userProviderMock.ExpectedCalls = nil
+			loginFailureProviderMock.ExpectedCalls = nil
+			auditEventProviderMock.ExpectedCalls = nil
+			hasherMock.ExpectedCalls = nil
+			notifierMock.ExpectedCalls = nil
+			hash = nil
+			h.enabled = c.enabled
+
+			c.init(t)
+			audit := auditEventProviderMock.On("Insert", mock.Anything, auditEventMatcher(model.AuditEventTargetUser, 1)).
+				Return(&model.AuditEventEntity{}, nil)
+			if c.err != nil {
+				audit.Maybe()
+			}
+
+			res, err := h.Register(ctx, &c.req)
+			assertError(t, c.err, err)
+			if c.err == nil && res.User.Id != 1 {
+				t.Errorf("wrong user id, expected 1 but got %d", res.User.Id)
+			}
+
+			mock.AssertExpectationsForObjects(t, userProviderMock, loginFailureProviderMock, auditEventProviderMock, hasherMock, notifierMock)
+		})
+	}
+}
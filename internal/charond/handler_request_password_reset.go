@@ -0,0 +1,84 @@
+package charond
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/password"
+	"github.com/piotrkowalczuk/charon/internal/service"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"go.uber.org/zap"
+
+	"google.golang.org/grpc/codes"
+)
+
+type requestPasswordResetHandler struct {
+	*handler
+	notifier service.Notifier
+	limiter  *service.RegistrationLimiter
+	// ttl is the period of time a reset token is valid for.
+	ttl time.Duration
+}
+
+// RequestPasswordReset issues password reset token and hands it over to the notifier.
+// Any token issued before for the same user stops being valid, so number of requests coming from a single address is limited.
+// The response does not depend on whether the user exists, so that it cannot be used to enumerate users.
+func (rprh *requestPasswordResetHandler) RequestPasswordReset(ctx context.Context, req *charonrpc.RequestPasswordResetRequest) (*empty.Empty, error) {
+	if req.Username == "" {
+		return nil, grpcerr.E(codes.InvalidArgument, "password reset cannot be requested, empty username")
+	}
+
+	if rprh.limiter != nil {
+		ok, err := rprh.limiter.Allow(ctx, peerHost(ctx))
+		if err != nil {
+			return nil, grpcerr.E(codes.Internal, "password reset limiting failure", err)
+		}
+		if !ok {
+			return nil, grpcerr.E(codes.ResourceExhausted, "too many password reset requests, try again later")
+		}
+	}
+
+	usr, err := rprh.repository.user.FindOneByUsername(ctx, req.Username)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			rprh.logger.Debug("password reset requested for non existing user", zap.String("username", req.Username))
+			return &empty.Empty{}, nil
+		}
+		return nil, grpcerr.E(codes.Internal, "user retrieval failure", err)
+	}
+	if bytes.Equal(usr.Password, model.ExternalPassword) {
+		rprh.logger.Debug("password reset requested for user with external password", zap.Int64("user_id", usr.ID))
+		return &empty.Empty{}, nil
+	}
+	if usr.IsServiceAccount || bytes.Equal(usr.Password, model.NoPassword) {
+		rprh.logger.Debug("password reset requested for user without password", zap.Int64("user_id", usr.ID))
+		return &empty.Empty{}, nil
+	}
+
+	token, hash, err := password.GenerateToken()
+	if err != nil {
+		return nil, grpcerr.E(codes.Internal, "password reset token generation failure", err)
+	}
+	expireAt := time.Now().Add(rprh.ttl)
+	if _, err = rprh.repository.user.SetPasswordResetToken(ctx, usr.ID, hash, expireAt); err != nil {
+		return nil, grpcerr.E(codes.Internal, "password reset token persistence failure", err)
+	}
+
+	err = rprh.notifier.Notify(ctx, &service.Notification{
+		Kind:     service.NotificationPasswordReset,
+		UserID:   usr.ID,
+		Username: usr.Username,
+		Token:    token,
+		ExpireAt: expireAt,
+	})
+	if err != nil {
+		return nil, grpcerr.E(codes.Internal, "password reset token cannot be delivered", err)
+	}
+
+	return &empty.Empty{}, nil
+}
@@ -0,0 +1,172 @@
+package charond
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/model/modelmock"
+	"github.com/piotrkowalczuk/charon/internal/password"
+	"github.com/piotrkowalczuk/charon/internal/service"
+	"github.com/piotrkowalczuk/charon/internal/service/servicemock"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+)
+
+func TestRequestPasswordResetHandler_RequestPasswordReset_Unit(t *testing.T) {
+	userProviderMock := &modelmock.UserProvider{}
+	loginFailureProviderMock := &modelmock.LoginFailureProvider{}
+	notifierMock := &servicemock.Notifier{}
+
+	h := requestPasswordResetHandler{
+		handler: &handler{
+			logger: zap.L(),
+			repository: repositories{
+				user: userProviderMock,
+			},
+		},
+		notifier: notifierMock,
+		limiter: &service.RegistrationLimiter{
+			Repository: loginFailureProviderMock,
+			Kind:       model.LoginFailureKindPasswordReset,
+			Limit:      1,
+			Window:     time.Hour,
+		},
+		ttl: time.Hour,
+	}
+	// tokenMatcher ensures that the token handed over to the notifier is the one that got persisted.
+	var hash []byte
+	tokenMatcher := mock.MatchedBy(func(n *service.Notification) bool {
+		return n.Kind == service.NotificationPasswordReset &&
+			n.UserID == 1 &&
+			string(password.HashToken(n.Token)) == string(hash)
+	})
+	persist := func(args mock.Arguments) {
+		hash = args.Get(2).([]byte)
+	}
+	allow := func(failures int64) {
+		loginFailureProviderMock.On("Register", mock.Anything, model.LoginFailureKindPasswordReset, "127.0.0.1", mock.Anything).
+			Return(&model.LoginFailureEntity{Failures: failures}, nil).
+			Once()
+	}
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 50000},
+	})
+
+	cases := map[string]struct {
+		init func(*testing.T)
+		req  charonrpc.RequestPasswordResetRequest
+		err  error
+	}{
+		"empty-username": {
+			init: func(t *testing.T) {},
+			err:  grpcerr.E(codes.InvalidArgument),
+		},
+		"limit-exceeded": {
+			init: func(t *testing.T) {
+				allow(2)
+			},
+			req: charonrpc.RequestPasswordResetRequest{Username: "john@example.com"},
+			err: grpcerr.E(codes.ResourceExhausted),
+		},
+		"user-does-not-exists": {
+			init: func(t *testing.T) {
+				allow(1)
+				userProviderMock.On("FindOneByUsername", mock.Anything, "john@example.com").
+					Return(nil, sql.ErrNoRows).
+					Once()
+			},
+			req: charonrpc.RequestPasswordResetRequest{Username: "john@example.com"},
+		},
+		"external-password": {
+			init: func(t *testing.T) {
+				allow(1)
+				userProviderMock.On("FindOneByUsername", mock.Anything, "john@example.com").
+					Return(&model.UserEntity{ID: 1, Password: model.ExternalPassword}, nil).
+					Once()
+			},
+			req: charonrpc.RequestPasswordResetRequest{Username: "john@example.com"},
+		},
+		"no-password": {
+			init: func(t *testing.T) {
+				allow(1)
+				userProviderMock.On("FindOneByUsername", mock.Anything, "john@example.com").
+					Return(&model.UserEntity{ID: 1, Password: model.NoPassword}, nil).
+					Once()
+			},
+			req: charonrpc.RequestPasswordResetRequest{Username: "john@example.com"},
+		},
+		"query-timeout": {
+			init: func(t *testing.T) {
+				allow(1)
+				userProviderMock.On("FindOneByUsername", mock.Anything, "john@example.com").
+					Return(&model.UserEntity{ID: 1, Username: "john@example.com"}, nil).
+					Once()
+				userProviderMock.On("SetPasswordResetToken", mock.Anything, int64(1), mock.Anything, mock.Anything).
+					Return(int64(0), context.DeadlineExceeded).
+					Once()
+			},
+			req: charonrpc.RequestPasswordResetRequest{Username: "john@example.com"},
+			err: grpcerr.E(codes.DeadlineExceeded),
+		},
+		"notifier-failure": {
+			init: func(t *testing.T) {
+				allow(1)
+				userProviderMock.On("FindOneByUsername", mock.Anything, "john@example.com").
+					Return(&model.UserEntity{ID: 1, Username: "john@example.com"}, nil).
+					Once()
+				userProviderMock.On("SetPasswordResetToken", mock.Anything, int64(1), mock.Anything, mock.Anything).
+					Run(persist).
+					Return(int64(1), nil).
+					Once()
+				notifierMock.On("Notify", mock.Anything, tokenMatcher).
+					Return(errors.New("disk full")).
+					Once()
+			},
+			req: charonrpc.RequestPasswordResetRequest{Username: "john@example.com"},
+			err: grpcerr.E(codes.Internal),
+		},
+		"success": {
+			init: func(t *testing.T) {
+				allow(1)
+				userProviderMock.On("FindOneByUsername", mock.Anything, "john@example.com").
+					Return(&model.UserEntity{ID: 1, Username: "john@example.com"}, nil).
+					Once()
+				userProviderMock.On("SetPasswordResetToken", mock.Anything, int64(1), mock.Anything, mock.Anything).
+					Run(persist).
+					Return(int64(1), nil).
+					Once()
+				notifierMock.On("Notify", mock.Anything, tokenMatcher).
+					Return(nil).
+					Once()
+			},
+			req: charonrpc.RequestPasswordResetRequest{Username: "john@example.com"},
+		},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			defer recoverTest(t)
+
+			userProviderMock.ExpectedCalls = nil
+			loginFailureProviderMock.ExpectedCalls = nil
+			notifierMock.ExpectedCalls = nil
+			hash = nil
+
+			c.init(t)
+
+			_, err := h.RequestPasswordReset(ctx, &c.req)
+			assertError(t, c.err, err)
+
+			mock.AssertExpectationsForObjects(t, userProviderMock, loginFailureProviderMock, notifierMock)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package charond
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/password"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+
+	"google.golang.org/grpc/codes"
+)
+
+type resetPasswordHandler struct {
+	*handler
+	hasher password.Hasher
+}
+
+// ResetPassword sets new password of a user that given reset token was issued for.
+// Token is consumed, so it cannot be used again.
+func (rph *resetPasswordHandler) ResetPassword(ctx context.Context, req *charonrpc.ResetPasswordRequest) (*wrappers.BoolValue, error) {
+	if req.Token == "" {
+		return nil, grpcerr.E(codes.InvalidArgument, "password cannot be reset, empty token")
+	}
+	if len(req.NewPassword) < 8 {
+		return nil, grpcerr.E(codes.InvalidArgument, "password needs to be at least 8 characters long")
+	}
+
+	entry := &auditEntry{
+		targetKind: model.AuditEventTargetUser,
+		after:      map[string]interface{}{"password": "reset"},
+	}
+	// Whoever knows the token acts on behalf of the user, so there is no actor to record.
+	err := rph.audit(ctx, nil, entry, func(ctx context.Context) error {
+		// Token is consumed before the password is hashed, so that nobody can make use of the expensive hasher without a valid token.
+		// If anything fails afterwards, the transaction is rolled back and the token remains valid.
+		usr, err := rph.repository.user.ConsumePasswordResetToken(ctx, password.HashToken(req.Token))
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return grpcerr.E(codes.Unauthenticated, "password reset token is invalid or expired")
+			}
+			return grpcerr.E(codes.Internal, "password reset token cannot be consumed", err)
+		}
+		hashed, err := rph.hasher.Hash([]byte(req.NewPassword))
+		if err != nil {
+			return grpcerr.E(codes.Internal, "password hashing failure", err)
+		}
+		if err = rph.repository.user.ChangePassword(ctx, usr.ID, string(hashed)); err != nil {
+			return grpcerr.E(codes.Internal, "password cannot be reset", err)
+		}
+		entry.targetID = usr.ID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	rph.invalidateActor(entry.targetID)
+
+	return &wrappers.BoolValue{Value: true}, nil
+}
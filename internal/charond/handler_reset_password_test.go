@@ -0,0 +1,117 @@
+package charond
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/model/modelmock"
+	"github.com/piotrkowalczuk/charon/internal/password"
+	"github.com/piotrkowalczuk/charon/internal/password/passwordmock"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+)
+
+func TestResetPasswordHandler_ResetPassword_Unit(t *testing.T) {
+	userProviderMock := &modelmock.UserProvider{}
+	auditEventProviderMock := &modelmock.AuditEventProvider{}
+	hasherMock := &passwordmock.Hasher{}
+
+	h := resetPasswordHandler{
+		handler: &handler{
+			repository: repositories{
+				user:       userProviderMock,
+				auditEvent: auditEventProviderMock,
+				transactor: newTransactorMock(),
+			},
+		},
+		hasher: hasherMock,
+	}
+
+	cases := map[string]struct {
+		init func(*testing.T)
+		req  charonrpc.ResetPasswordRequest
+		err  error
+	}{
+		"empty-token": {
+			init: func(t *testing.T) {},
+			req:  charonrpc.ResetPasswordRequest{NewPassword: "newpassword"},
+			err:  grpcerr.E(codes.InvalidArgument),
+		},
+		"new-password-too-short": {
+			init: func(t *testing.T) {},
+			req:  charonrpc.ResetPasswordRequest{Token: "token", NewPassword: "short"},
+			err:  grpcerr.E(codes.InvalidArgument),
+		},
+		"invalid-token": {
+			init: func(t *testing.T) {
+				userProviderMock.On("ConsumePasswordResetToken", mock.Anything, password.HashToken("token")).
+					Return(nil, sql.ErrNoRows).
+					Once()
+			},
+			req: charonrpc.ResetPasswordRequest{Token: "token", NewPassword: "newpassword"},
+			err: grpcerr.E(codes.Unauthenticated),
+		},
+		"query-timeout": {
+			init: func(t *testing.T) {
+				userProviderMock.On("ConsumePasswordResetToken", mock.Anything, password.HashToken("token")).
+					Return(nil, context.DeadlineExceeded).
+					Once()
+			},
+			req: charonrpc.ResetPasswordRequest{Token: "token", NewPassword: "newpassword"},
+			err: grpcerr.E(codes.DeadlineExceeded),
+		},
+		"hashing-failure": {
+			init: func(t *testing.T) {
+				userProviderMock.On("ConsumePasswordResetToken", mock.Anything, password.HashToken("token")).
+					Return(&model.UserEntity{ID: 1}, nil).
+					Once()
+				hasherMock.On("Hash", []byte("newpassword")).
+					Return(nil, errors.New("hashing failure")).
+					Once()
+			},
+			req: charonrpc.ResetPasswordRequest{Token: "token", NewPassword: "newpassword"},
+			err: grpcerr.E(codes.Internal),
+		},
+		"success": {
+			init: func(t *testing.T) {
+				userProviderMock.On("ConsumePasswordResetToken", mock.Anything, password.HashToken("token")).
+					Return(&model.UserEntity{ID: 1}, nil).
+					Once()
+				hasherMock.On("Hash", []byte("newpassword")).
+					Return([]byte("new-hash"), nil).
+					Once()
+				userProviderMock.On("ChangePassword", mock.Anything, int64(1), "new-hash").
+					Return(nil).
+					Once()
+			},
+			req: charonrpc.ResetPasswordRequest{Token: "token", NewPassword: "newpassword"},
+		},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			defer recoverTest(t)
+
+			userProviderMock.ExpectedCalls = nil
+			auditEventProviderMock.ExpectedCalls = nil
+			hasherMock.ExpectedCalls = nil
+
+			c.init(t)
+			audit := auditEventProviderMock.On("Insert", mock.Anything, auditEventMatcher(model.AuditEventTargetUser, 1)).
+				Return(&model.AuditEventEntity{}, nil)
+			if c.err != nil {
+				audit.Maybe()
+			}
+
+			_, err := h.ResetPassword(context.Background(), &c.req)
+			assertError(t, c.err, err)
+
+			mock.AssertExpectationsForObjects(t, userProviderMock, auditEventProviderMock, hasherMock)
+		})
+	}
+}
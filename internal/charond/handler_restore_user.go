@@ -0,0 +1,70 @@
+package charond
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/mapping"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+
+	"google.golang.org/grpc/codes"
+)
+
+type restoreUserHandler struct {
+	*handler
+}
+
+// Restore brings back soft deleted user. Whoever is permitted to remove the user is permitted to restore it.
+func (ruh *restoreUserHandler) Restore(ctx context.Context, req *charonrpc.RestoreUserRequest) (*charonrpc.RestoreUserResponse, error) {
+	if req.Id <= 0 {
+		return nil, grpcerr.E(codes.InvalidArgument, "user cannot be restored, invalid id")
+	}
+
+	act, err := ruh.Actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ent, err := ruh.repository.user.FindOneByID(ctx, req.Id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, grpcerr.E(codes.NotFound, "user does not exists")
+		}
+		return nil, grpcerr.E(codes.Internal, "user retrieval failure", err)
+	}
+	if err = deletionFirewall(act, ent, "restored"); err != nil {
+		return nil, err
+	}
+	if !ent.DeletedAt.Valid {
+		return nil, grpcerr.E(codes.FailedPrecondition, "user cannot be restored, it is not deleted")
+	}
+
+	var (
+		restored *model.UserEntity
+		entry    = &auditEntry{
+			targetKind: model.AuditEventTargetUser,
+			targetID:   ent.ID,
+			before:     ent,
+		}
+	)
+	err = ruh.audit(ctx, act, entry, func(ctx context.Context) error {
+		if restored, err = ruh.repository.user.RestoreOneByID(ctx, ent.ID); err != nil {
+			if err == sql.ErrNoRows {
+				return grpcerr.E(codes.FailedPrecondition, "user cannot be restored, it is not deleted")
+			}
+			return grpcerr.E(codes.Internal, "user cannot be restored", err)
+		}
+		entry.after = restored
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := mapping.ReverseUser(restored)
+	if err != nil {
+		return nil, grpcerr.E(codes.Internal, "user entity mapping failure", err)
+	}
+	return &charonrpc.RestoreUserResponse{User: msg}, nil
+}
@@ -0,0 +1,160 @@
+package charond
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/model/modelmock"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	"github.com/piotrkowalczuk/charon/internal/session/sessionmock"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/piotrkowalczuk/ntypes"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+)
+
+func TestRestoreUserHandler_Restore_Unit(t *testing.T) {
+	actorProviderMock := &sessionmock.ActorProvider{}
+	userProviderMock := &modelmock.UserProvider{}
+	auditEventProviderMock := &modelmock.AuditEventProvider{}
+
+	h := restoreUserHandler{
+		handler: &handler{
+			ActorProvider: actorProviderMock,
+			repository: repositories{
+				user:       userProviderMock,
+				auditEvent: auditEventProviderMock,
+				transactor: newTransactorMock(),
+			},
+		},
+	}
+	deleted := pq.NullTime{Time: time.Now(), Valid: true}
+
+	cases := map[string]struct {
+		init func(*testing.T)
+		req  charonrpc.RestoreUserRequest
+		err  error
+	}{
+		"invalid-id": {
+			init: func(t *testing.T) {},
+			err:  grpcerr.E(codes.InvalidArgument),
+		},
+		"not-found": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1, IsSuperuser: true}}, nil).
+					Once()
+				userProviderMock.On("FindOneByID", mock.Anything, int64(2)).
+					Return(nil, sql.ErrNoRows).
+					Once()
+			},
+			req: charonrpc.RestoreUserRequest{Id: 2},
+			err: grpcerr.E(codes.NotFound),
+		},
+		"not-deleted": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1, IsSuperuser: true}}, nil).
+					Once()
+				userProviderMock.On("FindOneByID", mock.Anything, int64(2)).
+					Return(&model.UserEntity{ID: 2}, nil).
+					Once()
+			},
+			req: charonrpc.RestoreUserRequest{Id: 2},
+			err: grpcerr.E(codes.FailedPrecondition),
+		},
+		"missing-permission": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{
+						User:        &model.UserEntity{ID: 1},
+						Permissions: charon.Permissions{charon.UserCanDeleteAsOwner},
+					}, nil).
+					Once()
+				userProviderMock.On("FindOneByID", mock.Anything, int64(2)).
+					Return(&model.UserEntity{ID: 2, DeletedAt: deleted}, nil).
+					Once()
+			},
+			req: charonrpc.RestoreUserRequest{Id: 2},
+			err: grpcerr.E(codes.PermissionDenied),
+		},
+		"superuser-by-non-superuser": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{
+						User:        &model.UserEntity{ID: 1},
+						Permissions: charon.Permissions{charon.UserCanDeleteAsStranger},
+					}, nil).
+					Once()
+				userProviderMock.On("FindOneByID", mock.Anything, int64(2)).
+					Return(&model.UserEntity{ID: 2, IsSuperuser: true, DeletedAt: deleted}, nil).
+					Once()
+			},
+			req: charonrpc.RestoreUserRequest{Id: 2},
+			err: grpcerr.E(codes.PermissionDenied),
+		},
+		"restored-in-the-meantime": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1, IsSuperuser: true}}, nil).
+					Once()
+				userProviderMock.On("FindOneByID", mock.Anything, int64(2)).
+					Return(&model.UserEntity{ID: 2, DeletedAt: deleted}, nil).
+					Once()
+				userProviderMock.On("RestoreOneByID", mock.Anything, int64(2)).
+					Return(nil, sql.ErrNoRows).
+					Once()
+			},
+			req: charonrpc.RestoreUserRequest{Id: 2},
+			err: grpcerr.E(codes.FailedPrecondition),
+		},
+		"as-owner": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{
+						User:        &model.UserEntity{ID: 1},
+						Permissions: charon.Permissions{charon.UserCanDeleteAsOwner},
+					}, nil).
+					Once()
+				userProviderMock.On("FindOneByID", mock.Anything, int64(2)).
+					Return(&model.UserEntity{ID: 2, CreatedBy: ntypes.Int64{Int64: 1, Valid: true}, DeletedAt: deleted}, nil).
+					Once()
+				userProviderMock.On("RestoreOneByID", mock.Anything, int64(2)).
+					Return(&model.UserEntity{ID: 2, CreatedBy: ntypes.Int64{Int64: 1, Valid: true}}, nil).
+					Once()
+			},
+			req: charonrpc.RestoreUserRequest{Id: 2},
+		},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			defer recoverTest(t)
+
+			actorProviderMock.ExpectedCalls = nil
+			userProviderMock.ExpectedCalls = nil
+			auditEventProviderMock.ExpectedCalls = nil
+
+			c.init(t)
+			audit := auditEventProviderMock.On("Insert", mock.Anything, auditEventMatcher(model.AuditEventTargetUser, 2)).
+				Return(&model.AuditEventEntity{}, nil)
+			if c.err != nil {
+				audit.Maybe()
+			}
+
+			res, err := h.Restore(context.Background(), &c.req)
+			assertError(t, c.err, err)
+			if c.err == nil && res.User.DeletedAt != nil {
+				t.Error("restored user should not be marked as deleted")
+			}
+
+			mock.AssertExpectationsForObjects(t, actorProviderMock, userProviderMock, auditEventProviderMock)
+		})
+	}
+}
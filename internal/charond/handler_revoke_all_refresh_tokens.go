@@ -0,0 +1,111 @@
+package charond
+
+import (
+	"context"
+
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/mapping"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/piotrkowalczuk/mnemosyne/mnemosynerpc"
+	"github.com/piotrkowalczuk/qtypes"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type revokeAllRefreshTokensHandler struct {
+	*handler
+}
+
+// RevokeAll revokes tokens of a single user at once.
+// Without a query it logs the user out everywhere, sessions started with a password are abandoned as well.
+func (h *revokeAllRefreshTokensHandler) RevokeAll(ctx context.Context, req *charonrpc.RevokeAllRefreshTokensRequest) (*charonrpc.RevokeAllRefreshTokensResponse, error) {
+	if req.UserId == 0 {
+		return nil, grpcerr.E(codes.InvalidArgument, "refresh tokens cannot be revoked, missing user id")
+	}
+
+	act, err := h.Actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = h.firewall(act, req.UserId); err != nil {
+		return nil, err
+	}
+
+	criteria := mapping.RefreshTokenQuery(req.Query)
+	criteria.UserID = qtypes.EqualInt64(req.UserId)
+
+	var (
+		prefixes []string
+		entry    = &auditEntry{
+			targetKind: model.AuditEventTargetRefreshToken,
+			targetID:   req.UserId,
+		}
+	)
+	err = h.audit(ctx, act, entry, func(ctx context.Context) error {
+		prefixes, err = h.repository.refreshToken.RevokeMany(ctx, criteria)
+		if err != nil {
+			return grpcerr.E(codes.Internal, "refresh tokens could not be revoked", err)
+		}
+		entry.after = map[string]interface{}{"revoked": prefixes}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	abandoned, err := h.abandon(ctx, req, prefixes)
+	if err != nil {
+		return nil, err
+	}
+	h.logger.Debug("refresh tokens revoked", zap.Int64("user_id", req.UserId), zap.Int("count", len(prefixes)), zap.Int64("sessions", abandoned))
+
+	return &charonrpc.RevokeAllRefreshTokensResponse{
+		Prefixes:          prefixes,
+		AbandonedSessions: abandoned,
+	}, nil
+}
+
+// abandon removes sessions of the user, if query was given, only those bound with revoked tokens.
+func (h *revokeAllRefreshTokensHandler) abandon(ctx context.Context, req *charonrpc.RevokeAllRefreshTokensRequest, prefixes []string) (int64, error) {
+	subjectID := session.ActorIDFromInt64(req.UserId).String()
+	requests := []*mnemosynerpc.DeleteRequest{{SubjectId: subjectID}}
+	if req.Query != nil {
+		requests = make([]*mnemosynerpc.DeleteRequest, 0, len(prefixes))
+		for _, prefix := range prefixes {
+			requests = append(requests, &mnemosynerpc.DeleteRequest{SubjectId: subjectID, RefreshToken: prefix})
+		}
+	}
+
+	var abandoned int64
+	for _, r := range requests {
+		res, err := h.session.Delete(ctx, r)
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				continue
+			}
+			return abandoned, grpcerr.E(codes.Internal, "sessions could not be removed", err)
+		}
+		abandoned += res.GetValue()
+	}
+	return abandoned, nil
+}
+
+func (h *revokeAllRefreshTokensHandler) firewall(act *session.Actor, userID int64) error {
+	if act.User.IsSuperuser {
+		return nil
+	}
+	if act.Permissions.Match(charon.RefreshTokenCanRevokeAsStranger) {
+		return nil
+	}
+	if act.Permissions.Match(charon.RefreshTokenCanRevokeAsOwner) {
+		if act.User.ID == userID {
+			return nil
+		}
+		return grpcerr.E(codes.PermissionDenied, "refresh tokens cannot be revoked by stranger, missing permission")
+	}
+	return grpcerr.E(codes.PermissionDenied, "refresh tokens cannot be revoked, missing permission")
+}
@@ -0,0 +1,174 @@
+package charond
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/model/modelmock"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	"github.com/piotrkowalczuk/charon/internal/session/sessionmock"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/piotrkowalczuk/mnemosyne/mnemosynerpc"
+	"github.com/piotrkowalczuk/mnemosyne/mnemosynetest"
+	"github.com/piotrkowalczuk/ntypes"
+	"github.com/piotrkowalczuk/qtypes"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRevokeAllRefreshTokensHandler_RevokeAll_Unit(t *testing.T) {
+	sessionMock := &mnemosynetest.SessionManagerClient{}
+	refreshTokenMock := &modelmock.RefreshTokenProvider{}
+	actorProviderMock := &sessionmock.ActorProvider{}
+	auditEventProviderMock := &modelmock.AuditEventProvider{}
+
+	h := revokeAllRefreshTokensHandler{
+		handler: &handler{
+			logger:        zap.L(),
+			ActorProvider: actorProviderMock,
+			session:       sessionMock,
+			repository: repositories{
+				refreshToken: refreshTokenMock,
+				auditEvent:   auditEventProviderMock,
+				transactor:   newTransactorMock(),
+			},
+		},
+	}
+
+	actor := func(act *session.Actor) {
+		actorProviderMock.On("Actor", mock.Anything).Return(act, nil).Once()
+	}
+	revoked := func(prefixes ...string) {
+		refreshTokenMock.On("RevokeMany", mock.Anything, mock.MatchedBy(func(c *model.RefreshTokenCriteria) bool {
+			return c.UserID.GetValues()[0] == 1 && c.UserID.Type == qtypes.QueryType_EQUAL
+		})).Return(prefixes, nil).Once()
+	}
+	deleted := func(refreshToken string, err error) {
+		sessionMock.On("Delete", mock.Anything, mock.MatchedBy(func(req *mnemosynerpc.DeleteRequest) bool {
+			return req.SubjectId == session.ActorIDFromInt64(1).String() && req.RefreshToken == refreshToken
+		})).Return(&wrappers.Int64Value{Value: 1}, err).Once()
+	}
+
+	cases := map[string]struct {
+		req       charonrpc.RevokeAllRefreshTokensRequest
+		init      func(*testing.T)
+		err       error
+		prefixes  int
+		abandoned int64
+	}{
+		"missing-user-id": {
+			init: func(t *testing.T) {},
+			err:  grpcerr.E(codes.InvalidArgument),
+		},
+		"cannot-revoke-as-a-stranger": {
+			init: func(t *testing.T) {
+				actor(&session.Actor{
+					User:        &model.UserEntity{ID: 2},
+					Permissions: charon.Permissions{charon.RefreshTokenCanRevokeAsOwner},
+				})
+			},
+			req: charonrpc.RevokeAllRefreshTokensRequest{UserId: 1},
+			err: grpcerr.E(codes.PermissionDenied),
+		},
+		"cannot-revoke-without-permission": {
+			init: func(t *testing.T) {
+				actor(&session.Actor{User: &model.UserEntity{ID: 1}})
+			},
+			req: charonrpc.RevokeAllRefreshTokensRequest{UserId: 1},
+			err: grpcerr.E(codes.PermissionDenied),
+		},
+		"revoke-failure": {
+			init: func(t *testing.T) {
+				actor(&session.Actor{User: &model.UserEntity{ID: 2, IsSuperuser: true}})
+				refreshTokenMock.On("RevokeMany", mock.Anything, mock.Anything).Return(nil, context.Canceled).Once()
+			},
+			req: charonrpc.RevokeAllRefreshTokensRequest{UserId: 1},
+			err: grpcerr.E(codes.Canceled),
+		},
+		"all-as-an-owner": {
+			init: func(t *testing.T) {
+				actor(&session.Actor{
+					User:        &model.UserEntity{ID: 1},
+					Permissions: charon.Permissions{charon.RefreshTokenCanRevokeAsOwner},
+				})
+				revoked("abc", "def")
+				sessionMock.On("Delete", mock.Anything, mock.MatchedBy(func(req *mnemosynerpc.DeleteRequest) bool {
+					return req.SubjectId == session.ActorIDFromInt64(1).String() && req.RefreshToken == ""
+				})).Return(&wrappers.Int64Value{Value: 3}, nil).Once()
+			},
+			req:       charonrpc.RevokeAllRefreshTokensRequest{UserId: 1},
+			prefixes:  2,
+			abandoned: 3,
+		},
+		"filtered-as-a-stranger": {
+			init: func(t *testing.T) {
+				actor(&session.Actor{
+					User:        &model.UserEntity{ID: 2},
+					Permissions: charon.Permissions{charon.RefreshTokenCanRevokeAsStranger},
+				})
+				revoked("abc", "def")
+				deleted("abc", nil)
+				deleted("def", status.Error(codes.NotFound, "session not found"))
+			},
+			req: charonrpc.RevokeAllRefreshTokensRequest{
+				UserId: 1,
+				Query:  &charonrpc.RefreshTokenQuery{Notes: qtypes.EqualString("ci")},
+			},
+			prefixes:  2,
+			abandoned: 1,
+		},
+		"session-removal-failure": {
+			init: func(t *testing.T) {
+				actor(&session.Actor{User: &model.UserEntity{ID: 2, IsSuperuser: true}})
+				revoked("abc")
+				deleted("abc", status.Error(codes.Unavailable, "mnemosyne unavailable"))
+			},
+			req: charonrpc.RevokeAllRefreshTokensRequest{
+				UserId: 1,
+				Query:  &charonrpc.RefreshTokenQuery{Revoked: &ntypes.Bool{Bool: false, Valid: true}},
+			},
+			err: grpcerr.E(codes.Unavailable),
+		},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			sessionMock.ExpectedCalls = []*mock.Call{}
+			refreshTokenMock.ExpectedCalls = []*mock.Call{}
+			actorProviderMock.ExpectedCalls = []*mock.Call{}
+			auditEventProviderMock.ExpectedCalls = []*mock.Call{}
+
+			c.init(t)
+			audit := auditEventProviderMock.On("Insert", mock.Anything, auditEventMatcher(model.AuditEventTargetRefreshToken, 1)).
+				Return(&model.AuditEventEntity{}, nil)
+			if c.err != nil {
+				audit.Maybe()
+			}
+
+			res, err := h.RevokeAll(context.TODO(), &c.req)
+			if c.err != nil {
+				if !grpcerr.Match(c.err, err) {
+					t.Fatalf("errors do not match, got '%v'", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if len(res.Prefixes) != c.prefixes {
+				t.Errorf("wrong number of revoked tokens, expected %d but got %d", c.prefixes, len(res.Prefixes))
+			}
+			if res.AbandonedSessions != c.abandoned {
+				t.Errorf("wrong number of abandoned sessions, expected %d but got %d", c.abandoned, res.AbandonedSessions)
+			}
+
+			mock.AssertExpectationsForObjects(t, sessionMock, refreshTokenMock, actorProviderMock, auditEventProviderMock)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package charond
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/mapping"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/piotrkowalczuk/ntypes"
+	"google.golang.org/grpc/codes"
+)
+
+type revokeAPIKeyHandler struct {
+	*handler
+}
+
+// Revoke makes the key unusable. Sessions started with it are rejected by the actor provider from now on,
+// so there is no need to look them up.
+func (rakh *revokeAPIKeyHandler) Revoke(ctx context.Context, req *charonrpc.RevokeAPIKeyRequest) (*charonrpc.RevokeAPIKeyResponse, error) {
+	if req.Id <= 0 {
+		return nil, grpcerr.E(codes.InvalidArgument, "api key cannot be revoked, invalid id")
+	}
+
+	act, err := rakh.Actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ent, err := rakh.repository.apiKey.FindOneByID(ctx, req.Id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, grpcerr.E(codes.NotFound, "api key does not exists")
+		}
+		return nil, grpcerr.E(codes.Internal, "api key could not be retrieved", err)
+	}
+	if err = rakh.firewall(act, ent); err != nil {
+		return nil, err
+	}
+
+	entry := &auditEntry{
+		targetKind: model.AuditEventTargetAPIKey,
+		targetID:   ent.ID,
+		before:     ent,
+	}
+	err = rakh.audit(ctx, act, entry, func(ctx context.Context) error {
+		ent, err = rakh.repository.apiKey.UpdateOneByID(ctx, req.Id, &model.APIKeyPatch{
+			Revoked:   ntypes.Bool{Bool: true, Valid: true},
+			UpdatedBy: ntypes.Int64{Int64: act.User.ID, Valid: true},
+		})
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return grpcerr.E(codes.NotFound, "api key does not exists")
+			}
+			return grpcerr.E(codes.Internal, "api key could not be revoked", err)
+		}
+		entry.after = ent
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := mapping.ReverseAPIKey(ent)
+	if err != nil {
+		return nil, grpcerr.E(codes.Internal, "api key mapping failure", err)
+	}
+	return &charonrpc.RevokeAPIKeyResponse{
+		ApiKey: msg,
+	}, nil
+}
+
+func (rakh *revokeAPIKeyHandler) firewall(act *session.Actor, ent *model.APIKeyEntity) error {
+	if act.User.IsSuperuser {
+		return nil
+	}
+	if act.Permissions.Match(charon.APIKeyCanRevokeAsStranger) {
+		return nil
+	}
+	if act.Permissions.Match(charon.APIKeyCanRevokeAsOwner) {
+		if act.User.ID == ent.UserID {
+			return nil
+		}
+		return grpcerr.E(codes.PermissionDenied, "api key cannot be revoked by stranger, missing permission")
+	}
+	return grpcerr.E(codes.PermissionDenied, "api key cannot be revoked, missing permission")
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/piotrkowalczuk/charon/internal/grpcerr"
 	"github.com/piotrkowalczuk/charon/internal/mapping"
 	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/refreshtoken"
 	"github.com/piotrkowalczuk/charon/internal/session"
 	"github.com/piotrkowalczuk/mnemosyne/mnemosynerpc"
 	"github.com/piotrkowalczuk/ntypes"
@@ -22,8 +23,13 @@ type revokeRefreshTokenHandler struct {
 }
 
 func (h *revokeRefreshTokenHandler) Revoke(ctx context.Context, req *charonrpc.RevokeRefreshTokenRequest) (*charonrpc.RevokeRefreshTokenResponse, error) {
-	if len(req.Token) == 0 {
-		return nil, grpcerr.E(codes.InvalidArgument, "refresh token cannot be disabled, invalid token: %s", req.Token)
+	// Token is known only to its owner, others can refer to it using its prefix.
+	prefix := req.Prefix
+	if prefix == "" {
+		prefix = refreshtoken.Prefix(req.Token)
+	}
+	if prefix == "" {
+		return nil, grpcerr.E(codes.InvalidArgument, "refresh token cannot be disabled, missing token or its prefix")
 	}
 	if req.UserId == 0 {
 		return nil, grpcerr.E(codes.InvalidArgument, "refresh token cannot be disabled, missing user id")
@@ -33,7 +39,7 @@ func (h *revokeRefreshTokenHandler) Revoke(ctx context.Context, req *charonrpc.R
 	if err != nil {
 		return nil, err
 	}
-	ent, err := h.repository.refreshToken.FindOneByTokenAndUserID(ctx, req.Token, req.UserId)
+	ent, err := h.repository.refreshToken.FindOneByPrefixAndUserID(ctx, prefix, req.UserId)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, grpcerr.E(codes.NotFound, "refresh token does not exists")
@@ -44,19 +50,31 @@ func (h *revokeRefreshTokenHandler) Revoke(ctx context.Context, req *charonrpc.R
 		return nil, err
 	}
 
-	ent, err = h.repository.refreshToken.UpdateOneByToken(ctx, req.Token, &model.RefreshTokenPatch{
-		Revoked: ntypes.Bool{Bool: true, Valid: true},
+	entry := &auditEntry{
+		targetKind: model.AuditEventTargetRefreshToken,
+		targetID:   ent.UserID,
+		before:     ent,
+	}
+	err = h.audit(ctx, act, entry, func(ctx context.Context) error {
+		ent, err = h.repository.refreshToken.UpdateOneByPrefix(ctx, prefix, &model.RefreshTokenPatch{
+			Revoked: ntypes.Bool{Bool: true, Valid: true},
+		})
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return grpcerr.E(codes.NotFound, "refresh token does not exists")
+			}
+			return grpcerr.E(codes.Internal, "refresh token could not be disabled", err)
+		}
+		entry.after = ent
+		return nil
 	})
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, grpcerr.E(codes.NotFound, "refresh token does not exists")
-		}
-		return nil, grpcerr.E(codes.Internal, "refresh token could not be disabled", err)
+		return nil, err
 	}
 
 	res, err := h.session.Delete(ctx, &mnemosynerpc.DeleteRequest{
 		SubjectId:    session.ActorIDFromInt64(ent.UserID).String(),
-		RefreshToken: req.Token,
+		RefreshToken: prefix,
 	})
 	if err != nil {
 		if status.Code(err) != codes.NotFound {
@@ -78,10 +96,10 @@ func (h *revokeRefreshTokenHandler) firewall(req *charonrpc.RevokeRefreshTokenRe
 	if act.User.IsSuperuser {
 		return nil
 	}
-	if act.Permissions.Contains(charon.RefreshTokenCanRevokeAsStranger) {
+	if act.Permissions.Match(charon.RefreshTokenCanRevokeAsStranger) {
 		return nil
 	}
-	if act.Permissions.Contains(charon.RefreshTokenCanRevokeAsOwner) {
+	if act.Permissions.Match(charon.RefreshTokenCanRevokeAsOwner) {
 		if act.User.ID == ent.UserID {
 			return nil
 		}
@@ -14,6 +14,7 @@ import (
 	"github.com/piotrkowalczuk/charon/internal/session"
 	"github.com/piotrkowalczuk/charon/internal/session/sessionmock"
 	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/piotrkowalczuk/mnemosyne/mnemosynerpc"
 	"github.com/piotrkowalczuk/mnemosyne/mnemosynetest"
 	"github.com/piotrkowalczuk/ntypes"
 	"github.com/stretchr/testify/mock"
@@ -80,6 +81,7 @@ func TestRevokeRefreshTokenHandler_Disable_Unit(t *testing.T) {
 	sessionMock := &mnemosynetest.SessionManagerClient{}
 	refreshTokenMock := &modelmock.RefreshTokenProvider{}
 	actorProviderMock := &sessionmock.ActorProvider{}
+	auditEventProviderMock := &modelmock.AuditEventProvider{}
 
 	h := revokeRefreshTokenHandler{
 		handler: &handler{
@@ -88,6 +90,8 @@ func TestRevokeRefreshTokenHandler_Disable_Unit(t *testing.T) {
 			session:       sessionMock,
 			repository: repositories{
 				refreshToken: refreshTokenMock,
+				auditEvent:   auditEventProviderMock,
+				transactor:   newTransactorMock(),
 			},
 		},
 	}
@@ -121,7 +125,7 @@ func TestRevokeRefreshTokenHandler_Disable_Unit(t *testing.T) {
 				actorProviderMock.On("Actor", mock.Anything).
 					Return(&session.Actor{}, nil).
 					Once()
-				refreshTokenMock.On("FindOneByTokenAndUserID", mock.Anything, "123", int64(1)).Return(nil, sql.ErrNoRows)
+				refreshTokenMock.On("FindOneByPrefixAndUserID", mock.Anything, "123", int64(1)).Return(nil, sql.ErrNoRows)
 			},
 			req: charonrpc.RevokeRefreshTokenRequest{UserId: 1, Token: "123"},
 			err: grpcerr.E(codes.NotFound),
@@ -131,7 +135,7 @@ func TestRevokeRefreshTokenHandler_Disable_Unit(t *testing.T) {
 				actorProviderMock.On("Actor", mock.Anything).
 					Return(&session.Actor{}, nil).
 					Once()
-				refreshTokenMock.On("FindOneByTokenAndUserID", mock.Anything, "123", int64(1)).Return(nil, context.DeadlineExceeded)
+				refreshTokenMock.On("FindOneByPrefixAndUserID", mock.Anything, "123", int64(1)).Return(nil, context.DeadlineExceeded)
 			},
 			req: charonrpc.RevokeRefreshTokenRequest{UserId: 1, Token: "123"},
 			err: grpcerr.E(codes.DeadlineExceeded),
@@ -144,7 +148,7 @@ func TestRevokeRefreshTokenHandler_Disable_Unit(t *testing.T) {
 						User:        &model.UserEntity{ID: 1},
 					}, nil).
 					Once()
-				refreshTokenMock.On("FindOneByTokenAndUserID", mock.Anything, "123", int64(2)).Return(&model.RefreshTokenEntity{
+				refreshTokenMock.On("FindOneByPrefixAndUserID", mock.Anything, "123", int64(2)).Return(&model.RefreshTokenEntity{
 					UserID: 2,
 				}, nil)
 			},
@@ -158,7 +162,7 @@ func TestRevokeRefreshTokenHandler_Disable_Unit(t *testing.T) {
 						User: &model.UserEntity{ID: 1},
 					}, nil).
 					Once()
-				refreshTokenMock.On("FindOneByTokenAndUserID", mock.Anything, "123", int64(1)).Return(&model.RefreshTokenEntity{
+				refreshTokenMock.On("FindOneByPrefixAndUserID", mock.Anything, "123", int64(1)).Return(&model.RefreshTokenEntity{
 					UserID: 1,
 				}, nil)
 			},
@@ -172,18 +176,39 @@ func TestRevokeRefreshTokenHandler_Disable_Unit(t *testing.T) {
 						User: &model.UserEntity{ID: 4, IsSuperuser: true},
 					}, nil).
 					Once()
-				refreshTokenMock.On("FindOneByTokenAndUserID", mock.Anything, "123", int64(1)).Return(&model.RefreshTokenEntity{
+				refreshTokenMock.On("FindOneByPrefixAndUserID", mock.Anything, "123", int64(1)).Return(&model.RefreshTokenEntity{
 					UserID: 1,
 				}, nil)
-				refreshTokenMock.On("UpdateOneByToken", mock.Anything, "123", mock.Anything).Return(&model.RefreshTokenEntity{
+				refreshTokenMock.On("UpdateOneByPrefix", mock.Anything, "123", mock.Anything).Return(&model.RefreshTokenEntity{
 					UserID:  1,
-					Token:   "123",
+					Prefix:  "123",
 					Revoked: true,
 				}, nil)
 				sessionMock.On("Delete", mock.Anything, mock.Anything).Return(&wrappers.Int64Value{Value: 1}, nil)
 			},
 			req: charonrpc.RevokeRefreshTokenRequest{UserId: 1, Token: "123"},
 		},
+		"can-disable-by-prefix": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{
+						User: &model.UserEntity{ID: 4, IsSuperuser: true},
+					}, nil).
+					Once()
+				refreshTokenMock.On("FindOneByPrefixAndUserID", mock.Anything, "0123456789abcdef", int64(1)).Return(&model.RefreshTokenEntity{
+					UserID: 1,
+				}, nil)
+				refreshTokenMock.On("UpdateOneByPrefix", mock.Anything, "0123456789abcdef", mock.Anything).Return(&model.RefreshTokenEntity{
+					UserID:  1,
+					Prefix:  "0123456789abcdef",
+					Revoked: true,
+				}, nil)
+				sessionMock.On("Delete", mock.Anything, mock.MatchedBy(func(req *mnemosynerpc.DeleteRequest) bool {
+					return req.RefreshToken == "0123456789abcdef"
+				})).Return(&wrappers.Int64Value{Value: 1}, nil)
+			},
+			req: charonrpc.RevokeRefreshTokenRequest{UserId: 1, Prefix: "0123456789abcdef"},
+		},
 		"can-disable-as-a-stranger": {
 			init: func(t *testing.T) {
 				actorProviderMock.On("Actor", mock.Anything).
@@ -192,12 +217,12 @@ func TestRevokeRefreshTokenHandler_Disable_Unit(t *testing.T) {
 						User:        &model.UserEntity{ID: 4},
 					}, nil).
 					Once()
-				refreshTokenMock.On("FindOneByTokenAndUserID", mock.Anything, "123", int64(1)).Return(&model.RefreshTokenEntity{
+				refreshTokenMock.On("FindOneByPrefixAndUserID", mock.Anything, "123", int64(1)).Return(&model.RefreshTokenEntity{
 					UserID: 1,
 				}, nil)
-				refreshTokenMock.On("UpdateOneByToken", mock.Anything, "123", mock.Anything).Return(&model.RefreshTokenEntity{
+				refreshTokenMock.On("UpdateOneByPrefix", mock.Anything, "123", mock.Anything).Return(&model.RefreshTokenEntity{
 					UserID:  1,
-					Token:   "123",
+					Prefix:  "123",
 					Revoked: true,
 				}, nil)
 				sessionMock.On("Delete", mock.Anything, mock.Anything).Return(&wrappers.Int64Value{Value: 1}, nil)
@@ -212,12 +237,12 @@ func TestRevokeRefreshTokenHandler_Disable_Unit(t *testing.T) {
 						User:        &model.UserEntity{ID: 1},
 					}, nil).
 					Once()
-				refreshTokenMock.On("FindOneByTokenAndUserID", mock.Anything, "123", int64(1)).Return(&model.RefreshTokenEntity{
+				refreshTokenMock.On("FindOneByPrefixAndUserID", mock.Anything, "123", int64(1)).Return(&model.RefreshTokenEntity{
 					UserID: 1,
 				}, nil)
-				refreshTokenMock.On("UpdateOneByToken", mock.Anything, "123", mock.Anything).Return(&model.RefreshTokenEntity{
+				refreshTokenMock.On("UpdateOneByPrefix", mock.Anything, "123", mock.Anything).Return(&model.RefreshTokenEntity{
 					UserID:  1,
-					Token:   "123",
+					Prefix:  "123",
 					Revoked: true,
 				}, nil)
 				sessionMock.On("Delete", mock.Anything, mock.Anything).Return(&wrappers.Int64Value{Value: 1}, nil)
@@ -232,12 +257,12 @@ func TestRevokeRefreshTokenHandler_Disable_Unit(t *testing.T) {
 						User:        &model.UserEntity{ID: 1},
 					}, nil).
 					Once()
-				refreshTokenMock.On("FindOneByTokenAndUserID", mock.Anything, "123", int64(1)).Return(&model.RefreshTokenEntity{
+				refreshTokenMock.On("FindOneByPrefixAndUserID", mock.Anything, "123", int64(1)).Return(&model.RefreshTokenEntity{
 					UserID: 1,
 				}, nil)
-				refreshTokenMock.On("UpdateOneByToken", mock.Anything, "123", mock.Anything).Return(&model.RefreshTokenEntity{
+				refreshTokenMock.On("UpdateOneByPrefix", mock.Anything, "123", mock.Anything).Return(&model.RefreshTokenEntity{
 					UserID:    1,
-					Token:     "123",
+					Prefix:    "123",
 					Revoked:   true,
 					CreatedAt: brokenDate(),
 				}, nil)
@@ -253,10 +278,10 @@ func TestRevokeRefreshTokenHandler_Disable_Unit(t *testing.T) {
 						User: &model.UserEntity{ID: 4, IsSuperuser: true},
 					}, nil).
 					Once()
-				refreshTokenMock.On("FindOneByTokenAndUserID", mock.Anything, "123", int64(1)).Return(&model.RefreshTokenEntity{
+				refreshTokenMock.On("FindOneByPrefixAndUserID", mock.Anything, "123", int64(1)).Return(&model.RefreshTokenEntity{
 					UserID: 1,
 				}, nil)
-				refreshTokenMock.On("UpdateOneByToken", mock.Anything, "123", mock.Anything).Return(nil, sql.ErrNoRows)
+				refreshTokenMock.On("UpdateOneByPrefix", mock.Anything, "123", mock.Anything).Return(nil, sql.ErrNoRows)
 			},
 			req: charonrpc.RevokeRefreshTokenRequest{UserId: 1, Token: "123"},
 			err: grpcerr.E(codes.NotFound),
@@ -268,10 +293,10 @@ func TestRevokeRefreshTokenHandler_Disable_Unit(t *testing.T) {
 						User: &model.UserEntity{ID: 4, IsSuperuser: true},
 					}, nil).
 					Once()
-				refreshTokenMock.On("FindOneByTokenAndUserID", mock.Anything, "123", int64(1)).Return(&model.RefreshTokenEntity{
+				refreshTokenMock.On("FindOneByPrefixAndUserID", mock.Anything, "123", int64(1)).Return(&model.RefreshTokenEntity{
 					UserID: 1,
 				}, nil)
-				refreshTokenMock.On("UpdateOneByToken", mock.Anything, "123", mock.Anything).Return(nil, context.Canceled)
+				refreshTokenMock.On("UpdateOneByPrefix", mock.Anything, "123", mock.Anything).Return(nil, context.Canceled)
 			},
 			req: charonrpc.RevokeRefreshTokenRequest{UserId: 1, Token: "123"},
 			err: grpcerr.E(codes.Canceled),
@@ -283,12 +308,12 @@ func TestRevokeRefreshTokenHandler_Disable_Unit(t *testing.T) {
 						User: &model.UserEntity{ID: 4, IsSuperuser: true},
 					}, nil).
 					Once()
-				refreshTokenMock.On("FindOneByTokenAndUserID", mock.Anything, "123", int64(1)).Return(&model.RefreshTokenEntity{
+				refreshTokenMock.On("FindOneByPrefixAndUserID", mock.Anything, "123", int64(1)).Return(&model.RefreshTokenEntity{
 					UserID: 1,
 				}, nil)
-				refreshTokenMock.On("UpdateOneByToken", mock.Anything, "123", mock.Anything).Return(&model.RefreshTokenEntity{
+				refreshTokenMock.On("UpdateOneByPrefix", mock.Anything, "123", mock.Anything).Return(&model.RefreshTokenEntity{
 					UserID:  1,
-					Token:   "123",
+					Prefix:  "123",
 					Revoked: true,
 				}, nil)
 				sessionMock.On("Delete", mock.Anything, mock.Anything).Return(nil, status.Errorf(codes.Aborted, "something went wrong"))
@@ -303,8 +328,14 @@ func TestRevokeRefreshTokenHandler_Disable_Unit(t *testing.T) {
 			sessionMock.ExpectedCalls = nil
 			refreshTokenMock.ExpectedCalls = nil
 			actorProviderMock.ExpectedCalls = nil
+			auditEventProviderMock.ExpectedCalls = nil
 
 			c.init(t)
+			audit := auditEventProviderMock.On("Insert", mock.Anything, auditEventMatcher(model.AuditEventTargetRefreshToken, 1)).
+				Return(&model.AuditEventEntity{}, nil)
+			if c.err != nil {
+				audit.Maybe()
+			}
 
 			_, err := h.Revoke(context.TODO(), &c.req)
 			assertError(t, c.err, err)
@@ -9,6 +9,7 @@ import (
 	"github.com/piotrkowalczuk/charon/internal/grpcerr"
 	"github.com/piotrkowalczuk/charon/internal/model"
 	"github.com/piotrkowalczuk/charon/internal/session"
+	"github.com/piotrkowalczuk/qtypes"
 
 	"google.golang.org/grpc/codes"
 )
@@ -27,30 +28,66 @@ func (sgph *setGroupPermissionsHandler) SetPermissions(ctx context.Context, req
 		return nil, err
 	}
 
-	permissions := charon.NewPermissions(req.Permissions...)
-	if req.Force {
-		_, err := sgph.repository.permission.InsertMissing(ctx, permissions)
+	permissions, denials, err := grantsAndDenials(req.Permissions, req.DeniedPermissions)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		created, removed int64
+		entry            = &auditEntry{
+			targetKind: model.AuditEventTargetGroup,
+			targetID:   req.GroupId,
+			after:      auditPermissionsWithDenials(permissions, denials),
+		}
+	)
+	err = sgph.audit(ctx, act, entry, func(ctx context.Context) error {
+		links, err := sgph.repository.groupPermissions.Find(ctx, &model.GroupPermissionsFindExpr{
+			Where: &model.GroupPermissionsCriteria{
+				GroupID: qtypes.EqualInt64(req.GroupId),
+			},
+		})
 		if err != nil {
-			return nil, err
+			return grpcerr.E(codes.Internal, "group permissions cannot be retrieved", err)
 		}
-	}
+		var existing, existingDenials charon.Permissions
+		for _, link := range links {
+			p := charon.Permission(link.PermissionSubsystem + ":" + link.PermissionModule + ":" + link.PermissionAction)
+			if link.Denied {
+				existingDenials = append(existingDenials, p)
+			} else {
+				existing = append(existing, p)
+			}
+		}
+		entry.before = auditPermissionsWithDenials(existing, existingDenials)
 
-	created, removed, err := sgph.repository.group.SetPermissions(ctx, req.GroupId, permissions...)
-	if err != nil {
-		switch model.ErrorConstraint(err) {
-		case model.TableGroupPermissionsConstraintGroupIDForeignKey:
-			return nil, grpcerr.E(codes.NotFound, "%s: group does not exist", err.(*pq.Error).Detail)
-		case model.TableGroupPermissionsConstraintPermissionSubsystemPermissionModulePermissionActionForeignKey:
-			return nil, grpcerr.E(codes.NotFound, "%s: permission does not exist", err.(*pq.Error).Detail)
-		default:
-			return nil, err
+		if req.Force {
+			if _, err := sgph.repository.permission.InsertMissing(ctx, append(append(charon.Permissions{}, permissions...), denials...)); err != nil {
+				return err
+			}
+		}
+
+		created, removed, err = sgph.repository.group.SetPermissionsWithDenials(ctx, req.GroupId, permissions, denials)
+		if err != nil {
+			switch model.ErrorConstraint(err) {
+			case model.TableGroupPermissionsConstraintGroupIDForeignKey:
+				return grpcerr.E(codes.NotFound, "%s: group does not exist", err.(*pq.Error).Detail)
+			case model.TableGroupPermissionsConstraintPermissionSubsystemPermissionModulePermissionActionForeignKey:
+				return grpcerr.E(codes.NotFound, "%s: permission does not exist", err.(*pq.Error).Detail)
+			default:
+				return err
+			}
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return &charonrpc.SetGroupPermissionsResponse{
 		Created:   created,
 		Removed:   removed,
-		Untouched: untouched(int64(len(req.Permissions)), created, removed),
+		Untouched: untouched(int64(len(permissions)+len(denials)), created, removed),
 	}, nil
 }
 
@@ -58,7 +95,7 @@ func (sgph *setGroupPermissionsHandler) firewall(req *charonrpc.SetGroupPermissi
 	if act.User.IsSuperuser {
 		return nil
 	}
-	if act.Permissions.Contains(charon.GroupPermissionCanCreate) && act.Permissions.Contains(charon.GroupPermissionCanDelete) {
+	if act.Permissions.Match(charon.GroupPermissionCanCreate) && act.Permissions.Match(charon.GroupPermissionCanDelete) {
 		return nil
 	}
 
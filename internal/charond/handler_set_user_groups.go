@@ -9,6 +9,7 @@ import (
 	"github.com/piotrkowalczuk/charon/internal/grpcerr"
 	"github.com/piotrkowalczuk/charon/internal/model"
 	"github.com/piotrkowalczuk/charon/internal/session"
+	"github.com/piotrkowalczuk/qtypes"
 
 	"google.golang.org/grpc/codes"
 )
@@ -27,16 +28,55 @@ func (sugh *setUserGroupsHandler) SetGroups(ctx context.Context, req *charonrpc.
 		return nil, err
 	}
 
-	created, removed, err := sugh.repository.userGroups.Set(ctx, req.UserId, req.Groups)
+	validity, err := groupsValidity(req.Validity, req.Groups)
 	if err != nil {
-		switch model.ErrorConstraint(err) {
-		case model.TableUserGroupsConstraintGroupIDForeignKey:
-			return nil, grpcerr.E(codes.NotFound, "%s: group does not exist", err.(*pq.Error).Detail)
-		case model.TableUserGroupsConstraintUserIDForeignKey:
-			return nil, grpcerr.E(codes.NotFound, "%s: user does not exist", err.(*pq.Error).Detail)
-		default:
-			return nil, err
+		return nil, err
+	}
+
+	var (
+		created, removed int64
+		entry            = &auditEntry{
+			targetKind: model.AuditEventTargetUser,
+			targetID:   req.UserId,
+			after:      auditGroupsValidity(auditGroups(req.Groups), validity),
+		}
+	)
+	err = sugh.audit(ctx, act, entry, func(ctx context.Context) error {
+		links, err := sugh.repository.userGroups.Find(ctx, &model.UserGroupsFindExpr{
+			Where: &model.UserGroupsCriteria{
+				UserID: qtypes.EqualInt64(req.UserId),
+			},
+		})
+		if err != nil {
+			return grpcerr.E(codes.Internal, "user groups cannot be retrieved", err)
+		}
+		var (
+			existing         = make([]int64, 0, len(links))
+			existingValidity = make(map[int64]model.Validity)
+		)
+		for _, link := range links {
+			existing = append(existing, link.GroupID)
+			if v := (model.Validity{From: link.ValidFrom, Until: link.ValidUntil}); v.IsTimeBound() {
+				existingValidity[link.GroupID] = v
+			}
 		}
+		entry.before = auditGroupsValidity(auditGroups(existing), existingValidity)
+
+		created, removed, err = sugh.repository.userGroups.SetTimeBound(ctx, req.UserId, req.Groups, validity)
+		if err != nil {
+			switch model.ErrorConstraint(err) {
+			case model.TableUserGroupsConstraintGroupIDForeignKey:
+				return grpcerr.E(codes.NotFound, "%s: group does not exist", err.(*pq.Error).Detail)
+			case model.TableUserGroupsConstraintUserIDForeignKey:
+				return grpcerr.E(codes.NotFound, "%s: user does not exist", err.(*pq.Error).Detail)
+			default:
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return &charonrpc.SetUserGroupsResponse{
@@ -50,7 +90,7 @@ func (sugh *setUserGroupsHandler) firewall(req *charonrpc.SetUserGroupsRequest,
 	if act.User.IsSuperuser {
 		return nil
 	}
-	if act.Permissions.Contains(charon.UserGroupCanCreate) && act.Permissions.Contains(charon.UserGroupCanDelete) {
+	if act.Permissions.Match(charon.UserGroupCanCreate) && act.Permissions.Match(charon.UserGroupCanDelete) {
 		return nil
 	}
 
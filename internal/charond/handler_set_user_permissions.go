@@ -9,6 +9,7 @@ import (
 	"github.com/piotrkowalczuk/charon/internal/grpcerr"
 	"github.com/piotrkowalczuk/charon/internal/model"
 	"github.com/piotrkowalczuk/charon/internal/session"
+	"github.com/piotrkowalczuk/qtypes"
 
 	"google.golang.org/grpc/codes"
 )
@@ -27,30 +28,76 @@ func (suph *setUserPermissionsHandler) SetPermissions(ctx context.Context, req *
 		return nil, err
 	}
 
-	permissions := charon.NewPermissions(req.Permissions...)
-	if req.Force {
-		_, err := suph.repository.permission.InsertMissing(ctx, permissions)
+	permissions, denials, err := grantsAndDenials(req.Permissions, req.DeniedPermissions)
+	if err != nil {
+		return nil, err
+	}
+	validity, err := permissionsValidity(req.Validity, permissions, denials)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		created, removed int64
+		entry            = &auditEntry{
+			targetKind: model.AuditEventTargetUser,
+			targetID:   req.UserId,
+			after:      auditPermissionsValidity(auditPermissionsWithDenials(permissions, denials), validity),
+		}
+	)
+	err = suph.audit(ctx, act, entry, func(ctx context.Context) error {
+		links, err := suph.repository.userPermissions.Find(ctx, &model.UserPermissionsFindExpr{
+			Where: &model.UserPermissionsCriteria{
+				UserID: qtypes.EqualInt64(req.UserId),
+			},
+		})
 		if err != nil {
-			return nil, err
+			return grpcerr.E(codes.Internal, "user permissions cannot be retrieved", err)
 		}
-	}
+		var (
+			existing, existingDenials charon.Permissions
+			existingValidity          = make(map[charon.Permission]model.Validity)
+		)
+		for _, link := range links {
+			p := charon.Permission(link.PermissionSubsystem + ":" + link.PermissionModule + ":" + link.PermissionAction)
+			if link.Denied {
+				existingDenials = append(existingDenials, p)
+			} else {
+				existing = append(existing, p)
+			}
+			if v := (model.Validity{From: link.ValidFrom, Until: link.ValidUntil}); v.IsTimeBound() {
+				existingValidity[p] = v
+			}
+		}
+		entry.before = auditPermissionsValidity(auditPermissionsWithDenials(existing, existingDenials), existingValidity)
 
-	created, removed, err := suph.repository.user.SetPermissions(ctx, req.UserId, permissions...)
-	if err != nil {
-		switch model.ErrorConstraint(err) {
-		case model.TableUserPermissionsConstraintUserIDForeignKey:
-			return nil, grpcerr.E(codes.NotFound, "%s: user does not exist", err.(*pq.Error).Detail)
-		case model.TableUserPermissionsConstraintPermissionSubsystemPermissionModulePermissionActionForeignKey:
-			return nil, grpcerr.E(codes.NotFound, "%s: permission does not exist", err.(*pq.Error).Detail)
-		default:
-			return nil, err
+		if req.Force {
+			if _, err := suph.repository.permission.InsertMissing(ctx, append(append(charon.Permissions{}, permissions...), denials...)); err != nil {
+				return err
+			}
+		}
+
+		created, removed, err = suph.repository.user.SetTimeBoundPermissions(ctx, req.UserId, permissions, denials, validity)
+		if err != nil {
+			switch model.ErrorConstraint(err) {
+			case model.TableUserPermissionsConstraintUserIDForeignKey:
+				return grpcerr.E(codes.NotFound, "%s: user does not exist", err.(*pq.Error).Detail)
+			case model.TableUserPermissionsConstraintPermissionSubsystemPermissionModulePermissionActionForeignKey:
+				return grpcerr.E(codes.NotFound, "%s: permission does not exist", err.(*pq.Error).Detail)
+			default:
+				return err
+			}
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return &charonrpc.SetUserPermissionsResponse{
 		Created:   created,
 		Removed:   removed,
-		Untouched: untouched(int64(len(req.Permissions)), created, removed),
+		Untouched: untouched(int64(len(permissions)+len(denials)), created, removed),
 	}, nil
 }
 
@@ -59,7 +106,7 @@ func (suph *setUserPermissionsHandler) firewall(req *charonrpc.SetUserPermission
 		return nil
 	}
 
-	if act.Permissions.Contains(charon.UserPermissionCanCreate) && act.Permissions.Contains(charon.UserPermissionCanDelete) {
+	if act.Permissions.Match(charon.UserPermissionCanCreate) && act.Permissions.Match(charon.UserPermissionCanDelete) {
 		return nil
 	}
 
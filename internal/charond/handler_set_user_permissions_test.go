@@ -101,6 +101,49 @@ func TestSetUserPermissionsHandler_SetPermissions_nonExistingPermission(t *testi
 	}
 }
 
+func TestSetUserPermissionsHandler_SetPermissions_denied(t *testing.T) {
+	suite := &endToEndSuite{}
+	suite.setup(t)
+	defer suite.teardown(t)
+
+	ctx := testRPCServerLogin(t, suite)
+
+	res, err := suite.charon.user.SetPermissions(ctx, &charonrpc.SetUserPermissionsRequest{
+		UserId:            1,
+		Permissions:       []string{"a:b:*"},
+		DeniedPermissions: []string{"a:b:c"},
+		Force:             true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if res.Created != 2 {
+		t.Errorf("wrong number of created links, expected 2 but got %d", res.Created)
+	}
+
+	for permission, expected := range map[string]bool{"a:b:c": false, "a:b:d": true} {
+		granted, err := suite.charon.auth.IsGranted(ctx, &charonrpc.IsGrantedRequest{
+			UserId:     1,
+			Permission: permission,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		if granted.Value != expected {
+			t.Errorf("wrong result for %s, expected %t but got %t", permission, expected, granted.Value)
+		}
+	}
+
+	_, err = suite.charon.user.SetPermissions(ctx, &charonrpc.SetUserPermissionsRequest{
+		UserId:            1,
+		Permissions:       []string{"a:b:c"},
+		DeniedPermissions: []string{"a:b:c"},
+	})
+	if st, ok := status.FromError(err); !ok || st.Code() != codes.InvalidArgument {
+		t.Errorf("expected invalid argument error, got: %v", err)
+	}
+}
+
 func TestSetUserPermissionsHandler_firewall_success(t *testing.T) {
 	data := []struct {
 		req charonrpc.SetUserPermissionsRequest
@@ -0,0 +1,107 @@
+package charond
+
+import (
+	"context"
+
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/piotrkowalczuk/qtypes"
+	"google.golang.org/grpc/codes"
+)
+
+type setUserResourcePermissionsHandler struct {
+	*handler
+}
+
+func (surph *setUserResourcePermissionsHandler) SetResourcePermissions(ctx context.Context, req *charonrpc.SetUserResourcePermissionsRequest) (*charonrpc.SetUserResourcePermissionsResponse, error) {
+	act, err := surph.Actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = surph.firewall(req, act); err != nil {
+		return nil, err
+	}
+
+	res := req.Resource
+	if res == nil || res.Type == "" || res.Id == "" {
+		return nil, grpcerr.E(codes.InvalidArgument, "resource type and id cannot be empty")
+	}
+	permissions := charon.NewPermissions(req.Permissions...)
+	for _, p := range permissions {
+		if p.IsDenial() {
+			return nil, grpcerr.E(codes.InvalidArgument, "%s: permission cannot be denied on a single resource", p.String())
+		}
+	}
+
+	var (
+		created, removed int64
+		entry            = &auditEntry{
+			targetKind: model.AuditEventTargetUser,
+			targetID:   req.UserId,
+			after:      auditResourcePermissions(res.Type, res.Id, permissions),
+		}
+	)
+	err = surph.audit(ctx, act, entry, func(ctx context.Context) error {
+		links, err := surph.repository.userResources.Find(ctx, &model.UserResourcePermissionsFindExpr{
+			Where: &model.UserResourcePermissionsCriteria{
+				UserID:       qtypes.EqualInt64(req.UserId),
+				ResourceType: qtypes.EqualString(res.Type),
+				ResourceID:   qtypes.EqualString(res.Id),
+			},
+		})
+		if err != nil {
+			return grpcerr.E(codes.Internal, "user resource permissions cannot be retrieved", err)
+		}
+		existing := make(charon.Permissions, 0, len(links))
+		for _, link := range links {
+			ent, err := surph.repository.permission.FindOneByID(ctx, link.PermissionID)
+			if err != nil {
+				return grpcerr.E(codes.Internal, "user resource permission cannot be retrieved", err)
+			}
+			existing = append(existing, ent.Permission())
+		}
+		entry.before = auditResourcePermissions(res.Type, res.Id, existing)
+
+		if req.Force && len(permissions) > 0 {
+			if _, err := surph.repository.permission.InsertMissing(ctx, permissions); err != nil {
+				return err
+			}
+		}
+
+		created, removed, err = surph.repository.userResources.Set(ctx, req.UserId, res.Type, res.Id, permissions)
+		if err != nil {
+			if err == model.ErrPermissionNotFound {
+				return grpcerr.E(codes.NotFound, "permission does not exist")
+			}
+			if model.ErrorConstraint(err) == model.TableUserResourcePermissionsConstraintUserIDForeignKey {
+				return grpcerr.E(codes.NotFound, "user does not exist")
+			}
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &charonrpc.SetUserResourcePermissionsResponse{
+		Created:   created,
+		Removed:   removed,
+		Untouched: untouched(int64(len(permissions)), created, removed),
+	}, nil
+}
+
+func (surph *setUserResourcePermissionsHandler) firewall(req *charonrpc.SetUserResourcePermissionsRequest, act *session.Actor) error {
+	if act.User.IsSuperuser {
+		return nil
+	}
+
+	if act.Permissions.Match(charon.UserPermissionCanCreate) && act.Permissions.Match(charon.UserPermissionCanDelete) {
+		return nil
+	}
+
+	return grpcerr.E(codes.PermissionDenied, "user resource permissions cannot be set, missing permission")
+}
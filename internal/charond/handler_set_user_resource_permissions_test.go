@@ -0,0 +1,170 @@
+package charond
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/model/modelmock"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	"github.com/piotrkowalczuk/charon/internal/session/sessionmock"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+)
+
+func TestSetUserResourcePermissionsHandler_SetResourcePermissions_Unit(t *testing.T) {
+	actorProviderMock := &sessionmock.ActorProvider{}
+	userResourcesMock := &modelmock.UserResourcePermissionsProvider{}
+	permissionProviderMock := &modelmock.PermissionProvider{}
+	auditEventProviderMock := &modelmock.AuditEventProvider{}
+
+	h := setUserResourcePermissionsHandler{
+		handler: &handler{
+			ActorProvider: actorProviderMock,
+			repository: repositories{
+				userResources: userResourcesMock,
+				permission:    permissionProviderMock,
+				auditEvent:    auditEventProviderMock,
+				transactor:    newTransactorMock(),
+			},
+		},
+	}
+	superuser := &session.Actor{User: &model.UserEntity{ID: 2, IsSuperuser: true}}
+	resource := &charonrpc.Resource{Type: "comment", Id: "42"}
+
+	cases := map[string]struct {
+		init func(*testing.T)
+		req  charonrpc.SetUserResourcePermissionsRequest
+		err  error
+	}{
+		"missing-permission": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 2}}, nil).
+					Once()
+			},
+			req: charonrpc.SetUserResourcePermissionsRequest{UserId: 1, Resource: resource},
+			err: grpcerr.E(codes.PermissionDenied),
+		},
+		"missing-resource": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).Return(superuser, nil).Once()
+			},
+			req: charonrpc.SetUserResourcePermissionsRequest{UserId: 1, Permissions: []string{"forum:comment:can edit"}},
+			err: grpcerr.E(codes.InvalidArgument),
+		},
+		"denial": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).Return(superuser, nil).Once()
+			},
+			req: charonrpc.SetUserResourcePermissionsRequest{
+				UserId:      1,
+				Resource:    resource,
+				Permissions: []string{"!forum:comment:can edit"},
+			},
+			err: grpcerr.E(codes.InvalidArgument),
+		},
+		"permission-does-not-exist": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).Return(superuser, nil).Once()
+				userResourcesMock.On("Find", mock.Anything, mock.Anything).
+					Return([]*model.UserResourcePermissionsEntity{}, nil).
+					Once()
+				userResourcesMock.On("Set", mock.Anything, int64(1), "comment", "42", charon.Permissions{"forum:comment:can edit"}).
+					Return(int64(0), int64(0), model.ErrPermissionNotFound).
+					Once()
+			},
+			req: charonrpc.SetUserResourcePermissionsRequest{
+				UserId:      1,
+				Resource:    resource,
+				Permissions: []string{"forum:comment:can edit"},
+			},
+			err: grpcerr.E(codes.NotFound),
+		},
+		"user-does-not-exist": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).Return(superuser, nil).Once()
+				userResourcesMock.On("Find", mock.Anything, mock.Anything).
+					Return([]*model.UserResourcePermissionsEntity{}, nil).
+					Once()
+				userResourcesMock.On("Set", mock.Anything, int64(1), "comment", "42", charon.Permissions{"forum:comment:can edit"}).
+					Return(int64(0), int64(0), &pq.Error{Constraint: model.TableUserResourcePermissionsConstraintUserIDForeignKey}).
+					Once()
+			},
+			req: charonrpc.SetUserResourcePermissionsRequest{
+				UserId:      1,
+				Resource:    resource,
+				Permissions: []string{"forum:comment:can edit"},
+			},
+			err: grpcerr.E(codes.NotFound),
+		},
+		"success": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).Return(superuser, nil).Once()
+				userResourcesMock.On("Find", mock.Anything, mock.Anything).
+					Return([]*model.UserResourcePermissionsEntity{{UserID: 1, PermissionID: 5}}, nil).
+					Once()
+				permissionProviderMock.On("FindOneByID", mock.Anything, int64(5)).
+					Return(&model.PermissionEntity{ID: 5, Subsystem: "forum", Module: "comment", Action: "can delete"}, nil).
+					Once()
+				permissionProviderMock.On("InsertMissing", mock.Anything, charon.Permissions{"forum:comment:can edit"}).
+					Return(int64(1), nil).
+					Once()
+				userResourcesMock.On("Set", mock.Anything, int64(1), "comment", "42", charon.Permissions{"forum:comment:can edit"}).
+					Return(int64(1), int64(1), nil).
+					Once()
+			},
+			req: charonrpc.SetUserResourcePermissionsRequest{
+				UserId:      1,
+				Resource:    resource,
+				Permissions: []string{"forum:comment:can edit"},
+				Force:       true,
+			},
+		},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			defer recoverTest(t)
+
+			actorProviderMock.ExpectedCalls = nil
+			userResourcesMock.ExpectedCalls = nil
+			permissionProviderMock.ExpectedCalls = nil
+			auditEventProviderMock.ExpectedCalls = nil
+
+			c.init(t)
+			audit := auditEventProviderMock.On("Insert", mock.Anything, auditEventMatcher(model.AuditEventTargetUser, 1)).
+				Return(&model.AuditEventEntity{}, nil)
+			if c.err != nil {
+				audit.Maybe()
+			}
+
+			_, err := h.SetResourcePermissions(context.Background(), &c.req)
+			assertError(t, c.err, err)
+
+			mock.AssertExpectationsForObjects(t, actorProviderMock, userResourcesMock, permissionProviderMock, auditEventProviderMock)
+		})
+	}
+}
+
+func TestSetUserResourcePermissionsHandler_firewall(t *testing.T) {
+	h := &setUserResourcePermissionsHandler{}
+	req := &charonrpc.SetUserResourcePermissionsRequest{}
+
+	if err := h.firewall(req, &session.Actor{
+		User:        &model.UserEntity{ID: 1},
+		Permissions: charon.Permissions{charon.UserPermissionCanCreate, charon.UserPermissionCanDelete},
+	}); err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+	}
+	if err := h.firewall(req, &session.Actor{
+		User:        &model.UserEntity{ID: 1},
+		Permissions: charon.Permissions{charon.UserPermissionCanCreate},
+	}); err == nil {
+		t.Error("expected error, got nil")
+	}
+}
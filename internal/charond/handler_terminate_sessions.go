@@ -0,0 +1,123 @@
+package charond
+
+import (
+	"context"
+
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/piotrkowalczuk/mnemosyne/mnemosynerpc"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type terminateSessionsHandler struct {
+	*handler
+}
+
+// Terminate abandons sessions of a single user.
+// Without ids every session is abandoned, except the one the request is made with.
+func (tsh *terminateSessionsHandler) Terminate(ctx context.Context, req *charonrpc.TerminateSessionsRequest) (*charonrpc.TerminateSessionsResponse, error) {
+	if req.UserId == 0 {
+		return nil, grpcerr.E(codes.InvalidArgument, "sessions cannot be terminated, missing user id")
+	}
+
+	act, err := tsh.Actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = tsh.firewall(req, act); err != nil {
+		return nil, err
+	}
+
+	wanted := make(map[string]bool, len(req.Ids))
+	for _, id := range req.Ids {
+		wanted[id] = true
+	}
+	current := callerAccessToken(ctx)
+	// Sessions of somebody else do not include the one the request is made with, so all of them are removed at once.
+	all := len(wanted) == 0 && act.User.ID != req.UserId
+
+	sessions, err := tsh.userSessions(ctx, req.UserId)
+	listed := err == nil
+	if err != nil && !(all && err == errSessionsNotListable) {
+		return nil, err
+	}
+
+	var (
+		ids   []string
+		entry = &auditEntry{
+			targetKind: model.AuditEventTargetSession,
+			targetID:   req.UserId,
+		}
+	)
+	err = tsh.audit(ctx, act, entry, func(ctx context.Context) error {
+		if all {
+			// Removal by subject does not need sessions to be listed, only their ids are not known otherwise.
+			if err := tsh.abandonUserSessions(ctx, req.UserId); err != nil {
+				return err
+			}
+			for _, ses := range sessions {
+				ids = append(ids, session.ID(ses.AccessToken))
+			}
+		} else {
+			for _, ses := range sessions {
+				id := session.ID(ses.AccessToken)
+				if len(wanted) > 0 && !wanted[id] {
+					continue
+				}
+				if len(wanted) == 0 && ses.AccessToken == current {
+					continue
+				}
+				if _, err := tsh.session.Abandon(ctx, &mnemosynerpc.AbandonRequest{AccessToken: ses.AccessToken}); err != nil {
+					if status.Code(err) == codes.NotFound {
+						continue
+					}
+					return grpcerr.E(codes.Internal, "session could not be abandoned", err)
+				}
+				if tsh.actorCache != nil {
+					tsh.actorCache.Forget(ses.AccessToken)
+				}
+				ids = append(ids, id)
+			}
+		}
+		entry.after = map[string]interface{}{"terminated": ids}
+		entry.skip = listed && len(ids) == 0
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	tsh.logger.Debug("sessions terminated", zap.Int64("user_id", req.UserId), zap.Int("count", len(ids)))
+
+	return &charonrpc.TerminateSessionsResponse{Ids: ids}, nil
+}
+
+func (tsh *terminateSessionsHandler) firewall(req *charonrpc.TerminateSessionsRequest, act *session.Actor) error {
+	if act.User.IsSuperuser {
+		return nil
+	}
+	if act.Permissions.Match(charon.SessionCanDeleteAsStranger) {
+		return nil
+	}
+	if act.Permissions.Match(charon.SessionCanDeleteAsOwner) {
+		if act.User.ID == req.UserId {
+			return nil
+		}
+		return grpcerr.E(codes.PermissionDenied, "sessions cannot be terminated by stranger, missing permission")
+	}
+	return grpcerr.E(codes.PermissionDenied, "sessions cannot be terminated, missing permission")
+}
+
+// abandonUserSessions removes every session of given user, so that a user that is deactivated or removed is logged out at once.
+func (h *handler) abandonUserSessions(ctx context.Context, userID int64) error {
+	_, err := h.session.Delete(ctx, &mnemosynerpc.DeleteRequest{SubjectId: session.ActorIDFromInt64(userID).String()})
+	if err != nil && status.Code(err) != codes.NotFound {
+		return grpcerr.E(codes.Internal, "user sessions could not be removed", err)
+	}
+	h.invalidateActor(userID)
+	return nil
+}
@@ -0,0 +1,227 @@
+package charond
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/model/modelmock"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	"github.com/piotrkowalczuk/charon/internal/session/sessionmock"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/piotrkowalczuk/mnemosyne"
+	"github.com/piotrkowalczuk/mnemosyne/mnemosynerpc"
+	"github.com/piotrkowalczuk/mnemosyne/mnemosynetest"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestTerminateSessionsHandler_Terminate_Unit(t *testing.T) {
+	sessionMock := &mnemosynetest.SessionManagerClient{}
+	subjectListerMock := &sessionmock.SubjectLister{}
+	actorProviderMock := &sessionmock.ActorProvider{}
+	auditEventProviderMock := &modelmock.AuditEventProvider{}
+
+	listing := &handler{
+		logger:        zap.L(),
+		ActorProvider: actorProviderMock,
+		session:       listingSessionStore{sessionMock, subjectListerMock},
+		repository: repositories{
+			auditEvent: auditEventProviderMock,
+			transactor: newTransactorMock(),
+		},
+	}
+	// notListing keeps sessions in mnemosyne, that cannot narrow them down to a single subject.
+	notListing := *listing
+	notListing.session = sessionMock
+
+	actor := func(act *session.Actor) {
+		actorProviderMock.On("Actor", mock.Anything).Return(act, nil).Once()
+	}
+	listed := func() {
+		subjectListerMock.On("ListBySubject", mock.Anything, session.ActorIDFromInt64(1).String()).
+			Return([]*mnemosynerpc.Session{
+				{AccessToken: "current", SubjectId: session.ActorIDFromInt64(1).String()},
+				{AccessToken: "a", SubjectId: session.ActorIDFromInt64(1).String()},
+				{AccessToken: "b", SubjectId: session.ActorIDFromInt64(1).String()},
+			}, nil).
+			Once()
+	}
+	deleted := func(err error) {
+		sessionMock.On("Delete", mock.Anything, &mnemosynerpc.DeleteRequest{SubjectId: session.ActorIDFromInt64(1).String()}).
+			Return(&wrappers.Int64Value{Value: 3}, err).
+			Once()
+	}
+	abandoned := func(token string, err error) {
+		sessionMock.On("Abandon", mock.Anything, &mnemosynerpc.AbandonRequest{AccessToken: token}).
+			Return(&wrappers.BoolValue{Value: err == nil}, err).
+			Once()
+	}
+
+	cases := map[string]struct {
+		req        charonrpc.TerminateSessionsRequest
+		init       func(*testing.T)
+		notListing bool
+		err        error
+		terminated []string
+	}{
+		"missing-user-id": {
+			init: func(t *testing.T) {},
+			err:  grpcerr.E(codes.InvalidArgument),
+		},
+		"cannot-terminate-as-a-stranger": {
+			init: func(t *testing.T) {
+				actor(&session.Actor{
+					User:        &model.UserEntity{ID: 2},
+					Permissions: charon.Permissions{charon.SessionCanDeleteAsOwner},
+				})
+			},
+			req: charonrpc.TerminateSessionsRequest{UserId: 1},
+			err: grpcerr.E(codes.PermissionDenied),
+		},
+		"cannot-terminate-without-permission": {
+			init: func(t *testing.T) {
+				actor(&session.Actor{
+					User:        &model.UserEntity{ID: 1},
+					Permissions: charon.Permissions{charon.SessionCanRetrieveAsOwner},
+				})
+			},
+			req: charonrpc.TerminateSessionsRequest{UserId: 1},
+			err: grpcerr.E(codes.PermissionDenied),
+		},
+		"all-except-current-as-an-owner": {
+			init: func(t *testing.T) {
+				actor(&session.Actor{
+					User:        &model.UserEntity{ID: 1},
+					Permissions: charon.Permissions{charon.SessionCanDeleteAsOwner},
+				})
+				listed()
+				abandoned("a", nil)
+				abandoned("b", status.Error(codes.NotFound, "session not found"))
+			},
+			req:        charonrpc.TerminateSessionsRequest{UserId: 1},
+			terminated: []string{session.ID("a")},
+		},
+		"selected-as-a-stranger": {
+			init: func(t *testing.T) {
+				actor(&session.Actor{
+					User:        &model.UserEntity{ID: 2},
+					Permissions: charon.Permissions{charon.SessionCanDeleteAsStranger},
+				})
+				listed()
+				abandoned("current", nil)
+				abandoned("b", nil)
+			},
+			req: charonrpc.TerminateSessionsRequest{
+				UserId: 1,
+				Ids:    []string{session.ID("current"), session.ID("b"), session.ID("c")},
+			},
+			terminated: []string{session.ID("current"), session.ID("b")},
+		},
+		"all-as-a-stranger": {
+			init: func(t *testing.T) {
+				actor(&session.Actor{User: &model.UserEntity{ID: 2, IsSuperuser: true}})
+				listed()
+				deleted(nil)
+			},
+			req:        charonrpc.TerminateSessionsRequest{UserId: 1},
+			terminated: []string{session.ID("current"), session.ID("a"), session.ID("b")},
+		},
+		"all-as-a-stranger-not-listing": {
+			init: func(t *testing.T) {
+				actor(&session.Actor{User: &model.UserEntity{ID: 2, IsSuperuser: true}})
+				deleted(nil)
+			},
+			req:        charonrpc.TerminateSessionsRequest{UserId: 1},
+			notListing: true,
+		},
+		"all-except-current-as-an-owner-not-listing": {
+			init: func(t *testing.T) {
+				actor(&session.Actor{
+					User:        &model.UserEntity{ID: 1},
+					Permissions: charon.Permissions{charon.SessionCanDeleteAsOwner},
+				})
+			},
+			req:        charonrpc.TerminateSessionsRequest{UserId: 1},
+			notListing: true,
+			err:        grpcerr.E(codes.FailedPrecondition),
+		},
+		"selected-as-a-stranger-not-listing": {
+			init: func(t *testing.T) {
+				actor(&session.Actor{User: &model.UserEntity{ID: 2, IsSuperuser: true}})
+			},
+			req:        charonrpc.TerminateSessionsRequest{UserId: 1, Ids: []string{session.ID("a")}},
+			notListing: true,
+			err:        grpcerr.E(codes.FailedPrecondition),
+		},
+		"abandon-failure": {
+			init: func(t *testing.T) {
+				actor(&session.Actor{
+					User:        &model.UserEntity{ID: 1},
+					Permissions: charon.Permissions{charon.SessionCanDeleteAsOwner},
+				})
+				listed()
+				abandoned("a", status.Error(codes.Unavailable, "mnemosyne unavailable"))
+			},
+			req: charonrpc.TerminateSessionsRequest{UserId: 1},
+			err: grpcerr.E(codes.Unavailable),
+		},
+		"delete-failure": {
+			init: func(t *testing.T) {
+				actor(&session.Actor{User: &model.UserEntity{ID: 2, IsSuperuser: true}})
+				listed()
+				deleted(status.Error(codes.Unavailable, "mnemosyne unavailable"))
+			},
+			req: charonrpc.TerminateSessionsRequest{UserId: 1},
+			err: grpcerr.E(codes.Unavailable),
+		},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			sessionMock.ExpectedCalls = []*mock.Call{}
+			subjectListerMock.ExpectedCalls = []*mock.Call{}
+			actorProviderMock.ExpectedCalls = []*mock.Call{}
+			auditEventProviderMock.ExpectedCalls = []*mock.Call{}
+
+			c.init(t)
+			audit := auditEventProviderMock.On("Insert", mock.Anything, auditEventMatcher(model.AuditEventTargetSession, 1)).
+				Return(&model.AuditEventEntity{}, nil)
+			if c.err != nil {
+				audit.Maybe()
+			}
+
+			ctx := metadata.NewIncomingContext(context.TODO(), metadata.Pairs(mnemosyne.AccessTokenMetadataKey, "current"))
+			h := terminateSessionsHandler{handler: listing}
+			if c.notListing {
+				h.handler = &notListing
+			}
+			res, err := h.Terminate(ctx, &c.req)
+			if c.err != nil {
+				if !grpcerr.Match(c.err, err) {
+					t.Fatalf("errors do not match, got '%v'", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if len(res.Ids) != len(c.terminated) {
+				t.Fatalf("wrong number of terminated sessions, expected %d but got %d", len(c.terminated), len(res.Ids))
+			}
+			for i, id := range c.terminated {
+				if res.Ids[i] != id {
+					t.Errorf("wrong session terminated, expected %s but got %s", id, res.Ids[i])
+				}
+			}
+
+			mock.AssertExpectationsForObjects(t, sessionMock, subjectListerMock, actorProviderMock, auditEventProviderMock)
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package charond
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/lib/pq"
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+
+	"google.golang.org/grpc/codes"
+)
+
+type unlockUserHandler struct {
+	*handler
+}
+
+// Unlock allows user locked after too many failed login attempts to log in again.
+func (uuh *unlockUserHandler) Unlock(ctx context.Context, req *charonrpc.UnlockUserRequest) (*wrappers.BoolValue, error) {
+	if req.Id <= 0 {
+		return nil, grpcerr.E(codes.InvalidArgument, "user cannot be unlocked, invalid id")
+	}
+
+	act, err := uuh.Actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ent, err := uuh.repository.user.FindOneByID(ctx, req.Id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, grpcerr.E(codes.NotFound, "user does not exists")
+		}
+		return nil, grpcerr.E(codes.Internal, "user retrieval failure", err)
+	}
+	if err = uuh.firewall(act, ent); err != nil {
+		return nil, err
+	}
+
+	var (
+		aff      int64
+		unlocked = *ent
+		entry    = &auditEntry{
+			targetKind: model.AuditEventTargetUser,
+			targetID:   ent.ID,
+			before:     ent,
+		}
+	)
+	err = uuh.audit(ctx, act, entry, func(ctx context.Context) error {
+		if aff, err = uuh.repository.user.Unlock(ctx, ent.ID); err != nil {
+			return grpcerr.E(codes.Internal, "user cannot be unlocked", err)
+		}
+		// Otherwise, the next failure would lock the user again.
+		if _, err = uuh.repository.loginFailure.Reset(ctx, model.LoginFailureKindUsername, ent.Username); err != nil {
+			return grpcerr.E(codes.Internal, "login failures reset failure", err)
+		}
+		unlocked.LockedAt = pq.NullTime{}
+		entry.after = &unlocked
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &wrappers.BoolValue{Value: aff > 0}, nil
+}
+
+func (uuh *unlockUserHandler) firewall(act *session.Actor, ent *model.UserEntity) error {
+	if act.User.IsSuperuser {
+		return nil
+	}
+	if ent.IsSuperuser {
+		return grpcerr.E(codes.PermissionDenied, "only superuser can unlock other superuser")
+	}
+	if !act.Permissions.Match(charon.UserCanUnlock) {
+		return grpcerr.E(codes.PermissionDenied, "user cannot be unlocked, missing permission")
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package charond
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/model/modelmock"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	"github.com/piotrkowalczuk/charon/internal/session/sessionmock"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+)
+
+func TestUnlockUserHandler_Unlock_Unit(t *testing.T) {
+	actorProviderMock := &sessionmock.ActorProvider{}
+	userProviderMock := &modelmock.UserProvider{}
+	loginFailureProviderMock := &modelmock.LoginFailureProvider{}
+	auditEventProviderMock := &modelmock.AuditEventProvider{}
+
+	h := unlockUserHandler{
+		handler: &handler{
+			ActorProvider: actorProviderMock,
+			repository: repositories{
+				user:         userProviderMock,
+				loginFailure: loginFailureProviderMock,
+				auditEvent:   auditEventProviderMock,
+				transactor:   newTransactorMock(),
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		init func(*testing.T)
+		req  charonrpc.UnlockUserRequest
+		err  error
+	}{
+		"invalid-id": {
+			init: func(t *testing.T) {},
+			err:  grpcerr.E(codes.InvalidArgument),
+		},
+		"not-found": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1, IsSuperuser: true}}, nil).
+					Once()
+				userProviderMock.On("FindOneByID", mock.Anything, int64(2)).
+					Return(nil, sql.ErrNoRows).
+					Once()
+			},
+			req: charonrpc.UnlockUserRequest{Id: 2},
+			err: grpcerr.E(codes.NotFound),
+		},
+		"missing-permission": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1}}, nil).
+					Once()
+				userProviderMock.On("FindOneByID", mock.Anything, int64(2)).
+					Return(&model.UserEntity{ID: 2, Username: "john"}, nil).
+					Once()
+			},
+			req: charonrpc.UnlockUserRequest{Id: 2},
+			err: grpcerr.E(codes.PermissionDenied),
+		},
+		"superuser-by-non-superuser": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{
+						User:        &model.UserEntity{ID: 1},
+						Permissions: charon.Permissions{charon.UserCanUnlock},
+					}, nil).
+					Once()
+				userProviderMock.On("FindOneByID", mock.Anything, int64(2)).
+					Return(&model.UserEntity{ID: 2, Username: "john", IsSuperuser: true}, nil).
+					Once()
+			},
+			req: charonrpc.UnlockUserRequest{Id: 2},
+			err: grpcerr.E(codes.PermissionDenied),
+		},
+		"unlock-query-timeout": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1, IsSuperuser: true}}, nil).
+					Once()
+				userProviderMock.On("FindOneByID", mock.Anything, int64(2)).
+					Return(&model.UserEntity{ID: 2, Username: "john"}, nil).
+					Once()
+				userProviderMock.On("Unlock", mock.Anything, int64(2)).
+					Return(int64(0), context.DeadlineExceeded).
+					Once()
+			},
+			req: charonrpc.UnlockUserRequest{Id: 2},
+			err: grpcerr.E(codes.DeadlineExceeded),
+		},
+		"with-permission": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{
+						User:        &model.UserEntity{ID: 1},
+						Permissions: charon.Permissions{charon.UserCanUnlock},
+					}, nil).
+					Once()
+				userProviderMock.On("FindOneByID", mock.Anything, int64(2)).
+					Return(&model.UserEntity{ID: 2, Username: "john"}, nil).
+					Once()
+				userProviderMock.On("Unlock", mock.Anything, int64(2)).
+					Return(int64(1), nil).
+					Once()
+				loginFailureProviderMock.On("Reset", mock.Anything, model.LoginFailureKindUsername, "john").
+					Return(int64(1), nil).
+					Once()
+			},
+			req: charonrpc.UnlockUserRequest{Id: 2},
+		},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			defer recoverTest(t)
+
+			actorProviderMock.ExpectedCalls = nil
+			userProviderMock.ExpectedCalls = nil
+			loginFailureProviderMock.ExpectedCalls = nil
+			auditEventProviderMock.ExpectedCalls = nil
+
+			c.init(t)
+			audit := auditEventProviderMock.On("Insert", mock.Anything, auditEventMatcher(model.AuditEventTargetUser, 2)).
+				Return(&model.AuditEventEntity{}, nil)
+			if c.err != nil {
+				audit.Maybe()
+			}
+
+			_, err := h.Unlock(context.Background(), &c.req)
+			assertError(t, c.err, err)
+
+			mock.AssertExpectationsForObjects(t, actorProviderMock, userProviderMock, loginFailureProviderMock, auditEventProviderMock)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package charond
+
+import (
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+
+	"google.golang.org/grpc/codes"
+)
+
+type watchHandler struct {
+	*handler
+	broker *watchBroker
+}
+
+func (wh *watchHandler) Watch(req *charonrpc.WatchRequest, stream charonrpc.Auth_WatchServer) error {
+	if wh.broker == nil {
+		return grpcerr.E(codes.Unimplemented, "watching is not available")
+	}
+
+	ctx := stream.Context()
+	act, err := wh.Actor(ctx)
+	if err != nil {
+		return err
+	}
+
+	ids := req.UserIds
+	if len(ids) == 0 {
+		ids = []int64{act.User.ID}
+	}
+	for _, id := range ids {
+		if id < 1 {
+			return grpcerr.E(codes.InvalidArgument, "user id needs to be greater than zero")
+		}
+		if err = wh.firewall(id, act); err != nil {
+			return err
+		}
+	}
+
+	sub := wh.broker.subscribe(ids...)
+	defer wh.broker.unsubscribe(sub)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case ev, ok := <-sub.events:
+			if !ok {
+				return grpcerr.E(codes.Unavailable, "events might have been lost, watch has to be started again")
+			}
+			if err = stream.Send(ev); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// firewall applies the same rules as isGrantedHandler does, since events reveal changes in granting.
+func (wh *watchHandler) firewall(userID int64, act *session.Actor) error {
+	if act.User.ID == userID {
+		return nil
+	}
+	if act.User.IsSuperuser {
+		return nil
+	}
+	if act.Permissions.Match(charon.UserPermissionCanCheckGrantingAsStranger) {
+		return nil
+	}
+
+	return grpcerr.E(codes.PermissionDenied, "user cannot be watched, missing permission")
+}
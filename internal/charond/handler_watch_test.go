@@ -0,0 +1,199 @@
+package charond
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	"github.com/piotrkowalczuk/charon/internal/session/sessionmock"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+type watchServerStub struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *charonrpc.WatchEvent
+}
+
+func (wss *watchServerStub) Context() context.Context {
+	return wss.ctx
+}
+
+func (wss *watchServerStub) Send(ev *charonrpc.WatchEvent) error {
+	wss.events <- ev
+	return nil
+}
+
+func TestWatchHandler_Watch_E2E(t *testing.T) {
+	suite := &endToEndSuite{}
+	suite.setup(t)
+	defer suite.teardown(t)
+
+	ctx, cancel := context.WithCancel(testRPCServerLogin(t, suite))
+	defer cancel()
+
+	stream, err := suite.charon.auth.Watch(ctx, &charonrpc.WatchRequest{UserIds: []int64{1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// give server a moment to subscribe, events are not replayed
+	time.Sleep(100 * time.Millisecond)
+
+	_, err = suite.charon.user.SetPermissions(ctx, &charonrpc.SetUserPermissionsRequest{
+		UserId:      1,
+		Permissions: []string{charon.PermissionCanRetrieve.String()},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ev, err := stream.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ev.UserId != 1 || ev.Kind != charonrpc.WatchEvent_PERMISSIONS {
+		t.Errorf("unexpected event: %v", ev)
+	}
+}
+
+func TestWatchHandler_Watch_Unit(t *testing.T) {
+	actorProviderMock := &sessionmock.ActorProvider{}
+
+	cases := map[string]struct {
+		init func(*testing.T)
+		req  charonrpc.WatchRequest
+		err  error
+	}{
+		"session-does-not-exists": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(nil, grpcerr.E(codes.Unauthenticated, "session does not exists")).
+					Once()
+			},
+			err: grpcerr.E(codes.Unauthenticated),
+		},
+		"invalid-user-id": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1}}, nil).
+					Once()
+			},
+			req: charonrpc.WatchRequest{UserIds: []int64{-1}},
+			err: grpcerr.E(codes.InvalidArgument),
+		},
+		"cannot-watch-as-a-stranger-if-missing-permission": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1, IsStaff: true}}, nil).
+					Once()
+			},
+			req: charonrpc.WatchRequest{UserIds: []int64{1, 2}},
+			err: grpcerr.E(codes.PermissionDenied),
+		},
+		"itself": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{User: &model.UserEntity{ID: 1}}, nil).
+					Once()
+			},
+		},
+		"as-a-stranger-if-have-permission": {
+			init: func(t *testing.T) {
+				actorProviderMock.On("Actor", mock.Anything).
+					Return(&session.Actor{
+						Permissions: charon.Permissions{charon.UserPermissionCanCheckGrantingAsStranger},
+						User:        &model.UserEntity{ID: 2},
+					}, nil).
+					Once()
+			},
+			req: charonrpc.WatchRequest{UserIds: []int64{1}},
+		},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			actorProviderMock.ExpectedCalls = nil
+
+			c.init(t)
+
+			h := watchHandler{
+				handler: &handler{
+					logger:        zap.L(),
+					ActorProvider: actorProviderMock,
+				},
+				broker: newWatchBroker(nil, nil, zap.L()),
+			}
+			ctx, cancel := context.WithCancel(context.Background())
+			stream := &watchServerStub{ctx: ctx, events: make(chan *charonrpc.WatchEvent, 1)}
+
+			done := make(chan error)
+			go func() {
+				done <- h.Watch(&c.req, stream)
+			}()
+
+			if c.err == nil {
+				// wait for subscription, then deliver an event of the watched user
+				for {
+					h.broker.lock.Lock()
+					n := len(h.broker.subscriptions)
+					h.broker.lock.Unlock()
+					if n > 0 {
+						break
+					}
+					time.Sleep(time.Millisecond)
+				}
+				h.broker.dispatch(`{"kind": "FLAGS", "user_id": 1}`)
+				if ev := <-stream.events; ev.UserId != 1 || ev.Kind != charonrpc.WatchEvent_FLAGS {
+					t.Errorf("unexpected event: %v", ev)
+				}
+				cancel()
+			}
+
+			assertError(t, c.err, <-done)
+			cancel()
+
+			mock.AssertExpectationsForObjects(t, actorProviderMock)
+		})
+	}
+}
+
+func TestWatchHandler_Watch_lost(t *testing.T) {
+	actorProviderMock := &sessionmock.ActorProvider{}
+	actorProviderMock.On("Actor", mock.Anything).
+		Return(&session.Actor{User: &model.UserEntity{ID: 1}}, nil).
+		Once()
+
+	h := watchHandler{
+		handler: &handler{
+			logger:        zap.L(),
+			ActorProvider: actorProviderMock,
+		},
+		broker: newWatchBroker(nil, nil, zap.L()),
+	}
+
+	done := make(chan error)
+	go func() {
+		done <- h.Watch(&charonrpc.WatchRequest{}, &watchServerStub{ctx: context.Background()})
+	}()
+
+	for {
+		h.broker.lock.Lock()
+		n := len(h.broker.subscriptions)
+		h.broker.lock.Unlock()
+		if n > 0 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	h.broker.reset()
+
+	assertError(t, grpcerr.E(codes.Unavailable), <-done)
+}
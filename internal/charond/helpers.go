@@ -1,8 +1,21 @@
 package charond
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
 	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/mapping"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
 	"github.com/piotrkowalczuk/ntypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
 )
 
 func untouched(given, created, removed int64) int64 {
@@ -18,6 +31,90 @@ func untouched(given, created, removed int64) int64 {
 	}
 }
 
+// grantsAndDenials maps permissions passed to set permissions requests.
+// Denials can be passed with or without the denial prefix, but the same permission cannot be granted and denied at once.
+func grantsAndDenials(granted, denied []string) (charon.Permissions, charon.Permissions, error) {
+	grants := charon.NewPermissions(granted...)
+	for _, p := range grants {
+		if p.IsDenial() {
+			return nil, nil, grpcerr.E(codes.InvalidArgument, "%s: denials are expected to be passed as denied permissions", p.String())
+		}
+	}
+	denials := make(charon.Permissions, 0, len(denied))
+	for _, d := range denied {
+		p := charon.Permission(d).Allow()
+		if grants.Contains(p) {
+			return nil, nil, grpcerr.E(codes.InvalidArgument, "%s: permission cannot be granted and denied at the same time", p.String())
+		}
+		denials = append(denials, p)
+	}
+
+	return grants, denials, nil
+}
+
+// permissionsValidity maps time windows passed to set user permissions request.
+// Each of them has to refer to one of the permissions being set, either granted or denied.
+func permissionsValidity(in map[string]*charonrpc.Validity, granted, denied charon.Permissions) (map[charon.Permission]model.Validity, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	out := make(map[charon.Permission]model.Validity, len(in))
+	for k, msg := range in {
+		p := charon.Permission(k).Allow()
+		if !granted.Contains(p) && !denied.Contains(p) {
+			return nil, grpcerr.E(codes.InvalidArgument, fmt.Sprintf("%s: validity given for a permission that is not being set", k))
+		}
+		v, err := validity(msg)
+		if err != nil {
+			return nil, grpcerr.E(codes.InvalidArgument, fmt.Sprintf("%s: %s", k, err.Error()))
+		}
+		out[p] = v
+	}
+
+	return out, nil
+}
+
+// groupsValidity works like permissionsValidity, but for time windows passed to set user groups request.
+func groupsValidity(in map[int64]*charonrpc.Validity, groups []int64) (map[int64]model.Validity, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	given := make(map[int64]struct{}, len(groups))
+	for _, id := range groups {
+		given[id] = struct{}{}
+	}
+	out := make(map[int64]model.Validity, len(in))
+	for id, msg := range in {
+		if _, ok := given[id]; !ok {
+			return nil, grpcerr.E(codes.InvalidArgument, fmt.Sprintf("%d: validity given for a group that is not being set", id))
+		}
+		v, err := validity(msg)
+		if err != nil {
+			return nil, grpcerr.E(codes.InvalidArgument, fmt.Sprintf("%d: %s", id, err.Error()))
+		}
+		out[id] = v
+	}
+
+	return out, nil
+}
+
+func validity(msg *charonrpc.Validity) (model.Validity, error) {
+	v, err := mapping.Validity(msg)
+	if err != nil {
+		return v, err
+	}
+	if v.Until.Valid {
+		if v.From.Valid && !v.Until.Time.After(v.From.Time) {
+			return v, errors.New("valid until needs to be after valid from")
+		}
+		if !v.Until.Time.After(time.Now()) {
+			return v, errors.New("valid until needs to be in the future")
+		}
+	}
+
+	return v, nil
+}
+
 func none() *empty.Empty {
 	return &empty.Empty{}
 }
@@ -35,3 +132,16 @@ func allocNilBool(b *ntypes.Bool) ntypes.Bool {
 	}
 	return *b
 }
+
+// peerHost returns host of the remote address, without the port.
+func peerHost(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
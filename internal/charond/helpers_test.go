@@ -1,6 +1,17 @@
 package charond
 
-import "testing"
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	pbts "github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"google.golang.org/grpc/codes"
+)
 
 func TestUntouched(t *testing.T) {
 	data := []struct {
@@ -57,3 +68,134 @@ func TestUntouched(t *testing.T) {
 		}
 	}
 }
+
+func TestGrantsAndDenials(t *testing.T) {
+	cases := map[string]struct {
+		granted, denied []string
+		grants, denials charon.Permissions
+		err             error
+	}{
+		"none": {
+			grants:  charon.Permissions{},
+			denials: charon.Permissions{},
+		},
+		"grants-and-denials": {
+			granted: []string{"a:b:*"},
+			denied:  []string{"a:b:c", "!a:b:d"},
+			grants:  charon.Permissions{"a:b:*"},
+			denials: charon.Permissions{"a:b:c", "a:b:d"},
+		},
+		"denial-among-grants": {
+			granted: []string{"!a:b:c"},
+			err:     grpcerr.E(codes.InvalidArgument),
+		},
+		"granted-and-denied": {
+			granted: []string{"a:b:c"},
+			denied:  []string{"!a:b:c"},
+			err:     grpcerr.E(codes.InvalidArgument),
+		},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			grants, denials, err := grantsAndDenials(c.granted, c.denied)
+			if c.err != nil {
+				if !grpcerr.Match(c.err, err) {
+					t.Fatalf("errors do not match, expected '%v', but got '%v'", c.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if !reflect.DeepEqual(c.grants, grants) {
+				t.Errorf("wrong grants, expected %v but got %v", c.grants, grants)
+			}
+			if !reflect.DeepEqual(c.denials, denials) {
+				t.Errorf("wrong denials, expected %v but got %v", c.denials, denials)
+			}
+		})
+	}
+}
+
+func TestPermissionsValidity(t *testing.T) {
+	ts := func(d time.Duration) *pbts.Timestamp {
+		res, err := ptypes.TimestampProto(time.Now().Add(d))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		return res
+	}
+	granted := charon.Permissions{"a:b:c"}
+	denied := charon.Permissions{"a:b:d"}
+
+	cases := map[string]struct {
+		given map[string]*charonrpc.Validity
+		keys  charon.Permissions
+		err   error
+	}{
+		"none": {},
+		"granted-and-denied": {
+			given: map[string]*charonrpc.Validity{
+				"a:b:c":  {ValidUntil: ts(time.Hour)},
+				"!a:b:d": {ValidFrom: ts(time.Hour), ValidUntil: ts(2 * time.Hour)},
+			},
+			keys: charon.Permissions{"a:b:c", "a:b:d"},
+		},
+		"not-being-set": {
+			given: map[string]*charonrpc.Validity{"a:b:e": {ValidUntil: ts(time.Hour)}},
+			err:   grpcerr.E(codes.InvalidArgument),
+		},
+		"until-before-from": {
+			given: map[string]*charonrpc.Validity{"a:b:c": {ValidFrom: ts(2 * time.Hour), ValidUntil: ts(time.Hour)}},
+			err:   grpcerr.E(codes.InvalidArgument),
+		},
+		"already-expired": {
+			given: map[string]*charonrpc.Validity{"a:b:c": {ValidUntil: ts(-time.Hour)}},
+			err:   grpcerr.E(codes.InvalidArgument),
+		},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			validity, err := permissionsValidity(c.given, granted, denied)
+			if c.err != nil {
+				if !grpcerr.Match(c.err, err) {
+					t.Fatalf("errors do not match, expected '%v', but got '%v'", c.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if len(validity) != len(c.keys) {
+				t.Fatalf("wrong number of windows, expected %d but got %d", len(c.keys), len(validity))
+			}
+			for _, p := range c.keys {
+				if !validity[p].IsTimeBound() {
+					t.Errorf("missing window of %s", p)
+				}
+			}
+		})
+	}
+}
+
+func TestGroupsValidity(t *testing.T) {
+	until, err := ptypes.TimestampProto(time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	validity, err := groupsValidity(map[int64]*charonrpc.Validity{1: {ValidUntil: until}}, []int64{1, 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if !validity[1].Until.Valid || validity[2].IsTimeBound() {
+		t.Errorf("wrong windows: %v", validity)
+	}
+
+	_, err = groupsValidity(map[int64]*charonrpc.Validity{3: {ValidUntil: until}}, []int64{1, 2})
+	if !grpcerr.Match(grpcerr.E(codes.InvalidArgument), err) {
+		t.Errorf("expected invalid argument, got: %v", err)
+	}
+}
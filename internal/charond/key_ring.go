@@ -0,0 +1,147 @@
+package charond
+
+import (
+	"context"
+	"crypto"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/piotrkowalczuk/charon/pkg/jwt"
+	"go.uber.org/zap"
+)
+
+// keyRing holds JWT signing keys read from PEM files of a directory, key id is a file name without extension.
+// All keys are published, so that tokens signed before rotation remain valid until they expire.
+// New tokens are signed by the key whose file name sorts last, so that every instance picks the same one.
+type keyRing struct {
+	dir    string
+	logger *zap.Logger
+
+	lock   sync.RWMutex
+	signer *jwt.Signer
+	keys   jwt.KeySet
+	jwks   []byte
+}
+
+func initKeyRing(opts DaemonOpts, logger *zap.Logger) (*keyRing, error) {
+	if opts.JWTKeys == "" {
+		return nil, nil
+	}
+
+	kr := &keyRing{
+		dir:    opts.JWTKeys,
+		logger: logger,
+	}
+	if err := kr.load(); err != nil {
+		return nil, err
+	}
+
+	logger.Info("jwt key ring has been initialized", zap.String("directory", kr.dir), zap.String("key_id", kr.Signer().KeyID()))
+
+	return kr, nil
+}
+
+// load replaces keys with those currently present in the directory.
+// Previous keys are kept if any of the files is invalid.
+func (kr *keyRing) load() error {
+	files, err := filepath.Glob(filepath.Join(kr.dir, "*.pem"))
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return errors.New("jwt key directory does not contain any *.pem file")
+	}
+	sort.Strings(files)
+
+	var (
+		signer *jwt.Signer
+		keys   = make(jwt.KeySet, len(files))
+		jwks   = jwt.JWKS{Keys: make([]jwt.JWK, 0, len(files))}
+	)
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		key, err := jwt.ParsePrivateKey(data)
+		if err != nil {
+			return fmt.Errorf("%s: %s", file, err.Error())
+		}
+		if signer, err = jwt.NewSigner(strings.TrimSuffix(filepath.Base(file), ".pem"), key); err != nil {
+			return fmt.Errorf("%s: %s", file, err.Error())
+		}
+		jwk, err := signer.JWK()
+		if err != nil {
+			return fmt.Errorf("%s: %s", file, err.Error())
+		}
+		keys[signer.KeyID()] = key.Public()
+		jwks.Keys = append(jwks.Keys, *jwk)
+	}
+
+	buf, err := json.Marshal(jwks)
+	if err != nil {
+		return err
+	}
+
+	kr.lock.Lock()
+	kr.signer, kr.keys, kr.jwks = signer, keys, buf
+	kr.lock.Unlock()
+
+	return nil
+}
+
+// run reloads keys periodically until context is done, so that keys can be rotated without a restart.
+func (kr *keyRing) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			previous := kr.Signer().KeyID()
+			if err := kr.load(); err != nil {
+				kr.logger.Error("jwt keys reload failure", zap.Error(err))
+				continue
+			}
+			if current := kr.Signer().KeyID(); current != previous {
+				kr.logger.Info("jwt signing key has been rotated", zap.String("key_id", current))
+			}
+		}
+	}
+}
+
+// Signer implements session.TokenKeys interface.
+func (kr *keyRing) Signer() *jwt.Signer {
+	kr.lock.RLock()
+	defer kr.lock.RUnlock()
+
+	return kr.signer
+}
+
+// PublicKey implements jwt.KeySource interface.
+func (kr *keyRing) PublicKey(ctx context.Context, keyID string) (crypto.PublicKey, error) {
+	kr.lock.RLock()
+	defer kr.lock.RUnlock()
+
+	return kr.keys.PublicKey(ctx, keyID)
+}
+
+// ServeHTTP implements http.Handler interface, it publishes public keys in JWKS format.
+func (kr *keyRing) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	kr.lock.RLock()
+	jwks := kr.jwks
+	kr.lock.RUnlock()
+
+	rw.Header().Set("Content-Type", "application/json")
+	rw.Write(jwks)
+}
@@ -0,0 +1,90 @@
+package charond
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/piotrkowalczuk/charon/pkg/jwt"
+	"go.uber.org/zap"
+)
+
+func writeTestKey(t *testing.T, dir, name string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	data := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+	if err = ioutil.WriteFile(filepath.Join(dir, name), data, 0600); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+}
+
+func TestKeyRing(t *testing.T) {
+	dir, err := ioutil.TempDir("", "charond-keys")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	defer os.RemoveAll(dir)
+
+	if _, err = initKeyRing(DaemonOpts{JWTKeys: dir}, zap.L()); err == nil {
+		t.Fatal("empty directory should be rejected")
+	}
+
+	writeTestKey(t, dir, "2018-01.pem")
+	kr, err := initKeyRing(DaemonOpts{JWTKeys: dir}, zap.L())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	old, err := kr.Signer().Sign(&jwt.Claims{Subject: "charon:user:1", ExpiresAt: 1 << 40})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	writeTestKey(t, dir, "2018-02.pem")
+	if err = kr.load(); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if kr.Signer().KeyID() != "2018-02" {
+		t.Errorf("key whose file name sorts last should sign, got: %s", kr.Signer().KeyID())
+	}
+	if _, err = (&jwt.Verifier{Keys: kr}).Verify(context.Background(), old); err != nil {
+		t.Errorf("token signed before rotation should remain valid: %s", err.Error())
+	}
+
+	if err = ioutil.WriteFile(filepath.Join(dir, "2018-03.pem"), []byte("broken"), 0600); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if err = kr.load(); err == nil {
+		t.Error("invalid key file should be reported")
+	}
+	if kr.Signer().KeyID() != "2018-02" {
+		t.Errorf("previous keys should be kept, got: %s", kr.Signer().KeyID())
+	}
+
+	rec := httptest.NewRecorder()
+	kr.ServeHTTP(rec, httptest.NewRequest("GET", "/.well-known/jwks.json", nil))
+	var jwks jwt.JWKS
+	if err = json.NewDecoder(rec.Body).Decode(&jwks); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(jwks.Keys) != 2 {
+		t.Fatalf("wrong number of published keys, expected 2 but got %d", len(jwks.Keys))
+	}
+	if _, err = jwt.NewKeySet(&jwks); err != nil {
+		t.Errorf("published keys should be decodable: %s", err.Error())
+	}
+}
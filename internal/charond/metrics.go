@@ -0,0 +1,40 @@
+package charond
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// loginMetrics implements prometheus.Collector interface.
+type loginMetrics struct {
+	locked    prometheus.Counter
+	throttled prometheus.Counter
+}
+
+func newLoginMetrics() *loginMetrics {
+	return &loginMetrics{
+		locked: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "charond",
+			Subsystem: "login",
+			Name:      "locked_attempts_total",
+			Help:      "Total number of login attempts rejected because the user was locked.",
+		}),
+		throttled: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "charond",
+			Subsystem: "login",
+			Name:      "throttled_attempts_total",
+			Help:      "Total number of login attempts rejected because of too many previous failures.",
+		}),
+	}
+}
+
+// Describe implements prometheus.Collector interface.
+func (lm *loginMetrics) Describe(in chan<- *prometheus.Desc) {
+	lm.locked.Describe(in)
+	lm.throttled.Describe(in)
+}
+
+// Collect implements prometheus.Collector interface.
+func (lm *loginMetrics) Collect(in chan<- prometheus.Metric) {
+	lm.locked.Collect(in)
+	lm.throttled.Collect(in)
+}
@@ -0,0 +1,786 @@
+package charond
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"html/template"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/piotrkowalczuk/charon/pkg/jwt"
+	"github.com/piotrkowalczuk/mnemosyne/mnemosynerpc"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	oidcScopeOpenID  = "openid"
+	oidcScopeProfile = "profile"
+	oidcScopeGroups  = "groups"
+
+	// oidcSessionCookie keeps access token of the user that signed in on the authorization page.
+	oidcSessionCookie = "charon_session"
+)
+
+var oidcScopes = []string{oidcScopeOpenID, oidcScopeProfile, oidcScopeGroups}
+
+// oidcProvider is an OpenID Connect provider that supports authorization code flow, with PKCE.
+// Users sign in with the same credentials as they use for Login, access tokens it issues are regular charon sessions.
+type oidcProvider struct {
+	issuer     string
+	codeTTL    time.Duration
+	tokenTTL   time.Duration
+	keys       session.TokenKeys
+	jwks       http.Handler
+	session    session.Store
+	repository repositories
+	logger     *zap.Logger
+	// login is the Auth.Login implementation, it takes care of throttling and the second factor.
+	login func(context.Context, *charonrpc.LoginRequest) (*wrappers.StringValue, error)
+	mux   *http.ServeMux
+}
+
+func newOIDCProvider(opts DaemonOpts, server *rpcServer, keys *keyRing) *oidcProvider {
+	op := &oidcProvider{
+		issuer:     strings.TrimSuffix(opts.OIDCIssuer, "/"),
+		codeTTL:    opts.OIDCCodeTTL,
+		tokenTTL:   opts.JWTTTL,
+		keys:       keys,
+		jwks:       keys,
+		session:    server.session,
+		repository: server.repository,
+		logger:     server.logger.Named("oidc"),
+		login:      newAuth(server).Login,
+	}
+	op.init()
+	return op
+}
+
+func (op *oidcProvider) init() {
+	op.mux = http.NewServeMux()
+	op.mux.HandleFunc("/.well-known/openid-configuration", op.discovery)
+	op.mux.HandleFunc("/authorize", op.authorize)
+	op.mux.HandleFunc("/token", op.token)
+	op.mux.HandleFunc("/userinfo", op.userinfo)
+	op.mux.Handle("/jwks", op.jwks)
+}
+
+// run removes expired authorization codes periodically, until context is cancelled.
+func (op *oidcProvider) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := op.repository.oauthCode.DeleteExpired(ctx); err != nil {
+				op.logger.Error("expired authorization codes removal failure", zap.Error(err))
+			}
+		}
+	}
+}
+
+// ServeHTTP implements http.Handler interface.
+func (op *oidcProvider) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	op.mux.ServeHTTP(rw, r)
+}
+
+func (op *oidcProvider) discovery(rw http.ResponseWriter, r *http.Request) {
+	writeJSON(rw, http.StatusOK, map[string]interface{}{
+		"issuer":                                op.issuer,
+		"authorization_endpoint":                op.issuer + "/authorize",
+		"token_endpoint":                        op.issuer + "/token",
+		"userinfo_endpoint":                     op.issuer + "/userinfo",
+		"jwks_uri":                              op.issuer + "/jwks",
+		"scopes_supported":                      oidcScopes,
+		"response_types_supported":              []string{"code"},
+		"grant_types_supported":                 []string{"authorization_code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{op.keys.Signer().Algorithm()},
+		"token_endpoint_auth_methods_supported": []string{"client_secret_basic", "client_secret_post", "none"},
+		"code_challenge_methods_supported":      []string{"S256"},
+		"claims_supported":                      []string{"sub", "iss", "aud", "exp", "iat", "auth_time", "nonce", "preferred_username", "given_name", "family_name", "groups"},
+	})
+}
+
+// authorizeRequest is an authorization request, as sent by the client.
+type authorizeRequest struct {
+	ClientID            string
+	RedirectURI         string
+	ResponseType        string
+	Scope               string
+	State               string
+	Nonce               string
+	Prompt              string
+	CodeChallenge       string
+	CodeChallengeMethod string
+
+	client *model.OauthClientEntity
+	// requestedRedirectURI is empty if client relied on its only registered redirect uri,
+	// token request has to repeat it only if it was given.
+	requestedRedirectURI string
+}
+
+func parseAuthorizeRequest(form url.Values) *authorizeRequest {
+	return &authorizeRequest{
+		ClientID:            form.Get("client_id"),
+		RedirectURI:         form.Get("redirect_uri"),
+		ResponseType:        form.Get("response_type"),
+		Scope:               form.Get("scope"),
+		State:               form.Get("state"),
+		Nonce:               form.Get("nonce"),
+		Prompt:              form.Get("prompt"),
+		CodeChallenge:       form.Get("code_challenge"),
+		CodeChallengeMethod: form.Get("code_challenge_method"),
+	}
+}
+
+// Scopes returns requested scopes that are supported, in the order they were requested.
+func (ar *authorizeRequest) Scopes() []string {
+	var scopes []string
+	for _, s := range strings.Fields(ar.Scope) {
+		for _, supported := range oidcScopes {
+			if s == supported {
+				scopes = append(scopes, s)
+				break
+			}
+		}
+	}
+	return scopes
+}
+
+// Fields returns request parameters, so that they can be carried over by the forms rendered by the authorization page.
+func (ar *authorizeRequest) Fields() map[string]string {
+	return map[string]string{
+		"client_id":             ar.ClientID,
+		"redirect_uri":          ar.RedirectURI,
+		"response_type":         ar.ResponseType,
+		"scope":                 ar.Scope,
+		"state":                 ar.State,
+		"nonce":                 ar.Nonce,
+		"code_challenge":        ar.CodeChallenge,
+		"code_challenge_method": ar.CodeChallengeMethod,
+	}
+}
+
+// redirect sends the user back to the client with given parameters, state is always included if given.
+func (ar *authorizeRequest) redirect(rw http.ResponseWriter, r *http.Request, params url.Values) {
+	if ar.State != "" {
+		params.Set("state", ar.State)
+	}
+	u, _ := url.Parse(ar.RedirectURI)
+	if u.RawQuery != "" {
+		u.RawQuery += "&" + params.Encode()
+	} else {
+		u.RawQuery = params.Encode()
+	}
+	http.Redirect(rw, r, u.String(), http.StatusFound)
+}
+
+func (ar *authorizeRequest) fail(rw http.ResponseWriter, r *http.Request, code, description string) {
+	ar.redirect(rw, r, url.Values{"error": {code}, "error_description": {description}})
+}
+
+// authorizePage is what the authorization page template is rendered with.
+type authorizePage struct {
+	Request   *authorizeRequest
+	Client    string
+	Username  string
+	Scopes    []string
+	Challenge string
+	CSRF      string
+	Error     string
+}
+
+func (op *oidcProvider) authorize(rw http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodPost {
+		http.Error(rw, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		op.renderError(rw, http.StatusBadRequest, "malformed authorization request")
+		return
+	}
+	ar := parseAuthorizeRequest(r.Form)
+
+	client, err := op.repository.oauthClient.FindOneByClientID(r.Context(), ar.ClientID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			op.renderError(rw, http.StatusBadRequest, "unknown client")
+			return
+		}
+		op.logger.Error("oauth client fetch failure", zap.Error(err))
+		op.renderError(rw, http.StatusInternalServerError, "authorization server failure")
+		return
+	}
+	ar.client = client
+
+	// Until redirect uri is validated, errors cannot be reported to the client.
+	uris := client.RedirectURIs()
+	ar.requestedRedirectURI = ar.RedirectURI
+	if ar.RedirectURI == "" && len(uris) == 1 {
+		ar.RedirectURI = uris[0]
+	}
+	if !containsString(uris, ar.RedirectURI) {
+		op.renderError(rw, http.StatusBadRequest, "redirect uri is not registered for the client")
+		return
+	}
+
+	switch {
+	case ar.ResponseType != "code":
+		ar.fail(rw, r, "unsupported_response_type", "only authorization code flow is supported")
+		return
+	case !containsString(ar.Scopes(), oidcScopeOpenID):
+		ar.fail(rw, r, "invalid_scope", "openid scope is required")
+		return
+	case ar.CodeChallenge == "" && client.IsPublic():
+		ar.fail(rw, r, "invalid_request", "public clients have to use PKCE")
+		return
+	case ar.CodeChallenge != "" && ar.CodeChallengeMethod != "S256":
+		ar.fail(rw, r, "invalid_request", "only S256 code challenge method is supported")
+		return
+	}
+
+	page := &authorizePage{
+		Request: ar,
+		Client:  client.Name,
+		Scopes:  ar.Scopes(),
+	}
+
+	token := op.sessionToken(r)
+	if r.Method == http.MethodPost && r.PostForm.Get("action") == "login" {
+		token, page.Challenge, err = op.signIn(r)
+		if err != nil {
+			page.Error = err.Error()
+			op.render(rw, http.StatusUnauthorized, page)
+			return
+		}
+		if page.Challenge != "" {
+			op.render(rw, http.StatusOK, page)
+			return
+		}
+		http.SetCookie(rw, &http.Cookie{
+			Name:     oidcSessionCookie,
+			Value:    token,
+			Path:     "/",
+			HttpOnly: true,
+			Secure:   strings.HasPrefix(op.issuer, "https://"),
+			SameSite: http.SameSiteLaxMode,
+		})
+	}
+
+	usr, err := op.sessionUser(r.Context(), token)
+	if err != nil {
+		op.logger.Error("oauth session user fetch failure", zap.Error(err))
+		op.renderError(rw, http.StatusInternalServerError, "authorization server failure")
+		return
+	}
+	if usr == nil {
+		if ar.Prompt == "none" {
+			ar.fail(rw, r, "login_required", "user is not signed in")
+			return
+		}
+		op.render(rw, http.StatusOK, page)
+		return
+	}
+	page.Username = usr.Username
+	page.CSRF = oidcCSRF(token)
+
+	if r.Method == http.MethodPost && r.PostForm.Get("action") != "login" {
+		if subtle.ConstantTimeCompare([]byte(r.PostForm.Get("csrf")), []byte(page.CSRF)) != 1 {
+			op.renderError(rw, http.StatusForbidden, "invalid csrf token")
+			return
+		}
+		if r.PostForm.Get("action") != "allow" {
+			ar.fail(rw, r, "access_denied", "user denied access")
+			return
+		}
+		if _, err = op.repository.oauthConsent.Grant(r.Context(), usr.ID, client.ID, strings.Join(page.Scopes, " ")); err != nil {
+			op.logger.Error("oauth consent persistence failure", zap.Error(err))
+			op.renderError(rw, http.StatusInternalServerError, "authorization server failure")
+			return
+		}
+		op.issueCode(rw, r, ar, usr)
+		return
+	}
+
+	if ar.Prompt != "consent" {
+		consent, err := op.repository.oauthConsent.FindOneByUserIDAndClientID(r.Context(), usr.ID, client.ID)
+		if err != nil && err != sql.ErrNoRows {
+			op.logger.Error("oauth consent fetch failure", zap.Error(err))
+			op.renderError(rw, http.StatusInternalServerError, "authorization server failure")
+			return
+		}
+		if consent != nil && containsAllStrings(consent.Scopes(), page.Scopes) {
+			op.issueCode(rw, r, ar, usr)
+			return
+		}
+	}
+	if ar.Prompt == "none" {
+		ar.fail(rw, r, "consent_required", "user did not consent to requested scopes")
+		return
+	}
+
+	op.render(rw, http.StatusOK, page)
+}
+
+// signIn authenticates the user using credentials from the login form.
+// If user has the second factor enabled, returned challenge has to be answered with a code.
+func (op *oidcProvider) signIn(r *http.Request) (token, challenge string, err error) {
+	req := &charonrpc.LoginRequest{Client: r.PostForm.Get("client_id")}
+	if c := r.PostForm.Get("challenge"); c != "" {
+		req.Strategy = &charonrpc.LoginRequest_Totp{Totp: &charonrpc.TOTPStrategy{
+			Challenge: c,
+			Code:      r.PostForm.Get("code"),
+		}}
+	} else {
+		req.Strategy = &charonrpc.LoginRequest_UsernameAndPassword{UsernameAndPassword: &charonrpc.UsernameAndPasswordStrategy{
+			Username: r.PostForm.Get("username"),
+			Password: r.PostForm.Get("password"),
+		}}
+	}
+
+	// Login throttles by the remote address, the same way it does for gRPC calls.
+	ctx := r.Context()
+	if addr, err := net.ResolveTCPAddr("tcp", r.RemoteAddr); err == nil {
+		ctx = peer.NewContext(ctx, &peer.Peer{Addr: addr})
+	}
+
+	res, err := op.login(ctx, req)
+	if err != nil {
+		if e, ok := err.(*grpcerr.Error); ok {
+			for _, d := range e.Details {
+				if sfr, ok := d.(*charonrpc.SecondFactorRequired); ok {
+					return "", sfr.Challenge, nil
+				}
+			}
+			switch e.Code {
+			case codes.Unauthenticated, codes.ResourceExhausted, codes.PermissionDenied:
+				return "", "", grpcerr.E("invalid credentials, or account is locked")
+			}
+		}
+		op.logger.Error("oauth login failure", zap.Error(err))
+		return "", "", grpcerr.E("sign in failed, try again later")
+	}
+	return res.Value, "", nil
+}
+
+// sessionToken returns access token from the session cookie, if present.
+func (op *oidcProvider) sessionToken(r *http.Request) string {
+	c, err := r.Cookie(oidcSessionCookie)
+	if err != nil {
+		return ""
+	}
+	return c.Value
+}
+
+// sessionUser returns user the token belongs to, or nil if token does not represent an active user session.
+func (op *oidcProvider) sessionUser(ctx context.Context, token string) (*model.UserEntity, error) {
+	if token == "" {
+		return nil, nil
+	}
+	res, err := op.session.Get(ctx, &mnemosynerpc.GetRequest{AccessToken: token})
+	if err != nil {
+		// Expired or abandoned session is treated as no session at all.
+		return nil, nil
+	}
+	return op.activeUser(ctx, res.Session.SubjectId)
+}
+
+// activeUser returns user represented by given subject id, or nil if user is gone or not allowed to sign in.
+func (op *oidcProvider) activeUser(ctx context.Context, subjectID string) (*model.UserEntity, error) {
+	userID, err := session.ActorID(subjectID).UserID()
+	if err != nil {
+		return nil, nil
+	}
+	usr, err := op.repository.user.FindOneByID(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if !usr.IsActive || !usr.IsConfirmed || usr.LockedAt.Valid || usr.DeletedAt.Valid {
+		return nil, nil
+	}
+	return usr, nil
+}
+
+func (op *oidcProvider) issueCode(rw http.ResponseWriter, r *http.Request, ar *authorizeRequest, usr *model.UserEntity) {
+	code, err := oauthRandom(32)
+	if err != nil {
+		op.logger.Error("authorization code generation failure", zap.Error(err))
+		ar.fail(rw, r, "server_error", "authorization code generation failure")
+		return
+	}
+	now := time.Now()
+	_, err = op.repository.oauthCode.Insert(r.Context(), &model.OauthAuthorizationCodeEntity{
+		Code:          hex.EncodeToString(oauthHash(code)),
+		ClientID:      ar.client.ID,
+		UserID:        usr.ID,
+		RedirectURI:   ar.requestedRedirectURI,
+		Scope:         strings.Join(ar.Scopes(), " "),
+		Nonce:         ar.Nonce,
+		CodeChallenge: ar.CodeChallenge,
+		AuthTime:      now,
+		ExpireAt:      now.Add(op.codeTTL),
+	})
+	if err != nil {
+		op.logger.Error("authorization code persistence failure", zap.Error(err))
+		ar.fail(rw, r, "server_error", "authorization code persistence failure")
+		return
+	}
+
+	op.logger.Debug("authorization code has been issued", zap.Int64("user_id", usr.ID), zap.String("client_id", ar.ClientID))
+
+	ar.redirect(rw, r, url.Values{"code": {code}})
+}
+
+// tokenError is an error response of the token endpoint.
+type tokenError struct {
+	Code        string `json:"error"`
+	Description string `json:"error_description,omitempty"`
+}
+
+func (op *oidcProvider) token(rw http.ResponseWriter, r *http.Request) {
+	rw.Header().Set("Cache-Control", "no-store")
+	rw.Header().Set("Pragma", "no-cache")
+
+	if r.Method != http.MethodPost {
+		writeJSON(rw, http.StatusMethodNotAllowed, &tokenError{Code: "invalid_request", Description: "only POST method is allowed"})
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeJSON(rw, http.StatusBadRequest, &tokenError{Code: "invalid_request", Description: "malformed request"})
+		return
+	}
+	if gt := r.PostForm.Get("grant_type"); gt != "authorization_code" {
+		writeJSON(rw, http.StatusBadRequest, &tokenError{Code: "unsupported_grant_type", Description: "only authorization_code grant is supported"})
+		return
+	}
+
+	client, terr := op.authenticateClient(r)
+	if terr != nil {
+		status := http.StatusBadRequest
+		if terr.Code == "invalid_client" {
+			status = http.StatusUnauthorized
+			rw.Header().Set("WWW-Authenticate", `Basic realm="charon"`)
+		}
+		writeJSON(rw, status, terr)
+		return
+	}
+
+	ctx := r.Context()
+	code, err := op.repository.oauthCode.Consume(ctx, hex.EncodeToString(oauthHash(r.PostForm.Get("code"))))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			writeJSON(rw, http.StatusBadRequest, &tokenError{Code: "invalid_grant", Description: "authorization code is invalid or expired"})
+			return
+		}
+		op.logger.Error("authorization code fetch failure", zap.Error(err))
+		writeJSON(rw, http.StatusInternalServerError, &tokenError{Code: "server_error"})
+		return
+	}
+	if code.ClientID != client.ID || code.RedirectURI != r.PostForm.Get("redirect_uri") {
+		writeJSON(rw, http.StatusBadRequest, &tokenError{Code: "invalid_grant", Description: "authorization code was issued to another client or redirect uri"})
+		return
+	}
+	if code.CodeChallenge != "" && !verifyCodeChallenge(code.CodeChallenge, r.PostForm.Get("code_verifier")) {
+		writeJSON(rw, http.StatusBadRequest, &tokenError{Code: "invalid_grant", Description: "code verifier does not match the challenge"})
+		return
+	}
+
+	usr, err := op.activeUser(ctx, session.ActorIDFromInt64(code.UserID).String())
+	if err != nil {
+		op.logger.Error("user fetch failure", zap.Error(err))
+		writeJSON(rw, http.StatusInternalServerError, &tokenError{Code: "server_error"})
+		return
+	}
+	if usr == nil {
+		writeJSON(rw, http.StatusBadRequest, &tokenError{Code: "invalid_grant", Description: "user is not active"})
+		return
+	}
+
+	res, err := op.session.Start(ctx, &mnemosynerpc.StartRequest{
+		Session: &mnemosynerpc.Session{
+			SubjectId:     session.ActorIDFromInt64(usr.ID).String(),
+			SubjectClient: client.ClientID,
+			Bag: map[string]string{
+				"username":       usr.Username,
+				"first_name":     usr.FirstName,
+				"last_name":      usr.LastName,
+				session.BagScope: code.Scope,
+			},
+		},
+	})
+	if err != nil {
+		op.logger.Error("session start failure", zap.Error(err))
+		writeJSON(rw, http.StatusInternalServerError, &tokenError{Code: "server_error"})
+		return
+	}
+
+	now := time.Now()
+	claims, err := op.claims(ctx, usr, code.Scopes())
+	if err != nil {
+		op.logger.Error("id token claims failure", zap.Error(err))
+		writeJSON(rw, http.StatusInternalServerError, &tokenError{Code: "server_error"})
+		return
+	}
+	claims.Issuer = op.issuer
+	claims.Audience = jwt.Audience{client.ClientID}
+	claims.IssuedAt = now.Unix()
+	claims.ExpiresAt = now.Add(op.tokenTTL).Unix()
+	claims.AuthTime = code.AuthTime.Unix()
+	claims.Nonce = code.Nonce
+	idToken, err := op.keys.Signer().SignIDToken(claims)
+	if err != nil {
+		op.logger.Error("id token signing failure", zap.Error(err))
+		writeJSON(rw, http.StatusInternalServerError, &tokenError{Code: "server_error"})
+		return
+	}
+
+	op.logger.Debug("authorization code has been exchanged", zap.Int64("user_id", usr.ID), zap.String("client_id", client.ClientID))
+
+	expiresIn := int64(op.tokenTTL.Seconds())
+	if expireAt := res.Session.GetExpireAt(); expireAt != nil {
+		expiresIn = expireAt.Seconds - now.Unix()
+	}
+	writeJSON(rw, http.StatusOK, map[string]interface{}{
+		"access_token": res.Session.AccessToken,
+		"token_type":   "Bearer",
+		"expires_in":   expiresIn,
+		"id_token":     idToken,
+		"scope":        code.Scope,
+	})
+}
+
+// authenticateClient identifies the client using HTTP basic authentication or credentials passed in the form.
+// Public clients only identify themselves, they prove possession of the code with PKCE instead.
+func (op *oidcProvider) authenticateClient(r *http.Request) (*model.OauthClientEntity, *tokenError) {
+	clientID, secret, basic := r.BasicAuth()
+	if basic {
+		// Credentials are form encoded before they are put into the header.
+		clientID, _ = url.QueryUnescape(clientID)
+		secret, _ = url.QueryUnescape(secret)
+	} else {
+		clientID, secret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+	if clientID == "" {
+		return nil, &tokenError{Code: "invalid_client", Description: "missing client id"}
+	}
+
+	client, err := op.repository.oauthClient.FindOneByClientID(r.Context(), clientID)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			op.logger.Error("oauth client fetch failure", zap.Error(err))
+			return nil, &tokenError{Code: "server_error"}
+		}
+		return nil, &tokenError{Code: "invalid_client", Description: "unknown client"}
+	}
+	if client.IsPublic() {
+		if secret != "" {
+			return nil, &tokenError{Code: "invalid_client", Description: "public client cannot authenticate with a secret"}
+		}
+		if r.PostForm.Get("code_verifier") == "" {
+			return nil, &tokenError{Code: "invalid_request", Description: "public clients have to use PKCE"}
+		}
+		return client, nil
+	}
+	if subtle.ConstantTimeCompare(oauthHash(secret), client.Secret) != 1 {
+		return nil, &tokenError{Code: "invalid_client", Description: "invalid client credentials"}
+	}
+	return client, nil
+}
+
+func (op *oidcProvider) userinfo(rw http.ResponseWriter, r *http.Request) {
+	token := strings.TrimSpace(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "))
+	if token == "" || token == r.Header.Get("Authorization") {
+		rw.Header().Set("WWW-Authenticate", `Bearer realm="charon"`)
+		writeJSON(rw, http.StatusUnauthorized, &tokenError{Code: "invalid_token", Description: "missing bearer token"})
+		return
+	}
+
+	ctx := r.Context()
+	res, err := op.session.Get(ctx, &mnemosynerpc.GetRequest{AccessToken: token})
+	if err != nil {
+		rw.Header().Set("WWW-Authenticate", `Bearer realm="charon", error="invalid_token"`)
+		writeJSON(rw, http.StatusUnauthorized, &tokenError{Code: "invalid_token", Description: "access token is invalid or expired"})
+		return
+	}
+	scopes := strings.Fields(res.Session.Bag[session.BagScope])
+	if !containsString(scopes, oidcScopeOpenID) {
+		writeJSON(rw, http.StatusForbidden, &tokenError{Code: "insufficient_scope", Description: "access token was not issued with openid scope"})
+		return
+	}
+	usr, err := op.activeUser(ctx, res.Session.SubjectId)
+	if err != nil {
+		op.logger.Error("user fetch failure", zap.Error(err))
+		writeJSON(rw, http.StatusInternalServerError, &tokenError{Code: "server_error"})
+		return
+	}
+	if usr == nil {
+		writeJSON(rw, http.StatusUnauthorized, &tokenError{Code: "invalid_token", Description: "user is not active"})
+		return
+	}
+
+	claims, err := op.claims(ctx, usr, scopes)
+	if err != nil {
+		op.logger.Error("userinfo claims failure", zap.Error(err))
+		writeJSON(rw, http.StatusInternalServerError, &tokenError{Code: "server_error"})
+		return
+	}
+	info := map[string]interface{}{"sub": claims.Subject}
+	if containsString(scopes, oidcScopeProfile) {
+		info["preferred_username"] = usr.Username
+		info["given_name"] = usr.FirstName
+		info["family_name"] = usr.LastName
+		info["name"] = strings.TrimSpace(usr.FirstName + " " + usr.LastName)
+	}
+	if containsString(scopes, oidcScopeGroups) {
+		info["groups"] = claims.Groups
+	}
+	writeJSON(rw, http.StatusOK, info)
+}
+
+// claims returns identity claims of the user limited to what given scopes allow.
+func (op *oidcProvider) claims(ctx context.Context, usr *model.UserEntity, scopes []string) (*jwt.Claims, error) {
+	claims := &jwt.Claims{
+		Subject: session.ActorIDFromInt64(usr.ID).String(),
+		UserID:  usr.ID,
+	}
+	if containsString(scopes, oidcScopeProfile) {
+		claims.Username = usr.Username
+		claims.GivenName = usr.FirstName
+		claims.FamilyName = usr.LastName
+	}
+	if containsString(scopes, oidcScopeGroups) {
+		groups, err := op.repository.group.FindByUserID(ctx, usr.ID)
+		if err != nil && err != sql.ErrNoRows {
+			return nil, err
+		}
+		claims.Groups = make([]string, 0, len(groups))
+		for _, g := range groups {
+			claims.Groups = append(claims.Groups, g.Name)
+		}
+	}
+	return claims, nil
+}
+
+func (op *oidcProvider) render(rw http.ResponseWriter, status int, page *authorizePage) {
+	rw.Header().Set("Content-Type", "text/html; charset=utf-8")
+	rw.Header().Set("X-Frame-Options", "DENY")
+	rw.WriteHeader(status)
+	if err := authorizeTemplate.Execute(rw, page); err != nil {
+		op.logger.Error("authorization page rendering failure", zap.Error(err))
+	}
+}
+
+func (op *oidcProvider) renderError(rw http.ResponseWriter, status int, msg string) {
+	op.render(rw, status, &authorizePage{Error: msg})
+}
+
+var authorizeTemplate = template.Must(template.New("authorize").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>Sign in</title></head>
+<body>
+{{if .Error}}<p class="error">{{.Error}}</p>{{end}}
+{{with .Request}}
+<form method="post" action="authorize">
+	{{range $name, $value := .Fields}}<input type="hidden" name="{{$name}}" value="{{$value}}">
+	{{end}}
+	{{if $.Username}}
+	<p>{{$.Client}} would like to access following information of {{$.Username}}:</p>
+	<ul>{{range $.Scopes}}<li>{{.}}</li>{{end}}</ul>
+	<input type="hidden" name="csrf" value="{{$.CSRF}}">
+	<button type="submit" name="action" value="allow">Allow</button>
+	<button type="submit" name="action" value="deny">Deny</button>
+	{{else if $.Challenge}}
+	<p>Enter the code from your authenticator application to sign in to {{$.Client}}.</p>
+	<input type="hidden" name="challenge" value="{{$.Challenge}}">
+	<input type="text" name="code" autocomplete="one-time-code" autofocus>
+	<button type="submit" name="action" value="login">Verify</button>
+	{{else}}
+	<p>Sign in to {{$.Client}}.</p>
+	<input type="text" name="username" autocomplete="username" autofocus>
+	<input type="password" name="password" autocomplete="current-password">
+	<button type="submit" name="action" value="login">Sign in</button>
+	{{end}}
+</form>
+{{end}}
+</body>
+</html>
+`))
+
+func writeJSON(rw http.ResponseWriter, status int, v interface{}) {
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(status)
+	json.NewEncoder(rw).Encode(v)
+}
+
+// oauthRandom returns hex encoded random value of given number of bytes.
+func oauthRandom(size int) (string, error) {
+	buf := make([]byte, size)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// oauthHash returns digest secrets and codes are persisted as, it is nil for an empty value.
+func oauthHash(s string) []byte {
+	if s == "" {
+		return nil
+	}
+	sum := sha256.Sum256([]byte(s))
+	return sum[:]
+}
+
+// oidcCSRF derives csrf token from the session token, so that it does not have to be stored.
+func oidcCSRF(token string) string {
+	sum := sha256.Sum256([]byte("csrf:" + token))
+	return hex.EncodeToString(sum[:])
+}
+
+// verifyCodeChallenge checks verifier against S256 code challenge, as described in RFC 7636.
+func verifyCodeChallenge(challenge, verifier string) bool {
+	if verifier == "" {
+		return false
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	return subtle.ConstantTimeCompare([]byte(base64.RawURLEncoding.EncodeToString(sum[:])), []byte(challenge)) == 1
+}
+
+func containsString(set []string, s string) bool {
+	for _, v := range set {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAllStrings(set, subset []string) bool {
+	for _, s := range subset {
+		if !containsString(set, s) {
+			return false
+		}
+	}
+	return true
+}
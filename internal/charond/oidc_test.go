@@ -0,0 +1,391 @@
+package charond
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/model/modelmock"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/piotrkowalczuk/charon/pkg/jwt"
+	"github.com/piotrkowalczuk/mnemosyne/mnemosynerpc"
+	"github.com/piotrkowalczuk/mnemosyne/mnemosynetest"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+type oidcTestSuite struct {
+	provider     *oidcProvider
+	keys         *keyRing
+	session      *mnemosynetest.SessionManagerClient
+	user         *modelmock.UserProvider
+	group        *modelmock.GroupProvider
+	oauthClient  *modelmock.OauthClientProvider
+	oauthConsent *modelmock.OauthConsentProvider
+	oauthCode    *modelmock.OauthAuthorizationCodeProvider
+}
+
+func newOIDCTestSuite(t *testing.T) *oidcTestSuite {
+	dir, err := ioutil.TempDir("", "charond-oidc")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	defer os.RemoveAll(dir)
+	writeTestKey(t, dir, "1.pem")
+	keys, err := initKeyRing(DaemonOpts{JWTKeys: dir}, zap.L())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	s := &oidcTestSuite{
+		keys:         keys,
+		session:      &mnemosynetest.SessionManagerClient{},
+		user:         &modelmock.UserProvider{},
+		group:        &modelmock.GroupProvider{},
+		oauthClient:  &modelmock.OauthClientProvider{},
+		oauthConsent: &modelmock.OauthConsentProvider{},
+		oauthCode:    &modelmock.OauthAuthorizationCodeProvider{},
+	}
+	s.provider = &oidcProvider{
+		issuer:   "https://id.example.com",
+		codeTTL:  time.Minute,
+		tokenTTL: time.Minute,
+		keys:     keys,
+		jwks:     keys,
+		session:  s.session,
+		repository: repositories{
+			user:         s.user,
+			group:        s.group,
+			oauthClient:  s.oauthClient,
+			oauthConsent: s.oauthConsent,
+			oauthCode:    s.oauthCode,
+		},
+		logger: zap.L(),
+		login: func(_ context.Context, req *charonrpc.LoginRequest) (*wrappers.StringValue, error) {
+			if req.GetUsernameAndPassword().GetPassword() != "secret" {
+				return nil, grpcerr.E(codes.Unauthenticated, "wrong password")
+			}
+			return &wrappers.StringValue{Value: "session-token"}, nil
+		},
+	}
+	s.provider.init()
+	return s
+}
+
+func (s *oidcTestSuite) do(method, target string, form url.Values, header http.Header) *httptest.ResponseRecorder {
+	var req *http.Request
+	if method == http.MethodPost {
+		req = httptest.NewRequest(method, target, strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	} else {
+		req = httptest.NewRequest(method, target+"?"+form.Encode(), nil)
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	rec := httptest.NewRecorder()
+	s.provider.ServeHTTP(rec, req)
+	return rec
+}
+
+var oidcTestClient = &model.OauthClientEntity{
+	ID:           1,
+	ClientID:     "app",
+	Name:         "Application",
+	RedirectUris: "https://app.example.com/cb",
+}
+
+func TestOIDCProvider_authorize(t *testing.T) {
+	s := newOIDCTestSuite(t)
+	s.oauthClient.On("FindOneByClientID", mock.Anything, "app").Return(oidcTestClient, nil)
+	s.oauthClient.On("FindOneByClientID", mock.Anything, mock.Anything).Return(nil, sql.ErrNoRows)
+
+	valid := func() url.Values {
+		return url.Values{
+			"client_id":             {"app"},
+			"redirect_uri":          {"https://app.example.com/cb"},
+			"response_type":         {"code"},
+			"scope":                 {"openid profile"},
+			"state":                 {"xyz"},
+			"code_challenge":        {"challenge"},
+			"code_challenge_method": {"S256"},
+		}
+	}
+
+	cases := map[string]struct {
+		modify func(url.Values)
+		status int
+		// err is expected in the redirect, if empty, login page is expected.
+		err string
+	}{
+		"unknown-client": {
+			modify: func(v url.Values) { v.Set("client_id", "unknown") },
+			status: http.StatusBadRequest,
+		},
+		"unregistered-redirect-uri": {
+			modify: func(v url.Values) { v.Set("redirect_uri", "https://evil.example.com/cb") },
+			status: http.StatusBadRequest,
+		},
+		"unsupported-response-type": {
+			modify: func(v url.Values) { v.Set("response_type", "token") },
+			status: http.StatusFound,
+			err:    "unsupported_response_type",
+		},
+		"missing-openid-scope": {
+			modify: func(v url.Values) { v.Set("scope", "profile") },
+			status: http.StatusFound,
+			err:    "invalid_scope",
+		},
+		"public-client-without-pkce": {
+			modify: func(v url.Values) { v.Del("code_challenge") },
+			status: http.StatusFound,
+			err:    "invalid_request",
+		},
+		"plain-code-challenge": {
+			modify: func(v url.Values) { v.Set("code_challenge_method", "plain") },
+			status: http.StatusFound,
+			err:    "invalid_request",
+		},
+		"not-signed-in-without-prompt": {
+			modify: func(v url.Values) { v.Set("prompt", "none") },
+			status: http.StatusFound,
+			err:    "login_required",
+		},
+		"login-page": {
+			modify: func(v url.Values) { v.Del("redirect_uri") },
+			status: http.StatusOK,
+		},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			form := valid()
+			c.modify(form)
+
+			rec := s.do(http.MethodGet, "/authorize", form, nil)
+			if rec.Code != c.status {
+				t.Fatalf("wrong status code, expected %d but got %d: %s", c.status, rec.Code, rec.Body.String())
+			}
+			if c.status != http.StatusFound {
+				if c.status == http.StatusOK && !strings.Contains(rec.Body.String(), `name="password"`) {
+					t.Errorf("login form expected, got: %s", rec.Body.String())
+				}
+				return
+			}
+			loc, err := url.Parse(rec.Header().Get("Location"))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if loc.Host != "app.example.com" || loc.Query().Get("error") != c.err || loc.Query().Get("state") != "xyz" {
+				t.Errorf("wrong redirect: %s", loc)
+			}
+		})
+	}
+}
+
+func TestOIDCProvider_flow(t *testing.T) {
+	s := newOIDCTestSuite(t)
+	usr := &model.UserEntity{ID: 7, Username: "john@example.com", FirstName: "John", LastName: "Snow", IsActive: true, IsConfirmed: true}
+	verifier := "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+	sum := sha256.Sum256([]byte(verifier))
+
+	s.oauthClient.On("FindOneByClientID", mock.Anything, "app").Return(oidcTestClient, nil)
+	s.user.On("FindOneByID", mock.Anything, int64(7)).Return(usr, nil)
+	s.group.On("FindByUserID", mock.Anything, int64(7)).Return([]*model.GroupEntity{{Name: "admins"}}, nil)
+	s.session.On("Get", mock.Anything, &mnemosynerpc.GetRequest{AccessToken: "session-token"}).
+		Return(&mnemosynerpc.GetResponse{Session: &mnemosynerpc.Session{SubjectId: "charon:user:7"}}, nil)
+	s.oauthConsent.On("FindOneByUserIDAndClientID", mock.Anything, int64(7), int64(1)).Return(nil, sql.ErrNoRows).Once()
+	s.oauthConsent.On("Grant", mock.Anything, int64(7), int64(1), "openid profile groups").Return(&model.OauthConsentEntity{}, nil).Once()
+
+	var code *model.OauthAuthorizationCodeEntity
+	s.oauthCode.On("Insert", mock.Anything, mock.AnythingOfType("*model.OauthAuthorizationCodeEntity")).
+		Return(func(_ context.Context, ent *model.OauthAuthorizationCodeEntity) *model.OauthAuthorizationCodeEntity {
+			code = ent
+			return ent
+		}, nil).
+		Once()
+
+	form := url.Values{
+		"client_id":             {"app"},
+		"redirect_uri":          {"https://app.example.com/cb"},
+		"response_type":         {"code"},
+		"scope":                 {"openid profile groups"},
+		"state":                 {"xyz"},
+		"nonce":                 {"n-0S6_WzA2Mj"},
+		"code_challenge":        {base64.RawURLEncoding.EncodeToString(sum[:])},
+		"code_challenge_method": {"S256"},
+	}
+
+	// Wrong password renders the login page again.
+	form.Set("action", "login")
+	form.Set("username", "john@example.com")
+	form.Set("password", "wrong")
+	if rec := s.do(http.MethodPost, "/authorize", form, nil); rec.Code != http.StatusUnauthorized {
+		t.Fatalf("wrong status code, expected %d but got %d", http.StatusUnauthorized, rec.Code)
+	}
+
+	// Successful sign in leads to the consent page.
+	form.Set("password", "secret")
+	rec := s.do(http.MethodPost, "/authorize", form, nil)
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), `value="allow"`) {
+		t.Fatalf("consent page expected, got %d: %s", rec.Code, rec.Body.String())
+	}
+	cookie := rec.Result().Cookies()
+	if len(cookie) != 1 || cookie[0].Value != "session-token" || !cookie[0].HttpOnly {
+		t.Fatalf("session cookie expected, got: %v", cookie)
+	}
+	header := http.Header{"Cookie": {cookie[0].String()}}
+
+	form.Del("username")
+	form.Del("password")
+	form.Set("action", "allow")
+	form.Set("csrf", "forged")
+	if rec = s.do(http.MethodPost, "/authorize", form, header); rec.Code != http.StatusForbidden {
+		t.Fatalf("forged consent should be rejected, got %d", rec.Code)
+	}
+
+	form.Set("csrf", oidcCSRF("session-token"))
+	rec = s.do(http.MethodPost, "/authorize", form, header)
+	if rec.Code != http.StatusFound {
+		t.Fatalf("wrong status code, expected %d but got %d: %s", http.StatusFound, rec.Code, rec.Body.String())
+	}
+	loc, err := url.Parse(rec.Header().Get("Location"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	given := loc.Query().Get("code")
+	if given == "" || loc.Query().Get("state") != "xyz" {
+		t.Fatalf("wrong redirect: %s", loc)
+	}
+	if code.Code == given || code.Code != hex.EncodeToString(oauthHash(given)) {
+		t.Fatalf("authorization code should be persisted hashed: %s", code.Code)
+	}
+
+	exchange := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {given},
+		"redirect_uri":  {"https://app.example.com/cb"},
+		"client_id":     {"app"},
+		"code_verifier": {"wrong"},
+	}
+	s.oauthCode.On("Consume", mock.Anything, code.Code).Return(code, nil).Twice()
+	if rec = s.do(http.MethodPost, "/token", exchange, nil); rec.Code != http.StatusBadRequest || !strings.Contains(rec.Body.String(), "invalid_grant") {
+		t.Fatalf("wrong code verifier should be rejected, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	s.session.On("Start", mock.Anything, mock.MatchedBy(func(req *mnemosynerpc.StartRequest) bool {
+		return req.Session.SubjectId == "charon:user:7" && req.Session.SubjectClient == "app" && req.Session.Bag["scope"] == "openid profile groups"
+	})).
+		Return(&mnemosynerpc.StartResponse{Session: &mnemosynerpc.Session{AccessToken: "access-token"}}, nil).
+		Once()
+	exchange.Set("code_verifier", verifier)
+	rec = s.do(http.MethodPost, "/token", exchange, nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("wrong status code, expected %d but got %d: %s", http.StatusOK, rec.Code, rec.Body.String())
+	}
+	var res struct {
+		AccessToken string `json:"access_token"`
+		TokenType   string `json:"token_type"`
+		IDToken     string `json:"id_token"`
+	}
+	if err = json.NewDecoder(rec.Body).Decode(&res); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if res.AccessToken != "access-token" || res.TokenType != "Bearer" {
+		t.Errorf("wrong token response: %#v", res)
+	}
+	claims, err := (&jwt.Verifier{Keys: s.keys, Issuer: "https://id.example.com", Audience: "app", IDToken: true}).Verify(context.Background(), res.IDToken)
+	if err != nil {
+		t.Fatalf("id token should be valid: %s", err.Error())
+	}
+	if claims.Subject != "charon:user:7" || claims.Nonce != "n-0S6_WzA2Mj" || claims.GivenName != "John" || len(claims.Groups) != 1 {
+		t.Errorf("wrong id token claims: %#v", claims)
+	}
+
+	s.session.On("Get", mock.Anything, &mnemosynerpc.GetRequest{AccessToken: "access-token"}).
+		Return(&mnemosynerpc.GetResponse{Session: &mnemosynerpc.Session{
+			SubjectId: "charon:user:7",
+			Bag:       map[string]string{"scope": "openid profile"},
+		}}, nil)
+	rec = s.do(http.MethodGet, "/userinfo", nil, http.Header{"Authorization": {"Bearer access-token"}})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("wrong status code, expected %d but got %d: %s", http.StatusOK, rec.Code, rec.Body.String())
+	}
+	var info map[string]interface{}
+	if err = json.NewDecoder(rec.Body).Decode(&info); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if info["sub"] != "charon:user:7" || info["preferred_username"] != "john@example.com" {
+		t.Errorf("wrong userinfo: %v", info)
+	}
+	if _, ok := info["groups"]; ok {
+		t.Error("groups should not be exposed without groups scope")
+	}
+
+	mock.AssertExpectationsForObjects(t, s.oauthConsent, s.oauthCode, s.session)
+}
+
+func TestOIDCProvider_discovery(t *testing.T) {
+	s := newOIDCTestSuite(t)
+
+	rec := s.do(http.MethodGet, "/.well-known/openid-configuration", nil, nil)
+	var cfg map[string]interface{}
+	if err := json.NewDecoder(rec.Body).Decode(&cfg); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if cfg["issuer"] != "https://id.example.com" || cfg["jwks_uri"] != "https://id.example.com/jwks" {
+		t.Errorf("wrong configuration: %v", cfg)
+	}
+
+	rec = s.do(http.MethodGet, "/jwks", nil, nil)
+	var jwks jwt.JWKS
+	if err := json.NewDecoder(rec.Body).Decode(&jwks); err != nil || len(jwks.Keys) != 1 {
+		t.Errorf("single key expected, got %v (%v)", jwks.Keys, err)
+	}
+}
+
+func TestOIDCProvider_accessTokenCannotBeUsedWithRPC(t *testing.T) {
+	sessionMock := &mnemosynetest.SessionManagerClient{}
+	userProviderMock := &modelmock.UserProvider{}
+	sessionMock.On("Context", mock.Anything, mock.Anything).
+		Return(&mnemosynerpc.ContextResponse{Session: &mnemosynerpc.Session{
+			AccessToken:   "access-token",
+			SubjectId:     "charon:user:7",
+			SubjectClient: "app",
+			Bag:           map[string]string{session.BagScope: "openid profile"},
+		}}, nil)
+
+	h := getUserHandler{
+		handler: &handler{
+			logger: zap.L(),
+			ActorProvider: &session.MnemosyneActorProvider{
+				Client:             sessionMock,
+				UserProvider:       userProviderMock,
+				PermissionProvider: &modelmock.PermissionProvider{},
+			},
+			repository: repositories{user: userProviderMock},
+		},
+	}
+
+	_, err := h.Get(context.TODO(), &charonrpc.GetUserRequest{Id: 7})
+	if !grpcerr.Match(grpcerr.E(codes.PermissionDenied), err) {
+		t.Fatalf("access token issued to an oauth client should be rejected, got: %v", err)
+	}
+	mock.AssertExpectationsForObjects(t, sessionMock, userProviderMock)
+}
@@ -0,0 +1,101 @@
+package charond
+
+import (
+	"context"
+	"time"
+
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/ntypes"
+	"go.uber.org/zap"
+)
+
+// auditPurgerRPC is recorded in place of the RPC name, purge of soft deleted users is not requested by anyone.
+const auditPurgerRPC = "charond/PurgeDeletedUsers"
+
+// userPurger periodically hard deletes users that were soft deleted longer than retention period ago.
+// Everything that is assigned to such user is removed as well, as if deletion was requested with cascade.
+type userPurger struct {
+	logger     *zap.Logger
+	interval   time.Duration
+	retention  time.Duration
+	repository repositories
+}
+
+func initUserPurger(opts DaemonOpts, repos repositories, logger *zap.Logger) *userPurger {
+	if opts.UserRetention <= 0 || opts.UserPurgeInterval <= 0 {
+		return nil
+	}
+
+	logger.Info("user purger has been initialized",
+		zap.Duration("interval", opts.UserPurgeInterval),
+		zap.Duration("retention", opts.UserRetention),
+	)
+
+	return &userPurger{
+		logger:     logger,
+		interval:   opts.UserPurgeInterval,
+		retention:  opts.UserRetention,
+		repository: repos,
+	}
+}
+
+// run purges users until context is done.
+func (up *userPurger) run(ctx context.Context) {
+	ticker := time.NewTicker(up.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			purged, err := up.purge(ctx, time.Now().Add(-up.retention))
+			if err != nil {
+				up.logger.Error("deleted users purge failure", zap.Error(err))
+				continue
+			}
+			if purged > 0 {
+				up.logger.Info("deleted users have been purged", zap.Int("users", purged))
+			}
+		}
+	}
+}
+
+// purge hard deletes users soft deleted before given point in time, each of them within its own transaction,
+// so that a single user that cannot be removed does not hold back the others.
+func (up *userPurger) purge(ctx context.Context, before time.Time) (int, error) {
+	ents, err := up.repository.user.FindDeletedBefore(ctx, before)
+	if err != nil {
+		return 0, err
+	}
+
+	var purged int
+	for _, ent := range ents {
+		var aff int64
+		err := up.repository.transactor.Transaction(ctx, func(ctx context.Context) (err error) {
+			if err = detachUser(ctx, up.repository, ent.ID); err != nil {
+				return err
+			}
+			if aff, err = removeUser(ctx, up.repository, ent.ID); err != nil || aff == 0 {
+				return err
+			}
+			doc, _, err := auditDiff(ent, nil)
+			if err != nil {
+				return err
+			}
+			_, err = up.repository.auditEvent.Insert(ctx, &model.AuditEventEntity{
+				RPC:        auditPurgerRPC,
+				TargetKind: model.AuditEventTargetUser,
+				TargetID:   ntypes.Int64{Int64: ent.ID, Valid: true},
+				Before:     doc,
+			})
+			return err
+		})
+		if err != nil {
+			up.logger.Error("deleted user purge failure", zap.Int64("user_id", ent.ID), zap.Error(err))
+			continue
+		}
+		purged += int(aff)
+	}
+	return purged, nil
+}
@@ -0,0 +1,103 @@
+package charond
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/model/modelmock"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestUserPurger_purge(t *testing.T) {
+	userMock := &modelmock.UserProvider{}
+	userGroupsMock := &modelmock.UserGroupsProvider{}
+	userPermissionsMock := &modelmock.UserPermissionsProvider{}
+	userResourcesMock := &modelmock.UserResourcePermissionsProvider{}
+	refreshTokenMock := &modelmock.RefreshTokenProvider{}
+	oauthConsentMock := &modelmock.OauthConsentProvider{}
+	oauthCodeMock := &modelmock.OauthAuthorizationCodeProvider{}
+	userIdentityMock := &modelmock.UserIdentityProvider{}
+	apiKeyMock := &modelmock.APIKeyProvider{}
+	auditEventMock := &modelmock.AuditEventProvider{}
+
+	up := &userPurger{
+		logger:    zap.L(),
+		interval:  time.Minute,
+		retention: time.Hour,
+		repository: repositories{
+			user:            userMock,
+			userGroups:      userGroupsMock,
+			userPermissions: userPermissionsMock,
+			userResources:   userResourcesMock,
+			refreshToken:    refreshTokenMock,
+			oauthConsent:    oauthConsentMock,
+			oauthCode:       oauthCodeMock,
+			userIdentity:    userIdentityMock,
+			apiKey:          apiKeyMock,
+			auditEvent:      auditEventMock,
+			transactor:      newTransactorMock(),
+		},
+	}
+	before := time.Now().Add(-time.Hour)
+	deletedAt := pq.NullTime{Time: before.Add(-time.Hour), Valid: true}
+
+	userMock.On("FindDeletedBefore", mock.Anything, before).
+		Return([]*model.UserEntity{
+			{ID: 1, DeletedAt: deletedAt},
+			{ID: 2, DeletedAt: deletedAt},
+		}, nil).
+		Once()
+	for _, id := range []int64{1, 2} {
+		userGroupsMock.On("DeleteByUserID", mock.Anything, id).Return(int64(1), nil).Once()
+		userPermissionsMock.On("DeleteByUserID", mock.Anything, id).Return(int64(1), nil).Once()
+		userResourcesMock.On("DeleteByUserID", mock.Anything, id).Return(int64(0), nil).Once()
+		refreshTokenMock.On("DeleteByUserID", mock.Anything, id).Return(int64(1), nil).Once()
+		oauthConsentMock.On("DeleteByUserID", mock.Anything, id).Return(int64(0), nil).Once()
+		oauthCodeMock.On("DeleteByUserID", mock.Anything, id).Return(int64(0), nil).Once()
+		userIdentityMock.On("DeleteByUserID", mock.Anything, id).Return(int64(0), nil).Once()
+		apiKeyMock.On("DeleteByUserID", mock.Anything, id).Return(int64(0), nil).Once()
+		// Users the purged one created or modified are kept, otherwise it could never be removed.
+		userMock.On("Disown", mock.Anything, id).Return(int64(1), nil).Once()
+	}
+	// The first user cannot be removed, it should not hold back the other one.
+	userMock.On("DeleteOneByID", mock.Anything, int64(1)).Return(int64(0), context.DeadlineExceeded).Once()
+	userMock.On("DeleteOneByID", mock.Anything, int64(2)).Return(int64(1), nil).Once()
+	auditEventMock.On("Insert", mock.Anything, mock.MatchedBy(func(ent *model.AuditEventEntity) bool {
+		return ent.RPC == auditPurgerRPC &&
+			ent.TargetKind == model.AuditEventTargetUser &&
+			ent.TargetID.Int64Or(0) == 2 &&
+			len(ent.Before) > 0
+	})).
+		Return(&model.AuditEventEntity{}, nil).
+		Once()
+
+	purged, err := up.purge(context.Background(), before)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if purged != 1 {
+		t.Errorf("wrong number of purged users, expected 1 but got %d", purged)
+	}
+
+	mock.AssertExpectationsForObjects(t,
+		userMock,
+		userGroupsMock,
+		userPermissionsMock,
+		userResourcesMock,
+		refreshTokenMock,
+		auditEventMock,
+	)
+}
+
+func TestInitUserPurger(t *testing.T) {
+	if up := initUserPurger(DaemonOpts{UserPurgeInterval: time.Minute}, repositories{}, zap.L()); up != nil {
+		t.Error("purger should be disabled without retention period")
+	}
+	if up := initUserPurger(DaemonOpts{UserRetention: time.Hour, UserPurgeInterval: time.Minute}, repositories{}, zap.L()); up == nil {
+		t.Error("purger should be enabled")
+	}
+}
@@ -4,21 +4,32 @@ import (
 	"context"
 
 	"github.com/piotrkowalczuk/charon/internal/service"
+	"github.com/piotrkowalczuk/charon/internal/session"
 	"go.uber.org/zap"
 
 	"github.com/piotrkowalczuk/charon/internal/model"
 	"github.com/piotrkowalczuk/charon/internal/password"
-	"github.com/piotrkowalczuk/mnemosyne/mnemosynerpc"
+	"github.com/piotrkowalczuk/mnemosyne"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 type rpcServer struct {
-	opts               DaemonOpts
-	logger             *zap.Logger
-	session            mnemosynerpc.SessionManagerClient
-	passwordHasher     password.Hasher
-	permissionRegistry model.PermissionRegistry
-	repository         repositories
+	opts                 DaemonOpts
+	logger               *zap.Logger
+	session              session.Store
+	passwordHasher       password.Hasher
+	externalAuth         service.ExternalAuthenticator
+	federation           *service.Federation
+	loginThrottler       *service.LoginThrottler
+	loginMetrics         *loginMetrics
+	notifier             service.Notifier
+	registrationLimiter  *service.RegistrationLimiter
+	passwordResetLimiter *service.RegistrationLimiter
+	permissionRegistry   model.PermissionRegistry
+	watchBroker          *watchBroker
+	actorCache           *session.CachingActorProvider
+	repository           repositories
 }
 
 type auth struct {
@@ -26,8 +37,11 @@ type auth struct {
 	*loginHandler
 	*logoutHandler
 	*isGrantedHandler
+	*isGrantedBatchHandler
 	*isAuthenticatedHandler
 	*belongsToHandler
+	*listResourcesHandler
+	*watchHandler
 }
 
 func newAuth(server *rpcServer) *auth {
@@ -35,22 +49,40 @@ func newAuth(server *rpcServer) *auth {
 		actorHandler:           &actorHandler{handler: newHandler(server)},
 		belongsToHandler:       &belongsToHandler{handler: newHandler(server)},
 		isGrantedHandler:       &isGrantedHandler{handler: newHandler(server)},
+		isGrantedBatchHandler:  &isGrantedBatchHandler{handler: newHandler(server)},
 		isAuthenticatedHandler: &isAuthenticatedHandler{handler: newHandler(server)},
+		listResourcesHandler:   &listResourcesHandler{handler: newHandler(server)},
 		loginHandler: &loginHandler{
 			handler: newHandler(server),
 			userFinderFactory: &service.UserFinderFactory{
 				Hasher:                 server.passwordHasher,
 				UserRepository:         server.repository.user,
 				RefreshTokenRepository: server.repository.refreshToken,
+				APIKeyRepository:       server.repository.apiKey,
+				ExternalAuthenticator:  server.externalAuth,
+				Federation:             server.federation,
+				Transactor:             server.repository.transactor,
+				RefreshTokenRotation:   server.opts.RefreshTokenRotation,
+				Logger:                 server.logger.Named("user_finder"),
 			},
+			throttler: server.loginThrottler,
+			metrics:   server.loginMetrics,
 		},
 		logoutHandler: &logoutHandler{handler: newHandler(server)},
+		watchHandler: &watchHandler{
+			handler: newHandler(server),
+			broker:  server.watchBroker,
+		},
 	}
 }
 
 type userManager struct {
+	*confirmTOTPHandler
 	*createUserHandler
 	*deleteUserHandler
+	*disableTOTPHandler
+	*generateRecoveryCodesHandler
+	*generateTOTPSecretHandler
 	*getUserHandler
 	*listUserGroupsHandler
 	*listUserPermissionsHandler
@@ -58,19 +90,51 @@ type userManager struct {
 	*modifyUserHandler
 	*setUserGroupsHandler
 	*setUserPermissionsHandler
+	*setUserResourcePermissionsHandler
+	*unlockUserHandler
+	*restoreUserHandler
+	*changePasswordHandler
+	*requestPasswordResetHandler
+	*resetPasswordHandler
+	*registerUserHandler
+	*confirmUserHandler
 }
 
 func newUserManager(server *rpcServer) *userManager {
 	return &userManager{
-		createUserHandler:          &createUserHandler{handler: newHandler(server), hasher: server.passwordHasher},
-		deleteUserHandler:          &deleteUserHandler{handler: newHandler(server)},
-		getUserHandler:             &getUserHandler{handler: newHandler(server)},
-		listUserGroupsHandler:      &listUserGroupsHandler{handler: newHandler(server)},
-		listUserPermissionsHandler: &listUserPermissionsHandler{handler: newHandler(server)},
-		listUsersHandler:           &listUsersHandler{handler: newHandler(server)},
-		modifyUserHandler:          &modifyUserHandler{handler: newHandler(server)},
-		setUserGroupsHandler:       &setUserGroupsHandler{handler: newHandler(server)},
-		setUserPermissionsHandler:  &setUserPermissionsHandler{handler: newHandler(server)},
+		confirmTOTPHandler:           &confirmTOTPHandler{handler: newHandler(server)},
+		createUserHandler:            &createUserHandler{handler: newHandler(server), hasher: server.passwordHasher},
+		deleteUserHandler:            &deleteUserHandler{handler: newHandler(server)},
+		disableTOTPHandler:           &disableTOTPHandler{handler: newHandler(server)},
+		generateRecoveryCodesHandler: &generateRecoveryCodesHandler{handler: newHandler(server)},
+		generateTOTPSecretHandler:    &generateTOTPSecretHandler{handler: newHandler(server), issuer: server.opts.TOTPIssuer},
+		getUserHandler:               &getUserHandler{handler: newHandler(server)},
+		listUserGroupsHandler:        &listUserGroupsHandler{handler: newHandler(server)},
+		listUserPermissionsHandler:   &listUserPermissionsHandler{handler: newHandler(server)},
+		listUsersHandler:             &listUsersHandler{handler: newHandler(server)},
+		modifyUserHandler:            &modifyUserHandler{handler: newHandler(server)},
+		setUserGroupsHandler:         &setUserGroupsHandler{handler: newHandler(server)},
+		setUserPermissionsHandler:    &setUserPermissionsHandler{handler: newHandler(server)},
+		unlockUserHandler:            &unlockUserHandler{handler: newHandler(server)},
+		restoreUserHandler:           &restoreUserHandler{handler: newHandler(server)},
+		changePasswordHandler:        &changePasswordHandler{handler: newHandler(server), hasher: server.passwordHasher},
+		requestPasswordResetHandler: &requestPasswordResetHandler{
+			handler:  newHandler(server),
+			notifier: server.notifier,
+			limiter:  server.passwordResetLimiter,
+			ttl:      server.opts.PasswordResetTTL,
+		},
+		resetPasswordHandler: &resetPasswordHandler{handler: newHandler(server), hasher: server.passwordHasher},
+		registerUserHandler: &registerUserHandler{
+			handler:  newHandler(server),
+			enabled:  server.opts.Registration,
+			hasher:   server.passwordHasher,
+			notifier: server.notifier,
+			limiter:  server.registrationLimiter,
+			ttl:      server.opts.RegistrationTTL,
+		},
+		confirmUserHandler:                &confirmUserHandler{handler: newHandler(server)},
+		setUserResourcePermissionsHandler: &setUserResourcePermissionsHandler{handler: newHandler(server)},
 	}
 }
 
@@ -114,13 +178,71 @@ type refreshTokenManager struct {
 	*createRefreshTokenHandler
 	*listRefreshTokensHandler
 	*revokeRefreshTokenHandler
+	*revokeAllRefreshTokensHandler
 }
 
 func newRefreshTokenManager(server *rpcServer) *refreshTokenManager {
 	return &refreshTokenManager{
-		createRefreshTokenHandler: &createRefreshTokenHandler{handler: newHandler(server)},
-		listRefreshTokensHandler:  &listRefreshTokensHandler{handler: newHandler(server)},
-		revokeRefreshTokenHandler: &revokeRefreshTokenHandler{handler: newHandler(server)},
+		createRefreshTokenHandler:     &createRefreshTokenHandler{handler: newHandler(server)},
+		listRefreshTokensHandler:      &listRefreshTokensHandler{handler: newHandler(server)},
+		revokeRefreshTokenHandler:     &revokeRefreshTokenHandler{handler: newHandler(server)},
+		revokeAllRefreshTokensHandler: &revokeAllRefreshTokensHandler{handler: newHandler(server)},
+	}
+}
+
+type apiKeyManager struct {
+	*createAPIKeyHandler
+	*getAPIKeyHandler
+	*listAPIKeysHandler
+	*revokeAPIKeyHandler
+	*deleteAPIKeyHandler
+}
+
+func newAPIKeyManager(server *rpcServer) *apiKeyManager {
+	return &apiKeyManager{
+		createAPIKeyHandler: &createAPIKeyHandler{handler: newHandler(server)},
+		getAPIKeyHandler:    &getAPIKeyHandler{handler: newHandler(server)},
+		listAPIKeysHandler:  &listAPIKeysHandler{handler: newHandler(server)},
+		revokeAPIKeyHandler: &revokeAPIKeyHandler{handler: newHandler(server)},
+		deleteAPIKeyHandler: &deleteAPIKeyHandler{handler: newHandler(server)},
+	}
+}
+
+type auditManager struct {
+	*listAuditEventsHandler
+}
+
+func newAuditManager(server *rpcServer) *auditManager {
+	return &auditManager{
+		listAuditEventsHandler: &listAuditEventsHandler{handler: newHandler(server)},
+	}
+}
+
+type oauthClientManager struct {
+	*createOAuthClientHandler
+	*getOAuthClientHandler
+	*listOAuthClientsHandler
+	*deleteOAuthClientHandler
+}
+
+func newOAuthClientManager(server *rpcServer) *oauthClientManager {
+	return &oauthClientManager{
+		createOAuthClientHandler: &createOAuthClientHandler{handler: newHandler(server)},
+		getOAuthClientHandler:    &getOAuthClientHandler{handler: newHandler(server)},
+		listOAuthClientsHandler:  &listOAuthClientsHandler{handler: newHandler(server)},
+		deleteOAuthClientHandler: &deleteOAuthClientHandler{handler: newHandler(server)},
+	}
+}
+
+type sessionManager struct {
+	*listSessionsHandler
+	*terminateSessionsHandler
+}
+
+func newSessionManager(server *rpcServer) *sessionManager {
+	return &sessionManager{
+		listSessionsHandler:      &listSessionsHandler{handler: newHandler(server)},
+		terminateSessionsHandler: &terminateSessionsHandler{handler: newHandler(server)},
 	}
 }
 
@@ -138,3 +260,40 @@ func unaryServerInterceptors(interceptors ...grpc.UnaryServerInterceptor) grpc.U
 		return chain(ctx, req)
 	}
 }
+
+func streamServerInterceptors(interceptors ...grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		wrap := func(current grpc.StreamServerInterceptor, next grpc.StreamHandler) grpc.StreamHandler {
+			return func(currentSrv interface{}, currentSS grpc.ServerStream) error {
+				return current(currentSrv, currentSS, info, next)
+			}
+		}
+		chain := handler
+		for _, i := range interceptors {
+			chain = wrap(i, chain)
+		}
+		return chain(srv, ss)
+	}
+}
+
+// serverStream allows to replace context of the stream.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context implements grpc.ServerStream interface.
+func (ss *serverStream) Context() context.Context {
+	return ss.ctx
+}
+
+// forwardMetadata passes access token and request id to services called while handling a request.
+func forwardMetadata(ctx context.Context) context.Context {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		return metadata.NewOutgoingContext(ctx, metadata.MD{
+			mnemosyne.AccessTokenMetadataKey: md[mnemosyne.AccessTokenMetadataKey],
+			"request_id":                     md["request_id"],
+		})
+	}
+	return ctx
+}
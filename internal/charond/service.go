@@ -2,14 +2,19 @@ package charond
 
 import (
 	"context"
+	"crypto/tls"
 	"database/sql"
 	"fmt"
+	"net"
+	"net/http"
 	"net/url"
 	"time"
 
 	"github.com/piotrkowalczuk/charon"
 	"github.com/piotrkowalczuk/charon/internal/model"
 	"github.com/piotrkowalczuk/charon/internal/password"
+	"github.com/piotrkowalczuk/charon/internal/service"
+	"github.com/piotrkowalczuk/charon/internal/session"
 	"github.com/piotrkowalczuk/mnemosyne/mnemosynerpc"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -63,6 +68,53 @@ func initPostgres(address string, test bool, logger *zap.Logger) (*sql.DB, error
 	return db, nil
 }
 
+func initActorCache(opts DaemonOpts, store session.Store, repos repositories, logger *zap.Logger) *session.CachingActorProvider {
+	if opts.ActorCacheTTL <= 0 {
+		return nil
+	}
+
+	logger.Info("actor cache has been initialized", zap.Duration("ttl", opts.ActorCacheTTL), zap.Int("size", opts.ActorCacheSize))
+
+	return session.NewCachingActorProvider(&session.MnemosyneActorProvider{
+		Client:             store,
+		UserProvider:       repos.user,
+		PermissionProvider: repos.permission,
+		APIKeyProvider:     repos.apiKey,
+	}, opts.ActorCacheTTL, opts.ActorCacheSize)
+}
+
+// initSessionStore returns connection to mnemosyne as well, if it is the chosen store.
+func initSessionStore(opts DaemonOpts, repos repositories, logger *zap.Logger, clientOpts []grpc.DialOption) (session.Store, *grpc.ClientConn, error) {
+	switch opts.SessionStore {
+	case "", session.StoreMnemosyne:
+		client, conn := initMnemosyne(opts.MnemosyneAddress, logger, clientOpts)
+		return client, conn, nil
+	case session.StorePostgres:
+		logger.Info("postgres session store has been initialized", zap.Duration("ttl", opts.SessionTTL))
+
+		return &session.PostgresStore{
+			Repository: repos.session,
+			TTL:        opts.SessionTTL,
+		}, nil, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown session store: %s", opts.SessionStore)
+	}
+}
+
+// initTokenStore makes given store issue self-contained tokens signed by keys from the ring.
+func initTokenStore(opts DaemonOpts, store session.Store, keys *keyRing, repos repositories, logger *zap.Logger) session.Store {
+	logger.Info("jwt access tokens are enabled", zap.Duration("ttl", opts.JWTTTL), zap.String("issuer", opts.JWTIssuer))
+
+	return &session.TokenStore{
+		Store:              store,
+		Keys:               keys,
+		PermissionProvider: repos.permission,
+		Issuer:             opts.JWTIssuer,
+		TTL:                opts.JWTTTL,
+		APIKeyProvider:     repos.apiKey,
+	}
+}
+
 func initMnemosyne(address string, logger *zap.Logger, opts []grpc.DialOption) (mnemosynerpc.SessionManagerClient, *grpc.ClientConn) {
 	if address == "" {
 		logger.Error("missing mnemosyne address")
@@ -77,13 +129,74 @@ func initMnemosyne(address string, logger *zap.Logger, opts []grpc.DialOption) (
 	return mnemosynerpc.NewSessionManagerClient(conn), conn
 }
 
-func initHasher(cost int, logger *zap.Logger) password.Hasher {
-	bh, err := password.NewBCryptHasher(cost)
+func initHasher(opts DaemonOpts, logger *zap.Logger) password.Hasher {
+	var (
+		h   password.Hasher
+		err error
+	)
+	switch opts.PasswordStrategy {
+	case password.StrategyBCrypt, "":
+		h, err = password.NewBCryptHasher(opts.PasswordBCryptCost)
+	case password.StrategyArgon2id:
+		h, err = password.NewArgon2idHasher(opts.PasswordArgon2id.Time, opts.PasswordArgon2id.Memory, opts.PasswordArgon2id.Threads)
+	case password.StrategySCrypt:
+		h, err = password.NewSCryptHasher(opts.PasswordSCrypt.Cost, opts.PasswordSCrypt.BlockSize, opts.PasswordSCrypt.Parallelization)
+	default:
+		err = fmt.Errorf("unknown password strategy: %s", opts.PasswordStrategy)
+	}
 	if err != nil {
 		logger.Fatal("hasher initialization failure", zap.Error(err))
 	}
 
-	return bh
+	logger.Info("password hasher has been initialized", zap.String("strategy", opts.PasswordStrategy))
+
+	return h
+}
+
+func initExternalAuthenticator(opts DaemonOpts, logger *zap.Logger) service.ExternalAuthenticator {
+	if opts.LDAPAddress == "" {
+		return nil
+	}
+
+	auth := &service.LDAPAuthenticator{
+		Address: opts.LDAPAddress,
+		BindDN:  opts.LDAPBindDN,
+	}
+	if opts.LDAPTLS {
+		host, _, err := net.SplitHostPort(opts.LDAPAddress)
+		if err != nil {
+			logger.Fatal("ldap authenticator initialization failure", zap.Error(err))
+		}
+		auth.TLS = &tls.Config{ServerName: host}
+	}
+
+	logger.Info("ldap authenticator has been initialized", zap.String("address", opts.LDAPAddress), zap.Bool("tls", opts.LDAPTLS))
+
+	return auth
+}
+
+func initFederation(opts DaemonOpts, repos repositories, logger *zap.Logger) *service.Federation {
+	if opts.FederationConfig == "" {
+		return nil
+	}
+
+	providers, err := service.LoadIdentityProviders(opts.FederationConfig)
+	if err != nil {
+		logger.Fatal("federation initialization failure", zap.Error(err))
+	}
+	federation, err := service.NewFederation(providers, &http.Client{Timeout: 10 * time.Second}, opts.FederationInterval)
+	if err != nil {
+		logger.Fatal("federation initialization failure", zap.Error(err))
+	}
+	federation.UserRepository = repos.user
+	federation.UserIdentityRepository = repos.userIdentity
+	federation.GroupRepository = repos.group
+	federation.UserGroupsRepository = repos.userGroups
+	federation.Transactor = repos.transactor
+
+	logger.Info("federation has been initialized", zap.Int("identity_providers", len(providers)))
+
+	return federation
 }
 
 func initPermissionRegistry(r model.PermissionProvider, permissions charon.Permissions, logger *zap.Logger) (pr model.PermissionRegistry) {
@@ -97,3 +210,53 @@ func initPermissionRegistry(r model.PermissionProvider, permissions charon.Permi
 
 	return
 }
+
+func initLoginThrottler(opts DaemonOpts, repos repositories) *service.LoginThrottler {
+	if opts.LoginDelay <= 0 && opts.LoginLockout <= 0 {
+		return nil
+	}
+
+	return &service.LoginThrottler{
+		Repository:       repos.loginFailure,
+		UserRepository:   repos.user,
+		FreeAttempts:     opts.LoginFreeAttempts,
+		Delay:            opts.LoginDelay,
+		MaxDelay:         opts.LoginMaxDelay,
+		Window:           opts.LoginWindow,
+		LockoutThreshold: opts.LoginLockout,
+	}
+}
+
+func initRegistrationLimiter(opts DaemonOpts, repos repositories) *service.RegistrationLimiter {
+	if opts.RegistrationLimit <= 0 {
+		return nil
+	}
+
+	return &service.RegistrationLimiter{
+		Repository: repos.loginFailure,
+		Limit:      opts.RegistrationLimit,
+		Window:     opts.RegistrationWindow,
+	}
+}
+
+func initPasswordResetLimiter(opts DaemonOpts, repos repositories) *service.RegistrationLimiter {
+	if opts.PasswordResetLimit <= 0 {
+		return nil
+	}
+
+	return &service.RegistrationLimiter{
+		Repository: repos.loginFailure,
+		Kind:       model.LoginFailureKindPasswordReset,
+		Limit:      opts.PasswordResetLimit,
+		Window:     opts.PasswordResetWindow,
+	}
+}
+
+func initNotifier(opts DaemonOpts, logger *zap.Logger) service.Notifier {
+	if opts.NotifierFile != "" {
+		logger.Info("file notifier has been initialized", zap.String("path", opts.NotifierFile))
+		return &service.FileNotifier{Path: opts.NotifierFile}
+	}
+
+	return &service.LogNotifier{Logger: logger.Named("notifier")}
+}
@@ -29,6 +29,8 @@ type endToEndSuite struct {
 		group        charonrpc.GroupManagerClient
 		permission   charonrpc.PermissionManagerClient
 		refreshToken charonrpc.RefreshTokenManagerClient
+		audit        charonrpc.AuditManagerClient
+		oauthClient  charonrpc.OAuthClientManagerClient
 	}
 	charonCloser io.Closer
 	charonConn   *grpc.ClientConn
@@ -94,12 +96,16 @@ func (etes *endToEndSuite) setup(t *testing.T) {
 		group        charonrpc.GroupManagerClient
 		permission   charonrpc.PermissionManagerClient
 		refreshToken charonrpc.RefreshTokenManagerClient
+		audit        charonrpc.AuditManagerClient
+		oauthClient  charonrpc.OAuthClientManagerClient
 	}{
 		auth:         charonrpc.NewAuthClient(etes.charonConn),
 		user:         charonrpc.NewUserManagerClient(etes.charonConn),
 		group:        charonrpc.NewGroupManagerClient(etes.charonConn),
 		permission:   charonrpc.NewPermissionManagerClient(etes.charonConn),
 		refreshToken: charonrpc.NewRefreshTokenManagerClient(etes.charonConn),
+		audit:        charonrpc.NewAuditManagerClient(etes.charonConn),
+		oauthClient:  charonrpc.NewOAuthClientManagerClient(etes.charonConn),
 	}
 	etes.mnemosyne = mnemosynerpc.NewSessionManagerClient(etes.mnemosyneConn)
 }
@@ -0,0 +1,141 @@
+package charond
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/ntypes"
+	"go.uber.org/zap"
+)
+
+// auditSweeperRPC is recorded in place of the RPC name, removal of expired links is not requested by anyone.
+const auditSweeperRPC = "charond/SweepExpiredGrants"
+
+// grantSweeper periodically removes time-bound permissions and group memberships that expired.
+// Every removal is recorded in the audit log, as if it was made by a set request.
+type grantSweeper struct {
+	logger     *zap.Logger
+	interval   time.Duration
+	repository repositories
+}
+
+func initGrantSweeper(opts DaemonOpts, repos repositories, logger *zap.Logger) *grantSweeper {
+	if opts.GrantSweepInterval <= 0 {
+		return nil
+	}
+
+	logger.Info("grant sweeper has been initialized", zap.Duration("interval", opts.GrantSweepInterval))
+
+	return &grantSweeper{
+		logger:     logger,
+		interval:   opts.GrantSweepInterval,
+		repository: repos,
+	}
+}
+
+// run sweeps expired links until context is done.
+func (gs *grantSweeper) run(ctx context.Context) {
+	ticker := time.NewTicker(gs.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			permissions, groups, err := gs.sweep(ctx)
+			if err != nil {
+				gs.logger.Error("expired grants removal failure", zap.Error(err))
+				continue
+			}
+			if permissions+groups > 0 {
+				gs.logger.Info("expired grants have been removed", zap.Int("permissions", permissions), zap.Int("groups", groups))
+			}
+		}
+	}
+}
+
+// sweep removes expired links within a single transaction and records a single audit event per affected user.
+func (gs *grantSweeper) sweep(ctx context.Context) (permissions, groups int, err error) {
+	err = gs.repository.transactor.Transaction(ctx, func(ctx context.Context) error {
+		expiredPermissions, err := gs.repository.userPermissions.DeleteExpired(ctx)
+		if err != nil {
+			return err
+		}
+		expiredGroups, err := gs.repository.userGroups.DeleteExpired(ctx)
+		if err != nil {
+			return err
+		}
+		permissions, groups = len(expiredPermissions), len(expiredGroups)
+
+		type expired struct {
+			granted, denied charon.Permissions
+			groups          []int64
+			validity        map[charon.Permission]model.Validity
+			groupsValidity  map[int64]model.Validity
+		}
+		users := make(map[int64]*expired)
+		get := func(userID int64) *expired {
+			if e, ok := users[userID]; ok {
+				return e
+			}
+			e := &expired{
+				validity:       make(map[charon.Permission]model.Validity),
+				groupsValidity: make(map[int64]model.Validity),
+			}
+			users[userID] = e
+			return e
+		}
+		for _, link := range expiredPermissions {
+			e := get(link.UserID)
+			p := charon.Permission(link.PermissionSubsystem + ":" + link.PermissionModule + ":" + link.PermissionAction)
+			if link.Denied {
+				e.denied = append(e.denied, p)
+			} else {
+				e.granted = append(e.granted, p)
+			}
+			e.validity[p] = model.Validity{From: link.ValidFrom, Until: link.ValidUntil}
+		}
+		for _, link := range expiredGroups {
+			e := get(link.UserID)
+			e.groups = append(e.groups, link.GroupID)
+			e.groupsValidity[link.GroupID] = model.Validity{From: link.ValidFrom, Until: link.ValidUntil}
+		}
+
+		ids := make([]int64, 0, len(users))
+		for id := range users {
+			ids = append(ids, id)
+		}
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+		for _, id := range ids {
+			e := users[id]
+			before := make(map[string]interface{})
+			if len(e.granted)+len(e.denied) > 0 {
+				before = auditPermissionsValidity(auditPermissionsWithDenials(e.granted, e.denied), e.validity)
+			}
+			if len(e.groups) > 0 {
+				g := auditGroupsValidity(auditGroups(e.groups), e.groupsValidity)
+				before["groups"] = g["groups"]
+				before["groups_validity"] = g["validity"]
+			}
+			doc, err := auditEncode(before)
+			if err != nil {
+				return err
+			}
+			if _, err = gs.repository.auditEvent.Insert(ctx, &model.AuditEventEntity{
+				RPC:        auditSweeperRPC,
+				TargetKind: model.AuditEventTargetUser,
+				TargetID:   ntypes.Int64{Int64: id, Valid: true},
+				Before:     doc,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return
+}
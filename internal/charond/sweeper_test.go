@@ -0,0 +1,74 @@
+package charond
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/model/modelmock"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestGrantSweeper_sweep(t *testing.T) {
+	userPermissionsMock := &modelmock.UserPermissionsProvider{}
+	userGroupsMock := &modelmock.UserGroupsProvider{}
+	auditEventMock := &modelmock.AuditEventProvider{}
+
+	gs := &grantSweeper{
+		logger:   zap.L(),
+		interval: time.Minute,
+		repository: repositories{
+			userPermissions: userPermissionsMock,
+			userGroups:      userGroupsMock,
+			auditEvent:      auditEventMock,
+			transactor:      newTransactorMock(),
+		},
+	}
+	until := pq.NullTime{Time: time.Now().Add(-time.Minute), Valid: true}
+
+	userPermissionsMock.On("DeleteExpired", mock.Anything).
+		Return([]*model.UserPermissionsEntity{
+			{UserID: 1, PermissionSubsystem: "a", PermissionModule: "b", PermissionAction: "c", ValidUntil: until},
+			{UserID: 1, PermissionSubsystem: "a", PermissionModule: "b", PermissionAction: "d", ValidUntil: until, Denied: true},
+		}, nil).
+		Once()
+	userGroupsMock.On("DeleteExpired", mock.Anything).
+		Return([]*model.UserGroupsEntity{
+			{UserID: 2, GroupID: 5, ValidUntil: until},
+		}, nil).
+		Once()
+	auditEventMock.On("Insert", mock.Anything, mock.MatchedBy(func(ent *model.AuditEventEntity) bool {
+		var doc map[string]interface{}
+		if err := json.Unmarshal(ent.Before, &doc); err != nil {
+			return false
+		}
+		_, hasPermissions := doc["permissions"]
+		return ent.RPC == auditSweeperRPC && ent.TargetID.Int64Or(0) == 1 && hasPermissions
+	})).
+		Return(&model.AuditEventEntity{}, nil).
+		Once()
+	auditEventMock.On("Insert", mock.Anything, mock.MatchedBy(func(ent *model.AuditEventEntity) bool {
+		var doc map[string]interface{}
+		if err := json.Unmarshal(ent.Before, &doc); err != nil {
+			return false
+		}
+		_, hasGroups := doc["groups"]
+		return ent.RPC == auditSweeperRPC && ent.TargetID.Int64Or(0) == 2 && hasGroups
+	})).
+		Return(&model.AuditEventEntity{}, nil).
+		Once()
+
+	permissions, groups, err := gs.sweep(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if permissions != 2 || groups != 1 {
+		t.Errorf("wrong number of removed links, expected 2/1 but got %d/%d", permissions, groups)
+	}
+
+	mock.AssertExpectationsForObjects(t, userPermissionsMock, userGroupsMock, auditEventMock)
+}
@@ -0,0 +1,188 @@
+package charond
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"go.uber.org/zap"
+)
+
+const (
+	// watchChannel is a Postgres notification channel that database triggers publish changes on.
+	watchChannel = "charon_watch"
+	// watchBuffer is a number of events a subscription can lag behind, before it is dropped.
+	watchBuffer = 64
+)
+
+// watchNotification is a payload sent by database triggers.
+type watchNotification struct {
+	Kind   string `json:"kind"`
+	UserID int64  `json:"user_id"`
+}
+
+// watchSubscription receives events of watched users, or of all users if none are given, until it is closed.
+// Closed subscription means that some events might have been lost.
+type watchSubscription struct {
+	userIDs map[int64]struct{}
+	events  chan *charonrpc.WatchEvent
+}
+
+// watchBroker fans out notifications received from Postgres to subscriptions.
+// Every instance listens on its own, so events are delivered regardless of which one made the change.
+type watchBroker struct {
+	logger        *zap.Logger
+	notifications <-chan *pq.Notification
+	closeListener func() error
+
+	lock          sync.Mutex
+	subscriptions map[*watchSubscription]struct{}
+}
+
+func initWatchBroker(opts DaemonOpts, logger *zap.Logger) (*watchBroker, error) {
+	listener := pq.NewListener(opts.PostgresAddress, time.Second, time.Minute, func(ev pq.ListenerEventType, err error) {
+		if err != nil {
+			logger.Error("postgres listener failure", zap.Error(err))
+		}
+	})
+	if err := listener.Listen(watchChannel); err != nil {
+		listener.Close()
+		return nil, err
+	}
+
+	logger.Info("watch broker has been initialized", zap.String("channel", watchChannel))
+
+	return newWatchBroker(listener.Notify, listener.Close, logger), nil
+}
+
+func newWatchBroker(notifications <-chan *pq.Notification, closeListener func() error, logger *zap.Logger) *watchBroker {
+	return &watchBroker{
+		logger:        logger,
+		notifications: notifications,
+		closeListener: closeListener,
+		subscriptions: make(map[*watchSubscription]struct{}),
+	}
+}
+
+// run dispatches notifications until context is done.
+func (wb *watchBroker) run(ctx context.Context) {
+	defer func() {
+		if err := wb.closeListener(); err != nil {
+			wb.logger.Error("postgres listener close failure", zap.Error(err))
+		}
+		wb.reset()
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case n, ok := <-wb.notifications:
+			if !ok {
+				return
+			}
+			// Listener sends nil after reconnecting, notifications sent in the meantime are lost.
+			if n == nil {
+				wb.logger.Warn("postgres listener has reconnected, dropping all subscriptions")
+				wb.reset()
+				continue
+			}
+			wb.dispatch(n.Extra)
+		}
+	}
+}
+
+// invalidate evicts cached actors of users affected by notifications until context is done.
+// Whole cache is purged whenever subscription is dropped, since notifications might have been lost.
+func (wb *watchBroker) invalidate(ctx context.Context, cache *session.CachingActorProvider) {
+	for {
+		sub := wb.subscribe()
+	Events:
+		for {
+			select {
+			case <-ctx.Done():
+				wb.unsubscribe(sub)
+				return
+			case ev, ok := <-sub.events:
+				if !ok {
+					break Events
+				}
+				cache.Invalidate(ev.UserId)
+			}
+		}
+		cache.Purge()
+	}
+}
+
+func (wb *watchBroker) dispatch(payload string) {
+	var n watchNotification
+	if err := json.Unmarshal([]byte(payload), &n); err != nil {
+		wb.logger.Error("malformed notification", zap.String("payload", payload), zap.Error(err))
+		return
+	}
+	kind, ok := charonrpc.WatchEvent_Kind_value[n.Kind]
+	if !ok {
+		wb.logger.Error("unknown notification kind", zap.String("kind", n.Kind))
+		return
+	}
+
+	wb.lock.Lock()
+	defer wb.lock.Unlock()
+
+	for s := range wb.subscriptions {
+		if _, ok := s.userIDs[n.UserID]; !ok && len(s.userIDs) > 0 {
+			continue
+		}
+		select {
+		case s.events <- &charonrpc.WatchEvent{UserId: n.UserID, Kind: charonrpc.WatchEvent_Kind(kind)}:
+		default:
+			// Slow subscriber cannot block others, it is dropped instead.
+			wb.logger.Warn("watch subscription is lagging behind, dropping it", zap.Int64("user_id", n.UserID))
+			wb.remove(s)
+		}
+	}
+}
+
+func (wb *watchBroker) subscribe(userIDs ...int64) *watchSubscription {
+	s := &watchSubscription{
+		userIDs: make(map[int64]struct{}, len(userIDs)),
+		events:  make(chan *charonrpc.WatchEvent, watchBuffer),
+	}
+	for _, id := range userIDs {
+		s.userIDs[id] = struct{}{}
+	}
+
+	wb.lock.Lock()
+	wb.subscriptions[s] = struct{}{}
+	wb.lock.Unlock()
+
+	return s
+}
+
+func (wb *watchBroker) unsubscribe(s *watchSubscription) {
+	wb.lock.Lock()
+	wb.remove(s)
+	wb.lock.Unlock()
+}
+
+// reset closes all subscriptions.
+func (wb *watchBroker) reset() {
+	wb.lock.Lock()
+	for s := range wb.subscriptions {
+		wb.remove(s)
+	}
+	wb.lock.Unlock()
+}
+
+// remove expects lock to be held.
+func (wb *watchBroker) remove(s *watchSubscription) {
+	if _, ok := wb.subscriptions[s]; !ok {
+		return
+	}
+	delete(wb.subscriptions, s)
+	close(s.events)
+}
@@ -0,0 +1,162 @@
+package charond
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	"github.com/piotrkowalczuk/charon/internal/session/sessionmock"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/piotrkowalczuk/mnemosyne"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestWatchBroker(t *testing.T) {
+	notifications := make(chan *pq.Notification)
+	closed := make(chan struct{})
+	broker := newWatchBroker(notifications, func() error {
+		close(closed)
+		return nil
+	}, zap.L())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go broker.run(ctx)
+
+	first := broker.subscribe(1, 2)
+	second := broker.subscribe(2)
+
+	notify := func(payload string) {
+		select {
+		case notifications <- &pq.Notification{Channel: watchChannel, Extra: payload}:
+		case <-time.After(time.Second):
+			t.Fatal("notification not consumed")
+		}
+	}
+	expect := func(s *watchSubscription, userID int64, kind charonrpc.WatchEvent_Kind) {
+		select {
+		case ev, ok := <-s.events:
+			if !ok {
+				t.Fatal("subscription closed unexpectedly")
+			}
+			if ev.UserId != userID || ev.Kind != kind {
+				t.Errorf("wrong event, expected %d/%s but got %d/%s", userID, kind, ev.UserId, ev.Kind)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("event not received")
+		}
+	}
+
+	notify(`{"kind": "PERMISSIONS", "user_id": 1}`)
+	notify(`malformed`)
+	notify(`{"kind": "UNKNOWN_KIND", "user_id": 2}`)
+	notify(`{"kind": "REFRESH_TOKEN_REVOKED", "user_id": 2}`)
+	notify(`{"kind": "FLAGS", "user_id": 3}`)
+
+	expect(first, 1, charonrpc.WatchEvent_PERMISSIONS)
+	expect(first, 2, charonrpc.WatchEvent_REFRESH_TOKEN_REVOKED)
+	expect(second, 2, charonrpc.WatchEvent_REFRESH_TOKEN_REVOKED)
+	if len(first.events)+len(second.events) > 0 {
+		t.Fatal("unexpected events")
+	}
+
+	broker.unsubscribe(second)
+	if _, ok := <-second.events; ok {
+		t.Error("unsubscribed subscription should be closed")
+	}
+
+	// nil notification means that listener has reconnected
+	select {
+	case notifications <- nil:
+	case <-time.After(time.Second):
+		t.Fatal("notification not consumed")
+	}
+	if _, ok := <-first.events; ok {
+		t.Error("subscription should be closed after reconnect")
+	}
+
+	cancel()
+	select {
+	case <-closed:
+	case <-time.After(time.Second):
+		t.Fatal("listener not closed")
+	}
+}
+
+func TestWatchBroker_lagging(t *testing.T) {
+	broker := newWatchBroker(nil, nil, zap.L())
+	sub := broker.subscribe(1)
+
+	for i := 0; i <= watchBuffer; i++ {
+		broker.dispatch(`{"kind": "GROUPS", "user_id": 1}`)
+	}
+
+	var n int
+	for range sub.events {
+		n++
+	}
+	if n != watchBuffer {
+		t.Errorf("wrong number of buffered events, expected %d but got %d", watchBuffer, n)
+	}
+}
+
+func TestWatchBroker_invalidate(t *testing.T) {
+	next := &sessionmock.ActorProvider{}
+	next.On("Actor", mock.Anything).
+		Return(&session.Actor{User: &model.UserEntity{ID: 1}}, nil)
+
+	cache := session.NewCachingActorProvider(next, time.Minute, 0)
+	broker := newWatchBroker(nil, nil, zap.L())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		broker.invalidate(ctx, cache)
+		close(done)
+	}()
+
+	fill := func() {
+		if _, err := cache.Actor(mnemosyne.NewAccessTokenContext(context.Background(), "token")); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		if cache.Len() != 1 {
+			t.Fatal("actor should be cached")
+		}
+	}
+	wait := func() {
+		for i := 0; cache.Len() > 0; i++ {
+			if i > 1000 {
+				t.Fatal("actor should be evicted")
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+	subscribed := func() {
+		for {
+			broker.lock.Lock()
+			n := len(broker.subscriptions)
+			broker.lock.Unlock()
+			if n > 0 {
+				return
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	subscribed()
+	fill()
+	broker.dispatch(`{"kind": "GROUPS", "user_id": 2}`)
+	broker.dispatch(`{"kind": "GROUPS", "user_id": 1}`)
+	wait()
+
+	// dropped subscription purges whole cache
+	fill()
+	broker.reset()
+	wait()
+
+	cancel()
+	<-done
+}
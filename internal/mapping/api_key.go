@@ -0,0 +1,79 @@
+package mapping
+
+import (
+	"github.com/golang/protobuf/ptypes"
+	pbts "github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/piotrkowalczuk/ntypes"
+)
+
+// ReverseAPIKey maps entity into its public representation, hash of the secret is left out.
+func ReverseAPIKey(ent *model.APIKeyEntity) (*charonrpc.APIKey, error) {
+	var (
+		err                                        error
+		expireAt, lastUsedAt, createdAt, updatedAt *pbts.Timestamp
+	)
+
+	if createdAt, err = ptypes.TimestampProto(ent.CreatedAt); err != nil {
+		return nil, err
+	}
+	if ent.UpdatedAt.Valid {
+		if updatedAt, err = ptypes.TimestampProto(ent.UpdatedAt.Time); err != nil {
+			return nil, err
+		}
+	}
+	if ent.ExpireAt.Valid {
+		if expireAt, err = ptypes.TimestampProto(ent.ExpireAt.Time); err != nil {
+			return nil, err
+		}
+	}
+	if ent.LastUsedAt.Valid {
+		if lastUsedAt, err = ptypes.TimestampProto(ent.LastUsedAt.Time); err != nil {
+			return nil, err
+		}
+	}
+
+	return &charonrpc.APIKey{
+		Id:          ent.ID,
+		Prefix:      ent.Prefix,
+		UserId:      ent.UserID,
+		Permissions: ent.AllowedPermissions().Strings(),
+		Notes:       &ent.Notes,
+		Revoked:     ent.Revoked,
+		ExpireAt:    expireAt,
+		LastUsedAt:  lastUsedAt,
+		CreatedAt:   createdAt,
+		CreatedBy:   &ent.CreatedBy,
+		UpdatedAt:   updatedAt,
+		UpdatedBy:   &ent.UpdatedBy,
+	}, nil
+}
+
+func ReverseAPIKeys(in []*model.APIKeyEntity) ([]*charonrpc.APIKey, error) {
+	res := make([]*charonrpc.APIKey, 0, len(in))
+	for _, ent := range in {
+		msg, err := ReverseAPIKey(ent)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, msg)
+	}
+
+	return res, nil
+}
+
+func APIKeyQuery(q *charonrpc.APIKeyQuery) *model.APIKeyCriteria {
+	var revoked ntypes.Bool
+	if q.GetRevoked() != nil {
+		revoked = *q.GetRevoked()
+	}
+
+	return &model.APIKeyCriteria{
+		UserID:     q.GetUserId(),
+		Revoked:    revoked,
+		ExpireAt:   q.GetExpireAt(),
+		LastUsedAt: q.GetLastUsedAt(),
+		CreatedAt:  q.GetCreatedAt(),
+	}
+}
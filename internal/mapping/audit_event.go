@@ -0,0 +1,50 @@
+package mapping
+
+import (
+	"github.com/golang/protobuf/ptypes"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+)
+
+func ReverseAuditEvent(ent *model.AuditEventEntity) (*charonrpc.AuditEvent, error) {
+	createdAt, err := ptypes.TimestampProto(ent.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &charonrpc.AuditEvent{
+		Id:         ent.ID,
+		ActorId:    &ent.ActorID,
+		Rpc:        ent.RPC,
+		TargetKind: ent.TargetKind,
+		TargetId:   &ent.TargetID,
+		Before:     string(ent.Before),
+		After:      string(ent.After),
+		Peer:       ent.Peer,
+		CreatedAt:  createdAt,
+	}, nil
+}
+
+func ReverseAuditEvents(in []*model.AuditEventEntity) ([]*charonrpc.AuditEvent, error) {
+	res := make([]*charonrpc.AuditEvent, 0, len(in))
+	for _, ent := range in {
+		msg, err := ReverseAuditEvent(ent)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, msg)
+	}
+
+	return res, nil
+}
+
+func AuditEventQuery(q *charonrpc.AuditEventQuery) *model.AuditEventCriteria {
+	return &model.AuditEventCriteria{
+		ActorID:    q.GetActorId(),
+		RPC:        q.GetRpc(),
+		TargetKind: q.GetTargetKind(),
+		TargetID:   q.GetTargetId(),
+		Peer:       q.GetPeer(),
+		CreatedAt:  q.GetCreatedAt(),
+	}
+}
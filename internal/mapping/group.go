@@ -1,6 +1,8 @@
 package mapping
 
 import (
+	"fmt"
+
 	"github.com/golang/protobuf/ptypes"
 	pbts "github.com/golang/protobuf/ptypes/timestamp"
 	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
@@ -31,6 +33,7 @@ func ReverseGroup(ent *model.GroupEntity) (*charonrpc.Group, error) {
 		CreatedBy:   &ent.CreatedBy,
 		UpdatedAt:   updatedAt,
 		UpdatedBy:   &ent.UpdatedBy,
+		ParentId:    &ent.ParentID,
 	}, nil
 }
 
@@ -47,3 +50,48 @@ func ReverseGroups(in []*model.GroupEntity) ([]*charonrpc.Group, error) {
 
 	return res, nil
 }
+
+// GroupPageColumns are columns groups can be ordered by, apart from the key.
+var GroupPageColumns = []string{model.TableGroupColumnName, model.TableGroupColumnCreatedAt}
+
+// GroupPage narrows given criteria down to groups placed after the previous page.
+func GroupPage(cri *model.GroupCriteria, p *Paging) (*model.GroupCriteria, error) {
+	keyset := p.Keyset()
+	if len(keyset) == 0 {
+		return cri, nil
+	}
+
+	alts := make([]*model.GroupCriteria, 0, len(keyset))
+	for _, bounds := range keyset {
+		alt := &model.GroupCriteria{}
+		for _, b := range bounds {
+			var err error
+			switch b.Column {
+			case model.TableGroupColumnID:
+				alt.ID, err = b.Int64()
+			case model.TableGroupColumnName:
+				alt.Name = b.Text()
+			case model.TableGroupColumnCreatedAt:
+				alt.CreatedAt, err = b.Timestamp()
+			default:
+				err = fmt.Errorf("groups cannot be paged by %q", b.Column)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+		alts = append(alts, alt)
+	}
+	if cri == nil {
+		return model.GroupOr(alts...), nil
+	}
+	return model.GroupAnd(cri, model.GroupOr(alts...)), nil
+}
+
+// GroupNextPageToken returns token of the page that follows given groups, it is empty if there is none.
+func GroupNextPageToken(p *Paging, ents []*model.GroupEntity) (string, error) {
+	if !p.Full(len(ents)) {
+		return "", nil
+	}
+	return p.Token(ents[len(ents)-1])
+}
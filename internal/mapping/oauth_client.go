@@ -0,0 +1,51 @@
+package mapping
+
+import (
+	"github.com/golang/protobuf/ptypes"
+	pbts "github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+)
+
+// ReverseOAuthClient maps entity into its public representation, secret is left out.
+func ReverseOAuthClient(ent *model.OauthClientEntity) (*charonrpc.OAuthClient, error) {
+	var (
+		err                  error
+		createdAt, updatedAt *pbts.Timestamp
+	)
+
+	if createdAt, err = ptypes.TimestampProto(ent.CreatedAt); err != nil {
+		return nil, err
+	}
+	if ent.UpdatedAt.Valid {
+		if updatedAt, err = ptypes.TimestampProto(ent.UpdatedAt.Time); err != nil {
+			return nil, err
+		}
+	}
+
+	return &charonrpc.OAuthClient{
+		Id:           ent.ID,
+		ClientId:     ent.ClientID,
+		Name:         ent.Name,
+		RedirectUris: ent.RedirectURIs(),
+		Confidential: !ent.IsPublic(),
+		CreatedAt:    createdAt,
+		CreatedBy:    &ent.CreatedBy,
+		UpdatedAt:    updatedAt,
+		UpdatedBy:    &ent.UpdatedBy,
+	}, nil
+}
+
+// ReverseOAuthClients ...
+func ReverseOAuthClients(in []*model.OauthClientEntity) ([]*charonrpc.OAuthClient, error) {
+	res := make([]*charonrpc.OAuthClient, 0, len(in))
+	for _, ent := range in {
+		msg, err := ReverseOAuthClient(ent)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, msg)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,196 @@
+package mapping
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/piotrkowalczuk/ntypes"
+	"github.com/piotrkowalczuk/qtypes"
+)
+
+// DefaultPageLimit is used if list request does not specify limit.
+const DefaultPageLimit = 10
+
+// PageRequest is implemented by every list request.
+type PageRequest interface {
+	GetOffset() *ntypes.Int64
+	GetLimit() *ntypes.Int64
+	GetOrderBy() []*charonrpc.Order
+	GetPageToken() string
+}
+
+// Paging describes a single page of a list.
+// Rows are always ordered by a unique key at the end, so that a page token points at exactly one row,
+// and the next page starts right after it, no matter how many rows were inserted in the meantime.
+type Paging struct {
+	Offset  int64
+	Limit   int64
+	OrderBy []model.RowOrder
+	// after holds values of ordering columns of the last row of the previous page.
+	after []string
+}
+
+type pageToken struct {
+	OrderBy []model.RowOrder `json:"o"`
+	After   []string         `json:"a"`
+}
+
+// Bound is a condition a single column has to meet, for a row to be placed after the previous page.
+type Bound struct {
+	Column string
+	Type   qtypes.QueryType
+	Value  string
+}
+
+// NewPaging maps paging part of a list request. Rows can be ordered by given columns only.
+// Key has to be unique, it is appended to the order if the request does not order by it already.
+func NewPaging(req PageRequest, key string, columns ...string) (*Paging, error) {
+	p := &Paging{
+		Offset:  req.GetOffset().Int64Or(0),
+		Limit:   req.GetLimit().Int64Or(DefaultPageLimit),
+		OrderBy: make([]model.RowOrder, 0, len(req.GetOrderBy())+1),
+	}
+	if p.Offset < 0 || p.Limit < 0 {
+		return nil, errors.New("offset and limit cannot be negative")
+	}
+
+	keyed := false
+	for _, o := range req.GetOrderBy() {
+		if o.GetName() != key && !contains(columns, o.GetName()) {
+			return nil, fmt.Errorf("list cannot be ordered by %q", o.GetName())
+		}
+		if o.GetName() == key {
+			keyed = true
+		}
+		p.OrderBy = append(p.OrderBy, model.RowOrder{Name: o.GetName(), Descending: o.GetDescending()})
+	}
+	if !keyed {
+		p.OrderBy = append(p.OrderBy, model.RowOrder{Name: key})
+	}
+
+	if req.GetPageToken() == "" {
+		return p, nil
+	}
+	if p.Offset > 0 {
+		return nil, errors.New("offset cannot be combined with page token")
+	}
+	buf, err := base64.RawURLEncoding.DecodeString(req.GetPageToken())
+	if err != nil {
+		return nil, errors.New("malformed page token")
+	}
+	var tok pageToken
+	if err = json.Unmarshal(buf, &tok); err != nil {
+		return nil, errors.New("malformed page token")
+	}
+	if len(tok.After) != len(p.OrderBy) || !reflect.DeepEqual(tok.OrderBy, p.OrderBy) {
+		return nil, errors.New("page token was issued for a different order")
+	}
+	p.after = tok.After
+
+	return p, nil
+}
+
+// Keyset returns alternatives of conditions, a row has to meet any of them to be placed after the previous page.
+// Within a single alternative, all columns but the last one are equal to the last row of the previous page.
+// It is empty for the first page.
+func (p *Paging) Keyset() [][]Bound {
+	if len(p.after) == 0 {
+		return nil
+	}
+
+	keyset := make([][]Bound, 0, len(p.OrderBy))
+	for i, o := range p.OrderBy {
+		alt := make([]Bound, 0, i+1)
+		for j := 0; j < i; j++ {
+			alt = append(alt, Bound{Column: p.OrderBy[j].Name, Type: qtypes.QueryType_EQUAL, Value: p.after[j]})
+		}
+		typ := qtypes.QueryType_GREATER
+		if o.Descending {
+			typ = qtypes.QueryType_LESS
+		}
+		keyset = append(keyset, append(alt, Bound{Column: o.Name, Type: typ, Value: p.after[i]}))
+	}
+	return keyset
+}
+
+// Full tells if page of given size is full, only then it can be followed by another one.
+func (p *Paging) Full(n int) bool {
+	return p.Limit > 0 && int64(n) >= p.Limit
+}
+
+// Token returns token of the page that starts right after given row.
+func (p *Paging) Token(last interface {
+	Prop(string) (interface{}, bool)
+}) (string, error) {
+	tok := pageToken{
+		OrderBy: p.OrderBy,
+		After:   make([]string, 0, len(p.OrderBy)),
+	}
+	for _, o := range p.OrderBy {
+		prop, ok := last.Prop(o.Name)
+		if !ok {
+			return "", fmt.Errorf("unexpected column %q", o.Name)
+		}
+		switch v := prop.(type) {
+		case *int64:
+			tok.After = append(tok.After, strconv.FormatInt(*v, 10))
+		case *string:
+			tok.After = append(tok.After, *v)
+		case *time.Time:
+			tok.After = append(tok.After, v.Format(time.RFC3339Nano))
+		default:
+			return "", fmt.Errorf("list cannot be paged by column %q of type %T", o.Name, prop)
+		}
+	}
+
+	buf, err := json.Marshal(tok)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// Int64 maps bound onto int64 column condition.
+func (b Bound) Int64() (*qtypes.Int64, error) {
+	v, err := strconv.ParseInt(b.Value, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("malformed page token value of %q", b.Column)
+	}
+	return &qtypes.Int64{Values: []int64{v}, Type: b.Type, Valid: true}, nil
+}
+
+// Text maps bound onto text column condition.
+func (b Bound) Text() *qtypes.String {
+	return &qtypes.String{Values: []string{b.Value}, Type: b.Type, Valid: true}
+}
+
+// Timestamp maps bound onto timestamp column condition.
+func (b Bound) Timestamp() (*qtypes.Timestamp, error) {
+	t, err := time.Parse(time.RFC3339Nano, b.Value)
+	if err != nil {
+		return nil, fmt.Errorf("malformed page token value of %q", b.Column)
+	}
+	ts, err := ptypes.TimestampProto(t)
+	if err != nil {
+		return nil, err
+	}
+	return &qtypes.Timestamp{Values: []*timestamp.Timestamp{ts}, Type: b.Type, Valid: true}, nil
+}
+
+func contains(columns []string, column string) bool {
+	for _, c := range columns {
+		if c == column {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,148 @@
+package mapping_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/piotrkowalczuk/charon/internal/mapping"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+	"github.com/piotrkowalczuk/ntypes"
+	"github.com/piotrkowalczuk/qtypes"
+)
+
+func TestNewPaging(t *testing.T) {
+	cases := map[string]struct {
+		req     charonrpc.ListUsersRequest
+		orderBy []model.RowOrder
+		ok      bool
+	}{
+		"default": {
+			orderBy: []model.RowOrder{{Name: model.TableUserColumnID}},
+			ok:      true,
+		},
+		"key-appended": {
+			req: charonrpc.ListUsersRequest{
+				OrderBy: []*charonrpc.Order{{Name: model.TableUserColumnLastName, Descending: true}},
+			},
+			orderBy: []model.RowOrder{
+				{Name: model.TableUserColumnLastName, Descending: true},
+				{Name: model.TableUserColumnID},
+			},
+			ok: true,
+		},
+		"key-given": {
+			req: charonrpc.ListUsersRequest{
+				OrderBy: []*charonrpc.Order{{Name: model.TableUserColumnID, Descending: true}},
+			},
+			orderBy: []model.RowOrder{{Name: model.TableUserColumnID, Descending: true}},
+			ok:      true,
+		},
+		"unknown-column": {
+			req: charonrpc.ListUsersRequest{
+				OrderBy: []*charonrpc.Order{{Name: model.TableUserColumnUpdatedAt}},
+			},
+		},
+		"negative-limit": {
+			req: charonrpc.ListUsersRequest{Limit: ntypes.NewInt64(-1)},
+		},
+		"offset-and-page-token": {
+			req: charonrpc.ListUsersRequest{Offset: ntypes.NewInt64(1), PageToken: "e30"},
+		},
+		"malformed-page-token": {
+			req: charonrpc.ListUsersRequest{PageToken: "!"},
+		},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			p, err := mapping.NewPaging(&c.req, model.TableUserColumnID, mapping.UserPageColumns...)
+			if !c.ok {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if p.Limit != mapping.DefaultPageLimit {
+				t.Errorf("wrong limit, expected %d but got %d", mapping.DefaultPageLimit, p.Limit)
+			}
+			if !reflect.DeepEqual(p.OrderBy, c.orderBy) {
+				t.Errorf("wrong order, expected %v but got %v", c.orderBy, p.OrderBy)
+			}
+			if p.Keyset() != nil {
+				t.Error("first page should not be narrowed down")
+			}
+		})
+	}
+}
+
+func TestPaging_Token(t *testing.T) {
+	req := &charonrpc.ListUsersRequest{
+		Limit: ntypes.NewInt64(2),
+		OrderBy: []*charonrpc.Order{
+			{Name: model.TableUserColumnCreatedAt, Descending: true},
+			{Name: model.TableUserColumnUsername},
+		},
+	}
+	first, err := mapping.NewPaging(req, model.TableUserColumnID, mapping.UserPageColumns...)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	now := time.Date(2026, 10, 18, 12, 0, 0, 123456000, time.UTC)
+	ents := []*model.UserEntity{
+		{ID: 2, Username: "b", CreatedAt: now},
+		{ID: 1, Username: "a", CreatedAt: now},
+	}
+	if tok, err := mapping.UserNextPageToken(first, ents[:1]); err != nil || tok != "" {
+		t.Fatalf("page that is not full should not be followed by another one, got %q, %v", tok, err)
+	}
+	tok, err := mapping.UserNextPageToken(first, ents)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if tok == "" {
+		t.Fatal("full page should be followed by another one")
+	}
+
+	req.PageToken = tok
+	next, err := mapping.NewPaging(req, model.TableUserColumnID, mapping.UserPageColumns...)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	keyset := next.Keyset()
+	if len(keyset) != 3 {
+		t.Fatalf("wrong number of alternatives, expected 3 but got %d", len(keyset))
+	}
+	exp := []mapping.Bound{
+		{Column: model.TableUserColumnCreatedAt, Type: qtypes.QueryType_EQUAL, Value: now.Format(time.RFC3339Nano)},
+		{Column: model.TableUserColumnUsername, Type: qtypes.QueryType_EQUAL, Value: "a"},
+		{Column: model.TableUserColumnID, Type: qtypes.QueryType_GREATER, Value: "1"},
+	}
+	if !reflect.DeepEqual(keyset[2], exp) {
+		t.Errorf("wrong alternative, expected %v but got %v", exp, keyset[2])
+	}
+	if keyset[0][0].Type != qtypes.QueryType_LESS {
+		t.Errorf("descending column should be bound from above, got %s", keyset[0][0].Type)
+	}
+
+	ts, err := keyset[0][0].Timestamp()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if got := time.Unix(ts.Values[0].Seconds, int64(ts.Values[0].Nanos)).UTC(); !got.Equal(now) {
+		t.Errorf("wrong timestamp, expected %s but got %s", now, got)
+	}
+	if _, err = mapping.UserPage(&model.UserCriteria{}, next); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	req.OrderBy = req.OrderBy[:1]
+	if _, err = mapping.NewPaging(req, model.TableUserColumnID, mapping.UserPageColumns...); err == nil {
+		t.Error("token issued for a different order should be rejected")
+	}
+}
@@ -0,0 +1,59 @@
+package mapping
+
+import (
+	"fmt"
+
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/qtypes"
+)
+
+// PermissionPageColumns are columns permissions can be ordered by, apart from the key.
+var PermissionPageColumns = []string{model.TablePermissionColumnSubsystem, model.TablePermissionColumnModule, model.TablePermissionColumnAction, model.TablePermissionColumnCreatedAt}
+
+// PermissionPage narrows given criteria down to permissions placed after the previous page.
+func PermissionPage(cri *model.PermissionCriteria, p *Paging) (*model.PermissionCriteria, error) {
+	keyset := p.Keyset()
+	if len(keyset) == 0 {
+		return cri, nil
+	}
+
+	alts := make([]*model.PermissionCriteria, 0, len(keyset))
+	for _, bounds := range keyset {
+		alt := &model.PermissionCriteria{}
+		for _, b := range bounds {
+			var err error
+			switch b.Column {
+			case model.TablePermissionColumnID:
+				alt.ID, err = b.Int64()
+			case model.TablePermissionColumnSubsystem:
+				alt.Subsystem = b.Text()
+			case model.TablePermissionColumnModule:
+				alt.Module = b.Text()
+			case model.TablePermissionColumnAction:
+				alt.Action = b.Text()
+			case model.TablePermissionColumnCreatedAt:
+				alt.CreatedAt, err = b.Timestamp()
+			default:
+				err = fmt.Errorf("permissions cannot be paged by %q", b.Column)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+		alts = append(alts, alt)
+	}
+	// Criteria that renders no condition at all cannot be combined with another one.
+	// Key is never null, so this condition changes nothing but makes sure there is at least one.
+	if cri.ID == nil {
+		cri.ID = &qtypes.Int64{Type: qtypes.QueryType_NULL, Negation: true, Valid: true}
+	}
+	return model.PermissionAnd(cri, model.PermissionOr(alts...)), nil
+}
+
+// PermissionNextPageToken returns token of the page that follows given permissions, it is empty if there is none.
+func PermissionNextPageToken(p *Paging, ents []*model.PermissionEntity) (string, error) {
+	if !p.Full(len(ents)) {
+		return "", nil
+	}
+	return p.Token(ents[len(ents)-1])
+}
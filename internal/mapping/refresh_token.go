@@ -1,17 +1,19 @@
 package mapping
 
 import (
+	"fmt"
 	"github.com/golang/protobuf/ptypes"
 	pbts "github.com/golang/protobuf/ptypes/timestamp"
-	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
 	"github.com/piotrkowalczuk/charon/internal/model"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
 	"github.com/piotrkowalczuk/ntypes"
+	"github.com/piotrkowalczuk/qtypes"
 )
 
 func ReverseRefreshToken(ent *model.RefreshTokenEntity) (*charonrpc.RefreshToken, error) {
 	var (
-		err                                        error
-		expireAt, lastUsedAt, createdAt, updatedAt *pbts.Timestamp
+		err                                                   error
+		expireAt, lastUsedAt, rotatedAt, createdAt, updatedAt *pbts.Timestamp
 	)
 
 	if createdAt, err = ptypes.TimestampProto(ent.CreatedAt); err != nil {
@@ -32,13 +34,20 @@ func ReverseRefreshToken(ent *model.RefreshTokenEntity) (*charonrpc.RefreshToken
 			return nil, err
 		}
 	}
+	if ent.RotatedAt.Valid {
+		if rotatedAt, err = ptypes.TimestampProto(ent.RotatedAt.Time); err != nil {
+			return nil, err
+		}
+	}
 
 	return &charonrpc.RefreshToken{
-		Token:      ent.Token,
+		Prefix:     ent.Prefix,
+		Family:     ent.Family,
 		Notes:      &ent.Notes,
 		Revoked:    ent.Revoked,
 		ExpireAt:   expireAt,
 		LastUsedAt: lastUsedAt,
+		RotatedAt:  rotatedAt,
 		UserId:     ent.UserID,
 		CreatedAt:  createdAt,
 		CreatedBy:  &ent.CreatedBy,
@@ -74,5 +83,55 @@ func RefreshTokenQuery(q *charonrpc.RefreshTokenQuery) *model.RefreshTokenCriter
 		LastUsedAt: q.GetLastUsedAt(),
 		CreatedAt:  q.GetCreatedAt(),
 		UpdatedAt:  q.GetUpdatedAt(),
+		Family:     q.GetFamily(),
+	}
+}
+
+// RefreshTokenPageColumns are columns refreshTokens can be ordered by, apart from the key.
+var RefreshTokenPageColumns = []string{model.TableRefreshTokenColumnUserID, model.TableRefreshTokenColumnFamily, model.TableRefreshTokenColumnCreatedAt}
+
+// RefreshTokenPage narrows given criteria down to refreshTokens placed after the previous page.
+func RefreshTokenPage(cri *model.RefreshTokenCriteria, p *Paging) (*model.RefreshTokenCriteria, error) {
+	keyset := p.Keyset()
+	if len(keyset) == 0 {
+		return cri, nil
+	}
+
+	alts := make([]*model.RefreshTokenCriteria, 0, len(keyset))
+	for _, bounds := range keyset {
+		alt := &model.RefreshTokenCriteria{}
+		for _, b := range bounds {
+			var err error
+			switch b.Column {
+			case model.TableRefreshTokenColumnPrefix:
+				alt.Prefix = b.Text()
+			case model.TableRefreshTokenColumnUserID:
+				alt.UserID, err = b.Int64()
+			case model.TableRefreshTokenColumnFamily:
+				alt.Family = b.Text()
+			case model.TableRefreshTokenColumnCreatedAt:
+				alt.CreatedAt, err = b.Timestamp()
+			default:
+				err = fmt.Errorf("refreshTokens cannot be paged by %q", b.Column)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+		alts = append(alts, alt)
+	}
+	// Criteria that renders no condition at all cannot be combined with another one.
+	// Key is never null, so this condition changes nothing but makes sure there is at least one.
+	if cri.Prefix == nil {
+		cri.Prefix = &qtypes.String{Type: qtypes.QueryType_NULL, Negation: true, Valid: true}
+	}
+	return model.RefreshTokenAnd(cri, model.RefreshTokenOr(alts...)), nil
+}
+
+// RefreshTokenNextPageToken returns token of the page that follows given refreshTokens, it is empty if there is none.
+func RefreshTokenNextPageToken(p *Paging, ents []*model.RefreshTokenEntity) (string, error) {
+	if !p.Full(len(ents)) {
+		return "", nil
 	}
+	return p.Token(ents[len(ents)-1])
 }
@@ -1,6 +1,8 @@
 package mapping
 
 import (
+	"fmt"
+
 	"github.com/golang/protobuf/ptypes"
 	pbts "github.com/golang/protobuf/ptypes/timestamp"
 	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
@@ -9,8 +11,8 @@ import (
 
 func ReverseUser(ent *model.UserEntity) (*charonrpc.User, error) {
 	var (
-		err                  error
-		createdAt, updatedAt *pbts.Timestamp
+		err                             error
+		createdAt, updatedAt, deletedAt *pbts.Timestamp
 	)
 
 	if !ent.CreatedAt.IsZero() {
@@ -23,20 +25,29 @@ func ReverseUser(ent *model.UserEntity) (*charonrpc.User, error) {
 			return nil, err
 		}
 	}
+	if ent.DeletedAt.Valid {
+		if deletedAt, err = ptypes.TimestampProto(ent.DeletedAt.Time); err != nil {
+			return nil, err
+		}
+	}
 
 	return &charonrpc.User{
-		Id:          ent.ID,
-		Username:    ent.Username,
-		FirstName:   ent.FirstName,
-		LastName:    ent.LastName,
-		IsSuperuser: ent.IsSuperuser,
-		IsActive:    ent.IsActive,
-		IsStaff:     ent.IsStaff,
-		IsConfirmed: ent.IsConfirmed,
-		CreatedAt:   createdAt,
-		CreatedBy:   &ent.CreatedBy,
-		UpdatedAt:   updatedAt,
-		UpdatedBy:   &ent.UpdatedBy,
+		Id:                 ent.ID,
+		Username:           ent.Username,
+		FirstName:          ent.FirstName,
+		LastName:           ent.LastName,
+		IsSuperuser:        ent.IsSuperuser,
+		IsActive:           ent.IsActive,
+		IsStaff:            ent.IsStaff,
+		IsConfirmed:        ent.IsConfirmed,
+		IsTwoFactorEnabled: ent.TwoFactorConfirmedAt.Valid,
+		IsLocked:           ent.LockedAt.Valid,
+		IsServiceAccount:   ent.IsServiceAccount,
+		CreatedAt:          createdAt,
+		CreatedBy:          &ent.CreatedBy,
+		UpdatedAt:          updatedAt,
+		UpdatedBy:          &ent.UpdatedBy,
+		DeletedAt:          deletedAt,
 	}, nil
 }
 
@@ -52,3 +63,49 @@ func ReverseUsers(in []*model.UserEntity) ([]*charonrpc.User, error) {
 
 	return res, nil
 }
+
+// UserPageColumns are columns users can be ordered by, apart from the key.
+var UserPageColumns = []string{model.TableUserColumnUsername, model.TableUserColumnFirstName, model.TableUserColumnLastName, model.TableUserColumnCreatedAt}
+
+// UserPage narrows given criteria down to users placed after the previous page.
+func UserPage(cri *model.UserCriteria, p *Paging) (*model.UserCriteria, error) {
+	keyset := p.Keyset()
+	if len(keyset) == 0 {
+		return cri, nil
+	}
+
+	alts := make([]*model.UserCriteria, 0, len(keyset))
+	for _, bounds := range keyset {
+		alt := &model.UserCriteria{}
+		for _, b := range bounds {
+			var err error
+			switch b.Column {
+			case model.TableUserColumnID:
+				alt.ID, err = b.Int64()
+			case model.TableUserColumnUsername:
+				alt.Username = b.Text()
+			case model.TableUserColumnFirstName:
+				alt.FirstName = b.Text()
+			case model.TableUserColumnLastName:
+				alt.LastName = b.Text()
+			case model.TableUserColumnCreatedAt:
+				alt.CreatedAt, err = b.Timestamp()
+			default:
+				err = fmt.Errorf("users cannot be paged by %q", b.Column)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+		alts = append(alts, alt)
+	}
+	return model.UserAnd(cri, model.UserOr(alts...)), nil
+}
+
+// UserNextPageToken returns token of the page that follows given users, it is empty if there is none.
+func UserNextPageToken(p *Paging, ents []*model.UserEntity) (string, error) {
+	if !p.Full(len(ents)) {
+		return "", nil
+	}
+	return p.Token(ents[len(ents)-1])
+}
@@ -0,0 +1,62 @@
+package mapping
+
+import (
+	"github.com/golang/protobuf/ptypes"
+	pbts "github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/lib/pq"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	charonrpc "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+)
+
+// Validity maps protobuf message into time window used by the model.
+// Nil message results in a zero value, which stands for permanent link.
+func Validity(msg *charonrpc.Validity) (model.Validity, error) {
+	var v model.Validity
+	if msg == nil {
+		return v, nil
+	}
+	if msg.ValidFrom != nil {
+		from, err := ptypes.Timestamp(msg.ValidFrom)
+		if err != nil {
+			return v, err
+		}
+		v.From = pq.NullTime{Time: from, Valid: true}
+	}
+	if msg.ValidUntil != nil {
+		until, err := ptypes.Timestamp(msg.ValidUntil)
+		if err != nil {
+			return v, err
+		}
+		v.Until = pq.NullTime{Time: until, Valid: true}
+	}
+
+	return v, nil
+}
+
+// ReverseValidity maps bounds of a time window into protobuf message used by a client.
+// It returns nil if none of the bounds is set.
+func ReverseValidity(from, until pq.NullTime) (*charonrpc.Validity, error) {
+	if !from.Valid && !until.Valid {
+		return nil, nil
+	}
+
+	var (
+		err                   error
+		validFrom, validUntil *pbts.Timestamp
+	)
+	if from.Valid {
+		if validFrom, err = ptypes.TimestampProto(from.Time); err != nil {
+			return nil, err
+		}
+	}
+	if until.Valid {
+		if validUntil, err = ptypes.TimestampProto(until.Time); err != nil {
+			return nil, err
+		}
+	}
+
+	return &charonrpc.Validity{
+		ValidFrom:  validFrom,
+		ValidUntil: validUntil,
+	}, nil
+}
@@ -0,0 +1,115 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/piotrkowalczuk/charon"
+)
+
+// APIKeyProvider ...
+type APIKeyProvider interface {
+	// Insert ...
+	Insert(context.Context, *APIKeyEntity) (*APIKeyEntity, error)
+	// Find ...
+	Find(context.Context, *APIKeyFindExpr) ([]*APIKeyEntity, error)
+	// FindOneByID ...
+	FindOneByID(context.Context, int64) (*APIKeyEntity, error)
+	// FindOneByPrefix ...
+	FindOneByPrefix(context.Context, string) (*APIKeyEntity, error)
+	// UpdateOneByID ...
+	UpdateOneByID(context.Context, int64, *APIKeyPatch) (*APIKeyEntity, error)
+	// UpdateLastUsedAt records that the key has just been used.
+	UpdateLastUsedAt(context.Context, int64) (int64, error)
+	// DeleteOneByID ...
+	DeleteOneByID(context.Context, int64) (int64, error)
+	// DeleteByUserID ...
+	DeleteByUserID(context.Context, int64) (int64, error)
+}
+
+// APIKeyRepository extends APIKeyRepositoryBase.
+type APIKeyRepository struct {
+	APIKeyRepositoryBase
+}
+
+// NewAPIKeyRepository ...
+func NewAPIKeyRepository(dbPool *sql.DB) APIKeyProvider {
+	return &APIKeyRepository{
+		APIKeyRepositoryBase: APIKeyRepositoryBase{
+			DB:      dbPool,
+			Table:   TableAPIKey,
+			Columns: TableAPIKeyColumns,
+		},
+	}
+}
+
+// Insert implements APIKeyProvider interface, it takes part in a transaction carried by the context.
+func (akr *APIKeyRepository) Insert(ctx context.Context, ent *APIKeyEntity) (*APIKeyEntity, error) {
+	return akr.insert(ctx, txFromContext(ctx), ent)
+}
+
+// Find implements APIKeyProvider interface, it takes part in a transaction carried by the context.
+func (akr *APIKeyRepository) Find(ctx context.Context, fe *APIKeyFindExpr) ([]*APIKeyEntity, error) {
+	return akr.find(ctx, txFromContext(ctx), fe)
+}
+
+// FindOneByID implements APIKeyProvider interface, it takes part in a transaction carried by the context.
+func (akr *APIKeyRepository) FindOneByID(ctx context.Context, id int64) (*APIKeyEntity, error) {
+	return akr.findOneByID(ctx, txFromContext(ctx), id)
+}
+
+// FindOneByPrefix implements APIKeyProvider interface, it takes part in a transaction carried by the context.
+func (akr *APIKeyRepository) FindOneByPrefix(ctx context.Context, prefix string) (*APIKeyEntity, error) {
+	return akr.findOneByPrefix(ctx, txFromContext(ctx), prefix)
+}
+
+// UpdateOneByID implements APIKeyProvider interface, it takes part in a transaction carried by the context.
+func (akr *APIKeyRepository) UpdateOneByID(ctx context.Context, id int64, patch *APIKeyPatch) (*APIKeyEntity, error) {
+	return akr.updateOneByID(ctx, txFromContext(ctx), id, patch)
+}
+
+// UpdateLastUsedAt implements APIKeyProvider interface.
+func (akr *APIKeyRepository) UpdateLastUsedAt(ctx context.Context, id int64) (int64, error) {
+	res, err := conn(ctx, akr.DB).ExecContext(ctx, `
+		UPDATE `+akr.Table+`
+		SET `+TableAPIKeyColumnLastUsedAt+` = NOW()
+		WHERE `+TableAPIKeyColumnID+` = $1
+	`, id)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// DeleteOneByID implements APIKeyProvider interface, it takes part in a transaction carried by the context.
+func (akr *APIKeyRepository) DeleteOneByID(ctx context.Context, id int64) (int64, error) {
+	return akr.deleteOneByID(ctx, txFromContext(ctx), id)
+}
+
+// DeleteByUserID implements APIKeyProvider interface.
+func (akr *APIKeyRepository) DeleteByUserID(ctx context.Context, userID int64) (int64, error) {
+	return deleteBy(ctx, akr.DB, akr.Table, TableAPIKeyColumnUserID, userID)
+}
+
+// APIKeyPermissions encodes permissions the way they are persisted.
+func APIKeyPermissions(permissions charon.Permissions) string {
+	return strings.Join(permissions.Strings(), "\n")
+}
+
+// AllowedPermissions decodes permissions the key is restricted to.
+func (e *APIKeyEntity) AllowedPermissions() charon.Permissions {
+	if e.Permissions == "" {
+		return nil
+	}
+	return charon.NewPermissions(strings.Split(e.Permissions, "\n")...)
+}
+
+// IsUsable returns true if the key is neither revoked nor expired.
+func (e *APIKeyEntity) IsUsable(now time.Time) bool {
+	if e.Revoked {
+		return false
+	}
+	return !e.ExpireAt.Valid || e.ExpireAt.Time.After(now)
+}
@@ -0,0 +1,87 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/ntypes"
+)
+
+func TestAPIKeyRepository(t *testing.T) {
+	suite := &postgresSuite{}
+	suite.setup(t)
+	defer suite.teardown(t)
+
+	ctx := context.TODO()
+	usr, err := suite.repository.user.Create(ctx, &UserEntity{
+		Username:         "service@example.com",
+		Password:         NoPassword,
+		FirstName:        "first_name",
+		LastName:         "last_name",
+		IsServiceAccount: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if !usr.IsServiceAccount {
+		t.Error("user should be a service account")
+	}
+
+	permissions := charon.Permissions{charon.UserCanRetrieveAsStranger, charon.GroupCanRetrieve}
+	ent, err := suite.repository.apiKey.Insert(ctx, &APIKeyEntity{
+		Prefix:      "abc",
+		Secret:      []byte("secret"),
+		UserID:      usr.ID,
+		Permissions: APIKeyPermissions(permissions),
+		ExpireAt:    pq.NullTime{Time: time.Now().Add(time.Hour), Valid: true},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if _, err = suite.repository.apiKey.Insert(ctx, &APIKeyEntity{
+		Prefix: "abc",
+		Secret: []byte("other"),
+		UserID: usr.ID,
+	}); ErrorConstraint(err) != TableAPIKeyConstraintPrefixUnique {
+		t.Errorf("unique constraint violation expected, got: %v", err)
+	}
+
+	got, err := suite.repository.apiKey.FindOneByPrefix(ctx, "abc")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if got.ID != ent.ID || !got.AllowedPermissions().Contains(permissions...) {
+		t.Errorf("wrong api key: %#v", got)
+	}
+	if !got.IsUsable(time.Now()) {
+		t.Error("api key should be usable")
+	}
+	if got.LastUsedAt.Valid {
+		t.Error("api key should not be used yet")
+	}
+
+	if _, err = suite.repository.apiKey.UpdateLastUsedAt(ctx, ent.ID); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	got, err = suite.repository.apiKey.UpdateOneByID(ctx, ent.ID, &APIKeyPatch{Revoked: ntypes.Bool{Bool: true, Valid: true}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if !got.LastUsedAt.Valid {
+		t.Error("last used at should be set")
+	}
+	if got.IsUsable(time.Now()) {
+		t.Error("revoked api key should not be usable")
+	}
+
+	if affected, err := suite.repository.apiKey.DeleteByUserID(ctx, usr.ID); err != nil || affected != 1 {
+		t.Errorf("expected single api key to be removed, got %d (%v)", affected, err)
+	}
+	if _, err = suite.repository.apiKey.FindOneByPrefix(ctx, "abc"); err != sql.ErrNoRows {
+		t.Errorf("api key should be removed, got: %v", err)
+	}
+}
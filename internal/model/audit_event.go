@@ -0,0 +1,54 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+)
+
+const (
+	// AuditEventTargetUser identifies events that describe changes of users.
+	AuditEventTargetUser = "user"
+	// AuditEventTargetGroup identifies events that describe changes of groups.
+	AuditEventTargetGroup = "group"
+	// AuditEventTargetPermission identifies events that describe changes of permissions.
+	AuditEventTargetPermission = "permission"
+	// AuditEventTargetRefreshToken identifies events that describe changes of refresh tokens.
+	// Refresh tokens have no identifier of their own, events point to their owners instead.
+	AuditEventTargetRefreshToken = "refresh_token"
+	// AuditEventTargetOAuthClient identifies events that describe changes of OpenID Connect clients.
+	AuditEventTargetOAuthClient = "oauth_client"
+	// AuditEventTargetAPIKey identifies events that describe changes of API keys.
+	AuditEventTargetAPIKey = "api_key"
+	// AuditEventTargetSession identifies events that describe termination of sessions.
+	// Sessions are not identified by charon, events point to their owners instead.
+	AuditEventTargetSession = "session"
+)
+
+// AuditEventProvider ...
+type AuditEventProvider interface {
+	// Find ...
+	Find(context.Context, *AuditEventFindExpr) ([]*AuditEventEntity, error)
+	// Insert persists given event, it takes part in a transaction carried by the context.
+	Insert(context.Context, *AuditEventEntity) (*AuditEventEntity, error)
+}
+
+// AuditEventRepository extends AuditEventRepositoryBase.
+type AuditEventRepository struct {
+	AuditEventRepositoryBase
+}
+
+// NewAuditEventRepository ...
+func NewAuditEventRepository(dbPool *sql.DB) AuditEventProvider {
+	return &AuditEventRepository{
+		AuditEventRepositoryBase: AuditEventRepositoryBase{
+			DB:      dbPool,
+			Table:   TableAuditEvent,
+			Columns: TableAuditEventColumns,
+		},
+	}
+}
+
+// Insert implements AuditEventProvider interface.
+func (aer *AuditEventRepository) Insert(ctx context.Context, ent *AuditEventEntity) (*AuditEventEntity, error) {
+	return aer.insert(ctx, txFromContext(ctx), ent)
+}
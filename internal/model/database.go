@@ -6,6 +6,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/lib/pq"
 	"github.com/piotrkowalczuk/charon"
@@ -15,9 +16,18 @@ type repositories struct {
 	user             UserProvider
 	userGroups       UserGroupsProvider
 	userPermissions  UserPermissionsProvider
+	userResources    UserResourcePermissionsProvider
 	permission       PermissionProvider
 	group            GroupProvider
 	groupPermissions GroupPermissionsProvider
+	auditEvent       AuditEventProvider
+	session          SessionProvider
+	oauthClient      OauthClientProvider
+	oauthConsent     OauthConsentProvider
+	oauthCode        OauthAuthorizationCodeProvider
+	userIdentity     UserIdentityProvider
+	apiKey           APIKeyProvider
+	refreshToken     RefreshTokenProvider
 }
 
 func newRepositories(db *sql.DB) repositories {
@@ -25,9 +35,18 @@ func newRepositories(db *sql.DB) repositories {
 		user:             NewUserRepository(db),
 		userGroups:       NewUserGroupsRepository(db),
 		userPermissions:  NewUserPermissionsRepository(db),
+		userResources:    NewUserResourcePermissionsRepository(db),
 		permission:       NewPermissionRepository(db),
 		group:            NewGroupRepository(db),
 		groupPermissions: NewGroupPermissionsRepository(db),
+		auditEvent:       NewAuditEventRepository(db),
+		session:          NewSessionRepository(db),
+		oauthClient:      NewOauthClientRepository(db),
+		oauthConsent:     NewOauthConsentRepository(db),
+		oauthCode:        NewOauthAuthorizationCodeRepository(db),
+		userIdentity:     NewUserIdentityRepository(db),
+		apiKey:           NewAPIKeyRepository(db),
+		refreshToken:     NewRefreshTokenRepository(db),
 	}
 }
 
@@ -85,65 +104,102 @@ func existsManyToManyQuery(table, column1, column2 string) string {
 	`
 }
 
-func isGrantedQuery(table, columnID, columnSubsystem, columnModule, columnAction string) string {
+// memberOfQuery is a recursive common table expression named member_of,
+// that resolves groups user given as $1 belongs to, either directly or through any of their subgroups.
+// Memberships that are not in effect at the moment are ignored.
+// UNION discards duplicates, so that the recursion ends even if groups happen to form a cycle.
+var memberOfQuery = `
+	WITH RECURSIVE member_of (group_id) AS (
+		SELECT ug.` + TableUserGroupsColumnGroupID + `
+		FROM ` + TableUserGroups + ` AS ug
+		WHERE ug.` + TableUserGroupsColumnUserID + ` = $1
+			AND ` + activeLinkClause("ug", TableUserGroupsColumnValidFrom, TableUserGroupsColumnValidUntil) + `
+		UNION
+		SELECT g.` + TableGroupColumnParentID + `
+		FROM ` + TableGroup + ` AS g
+		INNER JOIN member_of AS mo ON g.` + TableGroupColumnID + ` = mo.group_id
+		WHERE g.` + TableGroupColumnParentID + ` IS NOT NULL
+	)
+`
+
+// isGrantedQuery checks if permission passed as $2, $3 and $4 is granted to the entity identified by $1.
+// Stored wildcards match any value of the corresponding part and a single matching denial overrides all grants.
+func isGrantedQuery(table, columnID, columnSubsystem, columnModule, columnAction, columnDenied string) string {
 	return `
-		SELECT EXISTS(
-			SELECT 1 FROM  ` + table + ` AS t
-			WHERE t.` + columnID + ` = $1
-				AND t.` + columnSubsystem + `= $2
-				AND t.` + columnModule + `= $3
-				AND t.` + columnAction + `= $4
-		)
+		SELECT COALESCE(BOOL_AND(NOT t.` + columnDenied + `), FALSE)
+		FROM  ` + table + ` AS t
+		WHERE t.` + columnID + ` = $1
+			AND ` + matchPermissionClause("t", columnSubsystem, columnModule, columnAction) + `
 	`
 }
 
-func setManyToMany(db *sql.DB, ctx context.Context, table, column1, column2 string, id int64, ids []int64) (int64, int64, error) {
+// matchPermissionClause expects subsystem, module and action to be passed as $2, $3 and $4 respectively.
+func matchPermissionClause(alias, columnSubsystem, columnModule, columnAction string) string {
+	return alias + `.` + columnSubsystem + ` IN ($2, '` + charon.PermissionWildcard + `')
+				AND ` + alias + `.` + columnModule + ` IN ($3, '` + charon.PermissionWildcard + `')
+				AND ` + alias + `.` + columnAction + ` IN ($4, '` + charon.PermissionWildcard + `')`
+}
+
+// setManyToMany replaces links of entity given by id, links of ids present in validity are time-bound.
+func setManyToMany(db *sql.DB, ctx context.Context, table, column1, column2, columnValidFrom, columnValidUntil string, id int64, ids []int64, validity map[int64]Validity) (int64, int64, error) {
 	var (
 		err                    error
 		aff, inserted, deleted int64
 		tx                     *sql.Tx
-		insert, exists         *sql.Stmt
+		end                    func(error) error
+		insert, update, exists *sql.Stmt
 		res                    sql.Result
 		in                     []int64
-		granted                bool
+		from, until            pq.NullTime
 	)
 
-	tx, err = db.BeginTx(ctx, nil)
+	tx, end, err = beginTx(ctx, db)
 	if err != nil {
 		return 0, 0, err
 	}
 	defer func() {
-		if err != nil {
-			tx.Rollback()
-		} else {
-			tx.Commit()
-		}
+		end(err)
 	}()
 
 	if len(ids) > 0 {
-		insert, err = tx.PrepareContext(ctx, `INSERT INTO `+table+` (`+column1+`, `+column2+`) VALUES ($1, $2)`)
+		where := ` WHERE ` + column1 + ` = $1 AND ` + column2 + ` = $2`
+
+		insert, err = tx.PrepareContext(ctx, `INSERT INTO `+table+` (`+column1+`, `+column2+`, `+columnValidFrom+`, `+columnValidUntil+`) VALUES ($1, $2, $3, $4)`)
 		if err != nil {
 			return 0, 0, err
 		}
-		exists, err = tx.PrepareContext(ctx, existsManyToManyQuery(table, column1, column2))
+		update, err = tx.PrepareContext(ctx, `UPDATE `+table+` SET `+columnValidFrom+` = $3, `+columnValidUntil+` = $4, updated_at = NOW()`+where)
+		if err != nil {
+			return 0, 0, err
+		}
+		exists, err = tx.PrepareContext(ctx, `SELECT `+columnValidFrom+`, `+columnValidUntil+` FROM `+table+where)
 		if err != nil {
 			return 0, 0, err
 		}
 
 		in = make([]int64, 0, len(ids))
-	InsertLoop:
 		for _, idd := range ids {
-			if err = exists.QueryRowContext(ctx, id, idd).Scan(&granted); err != nil {
-				return 0, 0, err
-			}
-			// Given combination already exists, ignore.
-			if granted {
+			v := validity[idd]
+
+			switch err = exists.QueryRowContext(ctx, id, idd).Scan(&from, &until); err {
+			case nil:
 				in = append(in, idd)
-				granted = false
-				continue InsertLoop
-			}
-			res, err = insert.ExecContext(ctx, id, idd)
-			if err != nil {
+				// Given combination already exists, ignore.
+				if v.equal(from, until) {
+					continue
+				}
+				// Changing the window counts as creation, previous link is gone.
+				res, err = update.ExecContext(ctx, id, idd, v.From, v.Until)
+				if err != nil {
+					return 0, 0, err
+				}
+			case sql.ErrNoRows:
+				res, err = insert.ExecContext(ctx, id, idd, v.From, v.Until)
+				if err != nil {
+					return 0, 0, err
+				}
+				in = append(in, idd)
+			default:
 				return 0, 0, err
 			}
 
@@ -152,8 +208,6 @@ func setManyToMany(db *sql.DB, ctx context.Context, table, column1, column2 stri
 				return 0, 0, err
 			}
 			inserted += aff
-
-			in = append(in, idd)
 		}
 	}
 
@@ -200,73 +254,163 @@ func setManyToMany(db *sql.DB, ctx context.Context, table, column1, column2 stri
 	return inserted, deleted, nil
 }
 
-func setPermissions(db *sql.DB, ctx context.Context, table, columnID, columnSubsystem, columnModule, columnAction string, id int64, permissions charon.Permissions) (int64, int64, error) {
-	if len(permissions) == 0 {
+// Validity is a time window within which a link is in effect.
+// A null bound leaves the window open on that side, zero value makes the link permanent.
+type Validity struct {
+	From, Until pq.NullTime
+}
+
+// IsTimeBound returns true if at least one of the bounds is set.
+func (v Validity) IsTimeBound() bool {
+	return v.From.Valid || v.Until.Valid
+}
+
+// InEffect returns true if given moment falls within the window.
+func (v Validity) InEffect(t time.Time) bool {
+	if v.From.Valid && v.From.Time.After(t) {
+		return false
+	}
+	if v.Until.Valid && !v.Until.Time.After(t) {
+		return false
+	}
+	return true
+}
+
+// equal compares bounds with microsecond precision, the one that postgres stores timestamps with.
+func (v Validity) equal(from, until pq.NullTime) bool {
+	same := func(a, b pq.NullTime) bool {
+		if a.Valid != b.Valid {
+			return false
+		}
+		return !a.Valid || a.Time.Truncate(time.Microsecond).Equal(b.Time.Truncate(time.Microsecond))
+	}
+	return same(v.From, from) && same(v.Until, until)
+}
+
+// activeLinkClause restricts links to those that are in effect at the moment of the query.
+func activeLinkClause(alias, columnValidFrom, columnValidUntil string) string {
+	return `(` + alias + `.` + columnValidFrom + ` IS NULL OR ` + alias + `.` + columnValidFrom + ` <= NOW())
+				AND (` + alias + `.` + columnValidUntil + ` IS NULL OR ` + alias + `.` + columnValidUntil + ` > NOW())`
+}
+
+// permissionLinks describes a table that links permissions with either users or groups.
+type permissionLinks struct {
+	table, columnID, columnSubsystem, columnModule, columnAction, columnDenied string
+	// columnValidFrom and columnValidUntil are empty if links stored in the table cannot be time-bound.
+	columnValidFrom, columnValidUntil string
+}
+
+func setPermissions(db *sql.DB, ctx context.Context, links permissionLinks, id int64, granted, denied charon.Permissions, validity map[charon.Permission]Validity) (int64, int64, error) {
+	if len(granted)+len(denied) == 0 {
 		return 0, 0, errors.New("permission cannot be set, none provided")
 	}
+	for _, p := range denied {
+		if granted.Contains(p) {
+			return 0, 0, ErrPermissionGrantedAndDenied
+		}
+	}
+	timeBound := links.columnValidFrom != "" && links.columnValidUntil != ""
+	if !timeBound && len(validity) > 0 {
+		return 0, 0, errors.New("permission cannot be set, links are not time-bound")
+	}
 	var (
 		err                    error
 		aff, inserted, deleted int64
 		tx                     *sql.Tx
-		insert, exists         *sql.Stmt
+		end                    func(error) error
+		insert, update, exists *sql.Stmt
 		res                    sql.Result
 		in                     []charon.Permission
-		granted                bool
+		isDenied               bool
+		from, until            pq.NullTime
 	)
 
-	tx, err = db.BeginTx(ctx, nil)
+	tx, end, err = beginTx(ctx, db)
 	if err != nil {
 		return 0, 0, err
 	}
 	defer func() {
-		if err != nil {
-			tx.Rollback()
-		} else {
-			tx.Commit()
-		}
+		end(err)
 	}()
 
 	var (
 		subsystem, module, action string
+		table                     = links.table
+		columnID                  = links.columnID
+		columnSubsystem           = links.columnSubsystem
+		columnModule              = links.columnModule
+		columnAction              = links.columnAction
+		columnDenied              = links.columnDenied
+		where                     = ` WHERE ` + columnID + ` = $1 AND ` + columnSubsystem + ` = $2 AND ` + columnModule + ` = $3 AND ` + columnAction + ` = $4`
+		window                    = `NULL, NULL`
 	)
 
-	if len(permissions) > 0 {
-		insert, err = tx.Prepare(`INSERT INTO ` + table + ` (` + columnID + `, ` + columnSubsystem + `, ` + columnModule + `,` + columnAction + `) VALUES ($1, $2, $3, $4)`)
+	if timeBound {
+		window = links.columnValidFrom + `, ` + links.columnValidUntil
+		insert, err = tx.Prepare(`INSERT INTO ` + table + ` (` + columnID + `, ` + columnSubsystem + `, ` + columnModule + `,` + columnAction + `,` + columnDenied + `,` + window + `) VALUES ($1, $2, $3, $4, $5, $6, $7)`)
 		if err != nil {
 			return 0, 0, err
 		}
-		exists, err = tx.Prepare(isGrantedQuery(table, columnID, columnSubsystem, columnModule, columnAction))
+		update, err = tx.Prepare(`UPDATE ` + table + ` SET ` + columnDenied + ` = $5, ` + links.columnValidFrom + ` = $6, ` + links.columnValidUntil + ` = $7, updated_at = NOW()` + where)
 		if err != nil {
 			return 0, 0, err
 		}
+	} else {
+		insert, err = tx.Prepare(`INSERT INTO ` + table + ` (` + columnID + `, ` + columnSubsystem + `, ` + columnModule + `,` + columnAction + `,` + columnDenied + `) VALUES ($1, $2, $3, $4, $5)`)
+		if err != nil {
+			return 0, 0, err
+		}
+		update, err = tx.Prepare(`UPDATE ` + table + ` SET ` + columnDenied + ` = $5, updated_at = NOW()` + where)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+	exists, err = tx.Prepare(`SELECT ` + columnDenied + `, ` + window + ` FROM ` + table + where)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	args := func(p charon.Permission, deny bool) []interface{} {
+		args := []interface{}{id, subsystem, module, action, deny}
+		if timeBound {
+			v := validity[p]
+			args = append(args, v.From, v.Until)
+		}
+		return args
+	}
 
-		in = make(charon.Permissions, 0, len(permissions))
-	InsertLoop:
-		for _, p := range permissions {
-			subsystem, module, action = p.Split()
+	in = make(charon.Permissions, 0, len(granted)+len(denied))
+	for i, p := range append(append(charon.Permissions{}, granted...), denied...) {
+		subsystem, module, action = p.Split()
+		deny := i >= len(granted)
 
-			if err = exists.QueryRow(id, subsystem, module, action).Scan(&granted); err != nil {
-				return 0, 0, pqErrorPrefix(err, "error on permission check")
-			}
+		switch err = exists.QueryRow(id, subsystem, module, action).Scan(&isDenied, &from, &until); err {
+		case nil:
+			in = append(in, p)
 			// Given combination already exists, ignore.
-			if granted {
-				in = append(in, p)
-				granted = false
-				continue InsertLoop
+			if isDenied == deny && validity[p].equal(from, until) {
+				continue
 			}
-			res, err = insert.Exec(id, subsystem, module, action)
+			// Flipping the flag or changing the window counts as creation, previous link is gone.
+			res, err = update.Exec(args(p, deny)...)
 			if err != nil {
-				return 0, 0, pqErrorPrefix(err, "error on permission insert")
+				return 0, 0, pqErrorPrefix(err, "error on permission update")
 			}
-
-			aff, err = res.RowsAffected()
+		case sql.ErrNoRows:
+			res, err = insert.Exec(args(p, deny)...)
 			if err != nil {
-				return 0, 0, err
+				return 0, 0, pqErrorPrefix(err, "error on permission insert")
 			}
-			inserted += aff
-
 			in = append(in, p)
+		default:
+			return 0, 0, pqErrorPrefix(err, "error on permission check")
+		}
+
+		aff, err = res.RowsAffected()
+		if err != nil {
+			return 0, 0, err
 		}
+		inserted += aff
 	}
 
 	delete := NewComposer(1)
@@ -3,10 +3,10 @@ package model
 import (
 	"context"
 	"database/sql"
+	"errors"
 
 	"github.com/piotrkowalczuk/charon"
 	"github.com/piotrkowalczuk/ntypes"
-	"github.com/piotrkowalczuk/qtypes"
 )
 
 // GroupProvider ...
@@ -18,6 +18,8 @@ type GroupProvider interface {
 	FindOneByID(context.Context, int64) (*GroupEntity, error)
 	// find ...
 	Find(context.Context, *GroupFindExpr) ([]*GroupEntity, error)
+	// Count retrieves number of groups that match given expression.
+	Count(context.Context, *GroupCountExpr) (int64, error)
 	// Create ...
 	Create(ctx context.Context, createdBy int64, name string, description *ntypes.String) (*GroupEntity, error)
 	// updateOneByID ...
@@ -28,6 +30,15 @@ type GroupProvider interface {
 	IsGranted(context.Context, int64, charon.Permission) (bool, error)
 	// SetPermissions ...
 	SetPermissions(context.Context, int64, ...charon.Permission) (int64, int64, error)
+	// SetPermissionsWithDenials works like SetPermissions, but denied permissions are linked as well, with denied flag set.
+	SetPermissionsWithDenials(ctx context.Context, id int64, granted, denied charon.Permissions) (int64, int64, error)
+	// SetParent nests group in given parent group, invalid parent id makes it a top level group.
+	SetParent(ctx context.Context, id int64, parentID ntypes.Int64) (int64, error)
+	// FindAncestorIDs retrieves ids of all groups given group is nested in, directly or indirectly.
+	FindAncestorIDs(ctx context.Context, id int64) ([]int64, error)
+	// LockHierarchy waits until no other transaction can change parents of groups.
+	// Lock is held until the end of the transaction carried by the context, it fails if there is none.
+	LockHierarchy(ctx context.Context) error
 }
 
 // GroupRepository extends GroupRepositoryBase
@@ -54,23 +65,26 @@ func NewGroupRepository(dbPool *sql.DB) GroupProvider {
 
 // FindByUserID implements GroupProvider interface.
 func (gr *GroupRepository) FindByUserID(ctx context.Context, userID int64) ([]*GroupEntity, error) {
-	userGroups, err := gr.UserGroups.Find(ctx, &UserGroupsFindExpr{
-		//Columns: TableGroupColumns,
-		Where: &UserGroupsCriteria{
-			UserID: qtypes.EqualInt64(userID),
-		},
-		JoinGroup: &GroupJoin{
-			Fetch: true,
-			Kind:  JoinRight,
-		},
-	})
+	query := `
+		SELECT ` + columns(TableGroupColumns, "g") + `
+		FROM ` + TableUserGroups + ` AS ug
+		INNER JOIN ` + TableGroup + ` AS g ON g.` + TableGroupColumnID + ` = ug.` + TableUserGroupsColumnGroupID + `
+		WHERE ug.` + TableUserGroupsColumnUserID + ` = $1
+			AND ` + activeLinkClause("ug", TableUserGroupsColumnValidFrom, TableUserGroupsColumnValidUntil) + `
+	`
+
+	rows, err := conn(ctx, gr.DB).QueryContext(ctx, query, userID)
 	if err != nil {
 		return nil, err
 	}
+	defer rows.Close()
 
-	groups := make([]*GroupEntity, 0, len(userGroups))
-	for _, userGroup := range userGroups {
-		groups = append(groups, userGroup.Group)
+	groups, err := ScanGroupRows(rows)
+	if err != nil {
+		return nil, err
+	}
+	if groups == nil {
+		groups = []*GroupEntity{}
 	}
 
 	return groups, nil
@@ -94,12 +108,13 @@ func (gr *GroupRepository) Create(ctx context.Context, createdBy int64, name str
 func (gr *GroupRepository) IsGranted(ctx context.Context, id int64, p charon.Permission) (bool, error) {
 	var exists bool
 	subsystem, module, action := p.Split()
-	if err := gr.DB.QueryRowContext(ctx, isGrantedQuery(
+	if err := conn(ctx, gr.DB).QueryRowContext(ctx, isGrantedQuery(
 		TableGroupPermissions,
 		TableGroupPermissionsColumnGroupID,
 		TableGroupPermissionsColumnPermissionSubsystem,
 		TableGroupPermissionsColumnPermissionModule,
 		TableGroupPermissionsColumnPermissionAction,
+		TableGroupPermissionsColumnDenied,
 	), id, subsystem, module, action).Scan(&exists); err != nil {
 		return false, err
 	}
@@ -109,9 +124,100 @@ func (gr *GroupRepository) IsGranted(ctx context.Context, id int64, p charon.Per
 
 // SetPermissions ...
 func (gr *GroupRepository) SetPermissions(ctx context.Context, id int64, p ...charon.Permission) (int64, int64, error) {
-	return setPermissions(gr.DB, ctx, TableGroupPermissions,
-		TableGroupPermissionsColumnGroupID,
-		TableGroupPermissionsColumnPermissionSubsystem,
-		TableGroupPermissionsColumnPermissionModule,
-		TableGroupPermissionsColumnPermissionAction, id, p)
+	return gr.SetPermissionsWithDenials(ctx, id, p, nil)
+}
+
+// SetPermissionsWithDenials implements GroupProvider interface.
+func (gr *GroupRepository) SetPermissionsWithDenials(ctx context.Context, id int64, granted, denied charon.Permissions) (int64, int64, error) {
+	return setPermissions(gr.DB, ctx, permissionLinks{
+		table:           TableGroupPermissions,
+		columnID:        TableGroupPermissionsColumnGroupID,
+		columnSubsystem: TableGroupPermissionsColumnPermissionSubsystem,
+		columnModule:    TableGroupPermissionsColumnPermissionModule,
+		columnAction:    TableGroupPermissionsColumnPermissionAction,
+		columnDenied:    TableGroupPermissionsColumnDenied,
+	}, id, granted, denied, nil)
+}
+
+// SetParent implements GroupProvider interface.
+func (gr *GroupRepository) SetParent(ctx context.Context, id int64, parentID ntypes.Int64) (int64, error) {
+	query := `
+		UPDATE ` + gr.Table + `
+		SET ` + TableGroupColumnParentID + ` = $2, ` + TableGroupColumnUpdatedAt + ` = NOW()
+		WHERE ` + TableGroupColumnID + ` = $1
+	`
+
+	res, err := conn(ctx, gr.DB).ExecContext(ctx, query, id, parentID)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// FindAncestorIDs implements GroupProvider interface.
+func (gr *GroupRepository) FindAncestorIDs(ctx context.Context, id int64) ([]int64, error) {
+	query := `
+		WITH RECURSIVE ancestor (id) AS (
+			SELECT g.` + TableGroupColumnParentID + ` FROM ` + gr.Table + ` AS g
+			WHERE g.` + TableGroupColumnID + ` = $1 AND g.` + TableGroupColumnParentID + ` IS NOT NULL
+			UNION
+			SELECT g.` + TableGroupColumnParentID + ` FROM ` + gr.Table + ` AS g
+			INNER JOIN ancestor AS a ON g.` + TableGroupColumnID + ` = a.id
+			WHERE g.` + TableGroupColumnParentID + ` IS NOT NULL
+		)
+		SELECT id FROM ancestor
+	`
+
+	rows, err := conn(ctx, gr.DB).QueryContext(ctx, query, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err = rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return ids, nil
+}
+
+// LockHierarchy implements GroupProvider interface.
+// Locking rows of the groups involved would not be enough,
+// a cycle can be closed by two transactions that change parents of disjoint sets of groups.
+func (gr *GroupRepository) LockHierarchy(ctx context.Context) error {
+	tx := txFromContext(ctx)
+	if tx == nil {
+		return errors.New("group hierarchy cannot be locked outside of a transaction")
+	}
+
+	_, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext($1))`, gr.Table+"."+TableGroupColumnParentID)
+	return err
+}
+
+// Insert implements GroupProvider interface, it takes part in a transaction carried by the context.
+func (gr *GroupRepository) Insert(ctx context.Context, ent *GroupEntity) (*GroupEntity, error) {
+	return gr.insert(ctx, txFromContext(ctx), ent)
+}
+
+// FindOneByID implements GroupProvider interface, it takes part in a transaction carried by the context.
+func (gr *GroupRepository) FindOneByID(ctx context.Context, id int64) (*GroupEntity, error) {
+	return gr.findOneByID(ctx, txFromContext(ctx), id)
+}
+
+// UpdateOneByID implements GroupProvider interface, it takes part in a transaction carried by the context.
+func (gr *GroupRepository) UpdateOneByID(ctx context.Context, id int64, patch *GroupPatch) (*GroupEntity, error) {
+	return gr.updateOneByID(ctx, txFromContext(ctx), id, patch)
+}
+
+// DeleteOneByID implements GroupProvider interface, it takes part in a transaction carried by the context.
+func (gr *GroupRepository) DeleteOneByID(ctx context.Context, id int64) (int64, error) {
+	return gr.deleteOneByID(ctx, txFromContext(ctx), id)
 }
@@ -8,6 +8,7 @@ import (
 // GroupPermissionsProvider ...
 type GroupPermissionsProvider interface {
 	Insert(context.Context, *GroupPermissionsEntity) (*GroupPermissionsEntity, error)
+	Find(context.Context, *GroupPermissionsFindExpr) ([]*GroupPermissionsEntity, error)
 }
 
 // GroupPermissionsRepository extends GroupPermissionsRepositoryBase
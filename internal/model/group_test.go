@@ -3,6 +3,7 @@ package model
 import (
 	"context"
 	"testing"
+	"time"
 )
 
 func TestGroupRepository_IsGranted(t *testing.T) {
@@ -36,6 +37,51 @@ func TestGroupRepository_IsGranted(t *testing.T) {
 	}
 }
 
+func TestGroupRepository_LockHierarchy(t *testing.T) {
+	suite := &postgresSuite{}
+	suite.setup(t)
+	defer suite.teardown(t)
+
+	if err := suite.repository.group.LockHierarchy(context.TODO()); err == nil {
+		t.Fatal("lock outside of a transaction should fail")
+	}
+
+	tr := NewTransactor(suite.db)
+	lock := func(ctx context.Context) error {
+		return suite.repository.group.LockHierarchy(ctx)
+	}
+	locked, release, done := make(chan struct{}), make(chan struct{}), make(chan error, 1)
+	go func() {
+		done <- tr.Transaction(context.TODO(), func(ctx context.Context) error {
+			if err := lock(ctx); err != nil {
+				return err
+			}
+			close(locked)
+			<-release
+			return nil
+		})
+	}()
+	select {
+	case <-locked:
+	case err := <-done:
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 100*time.Millisecond)
+	defer cancel()
+	if err := tr.Transaction(ctx, lock); err == nil {
+		t.Error("lock should not be acquired while held by another transaction")
+	}
+
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if err := tr.Transaction(context.TODO(), lock); err != nil {
+		t.Errorf("lock should be released with the end of the transaction, unexpected error: %s", err.Error())
+	}
+}
+
 type groupFixtures struct {
 	got, given GroupEntity
 }
@@ -0,0 +1,90 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+)
+
+const (
+	// LoginFailureKindUsername identifies failures counted per username.
+	LoginFailureKindUsername = "username"
+	// LoginFailureKindPeer identifies failures counted per remote address.
+	LoginFailureKindPeer = "peer"
+	// LoginFailureKindRegistration identifies registration attempts counted per remote address.
+	LoginFailureKindRegistration = "registration"
+	// LoginFailureKindPasswordReset identifies password reset requests counted per remote address.
+	LoginFailureKindPasswordReset = "password_reset"
+)
+
+// LoginFailureProvider ...
+type LoginFailureProvider interface {
+	// FindOneByKindAndSubject ...
+	FindOneByKindAndSubject(ctx context.Context, kind, subject string) (*LoginFailureEntity, error)
+	// Register increments number of failures of given subject.
+	// Counter starts over if the previous failure happened before given time.
+	Register(ctx context.Context, kind, subject string, since time.Time) (*LoginFailureEntity, error)
+	// Reset forgets failures of given subject.
+	Reset(ctx context.Context, kind, subject string) (int64, error)
+}
+
+// LoginFailureRepository extends LoginFailureRepositoryBase.
+type LoginFailureRepository struct {
+	LoginFailureRepositoryBase
+}
+
+// NewLoginFailureRepository ...
+func NewLoginFailureRepository(dbPool *sql.DB) LoginFailureProvider {
+	return &LoginFailureRepository{
+		LoginFailureRepositoryBase: LoginFailureRepositoryBase{
+			DB:      dbPool,
+			Table:   TableLoginFailure,
+			Columns: TableLoginFailureColumns,
+		},
+	}
+}
+
+// Register implements LoginFailureProvider interface.
+func (lfr *LoginFailureRepository) Register(ctx context.Context, kind, subject string, since time.Time) (*LoginFailureEntity, error) {
+	query := `
+		INSERT INTO ` + lfr.Table + ` AS lf (` + TableLoginFailureColumnKind + `, ` + TableLoginFailureColumnSubject + `, ` + TableLoginFailureColumnFailures + `)
+		VALUES ($1, $2, 1)
+		ON CONFLICT (` + TableLoginFailureColumnKind + `, ` + TableLoginFailureColumnSubject + `) DO UPDATE
+		SET ` + TableLoginFailureColumnFailures + ` = CASE WHEN lf.` + TableLoginFailureColumnLastFailureAt + ` < $3 THEN 1 ELSE lf.` + TableLoginFailureColumnFailures + ` + 1 END,
+			` + TableLoginFailureColumnLastFailureAt + ` = NOW(),
+			` + TableLoginFailureColumnUpdatedAt + ` = NOW()
+		RETURNING ` + strings.Join(TableLoginFailureColumns, ",") + `
+	`
+
+	rows, err := conn(ctx, lfr.DB).QueryContext(ctx, query, kind, subject, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ents, err := ScanLoginFailureRows(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(ents) == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	return ents[0], nil
+}
+
+// Reset implements LoginFailureProvider interface.
+func (lfr *LoginFailureRepository) Reset(ctx context.Context, kind, subject string) (int64, error) {
+	query := `
+		DELETE FROM ` + lfr.Table + `
+		WHERE ` + TableLoginFailureColumnKind + ` = $1 AND ` + TableLoginFailureColumnSubject + ` = $2
+	`
+
+	result, err := conn(ctx, lfr.DB).ExecContext(ctx, query, kind, subject)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
@@ -11,6 +11,29 @@ type GroupPermissionsProvider struct {
 	mock.Mock
 }
 
+// Find provides a mock function with given fields: _a0, _a1
+func (_m *GroupPermissionsProvider) Find(_a0 context.Context, _a1 *model.GroupPermissionsFindExpr) ([]*model.GroupPermissionsEntity, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []*model.GroupPermissionsEntity
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GroupPermissionsFindExpr) []*model.GroupPermissionsEntity); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.GroupPermissionsEntity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *model.GroupPermissionsFindExpr) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Insert provides a mock function with given fields: _a0, _a1
 func (_m *GroupPermissionsProvider) Insert(_a0 context.Context, _a1 *model.GroupPermissionsEntity) (*model.GroupPermissionsEntity, error) {
 	ret := _m.Called(_a0, _a1)
@@ -13,6 +13,27 @@ type GroupProvider struct {
 	mock.Mock
 }
 
+// Count provides a mock function with given fields: _a0, _a1
+func (_m *GroupProvider) Count(_a0 context.Context, _a1 *model.GroupCountExpr) (int64, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GroupCountExpr) int64); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *model.GroupCountExpr) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Create provides a mock function with given fields: ctx, createdBy, name, description
 func (_m *GroupProvider) Create(ctx context.Context, createdBy int64, name string, description *ntypes.String) (*model.GroupEntity, error) {
 	ret := _m.Called(ctx, createdBy, name, description)
@@ -80,6 +101,29 @@ func (_m *GroupProvider) Find(_a0 context.Context, _a1 *model.GroupFindExpr) ([]
 	return r0, r1
 }
 
+// FindAncestorIDs provides a mock function with given fields: ctx, id
+func (_m *GroupProvider) FindAncestorIDs(ctx context.Context, id int64) ([]int64, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 []int64
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []int64); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindByUserID provides a mock function with given fields: _a0, _a1
 func (_m *GroupProvider) FindByUserID(_a0 context.Context, _a1 int64) ([]*model.GroupEntity, error) {
 	ret := _m.Called(_a0, _a1)
@@ -170,6 +214,41 @@ func (_m *GroupProvider) IsGranted(_a0 context.Context, _a1 int64, _a2 charon.Pe
 	return r0, r1
 }
 
+// LockHierarchy provides a mock function with given fields: ctx
+func (_m *GroupProvider) LockHierarchy(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetParent provides a mock function with given fields: ctx, id, parentID
+func (_m *GroupProvider) SetParent(ctx context.Context, id int64, parentID ntypes.Int64) (int64, error) {
+	ret := _m.Called(ctx, id, parentID)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, int64, ntypes.Int64) int64); ok {
+		r0 = rf(ctx, id, parentID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, ntypes.Int64) error); ok {
+		r1 = rf(ctx, id, parentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetPermissions provides a mock function with given fields: _a0, _a1, _a2
 func (_m *GroupProvider) SetPermissions(_a0 context.Context, _a1 int64, _a2 ...charon.Permission) (int64, int64, error) {
 	_va := make([]interface{}, len(_a2))
@@ -205,6 +284,34 @@ func (_m *GroupProvider) SetPermissions(_a0 context.Context, _a1 int64, _a2 ...c
 	return r0, r1, r2
 }
 
+// SetPermissionsWithDenials provides a mock function with given fields: ctx, id, granted, denied
+func (_m *GroupProvider) SetPermissionsWithDenials(ctx context.Context, id int64, granted charon.Permissions, denied charon.Permissions) (int64, int64, error) {
+	ret := _m.Called(ctx, id, granted, denied)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, int64, charon.Permissions, charon.Permissions) int64); ok {
+		r0 = rf(ctx, id, granted, denied)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 int64
+	if rf, ok := ret.Get(1).(func(context.Context, int64, charon.Permissions, charon.Permissions) int64); ok {
+		r1 = rf(ctx, id, granted, denied)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int64, charon.Permissions, charon.Permissions) error); ok {
+		r2 = rf(ctx, id, granted, denied)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // UpdateOneByID provides a mock function with given fields: _a0, _a1, _a2
 func (_m *GroupProvider) UpdateOneByID(_a0 context.Context, _a1 int64, _a2 *model.GroupPatch) (*model.GroupEntity, error) {
 	ret := _m.Called(_a0, _a1, _a2)
@@ -12,6 +12,27 @@ type PermissionProvider struct {
 	mock.Mock
 }
 
+// Count provides a mock function with given fields: ctx, exp
+func (_m *PermissionProvider) Count(ctx context.Context, exp *model.PermissionCountExpr) (int64, error) {
+	ret := _m.Called(ctx, exp)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PermissionCountExpr) int64); ok {
+		r0 = rf(ctx, exp)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *model.PermissionCountExpr) error); ok {
+		r1 = rf(ctx, exp)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Find provides a mock function with given fields: ctx, criteria
 func (_m *PermissionProvider) Find(ctx context.Context, criteria *model.PermissionFindExpr) ([]*model.PermissionEntity, error) {
 	ret := _m.Called(ctx, criteria)
@@ -81,6 +102,29 @@ func (_m *PermissionProvider) FindByUserID(ctx context.Context, userID int64) ([
 	return r0, r1
 }
 
+// FindDeniedByUserID provides a mock function with given fields: ctx, userID
+func (_m *PermissionProvider) FindDeniedByUserID(ctx context.Context, userID int64) ([]*model.PermissionEntity, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []*model.PermissionEntity
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []*model.PermissionEntity); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PermissionEntity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindOneByID provides a mock function with given fields: ctx, id
 func (_m *PermissionProvider) FindOneByID(ctx context.Context, id int64) (*model.PermissionEntity, error) {
 	ret := _m.Called(ctx, id)
@@ -11,6 +11,27 @@ type RefreshTokenProvider struct {
 	mock.Mock
 }
 
+// Count provides a mock function with given fields: _a0, _a1
+func (_m *RefreshTokenProvider) Count(_a0 context.Context, _a1 *model.RefreshTokenCountExpr) (int64, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, *model.RefreshTokenCountExpr) int64); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *model.RefreshTokenCountExpr) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Create provides a mock function with given fields: _a0, _a1
 func (_m *RefreshTokenProvider) Create(_a0 context.Context, _a1 *model.RefreshTokenEntity) (*model.RefreshTokenEntity, error) {
 	ret := _m.Called(_a0, _a1)
@@ -34,6 +55,27 @@ func (_m *RefreshTokenProvider) Create(_a0 context.Context, _a1 *model.RefreshTo
 	return r0, r1
 }
 
+// DeleteByUserID provides a mock function with given fields: ctx, userID
+func (_m *RefreshTokenProvider) DeleteByUserID(ctx context.Context, userID int64) (int64, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, int64) int64); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Find provides a mock function with given fields: _a0, _a1
 func (_m *RefreshTokenProvider) Find(_a0 context.Context, _a1 *model.RefreshTokenFindExpr) ([]*model.RefreshTokenEntity, error) {
 	ret := _m.Called(_a0, _a1)
@@ -57,8 +99,8 @@ func (_m *RefreshTokenProvider) Find(_a0 context.Context, _a1 *model.RefreshToke
 	return r0, r1
 }
 
-// FindOneByToken provides a mock function with given fields: _a0, _a1
-func (_m *RefreshTokenProvider) FindOneByToken(_a0 context.Context, _a1 string) (*model.RefreshTokenEntity, error) {
+// FindOneByPrefix provides a mock function with given fields: _a0, _a1
+func (_m *RefreshTokenProvider) FindOneByPrefix(_a0 context.Context, _a1 string) (*model.RefreshTokenEntity, error) {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 *model.RefreshTokenEntity
@@ -80,13 +122,13 @@ func (_m *RefreshTokenProvider) FindOneByToken(_a0 context.Context, _a1 string)
 	return r0, r1
 }
 
-// FindOneByTokenAndUserID provides a mock function with given fields: ctx, token, userID
-func (_m *RefreshTokenProvider) FindOneByTokenAndUserID(ctx context.Context, token string, userID int64) (*model.RefreshTokenEntity, error) {
-	ret := _m.Called(ctx, token, userID)
+// FindOneByPrefixAndUserID provides a mock function with given fields: ctx, prefix, userID
+func (_m *RefreshTokenProvider) FindOneByPrefixAndUserID(ctx context.Context, prefix string, userID int64) (*model.RefreshTokenEntity, error) {
+	ret := _m.Called(ctx, prefix, userID)
 
 	var r0 *model.RefreshTokenEntity
 	if rf, ok := ret.Get(0).(func(context.Context, string, int64) *model.RefreshTokenEntity); ok {
-		r0 = rf(ctx, token, userID)
+		r0 = rf(ctx, prefix, userID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.RefreshTokenEntity)
@@ -95,7 +137,74 @@ func (_m *RefreshTokenProvider) FindOneByTokenAndUserID(ctx context.Context, tok
 
 	var r1 error
 	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
-		r1 = rf(ctx, token, userID)
+		r1 = rf(ctx, prefix, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkRotated provides a mock function with given fields: ctx, prefix
+func (_m *RefreshTokenProvider) MarkRotated(ctx context.Context, prefix string) (int64, error) {
+	ret := _m.Called(ctx, prefix)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, prefix)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, prefix)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevokeFamily provides a mock function with given fields: ctx, family
+func (_m *RefreshTokenProvider) RevokeFamily(ctx context.Context, family string) ([]string, error) {
+	ret := _m.Called(ctx, family)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = rf(ctx, family)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, family)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevokeMany provides a mock function with given fields: ctx, criteria
+func (_m *RefreshTokenProvider) RevokeMany(ctx context.Context, criteria *model.RefreshTokenCriteria) ([]string, error) {
+	ret := _m.Called(ctx, criteria)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, *model.RefreshTokenCriteria) []string); ok {
+		r0 = rf(ctx, criteria)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *model.RefreshTokenCriteria) error); ok {
+		r1 = rf(ctx, criteria)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -103,8 +212,8 @@ func (_m *RefreshTokenProvider) FindOneByTokenAndUserID(ctx context.Context, tok
 	return r0, r1
 }
 
-// UpdateOneByToken provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RefreshTokenProvider) UpdateOneByToken(_a0 context.Context, _a1 string, _a2 *model.RefreshTokenPatch) (*model.RefreshTokenEntity, error) {
+// UpdateOneByPrefix provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RefreshTokenProvider) UpdateOneByPrefix(_a0 context.Context, _a1 string, _a2 *model.RefreshTokenPatch) (*model.RefreshTokenEntity, error) {
 	ret := _m.Called(_a0, _a1, _a2)
 
 	var r0 *model.RefreshTokenEntity
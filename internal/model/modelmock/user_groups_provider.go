@@ -11,6 +11,27 @@ type UserGroupsProvider struct {
 	mock.Mock
 }
 
+// BelongsTo provides a mock function with given fields: ctx, userID, groupID
+func (_m *UserGroupsProvider) BelongsTo(ctx context.Context, userID int64, groupID int64) (bool, error) {
+	ret := _m.Called(ctx, userID, groupID)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) bool); ok {
+		r0 = rf(ctx, userID, groupID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, userID, groupID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteByUserID provides a mock function with given fields: ctx, id
 func (_m *UserGroupsProvider) DeleteByUserID(ctx context.Context, id int64) (int64, error) {
 	ret := _m.Called(ctx, id)
@@ -32,6 +53,50 @@ func (_m *UserGroupsProvider) DeleteByUserID(ctx context.Context, id int64) (int
 	return r0, r1
 }
 
+// DeleteExpired provides a mock function with given fields: ctx
+func (_m *UserGroupsProvider) DeleteExpired(ctx context.Context) ([]*model.UserGroupsEntity, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*model.UserGroupsEntity
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.UserGroupsEntity); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.UserGroupsEntity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteMapped provides a mock function with given fields: ctx, userID, issuer, keep
+func (_m *UserGroupsProvider) DeleteMapped(ctx context.Context, userID int64, issuer string, keep []int64) (int64, error) {
+	ret := _m.Called(ctx, userID, issuer, keep)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, []int64) int64); ok {
+		r0 = rf(ctx, userID, issuer, keep)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, []int64) error); ok {
+		r1 = rf(ctx, userID, issuer, keep)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Exists provides a mock function with given fields: ctx, userID, groupID
 func (_m *UserGroupsProvider) Exists(ctx context.Context, userID int64, groupID int64) (bool, error) {
 	ret := _m.Called(ctx, userID, groupID)
@@ -126,3 +191,31 @@ func (_m *UserGroupsProvider) Set(ctx context.Context, userID int64, groupIDs []
 
 	return r0, r1, r2
 }
+
+// SetTimeBound provides a mock function with given fields: ctx, userID, groupIDs, validity
+func (_m *UserGroupsProvider) SetTimeBound(ctx context.Context, userID int64, groupIDs []int64, validity map[int64]model.Validity) (int64, int64, error) {
+	ret := _m.Called(ctx, userID, groupIDs, validity)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []int64, map[int64]model.Validity) int64); ok {
+		r0 = rf(ctx, userID, groupIDs, validity)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 int64
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []int64, map[int64]model.Validity) int64); ok {
+		r1 = rf(ctx, userID, groupIDs, validity)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int64, []int64, map[int64]model.Validity) error); ok {
+		r2 = rf(ctx, userID, groupIDs, validity)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
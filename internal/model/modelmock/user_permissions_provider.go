@@ -32,6 +32,52 @@ func (_m *UserPermissionsProvider) DeleteByUserID(_a0 context.Context, _a1 int64
 	return r0, r1
 }
 
+// DeleteExpired provides a mock function with given fields: _a0
+func (_m *UserPermissionsProvider) DeleteExpired(_a0 context.Context) ([]*model.UserPermissionsEntity, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []*model.UserPermissionsEntity
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.UserPermissionsEntity); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.UserPermissionsEntity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Find provides a mock function with given fields: _a0, _a1
+func (_m *UserPermissionsProvider) Find(_a0 context.Context, _a1 *model.UserPermissionsFindExpr) ([]*model.UserPermissionsEntity, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []*model.UserPermissionsEntity
+	if rf, ok := ret.Get(0).(func(context.Context, *model.UserPermissionsFindExpr) []*model.UserPermissionsEntity); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.UserPermissionsEntity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *model.UserPermissionsFindExpr) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Insert provides a mock function with given fields: _a0, _a1
 func (_m *UserPermissionsProvider) Insert(_a0 context.Context, _a1 *model.UserPermissionsEntity) (*model.UserPermissionsEntity, error) {
 	ret := _m.Called(_a0, _a1)
@@ -6,6 +6,7 @@ import charon "github.com/piotrkowalczuk/charon"
 import context "context"
 import mock "github.com/stretchr/testify/mock"
 import model "github.com/piotrkowalczuk/charon/internal/model"
+import time "time"
 
 // UserProvider is an autogenerated mock type for the UserProvider type
 type UserProvider struct {
@@ -26,6 +27,73 @@ func (_m *UserProvider) ChangePassword(ctx context.Context, id int64, password s
 	return r0
 }
 
+// ConfirmTwoFactor provides a mock function with given fields: ctx, id, step, recoveryCodes
+func (_m *UserProvider) ConfirmTwoFactor(ctx context.Context, id int64, step int64, recoveryCodes []byte) (int64, error) {
+	ret := _m.Called(ctx, id, step, recoveryCodes)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, []byte) int64); ok {
+		r0 = rf(ctx, id, step, recoveryCodes)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, []byte) error); ok {
+		r1 = rf(ctx, id, step, recoveryCodes)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConsumePasswordResetToken provides a mock function with given fields: ctx, token
+func (_m *UserProvider) ConsumePasswordResetToken(ctx context.Context, token []byte) (*model.UserEntity, error) {
+	ret := _m.Called(ctx, token)
+
+	var r0 *model.UserEntity
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) *model.UserEntity); ok {
+		r0 = rf(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.UserEntity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []byte) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConsumeTwoFactorChallenge provides a mock function with given fields: ctx, challenge
+func (_m *UserProvider) ConsumeTwoFactorChallenge(ctx context.Context, challenge []byte) (*model.UserEntity, error) {
+	ret := _m.Called(ctx, challenge)
+
+	var r0 *model.UserEntity
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) *model.UserEntity); ok {
+		r0 = rf(ctx, challenge)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.UserEntity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []byte) error); ok {
+		r1 = rf(ctx, challenge)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Count provides a mock function with given fields: _a0
 func (_m *UserProvider) Count(_a0 context.Context) (int64, error) {
 	ret := _m.Called(_a0)
@@ -47,6 +115,27 @@ func (_m *UserProvider) Count(_a0 context.Context) (int64, error) {
 	return r0, r1
 }
 
+// CountBy provides a mock function with given fields: _a0, _a1
+func (_m *UserProvider) CountBy(_a0 context.Context, _a1 *model.UserCountExpr) (int64, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, *model.UserCountExpr) int64); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *model.UserCountExpr) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Create provides a mock function with given fields: _a0, _a1
 func (_m *UserProvider) Create(_a0 context.Context, _a1 *model.UserEntity) (*model.UserEntity, error) {
 	ret := _m.Called(_a0, _a1)
@@ -114,6 +203,48 @@ func (_m *UserProvider) DeleteOneByID(_a0 context.Context, _a1 int64) (int64, er
 	return r0, r1
 }
 
+// DisableTwoFactor provides a mock function with given fields: ctx, id
+func (_m *UserProvider) DisableTwoFactor(ctx context.Context, id int64) (int64, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, int64) int64); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Disown provides a mock function with given fields: ctx, id
+func (_m *UserProvider) Disown(ctx context.Context, id int64) (int64, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, int64) int64); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Exists provides a mock function with given fields: _a0, _a1
 func (_m *UserProvider) Exists(_a0 context.Context, _a1 int64) (bool, error) {
 	ret := _m.Called(_a0, _a1)
@@ -158,6 +289,29 @@ func (_m *UserProvider) Find(_a0 context.Context, _a1 *model.UserFindExpr) ([]*m
 	return r0, r1
 }
 
+// FindDeletedBefore provides a mock function with given fields: ctx, before
+func (_m *UserProvider) FindDeletedBefore(ctx context.Context, before time.Time) ([]*model.UserEntity, error) {
+	ret := _m.Called(ctx, before)
+
+	var r0 []*model.UserEntity
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []*model.UserEntity); ok {
+		r0 = rf(ctx, before)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.UserEntity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindOneByID provides a mock function with given fields: _a0, _a1
 func (_m *UserProvider) FindOneByID(_a0 context.Context, _a1 int64) (*model.UserEntity, error) {
 	ret := _m.Called(_a0, _a1)
@@ -248,20 +402,152 @@ func (_m *UserProvider) IsGranted(ctx context.Context, id int64, permission char
 	return r0, r1
 }
 
-// RegistrationConfirmation provides a mock function with given fields: ctx, id, confirmationToken
-func (_m *UserProvider) RegistrationConfirmation(ctx context.Context, id int64, confirmationToken string) (int64, error) {
-	ret := _m.Called(ctx, id, confirmationToken)
+// IsGrantedMany provides a mock function with given fields: ctx, ids, permissions
+func (_m *UserProvider) IsGrantedMany(ctx context.Context, ids []int64, permissions charon.Permissions) (map[int64]map[charon.Permission]bool, error) {
+	ret := _m.Called(ctx, ids, permissions)
+
+	var r0 map[int64]map[charon.Permission]bool
+	if rf, ok := ret.Get(0).(func(context.Context, []int64, charon.Permissions) map[int64]map[charon.Permission]bool); ok {
+		r0 = rf(ctx, ids, permissions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int64]map[charon.Permission]bool)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []int64, charon.Permissions) error); ok {
+		r1 = rf(ctx, ids, permissions)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IsGrantedOnResource provides a mock function with given fields: ctx, id, permission, resourceType, resourceID
+func (_m *UserProvider) IsGrantedOnResource(ctx context.Context, id int64, permission charon.Permission, resourceType string, resourceID string) (bool, error) {
+	ret := _m.Called(ctx, id, permission, resourceType, resourceID)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, int64, charon.Permission, string, string) bool); ok {
+		r0 = rf(ctx, id, permission, resourceType, resourceID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, charon.Permission, string, string) error); ok {
+		r1 = rf(ctx, id, permission, resourceType, resourceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Lock provides a mock function with given fields: ctx, username
+func (_m *UserProvider) Lock(ctx context.Context, username string) (int64, error) {
+	ret := _m.Called(ctx, username)
 
 	var r0 int64
-	if rf, ok := ret.Get(0).(func(context.Context, int64, string) int64); ok {
-		r0 = rf(ctx, id, confirmationToken)
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, username)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
-		r1 = rf(ctx, id, confirmationToken)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RegistrationConfirmation provides a mock function with given fields: ctx, token
+func (_m *UserProvider) RegistrationConfirmation(ctx context.Context, token []byte) (*model.UserEntity, error) {
+	ret := _m.Called(ctx, token)
+
+	var r0 *model.UserEntity
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) *model.UserEntity); ok {
+		r0 = rf(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.UserEntity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []byte) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReplaceTwoFactorRecoveryCodes provides a mock function with given fields: ctx, id, previous, next
+func (_m *UserProvider) ReplaceTwoFactorRecoveryCodes(ctx context.Context, id int64, previous []byte, next []byte) (int64, error) {
+	ret := _m.Called(ctx, id, previous, next)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []byte, []byte) int64); ok {
+		r0 = rf(ctx, id, previous, next)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []byte, []byte) error); ok {
+		r1 = rf(ctx, id, previous, next)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreOneByID provides a mock function with given fields: ctx, id
+func (_m *UserProvider) RestoreOneByID(ctx context.Context, id int64) (*model.UserEntity, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.UserEntity
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *model.UserEntity); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.UserEntity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetPasswordResetToken provides a mock function with given fields: ctx, id, token, expireAt
+func (_m *UserProvider) SetPasswordResetToken(ctx context.Context, id int64, token []byte, expireAt time.Time) (int64, error) {
+	ret := _m.Called(ctx, id, token, expireAt)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []byte, time.Time) int64); ok {
+		r0 = rf(ctx, id, token, expireAt)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []byte, time.Time) error); ok {
+		r1 = rf(ctx, id, token, expireAt)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -304,6 +590,148 @@ func (_m *UserProvider) SetPermissions(ctx context.Context, id int64, permission
 	return r0, r1, r2
 }
 
+// SetPermissionsWithDenials provides a mock function with given fields: ctx, id, granted, denied
+func (_m *UserProvider) SetPermissionsWithDenials(ctx context.Context, id int64, granted charon.Permissions, denied charon.Permissions) (int64, int64, error) {
+	ret := _m.Called(ctx, id, granted, denied)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, int64, charon.Permissions, charon.Permissions) int64); ok {
+		r0 = rf(ctx, id, granted, denied)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 int64
+	if rf, ok := ret.Get(1).(func(context.Context, int64, charon.Permissions, charon.Permissions) int64); ok {
+		r1 = rf(ctx, id, granted, denied)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int64, charon.Permissions, charon.Permissions) error); ok {
+		r2 = rf(ctx, id, granted, denied)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// SetTimeBoundPermissions provides a mock function with given fields: ctx, id, granted, denied, validity
+func (_m *UserProvider) SetTimeBoundPermissions(ctx context.Context, id int64, granted charon.Permissions, denied charon.Permissions, validity map[charon.Permission]model.Validity) (int64, int64, error) {
+	ret := _m.Called(ctx, id, granted, denied, validity)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, int64, charon.Permissions, charon.Permissions, map[charon.Permission]model.Validity) int64); ok {
+		r0 = rf(ctx, id, granted, denied, validity)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 int64
+	if rf, ok := ret.Get(1).(func(context.Context, int64, charon.Permissions, charon.Permissions, map[charon.Permission]model.Validity) int64); ok {
+		r1 = rf(ctx, id, granted, denied, validity)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int64, charon.Permissions, charon.Permissions, map[charon.Permission]model.Validity) error); ok {
+		r2 = rf(ctx, id, granted, denied, validity)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// SetTwoFactorChallenge provides a mock function with given fields: ctx, id, challenge, expireAt
+func (_m *UserProvider) SetTwoFactorChallenge(ctx context.Context, id int64, challenge []byte, expireAt time.Time) (int64, error) {
+	ret := _m.Called(ctx, id, challenge, expireAt)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []byte, time.Time) int64); ok {
+		r0 = rf(ctx, id, challenge, expireAt)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []byte, time.Time) error); ok {
+		r1 = rf(ctx, id, challenge, expireAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetTwoFactorSecret provides a mock function with given fields: ctx, id, secret
+func (_m *UserProvider) SetTwoFactorSecret(ctx context.Context, id int64, secret []byte) (int64, error) {
+	ret := _m.Called(ctx, id, secret)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []byte) int64); ok {
+		r0 = rf(ctx, id, secret)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []byte) error); ok {
+		r1 = rf(ctx, id, secret)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SoftDeleteOneByID provides a mock function with given fields: ctx, id
+func (_m *UserProvider) SoftDeleteOneByID(ctx context.Context, id int64) (*model.UserEntity, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.UserEntity
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *model.UserEntity); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.UserEntity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Unlock provides a mock function with given fields: ctx, id
+func (_m *UserProvider) Unlock(ctx context.Context, id int64) (int64, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, int64) int64); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateLastLoginAt provides a mock function with given fields: ctx, id
 func (_m *UserProvider) UpdateLastLoginAt(ctx context.Context, id int64) (int64, error) {
 	ret := _m.Called(ctx, id)
@@ -347,3 +775,24 @@ func (_m *UserProvider) UpdateOneByID(_a0 context.Context, _a1 int64, _a2 *model
 
 	return r0, r1
 }
+
+// UseTwoFactorStep provides a mock function with given fields: ctx, id, step
+func (_m *UserProvider) UseTwoFactorStep(ctx context.Context, id int64, step int64) (int64, error) {
+	ret := _m.Called(ctx, id, step)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) int64); ok {
+		r0 = rf(ctx, id, step)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, id, step)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
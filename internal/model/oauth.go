@@ -0,0 +1,217 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+)
+
+// OauthClientProvider ...
+type OauthClientProvider interface {
+	// Insert ...
+	Insert(context.Context, *OauthClientEntity) (*OauthClientEntity, error)
+	// Find ...
+	Find(context.Context, *OauthClientFindExpr) ([]*OauthClientEntity, error)
+	// FindOneByID ...
+	FindOneByID(context.Context, int64) (*OauthClientEntity, error)
+	// FindOneByClientID ...
+	FindOneByClientID(context.Context, string) (*OauthClientEntity, error)
+	// DeleteOneByID ...
+	DeleteOneByID(context.Context, int64) (int64, error)
+}
+
+// OauthClientRepository extends OauthClientRepositoryBase.
+type OauthClientRepository struct {
+	OauthClientRepositoryBase
+}
+
+// NewOauthClientRepository ...
+func NewOauthClientRepository(dbPool *sql.DB) OauthClientProvider {
+	return &OauthClientRepository{
+		OauthClientRepositoryBase: OauthClientRepositoryBase{
+			DB:      dbPool,
+			Table:   TableOauthClient,
+			Columns: TableOauthClientColumns,
+		},
+	}
+}
+
+// Insert implements OauthClientProvider interface, it takes part in a transaction carried by the context.
+func (ocr *OauthClientRepository) Insert(ctx context.Context, ent *OauthClientEntity) (*OauthClientEntity, error) {
+	return ocr.insert(ctx, txFromContext(ctx), ent)
+}
+
+// FindOneByID implements OauthClientProvider interface, it takes part in a transaction carried by the context.
+func (ocr *OauthClientRepository) FindOneByID(ctx context.Context, id int64) (*OauthClientEntity, error) {
+	return ocr.findOneByID(ctx, txFromContext(ctx), id)
+}
+
+// DeleteOneByID implements OauthClientProvider interface, it takes part in a transaction carried by the context.
+func (ocr *OauthClientRepository) DeleteOneByID(ctx context.Context, id int64) (int64, error) {
+	return ocr.deleteOneByID(ctx, txFromContext(ctx), id)
+}
+
+// RedirectURIs splits space separated list of redirect URIs the client is allowed to use.
+func (e *OauthClientEntity) RedirectURIs() []string {
+	return strings.Fields(e.RedirectUris)
+}
+
+// IsPublic returns true if client does not have a secret, so it cannot authenticate itself.
+func (e *OauthClientEntity) IsPublic() bool {
+	return len(e.Secret) == 0
+}
+
+// OauthConsentProvider ...
+type OauthConsentProvider interface {
+	// FindOneByUserIDAndClientID ...
+	FindOneByUserIDAndClientID(ctx context.Context, userID, clientID int64) (*OauthConsentEntity, error)
+	// Grant records consent of the user to give the client access to given scopes, replacing the previous one.
+	Grant(ctx context.Context, userID, clientID int64, scope string) (*OauthConsentEntity, error)
+	// DeleteByUserID ...
+	DeleteByUserID(ctx context.Context, userID int64) (int64, error)
+	// DeleteByClientID ...
+	DeleteByClientID(ctx context.Context, clientID int64) (int64, error)
+}
+
+// OauthConsentRepository extends OauthConsentRepositoryBase.
+type OauthConsentRepository struct {
+	OauthConsentRepositoryBase
+}
+
+// NewOauthConsentRepository ...
+func NewOauthConsentRepository(dbPool *sql.DB) OauthConsentProvider {
+	return &OauthConsentRepository{
+		OauthConsentRepositoryBase: OauthConsentRepositoryBase{
+			DB:      dbPool,
+			Table:   TableOauthConsent,
+			Columns: TableOauthConsentColumns,
+		},
+	}
+}
+
+// Grant implements OauthConsentProvider interface.
+func (ocr *OauthConsentRepository) Grant(ctx context.Context, userID, clientID int64, scope string) (*OauthConsentEntity, error) {
+	query := `
+		INSERT INTO ` + ocr.Table + ` (` + TableOauthConsentColumnUserID + `, ` + TableOauthConsentColumnClientID + `, ` + TableOauthConsentColumnScope + `)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (` + TableOauthConsentColumnUserID + `, ` + TableOauthConsentColumnClientID + `)
+		DO UPDATE SET ` + TableOauthConsentColumnScope + ` = EXCLUDED.` + TableOauthConsentColumnScope + `, ` + TableOauthConsentColumnUpdatedAt + ` = NOW()
+		RETURNING ` + strings.Join(TableOauthConsentColumns, ",")
+
+	rows, err := conn(ctx, ocr.DB).QueryContext(ctx, query, userID, clientID, scope)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ents, err := ScanOauthConsentRows(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(ents) == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return ents[0], nil
+}
+
+// DeleteByUserID implements OauthConsentProvider interface.
+func (ocr *OauthConsentRepository) DeleteByUserID(ctx context.Context, userID int64) (int64, error) {
+	return deleteBy(ctx, ocr.DB, ocr.Table, TableOauthConsentColumnUserID, userID)
+}
+
+// DeleteByClientID implements OauthConsentProvider interface.
+func (ocr *OauthConsentRepository) DeleteByClientID(ctx context.Context, clientID int64) (int64, error) {
+	return deleteBy(ctx, ocr.DB, ocr.Table, TableOauthConsentColumnClientID, clientID)
+}
+
+// Scopes splits space separated list of scopes the user consented to.
+func (e *OauthConsentEntity) Scopes() []string {
+	return strings.Fields(e.Scope)
+}
+
+// OauthAuthorizationCodeProvider ...
+type OauthAuthorizationCodeProvider interface {
+	// Insert ...
+	Insert(context.Context, *OauthAuthorizationCodeEntity) (*OauthAuthorizationCodeEntity, error)
+	// Consume removes given code, so that it cannot be exchanged twice, and returns it unless it expired.
+	Consume(ctx context.Context, code string) (*OauthAuthorizationCodeEntity, error)
+	// DeleteExpired ...
+	DeleteExpired(context.Context) (int64, error)
+	// DeleteByUserID ...
+	DeleteByUserID(ctx context.Context, userID int64) (int64, error)
+	// DeleteByClientID ...
+	DeleteByClientID(ctx context.Context, clientID int64) (int64, error)
+}
+
+// OauthAuthorizationCodeRepository extends OauthAuthorizationCodeRepositoryBase.
+type OauthAuthorizationCodeRepository struct {
+	OauthAuthorizationCodeRepositoryBase
+}
+
+// NewOauthAuthorizationCodeRepository ...
+func NewOauthAuthorizationCodeRepository(dbPool *sql.DB) OauthAuthorizationCodeProvider {
+	return &OauthAuthorizationCodeRepository{
+		OauthAuthorizationCodeRepositoryBase: OauthAuthorizationCodeRepositoryBase{
+			DB:      dbPool,
+			Table:   TableOauthAuthorizationCode,
+			Columns: TableOauthAuthorizationCodeColumns,
+		},
+	}
+}
+
+// Consume implements OauthAuthorizationCodeProvider interface.
+func (oacr *OauthAuthorizationCodeRepository) Consume(ctx context.Context, code string) (*OauthAuthorizationCodeEntity, error) {
+	query := `
+		DELETE FROM ` + oacr.Table + `
+		WHERE ` + TableOauthAuthorizationCodeColumnCode + ` = $1
+		RETURNING ` + strings.Join(TableOauthAuthorizationCodeColumns, ",")
+
+	rows, err := conn(ctx, oacr.DB).QueryContext(ctx, query, code)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ents, err := ScanOauthAuthorizationCodeRows(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(ents) == 0 || !ents[0].ExpireAt.After(time.Now()) {
+		return nil, sql.ErrNoRows
+	}
+	return ents[0], nil
+}
+
+// DeleteExpired implements OauthAuthorizationCodeProvider interface.
+func (oacr *OauthAuthorizationCodeRepository) DeleteExpired(ctx context.Context) (int64, error) {
+	res, err := conn(ctx, oacr.DB).ExecContext(ctx, `DELETE FROM `+oacr.Table+` WHERE `+TableOauthAuthorizationCodeColumnExpireAt+` <= NOW()`)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// DeleteByUserID implements OauthAuthorizationCodeProvider interface.
+func (oacr *OauthAuthorizationCodeRepository) DeleteByUserID(ctx context.Context, userID int64) (int64, error) {
+	return deleteBy(ctx, oacr.DB, oacr.Table, TableOauthAuthorizationCodeColumnUserID, userID)
+}
+
+// DeleteByClientID implements OauthAuthorizationCodeProvider interface.
+func (oacr *OauthAuthorizationCodeRepository) DeleteByClientID(ctx context.Context, clientID int64) (int64, error) {
+	return deleteBy(ctx, oacr.DB, oacr.Table, TableOauthAuthorizationCodeColumnClientID, clientID)
+}
+
+// Scopes splits space separated list of scopes the code grants access to.
+func (e *OauthAuthorizationCodeEntity) Scopes() []string {
+	return strings.Fields(e.Scope)
+}
+
+// deleteBy removes all rows of the table with given value of the column, it takes part in a transaction carried by the context.
+func deleteBy(ctx context.Context, db *sql.DB, table, column string, value interface{}) (int64, error) {
+	res, err := conn(ctx, db).ExecContext(ctx, `DELETE FROM `+table+` WHERE `+column+` = $1`, value)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
@@ -0,0 +1,87 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+)
+
+func TestOauthRepositories(t *testing.T) {
+	suite := &postgresSuite{}
+	suite.setup(t)
+	defer suite.teardown(t)
+
+	ctx := context.TODO()
+	usr, err := suite.repository.user.Create(ctx, &UserEntity{
+		Username:  "oauth@example.com",
+		Password:  []byte("password"),
+		FirstName: "first_name",
+		LastName:  "last_name",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	client, err := suite.repository.oauthClient.Insert(ctx, &OauthClientEntity{
+		ClientID:     "client",
+		Name:         "Client",
+		RedirectUris: "https://example.com/callback https://example.com/other",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if !client.IsPublic() || len(client.RedirectURIs()) != 2 {
+		t.Errorf("unexpected client: %#v", client)
+	}
+
+	if _, err = suite.repository.oauthConsent.Grant(ctx, usr.ID, client.ID, "openid"); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if _, err = suite.repository.oauthConsent.Grant(ctx, usr.ID, client.ID, "openid profile"); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	consent, err := suite.repository.oauthConsent.FindOneByUserIDAndClientID(ctx, usr.ID, client.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if consent.Scope != "openid profile" {
+		t.Errorf("consent should be replaced, got scope: %s", consent.Scope)
+	}
+
+	insert := func(code string, expireAt time.Time) {
+		if _, err := suite.repository.oauthCode.Insert(ctx, &OauthAuthorizationCodeEntity{
+			Code:        code,
+			ClientID:    client.ID,
+			UserID:      usr.ID,
+			RedirectURI: "https://example.com/callback",
+			Scope:       "openid",
+			AuthTime:    time.Now(),
+			ExpireAt:    expireAt,
+		}); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+	}
+	insert("valid", time.Now().Add(time.Minute))
+	insert("expired", time.Now().Add(-time.Minute))
+	insert("other", time.Now().Add(time.Minute))
+
+	if _, err = suite.repository.oauthCode.Consume(ctx, "valid"); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if _, err = suite.repository.oauthCode.Consume(ctx, "valid"); err != sql.ErrNoRows {
+		t.Errorf("code should not be consumed twice, got: %v", err)
+	}
+	if _, err = suite.repository.oauthCode.Consume(ctx, "expired"); err != sql.ErrNoRows {
+		t.Errorf("expired code should not be consumed, got: %v", err)
+	}
+
+	if affected, err := suite.repository.oauthCode.DeleteByClientID(ctx, client.ID); err != nil || affected != 1 {
+		t.Errorf("expected single code to be removed, got %d (%v)", affected, err)
+	}
+	if affected, err := suite.repository.oauthConsent.DeleteByUserID(ctx, usr.ID); err != nil || affected != 1 {
+		t.Errorf("expected single consent to be removed, got %d (%v)", affected, err)
+	}
+	if affected, err := suite.repository.oauthClient.DeleteOneByID(ctx, client.ID); err != nil || affected != 1 {
+		t.Errorf("expected client to be removed, got %d (%v)", affected, err)
+	}
+}
@@ -20,10 +20,16 @@ func (pe *PermissionEntity) Permission() charon.Permission {
 // PermissionProvider ...
 type PermissionProvider interface {
 	Find(ctx context.Context, criteria *PermissionFindExpr) ([]*PermissionEntity, error)
+	// Count retrieves number of permissions that match given expression.
+	Count(ctx context.Context, exp *PermissionCountExpr) (int64, error)
 	FindOneByID(ctx context.Context, id int64) (entity *PermissionEntity, err error)
-	// FindByUserID retrieves all permissions for user represented by given id.
+	// FindByUserID retrieves all permissions for user represented by given id,
+	// including permissions granted to groups the user belongs to and to their parents.
+	// Denials are not included, they can be retrieved using FindDeniedByUserID.
 	FindByUserID(ctx context.Context, userID int64) (entities []*PermissionEntity, err error)
-	// FindByGroupID retrieves all permissions for group represented by given id.
+	// FindDeniedByUserID works like FindByUserID, but it retrieves explicitly denied permissions.
+	FindDeniedByUserID(ctx context.Context, userID int64) (entities []*PermissionEntity, err error)
+	// FindByGroupID retrieves all permissions granted to group represented by given id.
 	FindByGroupID(ctx context.Context, groupID int64) (entities []*PermissionEntity, err error)
 	Register(ctx context.Context, permissions charon.Permissions) (created, untouched, removed int64, err error)
 	Insert(ctx context.Context, entity *PermissionEntity) (*PermissionEntity, error)
@@ -44,7 +50,8 @@ func NewPermissionRepository(dbPool *sql.DB) *PermissionRepository {
 			Table:   TablePermission,
 			Columns: TablePermissionColumns,
 		},
-		findByUserIDQuery: `SELECT DISTINCT ON (p.id)
+		findByUserIDQuery: memberOfQuery + `
+		SELECT DISTINCT ON (p.id)
 			` + columns(TablePermissionColumns, "p") + `
 		FROM ` + TableUserPermissions + ` AS up
 		LEFT JOIN ` + TablePermission + ` AS p
@@ -52,16 +59,17 @@ func NewPermissionRepository(dbPool *sql.DB) *PermissionRepository {
 			AND up.` + TableUserPermissionsColumnPermissionModule + ` = p.` + TablePermissionColumnModule + `
 			AND up.` + TableUserPermissionsColumnPermissionAction + ` = p.` + TablePermissionColumnAction + `
 		WHERE up.` + TableUserPermissionsColumnUserID + ` = $1
+			AND up.` + TableUserPermissionsColumnDenied + ` = $2
+			AND ` + activeLinkClause("up", TableUserPermissionsColumnValidFrom, TableUserPermissionsColumnValidUntil) + `
 		UNION
 		SELECT DISTINCT ON (p.id) ` + columns(TablePermissionColumns, "p") + `
-		FROM ` + TableUserGroups + ` AS ug
-		INNER JOIN ` + TableGroupPermissions + ` AS gp ON ug.` + TableUserGroupsColumnGroupID + ` = gp.` + TableGroupPermissionsColumnGroupID + `
+		FROM member_of AS mo
+		INNER JOIN ` + TableGroupPermissions + ` AS gp ON mo.group_id = gp.` + TableGroupPermissionsColumnGroupID + `
 		LEFT JOIN ` + TablePermission + ` as p
 			ON gp.` + TableGroupPermissionsColumnPermissionSubsystem + ` = p.` + TablePermissionColumnSubsystem + `
 			AND gp.` + TableGroupPermissionsColumnPermissionModule + ` = p.` + TablePermissionColumnModule + `
 			AND gp.` + TableGroupPermissionsColumnPermissionAction + ` = p.` + TablePermissionColumnAction + `
-			AND gp.` + TableGroupPermissionsColumnGroupID + ` = ug.` + TableUserGroupsColumnGroupID + `
-		WHERE ug.` + TableUserGroupsColumnUserID + ` = $1
+		WHERE gp.` + TableGroupPermissionsColumnDenied + ` = $2
 	`,
 	}
 }
@@ -69,7 +77,12 @@ func NewPermissionRepository(dbPool *sql.DB) *PermissionRepository {
 // FindByUserID implements PermissionProvider interface.
 func (pr *PermissionRepository) FindByUserID(ctx context.Context, userID int64) ([]*PermissionEntity, error) {
 	// TODO: does it work?
-	return pr.FindBy(ctx, pr.findByUserIDQuery, userID)
+	return pr.FindBy(ctx, pr.findByUserIDQuery, userID, false)
+}
+
+// FindDeniedByUserID implements PermissionProvider interface.
+func (pr *PermissionRepository) FindDeniedByUserID(ctx context.Context, userID int64) ([]*PermissionEntity, error) {
+	return pr.FindBy(ctx, pr.findByUserIDQuery, userID, true)
 }
 
 // FindByGroupID implements PermissionProvider interface.
@@ -83,13 +96,13 @@ func (pr *PermissionRepository) FindByGroupID(ctx context.Context, groupID int64
 			ON gp.permission_subsystem = p.subsystem
 			AND gp.permission_module = p.module
 			AND gp.permission_action = p.action
-		WHERE gp.group_id = $1
+		WHERE gp.group_id = $1 AND gp.denied = FALSE
 	`, groupID)
 }
 
 // FindBy ...
 func (pr *PermissionRepository) FindBy(ctx context.Context, query string, args ...interface{}) ([]*PermissionEntity, error) {
-	rows, err := pr.DB.QueryContext(ctx, query, args...)
+	rows, err := conn(ctx, pr.DB).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -153,12 +166,17 @@ var (
 	ErrEmptySliceOfPermissions = errors.New("empty slice, permissions cannot be registered")
 	ErrEmptySubsystem          = errors.New("subsystem name is empty string, permissions cannot be registered")
 	ErrorInconsistentSubsystem = errors.New("provided permissions do not belong to one subsystem, permissions cannot be registered")
+	// ErrPermissionGrantedAndDenied is returned if the same permission is meant to be granted and denied at once.
+	ErrPermissionGrantedAndDenied = errors.New("permission cannot be granted and denied at the same time")
+	// ErrPermissionNotFound is returned if given permission is not registered.
+	ErrPermissionNotFound = errors.New("permission does not exist")
 )
 
 // Register ...
 func (pr *PermissionRepository) Register(ctx context.Context, permissions charon.Permissions) (created, unt, removed int64, err error) {
 	var (
 		tx             *sql.Tx
+		end            func(error) error
 		insert, delete *sql.Stmt
 		rows           *sql.Rows
 		res            sql.Result
@@ -181,15 +199,12 @@ func (pr *PermissionRepository) Register(ctx context.Context, permissions charon
 		}
 	}
 
-	tx, err = pr.DB.BeginTx(ctx, nil)
+	tx, end, err = beginTx(ctx, pr.DB)
 	if err != nil {
 		return
 	}
 	defer func() {
-		if err != nil {
-			tx.Rollback()
-		} else {
-			err = tx.Commit()
+		if err = end(err); err == nil {
 			unt = untouched(int64(len(permissions)), created, removed)
 		}
 	}()
@@ -249,6 +264,10 @@ MissingPermissionsLoop:
 
 RedundantPermissionsLoop:
 	for _, e := range entities {
+		// Wildcards are granted by administrators, not declared by subsystems, so registration leaves them intact.
+		if e.Permission().IsWildcard() {
+			continue RedundantPermissionsLoop
+		}
 		for _, p := range permissions {
 			if e.Permission() == p {
 				continue RedundantPermissionsLoop
@@ -336,7 +355,7 @@ func (pr *PermissionRepository) FindByTag(ctx context.Context, userID int64) ([]
 		WHERE up.user_id = $1 OR ug.user_id = $1
 	`
 
-	rows, err := pr.DB.QueryContext(ctx, query, userID)
+	rows, err := conn(ctx, pr.DB).QueryContext(ctx, query, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -105,6 +105,27 @@ func TestPermissionRepository_Register(t *testing.T) {
 	}
 }
 
+func TestPermissionRepository_Register_wildcard(t *testing.T) {
+	suite := &postgresSuite{}
+	suite.setup(t)
+	defer suite.teardown(t)
+
+	ctx := context.TODO()
+	if _, err := suite.repository.permission.InsertMissing(ctx, charon.Permissions{"forumservice:comment:*"}); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	created, _, removed, err := suite.repository.permission.Register(ctx, charon.Permissions{"forumservice:comment:can create"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if created != 1 {
+		t.Errorf("expected one permission to be created, got %d", created)
+	}
+	if removed != 0 {
+		t.Errorf("wildcard permission should not be removed, got %d removed", removed)
+	}
+}
+
 type permissionFixtures struct {
 	got, given PermissionEntity
 }
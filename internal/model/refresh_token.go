@@ -3,20 +3,32 @@ package model
 import (
 	"context"
 	"database/sql"
+	"time"
 )
 
 // RefreshTokenProvider ...
 type RefreshTokenProvider interface {
 	// Find ...
 	Find(context.Context, *RefreshTokenFindExpr) ([]*RefreshTokenEntity, error)
-	// FindOneByToken ...
-	FindOneByToken(context.Context, string) (*RefreshTokenEntity, error)
+	// Count retrieves number of refresh tokens that match given expression.
+	Count(context.Context, *RefreshTokenCountExpr) (int64, error)
+	// FindOneByPrefix ...
+	FindOneByPrefix(context.Context, string) (*RefreshTokenEntity, error)
 	// Create ...
 	Create(context.Context, *RefreshTokenEntity) (*RefreshTokenEntity, error)
-	// UpdateOneByToken ...
-	UpdateOneByToken(context.Context, string, *RefreshTokenPatch) (*RefreshTokenEntity, error)
-	// FindOneByTokenAndUserID .
-	FindOneByTokenAndUserID(ctx context.Context, token string, userID int64) (*RefreshTokenEntity, error)
+	// UpdateOneByPrefix ...
+	UpdateOneByPrefix(context.Context, string, *RefreshTokenPatch) (*RefreshTokenEntity, error)
+	// FindOneByPrefixAndUserID .
+	FindOneByPrefixAndUserID(ctx context.Context, prefix string, userID int64) (*RefreshTokenEntity, error)
+	// MarkRotated marks token as replaced by its successor, unless it was done already.
+	// It returns number of affected rows, zero means that the token was used more than once.
+	MarkRotated(ctx context.Context, prefix string) (int64, error)
+	// RevokeFamily revokes all tokens of given family and returns their prefixes.
+	RevokeFamily(ctx context.Context, family string) ([]string, error)
+	// RevokeMany revokes tokens that match given criteria and are not revoked yet, it returns their prefixes.
+	RevokeMany(ctx context.Context, criteria *RefreshTokenCriteria) ([]string, error)
+	// DeleteByUserID ...
+	DeleteByUserID(ctx context.Context, userID int64) (int64, error)
 }
 
 // RefreshTokenRepository extends RefreshTokenRepositoryBase
@@ -40,9 +52,9 @@ func (rtr *RefreshTokenRepository) Create(ctx context.Context, ent *RefreshToken
 	return rtr.Insert(ctx, ent)
 }
 
-// FindOneByTokenAndUserID ...
-func (rtr *RefreshTokenRepository) FindOneByTokenAndUserID(ctx context.Context, token string, userID int64) (*RefreshTokenEntity, error) {
-	ent, err := rtr.FindOneByToken(ctx, token)
+// FindOneByPrefixAndUserID ...
+func (rtr *RefreshTokenRepository) FindOneByPrefixAndUserID(ctx context.Context, prefix string, userID int64) (*RefreshTokenEntity, error) {
+	ent, err := rtr.FindOneByPrefix(ctx, prefix)
 	if err != nil {
 		return nil, err
 	}
@@ -51,3 +63,84 @@ func (rtr *RefreshTokenRepository) FindOneByTokenAndUserID(ctx context.Context,
 	}
 	return ent, nil
 }
+
+// Insert takes part in a transaction carried by the context.
+func (rtr *RefreshTokenRepository) Insert(ctx context.Context, ent *RefreshTokenEntity) (*RefreshTokenEntity, error) {
+	return rtr.insert(ctx, txFromContext(ctx), ent)
+}
+
+// FindOneByPrefix implements RefreshTokenProvider interface, it takes part in a transaction carried by the context.
+func (rtr *RefreshTokenRepository) FindOneByPrefix(ctx context.Context, prefix string) (*RefreshTokenEntity, error) {
+	return rtr.findOneByPrefix(ctx, txFromContext(ctx), prefix)
+}
+
+// UpdateOneByPrefix implements RefreshTokenProvider interface, it takes part in a transaction carried by the context.
+func (rtr *RefreshTokenRepository) UpdateOneByPrefix(ctx context.Context, prefix string, patch *RefreshTokenPatch) (*RefreshTokenEntity, error) {
+	return rtr.updateOneByPrefix(ctx, txFromContext(ctx), prefix, patch)
+}
+
+// MarkRotated implements RefreshTokenProvider interface, it takes part in a transaction carried by the context.
+func (rtr *RefreshTokenRepository) MarkRotated(ctx context.Context, prefix string) (int64, error) {
+	res, err := conn(ctx, rtr.DB).ExecContext(ctx, `
+		UPDATE `+rtr.Table+`
+		SET `+TableRefreshTokenColumnRotatedAt+` = $2, `+TableRefreshTokenColumnLastUsedAt+` = $2
+		WHERE `+TableRefreshTokenColumnPrefix+` = $1 AND `+TableRefreshTokenColumnRotatedAt+` IS NULL
+	`, prefix, time.Now())
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// RevokeFamily implements RefreshTokenProvider interface, it takes part in a transaction carried by the context.
+func (rtr *RefreshTokenRepository) RevokeFamily(ctx context.Context, family string) ([]string, error) {
+	rows, err := conn(ctx, rtr.DB).QueryContext(ctx, `
+		UPDATE `+rtr.Table+`
+		SET `+TableRefreshTokenColumnRevoked+` = TRUE
+		WHERE `+TableRefreshTokenColumnFamily+` = $1
+		RETURNING `+TableRefreshTokenColumnPrefix, family)
+	if err != nil {
+		return nil, err
+	}
+	return scanPrefixes(rows)
+}
+
+// RevokeMany implements RefreshTokenProvider interface, it takes part in a transaction carried by the context.
+func (rtr *RefreshTokenRepository) RevokeMany(ctx context.Context, criteria *RefreshTokenCriteria) ([]string, error) {
+	comp := NewComposer(1)
+	if err := RefreshTokenCriteriaWhereClause(comp, criteria, 0); err != nil {
+		return nil, err
+	}
+	query := `
+		UPDATE ` + rtr.Table + ` AS t0
+		SET ` + TableRefreshTokenColumnRevoked + ` = TRUE
+		WHERE NOT t0.` + TableRefreshTokenColumnRevoked
+	if comp.Dirty {
+		query += ` AND (` + comp.String() + `)`
+	}
+	rows, err := conn(ctx, rtr.DB).QueryContext(ctx, query+`
+		RETURNING t0.`+TableRefreshTokenColumnPrefix, comp.Args()...)
+	if err != nil {
+		return nil, err
+	}
+	return scanPrefixes(rows)
+}
+
+func scanPrefixes(rows *sql.Rows) ([]string, error) {
+	defer rows.Close()
+
+	var prefixes []string
+	for rows.Next() {
+		var prefix string
+		if err := rows.Scan(&prefix); err != nil {
+			return nil, err
+		}
+		prefixes = append(prefixes, prefix)
+	}
+	return prefixes, rows.Err()
+}
+
+// DeleteByUserID implements RefreshTokenProvider interface.
+func (rtr *RefreshTokenRepository) DeleteByUserID(ctx context.Context, userID int64) (int64, error) {
+	return deleteBy(ctx, rtr.DB, rtr.Table, TableRefreshTokenColumnUserID, userID)
+}
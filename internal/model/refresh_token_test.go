@@ -0,0 +1,141 @@
+package model
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/piotrkowalczuk/ntypes"
+	"github.com/piotrkowalczuk/qtypes"
+)
+
+func TestRefreshTokenRepository_rotation(t *testing.T) {
+	suite := &postgresSuite{}
+	suite.setup(t)
+	defer suite.teardown(t)
+
+	ctx := context.TODO()
+	usr, err := suite.repository.user.Create(ctx, &UserEntity{
+		Username:  "john@example.com",
+		Password:  []byte("password"),
+		FirstName: "first_name",
+		LastName:  "last_name",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	for _, prefix := range []string{"first", "second"} {
+		if _, err = suite.repository.refreshToken.Create(ctx, &RefreshTokenEntity{
+			Prefix: prefix,
+			Secret: []byte("secret"),
+			Family: "first",
+			UserID: usr.ID,
+		}); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+	}
+	if _, err = suite.repository.refreshToken.Create(ctx, &RefreshTokenEntity{
+		Prefix: "first",
+		Secret: []byte("other"),
+		Family: "first",
+		UserID: usr.ID,
+	}); ErrorConstraint(err) != TableRefreshTokenConstraintPrefixUnique {
+		t.Errorf("unique constraint violation expected, got: %v", err)
+	}
+
+	// Only the first attempt succeeds, the second one means that the token was used twice.
+	for _, exp := range []int64{1, 0} {
+		got, err := suite.repository.refreshToken.MarkRotated(ctx, "first")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		if got != exp {
+			t.Errorf("wrong number of affected rows, expected %d but got %d", exp, got)
+		}
+	}
+	ent, err := suite.repository.refreshToken.FindOneByPrefix(ctx, "first")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if !ent.RotatedAt.Valid || !ent.LastUsedAt.Valid {
+		t.Errorf("rotated token should have rotated at and last used at set: %#v", ent)
+	}
+
+	revoked, err := suite.repository.refreshToken.RevokeFamily(ctx, "first")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	sort.Strings(revoked)
+	if len(revoked) != 2 || revoked[0] != "first" || revoked[1] != "second" {
+		t.Errorf("wrong revoked tokens: %v", revoked)
+	}
+	if ent, err = suite.repository.refreshToken.FindOneByPrefix(ctx, "second"); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if !ent.Revoked {
+		t.Error("successor should be revoked as well")
+	}
+}
+
+func TestRefreshTokenRepository_RevokeMany(t *testing.T) {
+	suite := &postgresSuite{}
+	suite.setup(t)
+	defer suite.teardown(t)
+
+	ctx := context.TODO()
+	var users []*UserEntity
+	for _, username := range []string{"john@example.com", "jane@example.com"} {
+		usr, err := suite.repository.user.Create(ctx, &UserEntity{
+			Username:  username,
+			Password:  []byte("password"),
+			FirstName: "first_name",
+			LastName:  "last_name",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		users = append(users, usr)
+	}
+	for i, prefix := range []string{"john-ci", "john-cli", "jane-ci"} {
+		if _, err := suite.repository.refreshToken.Create(ctx, &RefreshTokenEntity{
+			Prefix: prefix,
+			Secret: []byte("secret"),
+			Family: prefix,
+			Notes:  ntypes.String{Chars: strings.Split(prefix, "-")[1], Valid: true},
+			UserID: users[i/2].ID,
+		}); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+	}
+
+	revoked, err := suite.repository.refreshToken.RevokeMany(ctx, &RefreshTokenCriteria{
+		UserID: qtypes.EqualInt64(users[0].ID),
+		Notes:  qtypes.EqualString("ci"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(revoked) != 1 || revoked[0] != "john-ci" {
+		t.Errorf("wrong revoked tokens: %v", revoked)
+	}
+
+	// Tokens revoked already are not returned again.
+	revoked, err = suite.repository.refreshToken.RevokeMany(ctx, &RefreshTokenCriteria{
+		UserID: qtypes.EqualInt64(users[0].ID),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(revoked) != 1 || revoked[0] != "john-cli" {
+		t.Errorf("wrong revoked tokens: %v", revoked)
+	}
+
+	ent, err := suite.repository.refreshToken.FindOneByPrefix(ctx, "jane-ci")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if ent.Revoked {
+		t.Error("token of another user should not be revoked")
+	}
+}
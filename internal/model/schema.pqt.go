@@ -107,37 +107,61 @@ const (
 )
 
 const (
-	TableUser                        = "charon.user"
-	TableUserColumnConfirmationToken = "confirmation_token"
-	TableUserColumnCreatedAt         = "created_at"
-	TableUserColumnCreatedBy         = "created_by"
-	TableUserColumnFirstName         = "first_name"
-	TableUserColumnID                = "id"
-	TableUserColumnIsActive          = "is_active"
-	TableUserColumnIsConfirmed       = "is_confirmed"
-	TableUserColumnIsStaff           = "is_staff"
-	TableUserColumnIsSuperuser       = "is_superuser"
-	TableUserColumnLastLoginAt       = "last_login_at"
-	TableUserColumnLastName          = "last_name"
-	TableUserColumnPassword          = "password"
-	TableUserColumnUpdatedAt         = "updated_at"
-	TableUserColumnUpdatedBy         = "updated_by"
-	TableUserColumnUsername          = "username"
+	TableUser                                 = "charon.user"
+	TableUserColumnConfirmationToken          = "confirmation_token"
+	TableUserColumnConfirmationTokenExpireAt  = "confirmation_token_expire_at"
+	TableUserColumnCreatedAt                  = "created_at"
+	TableUserColumnCreatedBy                  = "created_by"
+	TableUserColumnDeletedAt                  = "deleted_at"
+	TableUserColumnFirstName                  = "first_name"
+	TableUserColumnID                         = "id"
+	TableUserColumnIsActive                   = "is_active"
+	TableUserColumnIsConfirmed                = "is_confirmed"
+	TableUserColumnIsServiceAccount           = "is_service_account"
+	TableUserColumnIsStaff                    = "is_staff"
+	TableUserColumnIsSuperuser                = "is_superuser"
+	TableUserColumnLastLoginAt                = "last_login_at"
+	TableUserColumnLastName                   = "last_name"
+	TableUserColumnLockedAt                   = "locked_at"
+	TableUserColumnPassword                   = "password"
+	TableUserColumnPasswordResetToken         = "password_reset_token"
+	TableUserColumnPasswordResetTokenExpireAt = "password_reset_token_expire_at"
+	TableUserColumnTwoFactorChallenge         = "two_factor_challenge"
+	TableUserColumnTwoFactorChallengeExpireAt = "two_factor_challenge_expire_at"
+	TableUserColumnTwoFactorConfirmedAt       = "two_factor_confirmed_at"
+	TableUserColumnTwoFactorLastStep          = "two_factor_last_step"
+	TableUserColumnTwoFactorRecoveryCodes     = "two_factor_recovery_codes"
+	TableUserColumnTwoFactorSecret            = "two_factor_secret"
+	TableUserColumnUpdatedAt                  = "updated_at"
+	TableUserColumnUpdatedBy                  = "updated_by"
+	TableUserColumnUsername                   = "username"
 )
 
 var TableUserColumns = []string{
 	TableUserColumnConfirmationToken,
+	TableUserColumnConfirmationTokenExpireAt,
 	TableUserColumnCreatedAt,
 	TableUserColumnCreatedBy,
+	TableUserColumnDeletedAt,
 	TableUserColumnFirstName,
 	TableUserColumnID,
 	TableUserColumnIsActive,
 	TableUserColumnIsConfirmed,
+	TableUserColumnIsServiceAccount,
 	TableUserColumnIsStaff,
 	TableUserColumnIsSuperuser,
 	TableUserColumnLastLoginAt,
 	TableUserColumnLastName,
+	TableUserColumnLockedAt,
 	TableUserColumnPassword,
+	TableUserColumnPasswordResetToken,
+	TableUserColumnPasswordResetTokenExpireAt,
+	TableUserColumnTwoFactorChallenge,
+	TableUserColumnTwoFactorChallengeExpireAt,
+	TableUserColumnTwoFactorConfirmedAt,
+	TableUserColumnTwoFactorLastStep,
+	TableUserColumnTwoFactorRecoveryCodes,
+	TableUserColumnTwoFactorSecret,
 	TableUserColumnUpdatedAt,
 	TableUserColumnUpdatedBy,
 	TableUserColumnUsername,
@@ -147,10 +171,14 @@ var TableUserColumns = []string{
 type UserEntity struct {
 	// ConfirmationToken ...
 	ConfirmationToken []byte
+	// ConfirmationTokenExpireAt ...
+	ConfirmationTokenExpireAt pq.NullTime
 	// CreatedAt ...
 	CreatedAt time.Time
 	// CreatedBy ...
 	CreatedBy ntypes.Int64
+	// DeletedAt ...
+	DeletedAt pq.NullTime
 	// FirstName ...
 	FirstName string
 	// ID ...
@@ -159,6 +187,8 @@ type UserEntity struct {
 	IsActive bool
 	// IsConfirmed ...
 	IsConfirmed bool
+	// IsServiceAccount ...
+	IsServiceAccount bool
 	// IsStaff ...
 	IsStaff bool
 	// IsSuperuser ...
@@ -167,8 +197,26 @@ type UserEntity struct {
 	LastLoginAt pq.NullTime
 	// LastName ...
 	LastName string
+	// LockedAt ...
+	LockedAt pq.NullTime
 	// Password ...
 	Password []byte
+	// PasswordResetToken ...
+	PasswordResetToken []byte
+	// PasswordResetTokenExpireAt ...
+	PasswordResetTokenExpireAt pq.NullTime
+	// TwoFactorChallenge ...
+	TwoFactorChallenge []byte
+	// TwoFactorChallengeExpireAt ...
+	TwoFactorChallengeExpireAt pq.NullTime
+	// TwoFactorConfirmedAt ...
+	TwoFactorConfirmedAt pq.NullTime
+	// TwoFactorLastStep ...
+	TwoFactorLastStep ntypes.Int64
+	// TwoFactorRecoveryCodes ...
+	TwoFactorRecoveryCodes []byte
+	// TwoFactorSecret ...
+	TwoFactorSecret []byte
 	// UpdatedAt ...
 	UpdatedAt pq.NullTime
 	// UpdatedBy ...
@@ -190,10 +238,14 @@ func (e *UserEntity) Prop(cn string) (interface{}, bool) {
 
 	case TableUserColumnConfirmationToken:
 		return &e.ConfirmationToken, true
+	case TableUserColumnConfirmationTokenExpireAt:
+		return &e.ConfirmationTokenExpireAt, true
 	case TableUserColumnCreatedAt:
 		return &e.CreatedAt, true
 	case TableUserColumnCreatedBy:
 		return &e.CreatedBy, true
+	case TableUserColumnDeletedAt:
+		return &e.DeletedAt, true
 	case TableUserColumnFirstName:
 		return &e.FirstName, true
 	case TableUserColumnID:
@@ -202,6 +254,8 @@ func (e *UserEntity) Prop(cn string) (interface{}, bool) {
 		return &e.IsActive, true
 	case TableUserColumnIsConfirmed:
 		return &e.IsConfirmed, true
+	case TableUserColumnIsServiceAccount:
+		return &e.IsServiceAccount, true
 	case TableUserColumnIsStaff:
 		return &e.IsStaff, true
 	case TableUserColumnIsSuperuser:
@@ -210,8 +264,26 @@ func (e *UserEntity) Prop(cn string) (interface{}, bool) {
 		return &e.LastLoginAt, true
 	case TableUserColumnLastName:
 		return &e.LastName, true
+	case TableUserColumnLockedAt:
+		return &e.LockedAt, true
 	case TableUserColumnPassword:
 		return &e.Password, true
+	case TableUserColumnPasswordResetToken:
+		return &e.PasswordResetToken, true
+	case TableUserColumnPasswordResetTokenExpireAt:
+		return &e.PasswordResetTokenExpireAt, true
+	case TableUserColumnTwoFactorChallenge:
+		return &e.TwoFactorChallenge, true
+	case TableUserColumnTwoFactorChallengeExpireAt:
+		return &e.TwoFactorChallengeExpireAt, true
+	case TableUserColumnTwoFactorConfirmedAt:
+		return &e.TwoFactorConfirmedAt, true
+	case TableUserColumnTwoFactorLastStep:
+		return &e.TwoFactorLastStep, true
+	case TableUserColumnTwoFactorRecoveryCodes:
+		return &e.TwoFactorRecoveryCodes, true
+	case TableUserColumnTwoFactorSecret:
+		return &e.TwoFactorSecret, true
 	case TableUserColumnUpdatedAt:
 		return &e.UpdatedAt, true
 	case TableUserColumnUpdatedBy:
@@ -244,17 +316,29 @@ func ScanUserRows(rows Rows) (entities []*UserEntity, err error) {
 		var ent UserEntity
 		err = rows.Scan(
 			&ent.ConfirmationToken,
+			&ent.ConfirmationTokenExpireAt,
 			&ent.CreatedAt,
 			&ent.CreatedBy,
+			&ent.DeletedAt,
 			&ent.FirstName,
 			&ent.ID,
 			&ent.IsActive,
 			&ent.IsConfirmed,
+			&ent.IsServiceAccount,
 			&ent.IsStaff,
 			&ent.IsSuperuser,
 			&ent.LastLoginAt,
 			&ent.LastName,
+			&ent.LockedAt,
 			&ent.Password,
+			&ent.PasswordResetToken,
+			&ent.PasswordResetTokenExpireAt,
+			&ent.TwoFactorChallenge,
+			&ent.TwoFactorChallengeExpireAt,
+			&ent.TwoFactorConfirmedAt,
+			&ent.TwoFactorLastStep,
+			&ent.TwoFactorRecoveryCodes,
+			&ent.TwoFactorSecret,
 			&ent.UpdatedAt,
 			&ent.UpdatedBy,
 			&ent.Username,
@@ -341,23 +425,35 @@ func (i *UserIterator) User() (*UserEntity, error) {
 }
 
 type UserCriteria struct {
-	ConfirmationToken      []byte
-	CreatedAt              *qtypes.Timestamp
-	CreatedBy              *qtypes.Int64
-	FirstName              *qtypes.String
-	ID                     *qtypes.Int64
-	IsActive               ntypes.Bool
-	IsConfirmed            ntypes.Bool
-	IsStaff                ntypes.Bool
-	IsSuperuser            ntypes.Bool
-	LastLoginAt            *qtypes.Timestamp
-	LastName               *qtypes.String
-	Password               []byte
-	UpdatedAt              *qtypes.Timestamp
-	UpdatedBy              *qtypes.Int64
-	Username               *qtypes.String
-	operator               string
-	child, sibling, parent *UserCriteria
+	ConfirmationToken          []byte
+	ConfirmationTokenExpireAt  *qtypes.Timestamp
+	CreatedAt                  *qtypes.Timestamp
+	CreatedBy                  *qtypes.Int64
+	DeletedAt                  *qtypes.Timestamp
+	FirstName                  *qtypes.String
+	ID                         *qtypes.Int64
+	IsActive                   ntypes.Bool
+	IsConfirmed                ntypes.Bool
+	IsServiceAccount           ntypes.Bool
+	IsStaff                    ntypes.Bool
+	IsSuperuser                ntypes.Bool
+	LastLoginAt                *qtypes.Timestamp
+	LastName                   *qtypes.String
+	LockedAt                   *qtypes.Timestamp
+	Password                   []byte
+	PasswordResetToken         []byte
+	PasswordResetTokenExpireAt *qtypes.Timestamp
+	TwoFactorChallenge         []byte
+	TwoFactorChallengeExpireAt *qtypes.Timestamp
+	TwoFactorConfirmedAt       *qtypes.Timestamp
+	TwoFactorLastStep          *qtypes.Int64
+	TwoFactorRecoveryCodes     []byte
+	TwoFactorSecret            []byte
+	UpdatedAt                  *qtypes.Timestamp
+	UpdatedBy                  *qtypes.Int64
+	Username                   *qtypes.String
+	operator                   string
+	child, sibling, parent     *UserCriteria
 }
 
 func UserOperand(operator string, operands ...*UserCriteria) *UserCriteria {
@@ -412,20 +508,32 @@ type UserCountExpr struct {
 }
 
 type UserPatch struct {
-	ConfirmationToken []byte
-	CreatedAt         pq.NullTime
-	CreatedBy         ntypes.Int64
-	FirstName         ntypes.String
-	IsActive          ntypes.Bool
-	IsConfirmed       ntypes.Bool
-	IsStaff           ntypes.Bool
-	IsSuperuser       ntypes.Bool
-	LastLoginAt       pq.NullTime
-	LastName          ntypes.String
-	Password          []byte
-	UpdatedAt         pq.NullTime
-	UpdatedBy         ntypes.Int64
-	Username          ntypes.String
+	ConfirmationToken          []byte
+	ConfirmationTokenExpireAt  pq.NullTime
+	CreatedAt                  pq.NullTime
+	CreatedBy                  ntypes.Int64
+	DeletedAt                  pq.NullTime
+	FirstName                  ntypes.String
+	IsActive                   ntypes.Bool
+	IsConfirmed                ntypes.Bool
+	IsServiceAccount           ntypes.Bool
+	IsStaff                    ntypes.Bool
+	IsSuperuser                ntypes.Bool
+	LastLoginAt                pq.NullTime
+	LastName                   ntypes.String
+	LockedAt                   pq.NullTime
+	Password                   []byte
+	PasswordResetToken         []byte
+	PasswordResetTokenExpireAt pq.NullTime
+	TwoFactorChallenge         []byte
+	TwoFactorChallengeExpireAt pq.NullTime
+	TwoFactorConfirmedAt       pq.NullTime
+	TwoFactorLastStep          ntypes.Int64
+	TwoFactorRecoveryCodes     []byte
+	TwoFactorSecret            []byte
+	UpdatedAt                  pq.NullTime
+	UpdatedBy                  ntypes.Int64
+	Username                   ntypes.String
 }
 
 type UserRepositoryBase struct {
@@ -461,7 +569,7 @@ func (r UserRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *U
 }
 
 func (r *UserRepositoryBase) InsertQuery(e *UserEntity, read bool) (string, []interface{}, error) {
-	insert := NewComposer(15)
+	insert := NewComposer(27)
 	columns := bytes.NewBuffer(nil)
 	buf := bytes.NewBufferString("INSERT INTO ")
 	buf.WriteString(r.Table)
@@ -487,6 +595,27 @@ func (r *UserRepositoryBase) InsertQuery(e *UserEntity, read bool) (string, []in
 		insert.Dirty = true
 	}
 
+	if e.ConfirmationTokenExpireAt.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableUserColumnConfirmationTokenExpireAt); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ConfirmationTokenExpireAt)
+		insert.Dirty = true
+	}
+
 	if !e.CreatedAt.IsZero() {
 		if columns.Len() > 0 {
 			if _, err := columns.WriteString(", "); err != nil {
@@ -527,6 +656,27 @@ func (r *UserRepositoryBase) InsertQuery(e *UserEntity, read bool) (string, []in
 	insert.Add(e.CreatedBy)
 	insert.Dirty = true
 
+	if e.DeletedAt.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableUserColumnDeletedAt); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.DeletedAt)
+		insert.Dirty = true
+	}
+
 	if columns.Len() > 0 {
 		if _, err := columns.WriteString(", "); err != nil {
 			return "", nil, err
@@ -584,6 +734,25 @@ func (r *UserRepositoryBase) InsertQuery(e *UserEntity, read bool) (string, []in
 	insert.Add(e.IsConfirmed)
 	insert.Dirty = true
 
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableUserColumnIsServiceAccount); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.IsServiceAccount)
+	insert.Dirty = true
+
 	if columns.Len() > 0 {
 		if _, err := columns.WriteString(", "); err != nil {
 			return "", nil, err
@@ -662,6 +831,27 @@ func (r *UserRepositoryBase) InsertQuery(e *UserEntity, read bool) (string, []in
 	insert.Add(e.LastName)
 	insert.Dirty = true
 
+	if e.LockedAt.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableUserColumnLockedAt); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.LockedAt)
+		insert.Dirty = true
+	}
+
 	if e.Password != nil {
 		if columns.Len() > 0 {
 			if _, err := columns.WriteString(", "); err != nil {
@@ -683,6 +873,172 @@ func (r *UserRepositoryBase) InsertQuery(e *UserEntity, read bool) (string, []in
 		insert.Dirty = true
 	}
 
+	if e.PasswordResetToken != nil {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableUserColumnPasswordResetToken); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.PasswordResetToken)
+		insert.Dirty = true
+	}
+
+	if e.PasswordResetTokenExpireAt.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableUserColumnPasswordResetTokenExpireAt); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.PasswordResetTokenExpireAt)
+		insert.Dirty = true
+	}
+
+	if e.TwoFactorChallenge != nil {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableUserColumnTwoFactorChallenge); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.TwoFactorChallenge)
+		insert.Dirty = true
+	}
+
+	if e.TwoFactorChallengeExpireAt.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableUserColumnTwoFactorChallengeExpireAt); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.TwoFactorChallengeExpireAt)
+		insert.Dirty = true
+	}
+
+	if e.TwoFactorConfirmedAt.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableUserColumnTwoFactorConfirmedAt); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.TwoFactorConfirmedAt)
+		insert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableUserColumnTwoFactorLastStep); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.TwoFactorLastStep)
+	insert.Dirty = true
+
+	if e.TwoFactorRecoveryCodes != nil {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableUserColumnTwoFactorRecoveryCodes); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.TwoFactorRecoveryCodes)
+		insert.Dirty = true
+	}
+
+	if e.TwoFactorSecret != nil {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableUserColumnTwoFactorSecret); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.TwoFactorSecret)
+		insert.Dirty = true
+	}
+
 	if e.UpdatedAt.Valid {
 		if columns.Len() > 0 {
 			if _, err := columns.WriteString(", "); err != nil {
@@ -753,7 +1109,7 @@ func (r *UserRepositoryBase) InsertQuery(e *UserEntity, read bool) (string, []in
 			if len(r.Columns) > 0 {
 				buf.WriteString(strings.Join(r.Columns, ", "))
 			} else {
-				buf.WriteString("confirmation_token, created_at, created_by, first_name, id, is_active, is_confirmed, is_staff, is_superuser, last_login_at, last_name, password, updated_at, updated_by, username")
+				buf.WriteString("confirmation_token, confirmation_token_expire_at, created_at, created_by, deleted_at, first_name, id, is_active, is_confirmed, is_service_account, is_staff, is_superuser, last_login_at, last_name, locked_at, password, password_reset_token, password_reset_token_expire_at, two_factor_challenge, two_factor_challenge_expire_at, two_factor_confirmed_at, two_factor_last_step, two_factor_recovery_codes, two_factor_secret, updated_at, updated_by, username")
 			}
 		}
 	}
@@ -774,17 +1130,29 @@ func (r *UserRepositoryBase) insert(ctx context.Context, tx *sql.Tx, e *UserEnti
 	}
 	err = row.Scan(
 		&e.ConfirmationToken,
+		&e.ConfirmationTokenExpireAt,
 		&e.CreatedAt,
 		&e.CreatedBy,
+		&e.DeletedAt,
 		&e.FirstName,
 		&e.ID,
 		&e.IsActive,
 		&e.IsConfirmed,
+		&e.IsServiceAccount,
 		&e.IsStaff,
 		&e.IsSuperuser,
 		&e.LastLoginAt,
 		&e.LastName,
+		&e.LockedAt,
 		&e.Password,
+		&e.PasswordResetToken,
+		&e.PasswordResetTokenExpireAt,
+		&e.TwoFactorChallenge,
+		&e.TwoFactorChallengeExpireAt,
+		&e.TwoFactorConfirmedAt,
+		&e.TwoFactorLastStep,
+		&e.TwoFactorRecoveryCodes,
+		&e.TwoFactorSecret,
 		&e.UpdatedAt,
 		&e.UpdatedBy,
 		&e.Username,
@@ -871,10 +1239,14 @@ func _UserCriteriaWhereClause(comp *Composer, c *UserCriteria, id int) error {
 		comp.Add(c.ConfirmationToken)
 		comp.Dirty = true
 	}
+	QueryTimestampWhereClause(c.ConfirmationTokenExpireAt, id, TableUserColumnConfirmationTokenExpireAt, comp, And)
+
 	QueryTimestampWhereClause(c.CreatedAt, id, TableUserColumnCreatedAt, comp, And)
 
 	QueryInt64WhereClause(c.CreatedBy, id, TableUserColumnCreatedBy, comp, And)
 
+	QueryTimestampWhereClause(c.DeletedAt, id, TableUserColumnDeletedAt, comp, And)
+
 	QueryStringWhereClause(c.FirstName, id, TableUserColumnFirstName, comp, And)
 
 	QueryInt64WhereClause(c.ID, id, TableUserColumnID, comp, And)
@@ -923,6 +1295,28 @@ func _UserCriteriaWhereClause(comp *Composer, c *UserCriteria, id int) error {
 		comp.Dirty = true
 	}
 
+	if c.IsServiceAccount.Valid {
+		if comp.Dirty {
+			if _, err := comp.WriteString(" AND "); err != nil {
+				return err
+			}
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableUserColumnIsServiceAccount); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.IsServiceAccount)
+		comp.Dirty = true
+	}
+
 	if c.IsStaff.Valid {
 		if comp.Dirty {
 			if _, err := comp.WriteString(" AND "); err != nil {
@@ -971,6 +1365,8 @@ func _UserCriteriaWhereClause(comp *Composer, c *UserCriteria, id int) error {
 
 	QueryStringWhereClause(c.LastName, id, TableUserColumnLastName, comp, And)
 
+	QueryTimestampWhereClause(c.LockedAt, id, TableUserColumnLockedAt, comp, And)
+
 	if c.Password != nil {
 		if comp.Dirty {
 			comp.WriteString(" AND ")
@@ -990,28 +1386,112 @@ func _UserCriteriaWhereClause(comp *Composer, c *UserCriteria, id int) error {
 		comp.Add(c.Password)
 		comp.Dirty = true
 	}
-	QueryTimestampWhereClause(c.UpdatedAt, id, TableUserColumnUpdatedAt, comp, And)
-
-	QueryInt64WhereClause(c.UpdatedBy, id, TableUserColumnUpdatedBy, comp, And)
-
-	QueryStringWhereClause(c.Username, id, TableUserColumnUsername, comp, And)
-
-	return nil
-}
-
-func (r *UserRepositoryBase) FindQuery(fe *UserFindExpr) (string, []interface{}, error) {
-	comp := NewComposer(15)
-	buf := bytes.NewBufferString("SELECT ")
-	if len(fe.Columns) == 0 {
-		buf.WriteString("t0.confirmation_token, t0.created_at, t0.created_by, t0.first_name, t0.id, t0.is_active, t0.is_confirmed, t0.is_staff, t0.is_superuser, t0.last_login_at, t0.last_name, t0.password, t0.updated_at, t0.updated_by, t0.username")
-	} else {
+	if c.PasswordResetToken != nil {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableUserColumnPasswordResetToken); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.PasswordResetToken)
+		comp.Dirty = true
+	}
+	QueryTimestampWhereClause(c.PasswordResetTokenExpireAt, id, TableUserColumnPasswordResetTokenExpireAt, comp, And)
+
+	if c.TwoFactorChallenge != nil {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableUserColumnTwoFactorChallenge); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.TwoFactorChallenge)
+		comp.Dirty = true
+	}
+	QueryTimestampWhereClause(c.TwoFactorChallengeExpireAt, id, TableUserColumnTwoFactorChallengeExpireAt, comp, And)
+
+	QueryTimestampWhereClause(c.TwoFactorConfirmedAt, id, TableUserColumnTwoFactorConfirmedAt, comp, And)
+
+	QueryInt64WhereClause(c.TwoFactorLastStep, id, TableUserColumnTwoFactorLastStep, comp, And)
+
+	if c.TwoFactorRecoveryCodes != nil {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableUserColumnTwoFactorRecoveryCodes); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.TwoFactorRecoveryCodes)
+		comp.Dirty = true
+	}
+	if c.TwoFactorSecret != nil {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableUserColumnTwoFactorSecret); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.TwoFactorSecret)
+		comp.Dirty = true
+	}
+	QueryTimestampWhereClause(c.UpdatedAt, id, TableUserColumnUpdatedAt, comp, And)
+
+	QueryInt64WhereClause(c.UpdatedBy, id, TableUserColumnUpdatedBy, comp, And)
+
+	QueryStringWhereClause(c.Username, id, TableUserColumnUsername, comp, And)
+
+	return nil
+}
+
+func (r *UserRepositoryBase) FindQuery(fe *UserFindExpr) (string, []interface{}, error) {
+	comp := NewComposer(27)
+	buf := bytes.NewBufferString("SELECT ")
+	if len(fe.Columns) == 0 {
+		buf.WriteString("t0.confirmation_token, t0.confirmation_token_expire_at, t0.created_at, t0.created_by, t0.deleted_at, t0.first_name, t0.id, t0.is_active, t0.is_confirmed, t0.is_service_account, t0.is_staff, t0.is_superuser, t0.last_login_at, t0.last_name, t0.locked_at, t0.password, t0.password_reset_token, t0.password_reset_token_expire_at, t0.two_factor_challenge, t0.two_factor_challenge_expire_at, t0.two_factor_confirmed_at, t0.two_factor_last_step, t0.two_factor_recovery_codes, t0.two_factor_secret, t0.updated_at, t0.updated_by, t0.username")
+	} else {
 		buf.WriteString(strings.Join(fe.Columns, ", "))
 	}
 	if fe.JoinAuthor != nil && fe.JoinAuthor.Kind.Actionable() && fe.JoinAuthor.Fetch {
-		buf.WriteString(", t1.confirmation_token, t1.created_at, t1.created_by, t1.first_name, t1.id, t1.is_active, t1.is_confirmed, t1.is_staff, t1.is_superuser, t1.last_login_at, t1.last_name, t1.password, t1.updated_at, t1.updated_by, t1.username")
+		buf.WriteString(", t1.confirmation_token, t1.confirmation_token_expire_at, t1.created_at, t1.created_by, t1.deleted_at, t1.first_name, t1.id, t1.is_active, t1.is_confirmed, t1.is_service_account, t1.is_staff, t1.is_superuser, t1.last_login_at, t1.last_name, t1.locked_at, t1.password, t1.password_reset_token, t1.password_reset_token_expire_at, t1.two_factor_challenge, t1.two_factor_challenge_expire_at, t1.two_factor_confirmed_at, t1.two_factor_last_step, t1.two_factor_recovery_codes, t1.two_factor_secret, t1.updated_at, t1.updated_by, t1.username")
 	}
 	if fe.JoinModifier != nil && fe.JoinModifier.Kind.Actionable() && fe.JoinModifier.Fetch {
-		buf.WriteString(", t2.confirmation_token, t2.created_at, t2.created_by, t2.first_name, t2.id, t2.is_active, t2.is_confirmed, t2.is_staff, t2.is_superuser, t2.last_login_at, t2.last_name, t2.password, t2.updated_at, t2.updated_by, t2.username")
+		buf.WriteString(", t2.confirmation_token, t2.confirmation_token_expire_at, t2.created_at, t2.created_by, t2.deleted_at, t2.first_name, t2.id, t2.is_active, t2.is_confirmed, t2.is_service_account, t2.is_staff, t2.is_superuser, t2.last_login_at, t2.last_name, t2.locked_at, t2.password, t2.password_reset_token, t2.password_reset_token_expire_at, t2.two_factor_challenge, t2.two_factor_challenge_expire_at, t2.two_factor_confirmed_at, t2.two_factor_last_step, t2.two_factor_recovery_codes, t2.two_factor_secret, t2.updated_at, t2.updated_by, t2.username")
 	}
 	buf.WriteString(" FROM ")
 	buf.WriteString(r.Table)
@@ -1217,10 +1697,10 @@ func (r *UserRepositoryBase) FindIter(ctx context.Context, fe *UserFindExpr) (*U
 }
 
 func (r *UserRepositoryBase) findOneByID(ctx context.Context, tx *sql.Tx, pk int64) (*UserEntity, error) {
-	find := NewComposer(15)
+	find := NewComposer(27)
 	find.WriteString("SELECT ")
 	if len(r.Columns) == 0 {
-		find.WriteString("confirmation_token, created_at, created_by, first_name, id, is_active, is_confirmed, is_staff, is_superuser, last_login_at, last_name, password, updated_at, updated_by, username")
+		find.WriteString("confirmation_token, confirmation_token_expire_at, created_at, created_by, deleted_at, first_name, id, is_active, is_confirmed, is_service_account, is_staff, is_superuser, last_login_at, last_name, locked_at, password, password_reset_token, password_reset_token_expire_at, two_factor_challenge, two_factor_challenge_expire_at, two_factor_confirmed_at, two_factor_last_step, two_factor_recovery_codes, two_factor_secret, updated_at, updated_by, username")
 	} else {
 		find.WriteString(strings.Join(r.Columns, ", "))
 	}
@@ -1261,10 +1741,10 @@ func (r *UserRepositoryBase) FindOneByID(ctx context.Context, pk int64) (*UserEn
 }
 
 func (r *UserRepositoryBase) findOneByUsername(ctx context.Context, tx *sql.Tx, userUsername string) (*UserEntity, error) {
-	find := NewComposer(15)
+	find := NewComposer(27)
 	find.WriteString("SELECT ")
 	if len(r.Columns) == 0 {
-		find.WriteString("confirmation_token, created_at, created_by, first_name, id, is_active, is_confirmed, is_staff, is_superuser, last_login_at, last_name, password, updated_at, updated_by, username")
+		find.WriteString("confirmation_token, confirmation_token_expire_at, created_at, created_by, deleted_at, first_name, id, is_active, is_confirmed, is_service_account, is_staff, is_superuser, last_login_at, last_name, locked_at, password, password_reset_token, password_reset_token_expire_at, two_factor_challenge, two_factor_challenge_expire_at, two_factor_confirmed_at, two_factor_last_step, two_factor_recovery_codes, two_factor_secret, updated_at, updated_by, username")
 	} else {
 		find.WriteString(strings.Join(r.Columns, ", "))
 	}
@@ -1302,7 +1782,7 @@ func (r *UserRepositoryBase) FindOneByUsername(ctx context.Context, userUsername
 func (r *UserRepositoryBase) UpdateOneByIDQuery(pk int64, p *UserPatch) (string, []interface{}, error) {
 	buf := bytes.NewBufferString("UPDATE ")
 	buf.WriteString(r.Table)
-	update := NewComposer(15)
+	update := NewComposer(27)
 	if p.ConfirmationToken != nil {
 		if update.Dirty {
 			if _, err := update.WriteString(", "); err != nil {
@@ -1321,6 +1801,25 @@ func (r *UserRepositoryBase) UpdateOneByIDQuery(pk int64, p *UserPatch) (string,
 		update.Add(p.ConfirmationToken)
 		update.Dirty = true
 
+	}
+	if p.ConfirmationTokenExpireAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserColumnConfirmationTokenExpireAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.ConfirmationTokenExpireAt)
+		update.Dirty = true
+
 	}
 	if p.CreatedAt.Valid {
 		if update.Dirty {
@@ -1360,6 +1859,25 @@ func (r *UserRepositoryBase) UpdateOneByIDQuery(pk int64, p *UserPatch) (string,
 		update.Dirty = true
 	}
 
+	if p.DeletedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserColumnDeletedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.DeletedAt)
+		update.Dirty = true
+
+	}
 	if p.FirstName.Valid {
 		if update.Dirty {
 			if _, err := update.WriteString(", "); err != nil {
@@ -1417,6 +1935,25 @@ func (r *UserRepositoryBase) UpdateOneByIDQuery(pk int64, p *UserPatch) (string,
 		update.Dirty = true
 	}
 
+	if p.IsServiceAccount.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserColumnIsServiceAccount); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.IsServiceAccount)
+		update.Dirty = true
+	}
+
 	if p.IsStaff.Valid {
 		if update.Dirty {
 			if _, err := update.WriteString(", "); err != nil {
@@ -1493,6 +2030,25 @@ func (r *UserRepositoryBase) UpdateOneByIDQuery(pk int64, p *UserPatch) (string,
 		update.Dirty = true
 	}
 
+	if p.LockedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserColumnLockedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.LockedAt)
+		update.Dirty = true
+
+	}
 	if p.Password != nil {
 		if update.Dirty {
 			if _, err := update.WriteString(", "); err != nil {
@@ -1512,13 +2068,13 @@ func (r *UserRepositoryBase) UpdateOneByIDQuery(pk int64, p *UserPatch) (string,
 		update.Dirty = true
 
 	}
-	if p.UpdatedAt.Valid {
+	if p.PasswordResetToken != nil {
 		if update.Dirty {
 			if _, err := update.WriteString(", "); err != nil {
 				return "", nil, err
 			}
 		}
-		if _, err := update.WriteString(TableUserColumnUpdatedAt); err != nil {
+		if _, err := update.WriteString(TableUserColumnPasswordResetToken); err != nil {
 			return "", nil, err
 		}
 		if _, err := update.WriteString("="); err != nil {
@@ -1527,30 +2083,36 @@ func (r *UserRepositoryBase) UpdateOneByIDQuery(pk int64, p *UserPatch) (string,
 		if err := update.WritePlaceholder(); err != nil {
 			return "", nil, err
 		}
-		update.Add(p.UpdatedAt)
+		update.Add(p.PasswordResetToken)
 		update.Dirty = true
 
-	} else {
+	}
+	if p.PasswordResetTokenExpireAt.Valid {
 		if update.Dirty {
 			if _, err := update.WriteString(", "); err != nil {
 				return "", nil, err
 			}
 		}
-		if _, err := update.WriteString(TableUserColumnUpdatedAt); err != nil {
+		if _, err := update.WriteString(TableUserColumnPasswordResetTokenExpireAt); err != nil {
 			return "", nil, err
 		}
-		if _, err := update.WriteString("=NOW()"); err != nil {
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
 			return "", nil, err
 		}
+		update.Add(p.PasswordResetTokenExpireAt)
 		update.Dirty = true
+
 	}
-	if p.UpdatedBy.Valid {
+	if p.TwoFactorChallenge != nil {
 		if update.Dirty {
 			if _, err := update.WriteString(", "); err != nil {
 				return "", nil, err
 			}
 		}
-		if _, err := update.WriteString(TableUserColumnUpdatedBy); err != nil {
+		if _, err := update.WriteString(TableUserColumnTwoFactorChallenge); err != nil {
 			return "", nil, err
 		}
 		if _, err := update.WriteString("="); err != nil {
@@ -1559,17 +2121,17 @@ func (r *UserRepositoryBase) UpdateOneByIDQuery(pk int64, p *UserPatch) (string,
 		if err := update.WritePlaceholder(); err != nil {
 			return "", nil, err
 		}
-		update.Add(p.UpdatedBy)
+		update.Add(p.TwoFactorChallenge)
 		update.Dirty = true
-	}
 
-	if p.Username.Valid {
+	}
+	if p.TwoFactorChallengeExpireAt.Valid {
 		if update.Dirty {
 			if _, err := update.WriteString(", "); err != nil {
 				return "", nil, err
 			}
 		}
-		if _, err := update.WriteString(TableUserColumnUsername); err != nil {
+		if _, err := update.WriteString(TableUserColumnTwoFactorChallengeExpireAt); err != nil {
 			return "", nil, err
 		}
 		if _, err := update.WriteString("="); err != nil {
@@ -1578,69 +2140,215 @@ func (r *UserRepositoryBase) UpdateOneByIDQuery(pk int64, p *UserPatch) (string,
 		if err := update.WritePlaceholder(); err != nil {
 			return "", nil, err
 		}
-		update.Add(p.Username)
+		update.Add(p.TwoFactorChallengeExpireAt)
 		update.Dirty = true
-	}
-
-	if !update.Dirty {
-		return "", nil, errors.New("User update failure, nothing to update")
-	}
-	buf.WriteString(" SET ")
-	buf.ReadFrom(update)
-	buf.WriteString(" WHERE ")
-
-	update.WriteString(TableUserColumnID)
-	update.WriteString("=")
-	update.WritePlaceholder()
-	update.Add(pk)
 
-	buf.ReadFrom(update)
-	buf.WriteString(" RETURNING ")
-	if len(r.Columns) > 0 {
-		buf.WriteString(strings.Join(r.Columns, ", "))
-	} else {
-		buf.WriteString("confirmation_token, created_at, created_by, first_name, id, is_active, is_confirmed, is_staff, is_superuser, last_login_at, last_name, password, updated_at, updated_by, username")
 	}
-	return buf.String(), update.Args(), nil
-}
+	if p.TwoFactorConfirmedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserColumnTwoFactorConfirmedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.TwoFactorConfirmedAt)
+		update.Dirty = true
 
-func (r *UserRepositoryBase) updateOneByID(ctx context.Context, tx *sql.Tx, pk int64, p *UserPatch) (*UserEntity, error) {
-	query, args, err := r.UpdateOneByIDQuery(pk, p)
-	if err != nil {
-		return nil, err
-	}
-	var ent UserEntity
-	props, err := ent.Props(r.Columns...)
-	if err != nil {
-		return nil, err
 	}
-	if tx == nil {
-		err = r.DB.QueryRowContext(ctx, query, args...).Scan(props...)
-	} else {
-		err = tx.QueryRowContext(ctx, query, args...).Scan(props...)
-	}
-	if r.Log != nil {
-		if tx == nil {
-			r.Log(err, TableUser, "update by primary key", query, args...)
-		} else {
-			r.Log(err, TableUser, "update by primary key tx", query, args...)
+	if p.TwoFactorLastStep.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
 		}
+		if _, err := update.WriteString(TableUserColumnTwoFactorLastStep); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.TwoFactorLastStep)
+		update.Dirty = true
 	}
-	if err != nil {
-		return nil, err
-	}
-	return &ent, nil
-}
 
-func (r *UserRepositoryBase) UpdateOneByID(ctx context.Context, pk int64, p *UserPatch) (*UserEntity, error) {
-	return r.updateOneByID(ctx, nil, pk, p)
-}
+	if p.TwoFactorRecoveryCodes != nil {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserColumnTwoFactorRecoveryCodes); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.TwoFactorRecoveryCodes)
+		update.Dirty = true
 
-func (r *UserRepositoryBase) FindOneByIDAndUpdate(ctx context.Context, pk int64, p *UserPatch) (before, after *UserEntity, err error) {
-	find := NewComposer(15)
-	find.WriteString("SELECT ")
-	if len(r.Columns) == 0 {
-		find.WriteString("confirmation_token, created_at, created_by, first_name, id, is_active, is_confirmed, is_staff, is_superuser, last_login_at, last_name, password, updated_at, updated_by, username")
+	}
+	if p.TwoFactorSecret != nil {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserColumnTwoFactorSecret); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.TwoFactorSecret)
+		update.Dirty = true
+
+	}
+	if p.UpdatedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.UpdatedAt)
+		update.Dirty = true
+
+	} else {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("=NOW()"); err != nil {
+			return "", nil, err
+		}
+		update.Dirty = true
+	}
+	if p.UpdatedBy.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserColumnUpdatedBy); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.UpdatedBy)
+		update.Dirty = true
+	}
+
+	if p.Username.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserColumnUsername); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Username)
+		update.Dirty = true
+	}
+
+	if !update.Dirty {
+		return "", nil, errors.New("User update failure, nothing to update")
+	}
+	buf.WriteString(" SET ")
+	buf.ReadFrom(update)
+	buf.WriteString(" WHERE ")
+
+	update.WriteString(TableUserColumnID)
+	update.WriteString("=")
+	update.WritePlaceholder()
+	update.Add(pk)
+
+	buf.ReadFrom(update)
+	buf.WriteString(" RETURNING ")
+	if len(r.Columns) > 0 {
+		buf.WriteString(strings.Join(r.Columns, ", "))
+	} else {
+		buf.WriteString("confirmation_token, confirmation_token_expire_at, created_at, created_by, deleted_at, first_name, id, is_active, is_confirmed, is_service_account, is_staff, is_superuser, last_login_at, last_name, locked_at, password, password_reset_token, password_reset_token_expire_at, two_factor_challenge, two_factor_challenge_expire_at, two_factor_confirmed_at, two_factor_last_step, two_factor_recovery_codes, two_factor_secret, updated_at, updated_by, username")
+	}
+	return buf.String(), update.Args(), nil
+}
+
+func (r *UserRepositoryBase) updateOneByID(ctx context.Context, tx *sql.Tx, pk int64, p *UserPatch) (*UserEntity, error) {
+	query, args, err := r.UpdateOneByIDQuery(pk, p)
+	if err != nil {
+		return nil, err
+	}
+	var ent UserEntity
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, query, args...).Scan(props...)
+	} else {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(props...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableUser, "update by primary key", query, args...)
+		} else {
+			r.Log(err, TableUser, "update by primary key tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ent, nil
+}
+
+func (r *UserRepositoryBase) UpdateOneByID(ctx context.Context, pk int64, p *UserPatch) (*UserEntity, error) {
+	return r.updateOneByID(ctx, nil, pk, p)
+}
+
+func (r *UserRepositoryBase) FindOneByIDAndUpdate(ctx context.Context, pk int64, p *UserPatch) (before, after *UserEntity, err error) {
+	find := NewComposer(27)
+	find.WriteString("SELECT ")
+	if len(r.Columns) == 0 {
+		find.WriteString("confirmation_token, confirmation_token_expire_at, created_at, created_by, deleted_at, first_name, id, is_active, is_confirmed, is_service_account, is_staff, is_superuser, last_login_at, last_name, locked_at, password, password_reset_token, password_reset_token_expire_at, two_factor_challenge, two_factor_challenge_expire_at, two_factor_confirmed_at, two_factor_last_step, two_factor_recovery_codes, two_factor_secret, updated_at, updated_by, username")
 	} else {
 		find.WriteString(strings.Join(r.Columns, ", "))
 	}
@@ -1716,6 +2424,25 @@ func (r *UserRepositoryBase) UpdateOneByUsernameQuery(userUsername string, p *Us
 		update.Add(p.ConfirmationToken)
 		update.Dirty = true
 
+	}
+	if p.ConfirmationTokenExpireAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserColumnConfirmationTokenExpireAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.ConfirmationTokenExpireAt)
+		update.Dirty = true
+
 	}
 	if p.CreatedAt.Valid {
 		if update.Dirty {
@@ -1755,6 +2482,25 @@ func (r *UserRepositoryBase) UpdateOneByUsernameQuery(userUsername string, p *Us
 		update.Dirty = true
 	}
 
+	if p.DeletedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserColumnDeletedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.DeletedAt)
+		update.Dirty = true
+
+	}
 	if p.FirstName.Valid {
 		if update.Dirty {
 			if _, err := update.WriteString(", "); err != nil {
@@ -1812,6 +2558,25 @@ func (r *UserRepositoryBase) UpdateOneByUsernameQuery(userUsername string, p *Us
 		update.Dirty = true
 	}
 
+	if p.IsServiceAccount.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserColumnIsServiceAccount); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.IsServiceAccount)
+		update.Dirty = true
+	}
+
 	if p.IsStaff.Valid {
 		if update.Dirty {
 			if _, err := update.WriteString(", "); err != nil {
@@ -1888,6 +2653,25 @@ func (r *UserRepositoryBase) UpdateOneByUsernameQuery(userUsername string, p *Us
 		update.Dirty = true
 	}
 
+	if p.LockedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserColumnLockedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.LockedAt)
+		update.Dirty = true
+
+	}
 	if p.Password != nil {
 		if update.Dirty {
 			if _, err := update.WriteString(", "); err != nil {
@@ -1907,13 +2691,13 @@ func (r *UserRepositoryBase) UpdateOneByUsernameQuery(userUsername string, p *Us
 		update.Dirty = true
 
 	}
-	if p.UpdatedAt.Valid {
+	if p.PasswordResetToken != nil {
 		if update.Dirty {
 			if _, err := update.WriteString(", "); err != nil {
 				return "", nil, err
 			}
 		}
-		if _, err := update.WriteString(TableUserColumnUpdatedAt); err != nil {
+		if _, err := update.WriteString(TableUserColumnPasswordResetToken); err != nil {
 			return "", nil, err
 		}
 		if _, err := update.WriteString("="); err != nil {
@@ -1922,30 +2706,36 @@ func (r *UserRepositoryBase) UpdateOneByUsernameQuery(userUsername string, p *Us
 		if err := update.WritePlaceholder(); err != nil {
 			return "", nil, err
 		}
-		update.Add(p.UpdatedAt)
+		update.Add(p.PasswordResetToken)
 		update.Dirty = true
 
-	} else {
+	}
+	if p.PasswordResetTokenExpireAt.Valid {
 		if update.Dirty {
 			if _, err := update.WriteString(", "); err != nil {
 				return "", nil, err
 			}
 		}
-		if _, err := update.WriteString(TableUserColumnUpdatedAt); err != nil {
+		if _, err := update.WriteString(TableUserColumnPasswordResetTokenExpireAt); err != nil {
 			return "", nil, err
 		}
-		if _, err := update.WriteString("=NOW()"); err != nil {
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
 			return "", nil, err
 		}
+		update.Add(p.PasswordResetTokenExpireAt)
 		update.Dirty = true
+
 	}
-	if p.UpdatedBy.Valid {
+	if p.TwoFactorChallenge != nil {
 		if update.Dirty {
 			if _, err := update.WriteString(", "); err != nil {
 				return "", nil, err
 			}
 		}
-		if _, err := update.WriteString(TableUserColumnUpdatedBy); err != nil {
+		if _, err := update.WriteString(TableUserColumnTwoFactorChallenge); err != nil {
 			return "", nil, err
 		}
 		if _, err := update.WriteString("="); err != nil {
@@ -1954,17 +2744,17 @@ func (r *UserRepositoryBase) UpdateOneByUsernameQuery(userUsername string, p *Us
 		if err := update.WritePlaceholder(); err != nil {
 			return "", nil, err
 		}
-		update.Add(p.UpdatedBy)
+		update.Add(p.TwoFactorChallenge)
 		update.Dirty = true
-	}
 
-	if p.Username.Valid {
+	}
+	if p.TwoFactorChallengeExpireAt.Valid {
 		if update.Dirty {
 			if _, err := update.WriteString(", "); err != nil {
 				return "", nil, err
 			}
 		}
-		if _, err := update.WriteString(TableUserColumnUsername); err != nil {
+		if _, err := update.WriteString(TableUserColumnTwoFactorChallengeExpireAt); err != nil {
 			return "", nil, err
 		}
 		if _, err := update.WriteString("="); err != nil {
@@ -1973,26 +2763,172 @@ func (r *UserRepositoryBase) UpdateOneByUsernameQuery(userUsername string, p *Us
 		if err := update.WritePlaceholder(); err != nil {
 			return "", nil, err
 		}
-		update.Add(p.Username)
+		update.Add(p.TwoFactorChallengeExpireAt)
 		update.Dirty = true
+
 	}
+	if p.TwoFactorConfirmedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserColumnTwoFactorConfirmedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.TwoFactorConfirmedAt)
+		update.Dirty = true
 
-	if !update.Dirty {
-		return "", nil, errors.New("user update failure, nothing to update")
 	}
-	buf.WriteString(" SET ")
-	buf.ReadFrom(update)
-	buf.WriteString(" WHERE ")
-	update.WriteString(TableUserColumnUsername)
-	update.WriteString("=")
-	update.WritePlaceholder()
-	update.Add(userUsername)
-	buf.ReadFrom(update)
-	buf.WriteString(" RETURNING ")
-	if len(r.Columns) > 0 {
-		buf.WriteString(strings.Join(r.Columns, ", "))
-	} else {
-		buf.WriteString("confirmation_token, created_at, created_by, first_name, id, is_active, is_confirmed, is_staff, is_superuser, last_login_at, last_name, password, updated_at, updated_by, username")
+	if p.TwoFactorLastStep.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserColumnTwoFactorLastStep); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.TwoFactorLastStep)
+		update.Dirty = true
+	}
+
+	if p.TwoFactorRecoveryCodes != nil {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserColumnTwoFactorRecoveryCodes); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.TwoFactorRecoveryCodes)
+		update.Dirty = true
+
+	}
+	if p.TwoFactorSecret != nil {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserColumnTwoFactorSecret); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.TwoFactorSecret)
+		update.Dirty = true
+
+	}
+	if p.UpdatedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.UpdatedAt)
+		update.Dirty = true
+
+	} else {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("=NOW()"); err != nil {
+			return "", nil, err
+		}
+		update.Dirty = true
+	}
+	if p.UpdatedBy.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserColumnUpdatedBy); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.UpdatedBy)
+		update.Dirty = true
+	}
+
+	if p.Username.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserColumnUsername); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Username)
+		update.Dirty = true
+	}
+
+	if !update.Dirty {
+		return "", nil, errors.New("user update failure, nothing to update")
+	}
+	buf.WriteString(" SET ")
+	buf.ReadFrom(update)
+	buf.WriteString(" WHERE ")
+	update.WriteString(TableUserColumnUsername)
+	update.WriteString("=")
+	update.WritePlaceholder()
+	update.Add(userUsername)
+	buf.ReadFrom(update)
+	buf.WriteString(" RETURNING ")
+	if len(r.Columns) > 0 {
+		buf.WriteString(strings.Join(r.Columns, ", "))
+	} else {
+		buf.WriteString("confirmation_token, confirmation_token_expire_at, created_at, created_by, deleted_at, first_name, id, is_active, is_confirmed, is_service_account, is_staff, is_superuser, last_login_at, last_name, locked_at, password, password_reset_token, password_reset_token_expire_at, two_factor_challenge, two_factor_challenge_expire_at, two_factor_confirmed_at, two_factor_last_step, two_factor_recovery_codes, two_factor_secret, updated_at, updated_by, username")
 	}
 	return buf.String(), update.Args(), nil
 }
@@ -2033,7 +2969,7 @@ func (r *UserRepositoryBase) UpdateOneByUsername(ctx context.Context, userUserna
 }
 
 func (r *UserRepositoryBase) UpsertQuery(e *UserEntity, p *UserPatch, inf ...string) (string, []interface{}, error) {
-	upsert := NewComposer(30)
+	upsert := NewComposer(54)
 	columns := bytes.NewBuffer(nil)
 	buf := bytes.NewBufferString("INSERT INTO ")
 	buf.WriteString(r.Table)
@@ -2059,6 +2995,27 @@ func (r *UserRepositoryBase) UpsertQuery(e *UserEntity, p *UserPatch, inf ...str
 		upsert.Dirty = true
 	}
 
+	if e.ConfirmationTokenExpireAt.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableUserColumnConfirmationTokenExpireAt); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ConfirmationTokenExpireAt)
+		upsert.Dirty = true
+	}
+
 	if !e.CreatedAt.IsZero() {
 		if columns.Len() > 0 {
 			if _, err := columns.WriteString(", "); err != nil {
@@ -2099,6 +3056,27 @@ func (r *UserRepositoryBase) UpsertQuery(e *UserEntity, p *UserPatch, inf ...str
 	upsert.Add(e.CreatedBy)
 	upsert.Dirty = true
 
+	if e.DeletedAt.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableUserColumnDeletedAt); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.DeletedAt)
+		upsert.Dirty = true
+	}
+
 	if columns.Len() > 0 {
 		if _, err := columns.WriteString(", "); err != nil {
 			return "", nil, err
@@ -2156,6 +3134,25 @@ func (r *UserRepositoryBase) UpsertQuery(e *UserEntity, p *UserPatch, inf ...str
 	upsert.Add(e.IsConfirmed)
 	upsert.Dirty = true
 
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableUserColumnIsServiceAccount); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.IsServiceAccount)
+	upsert.Dirty = true
+
 	if columns.Len() > 0 {
 		if _, err := columns.WriteString(", "); err != nil {
 			return "", nil, err
@@ -2234,6 +3231,27 @@ func (r *UserRepositoryBase) UpsertQuery(e *UserEntity, p *UserPatch, inf ...str
 	upsert.Add(e.LastName)
 	upsert.Dirty = true
 
+	if e.LockedAt.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableUserColumnLockedAt); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.LockedAt)
+		upsert.Dirty = true
+	}
+
 	if e.Password != nil {
 		if columns.Len() > 0 {
 			if _, err := columns.WriteString(", "); err != nil {
@@ -2255,13 +3273,13 @@ func (r *UserRepositoryBase) UpsertQuery(e *UserEntity, p *UserPatch, inf ...str
 		upsert.Dirty = true
 	}
 
-	if e.UpdatedAt.Valid {
+	if e.PasswordResetToken != nil {
 		if columns.Len() > 0 {
 			if _, err := columns.WriteString(", "); err != nil {
 				return "", nil, err
 			}
 		}
-		if _, err := columns.WriteString(TableUserColumnUpdatedAt); err != nil {
+		if _, err := columns.WriteString(TableUserColumnPasswordResetToken); err != nil {
 			return "", nil, err
 		}
 		if upsert.Dirty {
@@ -2272,46 +3290,212 @@ func (r *UserRepositoryBase) UpsertQuery(e *UserEntity, p *UserPatch, inf ...str
 		if err := upsert.WritePlaceholder(); err != nil {
 			return "", nil, err
 		}
-		upsert.Add(e.UpdatedAt)
+		upsert.Add(e.PasswordResetToken)
 		upsert.Dirty = true
 	}
 
-	if columns.Len() > 0 {
-		if _, err := columns.WriteString(", "); err != nil {
+	if e.PasswordResetTokenExpireAt.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableUserColumnPasswordResetTokenExpireAt); err != nil {
 			return "", nil, err
 		}
-	}
-	if _, err := columns.WriteString(TableUserColumnUpdatedBy); err != nil {
-		return "", nil, err
-	}
-	if upsert.Dirty {
-		if _, err := upsert.WriteString(", "); err != nil {
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
 			return "", nil, err
 		}
+		upsert.Add(e.PasswordResetTokenExpireAt)
+		upsert.Dirty = true
 	}
-	if err := upsert.WritePlaceholder(); err != nil {
-		return "", nil, err
-	}
-	upsert.Add(e.UpdatedBy)
-	upsert.Dirty = true
 
-	if columns.Len() > 0 {
-		if _, err := columns.WriteString(", "); err != nil {
+	if e.TwoFactorChallenge != nil {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableUserColumnTwoFactorChallenge); err != nil {
 			return "", nil, err
 		}
-	}
-	if _, err := columns.WriteString(TableUserColumnUsername); err != nil {
-		return "", nil, err
-	}
-	if upsert.Dirty {
-		if _, err := upsert.WriteString(", "); err != nil {
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
 			return "", nil, err
 		}
+		upsert.Add(e.TwoFactorChallenge)
+		upsert.Dirty = true
 	}
-	if err := upsert.WritePlaceholder(); err != nil {
-		return "", nil, err
-	}
-	upsert.Add(e.Username)
+
+	if e.TwoFactorChallengeExpireAt.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableUserColumnTwoFactorChallengeExpireAt); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.TwoFactorChallengeExpireAt)
+		upsert.Dirty = true
+	}
+
+	if e.TwoFactorConfirmedAt.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableUserColumnTwoFactorConfirmedAt); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.TwoFactorConfirmedAt)
+		upsert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableUserColumnTwoFactorLastStep); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.TwoFactorLastStep)
+	upsert.Dirty = true
+
+	if e.TwoFactorRecoveryCodes != nil {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableUserColumnTwoFactorRecoveryCodes); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.TwoFactorRecoveryCodes)
+		upsert.Dirty = true
+	}
+
+	if e.TwoFactorSecret != nil {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableUserColumnTwoFactorSecret); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.TwoFactorSecret)
+		upsert.Dirty = true
+	}
+
+	if e.UpdatedAt.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableUserColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.UpdatedAt)
+		upsert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableUserColumnUpdatedBy); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.UpdatedBy)
+	upsert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableUserColumnUsername); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.Username)
 	upsert.Dirty = true
 
 	if upsert.Dirty {
@@ -2342,6 +3526,25 @@ func (r *UserRepositoryBase) UpsertQuery(e *UserEntity, p *UserPatch, inf ...str
 			upsert.Add(p.ConfirmationToken)
 			upsert.Dirty = true
 
+		}
+		if p.ConfirmationTokenExpireAt.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableUserColumnConfirmationTokenExpireAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ConfirmationTokenExpireAt)
+			upsert.Dirty = true
+
 		}
 		if p.CreatedAt.Valid {
 			if upsert.Dirty {
@@ -2381,6 +3584,25 @@ func (r *UserRepositoryBase) UpsertQuery(e *UserEntity, p *UserPatch, inf ...str
 			upsert.Dirty = true
 		}
 
+		if p.DeletedAt.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableUserColumnDeletedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.DeletedAt)
+			upsert.Dirty = true
+
+		}
 		if p.FirstName.Valid {
 			if upsert.Dirty {
 				if _, err := upsert.WriteString(", "); err != nil {
@@ -2438,6 +3660,25 @@ func (r *UserRepositoryBase) UpsertQuery(e *UserEntity, p *UserPatch, inf ...str
 			upsert.Dirty = true
 		}
 
+		if p.IsServiceAccount.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableUserColumnIsServiceAccount); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.IsServiceAccount)
+			upsert.Dirty = true
+		}
+
 		if p.IsStaff.Valid {
 			if upsert.Dirty {
 				if _, err := upsert.WriteString(", "); err != nil {
@@ -2514,6 +3755,25 @@ func (r *UserRepositoryBase) UpsertQuery(e *UserEntity, p *UserPatch, inf ...str
 			upsert.Dirty = true
 		}
 
+		if p.LockedAt.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableUserColumnLockedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.LockedAt)
+			upsert.Dirty = true
+
+		}
 		if p.Password != nil {
 			if upsert.Dirty {
 				if _, err := upsert.WriteString(", "); err != nil {
@@ -2533,13 +3793,13 @@ func (r *UserRepositoryBase) UpsertQuery(e *UserEntity, p *UserPatch, inf ...str
 			upsert.Dirty = true
 
 		}
-		if p.UpdatedAt.Valid {
+		if p.PasswordResetToken != nil {
 			if upsert.Dirty {
 				if _, err := upsert.WriteString(", "); err != nil {
 					return "", nil, err
 				}
 			}
-			if _, err := upsert.WriteString(TableUserColumnUpdatedAt); err != nil {
+			if _, err := upsert.WriteString(TableUserColumnPasswordResetToken); err != nil {
 				return "", nil, err
 			}
 			if _, err := upsert.WriteString("="); err != nil {
@@ -2548,30 +3808,36 @@ func (r *UserRepositoryBase) UpsertQuery(e *UserEntity, p *UserPatch, inf ...str
 			if err := upsert.WritePlaceholder(); err != nil {
 				return "", nil, err
 			}
-			upsert.Add(p.UpdatedAt)
+			upsert.Add(p.PasswordResetToken)
 			upsert.Dirty = true
 
-		} else {
+		}
+		if p.PasswordResetTokenExpireAt.Valid {
 			if upsert.Dirty {
 				if _, err := upsert.WriteString(", "); err != nil {
 					return "", nil, err
 				}
 			}
-			if _, err := upsert.WriteString(TableUserColumnUpdatedAt); err != nil {
+			if _, err := upsert.WriteString(TableUserColumnPasswordResetTokenExpireAt); err != nil {
 				return "", nil, err
 			}
-			if _, err := upsert.WriteString("=NOW()"); err != nil {
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
 				return "", nil, err
 			}
+			upsert.Add(p.PasswordResetTokenExpireAt)
 			upsert.Dirty = true
+
 		}
-		if p.UpdatedBy.Valid {
+		if p.TwoFactorChallenge != nil {
 			if upsert.Dirty {
 				if _, err := upsert.WriteString(", "); err != nil {
 					return "", nil, err
 				}
 			}
-			if _, err := upsert.WriteString(TableUserColumnUpdatedBy); err != nil {
+			if _, err := upsert.WriteString(TableUserColumnTwoFactorChallenge); err != nil {
 				return "", nil, err
 			}
 			if _, err := upsert.WriteString("="); err != nil {
@@ -2580,17 +3846,17 @@ func (r *UserRepositoryBase) UpsertQuery(e *UserEntity, p *UserPatch, inf ...str
 			if err := upsert.WritePlaceholder(); err != nil {
 				return "", nil, err
 			}
-			upsert.Add(p.UpdatedBy)
+			upsert.Add(p.TwoFactorChallenge)
 			upsert.Dirty = true
-		}
 
-		if p.Username.Valid {
+		}
+		if p.TwoFactorChallengeExpireAt.Valid {
 			if upsert.Dirty {
 				if _, err := upsert.WriteString(", "); err != nil {
 					return "", nil, err
 				}
 			}
-			if _, err := upsert.WriteString(TableUserColumnUsername); err != nil {
+			if _, err := upsert.WriteString(TableUserColumnTwoFactorChallengeExpireAt); err != nil {
 				return "", nil, err
 			}
 			if _, err := upsert.WriteString("="); err != nil {
@@ -2599,21 +3865,167 @@ func (r *UserRepositoryBase) UpsertQuery(e *UserEntity, p *UserPatch, inf ...str
 			if err := upsert.WritePlaceholder(); err != nil {
 				return "", nil, err
 			}
-			upsert.Add(p.Username)
+			upsert.Add(p.TwoFactorChallengeExpireAt)
 			upsert.Dirty = true
-		}
 
-	}
-	if len(inf) > 0 && upsert.Dirty {
-		buf.WriteString("(")
-		for j, i := range inf {
-			if j != 0 {
-				buf.WriteString(", ")
+		}
+		if p.TwoFactorConfirmedAt.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
 			}
-			buf.WriteString(i)
+			if _, err := upsert.WriteString(TableUserColumnTwoFactorConfirmedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.TwoFactorConfirmedAt)
+			upsert.Dirty = true
+
 		}
-		buf.WriteString(")")
-		buf.WriteString(" DO UPDATE SET ")
+		if p.TwoFactorLastStep.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableUserColumnTwoFactorLastStep); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.TwoFactorLastStep)
+			upsert.Dirty = true
+		}
+
+		if p.TwoFactorRecoveryCodes != nil {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableUserColumnTwoFactorRecoveryCodes); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.TwoFactorRecoveryCodes)
+			upsert.Dirty = true
+
+		}
+		if p.TwoFactorSecret != nil {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableUserColumnTwoFactorSecret); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.TwoFactorSecret)
+			upsert.Dirty = true
+
+		}
+		if p.UpdatedAt.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableUserColumnUpdatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.UpdatedAt)
+			upsert.Dirty = true
+
+		} else {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableUserColumnUpdatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("=NOW()"); err != nil {
+				return "", nil, err
+			}
+			upsert.Dirty = true
+		}
+		if p.UpdatedBy.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableUserColumnUpdatedBy); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.UpdatedBy)
+			upsert.Dirty = true
+		}
+
+		if p.Username.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableUserColumnUsername); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Username)
+			upsert.Dirty = true
+		}
+
+	}
+	if len(inf) > 0 && upsert.Dirty {
+		buf.WriteString("(")
+		for j, i := range inf {
+			if j != 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString(i)
+		}
+		buf.WriteString(")")
+		buf.WriteString(" DO UPDATE SET ")
 		buf.ReadFrom(upsert)
 	} else {
 		buf.WriteString(" DO NOTHING ")
@@ -2623,7 +4035,7 @@ func (r *UserRepositoryBase) UpsertQuery(e *UserEntity, p *UserPatch, inf ...str
 		if len(r.Columns) > 0 {
 			buf.WriteString(strings.Join(r.Columns, ", "))
 		} else {
-			buf.WriteString("confirmation_token, created_at, created_by, first_name, id, is_active, is_confirmed, is_staff, is_superuser, last_login_at, last_name, password, updated_at, updated_by, username")
+			buf.WriteString("confirmation_token, confirmation_token_expire_at, created_at, created_by, deleted_at, first_name, id, is_active, is_confirmed, is_service_account, is_staff, is_superuser, last_login_at, last_name, locked_at, password, password_reset_token, password_reset_token_expire_at, two_factor_challenge, two_factor_challenge_expire_at, two_factor_confirmed_at, two_factor_last_step, two_factor_recovery_codes, two_factor_secret, updated_at, updated_by, username")
 		}
 	}
 	return buf.String(), upsert.Args(), nil
@@ -2643,17 +4055,29 @@ func (r *UserRepositoryBase) upsert(ctx context.Context, tx *sql.Tx, e *UserEnti
 	}
 	err = row.Scan(
 		&e.ConfirmationToken,
+		&e.ConfirmationTokenExpireAt,
 		&e.CreatedAt,
 		&e.CreatedBy,
+		&e.DeletedAt,
 		&e.FirstName,
 		&e.ID,
 		&e.IsActive,
 		&e.IsConfirmed,
+		&e.IsServiceAccount,
 		&e.IsStaff,
 		&e.IsSuperuser,
 		&e.LastLoginAt,
 		&e.LastName,
+		&e.LockedAt,
 		&e.Password,
+		&e.PasswordResetToken,
+		&e.PasswordResetTokenExpireAt,
+		&e.TwoFactorChallenge,
+		&e.TwoFactorChallengeExpireAt,
+		&e.TwoFactorConfirmedAt,
+		&e.TwoFactorLastStep,
+		&e.TwoFactorRecoveryCodes,
+		&e.TwoFactorSecret,
 		&e.UpdatedAt,
 		&e.UpdatedBy,
 		&e.Username,
@@ -2710,7 +4134,7 @@ func (r *UserRepositoryBase) Count(ctx context.Context, exp *UserCountExpr) (int
 }
 
 func (r *UserRepositoryBase) deleteOneByID(ctx context.Context, tx *sql.Tx, pk int64) (int64, error) {
-	find := NewComposer(15)
+	find := NewComposer(27)
 	find.WriteString("DELETE FROM ")
 	find.WriteString(TableUser)
 	find.WriteString(" WHERE ")
@@ -2790,6 +4214,7 @@ func (r *UserRepositoryBaseTx) DeleteOneByID(ctx context.Context, pk int64) (int
 const (
 	TableGroupConstraintNameUnique          = "charon.group_name_key"
 	TableGroupConstraintPrimaryKey          = "charon.group_id_pkey"
+	TableGroupConstraintParentIDForeignKey  = "charon.group_parent_id_fkey"
 	TableGroupConstraintCreatedByForeignKey = "charon.group_created_by_fkey"
 	TableGroupConstraintUpdatedByForeignKey = "charon.group_updated_by_fkey"
 )
@@ -2801,6 +4226,7 @@ const (
 	TableGroupColumnDescription = "description"
 	TableGroupColumnID          = "id"
 	TableGroupColumnName        = "name"
+	TableGroupColumnParentID    = "parent_id"
 	TableGroupColumnUpdatedAt   = "updated_at"
 	TableGroupColumnUpdatedBy   = "updated_by"
 )
@@ -2811,6 +4237,7 @@ var TableGroupColumns = []string{
 	TableGroupColumnDescription,
 	TableGroupColumnID,
 	TableGroupColumnName,
+	TableGroupColumnParentID,
 	TableGroupColumnUpdatedAt,
 	TableGroupColumnUpdatedBy,
 }
@@ -2827,10 +4254,14 @@ type GroupEntity struct {
 	ID int64
 	// Name ...
 	Name string
+	// ParentID ...
+	ParentID ntypes.Int64
 	// UpdatedAt ...
 	UpdatedAt pq.NullTime
 	// UpdatedBy ...
 	UpdatedBy ntypes.Int64
+	// Parent ...
+	Parent *GroupEntity
 	// Author ...
 	Author *UserEntity
 	// Modifier ...
@@ -2854,6 +4285,8 @@ func (e *GroupEntity) Prop(cn string) (interface{}, bool) {
 		return &e.ID, true
 	case TableGroupColumnName:
 		return &e.Name, true
+	case TableGroupColumnParentID:
+		return &e.ParentID, true
 	case TableGroupColumnUpdatedAt:
 		return &e.UpdatedAt, true
 	case TableGroupColumnUpdatedBy:
@@ -2888,6 +4321,7 @@ func ScanGroupRows(rows Rows) (entities []*GroupEntity, err error) {
 			&ent.Description,
 			&ent.ID,
 			&ent.Name,
+			&ent.ParentID,
 			&ent.UpdatedAt,
 			&ent.UpdatedBy,
 		)
@@ -2952,6 +4386,13 @@ func (i *GroupIterator) Group() (*GroupEntity, error) {
 		return nil, err
 	}
 	var prop []interface{}
+	if i.expr.JoinParent != nil && i.expr.JoinParent.Kind.Actionable() && i.expr.JoinParent.Fetch {
+		ent.Parent = &GroupEntity{}
+		if prop, err = ent.Parent.Props(); err != nil {
+			return nil, err
+		}
+		props = append(props, prop...)
+	}
 	if i.expr.JoinAuthor != nil && i.expr.JoinAuthor.Kind.Actionable() && i.expr.JoinAuthor.Fetch {
 		ent.Author = &UserEntity{}
 		if prop, err = ent.Author.Props(); err != nil {
@@ -2978,6 +4419,7 @@ type GroupCriteria struct {
 	Description            *qtypes.String
 	ID                     *qtypes.Int64
 	Name                   *qtypes.String
+	ParentID               *qtypes.Int64
 	UpdatedAt              *qtypes.Timestamp
 	UpdatedBy              *qtypes.Int64
 	operator               string
@@ -3017,6 +4459,7 @@ type GroupFindExpr struct {
 	Offset, Limit int64
 	Columns       []string
 	OrderBy       []RowOrder
+	JoinParent    *GroupJoin
 	JoinAuthor    *UserJoin
 	JoinModifier  *UserJoin
 }
@@ -3025,12 +4468,14 @@ type GroupJoin struct {
 	On, Where    *GroupCriteria
 	Fetch        bool
 	Kind         JoinType
+	JoinParent   *GroupJoin
 	JoinAuthor   *UserJoin
 	JoinModifier *UserJoin
 }
 
 type GroupCountExpr struct {
 	Where        *GroupCriteria
+	JoinParent   *GroupJoin
 	JoinAuthor   *UserJoin
 	JoinModifier *UserJoin
 }
@@ -3040,6 +4485,7 @@ type GroupPatch struct {
 	CreatedBy   ntypes.Int64
 	Description ntypes.String
 	Name        ntypes.String
+	ParentID    ntypes.Int64
 	UpdatedAt   pq.NullTime
 	UpdatedBy   ntypes.Int64
 }
@@ -3077,7 +4523,7 @@ func (r GroupRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *
 }
 
 func (r *GroupRepositoryBase) InsertQuery(e *GroupEntity, read bool) (string, []interface{}, error) {
-	insert := NewComposer(7)
+	insert := NewComposer(8)
 	columns := bytes.NewBuffer(nil)
 	buf := bytes.NewBufferString("INSERT INTO ")
 	buf.WriteString(r.Table)
@@ -3160,6 +4606,25 @@ func (r *GroupRepositoryBase) InsertQuery(e *GroupEntity, read bool) (string, []
 	insert.Add(e.Name)
 	insert.Dirty = true
 
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableGroupColumnParentID); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.ParentID)
+	insert.Dirty = true
+
 	if e.UpdatedAt.Valid {
 		if columns.Len() > 0 {
 			if _, err := columns.WriteString(", "); err != nil {
@@ -3211,7 +4676,7 @@ func (r *GroupRepositoryBase) InsertQuery(e *GroupEntity, read bool) (string, []
 			if len(r.Columns) > 0 {
 				buf.WriteString(strings.Join(r.Columns, ", "))
 			} else {
-				buf.WriteString("created_at, created_by, description, id, name, updated_at, updated_by")
+				buf.WriteString("created_at, created_by, description, id, name, parent_id, updated_at, updated_by")
 			}
 		}
 	}
@@ -3236,6 +4701,7 @@ func (r *GroupRepositoryBase) insert(ctx context.Context, tx *sql.Tx, e *GroupEn
 		&e.Description,
 		&e.ID,
 		&e.Name,
+		&e.ParentID,
 		&e.UpdatedAt,
 		&e.UpdatedBy,
 	)
@@ -3312,6 +4778,8 @@ func _GroupCriteriaWhereClause(comp *Composer, c *GroupCriteria, id int) error {
 
 	QueryStringWhereClause(c.Name, id, TableGroupColumnName, comp, And)
 
+	QueryInt64WhereClause(c.ParentID, id, TableGroupColumnParentID, comp, And)
+
 	QueryTimestampWhereClause(c.UpdatedAt, id, TableGroupColumnUpdatedAt, comp, And)
 
 	QueryInt64WhereClause(c.UpdatedBy, id, TableGroupColumnUpdatedBy, comp, And)
@@ -3320,36 +4788,48 @@ func _GroupCriteriaWhereClause(comp *Composer, c *GroupCriteria, id int) error {
 }
 
 func (r *GroupRepositoryBase) FindQuery(fe *GroupFindExpr) (string, []interface{}, error) {
-	comp := NewComposer(7)
+	comp := NewComposer(8)
 	buf := bytes.NewBufferString("SELECT ")
 	if len(fe.Columns) == 0 {
-		buf.WriteString("t0.created_at, t0.created_by, t0.description, t0.id, t0.name, t0.updated_at, t0.updated_by")
+		buf.WriteString("t0.created_at, t0.created_by, t0.description, t0.id, t0.name, t0.parent_id, t0.updated_at, t0.updated_by")
 	} else {
 		buf.WriteString(strings.Join(fe.Columns, ", "))
 	}
+	if fe.JoinParent != nil && fe.JoinParent.Kind.Actionable() && fe.JoinParent.Fetch {
+		buf.WriteString(", t1.created_at, t1.created_by, t1.description, t1.id, t1.name, t1.parent_id, t1.updated_at, t1.updated_by")
+	}
 	if fe.JoinAuthor != nil && fe.JoinAuthor.Kind.Actionable() && fe.JoinAuthor.Fetch {
-		buf.WriteString(", t1.confirmation_token, t1.created_at, t1.created_by, t1.first_name, t1.id, t1.is_active, t1.is_confirmed, t1.is_staff, t1.is_superuser, t1.last_login_at, t1.last_name, t1.password, t1.updated_at, t1.updated_by, t1.username")
+		buf.WriteString(", t2.confirmation_token, t2.confirmation_token_expire_at, t2.created_at, t2.created_by, t2.deleted_at, t2.first_name, t2.id, t2.is_active, t2.is_confirmed, t2.is_service_account, t2.is_staff, t2.is_superuser, t2.last_login_at, t2.last_name, t2.locked_at, t2.password, t2.password_reset_token, t2.password_reset_token_expire_at, t2.two_factor_challenge, t2.two_factor_challenge_expire_at, t2.two_factor_confirmed_at, t2.two_factor_last_step, t2.two_factor_recovery_codes, t2.two_factor_secret, t2.updated_at, t2.updated_by, t2.username")
 	}
 	if fe.JoinModifier != nil && fe.JoinModifier.Kind.Actionable() && fe.JoinModifier.Fetch {
-		buf.WriteString(", t2.confirmation_token, t2.created_at, t2.created_by, t2.first_name, t2.id, t2.is_active, t2.is_confirmed, t2.is_staff, t2.is_superuser, t2.last_login_at, t2.last_name, t2.password, t2.updated_at, t2.updated_by, t2.username")
+		buf.WriteString(", t3.confirmation_token, t3.confirmation_token_expire_at, t3.created_at, t3.created_by, t3.deleted_at, t3.first_name, t3.id, t3.is_active, t3.is_confirmed, t3.is_service_account, t3.is_staff, t3.is_superuser, t3.last_login_at, t3.last_name, t3.locked_at, t3.password, t3.password_reset_token, t3.password_reset_token_expire_at, t3.two_factor_challenge, t3.two_factor_challenge_expire_at, t3.two_factor_confirmed_at, t3.two_factor_last_step, t3.two_factor_recovery_codes, t3.two_factor_secret, t3.updated_at, t3.updated_by, t3.username")
 	}
 	buf.WriteString(" FROM ")
 	buf.WriteString(r.Table)
 	buf.WriteString(" AS t0")
+	if fe.JoinParent != nil && fe.JoinParent.Kind.Actionable() {
+		joinClause(comp, fe.JoinParent.Kind, "charon.group AS t1 ON t0.parent_id=t1.id")
+		if fe.JoinParent.On != nil {
+			comp.Dirty = true
+			if err := GroupCriteriaWhereClause(comp, fe.JoinParent.On, 1); err != nil {
+				return "", nil, err
+			}
+		}
+	}
 	if fe.JoinAuthor != nil && fe.JoinAuthor.Kind.Actionable() {
-		joinClause(comp, fe.JoinAuthor.Kind, "charon.user AS t1 ON t0.created_by=t1.id")
+		joinClause(comp, fe.JoinAuthor.Kind, "charon.user AS t2 ON t0.created_by=t2.id")
 		if fe.JoinAuthor.On != nil {
 			comp.Dirty = true
-			if err := UserCriteriaWhereClause(comp, fe.JoinAuthor.On, 1); err != nil {
+			if err := UserCriteriaWhereClause(comp, fe.JoinAuthor.On, 2); err != nil {
 				return "", nil, err
 			}
 		}
 	}
 	if fe.JoinModifier != nil && fe.JoinModifier.Kind.Actionable() {
-		joinClause(comp, fe.JoinModifier.Kind, "charon.user AS t2 ON t0.updated_by=t2.id")
+		joinClause(comp, fe.JoinModifier.Kind, "charon.user AS t3 ON t0.updated_by=t3.id")
 		if fe.JoinModifier.On != nil {
 			comp.Dirty = true
-			if err := UserCriteriaWhereClause(comp, fe.JoinModifier.On, 2); err != nil {
+			if err := UserCriteriaWhereClause(comp, fe.JoinModifier.On, 3); err != nil {
 				return "", nil, err
 			}
 		}
@@ -3363,13 +4843,18 @@ func (r *GroupRepositoryBase) FindQuery(fe *GroupFindExpr) (string, []interface{
 			return "", nil, err
 		}
 	}
+	if fe.JoinParent != nil && fe.JoinParent.Kind.Actionable() && fe.JoinParent.Where != nil {
+		if err := GroupCriteriaWhereClause(comp, fe.JoinParent.Where, 1); err != nil {
+			return "", nil, err
+		}
+	}
 	if fe.JoinAuthor != nil && fe.JoinAuthor.Kind.Actionable() && fe.JoinAuthor.Where != nil {
-		if err := UserCriteriaWhereClause(comp, fe.JoinAuthor.Where, 1); err != nil {
+		if err := UserCriteriaWhereClause(comp, fe.JoinAuthor.Where, 2); err != nil {
 			return "", nil, err
 		}
 	}
 	if fe.JoinModifier != nil && fe.JoinModifier.Kind.Actionable() && fe.JoinModifier.Where != nil {
-		if err := UserCriteriaWhereClause(comp, fe.JoinModifier.Where, 2); err != nil {
+		if err := UserCriteriaWhereClause(comp, fe.JoinModifier.Where, 3); err != nil {
 			return "", nil, err
 		}
 	}
@@ -3469,6 +4954,13 @@ func (r *GroupRepositoryBase) find(ctx context.Context, tx *sql.Tx, fe *GroupFin
 			return nil, err
 		}
 		var prop []interface{}
+		if fe.JoinParent != nil && fe.JoinParent.Kind.Actionable() && fe.JoinParent.Fetch {
+			ent.Parent = &GroupEntity{}
+			if prop, err = ent.Parent.Props(); err != nil {
+				return nil, err
+			}
+			props = append(props, prop...)
+		}
 		if fe.JoinAuthor != nil && fe.JoinAuthor.Kind.Actionable() && fe.JoinAuthor.Fetch {
 			ent.Author = &UserEntity{}
 			if prop, err = ent.Author.Props(); err != nil {
@@ -3537,10 +5029,10 @@ func (r *GroupRepositoryBase) FindIter(ctx context.Context, fe *GroupFindExpr) (
 }
 
 func (r *GroupRepositoryBase) findOneByID(ctx context.Context, tx *sql.Tx, pk int64) (*GroupEntity, error) {
-	find := NewComposer(7)
+	find := NewComposer(8)
 	find.WriteString("SELECT ")
 	if len(r.Columns) == 0 {
-		find.WriteString("created_at, created_by, description, id, name, updated_at, updated_by")
+		find.WriteString("created_at, created_by, description, id, name, parent_id, updated_at, updated_by")
 	} else {
 		find.WriteString(strings.Join(r.Columns, ", "))
 	}
@@ -3581,10 +5073,10 @@ func (r *GroupRepositoryBase) FindOneByID(ctx context.Context, pk int64) (*Group
 }
 
 func (r *GroupRepositoryBase) findOneByName(ctx context.Context, tx *sql.Tx, groupName string) (*GroupEntity, error) {
-	find := NewComposer(7)
+	find := NewComposer(8)
 	find.WriteString("SELECT ")
 	if len(r.Columns) == 0 {
-		find.WriteString("created_at, created_by, description, id, name, updated_at, updated_by")
+		find.WriteString("created_at, created_by, description, id, name, parent_id, updated_at, updated_by")
 	} else {
 		find.WriteString(strings.Join(r.Columns, ", "))
 	}
@@ -3622,7 +5114,7 @@ func (r *GroupRepositoryBase) FindOneByName(ctx context.Context, groupName strin
 func (r *GroupRepositoryBase) UpdateOneByIDQuery(pk int64, p *GroupPatch) (string, []interface{}, error) {
 	buf := bytes.NewBufferString("UPDATE ")
 	buf.WriteString(r.Table)
-	update := NewComposer(7)
+	update := NewComposer(8)
 	if p.CreatedAt.Valid {
 		if update.Dirty {
 			if _, err := update.WriteString(", "); err != nil {
@@ -3699,6 +5191,25 @@ func (r *GroupRepositoryBase) UpdateOneByIDQuery(pk int64, p *GroupPatch) (strin
 		update.Dirty = true
 	}
 
+	if p.ParentID.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableGroupColumnParentID); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.ParentID)
+		update.Dirty = true
+	}
+
 	if p.UpdatedAt.Valid {
 		if update.Dirty {
 			if _, err := update.WriteString(", "); err != nil {
@@ -3767,7 +5278,7 @@ func (r *GroupRepositoryBase) UpdateOneByIDQuery(pk int64, p *GroupPatch) (strin
 	if len(r.Columns) > 0 {
 		buf.WriteString(strings.Join(r.Columns, ", "))
 	} else {
-		buf.WriteString("created_at, created_by, description, id, name, updated_at, updated_by")
+		buf.WriteString("created_at, created_by, description, id, name, parent_id, updated_at, updated_by")
 	}
 	return buf.String(), update.Args(), nil
 }
@@ -3805,10 +5316,10 @@ func (r *GroupRepositoryBase) UpdateOneByID(ctx context.Context, pk int64, p *Gr
 }
 
 func (r *GroupRepositoryBase) FindOneByIDAndUpdate(ctx context.Context, pk int64, p *GroupPatch) (before, after *GroupEntity, err error) {
-	find := NewComposer(7)
+	find := NewComposer(8)
 	find.WriteString("SELECT ")
 	if len(r.Columns) == 0 {
-		find.WriteString("created_at, created_by, description, id, name, updated_at, updated_by")
+		find.WriteString("created_at, created_by, description, id, name, parent_id, updated_at, updated_by")
 	} else {
 		find.WriteString(strings.Join(r.Columns, ", "))
 	}
@@ -3942,6 +5453,25 @@ func (r *GroupRepositoryBase) UpdateOneByNameQuery(groupName string, p *GroupPat
 		update.Dirty = true
 	}
 
+	if p.ParentID.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableGroupColumnParentID); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.ParentID)
+		update.Dirty = true
+	}
+
 	if p.UpdatedAt.Valid {
 		if update.Dirty {
 			if _, err := update.WriteString(", "); err != nil {
@@ -4008,7 +5538,7 @@ func (r *GroupRepositoryBase) UpdateOneByNameQuery(groupName string, p *GroupPat
 	if len(r.Columns) > 0 {
 		buf.WriteString(strings.Join(r.Columns, ", "))
 	} else {
-		buf.WriteString("created_at, created_by, description, id, name, updated_at, updated_by")
+		buf.WriteString("created_at, created_by, description, id, name, parent_id, updated_at, updated_by")
 	}
 	return buf.String(), update.Args(), nil
 }
@@ -4049,7 +5579,7 @@ func (r *GroupRepositoryBase) UpdateOneByName(ctx context.Context, groupName str
 }
 
 func (r *GroupRepositoryBase) UpsertQuery(e *GroupEntity, p *GroupPatch, inf ...string) (string, []interface{}, error) {
-	upsert := NewComposer(14)
+	upsert := NewComposer(16)
 	columns := bytes.NewBuffer(nil)
 	buf := bytes.NewBufferString("INSERT INTO ")
 	buf.WriteString(r.Table)
@@ -4132,6 +5662,25 @@ func (r *GroupRepositoryBase) UpsertQuery(e *GroupEntity, p *GroupPatch, inf ...
 	upsert.Add(e.Name)
 	upsert.Dirty = true
 
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableGroupColumnParentID); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.ParentID)
+	upsert.Dirty = true
+
 	if e.UpdatedAt.Valid {
 		if columns.Len() > 0 {
 			if _, err := columns.WriteString(", "); err != nil {
@@ -4258,6 +5807,25 @@ func (r *GroupRepositoryBase) UpsertQuery(e *GroupEntity, p *GroupPatch, inf ...
 			upsert.Dirty = true
 		}
 
+		if p.ParentID.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableGroupColumnParentID); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ParentID)
+			upsert.Dirty = true
+		}
+
 		if p.UpdatedAt.Valid {
 			if upsert.Dirty {
 				if _, err := upsert.WriteString(", "); err != nil {
@@ -4329,7 +5897,7 @@ func (r *GroupRepositoryBase) UpsertQuery(e *GroupEntity, p *GroupPatch, inf ...
 		if len(r.Columns) > 0 {
 			buf.WriteString(strings.Join(r.Columns, ", "))
 		} else {
-			buf.WriteString("created_at, created_by, description, id, name, updated_at, updated_by")
+			buf.WriteString("created_at, created_by, description, id, name, parent_id, updated_at, updated_by")
 		}
 	}
 	return buf.String(), upsert.Args(), nil
@@ -4353,6 +5921,7 @@ func (r *GroupRepositoryBase) upsert(ctx context.Context, tx *sql.Tx, e *GroupEn
 		&e.Description,
 		&e.ID,
 		&e.Name,
+		&e.ParentID,
 		&e.UpdatedAt,
 		&e.UpdatedBy,
 	)
@@ -4378,6 +5947,7 @@ func (r *GroupRepositoryBase) count(ctx context.Context, tx *sql.Tx, exp *GroupC
 		Where:   exp.Where,
 		Columns: []string{"COUNT(*)"},
 
+		JoinParent:   exp.JoinParent,
 		JoinAuthor:   exp.JoinAuthor,
 		JoinModifier: exp.JoinModifier,
 	})
@@ -4408,7 +5978,7 @@ func (r *GroupRepositoryBase) Count(ctx context.Context, exp *GroupCountExpr) (i
 }
 
 func (r *GroupRepositoryBase) deleteOneByID(ctx context.Context, tx *sql.Tx, pk int64) (int64, error) {
-	find := NewComposer(7)
+	find := NewComposer(8)
 	find.WriteString("DELETE FROM ")
 	find.WriteString(TableGroup)
 	find.WriteString(" WHERE ")
@@ -4486,8 +6056,8 @@ func (r *GroupRepositoryBaseTx) DeleteOneByID(ctx context.Context, pk int64) (in
 }
 
 const (
-	TablePermissionConstraintSubsystemModuleActionUnique = "charon.permission_subsystem_module_action_key"
 	TablePermissionConstraintPrimaryKey                  = "charon.permission_id_pkey"
+	TablePermissionConstraintSubsystemModuleActionUnique = "charon.permission_subsystem_module_action_key"
 )
 
 const (
@@ -6025,22 +7595,28 @@ const (
 )
 
 const (
-	TableUserGroups                = "charon.user_groups"
-	TableUserGroupsColumnCreatedAt = "created_at"
-	TableUserGroupsColumnCreatedBy = "created_by"
-	TableUserGroupsColumnGroupID   = "group_id"
-	TableUserGroupsColumnUpdatedAt = "updated_at"
-	TableUserGroupsColumnUpdatedBy = "updated_by"
-	TableUserGroupsColumnUserID    = "user_id"
+	TableUserGroups                 = "charon.user_groups"
+	TableUserGroupsColumnCreatedAt  = "created_at"
+	TableUserGroupsColumnCreatedBy  = "created_by"
+	TableUserGroupsColumnGroupID    = "group_id"
+	TableUserGroupsColumnMappedFrom = "mapped_from"
+	TableUserGroupsColumnUpdatedAt  = "updated_at"
+	TableUserGroupsColumnUpdatedBy  = "updated_by"
+	TableUserGroupsColumnUserID     = "user_id"
+	TableUserGroupsColumnValidFrom  = "valid_from"
+	TableUserGroupsColumnValidUntil = "valid_until"
 )
 
 var TableUserGroupsColumns = []string{
 	TableUserGroupsColumnCreatedAt,
 	TableUserGroupsColumnCreatedBy,
 	TableUserGroupsColumnGroupID,
+	TableUserGroupsColumnMappedFrom,
 	TableUserGroupsColumnUpdatedAt,
 	TableUserGroupsColumnUpdatedBy,
 	TableUserGroupsColumnUserID,
+	TableUserGroupsColumnValidFrom,
+	TableUserGroupsColumnValidUntil,
 }
 
 // UserGroupsEntity ...
@@ -6051,12 +7627,18 @@ type UserGroupsEntity struct {
 	CreatedBy ntypes.Int64
 	// GroupID ...
 	GroupID int64
+	// MappedFrom ...
+	MappedFrom ntypes.String
 	// UpdatedAt ...
 	UpdatedAt pq.NullTime
 	// UpdatedBy ...
 	UpdatedBy ntypes.Int64
 	// UserID ...
 	UserID int64
+	// ValidFrom ...
+	ValidFrom pq.NullTime
+	// ValidUntil ...
+	ValidUntil pq.NullTime
 	// User ...
 	User *UserEntity
 	// Group ...
@@ -6076,12 +7658,18 @@ func (e *UserGroupsEntity) Prop(cn string) (interface{}, bool) {
 		return &e.CreatedBy, true
 	case TableUserGroupsColumnGroupID:
 		return &e.GroupID, true
+	case TableUserGroupsColumnMappedFrom:
+		return &e.MappedFrom, true
 	case TableUserGroupsColumnUpdatedAt:
 		return &e.UpdatedAt, true
 	case TableUserGroupsColumnUpdatedBy:
 		return &e.UpdatedBy, true
 	case TableUserGroupsColumnUserID:
 		return &e.UserID, true
+	case TableUserGroupsColumnValidFrom:
+		return &e.ValidFrom, true
+	case TableUserGroupsColumnValidUntil:
+		return &e.ValidUntil, true
 	default:
 		return nil, false
 	}
@@ -6110,9 +7698,12 @@ func ScanUserGroupsRows(rows Rows) (entities []*UserGroupsEntity, err error) {
 			&ent.CreatedAt,
 			&ent.CreatedBy,
 			&ent.GroupID,
+			&ent.MappedFrom,
 			&ent.UpdatedAt,
 			&ent.UpdatedBy,
 			&ent.UserID,
+			&ent.ValidFrom,
+			&ent.ValidUntil,
 		)
 		if err != nil {
 			return
@@ -6213,9 +7804,12 @@ type UserGroupsCriteria struct {
 	CreatedAt              *qtypes.Timestamp
 	CreatedBy              *qtypes.Int64
 	GroupID                *qtypes.Int64
+	MappedFrom             *qtypes.String
 	UpdatedAt              *qtypes.Timestamp
 	UpdatedBy              *qtypes.Int64
 	UserID                 *qtypes.Int64
+	ValidFrom              *qtypes.Timestamp
+	ValidUntil             *qtypes.Timestamp
 	operator               string
 	child, sibling, parent *UserGroupsCriteria
 }
@@ -6278,12 +7872,15 @@ type UserGroupsCountExpr struct {
 }
 
 type UserGroupsPatch struct {
-	CreatedAt pq.NullTime
-	CreatedBy ntypes.Int64
-	GroupID   ntypes.Int64
-	UpdatedAt pq.NullTime
-	UpdatedBy ntypes.Int64
-	UserID    ntypes.Int64
+	CreatedAt  pq.NullTime
+	CreatedBy  ntypes.Int64
+	GroupID    ntypes.Int64
+	MappedFrom ntypes.String
+	UpdatedAt  pq.NullTime
+	UpdatedBy  ntypes.Int64
+	UserID     ntypes.Int64
+	ValidFrom  pq.NullTime
+	ValidUntil pq.NullTime
 }
 
 type UserGroupsRepositoryBase struct {
@@ -6319,7 +7916,7 @@ func (r UserGroupsRepositoryBase) RunInTransaction(ctx context.Context, fn func(
 }
 
 func (r *UserGroupsRepositoryBase) InsertQuery(e *UserGroupsEntity, read bool) (string, []interface{}, error) {
-	insert := NewComposer(6)
+	insert := NewComposer(9)
 	columns := bytes.NewBuffer(nil)
 	buf := bytes.NewBufferString("INSERT INTO ")
 	buf.WriteString(r.Table)
@@ -6383,6 +7980,25 @@ func (r *UserGroupsRepositoryBase) InsertQuery(e *UserGroupsEntity, read bool) (
 	insert.Add(e.GroupID)
 	insert.Dirty = true
 
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableUserGroupsColumnMappedFrom); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.MappedFrom)
+	insert.Dirty = true
+
 	if e.UpdatedAt.Valid {
 		if columns.Len() > 0 {
 			if _, err := columns.WriteString(", "); err != nil {
@@ -6442,6 +8058,48 @@ func (r *UserGroupsRepositoryBase) InsertQuery(e *UserGroupsEntity, read bool) (
 	insert.Add(e.UserID)
 	insert.Dirty = true
 
+	if e.ValidFrom.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableUserGroupsColumnValidFrom); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ValidFrom)
+		insert.Dirty = true
+	}
+
+	if e.ValidUntil.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableUserGroupsColumnValidUntil); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ValidUntil)
+		insert.Dirty = true
+	}
+
 	if columns.Len() > 0 {
 		buf.WriteString(" (")
 		buf.ReadFrom(columns)
@@ -6453,7 +8111,7 @@ func (r *UserGroupsRepositoryBase) InsertQuery(e *UserGroupsEntity, read bool) (
 			if len(r.Columns) > 0 {
 				buf.WriteString(strings.Join(r.Columns, ", "))
 			} else {
-				buf.WriteString("created_at, created_by, group_id, updated_at, updated_by, user_id")
+				buf.WriteString("created_at, created_by, group_id, mapped_from, updated_at, updated_by, user_id, valid_from, valid_until")
 			}
 		}
 	}
@@ -6476,9 +8134,12 @@ func (r *UserGroupsRepositoryBase) insert(ctx context.Context, tx *sql.Tx, e *Us
 		&e.CreatedAt,
 		&e.CreatedBy,
 		&e.GroupID,
+		&e.MappedFrom,
 		&e.UpdatedAt,
 		&e.UpdatedBy,
 		&e.UserID,
+		&e.ValidFrom,
+		&e.ValidUntil,
 	)
 	if r.Log != nil {
 		if tx == nil {
@@ -6549,34 +8210,40 @@ func _UserGroupsCriteriaWhereClause(comp *Composer, c *UserGroupsCriteria, id in
 
 	QueryInt64WhereClause(c.GroupID, id, TableUserGroupsColumnGroupID, comp, And)
 
+	QueryStringWhereClause(c.MappedFrom, id, TableUserGroupsColumnMappedFrom, comp, And)
+
 	QueryTimestampWhereClause(c.UpdatedAt, id, TableUserGroupsColumnUpdatedAt, comp, And)
 
 	QueryInt64WhereClause(c.UpdatedBy, id, TableUserGroupsColumnUpdatedBy, comp, And)
 
 	QueryInt64WhereClause(c.UserID, id, TableUserGroupsColumnUserID, comp, And)
 
+	QueryTimestampWhereClause(c.ValidFrom, id, TableUserGroupsColumnValidFrom, comp, And)
+
+	QueryTimestampWhereClause(c.ValidUntil, id, TableUserGroupsColumnValidUntil, comp, And)
+
 	return nil
 }
 
 func (r *UserGroupsRepositoryBase) FindQuery(fe *UserGroupsFindExpr) (string, []interface{}, error) {
-	comp := NewComposer(6)
+	comp := NewComposer(9)
 	buf := bytes.NewBufferString("SELECT ")
 	if len(fe.Columns) == 0 {
-		buf.WriteString("t0.created_at, t0.created_by, t0.group_id, t0.updated_at, t0.updated_by, t0.user_id")
+		buf.WriteString("t0.created_at, t0.created_by, t0.group_id, t0.mapped_from, t0.updated_at, t0.updated_by, t0.user_id, t0.valid_from, t0.valid_until")
 	} else {
 		buf.WriteString(strings.Join(fe.Columns, ", "))
 	}
 	if fe.JoinUser != nil && fe.JoinUser.Kind.Actionable() && fe.JoinUser.Fetch {
-		buf.WriteString(", t1.confirmation_token, t1.created_at, t1.created_by, t1.first_name, t1.id, t1.is_active, t1.is_confirmed, t1.is_staff, t1.is_superuser, t1.last_login_at, t1.last_name, t1.password, t1.updated_at, t1.updated_by, t1.username")
+		buf.WriteString(", t1.confirmation_token, t1.confirmation_token_expire_at, t1.created_at, t1.created_by, t1.deleted_at, t1.first_name, t1.id, t1.is_active, t1.is_confirmed, t1.is_service_account, t1.is_staff, t1.is_superuser, t1.last_login_at, t1.last_name, t1.locked_at, t1.password, t1.password_reset_token, t1.password_reset_token_expire_at, t1.two_factor_challenge, t1.two_factor_challenge_expire_at, t1.two_factor_confirmed_at, t1.two_factor_last_step, t1.two_factor_recovery_codes, t1.two_factor_secret, t1.updated_at, t1.updated_by, t1.username")
 	}
 	if fe.JoinGroup != nil && fe.JoinGroup.Kind.Actionable() && fe.JoinGroup.Fetch {
-		buf.WriteString(", t2.created_at, t2.created_by, t2.description, t2.id, t2.name, t2.updated_at, t2.updated_by")
+		buf.WriteString(", t2.created_at, t2.created_by, t2.description, t2.id, t2.name, t2.parent_id, t2.updated_at, t2.updated_by")
 	}
 	if fe.JoinAuthor != nil && fe.JoinAuthor.Kind.Actionable() && fe.JoinAuthor.Fetch {
-		buf.WriteString(", t3.confirmation_token, t3.created_at, t3.created_by, t3.first_name, t3.id, t3.is_active, t3.is_confirmed, t3.is_staff, t3.is_superuser, t3.last_login_at, t3.last_name, t3.password, t3.updated_at, t3.updated_by, t3.username")
+		buf.WriteString(", t3.confirmation_token, t3.confirmation_token_expire_at, t3.created_at, t3.created_by, t3.deleted_at, t3.first_name, t3.id, t3.is_active, t3.is_confirmed, t3.is_service_account, t3.is_staff, t3.is_superuser, t3.last_login_at, t3.last_name, t3.locked_at, t3.password, t3.password_reset_token, t3.password_reset_token_expire_at, t3.two_factor_challenge, t3.two_factor_challenge_expire_at, t3.two_factor_confirmed_at, t3.two_factor_last_step, t3.two_factor_recovery_codes, t3.two_factor_secret, t3.updated_at, t3.updated_by, t3.username")
 	}
 	if fe.JoinModifier != nil && fe.JoinModifier.Kind.Actionable() && fe.JoinModifier.Fetch {
-		buf.WriteString(", t4.confirmation_token, t4.created_at, t4.created_by, t4.first_name, t4.id, t4.is_active, t4.is_confirmed, t4.is_staff, t4.is_superuser, t4.last_login_at, t4.last_name, t4.password, t4.updated_at, t4.updated_by, t4.username")
+		buf.WriteString(", t4.confirmation_token, t4.confirmation_token_expire_at, t4.created_at, t4.created_by, t4.deleted_at, t4.first_name, t4.id, t4.is_active, t4.is_confirmed, t4.is_service_account, t4.is_staff, t4.is_superuser, t4.last_login_at, t4.last_name, t4.locked_at, t4.password, t4.password_reset_token, t4.password_reset_token_expire_at, t4.two_factor_challenge, t4.two_factor_challenge_expire_at, t4.two_factor_confirmed_at, t4.two_factor_last_step, t4.two_factor_recovery_codes, t4.two_factor_secret, t4.updated_at, t4.updated_by, t4.username")
 	}
 	buf.WriteString(" FROM ")
 	buf.WriteString(r.Table)
@@ -6824,10 +8491,10 @@ func (r *UserGroupsRepositoryBase) FindIter(ctx context.Context, fe *UserGroupsF
 }
 
 func (r *UserGroupsRepositoryBase) findOneByUserIDAndGroupID(ctx context.Context, tx *sql.Tx, userGroupsUserID int64, userGroupsGroupID int64) (*UserGroupsEntity, error) {
-	find := NewComposer(6)
+	find := NewComposer(9)
 	find.WriteString("SELECT ")
 	if len(r.Columns) == 0 {
-		find.WriteString("created_at, created_by, group_id, updated_at, updated_by, user_id")
+		find.WriteString("created_at, created_by, group_id, mapped_from, updated_at, updated_by, user_id, valid_from, valid_until")
 	} else {
 		find.WriteString(strings.Join(r.Columns, ", "))
 	}
@@ -6928,6 +8595,25 @@ func (r *UserGroupsRepositoryBase) UpdateOneByUserIDAndGroupIDQuery(userGroupsUs
 		update.Dirty = true
 	}
 
+	if p.MappedFrom.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserGroupsColumnMappedFrom); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.MappedFrom)
+		update.Dirty = true
+	}
+
 	if p.UpdatedAt.Valid {
 		if update.Dirty {
 			if _, err := update.WriteString(", "); err != nil {
@@ -6998,6 +8684,44 @@ func (r *UserGroupsRepositoryBase) UpdateOneByUserIDAndGroupIDQuery(userGroupsUs
 		update.Dirty = true
 	}
 
+	if p.ValidFrom.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserGroupsColumnValidFrom); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.ValidFrom)
+		update.Dirty = true
+
+	}
+	if p.ValidUntil.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserGroupsColumnValidUntil); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.ValidUntil)
+		update.Dirty = true
+
+	}
 	if !update.Dirty {
 		return "", nil, errors.New("user_groups update failure, nothing to update")
 	}
@@ -7018,7 +8742,7 @@ func (r *UserGroupsRepositoryBase) UpdateOneByUserIDAndGroupIDQuery(userGroupsUs
 	if len(r.Columns) > 0 {
 		buf.WriteString(strings.Join(r.Columns, ", "))
 	} else {
-		buf.WriteString("created_at, created_by, group_id, updated_at, updated_by, user_id")
+		buf.WriteString("created_at, created_by, group_id, mapped_from, updated_at, updated_by, user_id, valid_from, valid_until")
 	}
 	return buf.String(), update.Args(), nil
 }
@@ -7059,7 +8783,7 @@ func (r *UserGroupsRepositoryBase) UpdateOneByUserIDAndGroupID(ctx context.Conte
 }
 
 func (r *UserGroupsRepositoryBase) UpsertQuery(e *UserGroupsEntity, p *UserGroupsPatch, inf ...string) (string, []interface{}, error) {
-	upsert := NewComposer(12)
+	upsert := NewComposer(18)
 	columns := bytes.NewBuffer(nil)
 	buf := bytes.NewBufferString("INSERT INTO ")
 	buf.WriteString(r.Table)
@@ -7123,6 +8847,25 @@ func (r *UserGroupsRepositoryBase) UpsertQuery(e *UserGroupsEntity, p *UserGroup
 	upsert.Add(e.GroupID)
 	upsert.Dirty = true
 
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableUserGroupsColumnMappedFrom); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.MappedFrom)
+	upsert.Dirty = true
+
 	if e.UpdatedAt.Valid {
 		if columns.Len() > 0 {
 			if _, err := columns.WriteString(", "); err != nil {
@@ -7182,6 +8925,48 @@ func (r *UserGroupsRepositoryBase) UpsertQuery(e *UserGroupsEntity, p *UserGroup
 	upsert.Add(e.UserID)
 	upsert.Dirty = true
 
+	if e.ValidFrom.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableUserGroupsColumnValidFrom); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ValidFrom)
+		upsert.Dirty = true
+	}
+
+	if e.ValidUntil.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableUserGroupsColumnValidUntil); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ValidUntil)
+		upsert.Dirty = true
+	}
+
 	if upsert.Dirty {
 		buf.WriteString(" (")
 		buf.ReadFrom(columns)
@@ -7249,6 +9034,25 @@ func (r *UserGroupsRepositoryBase) UpsertQuery(e *UserGroupsEntity, p *UserGroup
 			upsert.Dirty = true
 		}
 
+		if p.MappedFrom.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableUserGroupsColumnMappedFrom); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.MappedFrom)
+			upsert.Dirty = true
+		}
+
 		if p.UpdatedAt.Valid {
 			if upsert.Dirty {
 				if _, err := upsert.WriteString(", "); err != nil {
@@ -7319,6 +9123,44 @@ func (r *UserGroupsRepositoryBase) UpsertQuery(e *UserGroupsEntity, p *UserGroup
 			upsert.Dirty = true
 		}
 
+		if p.ValidFrom.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableUserGroupsColumnValidFrom); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ValidFrom)
+			upsert.Dirty = true
+
+		}
+		if p.ValidUntil.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableUserGroupsColumnValidUntil); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ValidUntil)
+			upsert.Dirty = true
+
+		}
 	}
 	if len(inf) > 0 && upsert.Dirty {
 		buf.WriteString("(")
@@ -7339,7 +9181,7 @@ func (r *UserGroupsRepositoryBase) UpsertQuery(e *UserGroupsEntity, p *UserGroup
 		if len(r.Columns) > 0 {
 			buf.WriteString(strings.Join(r.Columns, ", "))
 		} else {
-			buf.WriteString("created_at, created_by, group_id, updated_at, updated_by, user_id")
+			buf.WriteString("created_at, created_by, group_id, mapped_from, updated_at, updated_by, user_id, valid_from, valid_until")
 		}
 	}
 	return buf.String(), upsert.Args(), nil
@@ -7361,9 +9203,12 @@ func (r *UserGroupsRepositoryBase) upsert(ctx context.Context, tx *sql.Tx, e *Us
 		&e.CreatedAt,
 		&e.CreatedBy,
 		&e.GroupID,
+		&e.MappedFrom,
 		&e.UpdatedAt,
 		&e.UpdatedBy,
 		&e.UserID,
+		&e.ValidFrom,
+		&e.ValidUntil,
 	)
 	if r.Log != nil {
 		if tx == nil {
@@ -7467,6 +9312,7 @@ const (
 	TableGroupPermissions                          = "charon.group_permissions"
 	TableGroupPermissionsColumnCreatedAt           = "created_at"
 	TableGroupPermissionsColumnCreatedBy           = "created_by"
+	TableGroupPermissionsColumnDenied              = "denied"
 	TableGroupPermissionsColumnGroupID             = "group_id"
 	TableGroupPermissionsColumnPermissionAction    = "permission_action"
 	TableGroupPermissionsColumnPermissionModule    = "permission_module"
@@ -7478,6 +9324,7 @@ const (
 var TableGroupPermissionsColumns = []string{
 	TableGroupPermissionsColumnCreatedAt,
 	TableGroupPermissionsColumnCreatedBy,
+	TableGroupPermissionsColumnDenied,
 	TableGroupPermissionsColumnGroupID,
 	TableGroupPermissionsColumnPermissionAction,
 	TableGroupPermissionsColumnPermissionModule,
@@ -7492,6 +9339,8 @@ type GroupPermissionsEntity struct {
 	CreatedAt time.Time
 	// CreatedBy ...
 	CreatedBy ntypes.Int64
+	// Denied ...
+	Denied bool
 	// GroupID ...
 	GroupID int64
 	// PermissionAction ...
@@ -7519,6 +9368,8 @@ func (e *GroupPermissionsEntity) Prop(cn string) (interface{}, bool) {
 		return &e.CreatedAt, true
 	case TableGroupPermissionsColumnCreatedBy:
 		return &e.CreatedBy, true
+	case TableGroupPermissionsColumnDenied:
+		return &e.Denied, true
 	case TableGroupPermissionsColumnGroupID:
 		return &e.GroupID, true
 	case TableGroupPermissionsColumnPermissionAction:
@@ -7558,6 +9409,7 @@ func ScanGroupPermissionsRows(rows Rows) (entities []*GroupPermissionsEntity, er
 		err = rows.Scan(
 			&ent.CreatedAt,
 			&ent.CreatedBy,
+			&ent.Denied,
 			&ent.GroupID,
 			&ent.PermissionAction,
 			&ent.PermissionModule,
@@ -7656,6 +9508,7 @@ func (i *GroupPermissionsIterator) GroupPermissions() (*GroupPermissionsEntity,
 type GroupPermissionsCriteria struct {
 	CreatedAt              *qtypes.Timestamp
 	CreatedBy              *qtypes.Int64
+	Denied                 ntypes.Bool
 	GroupID                *qtypes.Int64
 	PermissionAction       *qtypes.String
 	PermissionModule       *qtypes.String
@@ -7723,6 +9576,7 @@ type GroupPermissionsCountExpr struct {
 type GroupPermissionsPatch struct {
 	CreatedAt           pq.NullTime
 	CreatedBy           ntypes.Int64
+	Denied              ntypes.Bool
 	GroupID             ntypes.Int64
 	PermissionAction    ntypes.String
 	PermissionModule    ntypes.String
@@ -7764,7 +9618,7 @@ func (r GroupPermissionsRepositoryBase) RunInTransaction(ctx context.Context, fn
 }
 
 func (r *GroupPermissionsRepositoryBase) InsertQuery(e *GroupPermissionsEntity, read bool) (string, []interface{}, error) {
-	insert := NewComposer(8)
+	insert := NewComposer(9)
 	columns := bytes.NewBuffer(nil)
 	buf := bytes.NewBufferString("INSERT INTO ")
 	buf.WriteString(r.Table)
@@ -7809,6 +9663,25 @@ func (r *GroupPermissionsRepositoryBase) InsertQuery(e *GroupPermissionsEntity,
 	insert.Add(e.CreatedBy)
 	insert.Dirty = true
 
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableGroupPermissionsColumnDenied); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.Denied)
+	insert.Dirty = true
+
 	if columns.Len() > 0 {
 		if _, err := columns.WriteString(", "); err != nil {
 			return "", nil, err
@@ -7936,7 +9809,7 @@ func (r *GroupPermissionsRepositoryBase) InsertQuery(e *GroupPermissionsEntity,
 			if len(r.Columns) > 0 {
 				buf.WriteString(strings.Join(r.Columns, ", "))
 			} else {
-				buf.WriteString("created_at, created_by, group_id, permission_action, permission_module, permission_subsystem, updated_at, updated_by")
+				buf.WriteString("created_at, created_by, denied, group_id, permission_action, permission_module, permission_subsystem, updated_at, updated_by")
 			}
 		}
 	}
@@ -7958,6 +9831,7 @@ func (r *GroupPermissionsRepositoryBase) insert(ctx context.Context, tx *sql.Tx,
 	err = row.Scan(
 		&e.CreatedAt,
 		&e.CreatedBy,
+		&e.Denied,
 		&e.GroupID,
 		&e.PermissionAction,
 		&e.PermissionModule,
@@ -8032,37 +9906,59 @@ func _GroupPermissionsCriteriaWhereClause(comp *Composer, c *GroupPermissionsCri
 
 	QueryInt64WhereClause(c.CreatedBy, id, TableGroupPermissionsColumnCreatedBy, comp, And)
 
-	QueryInt64WhereClause(c.GroupID, id, TableGroupPermissionsColumnGroupID, comp, And)
-
-	QueryStringWhereClause(c.PermissionAction, id, TableGroupPermissionsColumnPermissionAction, comp, And)
-
-	QueryStringWhereClause(c.PermissionModule, id, TableGroupPermissionsColumnPermissionModule, comp, And)
-
-	QueryStringWhereClause(c.PermissionSubsystem, id, TableGroupPermissionsColumnPermissionSubsystem, comp, And)
-
-	QueryTimestampWhereClause(c.UpdatedAt, id, TableGroupPermissionsColumnUpdatedAt, comp, And)
-
-	QueryInt64WhereClause(c.UpdatedBy, id, TableGroupPermissionsColumnUpdatedBy, comp, And)
-
-	return nil
-}
-
-func (r *GroupPermissionsRepositoryBase) FindQuery(fe *GroupPermissionsFindExpr) (string, []interface{}, error) {
-	comp := NewComposer(8)
+	if c.Denied.Valid {
+		if comp.Dirty {
+			if _, err := comp.WriteString(" AND "); err != nil {
+				return err
+			}
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableGroupPermissionsColumnDenied); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.Denied)
+		comp.Dirty = true
+	}
+
+	QueryInt64WhereClause(c.GroupID, id, TableGroupPermissionsColumnGroupID, comp, And)
+
+	QueryStringWhereClause(c.PermissionAction, id, TableGroupPermissionsColumnPermissionAction, comp, And)
+
+	QueryStringWhereClause(c.PermissionModule, id, TableGroupPermissionsColumnPermissionModule, comp, And)
+
+	QueryStringWhereClause(c.PermissionSubsystem, id, TableGroupPermissionsColumnPermissionSubsystem, comp, And)
+
+	QueryTimestampWhereClause(c.UpdatedAt, id, TableGroupPermissionsColumnUpdatedAt, comp, And)
+
+	QueryInt64WhereClause(c.UpdatedBy, id, TableGroupPermissionsColumnUpdatedBy, comp, And)
+
+	return nil
+}
+
+func (r *GroupPermissionsRepositoryBase) FindQuery(fe *GroupPermissionsFindExpr) (string, []interface{}, error) {
+	comp := NewComposer(9)
 	buf := bytes.NewBufferString("SELECT ")
 	if len(fe.Columns) == 0 {
-		buf.WriteString("t0.created_at, t0.created_by, t0.group_id, t0.permission_action, t0.permission_module, t0.permission_subsystem, t0.updated_at, t0.updated_by")
+		buf.WriteString("t0.created_at, t0.created_by, t0.denied, t0.group_id, t0.permission_action, t0.permission_module, t0.permission_subsystem, t0.updated_at, t0.updated_by")
 	} else {
 		buf.WriteString(strings.Join(fe.Columns, ", "))
 	}
 	if fe.JoinGroup != nil && fe.JoinGroup.Kind.Actionable() && fe.JoinGroup.Fetch {
-		buf.WriteString(", t1.created_at, t1.created_by, t1.description, t1.id, t1.name, t1.updated_at, t1.updated_by")
+		buf.WriteString(", t1.created_at, t1.created_by, t1.description, t1.id, t1.name, t1.parent_id, t1.updated_at, t1.updated_by")
 	}
 	if fe.JoinAuthor != nil && fe.JoinAuthor.Kind.Actionable() && fe.JoinAuthor.Fetch {
-		buf.WriteString(", t2.confirmation_token, t2.created_at, t2.created_by, t2.first_name, t2.id, t2.is_active, t2.is_confirmed, t2.is_staff, t2.is_superuser, t2.last_login_at, t2.last_name, t2.password, t2.updated_at, t2.updated_by, t2.username")
+		buf.WriteString(", t2.confirmation_token, t2.confirmation_token_expire_at, t2.created_at, t2.created_by, t2.deleted_at, t2.first_name, t2.id, t2.is_active, t2.is_confirmed, t2.is_service_account, t2.is_staff, t2.is_superuser, t2.last_login_at, t2.last_name, t2.locked_at, t2.password, t2.password_reset_token, t2.password_reset_token_expire_at, t2.two_factor_challenge, t2.two_factor_challenge_expire_at, t2.two_factor_confirmed_at, t2.two_factor_last_step, t2.two_factor_recovery_codes, t2.two_factor_secret, t2.updated_at, t2.updated_by, t2.username")
 	}
 	if fe.JoinModifier != nil && fe.JoinModifier.Kind.Actionable() && fe.JoinModifier.Fetch {
-		buf.WriteString(", t3.confirmation_token, t3.created_at, t3.created_by, t3.first_name, t3.id, t3.is_active, t3.is_confirmed, t3.is_staff, t3.is_superuser, t3.last_login_at, t3.last_name, t3.password, t3.updated_at, t3.updated_by, t3.username")
+		buf.WriteString(", t3.confirmation_token, t3.confirmation_token_expire_at, t3.created_at, t3.created_by, t3.deleted_at, t3.first_name, t3.id, t3.is_active, t3.is_confirmed, t3.is_service_account, t3.is_staff, t3.is_superuser, t3.last_login_at, t3.last_name, t3.locked_at, t3.password, t3.password_reset_token, t3.password_reset_token_expire_at, t3.two_factor_challenge, t3.two_factor_challenge_expire_at, t3.two_factor_confirmed_at, t3.two_factor_last_step, t3.two_factor_recovery_codes, t3.two_factor_secret, t3.updated_at, t3.updated_by, t3.username")
 	}
 	buf.WriteString(" FROM ")
 	buf.WriteString(r.Table)
@@ -8289,10 +10185,10 @@ func (r *GroupPermissionsRepositoryBase) FindIter(ctx context.Context, fe *Group
 }
 
 func (r *GroupPermissionsRepositoryBase) findOneByGroupIDAndPermissionSubsystemAndPermissionModuleAndPermissionAction(ctx context.Context, tx *sql.Tx, groupPermissionsGroupID int64, groupPermissionsPermissionSubsystem string, groupPermissionsPermissionModule string, groupPermissionsPermissionAction string) (*GroupPermissionsEntity, error) {
-	find := NewComposer(8)
+	find := NewComposer(9)
 	find.WriteString("SELECT ")
 	if len(r.Columns) == 0 {
-		find.WriteString("created_at, created_by, group_id, permission_action, permission_module, permission_subsystem, updated_at, updated_by")
+		find.WriteString("created_at, created_by, denied, group_id, permission_action, permission_module, permission_subsystem, updated_at, updated_by")
 	} else {
 		find.WriteString(strings.Join(r.Columns, ", "))
 	}
@@ -8384,6 +10280,25 @@ func (r *GroupPermissionsRepositoryBase) UpdateOneByGroupIDAndPermissionSubsyste
 		update.Dirty = true
 	}
 
+	if p.Denied.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableGroupPermissionsColumnDenied); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Denied)
+		update.Dirty = true
+	}
+
 	if p.GroupID.Valid {
 		if update.Dirty {
 			if _, err := update.WriteString(", "); err != nil {
@@ -8541,7 +10456,7 @@ func (r *GroupPermissionsRepositoryBase) UpdateOneByGroupIDAndPermissionSubsyste
 	if len(r.Columns) > 0 {
 		buf.WriteString(strings.Join(r.Columns, ", "))
 	} else {
-		buf.WriteString("created_at, created_by, group_id, permission_action, permission_module, permission_subsystem, updated_at, updated_by")
+		buf.WriteString("created_at, created_by, denied, group_id, permission_action, permission_module, permission_subsystem, updated_at, updated_by")
 	}
 	return buf.String(), update.Args(), nil
 }
@@ -8582,7 +10497,7 @@ func (r *GroupPermissionsRepositoryBase) UpdateOneByGroupIDAndPermissionSubsyste
 }
 
 func (r *GroupPermissionsRepositoryBase) UpsertQuery(e *GroupPermissionsEntity, p *GroupPermissionsPatch, inf ...string) (string, []interface{}, error) {
-	upsert := NewComposer(16)
+	upsert := NewComposer(18)
 	columns := bytes.NewBuffer(nil)
 	buf := bytes.NewBufferString("INSERT INTO ")
 	buf.WriteString(r.Table)
@@ -8627,6 +10542,25 @@ func (r *GroupPermissionsRepositoryBase) UpsertQuery(e *GroupPermissionsEntity,
 	upsert.Add(e.CreatedBy)
 	upsert.Dirty = true
 
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableGroupPermissionsColumnDenied); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.Denied)
+	upsert.Dirty = true
+
 	if columns.Len() > 0 {
 		if _, err := columns.WriteString(", "); err != nil {
 			return "", nil, err
@@ -8791,6 +10725,25 @@ func (r *GroupPermissionsRepositoryBase) UpsertQuery(e *GroupPermissionsEntity,
 			upsert.Dirty = true
 		}
 
+		if p.Denied.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableGroupPermissionsColumnDenied); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Denied)
+			upsert.Dirty = true
+		}
+
 		if p.GroupID.Valid {
 			if upsert.Dirty {
 				if _, err := upsert.WriteString(", "); err != nil {
@@ -8938,7 +10891,7 @@ func (r *GroupPermissionsRepositoryBase) UpsertQuery(e *GroupPermissionsEntity,
 		if len(r.Columns) > 0 {
 			buf.WriteString(strings.Join(r.Columns, ", "))
 		} else {
-			buf.WriteString("created_at, created_by, group_id, permission_action, permission_module, permission_subsystem, updated_at, updated_by")
+			buf.WriteString("created_at, created_by, denied, group_id, permission_action, permission_module, permission_subsystem, updated_at, updated_by")
 		}
 	}
 	return buf.String(), upsert.Args(), nil
@@ -8959,6 +10912,7 @@ func (r *GroupPermissionsRepositoryBase) upsert(ctx context.Context, tx *sql.Tx,
 	err = row.Scan(
 		&e.CreatedAt,
 		&e.CreatedBy,
+		&e.Denied,
 		&e.GroupID,
 		&e.PermissionAction,
 		&e.PermissionModule,
@@ -9067,23 +11021,29 @@ const (
 	TableUserPermissions                          = "charon.user_permissions"
 	TableUserPermissionsColumnCreatedAt           = "created_at"
 	TableUserPermissionsColumnCreatedBy           = "created_by"
+	TableUserPermissionsColumnDenied              = "denied"
 	TableUserPermissionsColumnPermissionAction    = "permission_action"
 	TableUserPermissionsColumnPermissionModule    = "permission_module"
 	TableUserPermissionsColumnPermissionSubsystem = "permission_subsystem"
 	TableUserPermissionsColumnUpdatedAt           = "updated_at"
 	TableUserPermissionsColumnUpdatedBy           = "updated_by"
 	TableUserPermissionsColumnUserID              = "user_id"
+	TableUserPermissionsColumnValidFrom           = "valid_from"
+	TableUserPermissionsColumnValidUntil          = "valid_until"
 )
 
 var TableUserPermissionsColumns = []string{
 	TableUserPermissionsColumnCreatedAt,
 	TableUserPermissionsColumnCreatedBy,
+	TableUserPermissionsColumnDenied,
 	TableUserPermissionsColumnPermissionAction,
 	TableUserPermissionsColumnPermissionModule,
 	TableUserPermissionsColumnPermissionSubsystem,
 	TableUserPermissionsColumnUpdatedAt,
 	TableUserPermissionsColumnUpdatedBy,
 	TableUserPermissionsColumnUserID,
+	TableUserPermissionsColumnValidFrom,
+	TableUserPermissionsColumnValidUntil,
 }
 
 // UserPermissionsEntity ...
@@ -9092,6 +11052,8 @@ type UserPermissionsEntity struct {
 	CreatedAt time.Time
 	// CreatedBy ...
 	CreatedBy ntypes.Int64
+	// Denied ...
+	Denied bool
 	// PermissionAction ...
 	PermissionAction string
 	// PermissionModule ...
@@ -9104,6 +11066,10 @@ type UserPermissionsEntity struct {
 	UpdatedBy ntypes.Int64
 	// UserID ...
 	UserID int64
+	// ValidFrom ...
+	ValidFrom pq.NullTime
+	// ValidUntil ...
+	ValidUntil pq.NullTime
 	// User ...
 	User *UserEntity
 	// Author ...
@@ -9119,6 +11085,8 @@ func (e *UserPermissionsEntity) Prop(cn string) (interface{}, bool) {
 		return &e.CreatedAt, true
 	case TableUserPermissionsColumnCreatedBy:
 		return &e.CreatedBy, true
+	case TableUserPermissionsColumnDenied:
+		return &e.Denied, true
 	case TableUserPermissionsColumnPermissionAction:
 		return &e.PermissionAction, true
 	case TableUserPermissionsColumnPermissionModule:
@@ -9131,6 +11099,10 @@ func (e *UserPermissionsEntity) Prop(cn string) (interface{}, bool) {
 		return &e.UpdatedBy, true
 	case TableUserPermissionsColumnUserID:
 		return &e.UserID, true
+	case TableUserPermissionsColumnValidFrom:
+		return &e.ValidFrom, true
+	case TableUserPermissionsColumnValidUntil:
+		return &e.ValidUntil, true
 	default:
 		return nil, false
 	}
@@ -9158,12 +11130,15 @@ func ScanUserPermissionsRows(rows Rows) (entities []*UserPermissionsEntity, err
 		err = rows.Scan(
 			&ent.CreatedAt,
 			&ent.CreatedBy,
+			&ent.Denied,
 			&ent.PermissionAction,
 			&ent.PermissionModule,
 			&ent.PermissionSubsystem,
 			&ent.UpdatedAt,
 			&ent.UpdatedBy,
 			&ent.UserID,
+			&ent.ValidFrom,
+			&ent.ValidUntil,
 		)
 		if err != nil {
 			return
@@ -9256,12 +11231,15 @@ func (i *UserPermissionsIterator) UserPermissions() (*UserPermissionsEntity, err
 type UserPermissionsCriteria struct {
 	CreatedAt              *qtypes.Timestamp
 	CreatedBy              *qtypes.Int64
+	Denied                 ntypes.Bool
 	PermissionAction       *qtypes.String
 	PermissionModule       *qtypes.String
 	PermissionSubsystem    *qtypes.String
 	UpdatedAt              *qtypes.Timestamp
 	UpdatedBy              *qtypes.Int64
 	UserID                 *qtypes.Int64
+	ValidFrom              *qtypes.Timestamp
+	ValidUntil             *qtypes.Timestamp
 	operator               string
 	child, sibling, parent *UserPermissionsCriteria
 }
@@ -9323,12 +11301,15 @@ type UserPermissionsCountExpr struct {
 type UserPermissionsPatch struct {
 	CreatedAt           pq.NullTime
 	CreatedBy           ntypes.Int64
+	Denied              ntypes.Bool
 	PermissionAction    ntypes.String
 	PermissionModule    ntypes.String
 	PermissionSubsystem ntypes.String
 	UpdatedAt           pq.NullTime
 	UpdatedBy           ntypes.Int64
 	UserID              ntypes.Int64
+	ValidFrom           pq.NullTime
+	ValidUntil          pq.NullTime
 }
 
 type UserPermissionsRepositoryBase struct {
@@ -9364,7 +11345,7 @@ func (r UserPermissionsRepositoryBase) RunInTransaction(ctx context.Context, fn
 }
 
 func (r *UserPermissionsRepositoryBase) InsertQuery(e *UserPermissionsEntity, read bool) (string, []interface{}, error) {
-	insert := NewComposer(8)
+	insert := NewComposer(11)
 	columns := bytes.NewBuffer(nil)
 	buf := bytes.NewBufferString("INSERT INTO ")
 	buf.WriteString(r.Table)
@@ -9409,6 +11390,25 @@ func (r *UserPermissionsRepositoryBase) InsertQuery(e *UserPermissionsEntity, re
 	insert.Add(e.CreatedBy)
 	insert.Dirty = true
 
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableUserPermissionsColumnDenied); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.Denied)
+	insert.Dirty = true
+
 	if columns.Len() > 0 {
 		if _, err := columns.WriteString(", "); err != nil {
 			return "", nil, err
@@ -9525,6 +11525,48 @@ func (r *UserPermissionsRepositoryBase) InsertQuery(e *UserPermissionsEntity, re
 	insert.Add(e.UserID)
 	insert.Dirty = true
 
+	if e.ValidFrom.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableUserPermissionsColumnValidFrom); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ValidFrom)
+		insert.Dirty = true
+	}
+
+	if e.ValidUntil.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableUserPermissionsColumnValidUntil); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ValidUntil)
+		insert.Dirty = true
+	}
+
 	if columns.Len() > 0 {
 		buf.WriteString(" (")
 		buf.ReadFrom(columns)
@@ -9536,7 +11578,7 @@ func (r *UserPermissionsRepositoryBase) InsertQuery(e *UserPermissionsEntity, re
 			if len(r.Columns) > 0 {
 				buf.WriteString(strings.Join(r.Columns, ", "))
 			} else {
-				buf.WriteString("created_at, created_by, permission_action, permission_module, permission_subsystem, updated_at, updated_by, user_id")
+				buf.WriteString("created_at, created_by, denied, permission_action, permission_module, permission_subsystem, updated_at, updated_by, user_id, valid_from, valid_until")
 			}
 		}
 	}
@@ -9558,12 +11600,15 @@ func (r *UserPermissionsRepositoryBase) insert(ctx context.Context, tx *sql.Tx,
 	err = row.Scan(
 		&e.CreatedAt,
 		&e.CreatedBy,
+		&e.Denied,
 		&e.PermissionAction,
 		&e.PermissionModule,
 		&e.PermissionSubsystem,
 		&e.UpdatedAt,
 		&e.UpdatedBy,
 		&e.UserID,
+		&e.ValidFrom,
+		&e.ValidUntil,
 	)
 	if r.Log != nil {
 		if tx == nil {
@@ -9632,6 +11677,28 @@ func _UserPermissionsCriteriaWhereClause(comp *Composer, c *UserPermissionsCrite
 
 	QueryInt64WhereClause(c.CreatedBy, id, TableUserPermissionsColumnCreatedBy, comp, And)
 
+	if c.Denied.Valid {
+		if comp.Dirty {
+			if _, err := comp.WriteString(" AND "); err != nil {
+				return err
+			}
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableUserPermissionsColumnDenied); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.Denied)
+		comp.Dirty = true
+	}
+
 	QueryStringWhereClause(c.PermissionAction, id, TableUserPermissionsColumnPermissionAction, comp, And)
 
 	QueryStringWhereClause(c.PermissionModule, id, TableUserPermissionsColumnPermissionModule, comp, And)
@@ -9644,25 +11711,29 @@ func _UserPermissionsCriteriaWhereClause(comp *Composer, c *UserPermissionsCrite
 
 	QueryInt64WhereClause(c.UserID, id, TableUserPermissionsColumnUserID, comp, And)
 
+	QueryTimestampWhereClause(c.ValidFrom, id, TableUserPermissionsColumnValidFrom, comp, And)
+
+	QueryTimestampWhereClause(c.ValidUntil, id, TableUserPermissionsColumnValidUntil, comp, And)
+
 	return nil
 }
 
 func (r *UserPermissionsRepositoryBase) FindQuery(fe *UserPermissionsFindExpr) (string, []interface{}, error) {
-	comp := NewComposer(8)
+	comp := NewComposer(11)
 	buf := bytes.NewBufferString("SELECT ")
 	if len(fe.Columns) == 0 {
-		buf.WriteString("t0.created_at, t0.created_by, t0.permission_action, t0.permission_module, t0.permission_subsystem, t0.updated_at, t0.updated_by, t0.user_id")
+		buf.WriteString("t0.created_at, t0.created_by, t0.denied, t0.permission_action, t0.permission_module, t0.permission_subsystem, t0.updated_at, t0.updated_by, t0.user_id, t0.valid_from, t0.valid_until")
 	} else {
 		buf.WriteString(strings.Join(fe.Columns, ", "))
 	}
 	if fe.JoinUser != nil && fe.JoinUser.Kind.Actionable() && fe.JoinUser.Fetch {
-		buf.WriteString(", t1.confirmation_token, t1.created_at, t1.created_by, t1.first_name, t1.id, t1.is_active, t1.is_confirmed, t1.is_staff, t1.is_superuser, t1.last_login_at, t1.last_name, t1.password, t1.updated_at, t1.updated_by, t1.username")
+		buf.WriteString(", t1.confirmation_token, t1.confirmation_token_expire_at, t1.created_at, t1.created_by, t1.deleted_at, t1.first_name, t1.id, t1.is_active, t1.is_confirmed, t1.is_service_account, t1.is_staff, t1.is_superuser, t1.last_login_at, t1.last_name, t1.locked_at, t1.password, t1.password_reset_token, t1.password_reset_token_expire_at, t1.two_factor_challenge, t1.two_factor_challenge_expire_at, t1.two_factor_confirmed_at, t1.two_factor_last_step, t1.two_factor_recovery_codes, t1.two_factor_secret, t1.updated_at, t1.updated_by, t1.username")
 	}
 	if fe.JoinAuthor != nil && fe.JoinAuthor.Kind.Actionable() && fe.JoinAuthor.Fetch {
-		buf.WriteString(", t2.confirmation_token, t2.created_at, t2.created_by, t2.first_name, t2.id, t2.is_active, t2.is_confirmed, t2.is_staff, t2.is_superuser, t2.last_login_at, t2.last_name, t2.password, t2.updated_at, t2.updated_by, t2.username")
+		buf.WriteString(", t2.confirmation_token, t2.confirmation_token_expire_at, t2.created_at, t2.created_by, t2.deleted_at, t2.first_name, t2.id, t2.is_active, t2.is_confirmed, t2.is_service_account, t2.is_staff, t2.is_superuser, t2.last_login_at, t2.last_name, t2.locked_at, t2.password, t2.password_reset_token, t2.password_reset_token_expire_at, t2.two_factor_challenge, t2.two_factor_challenge_expire_at, t2.two_factor_confirmed_at, t2.two_factor_last_step, t2.two_factor_recovery_codes, t2.two_factor_secret, t2.updated_at, t2.updated_by, t2.username")
 	}
 	if fe.JoinModifier != nil && fe.JoinModifier.Kind.Actionable() && fe.JoinModifier.Fetch {
-		buf.WriteString(", t3.confirmation_token, t3.created_at, t3.created_by, t3.first_name, t3.id, t3.is_active, t3.is_confirmed, t3.is_staff, t3.is_superuser, t3.last_login_at, t3.last_name, t3.password, t3.updated_at, t3.updated_by, t3.username")
+		buf.WriteString(", t3.confirmation_token, t3.confirmation_token_expire_at, t3.created_at, t3.created_by, t3.deleted_at, t3.first_name, t3.id, t3.is_active, t3.is_confirmed, t3.is_service_account, t3.is_staff, t3.is_superuser, t3.last_login_at, t3.last_name, t3.locked_at, t3.password, t3.password_reset_token, t3.password_reset_token_expire_at, t3.two_factor_challenge, t3.two_factor_challenge_expire_at, t3.two_factor_confirmed_at, t3.two_factor_last_step, t3.two_factor_recovery_codes, t3.two_factor_secret, t3.updated_at, t3.updated_by, t3.username")
 	}
 	buf.WriteString(" FROM ")
 	buf.WriteString(r.Table)
@@ -9889,10 +11960,10 @@ func (r *UserPermissionsRepositoryBase) FindIter(ctx context.Context, fe *UserPe
 }
 
 func (r *UserPermissionsRepositoryBase) findOneByUserIDAndPermissionSubsystemAndPermissionModuleAndPermissionAction(ctx context.Context, tx *sql.Tx, userPermissionsUserID int64, userPermissionsPermissionSubsystem string, userPermissionsPermissionModule string, userPermissionsPermissionAction string) (*UserPermissionsEntity, error) {
-	find := NewComposer(8)
+	find := NewComposer(11)
 	find.WriteString("SELECT ")
 	if len(r.Columns) == 0 {
-		find.WriteString("created_at, created_by, permission_action, permission_module, permission_subsystem, updated_at, updated_by, user_id")
+		find.WriteString("created_at, created_by, denied, permission_action, permission_module, permission_subsystem, updated_at, updated_by, user_id, valid_from, valid_until")
 	} else {
 		find.WriteString(strings.Join(r.Columns, ", "))
 	}
@@ -9984,6 +12055,25 @@ func (r *UserPermissionsRepositoryBase) UpdateOneByUserIDAndPermissionSubsystemA
 		update.Dirty = true
 	}
 
+	if p.Denied.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserPermissionsColumnDenied); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Denied)
+		update.Dirty = true
+	}
+
 	if p.PermissionAction.Valid {
 		if update.Dirty {
 			if _, err := update.WriteString(", "); err != nil {
@@ -10111,6 +12201,44 @@ func (r *UserPermissionsRepositoryBase) UpdateOneByUserIDAndPermissionSubsystemA
 		update.Dirty = true
 	}
 
+	if p.ValidFrom.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserPermissionsColumnValidFrom); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.ValidFrom)
+		update.Dirty = true
+
+	}
+	if p.ValidUntil.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserPermissionsColumnValidUntil); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.ValidUntil)
+		update.Dirty = true
+
+	}
 	if !update.Dirty {
 		return "", nil, errors.New("user_permissions update failure, nothing to update")
 	}
@@ -10141,7 +12269,7 @@ func (r *UserPermissionsRepositoryBase) UpdateOneByUserIDAndPermissionSubsystemA
 	if len(r.Columns) > 0 {
 		buf.WriteString(strings.Join(r.Columns, ", "))
 	} else {
-		buf.WriteString("created_at, created_by, permission_action, permission_module, permission_subsystem, updated_at, updated_by, user_id")
+		buf.WriteString("created_at, created_by, denied, permission_action, permission_module, permission_subsystem, updated_at, updated_by, user_id, valid_from, valid_until")
 	}
 	return buf.String(), update.Args(), nil
 }
@@ -10182,7 +12310,7 @@ func (r *UserPermissionsRepositoryBase) UpdateOneByUserIDAndPermissionSubsystemA
 }
 
 func (r *UserPermissionsRepositoryBase) UpsertQuery(e *UserPermissionsEntity, p *UserPermissionsPatch, inf ...string) (string, []interface{}, error) {
-	upsert := NewComposer(16)
+	upsert := NewComposer(22)
 	columns := bytes.NewBuffer(nil)
 	buf := bytes.NewBufferString("INSERT INTO ")
 	buf.WriteString(r.Table)
@@ -10227,6 +12355,25 @@ func (r *UserPermissionsRepositoryBase) UpsertQuery(e *UserPermissionsEntity, p
 	upsert.Add(e.CreatedBy)
 	upsert.Dirty = true
 
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableUserPermissionsColumnDenied); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.Denied)
+	upsert.Dirty = true
+
 	if columns.Len() > 0 {
 		if _, err := columns.WriteString(", "); err != nil {
 			return "", nil, err
@@ -10343,30 +12490,72 @@ func (r *UserPermissionsRepositoryBase) UpsertQuery(e *UserPermissionsEntity, p
 	upsert.Add(e.UserID)
 	upsert.Dirty = true
 
-	if upsert.Dirty {
-		buf.WriteString(" (")
-		buf.ReadFrom(columns)
-		buf.WriteString(") VALUES (")
-		buf.ReadFrom(upsert)
-		buf.WriteString(")")
-	}
-	buf.WriteString(" ON CONFLICT ")
-	if len(inf) > 0 {
-		upsert.Dirty = false
-		if p.CreatedAt.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableUserPermissionsColumnCreatedAt); err != nil {
+	if e.ValidFrom.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
 				return "", nil, err
 			}
-			if _, err := upsert.WriteString("="); err != nil {
+		}
+		if _, err := columns.WriteString(TableUserPermissionsColumnValidFrom); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
 				return "", nil, err
 			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ValidFrom)
+		upsert.Dirty = true
+	}
+
+	if e.ValidUntil.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableUserPermissionsColumnValidUntil); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ValidUntil)
+		upsert.Dirty = true
+	}
+
+	if upsert.Dirty {
+		buf.WriteString(" (")
+		buf.ReadFrom(columns)
+		buf.WriteString(") VALUES (")
+		buf.ReadFrom(upsert)
+		buf.WriteString(")")
+	}
+	buf.WriteString(" ON CONFLICT ")
+	if len(inf) > 0 {
+		upsert.Dirty = false
+		if p.CreatedAt.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableUserPermissionsColumnCreatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
 			}
 			upsert.Add(p.CreatedAt)
 			upsert.Dirty = true
@@ -10391,6 +12580,25 @@ func (r *UserPermissionsRepositoryBase) UpsertQuery(e *UserPermissionsEntity, p
 			upsert.Dirty = true
 		}
 
+		if p.Denied.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableUserPermissionsColumnDenied); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Denied)
+			upsert.Dirty = true
+		}
+
 		if p.PermissionAction.Valid {
 			if upsert.Dirty {
 				if _, err := upsert.WriteString(", "); err != nil {
@@ -10518,6 +12726,44 @@ func (r *UserPermissionsRepositoryBase) UpsertQuery(e *UserPermissionsEntity, p
 			upsert.Dirty = true
 		}
 
+		if p.ValidFrom.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableUserPermissionsColumnValidFrom); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ValidFrom)
+			upsert.Dirty = true
+
+		}
+		if p.ValidUntil.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableUserPermissionsColumnValidUntil); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ValidUntil)
+			upsert.Dirty = true
+
+		}
 	}
 	if len(inf) > 0 && upsert.Dirty {
 		buf.WriteString("(")
@@ -10538,7 +12784,7 @@ func (r *UserPermissionsRepositoryBase) UpsertQuery(e *UserPermissionsEntity, p
 		if len(r.Columns) > 0 {
 			buf.WriteString(strings.Join(r.Columns, ", "))
 		} else {
-			buf.WriteString("created_at, created_by, permission_action, permission_module, permission_subsystem, updated_at, updated_by, user_id")
+			buf.WriteString("created_at, created_by, denied, permission_action, permission_module, permission_subsystem, updated_at, updated_by, user_id, valid_from, valid_until")
 		}
 	}
 	return buf.String(), upsert.Args(), nil
@@ -10559,12 +12805,15 @@ func (r *UserPermissionsRepositoryBase) upsert(ctx context.Context, tx *sql.Tx,
 	err = row.Scan(
 		&e.CreatedAt,
 		&e.CreatedBy,
+		&e.Denied,
 		&e.PermissionAction,
 		&e.PermissionModule,
 		&e.PermissionSubsystem,
 		&e.UpdatedAt,
 		&e.UpdatedBy,
 		&e.UserID,
+		&e.ValidFrom,
+		&e.ValidUntil,
 	)
 	if r.Log != nil {
 		if tx == nil {
@@ -10656,55 +12905,48 @@ func (r *UserPermissionsRepositoryBaseTx) Count(ctx context.Context, exp *UserPe
 }
 
 const (
-	TableRefreshTokenConstraintTokenUnique         = "charon.refresh_token_token_key"
-	TableRefreshTokenConstraintUserIDForeignKey    = "charon.refresh_token_user_id_fkey"
-	TableRefreshTokenConstraintCreatedByForeignKey = "charon.refresh_token_created_by_fkey"
-	TableRefreshTokenConstraintUpdatedByForeignKey = "charon.refresh_token_updated_by_fkey"
+	TableUserResourcePermissionsConstraintUserIDForeignKey                               = "charon.user_resource_permissions_user_id_fkey"
+	TableUserResourcePermissionsConstraintPermissionIDForeignKey                         = "charon.user_resource_permissions_permission_id_fkey"
+	TableUserResourcePermissionsConstraintUserIDPermissionIDResourceTypeResourceIDUnique = "charon.user_resource_permissions_user_id_permission_id_resource_type_resource_id_key"
+	TableUserResourcePermissionsConstraintCreatedByForeignKey                            = "charon.user_resource_permissions_created_by_fkey"
+	TableUserResourcePermissionsConstraintUpdatedByForeignKey                            = "charon.user_resource_permissions_updated_by_fkey"
 )
 
 const (
-	TableRefreshToken                 = "charon.refresh_token"
-	TableRefreshTokenColumnCreatedAt  = "created_at"
-	TableRefreshTokenColumnCreatedBy  = "created_by"
-	TableRefreshTokenColumnExpireAt   = "expire_at"
-	TableRefreshTokenColumnLastUsedAt = "last_used_at"
-	TableRefreshTokenColumnNotes      = "notes"
-	TableRefreshTokenColumnRevoked    = "revoked"
-	TableRefreshTokenColumnToken      = "token"
-	TableRefreshTokenColumnUpdatedAt  = "updated_at"
-	TableRefreshTokenColumnUpdatedBy  = "updated_by"
-	TableRefreshTokenColumnUserID     = "user_id"
+	TableUserResourcePermissions                   = "charon.user_resource_permissions"
+	TableUserResourcePermissionsColumnCreatedAt    = "created_at"
+	TableUserResourcePermissionsColumnCreatedBy    = "created_by"
+	TableUserResourcePermissionsColumnPermissionID = "permission_id"
+	TableUserResourcePermissionsColumnResourceID   = "resource_id"
+	TableUserResourcePermissionsColumnResourceType = "resource_type"
+	TableUserResourcePermissionsColumnUpdatedAt    = "updated_at"
+	TableUserResourcePermissionsColumnUpdatedBy    = "updated_by"
+	TableUserResourcePermissionsColumnUserID       = "user_id"
 )
 
-var TableRefreshTokenColumns = []string{
-	TableRefreshTokenColumnCreatedAt,
-	TableRefreshTokenColumnCreatedBy,
-	TableRefreshTokenColumnExpireAt,
-	TableRefreshTokenColumnLastUsedAt,
-	TableRefreshTokenColumnNotes,
-	TableRefreshTokenColumnRevoked,
-	TableRefreshTokenColumnToken,
-	TableRefreshTokenColumnUpdatedAt,
-	TableRefreshTokenColumnUpdatedBy,
-	TableRefreshTokenColumnUserID,
+var TableUserResourcePermissionsColumns = []string{
+	TableUserResourcePermissionsColumnCreatedAt,
+	TableUserResourcePermissionsColumnCreatedBy,
+	TableUserResourcePermissionsColumnPermissionID,
+	TableUserResourcePermissionsColumnResourceID,
+	TableUserResourcePermissionsColumnResourceType,
+	TableUserResourcePermissionsColumnUpdatedAt,
+	TableUserResourcePermissionsColumnUpdatedBy,
+	TableUserResourcePermissionsColumnUserID,
 }
 
-// RefreshTokenEntity ...
-type RefreshTokenEntity struct {
+// UserResourcePermissionsEntity ...
+type UserResourcePermissionsEntity struct {
 	// CreatedAt ...
 	CreatedAt time.Time
 	// CreatedBy ...
 	CreatedBy ntypes.Int64
-	// ExpireAt ...
-	ExpireAt pq.NullTime
-	// LastUsedAt ...
-	LastUsedAt pq.NullTime
-	// Notes ...
-	Notes ntypes.String
-	// Revoked ...
-	Revoked bool
-	// Token ...
-	Token string
+	// PermissionID ...
+	PermissionID int64
+	// ResourceID ...
+	ResourceID string
+	// ResourceType ...
+	ResourceType string
 	// UpdatedAt ...
 	UpdatedAt pq.NullTime
 	// UpdatedBy ...
@@ -10713,43 +12955,41 @@ type RefreshTokenEntity struct {
 	UserID int64
 	// User ...
 	User *UserEntity
+	// Permission ...
+	Permission *PermissionEntity
 	// Author ...
 	Author *UserEntity
 	// Modifier ...
 	Modifier *UserEntity
 }
 
-func (e *RefreshTokenEntity) Prop(cn string) (interface{}, bool) {
+func (e *UserResourcePermissionsEntity) Prop(cn string) (interface{}, bool) {
 	switch cn {
 
-	case TableRefreshTokenColumnCreatedAt:
+	case TableUserResourcePermissionsColumnCreatedAt:
 		return &e.CreatedAt, true
-	case TableRefreshTokenColumnCreatedBy:
+	case TableUserResourcePermissionsColumnCreatedBy:
 		return &e.CreatedBy, true
-	case TableRefreshTokenColumnExpireAt:
-		return &e.ExpireAt, true
-	case TableRefreshTokenColumnLastUsedAt:
-		return &e.LastUsedAt, true
-	case TableRefreshTokenColumnNotes:
-		return &e.Notes, true
-	case TableRefreshTokenColumnRevoked:
-		return &e.Revoked, true
-	case TableRefreshTokenColumnToken:
-		return &e.Token, true
-	case TableRefreshTokenColumnUpdatedAt:
+	case TableUserResourcePermissionsColumnPermissionID:
+		return &e.PermissionID, true
+	case TableUserResourcePermissionsColumnResourceID:
+		return &e.ResourceID, true
+	case TableUserResourcePermissionsColumnResourceType:
+		return &e.ResourceType, true
+	case TableUserResourcePermissionsColumnUpdatedAt:
 		return &e.UpdatedAt, true
-	case TableRefreshTokenColumnUpdatedBy:
+	case TableUserResourcePermissionsColumnUpdatedBy:
 		return &e.UpdatedBy, true
-	case TableRefreshTokenColumnUserID:
+	case TableUserResourcePermissionsColumnUserID:
 		return &e.UserID, true
 	default:
 		return nil, false
 	}
 }
 
-func (e *RefreshTokenEntity) Props(cns ...string) ([]interface{}, error) {
+func (e *UserResourcePermissionsEntity) Props(cns ...string) ([]interface{}, error) {
 	if len(cns) == 0 {
-		cns = TableRefreshTokenColumns
+		cns = TableUserResourcePermissionsColumns
 	}
 	res := make([]interface{}, 0, len(cns))
 	for _, cn := range cns {
@@ -10762,18 +13002,16 @@ func (e *RefreshTokenEntity) Props(cns ...string) ([]interface{}, error) {
 	return res, nil
 }
 
-// ScanRefreshTokenRows helps to scan rows straight to the slice of entities.
-func ScanRefreshTokenRows(rows Rows) (entities []*RefreshTokenEntity, err error) {
+// ScanUserResourcePermissionsRows helps to scan rows straight to the slice of entities.
+func ScanUserResourcePermissionsRows(rows Rows) (entities []*UserResourcePermissionsEntity, err error) {
 	for rows.Next() {
-		var ent RefreshTokenEntity
+		var ent UserResourcePermissionsEntity
 		err = rows.Scan(
 			&ent.CreatedAt,
 			&ent.CreatedBy,
-			&ent.ExpireAt,
-			&ent.LastUsedAt,
-			&ent.Notes,
-			&ent.Revoked,
-			&ent.Token,
+			&ent.PermissionID,
+			&ent.ResourceID,
+			&ent.ResourceType,
 			&ent.UpdatedAt,
 			&ent.UpdatedBy,
 			&ent.UserID,
@@ -10791,27 +13029,27 @@ func ScanRefreshTokenRows(rows Rows) (entities []*RefreshTokenEntity, err error)
 	return
 }
 
-// RefreshTokenIterator is not thread safe.
-type RefreshTokenIterator struct {
+// UserResourcePermissionsIterator is not thread safe.
+type UserResourcePermissionsIterator struct {
 	rows Rows
 	cols []string
-	expr *RefreshTokenFindExpr
+	expr *UserResourcePermissionsFindExpr
 }
 
-func (i *RefreshTokenIterator) Next() bool {
+func (i *UserResourcePermissionsIterator) Next() bool {
 	return i.rows.Next()
 }
 
-func (i *RefreshTokenIterator) Close() error {
+func (i *UserResourcePermissionsIterator) Close() error {
 	return i.rows.Close()
 }
 
-func (i *RefreshTokenIterator) Err() error {
+func (i *UserResourcePermissionsIterator) Err() error {
 	return i.rows.Err()
 }
 
 // Columns is wrapper around sql.Rows.Columns method, that also cache output inside iterator.
-func (i *RefreshTokenIterator) Columns() ([]string, error) {
+func (i *UserResourcePermissionsIterator) Columns() ([]string, error) {
 	if i.cols == nil {
 		cols, err := i.rows.Columns()
 		if err != nil {
@@ -10822,13 +13060,13 @@ func (i *RefreshTokenIterator) Columns() ([]string, error) {
 	return i.cols, nil
 }
 
-// Ent is wrapper around RefreshToken method that makes iterator more generic.
-func (i *RefreshTokenIterator) Ent() (interface{}, error) {
-	return i.RefreshToken()
+// Ent is wrapper around UserResourcePermissions method that makes iterator more generic.
+func (i *UserResourcePermissionsIterator) Ent() (interface{}, error) {
+	return i.UserResourcePermissions()
 }
 
-func (i *RefreshTokenIterator) RefreshToken() (*RefreshTokenEntity, error) {
-	var ent RefreshTokenEntity
+func (i *UserResourcePermissionsIterator) UserResourcePermissions() (*UserResourcePermissionsEntity, error) {
+	var ent UserResourcePermissionsEntity
 	cols, err := i.Columns()
 	if err != nil {
 		return nil, err
@@ -10846,6 +13084,13 @@ func (i *RefreshTokenIterator) RefreshToken() (*RefreshTokenEntity, error) {
 		}
 		props = append(props, prop...)
 	}
+	if i.expr.JoinPermission != nil && i.expr.JoinPermission.Kind.Actionable() && i.expr.JoinPermission.Fetch {
+		ent.Permission = &PermissionEntity{}
+		if prop, err = ent.Permission.Props(); err != nil {
+			return nil, err
+		}
+		props = append(props, prop...)
+	}
 	if i.expr.JoinAuthor != nil && i.expr.JoinAuthor.Kind.Actionable() && i.expr.JoinAuthor.Fetch {
 		ent.Author = &UserEntity{}
 		if prop, err = ent.Author.Props(); err != nil {
@@ -10866,27 +13111,25 @@ func (i *RefreshTokenIterator) RefreshToken() (*RefreshTokenEntity, error) {
 	return &ent, nil
 }
 
-type RefreshTokenCriteria struct {
+type UserResourcePermissionsCriteria struct {
 	CreatedAt              *qtypes.Timestamp
 	CreatedBy              *qtypes.Int64
-	ExpireAt               *qtypes.Timestamp
-	LastUsedAt             *qtypes.Timestamp
-	Notes                  *qtypes.String
-	Revoked                ntypes.Bool
-	Token                  *qtypes.String
+	PermissionID           *qtypes.Int64
+	ResourceID             *qtypes.String
+	ResourceType           *qtypes.String
 	UpdatedAt              *qtypes.Timestamp
 	UpdatedBy              *qtypes.Int64
 	UserID                 *qtypes.Int64
 	operator               string
-	child, sibling, parent *RefreshTokenCriteria
+	child, sibling, parent *UserResourcePermissionsCriteria
 }
 
-func RefreshTokenOperand(operator string, operands ...*RefreshTokenCriteria) *RefreshTokenCriteria {
+func UserResourcePermissionsOperand(operator string, operands ...*UserResourcePermissionsCriteria) *UserResourcePermissionsCriteria {
 	if len(operands) == 0 {
-		return &RefreshTokenCriteria{operator: operator}
+		return &UserResourcePermissionsCriteria{operator: operator}
 	}
 
-	parent := &RefreshTokenCriteria{
+	parent := &UserResourcePermissionsCriteria{
 		operator: operator,
 		child:    operands[0],
 	}
@@ -10901,68 +13144,69 @@ func RefreshTokenOperand(operator string, operands ...*RefreshTokenCriteria) *Re
 	return parent
 }
 
-func RefreshTokenOr(operands ...*RefreshTokenCriteria) *RefreshTokenCriteria {
-	return RefreshTokenOperand("OR", operands...)
+func UserResourcePermissionsOr(operands ...*UserResourcePermissionsCriteria) *UserResourcePermissionsCriteria {
+	return UserResourcePermissionsOperand("OR", operands...)
 }
 
-func RefreshTokenAnd(operands ...*RefreshTokenCriteria) *RefreshTokenCriteria {
-	return RefreshTokenOperand("AND", operands...)
+func UserResourcePermissionsAnd(operands ...*UserResourcePermissionsCriteria) *UserResourcePermissionsCriteria {
+	return UserResourcePermissionsOperand("AND", operands...)
 }
 
-type RefreshTokenFindExpr struct {
-	Where         *RefreshTokenCriteria
-	Offset, Limit int64
-	Columns       []string
-	OrderBy       []RowOrder
-	JoinUser      *UserJoin
-	JoinAuthor    *UserJoin
-	JoinModifier  *UserJoin
+type UserResourcePermissionsFindExpr struct {
+	Where          *UserResourcePermissionsCriteria
+	Offset, Limit  int64
+	Columns        []string
+	OrderBy        []RowOrder
+	JoinUser       *UserJoin
+	JoinPermission *PermissionJoin
+	JoinAuthor     *UserJoin
+	JoinModifier   *UserJoin
 }
 
-type RefreshTokenJoin struct {
-	On, Where    *RefreshTokenCriteria
-	Fetch        bool
-	Kind         JoinType
-	JoinUser     *UserJoin
-	JoinAuthor   *UserJoin
-	JoinModifier *UserJoin
+type UserResourcePermissionsJoin struct {
+	On, Where      *UserResourcePermissionsCriteria
+	Fetch          bool
+	Kind           JoinType
+	JoinUser       *UserJoin
+	JoinPermission *PermissionJoin
+	JoinAuthor     *UserJoin
+	JoinModifier   *UserJoin
 }
 
-type RefreshTokenCountExpr struct {
-	Where        *RefreshTokenCriteria
-	JoinUser     *UserJoin
-	JoinAuthor   *UserJoin
-	JoinModifier *UserJoin
+type UserResourcePermissionsCountExpr struct {
+	Where          *UserResourcePermissionsCriteria
+	JoinUser       *UserJoin
+	JoinPermission *PermissionJoin
+	JoinAuthor     *UserJoin
+	JoinModifier   *UserJoin
 }
 
-type RefreshTokenPatch struct {
-	CreatedAt  pq.NullTime
-	CreatedBy  ntypes.Int64
-	ExpireAt   pq.NullTime
-	LastUsedAt pq.NullTime
-	Notes      ntypes.String
-	Revoked    ntypes.Bool
-	Token      ntypes.String
-	UpdatedAt  pq.NullTime
-	UpdatedBy  ntypes.Int64
-	UserID     ntypes.Int64
+type UserResourcePermissionsPatch struct {
+	CreatedAt    pq.NullTime
+	CreatedBy    ntypes.Int64
+	PermissionID ntypes.Int64
+	ResourceID   ntypes.String
+	ResourceType ntypes.String
+	UpdatedAt    pq.NullTime
+	UpdatedBy    ntypes.Int64
+	UserID       ntypes.Int64
 }
 
-type RefreshTokenRepositoryBase struct {
+type UserResourcePermissionsRepositoryBase struct {
 	Table   string
 	Columns []string
 	DB      *sql.DB
 	Log     LogFunc
 }
 
-func (r *RefreshTokenRepositoryBase) Tx(tx *sql.Tx) (*RefreshTokenRepositoryBaseTx, error) {
-	return &RefreshTokenRepositoryBaseTx{
+func (r *UserResourcePermissionsRepositoryBase) Tx(tx *sql.Tx) (*UserResourcePermissionsRepositoryBaseTx, error) {
+	return &UserResourcePermissionsRepositoryBaseTx{
 		base: r,
 		tx:   tx,
 	}, nil
 }
 
-func (r *RefreshTokenRepositoryBase) BeginTx(ctx context.Context) (*RefreshTokenRepositoryBaseTx, error) {
+func (r *UserResourcePermissionsRepositoryBase) BeginTx(ctx context.Context) (*UserResourcePermissionsRepositoryBaseTx, error) {
 	tx, err := r.DB.BeginTx(ctx, nil)
 	if err != nil {
 		return nil, err
@@ -10970,7 +13214,7 @@ func (r *RefreshTokenRepositoryBase) BeginTx(ctx context.Context) (*RefreshToken
 	return r.Tx(tx)
 }
 
-func (r RefreshTokenRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *RefreshTokenRepositoryBaseTx) error, attempts int) (err error) {
+func (r UserResourcePermissionsRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *UserResourcePermissionsRepositoryBaseTx) error, attempts int) (err error) {
 	return RunInTransaction(ctx, r.DB, func(tx *sql.Tx) error {
 		rtx, err := r.Tx(tx)
 		if err != nil {
@@ -10980,8 +13224,8 @@ func (r RefreshTokenRepositoryBase) RunInTransaction(ctx context.Context, fn fun
 	}, attempts)
 }
 
-func (r *RefreshTokenRepositoryBase) InsertQuery(e *RefreshTokenEntity, read bool) (string, []interface{}, error) {
-	insert := NewComposer(10)
+func (r *UserResourcePermissionsRepositoryBase) InsertQuery(e *UserResourcePermissionsEntity, read bool) (string, []interface{}, error) {
+	insert := NewComposer(8)
 	columns := bytes.NewBuffer(nil)
 	buf := bytes.NewBufferString("INSERT INTO ")
 	buf.WriteString(r.Table)
@@ -10992,7 +13236,7 @@ func (r *RefreshTokenRepositoryBase) InsertQuery(e *RefreshTokenEntity, read boo
 				return "", nil, err
 			}
 		}
-		if _, err := columns.WriteString(TableRefreshTokenColumnCreatedAt); err != nil {
+		if _, err := columns.WriteString(TableUserResourcePermissionsColumnCreatedAt); err != nil {
 			return "", nil, err
 		}
 		if insert.Dirty {
@@ -11012,7 +13256,7 @@ func (r *RefreshTokenRepositoryBase) InsertQuery(e *RefreshTokenEntity, read boo
 			return "", nil, err
 		}
 	}
-	if _, err := columns.WriteString(TableRefreshTokenColumnCreatedBy); err != nil {
+	if _, err := columns.WriteString(TableUserResourcePermissionsColumnCreatedBy); err != nil {
 		return "", nil, err
 	}
 	if insert.Dirty {
@@ -11026,73 +13270,31 @@ func (r *RefreshTokenRepositoryBase) InsertQuery(e *RefreshTokenEntity, read boo
 	insert.Add(e.CreatedBy)
 	insert.Dirty = true
 
-	if e.ExpireAt.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableRefreshTokenColumnExpireAt); err != nil {
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
 			return "", nil, err
 		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
+	}
+	if _, err := columns.WriteString(TableUserResourcePermissionsColumnPermissionID); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
 			return "", nil, err
 		}
-		insert.Add(e.ExpireAt)
-		insert.Dirty = true
 	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.PermissionID)
+	insert.Dirty = true
 
-	if e.LastUsedAt.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableRefreshTokenColumnLastUsedAt); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.LastUsedAt)
-		insert.Dirty = true
-	}
-
-	if columns.Len() > 0 {
-		if _, err := columns.WriteString(", "); err != nil {
-			return "", nil, err
-		}
-	}
-	if _, err := columns.WriteString(TableRefreshTokenColumnNotes); err != nil {
-		return "", nil, err
-	}
-	if insert.Dirty {
-		if _, err := insert.WriteString(", "); err != nil {
-			return "", nil, err
-		}
-	}
-	if err := insert.WritePlaceholder(); err != nil {
-		return "", nil, err
-	}
-	insert.Add(e.Notes)
-	insert.Dirty = true
-
-	if columns.Len() > 0 {
-		if _, err := columns.WriteString(", "); err != nil {
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
 			return "", nil, err
 		}
 	}
-	if _, err := columns.WriteString(TableRefreshTokenColumnRevoked); err != nil {
+	if _, err := columns.WriteString(TableUserResourcePermissionsColumnResourceID); err != nil {
 		return "", nil, err
 	}
 	if insert.Dirty {
@@ -11103,7 +13305,7 @@ func (r *RefreshTokenRepositoryBase) InsertQuery(e *RefreshTokenEntity, read boo
 	if err := insert.WritePlaceholder(); err != nil {
 		return "", nil, err
 	}
-	insert.Add(e.Revoked)
+	insert.Add(e.ResourceID)
 	insert.Dirty = true
 
 	if columns.Len() > 0 {
@@ -11111,7 +13313,7 @@ func (r *RefreshTokenRepositoryBase) InsertQuery(e *RefreshTokenEntity, read boo
 			return "", nil, err
 		}
 	}
-	if _, err := columns.WriteString(TableRefreshTokenColumnToken); err != nil {
+	if _, err := columns.WriteString(TableUserResourcePermissionsColumnResourceType); err != nil {
 		return "", nil, err
 	}
 	if insert.Dirty {
@@ -11122,7 +13324,7 @@ func (r *RefreshTokenRepositoryBase) InsertQuery(e *RefreshTokenEntity, read boo
 	if err := insert.WritePlaceholder(); err != nil {
 		return "", nil, err
 	}
-	insert.Add(e.Token)
+	insert.Add(e.ResourceType)
 	insert.Dirty = true
 
 	if e.UpdatedAt.Valid {
@@ -11131,7 +13333,7 @@ func (r *RefreshTokenRepositoryBase) InsertQuery(e *RefreshTokenEntity, read boo
 				return "", nil, err
 			}
 		}
-		if _, err := columns.WriteString(TableRefreshTokenColumnUpdatedAt); err != nil {
+		if _, err := columns.WriteString(TableUserResourcePermissionsColumnUpdatedAt); err != nil {
 			return "", nil, err
 		}
 		if insert.Dirty {
@@ -11151,7 +13353,7 @@ func (r *RefreshTokenRepositoryBase) InsertQuery(e *RefreshTokenEntity, read boo
 			return "", nil, err
 		}
 	}
-	if _, err := columns.WriteString(TableRefreshTokenColumnUpdatedBy); err != nil {
+	if _, err := columns.WriteString(TableUserResourcePermissionsColumnUpdatedBy); err != nil {
 		return "", nil, err
 	}
 	if insert.Dirty {
@@ -11170,7 +13372,7 @@ func (r *RefreshTokenRepositoryBase) InsertQuery(e *RefreshTokenEntity, read boo
 			return "", nil, err
 		}
 	}
-	if _, err := columns.WriteString(TableRefreshTokenColumnUserID); err != nil {
+	if _, err := columns.WriteString(TableUserResourcePermissionsColumnUserID); err != nil {
 		return "", nil, err
 	}
 	if insert.Dirty {
@@ -11195,14 +13397,14 @@ func (r *RefreshTokenRepositoryBase) InsertQuery(e *RefreshTokenEntity, read boo
 			if len(r.Columns) > 0 {
 				buf.WriteString(strings.Join(r.Columns, ", "))
 			} else {
-				buf.WriteString("created_at, created_by, expire_at, last_used_at, notes, revoked, token, updated_at, updated_by, user_id")
+				buf.WriteString("created_at, created_by, permission_id, resource_id, resource_type, updated_at, updated_by, user_id")
 			}
 		}
 	}
 	return buf.String(), insert.Args(), nil
 }
 
-func (r *RefreshTokenRepositoryBase) insert(ctx context.Context, tx *sql.Tx, e *RefreshTokenEntity) (*RefreshTokenEntity, error) {
+func (r *UserResourcePermissionsRepositoryBase) insert(ctx context.Context, tx *sql.Tx, e *UserResourcePermissionsEntity) (*UserResourcePermissionsEntity, error) {
 	query, args, err := r.InsertQuery(e, true)
 	if err != nil {
 		return nil, err
@@ -11217,20 +13419,18 @@ func (r *RefreshTokenRepositoryBase) insert(ctx context.Context, tx *sql.Tx, e *
 	err = row.Scan(
 		&e.CreatedAt,
 		&e.CreatedBy,
-		&e.ExpireAt,
-		&e.LastUsedAt,
-		&e.Notes,
-		&e.Revoked,
-		&e.Token,
+		&e.PermissionID,
+		&e.ResourceID,
+		&e.ResourceType,
 		&e.UpdatedAt,
 		&e.UpdatedBy,
 		&e.UserID,
 	)
 	if r.Log != nil {
 		if tx == nil {
-			r.Log(err, TableRefreshToken, "insert", query, args...)
+			r.Log(err, TableUserResourcePermissions, "insert", query, args...)
 		} else {
-			r.Log(err, TableRefreshToken, "insert tx", query, args...)
+			r.Log(err, TableUserResourcePermissions, "insert tx", query, args...)
 		}
 	}
 	if err != nil {
@@ -11239,13 +13439,13 @@ func (r *RefreshTokenRepositoryBase) insert(ctx context.Context, tx *sql.Tx, e *
 	return e, nil
 }
 
-func (r *RefreshTokenRepositoryBase) Insert(ctx context.Context, e *RefreshTokenEntity) (*RefreshTokenEntity, error) {
+func (r *UserResourcePermissionsRepositoryBase) Insert(ctx context.Context, e *UserResourcePermissionsEntity) (*UserResourcePermissionsEntity, error) {
 	return r.insert(ctx, nil, e)
 }
 
-func RefreshTokenCriteriaWhereClause(comp *Composer, c *RefreshTokenCriteria, id int) error {
+func UserResourcePermissionsCriteriaWhereClause(comp *Composer, c *UserResourcePermissionsCriteria, id int) error {
 	if c.child == nil {
-		return _RefreshTokenCriteriaWhereClause(comp, c, id)
+		return _UserResourcePermissionsCriteriaWhereClause(comp, c, id)
 	}
 	node := c
 	sibling := false
@@ -11260,7 +13460,7 @@ func RefreshTokenCriteriaWhereClause(comp *Composer, c *RefreshTokenCriteria, id
 			} else {
 				comp.Dirty = false
 				comp.WriteString("(")
-				if err := _RefreshTokenCriteriaWhereClause(comp, node, id); err != nil {
+				if err := _UserResourcePermissionsCriteriaWhereClause(comp, node, id); err != nil {
 					return err
 				}
 				comp.WriteString(")")
@@ -11288,66 +13488,45 @@ func RefreshTokenCriteriaWhereClause(comp *Composer, c *RefreshTokenCriteria, id
 	return nil
 }
 
-func _RefreshTokenCriteriaWhereClause(comp *Composer, c *RefreshTokenCriteria, id int) error {
-	QueryTimestampWhereClause(c.CreatedAt, id, TableRefreshTokenColumnCreatedAt, comp, And)
-
-	QueryInt64WhereClause(c.CreatedBy, id, TableRefreshTokenColumnCreatedBy, comp, And)
-
-	QueryTimestampWhereClause(c.ExpireAt, id, TableRefreshTokenColumnExpireAt, comp, And)
+func _UserResourcePermissionsCriteriaWhereClause(comp *Composer, c *UserResourcePermissionsCriteria, id int) error {
+	QueryTimestampWhereClause(c.CreatedAt, id, TableUserResourcePermissionsColumnCreatedAt, comp, And)
 
-	QueryTimestampWhereClause(c.LastUsedAt, id, TableRefreshTokenColumnLastUsedAt, comp, And)
+	QueryInt64WhereClause(c.CreatedBy, id, TableUserResourcePermissionsColumnCreatedBy, comp, And)
 
-	QueryStringWhereClause(c.Notes, id, TableRefreshTokenColumnNotes, comp, And)
+	QueryInt64WhereClause(c.PermissionID, id, TableUserResourcePermissionsColumnPermissionID, comp, And)
 
-	if c.Revoked.Valid {
-		if comp.Dirty {
-			if _, err := comp.WriteString(" AND "); err != nil {
-				return err
-			}
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableRefreshTokenColumnRevoked); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.Revoked)
-		comp.Dirty = true
-	}
+	QueryStringWhereClause(c.ResourceID, id, TableUserResourcePermissionsColumnResourceID, comp, And)
 
-	QueryStringWhereClause(c.Token, id, TableRefreshTokenColumnToken, comp, And)
+	QueryStringWhereClause(c.ResourceType, id, TableUserResourcePermissionsColumnResourceType, comp, And)
 
-	QueryTimestampWhereClause(c.UpdatedAt, id, TableRefreshTokenColumnUpdatedAt, comp, And)
+	QueryTimestampWhereClause(c.UpdatedAt, id, TableUserResourcePermissionsColumnUpdatedAt, comp, And)
 
-	QueryInt64WhereClause(c.UpdatedBy, id, TableRefreshTokenColumnUpdatedBy, comp, And)
+	QueryInt64WhereClause(c.UpdatedBy, id, TableUserResourcePermissionsColumnUpdatedBy, comp, And)
 
-	QueryInt64WhereClause(c.UserID, id, TableRefreshTokenColumnUserID, comp, And)
+	QueryInt64WhereClause(c.UserID, id, TableUserResourcePermissionsColumnUserID, comp, And)
 
 	return nil
 }
 
-func (r *RefreshTokenRepositoryBase) FindQuery(fe *RefreshTokenFindExpr) (string, []interface{}, error) {
-	comp := NewComposer(10)
+func (r *UserResourcePermissionsRepositoryBase) FindQuery(fe *UserResourcePermissionsFindExpr) (string, []interface{}, error) {
+	comp := NewComposer(8)
 	buf := bytes.NewBufferString("SELECT ")
 	if len(fe.Columns) == 0 {
-		buf.WriteString("t0.created_at, t0.created_by, t0.expire_at, t0.last_used_at, t0.notes, t0.revoked, t0.token, t0.updated_at, t0.updated_by, t0.user_id")
+		buf.WriteString("t0.created_at, t0.created_by, t0.permission_id, t0.resource_id, t0.resource_type, t0.updated_at, t0.updated_by, t0.user_id")
 	} else {
 		buf.WriteString(strings.Join(fe.Columns, ", "))
 	}
 	if fe.JoinUser != nil && fe.JoinUser.Kind.Actionable() && fe.JoinUser.Fetch {
-		buf.WriteString(", t1.confirmation_token, t1.created_at, t1.created_by, t1.first_name, t1.id, t1.is_active, t1.is_confirmed, t1.is_staff, t1.is_superuser, t1.last_login_at, t1.last_name, t1.password, t1.updated_at, t1.updated_by, t1.username")
+		buf.WriteString(", t1.confirmation_token, t1.confirmation_token_expire_at, t1.created_at, t1.created_by, t1.deleted_at, t1.first_name, t1.id, t1.is_active, t1.is_confirmed, t1.is_service_account, t1.is_staff, t1.is_superuser, t1.last_login_at, t1.last_name, t1.locked_at, t1.password, t1.password_reset_token, t1.password_reset_token_expire_at, t1.two_factor_challenge, t1.two_factor_challenge_expire_at, t1.two_factor_confirmed_at, t1.two_factor_last_step, t1.two_factor_recovery_codes, t1.two_factor_secret, t1.updated_at, t1.updated_by, t1.username")
+	}
+	if fe.JoinPermission != nil && fe.JoinPermission.Kind.Actionable() && fe.JoinPermission.Fetch {
+		buf.WriteString(", t2.action, t2.created_at, t2.id, t2.module, t2.subsystem, t2.updated_at")
 	}
 	if fe.JoinAuthor != nil && fe.JoinAuthor.Kind.Actionable() && fe.JoinAuthor.Fetch {
-		buf.WriteString(", t2.confirmation_token, t2.created_at, t2.created_by, t2.first_name, t2.id, t2.is_active, t2.is_confirmed, t2.is_staff, t2.is_superuser, t2.last_login_at, t2.last_name, t2.password, t2.updated_at, t2.updated_by, t2.username")
+		buf.WriteString(", t3.confirmation_token, t3.confirmation_token_expire_at, t3.created_at, t3.created_by, t3.deleted_at, t3.first_name, t3.id, t3.is_active, t3.is_confirmed, t3.is_service_account, t3.is_staff, t3.is_superuser, t3.last_login_at, t3.last_name, t3.locked_at, t3.password, t3.password_reset_token, t3.password_reset_token_expire_at, t3.two_factor_challenge, t3.two_factor_challenge_expire_at, t3.two_factor_confirmed_at, t3.two_factor_last_step, t3.two_factor_recovery_codes, t3.two_factor_secret, t3.updated_at, t3.updated_by, t3.username")
 	}
 	if fe.JoinModifier != nil && fe.JoinModifier.Kind.Actionable() && fe.JoinModifier.Fetch {
-		buf.WriteString(", t3.confirmation_token, t3.created_at, t3.created_by, t3.first_name, t3.id, t3.is_active, t3.is_confirmed, t3.is_staff, t3.is_superuser, t3.last_login_at, t3.last_name, t3.password, t3.updated_at, t3.updated_by, t3.username")
+		buf.WriteString(", t4.confirmation_token, t4.confirmation_token_expire_at, t4.created_at, t4.created_by, t4.deleted_at, t4.first_name, t4.id, t4.is_active, t4.is_confirmed, t4.is_service_account, t4.is_staff, t4.is_superuser, t4.last_login_at, t4.last_name, t4.locked_at, t4.password, t4.password_reset_token, t4.password_reset_token_expire_at, t4.two_factor_challenge, t4.two_factor_challenge_expire_at, t4.two_factor_confirmed_at, t4.two_factor_last_step, t4.two_factor_recovery_codes, t4.two_factor_secret, t4.updated_at, t4.updated_by, t4.username")
 	}
 	buf.WriteString(" FROM ")
 	buf.WriteString(r.Table)
@@ -11361,20 +13540,29 @@ func (r *RefreshTokenRepositoryBase) FindQuery(fe *RefreshTokenFindExpr) (string
 			}
 		}
 	}
+	if fe.JoinPermission != nil && fe.JoinPermission.Kind.Actionable() {
+		joinClause(comp, fe.JoinPermission.Kind, "charon.permission AS t2 ON t0.permission_id=t2.id")
+		if fe.JoinPermission.On != nil {
+			comp.Dirty = true
+			if err := PermissionCriteriaWhereClause(comp, fe.JoinPermission.On, 2); err != nil {
+				return "", nil, err
+			}
+		}
+	}
 	if fe.JoinAuthor != nil && fe.JoinAuthor.Kind.Actionable() {
-		joinClause(comp, fe.JoinAuthor.Kind, "charon.user AS t2 ON t0.created_by=t2.id")
+		joinClause(comp, fe.JoinAuthor.Kind, "charon.user AS t3 ON t0.created_by=t3.id")
 		if fe.JoinAuthor.On != nil {
 			comp.Dirty = true
-			if err := UserCriteriaWhereClause(comp, fe.JoinAuthor.On, 2); err != nil {
+			if err := UserCriteriaWhereClause(comp, fe.JoinAuthor.On, 3); err != nil {
 				return "", nil, err
 			}
 		}
 	}
 	if fe.JoinModifier != nil && fe.JoinModifier.Kind.Actionable() {
-		joinClause(comp, fe.JoinModifier.Kind, "charon.user AS t3 ON t0.updated_by=t3.id")
+		joinClause(comp, fe.JoinModifier.Kind, "charon.user AS t4 ON t0.updated_by=t4.id")
 		if fe.JoinModifier.On != nil {
 			comp.Dirty = true
-			if err := UserCriteriaWhereClause(comp, fe.JoinModifier.On, 3); err != nil {
+			if err := UserCriteriaWhereClause(comp, fe.JoinModifier.On, 4); err != nil {
 				return "", nil, err
 			}
 		}
@@ -11384,7 +13572,7 @@ func (r *RefreshTokenRepositoryBase) FindQuery(fe *RefreshTokenFindExpr) (string
 		comp.Dirty = false
 	}
 	if fe.Where != nil {
-		if err := RefreshTokenCriteriaWhereClause(comp, fe.Where, 0); err != nil {
+		if err := UserResourcePermissionsCriteriaWhereClause(comp, fe.Where, 0); err != nil {
 			return "", nil, err
 		}
 	}
@@ -11393,13 +13581,18 @@ func (r *RefreshTokenRepositoryBase) FindQuery(fe *RefreshTokenFindExpr) (string
 			return "", nil, err
 		}
 	}
+	if fe.JoinPermission != nil && fe.JoinPermission.Kind.Actionable() && fe.JoinPermission.Where != nil {
+		if err := PermissionCriteriaWhereClause(comp, fe.JoinPermission.Where, 2); err != nil {
+			return "", nil, err
+		}
+	}
 	if fe.JoinAuthor != nil && fe.JoinAuthor.Kind.Actionable() && fe.JoinAuthor.Where != nil {
-		if err := UserCriteriaWhereClause(comp, fe.JoinAuthor.Where, 2); err != nil {
+		if err := UserCriteriaWhereClause(comp, fe.JoinAuthor.Where, 3); err != nil {
 			return "", nil, err
 		}
 	}
 	if fe.JoinModifier != nil && fe.JoinModifier.Kind.Actionable() && fe.JoinModifier.Where != nil {
-		if err := UserCriteriaWhereClause(comp, fe.JoinModifier.Where, 3); err != nil {
+		if err := UserCriteriaWhereClause(comp, fe.JoinModifier.Where, 4); err != nil {
 			return "", nil, err
 		}
 	}
@@ -11413,7 +13606,7 @@ func (r *RefreshTokenRepositoryBase) FindQuery(fe *RefreshTokenFindExpr) (string
 	if len(fe.OrderBy) > 0 {
 		i := 0
 		for _, order := range fe.OrderBy {
-			for _, columnName := range TableRefreshTokenColumns {
+			for _, columnName := range TableUserResourcePermissionsColumns {
 				if order.Name == columnName {
 					if i == 0 {
 						comp.WriteString(" ORDER BY ")
@@ -11467,7 +13660,7 @@ func (r *RefreshTokenRepositoryBase) FindQuery(fe *RefreshTokenFindExpr) (string
 	return buf.String(), comp.Args(), nil
 }
 
-func (r *RefreshTokenRepositoryBase) find(ctx context.Context, tx *sql.Tx, fe *RefreshTokenFindExpr) ([]*RefreshTokenEntity, error) {
+func (r *UserResourcePermissionsRepositoryBase) find(ctx context.Context, tx *sql.Tx, fe *UserResourcePermissionsFindExpr) ([]*UserResourcePermissionsEntity, error) {
 	query, args, err := r.FindQuery(fe)
 	if err != nil {
 		return nil, err
@@ -11480,9 +13673,9 @@ func (r *RefreshTokenRepositoryBase) find(ctx context.Context, tx *sql.Tx, fe *R
 	}
 	if r.Log != nil {
 		if tx == nil {
-			r.Log(err, TableRefreshToken, "find", query, args...)
+			r.Log(err, TableUserResourcePermissions, "find", query, args...)
 		} else {
-			r.Log(err, TableRefreshToken, "find tx", query, args...)
+			r.Log(err, TableUserResourcePermissions, "find tx", query, args...)
 		}
 	}
 	if err != nil {
@@ -11490,11 +13683,11 @@ func (r *RefreshTokenRepositoryBase) find(ctx context.Context, tx *sql.Tx, fe *R
 	}
 	defer rows.Close()
 	var (
-		entities []*RefreshTokenEntity
+		entities []*UserResourcePermissionsEntity
 		props    []interface{}
 	)
 	for rows.Next() {
-		var ent RefreshTokenEntity
+		var ent UserResourcePermissionsEntity
 		if props, err = ent.Props(); err != nil {
 			return nil, err
 		}
@@ -11506,6 +13699,13 @@ func (r *RefreshTokenRepositoryBase) find(ctx context.Context, tx *sql.Tx, fe *R
 			}
 			props = append(props, prop...)
 		}
+		if fe.JoinPermission != nil && fe.JoinPermission.Kind.Actionable() && fe.JoinPermission.Fetch {
+			ent.Permission = &PermissionEntity{}
+			if prop, err = ent.Permission.Props(); err != nil {
+				return nil, err
+			}
+			props = append(props, prop...)
+		}
 		if fe.JoinAuthor != nil && fe.JoinAuthor.Kind.Actionable() && fe.JoinAuthor.Fetch {
 			ent.Author = &UserEntity{}
 			if prop, err = ent.Author.Props(); err != nil {
@@ -11529,7 +13729,7 @@ func (r *RefreshTokenRepositoryBase) find(ctx context.Context, tx *sql.Tx, fe *R
 	}
 	err = rows.Err()
 	if r.Log != nil {
-		r.Log(err, TableRefreshToken, "find", query, args...)
+		r.Log(err, TableUserResourcePermissions, "find", query, args...)
 	}
 	if err != nil {
 		return nil, err
@@ -11537,11 +13737,11 @@ func (r *RefreshTokenRepositoryBase) find(ctx context.Context, tx *sql.Tx, fe *R
 	return entities, nil
 }
 
-func (r *RefreshTokenRepositoryBase) Find(ctx context.Context, fe *RefreshTokenFindExpr) ([]*RefreshTokenEntity, error) {
+func (r *UserResourcePermissionsRepositoryBase) Find(ctx context.Context, fe *UserResourcePermissionsFindExpr) ([]*UserResourcePermissionsEntity, error) {
 	return r.find(ctx, nil, fe)
 }
 
-func (r *RefreshTokenRepositoryBase) findIter(ctx context.Context, tx *sql.Tx, fe *RefreshTokenFindExpr) (*RefreshTokenIterator, error) {
+func (r *UserResourcePermissionsRepositoryBase) findIter(ctx context.Context, tx *sql.Tx, fe *UserResourcePermissionsFindExpr) (*UserResourcePermissionsIterator, error) {
 	query, args, err := r.FindQuery(fe)
 	if err != nil {
 		return nil, err
@@ -11554,43 +13754,58 @@ func (r *RefreshTokenRepositoryBase) findIter(ctx context.Context, tx *sql.Tx, f
 	}
 	if r.Log != nil {
 		if tx == nil {
-			r.Log(err, TableRefreshToken, "find iter", query, args...)
+			r.Log(err, TableUserResourcePermissions, "find iter", query, args...)
 		} else {
-			r.Log(err, TableRefreshToken, "find iter tx", query, args...)
+			r.Log(err, TableUserResourcePermissions, "find iter tx", query, args...)
 		}
 	}
 	if err != nil {
 		return nil, err
 	}
-	return &RefreshTokenIterator{
+	return &UserResourcePermissionsIterator{
 		rows: rows,
 		expr: fe,
 		cols: fe.Columns,
 	}, nil
 }
 
-func (r *RefreshTokenRepositoryBase) FindIter(ctx context.Context, fe *RefreshTokenFindExpr) (*RefreshTokenIterator, error) {
+func (r *UserResourcePermissionsRepositoryBase) FindIter(ctx context.Context, fe *UserResourcePermissionsFindExpr) (*UserResourcePermissionsIterator, error) {
 	return r.findIter(ctx, nil, fe)
 }
 
-func (r *RefreshTokenRepositoryBase) findOneByToken(ctx context.Context, tx *sql.Tx, refreshTokenToken string) (*RefreshTokenEntity, error) {
-	find := NewComposer(10)
+func (r *UserResourcePermissionsRepositoryBase) findOneByUserIDAndPermissionIDAndResourceTypeAndResourceID(ctx context.Context, tx *sql.Tx, userResourcePermissionsUserID int64, userResourcePermissionsPermissionID int64, userResourcePermissionsResourceType string, userResourcePermissionsResourceID string) (*UserResourcePermissionsEntity, error) {
+	find := NewComposer(8)
 	find.WriteString("SELECT ")
 	if len(r.Columns) == 0 {
-		find.WriteString("created_at, created_by, expire_at, last_used_at, notes, revoked, token, updated_at, updated_by, user_id")
+		find.WriteString("created_at, created_by, permission_id, resource_id, resource_type, updated_at, updated_by, user_id")
 	} else {
 		find.WriteString(strings.Join(r.Columns, ", "))
 	}
 	find.WriteString(" FROM ")
-	find.WriteString(TableRefreshToken)
+	find.WriteString(TableUserResourcePermissions)
 	find.WriteString(" WHERE ")
-	find.WriteString(TableRefreshTokenColumnToken)
+	find.WriteString(TableUserResourcePermissionsColumnUserID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(userResourcePermissionsUserID)
+	find.WriteString(" AND ")
+	find.WriteString(TableUserResourcePermissionsColumnPermissionID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(userResourcePermissionsPermissionID)
+	find.WriteString(" AND ")
+	find.WriteString(TableUserResourcePermissionsColumnResourceType)
 	find.WriteString("=")
 	find.WritePlaceholder()
-	find.Add(refreshTokenToken)
+	find.Add(userResourcePermissionsResourceType)
+	find.WriteString(" AND ")
+	find.WriteString(TableUserResourcePermissionsColumnResourceID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(userResourcePermissionsResourceID)
 
 	var (
-		ent RefreshTokenEntity
+		ent UserResourcePermissionsEntity
 	)
 	props, err := ent.Props(r.Columns...)
 	if err != nil {
@@ -11608,21 +13823,16338 @@ func (r *RefreshTokenRepositoryBase) findOneByToken(ctx context.Context, tx *sql
 	return &ent, nil
 }
 
-func (r *RefreshTokenRepositoryBase) FindOneByToken(ctx context.Context, refreshTokenToken string) (*RefreshTokenEntity, error) {
-	return r.findOneByToken(ctx, nil, refreshTokenToken)
+func (r *UserResourcePermissionsRepositoryBase) FindOneByUserIDAndPermissionIDAndResourceTypeAndResourceID(ctx context.Context, userResourcePermissionsUserID int64, userResourcePermissionsPermissionID int64, userResourcePermissionsResourceType string, userResourcePermissionsResourceID string) (*UserResourcePermissionsEntity, error) {
+	return r.findOneByUserIDAndPermissionIDAndResourceTypeAndResourceID(ctx, nil, userResourcePermissionsUserID, userResourcePermissionsPermissionID, userResourcePermissionsResourceType, userResourcePermissionsResourceID)
 }
 
-func (r *RefreshTokenRepositoryBase) UpdateOneByTokenQuery(refreshTokenToken string, p *RefreshTokenPatch) (string, []interface{}, error) {
+func (r *UserResourcePermissionsRepositoryBase) UpdateOneByUserIDAndPermissionIDAndResourceTypeAndResourceIDQuery(userResourcePermissionsUserID int64, userResourcePermissionsPermissionID int64, userResourcePermissionsResourceType string, userResourcePermissionsResourceID string, p *UserResourcePermissionsPatch) (string, []interface{}, error) {
 	buf := bytes.NewBufferString("UPDATE ")
 	buf.WriteString(r.Table)
-	update := NewComposer(1)
+	update := NewComposer(4)
 	if p.CreatedAt.Valid {
 		if update.Dirty {
 			if _, err := update.WriteString(", "); err != nil {
 				return "", nil, err
 			}
 		}
-		if _, err := update.WriteString(TableRefreshTokenColumnCreatedAt); err != nil {
+		if _, err := update.WriteString(TableUserResourcePermissionsColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.CreatedAt)
+		update.Dirty = true
+
+	}
+	if p.CreatedBy.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserResourcePermissionsColumnCreatedBy); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.CreatedBy)
+		update.Dirty = true
+	}
+
+	if p.PermissionID.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserResourcePermissionsColumnPermissionID); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.PermissionID)
+		update.Dirty = true
+	}
+
+	if p.ResourceID.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserResourcePermissionsColumnResourceID); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.ResourceID)
+		update.Dirty = true
+	}
+
+	if p.ResourceType.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserResourcePermissionsColumnResourceType); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.ResourceType)
+		update.Dirty = true
+	}
+
+	if p.UpdatedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserResourcePermissionsColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.UpdatedAt)
+		update.Dirty = true
+
+	} else {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserResourcePermissionsColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("=NOW()"); err != nil {
+			return "", nil, err
+		}
+		update.Dirty = true
+	}
+	if p.UpdatedBy.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserResourcePermissionsColumnUpdatedBy); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.UpdatedBy)
+		update.Dirty = true
+	}
+
+	if p.UserID.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserResourcePermissionsColumnUserID); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.UserID)
+		update.Dirty = true
+	}
+
+	if !update.Dirty {
+		return "", nil, errors.New("user_resource_permissions update failure, nothing to update")
+	}
+	buf.WriteString(" SET ")
+	buf.ReadFrom(update)
+	buf.WriteString(" WHERE ")
+	update.WriteString(TableUserResourcePermissionsColumnUserID)
+	update.WriteString("=")
+	update.WritePlaceholder()
+	update.Add(userResourcePermissionsUserID)
+	update.WriteString(" AND ")
+	update.WriteString(TableUserResourcePermissionsColumnPermissionID)
+	update.WriteString("=")
+	update.WritePlaceholder()
+	update.Add(userResourcePermissionsPermissionID)
+	update.WriteString(" AND ")
+	update.WriteString(TableUserResourcePermissionsColumnResourceType)
+	update.WriteString("=")
+	update.WritePlaceholder()
+	update.Add(userResourcePermissionsResourceType)
+	update.WriteString(" AND ")
+	update.WriteString(TableUserResourcePermissionsColumnResourceID)
+	update.WriteString("=")
+	update.WritePlaceholder()
+	update.Add(userResourcePermissionsResourceID)
+	buf.ReadFrom(update)
+	buf.WriteString(" RETURNING ")
+	if len(r.Columns) > 0 {
+		buf.WriteString(strings.Join(r.Columns, ", "))
+	} else {
+		buf.WriteString("created_at, created_by, permission_id, resource_id, resource_type, updated_at, updated_by, user_id")
+	}
+	return buf.String(), update.Args(), nil
+}
+
+func (r *UserResourcePermissionsRepositoryBase) updateOneByUserIDAndPermissionIDAndResourceTypeAndResourceID(ctx context.Context, tx *sql.Tx, userResourcePermissionsUserID int64, userResourcePermissionsPermissionID int64, userResourcePermissionsResourceType string, userResourcePermissionsResourceID string, p *UserResourcePermissionsPatch) (*UserResourcePermissionsEntity, error) {
+	query, args, err := r.UpdateOneByUserIDAndPermissionIDAndResourceTypeAndResourceIDQuery(userResourcePermissionsUserID, userResourcePermissionsPermissionID, userResourcePermissionsResourceType, userResourcePermissionsResourceID, p)
+	if err != nil {
+		return nil, err
+	}
+	var ent UserResourcePermissionsEntity
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+
+	var row *sql.Row
+	if tx == nil {
+		row = r.DB.QueryRowContext(ctx, query, args...)
+	} else {
+		row = tx.QueryRowContext(ctx, query, args...)
+	}
+	err = row.Scan(props...)
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableUserResourcePermissions, "update one by unique", query, args...)
+		} else {
+			r.Log(err, TableUserResourcePermissions, "update one by unique tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ent, nil
+}
+
+func (r *UserResourcePermissionsRepositoryBase) UpdateOneByUserIDAndPermissionIDAndResourceTypeAndResourceID(ctx context.Context, userResourcePermissionsUserID int64, userResourcePermissionsPermissionID int64, userResourcePermissionsResourceType string, userResourcePermissionsResourceID string, p *UserResourcePermissionsPatch) (*UserResourcePermissionsEntity, error) {
+	return r.updateOneByUserIDAndPermissionIDAndResourceTypeAndResourceID(ctx, nil, userResourcePermissionsUserID, userResourcePermissionsPermissionID, userResourcePermissionsResourceType, userResourcePermissionsResourceID, p)
+}
+
+func (r *UserResourcePermissionsRepositoryBase) UpsertQuery(e *UserResourcePermissionsEntity, p *UserResourcePermissionsPatch, inf ...string) (string, []interface{}, error) {
+	upsert := NewComposer(16)
+	columns := bytes.NewBuffer(nil)
+	buf := bytes.NewBufferString("INSERT INTO ")
+	buf.WriteString(r.Table)
+
+	if !e.CreatedAt.IsZero() {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableUserResourcePermissionsColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.CreatedAt)
+		upsert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableUserResourcePermissionsColumnCreatedBy); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.CreatedBy)
+	upsert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableUserResourcePermissionsColumnPermissionID); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.PermissionID)
+	upsert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableUserResourcePermissionsColumnResourceID); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.ResourceID)
+	upsert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableUserResourcePermissionsColumnResourceType); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.ResourceType)
+	upsert.Dirty = true
+
+	if e.UpdatedAt.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableUserResourcePermissionsColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.UpdatedAt)
+		upsert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableUserResourcePermissionsColumnUpdatedBy); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.UpdatedBy)
+	upsert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableUserResourcePermissionsColumnUserID); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.UserID)
+	upsert.Dirty = true
+
+	if upsert.Dirty {
+		buf.WriteString(" (")
+		buf.ReadFrom(columns)
+		buf.WriteString(") VALUES (")
+		buf.ReadFrom(upsert)
+		buf.WriteString(")")
+	}
+	buf.WriteString(" ON CONFLICT ")
+	if len(inf) > 0 {
+		upsert.Dirty = false
+		if p.CreatedAt.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableUserResourcePermissionsColumnCreatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.CreatedAt)
+			upsert.Dirty = true
+
+		}
+		if p.CreatedBy.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableUserResourcePermissionsColumnCreatedBy); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.CreatedBy)
+			upsert.Dirty = true
+		}
+
+		if p.PermissionID.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableUserResourcePermissionsColumnPermissionID); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.PermissionID)
+			upsert.Dirty = true
+		}
+
+		if p.ResourceID.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableUserResourcePermissionsColumnResourceID); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ResourceID)
+			upsert.Dirty = true
+		}
+
+		if p.ResourceType.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableUserResourcePermissionsColumnResourceType); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ResourceType)
+			upsert.Dirty = true
+		}
+
+		if p.UpdatedAt.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableUserResourcePermissionsColumnUpdatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.UpdatedAt)
+			upsert.Dirty = true
+
+		} else {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableUserResourcePermissionsColumnUpdatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("=NOW()"); err != nil {
+				return "", nil, err
+			}
+			upsert.Dirty = true
+		}
+		if p.UpdatedBy.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableUserResourcePermissionsColumnUpdatedBy); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.UpdatedBy)
+			upsert.Dirty = true
+		}
+
+		if p.UserID.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableUserResourcePermissionsColumnUserID); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.UserID)
+			upsert.Dirty = true
+		}
+
+	}
+	if len(inf) > 0 && upsert.Dirty {
+		buf.WriteString("(")
+		for j, i := range inf {
+			if j != 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString(i)
+		}
+		buf.WriteString(")")
+		buf.WriteString(" DO UPDATE SET ")
+		buf.ReadFrom(upsert)
+	} else {
+		buf.WriteString(" DO NOTHING ")
+	}
+	if upsert.Dirty {
+		buf.WriteString(" RETURNING ")
+		if len(r.Columns) > 0 {
+			buf.WriteString(strings.Join(r.Columns, ", "))
+		} else {
+			buf.WriteString("created_at, created_by, permission_id, resource_id, resource_type, updated_at, updated_by, user_id")
+		}
+	}
+	return buf.String(), upsert.Args(), nil
+}
+
+func (r *UserResourcePermissionsRepositoryBase) upsert(ctx context.Context, tx *sql.Tx, e *UserResourcePermissionsEntity, p *UserResourcePermissionsPatch, inf ...string) (*UserResourcePermissionsEntity, error) {
+	query, args, err := r.UpsertQuery(e, p, inf...)
+	if err != nil {
+		return nil, err
+	}
+
+	var row *sql.Row
+	if tx == nil {
+		row = r.DB.QueryRowContext(ctx, query, args...)
+	} else {
+		row = tx.QueryRowContext(ctx, query, args...)
+	}
+	err = row.Scan(
+		&e.CreatedAt,
+		&e.CreatedBy,
+		&e.PermissionID,
+		&e.ResourceID,
+		&e.ResourceType,
+		&e.UpdatedAt,
+		&e.UpdatedBy,
+		&e.UserID,
+	)
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableUserResourcePermissions, "upsert", query, args...)
+		} else {
+			r.Log(err, TableUserResourcePermissions, "upsert tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (r *UserResourcePermissionsRepositoryBase) Upsert(ctx context.Context, e *UserResourcePermissionsEntity, p *UserResourcePermissionsPatch, inf ...string) (*UserResourcePermissionsEntity, error) {
+	return r.upsert(ctx, nil, e, p, inf...)
+}
+
+func (r *UserResourcePermissionsRepositoryBase) count(ctx context.Context, tx *sql.Tx, exp *UserResourcePermissionsCountExpr) (int64, error) {
+	query, args, err := r.FindQuery(&UserResourcePermissionsFindExpr{
+		Where:   exp.Where,
+		Columns: []string{"COUNT(*)"},
+
+		JoinUser:       exp.JoinUser,
+		JoinPermission: exp.JoinPermission,
+		JoinAuthor:     exp.JoinAuthor,
+		JoinModifier:   exp.JoinModifier,
+	})
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, query, args...).Scan(&count)
+	} else {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(&count)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableUserResourcePermissions, "count", query, args...)
+		} else {
+			r.Log(err, TableUserResourcePermissions, "count tx", query, args...)
+		}
+	}
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *UserResourcePermissionsRepositoryBase) Count(ctx context.Context, exp *UserResourcePermissionsCountExpr) (int64, error) {
+	return r.count(ctx, nil, exp)
+}
+
+type UserResourcePermissionsRepositoryBaseTx struct {
+	base *UserResourcePermissionsRepositoryBase
+	tx   *sql.Tx
+}
+
+func (r UserResourcePermissionsRepositoryBaseTx) Commit() error {
+	return r.tx.Commit()
+}
+
+func (r UserResourcePermissionsRepositoryBaseTx) Rollback() error {
+	return r.tx.Rollback()
+}
+
+func (r *UserResourcePermissionsRepositoryBaseTx) Insert(ctx context.Context, e *UserResourcePermissionsEntity) (*UserResourcePermissionsEntity, error) {
+	return r.base.insert(ctx, r.tx, e)
+}
+
+func (r *UserResourcePermissionsRepositoryBaseTx) Find(ctx context.Context, fe *UserResourcePermissionsFindExpr) ([]*UserResourcePermissionsEntity, error) {
+	return r.base.find(ctx, r.tx, fe)
+}
+
+func (r *UserResourcePermissionsRepositoryBaseTx) FindIter(ctx context.Context, fe *UserResourcePermissionsFindExpr) (*UserResourcePermissionsIterator, error) {
+	return r.base.findIter(ctx, r.tx, fe)
+}
+
+func (r *UserResourcePermissionsRepositoryBaseTx) UpdateOneByUserIDAndPermissionIDAndResourceTypeAndResourceID(ctx context.Context, userResourcePermissionsUserID int64, userResourcePermissionsPermissionID int64, userResourcePermissionsResourceType string, userResourcePermissionsResourceID string, p *UserResourcePermissionsPatch) (*UserResourcePermissionsEntity, error) {
+	return r.base.updateOneByUserIDAndPermissionIDAndResourceTypeAndResourceID(ctx, r.tx, userResourcePermissionsUserID, userResourcePermissionsPermissionID, userResourcePermissionsResourceType, userResourcePermissionsResourceID, p)
+}
+
+func (r *UserResourcePermissionsRepositoryBaseTx) Upsert(ctx context.Context, e *UserResourcePermissionsEntity, p *UserResourcePermissionsPatch, inf ...string) (*UserResourcePermissionsEntity, error) {
+	return r.base.upsert(ctx, r.tx, e, p, inf...)
+}
+
+func (r *UserResourcePermissionsRepositoryBaseTx) Count(ctx context.Context, exp *UserResourcePermissionsCountExpr) (int64, error) {
+	return r.base.count(ctx, r.tx, exp)
+}
+
+const (
+	TableRefreshTokenConstraintPrefixUnique        = "charon.refresh_token_prefix_key"
+	TableRefreshTokenConstraintUserIDForeignKey    = "charon.refresh_token_user_id_fkey"
+	TableRefreshTokenConstraintCreatedByForeignKey = "charon.refresh_token_created_by_fkey"
+	TableRefreshTokenConstraintUpdatedByForeignKey = "charon.refresh_token_updated_by_fkey"
+)
+
+const (
+	TableRefreshToken                 = "charon.refresh_token"
+	TableRefreshTokenColumnCreatedAt  = "created_at"
+	TableRefreshTokenColumnCreatedBy  = "created_by"
+	TableRefreshTokenColumnExpireAt   = "expire_at"
+	TableRefreshTokenColumnFamily     = "family"
+	TableRefreshTokenColumnLastUsedAt = "last_used_at"
+	TableRefreshTokenColumnNotes      = "notes"
+	TableRefreshTokenColumnPrefix     = "prefix"
+	TableRefreshTokenColumnRevoked    = "revoked"
+	TableRefreshTokenColumnRotatedAt  = "rotated_at"
+	TableRefreshTokenColumnSecret     = "secret"
+	TableRefreshTokenColumnUpdatedAt  = "updated_at"
+	TableRefreshTokenColumnUpdatedBy  = "updated_by"
+	TableRefreshTokenColumnUserID     = "user_id"
+)
+
+var TableRefreshTokenColumns = []string{
+	TableRefreshTokenColumnCreatedAt,
+	TableRefreshTokenColumnCreatedBy,
+	TableRefreshTokenColumnExpireAt,
+	TableRefreshTokenColumnFamily,
+	TableRefreshTokenColumnLastUsedAt,
+	TableRefreshTokenColumnNotes,
+	TableRefreshTokenColumnPrefix,
+	TableRefreshTokenColumnRevoked,
+	TableRefreshTokenColumnRotatedAt,
+	TableRefreshTokenColumnSecret,
+	TableRefreshTokenColumnUpdatedAt,
+	TableRefreshTokenColumnUpdatedBy,
+	TableRefreshTokenColumnUserID,
+}
+
+// RefreshTokenEntity ...
+type RefreshTokenEntity struct {
+	// CreatedAt ...
+	CreatedAt time.Time
+	// CreatedBy ...
+	CreatedBy ntypes.Int64
+	// ExpireAt ...
+	ExpireAt pq.NullTime
+	// Family ...
+	Family string
+	// LastUsedAt ...
+	LastUsedAt pq.NullTime
+	// Notes ...
+	Notes ntypes.String
+	// Prefix ...
+	Prefix string
+	// Revoked ...
+	Revoked bool
+	// RotatedAt ...
+	RotatedAt pq.NullTime
+	// Secret ...
+	Secret []byte
+	// UpdatedAt ...
+	UpdatedAt pq.NullTime
+	// UpdatedBy ...
+	UpdatedBy ntypes.Int64
+	// UserID ...
+	UserID int64
+	// User ...
+	User *UserEntity
+	// Author ...
+	Author *UserEntity
+	// Modifier ...
+	Modifier *UserEntity
+}
+
+func (e *RefreshTokenEntity) Prop(cn string) (interface{}, bool) {
+	switch cn {
+
+	case TableRefreshTokenColumnCreatedAt:
+		return &e.CreatedAt, true
+	case TableRefreshTokenColumnCreatedBy:
+		return &e.CreatedBy, true
+	case TableRefreshTokenColumnExpireAt:
+		return &e.ExpireAt, true
+	case TableRefreshTokenColumnFamily:
+		return &e.Family, true
+	case TableRefreshTokenColumnLastUsedAt:
+		return &e.LastUsedAt, true
+	case TableRefreshTokenColumnNotes:
+		return &e.Notes, true
+	case TableRefreshTokenColumnPrefix:
+		return &e.Prefix, true
+	case TableRefreshTokenColumnRevoked:
+		return &e.Revoked, true
+	case TableRefreshTokenColumnRotatedAt:
+		return &e.RotatedAt, true
+	case TableRefreshTokenColumnSecret:
+		return &e.Secret, true
+	case TableRefreshTokenColumnUpdatedAt:
+		return &e.UpdatedAt, true
+	case TableRefreshTokenColumnUpdatedBy:
+		return &e.UpdatedBy, true
+	case TableRefreshTokenColumnUserID:
+		return &e.UserID, true
+	default:
+		return nil, false
+	}
+}
+
+func (e *RefreshTokenEntity) Props(cns ...string) ([]interface{}, error) {
+	if len(cns) == 0 {
+		cns = TableRefreshTokenColumns
+	}
+	res := make([]interface{}, 0, len(cns))
+	for _, cn := range cns {
+		if prop, ok := e.Prop(cn); ok {
+			res = append(res, prop)
+		} else {
+			return nil, fmt.Errorf("unexpected column provided: %s", cn)
+		}
+	}
+	return res, nil
+}
+
+// ScanRefreshTokenRows helps to scan rows straight to the slice of entities.
+func ScanRefreshTokenRows(rows Rows) (entities []*RefreshTokenEntity, err error) {
+	for rows.Next() {
+		var ent RefreshTokenEntity
+		err = rows.Scan(
+			&ent.CreatedAt,
+			&ent.CreatedBy,
+			&ent.ExpireAt,
+			&ent.Family,
+			&ent.LastUsedAt,
+			&ent.Notes,
+			&ent.Prefix,
+			&ent.Revoked,
+			&ent.RotatedAt,
+			&ent.Secret,
+			&ent.UpdatedAt,
+			&ent.UpdatedBy,
+			&ent.UserID,
+		)
+		if err != nil {
+			return
+		}
+
+		entities = append(entities, &ent)
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	return
+}
+
+// RefreshTokenIterator is not thread safe.
+type RefreshTokenIterator struct {
+	rows Rows
+	cols []string
+	expr *RefreshTokenFindExpr
+}
+
+func (i *RefreshTokenIterator) Next() bool {
+	return i.rows.Next()
+}
+
+func (i *RefreshTokenIterator) Close() error {
+	return i.rows.Close()
+}
+
+func (i *RefreshTokenIterator) Err() error {
+	return i.rows.Err()
+}
+
+// Columns is wrapper around sql.Rows.Columns method, that also cache output inside iterator.
+func (i *RefreshTokenIterator) Columns() ([]string, error) {
+	if i.cols == nil {
+		cols, err := i.rows.Columns()
+		if err != nil {
+			return nil, err
+		}
+		i.cols = cols
+	}
+	return i.cols, nil
+}
+
+// Ent is wrapper around RefreshToken method that makes iterator more generic.
+func (i *RefreshTokenIterator) Ent() (interface{}, error) {
+	return i.RefreshToken()
+}
+
+func (i *RefreshTokenIterator) RefreshToken() (*RefreshTokenEntity, error) {
+	var ent RefreshTokenEntity
+	cols, err := i.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	props, err := ent.Props(cols...)
+	if err != nil {
+		return nil, err
+	}
+	var prop []interface{}
+	if i.expr.JoinUser != nil && i.expr.JoinUser.Kind.Actionable() && i.expr.JoinUser.Fetch {
+		ent.User = &UserEntity{}
+		if prop, err = ent.User.Props(); err != nil {
+			return nil, err
+		}
+		props = append(props, prop...)
+	}
+	if i.expr.JoinAuthor != nil && i.expr.JoinAuthor.Kind.Actionable() && i.expr.JoinAuthor.Fetch {
+		ent.Author = &UserEntity{}
+		if prop, err = ent.Author.Props(); err != nil {
+			return nil, err
+		}
+		props = append(props, prop...)
+	}
+	if i.expr.JoinModifier != nil && i.expr.JoinModifier.Kind.Actionable() && i.expr.JoinModifier.Fetch {
+		ent.Modifier = &UserEntity{}
+		if prop, err = ent.Modifier.Props(); err != nil {
+			return nil, err
+		}
+		props = append(props, prop...)
+	}
+	if err := i.rows.Scan(props...); err != nil {
+		return nil, err
+	}
+	return &ent, nil
+}
+
+type RefreshTokenCriteria struct {
+	CreatedAt              *qtypes.Timestamp
+	CreatedBy              *qtypes.Int64
+	ExpireAt               *qtypes.Timestamp
+	Family                 *qtypes.String
+	LastUsedAt             *qtypes.Timestamp
+	Notes                  *qtypes.String
+	Prefix                 *qtypes.String
+	Revoked                ntypes.Bool
+	RotatedAt              *qtypes.Timestamp
+	Secret                 []byte
+	UpdatedAt              *qtypes.Timestamp
+	UpdatedBy              *qtypes.Int64
+	UserID                 *qtypes.Int64
+	operator               string
+	child, sibling, parent *RefreshTokenCriteria
+}
+
+func RefreshTokenOperand(operator string, operands ...*RefreshTokenCriteria) *RefreshTokenCriteria {
+	if len(operands) == 0 {
+		return &RefreshTokenCriteria{operator: operator}
+	}
+
+	parent := &RefreshTokenCriteria{
+		operator: operator,
+		child:    operands[0],
+	}
+
+	for i := 0; i < len(operands); i++ {
+		if i < len(operands)-1 {
+			operands[i].sibling = operands[i+1]
+		}
+		operands[i].parent = parent
+	}
+
+	return parent
+}
+
+func RefreshTokenOr(operands ...*RefreshTokenCriteria) *RefreshTokenCriteria {
+	return RefreshTokenOperand("OR", operands...)
+}
+
+func RefreshTokenAnd(operands ...*RefreshTokenCriteria) *RefreshTokenCriteria {
+	return RefreshTokenOperand("AND", operands...)
+}
+
+type RefreshTokenFindExpr struct {
+	Where         *RefreshTokenCriteria
+	Offset, Limit int64
+	Columns       []string
+	OrderBy       []RowOrder
+	JoinUser      *UserJoin
+	JoinAuthor    *UserJoin
+	JoinModifier  *UserJoin
+}
+
+type RefreshTokenJoin struct {
+	On, Where    *RefreshTokenCriteria
+	Fetch        bool
+	Kind         JoinType
+	JoinUser     *UserJoin
+	JoinAuthor   *UserJoin
+	JoinModifier *UserJoin
+}
+
+type RefreshTokenCountExpr struct {
+	Where        *RefreshTokenCriteria
+	JoinUser     *UserJoin
+	JoinAuthor   *UserJoin
+	JoinModifier *UserJoin
+}
+
+type RefreshTokenPatch struct {
+	CreatedAt  pq.NullTime
+	CreatedBy  ntypes.Int64
+	ExpireAt   pq.NullTime
+	Family     ntypes.String
+	LastUsedAt pq.NullTime
+	Notes      ntypes.String
+	Prefix     ntypes.String
+	Revoked    ntypes.Bool
+	RotatedAt  pq.NullTime
+	Secret     []byte
+	UpdatedAt  pq.NullTime
+	UpdatedBy  ntypes.Int64
+	UserID     ntypes.Int64
+}
+
+type RefreshTokenRepositoryBase struct {
+	Table   string
+	Columns []string
+	DB      *sql.DB
+	Log     LogFunc
+}
+
+func (r *RefreshTokenRepositoryBase) Tx(tx *sql.Tx) (*RefreshTokenRepositoryBaseTx, error) {
+	return &RefreshTokenRepositoryBaseTx{
+		base: r,
+		tx:   tx,
+	}, nil
+}
+
+func (r *RefreshTokenRepositoryBase) BeginTx(ctx context.Context) (*RefreshTokenRepositoryBaseTx, error) {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return r.Tx(tx)
+}
+
+func (r RefreshTokenRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *RefreshTokenRepositoryBaseTx) error, attempts int) (err error) {
+	return RunInTransaction(ctx, r.DB, func(tx *sql.Tx) error {
+		rtx, err := r.Tx(tx)
+		if err != nil {
+			return err
+		}
+		return fn(rtx)
+	}, attempts)
+}
+
+func (r *RefreshTokenRepositoryBase) InsertQuery(e *RefreshTokenEntity, read bool) (string, []interface{}, error) {
+	insert := NewComposer(13)
+	columns := bytes.NewBuffer(nil)
+	buf := bytes.NewBufferString("INSERT INTO ")
+	buf.WriteString(r.Table)
+
+	if !e.CreatedAt.IsZero() {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableRefreshTokenColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.CreatedAt)
+		insert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableRefreshTokenColumnCreatedBy); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.CreatedBy)
+	insert.Dirty = true
+
+	if e.ExpireAt.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableRefreshTokenColumnExpireAt); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ExpireAt)
+		insert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableRefreshTokenColumnFamily); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.Family)
+	insert.Dirty = true
+
+	if e.LastUsedAt.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableRefreshTokenColumnLastUsedAt); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.LastUsedAt)
+		insert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableRefreshTokenColumnNotes); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.Notes)
+	insert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableRefreshTokenColumnPrefix); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.Prefix)
+	insert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableRefreshTokenColumnRevoked); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.Revoked)
+	insert.Dirty = true
+
+	if e.RotatedAt.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableRefreshTokenColumnRotatedAt); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.RotatedAt)
+		insert.Dirty = true
+	}
+
+	if e.Secret != nil {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableRefreshTokenColumnSecret); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.Secret)
+		insert.Dirty = true
+	}
+
+	if e.UpdatedAt.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableRefreshTokenColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.UpdatedAt)
+		insert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableRefreshTokenColumnUpdatedBy); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.UpdatedBy)
+	insert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableRefreshTokenColumnUserID); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.UserID)
+	insert.Dirty = true
+
+	if columns.Len() > 0 {
+		buf.WriteString(" (")
+		buf.ReadFrom(columns)
+		buf.WriteString(") VALUES (")
+		buf.ReadFrom(insert)
+		buf.WriteString(") ")
+		if read {
+			buf.WriteString("RETURNING ")
+			if len(r.Columns) > 0 {
+				buf.WriteString(strings.Join(r.Columns, ", "))
+			} else {
+				buf.WriteString("created_at, created_by, expire_at, family, last_used_at, notes, prefix, revoked, rotated_at, secret, updated_at, updated_by, user_id")
+			}
+		}
+	}
+	return buf.String(), insert.Args(), nil
+}
+
+func (r *RefreshTokenRepositoryBase) insert(ctx context.Context, tx *sql.Tx, e *RefreshTokenEntity) (*RefreshTokenEntity, error) {
+	query, args, err := r.InsertQuery(e, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var row *sql.Row
+	if tx == nil {
+		row = r.DB.QueryRowContext(ctx, query, args...)
+	} else {
+		row = tx.QueryRowContext(ctx, query, args...)
+	}
+	err = row.Scan(
+		&e.CreatedAt,
+		&e.CreatedBy,
+		&e.ExpireAt,
+		&e.Family,
+		&e.LastUsedAt,
+		&e.Notes,
+		&e.Prefix,
+		&e.Revoked,
+		&e.RotatedAt,
+		&e.Secret,
+		&e.UpdatedAt,
+		&e.UpdatedBy,
+		&e.UserID,
+	)
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableRefreshToken, "insert", query, args...)
+		} else {
+			r.Log(err, TableRefreshToken, "insert tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (r *RefreshTokenRepositoryBase) Insert(ctx context.Context, e *RefreshTokenEntity) (*RefreshTokenEntity, error) {
+	return r.insert(ctx, nil, e)
+}
+
+func RefreshTokenCriteriaWhereClause(comp *Composer, c *RefreshTokenCriteria, id int) error {
+	if c.child == nil {
+		return _RefreshTokenCriteriaWhereClause(comp, c, id)
+	}
+	node := c
+	sibling := false
+	for {
+		if !sibling {
+			if node.child != nil {
+				if node.parent != nil {
+					comp.WriteString("(")
+				}
+				node = node.child
+				continue
+			} else {
+				comp.Dirty = false
+				comp.WriteString("(")
+				if err := _RefreshTokenCriteriaWhereClause(comp, node, id); err != nil {
+					return err
+				}
+				comp.WriteString(")")
+			}
+		}
+		if node.sibling != nil {
+			sibling = false
+			comp.WriteString(" ")
+			comp.WriteString(node.parent.operator)
+			comp.WriteString(" ")
+			node = node.sibling
+			continue
+		}
+		if node.parent != nil {
+			sibling = true
+			if node.parent.parent != nil {
+				comp.WriteString(")")
+			}
+			node = node.parent
+			continue
+		}
+
+		break
+	}
+	return nil
+}
+
+func _RefreshTokenCriteriaWhereClause(comp *Composer, c *RefreshTokenCriteria, id int) error {
+	QueryTimestampWhereClause(c.CreatedAt, id, TableRefreshTokenColumnCreatedAt, comp, And)
+
+	QueryInt64WhereClause(c.CreatedBy, id, TableRefreshTokenColumnCreatedBy, comp, And)
+
+	QueryTimestampWhereClause(c.ExpireAt, id, TableRefreshTokenColumnExpireAt, comp, And)
+
+	QueryStringWhereClause(c.Family, id, TableRefreshTokenColumnFamily, comp, And)
+
+	QueryTimestampWhereClause(c.LastUsedAt, id, TableRefreshTokenColumnLastUsedAt, comp, And)
+
+	QueryStringWhereClause(c.Notes, id, TableRefreshTokenColumnNotes, comp, And)
+
+	QueryStringWhereClause(c.Prefix, id, TableRefreshTokenColumnPrefix, comp, And)
+
+	if c.Revoked.Valid {
+		if comp.Dirty {
+			if _, err := comp.WriteString(" AND "); err != nil {
+				return err
+			}
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableRefreshTokenColumnRevoked); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.Revoked)
+		comp.Dirty = true
+	}
+
+	QueryTimestampWhereClause(c.RotatedAt, id, TableRefreshTokenColumnRotatedAt, comp, And)
+
+	if c.Secret != nil {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableRefreshTokenColumnSecret); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.Secret)
+		comp.Dirty = true
+	}
+	QueryTimestampWhereClause(c.UpdatedAt, id, TableRefreshTokenColumnUpdatedAt, comp, And)
+
+	QueryInt64WhereClause(c.UpdatedBy, id, TableRefreshTokenColumnUpdatedBy, comp, And)
+
+	QueryInt64WhereClause(c.UserID, id, TableRefreshTokenColumnUserID, comp, And)
+
+	return nil
+}
+
+func (r *RefreshTokenRepositoryBase) FindQuery(fe *RefreshTokenFindExpr) (string, []interface{}, error) {
+	comp := NewComposer(13)
+	buf := bytes.NewBufferString("SELECT ")
+	if len(fe.Columns) == 0 {
+		buf.WriteString("t0.created_at, t0.created_by, t0.expire_at, t0.family, t0.last_used_at, t0.notes, t0.prefix, t0.revoked, t0.rotated_at, t0.secret, t0.updated_at, t0.updated_by, t0.user_id")
+	} else {
+		buf.WriteString(strings.Join(fe.Columns, ", "))
+	}
+	if fe.JoinUser != nil && fe.JoinUser.Kind.Actionable() && fe.JoinUser.Fetch {
+		buf.WriteString(", t1.confirmation_token, t1.confirmation_token_expire_at, t1.created_at, t1.created_by, t1.deleted_at, t1.first_name, t1.id, t1.is_active, t1.is_confirmed, t1.is_service_account, t1.is_staff, t1.is_superuser, t1.last_login_at, t1.last_name, t1.locked_at, t1.password, t1.password_reset_token, t1.password_reset_token_expire_at, t1.two_factor_challenge, t1.two_factor_challenge_expire_at, t1.two_factor_confirmed_at, t1.two_factor_last_step, t1.two_factor_recovery_codes, t1.two_factor_secret, t1.updated_at, t1.updated_by, t1.username")
+	}
+	if fe.JoinAuthor != nil && fe.JoinAuthor.Kind.Actionable() && fe.JoinAuthor.Fetch {
+		buf.WriteString(", t2.confirmation_token, t2.confirmation_token_expire_at, t2.created_at, t2.created_by, t2.deleted_at, t2.first_name, t2.id, t2.is_active, t2.is_confirmed, t2.is_service_account, t2.is_staff, t2.is_superuser, t2.last_login_at, t2.last_name, t2.locked_at, t2.password, t2.password_reset_token, t2.password_reset_token_expire_at, t2.two_factor_challenge, t2.two_factor_challenge_expire_at, t2.two_factor_confirmed_at, t2.two_factor_last_step, t2.two_factor_recovery_codes, t2.two_factor_secret, t2.updated_at, t2.updated_by, t2.username")
+	}
+	if fe.JoinModifier != nil && fe.JoinModifier.Kind.Actionable() && fe.JoinModifier.Fetch {
+		buf.WriteString(", t3.confirmation_token, t3.confirmation_token_expire_at, t3.created_at, t3.created_by, t3.deleted_at, t3.first_name, t3.id, t3.is_active, t3.is_confirmed, t3.is_service_account, t3.is_staff, t3.is_superuser, t3.last_login_at, t3.last_name, t3.locked_at, t3.password, t3.password_reset_token, t3.password_reset_token_expire_at, t3.two_factor_challenge, t3.two_factor_challenge_expire_at, t3.two_factor_confirmed_at, t3.two_factor_last_step, t3.two_factor_recovery_codes, t3.two_factor_secret, t3.updated_at, t3.updated_by, t3.username")
+	}
+	buf.WriteString(" FROM ")
+	buf.WriteString(r.Table)
+	buf.WriteString(" AS t0")
+	if fe.JoinUser != nil && fe.JoinUser.Kind.Actionable() {
+		joinClause(comp, fe.JoinUser.Kind, "charon.user AS t1 ON t0.user_id=t1.id")
+		if fe.JoinUser.On != nil {
+			comp.Dirty = true
+			if err := UserCriteriaWhereClause(comp, fe.JoinUser.On, 1); err != nil {
+				return "", nil, err
+			}
+		}
+	}
+	if fe.JoinAuthor != nil && fe.JoinAuthor.Kind.Actionable() {
+		joinClause(comp, fe.JoinAuthor.Kind, "charon.user AS t2 ON t0.created_by=t2.id")
+		if fe.JoinAuthor.On != nil {
+			comp.Dirty = true
+			if err := UserCriteriaWhereClause(comp, fe.JoinAuthor.On, 2); err != nil {
+				return "", nil, err
+			}
+		}
+	}
+	if fe.JoinModifier != nil && fe.JoinModifier.Kind.Actionable() {
+		joinClause(comp, fe.JoinModifier.Kind, "charon.user AS t3 ON t0.updated_by=t3.id")
+		if fe.JoinModifier.On != nil {
+			comp.Dirty = true
+			if err := UserCriteriaWhereClause(comp, fe.JoinModifier.On, 3); err != nil {
+				return "", nil, err
+			}
+		}
+	}
+	if comp.Dirty {
+		buf.ReadFrom(comp)
+		comp.Dirty = false
+	}
+	if fe.Where != nil {
+		if err := RefreshTokenCriteriaWhereClause(comp, fe.Where, 0); err != nil {
+			return "", nil, err
+		}
+	}
+	if fe.JoinUser != nil && fe.JoinUser.Kind.Actionable() && fe.JoinUser.Where != nil {
+		if err := UserCriteriaWhereClause(comp, fe.JoinUser.Where, 1); err != nil {
+			return "", nil, err
+		}
+	}
+	if fe.JoinAuthor != nil && fe.JoinAuthor.Kind.Actionable() && fe.JoinAuthor.Where != nil {
+		if err := UserCriteriaWhereClause(comp, fe.JoinAuthor.Where, 2); err != nil {
+			return "", nil, err
+		}
+	}
+	if fe.JoinModifier != nil && fe.JoinModifier.Kind.Actionable() && fe.JoinModifier.Where != nil {
+		if err := UserCriteriaWhereClause(comp, fe.JoinModifier.Where, 3); err != nil {
+			return "", nil, err
+		}
+	}
+	if comp.Dirty {
+		if _, err := buf.WriteString(" WHERE "); err != nil {
+			return "", nil, err
+		}
+		buf.ReadFrom(comp)
+	}
+
+	if len(fe.OrderBy) > 0 {
+		i := 0
+		for _, order := range fe.OrderBy {
+			for _, columnName := range TableRefreshTokenColumns {
+				if order.Name == columnName {
+					if i == 0 {
+						comp.WriteString(" ORDER BY ")
+					}
+					if i > 0 {
+						if _, err := comp.WriteString(", "); err != nil {
+							return "", nil, err
+						}
+					}
+					if _, err := comp.WriteString(order.Name); err != nil {
+						return "", nil, err
+					}
+					if order.Descending {
+						if _, err := comp.WriteString(" DESC"); err != nil {
+							return "", nil, err
+						}
+					}
+					i++
+					break
+				}
+			}
+		}
+	}
+	if fe.Offset > 0 {
+		if _, err := comp.WriteString(" OFFSET "); err != nil {
+			return "", nil, err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		if _, err := comp.WriteString(" "); err != nil {
+			return "", nil, err
+		}
+		comp.Add(fe.Offset)
+	}
+	if fe.Limit > 0 {
+		if _, err := comp.WriteString(" LIMIT "); err != nil {
+			return "", nil, err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		if _, err := comp.WriteString(" "); err != nil {
+			return "", nil, err
+		}
+		comp.Add(fe.Limit)
+	}
+
+	buf.ReadFrom(comp)
+
+	return buf.String(), comp.Args(), nil
+}
+
+func (r *RefreshTokenRepositoryBase) find(ctx context.Context, tx *sql.Tx, fe *RefreshTokenFindExpr) ([]*RefreshTokenEntity, error) {
+	query, args, err := r.FindQuery(fe)
+	if err != nil {
+		return nil, err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableRefreshToken, "find", query, args...)
+		} else {
+			r.Log(err, TableRefreshToken, "find tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		entities []*RefreshTokenEntity
+		props    []interface{}
+	)
+	for rows.Next() {
+		var ent RefreshTokenEntity
+		if props, err = ent.Props(); err != nil {
+			return nil, err
+		}
+		var prop []interface{}
+		if fe.JoinUser != nil && fe.JoinUser.Kind.Actionable() && fe.JoinUser.Fetch {
+			ent.User = &UserEntity{}
+			if prop, err = ent.User.Props(); err != nil {
+				return nil, err
+			}
+			props = append(props, prop...)
+		}
+		if fe.JoinAuthor != nil && fe.JoinAuthor.Kind.Actionable() && fe.JoinAuthor.Fetch {
+			ent.Author = &UserEntity{}
+			if prop, err = ent.Author.Props(); err != nil {
+				return nil, err
+			}
+			props = append(props, prop...)
+		}
+		if fe.JoinModifier != nil && fe.JoinModifier.Kind.Actionable() && fe.JoinModifier.Fetch {
+			ent.Modifier = &UserEntity{}
+			if prop, err = ent.Modifier.Props(); err != nil {
+				return nil, err
+			}
+			props = append(props, prop...)
+		}
+		err = rows.Scan(props...)
+		if err != nil {
+			return nil, err
+		}
+
+		entities = append(entities, &ent)
+	}
+	err = rows.Err()
+	if r.Log != nil {
+		r.Log(err, TableRefreshToken, "find", query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return entities, nil
+}
+
+func (r *RefreshTokenRepositoryBase) Find(ctx context.Context, fe *RefreshTokenFindExpr) ([]*RefreshTokenEntity, error) {
+	return r.find(ctx, nil, fe)
+}
+
+func (r *RefreshTokenRepositoryBase) findIter(ctx context.Context, tx *sql.Tx, fe *RefreshTokenFindExpr) (*RefreshTokenIterator, error) {
+	query, args, err := r.FindQuery(fe)
+	if err != nil {
+		return nil, err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableRefreshToken, "find iter", query, args...)
+		} else {
+			r.Log(err, TableRefreshToken, "find iter tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &RefreshTokenIterator{
+		rows: rows,
+		expr: fe,
+		cols: fe.Columns,
+	}, nil
+}
+
+func (r *RefreshTokenRepositoryBase) FindIter(ctx context.Context, fe *RefreshTokenFindExpr) (*RefreshTokenIterator, error) {
+	return r.findIter(ctx, nil, fe)
+}
+
+func (r *RefreshTokenRepositoryBase) findOneByPrefix(ctx context.Context, tx *sql.Tx, refreshTokenPrefix string) (*RefreshTokenEntity, error) {
+	find := NewComposer(13)
+	find.WriteString("SELECT ")
+	if len(r.Columns) == 0 {
+		find.WriteString("created_at, created_by, expire_at, family, last_used_at, notes, prefix, revoked, rotated_at, secret, updated_at, updated_by, user_id")
+	} else {
+		find.WriteString(strings.Join(r.Columns, ", "))
+	}
+	find.WriteString(" FROM ")
+	find.WriteString(TableRefreshToken)
+	find.WriteString(" WHERE ")
+	find.WriteString(TableRefreshTokenColumnPrefix)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(refreshTokenPrefix)
+
+	var (
+		ent RefreshTokenEntity
+	)
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
+	} else {
+		err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &ent, nil
+}
+
+func (r *RefreshTokenRepositoryBase) FindOneByPrefix(ctx context.Context, refreshTokenPrefix string) (*RefreshTokenEntity, error) {
+	return r.findOneByPrefix(ctx, nil, refreshTokenPrefix)
+}
+
+func (r *RefreshTokenRepositoryBase) UpdateOneByPrefixQuery(refreshTokenPrefix string, p *RefreshTokenPatch) (string, []interface{}, error) {
+	buf := bytes.NewBufferString("UPDATE ")
+	buf.WriteString(r.Table)
+	update := NewComposer(1)
+	if p.CreatedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableRefreshTokenColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.CreatedAt)
+		update.Dirty = true
+
+	}
+	if p.CreatedBy.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableRefreshTokenColumnCreatedBy); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.CreatedBy)
+		update.Dirty = true
+	}
+
+	if p.ExpireAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableRefreshTokenColumnExpireAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.ExpireAt)
+		update.Dirty = true
+
+	}
+	if p.Family.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableRefreshTokenColumnFamily); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Family)
+		update.Dirty = true
+	}
+
+	if p.LastUsedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableRefreshTokenColumnLastUsedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.LastUsedAt)
+		update.Dirty = true
+
+	}
+	if p.Notes.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableRefreshTokenColumnNotes); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Notes)
+		update.Dirty = true
+	}
+
+	if p.Prefix.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableRefreshTokenColumnPrefix); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Prefix)
+		update.Dirty = true
+	}
+
+	if p.Revoked.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableRefreshTokenColumnRevoked); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Revoked)
+		update.Dirty = true
+	}
+
+	if p.RotatedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableRefreshTokenColumnRotatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.RotatedAt)
+		update.Dirty = true
+
+	}
+	if p.Secret != nil {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableRefreshTokenColumnSecret); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Secret)
+		update.Dirty = true
+
+	}
+	if p.UpdatedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableRefreshTokenColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.UpdatedAt)
+		update.Dirty = true
+
+	} else {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableRefreshTokenColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("=NOW()"); err != nil {
+			return "", nil, err
+		}
+		update.Dirty = true
+	}
+	if p.UpdatedBy.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableRefreshTokenColumnUpdatedBy); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.UpdatedBy)
+		update.Dirty = true
+	}
+
+	if p.UserID.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableRefreshTokenColumnUserID); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.UserID)
+		update.Dirty = true
+	}
+
+	if !update.Dirty {
+		return "", nil, errors.New("refresh_token update failure, nothing to update")
+	}
+	buf.WriteString(" SET ")
+	buf.ReadFrom(update)
+	buf.WriteString(" WHERE ")
+	update.WriteString(TableRefreshTokenColumnPrefix)
+	update.WriteString("=")
+	update.WritePlaceholder()
+	update.Add(refreshTokenPrefix)
+	buf.ReadFrom(update)
+	buf.WriteString(" RETURNING ")
+	if len(r.Columns) > 0 {
+		buf.WriteString(strings.Join(r.Columns, ", "))
+	} else {
+		buf.WriteString("created_at, created_by, expire_at, family, last_used_at, notes, prefix, revoked, rotated_at, secret, updated_at, updated_by, user_id")
+	}
+	return buf.String(), update.Args(), nil
+}
+
+func (r *RefreshTokenRepositoryBase) updateOneByPrefix(ctx context.Context, tx *sql.Tx, refreshTokenPrefix string, p *RefreshTokenPatch) (*RefreshTokenEntity, error) {
+	query, args, err := r.UpdateOneByPrefixQuery(refreshTokenPrefix, p)
+	if err != nil {
+		return nil, err
+	}
+	var ent RefreshTokenEntity
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+
+	var row *sql.Row
+	if tx == nil {
+		row = r.DB.QueryRowContext(ctx, query, args...)
+	} else {
+		row = tx.QueryRowContext(ctx, query, args...)
+	}
+	err = row.Scan(props...)
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableRefreshToken, "update one by unique", query, args...)
+		} else {
+			r.Log(err, TableRefreshToken, "update one by unique tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ent, nil
+}
+
+func (r *RefreshTokenRepositoryBase) UpdateOneByPrefix(ctx context.Context, refreshTokenPrefix string, p *RefreshTokenPatch) (*RefreshTokenEntity, error) {
+	return r.updateOneByPrefix(ctx, nil, refreshTokenPrefix, p)
+}
+
+func (r *RefreshTokenRepositoryBase) UpsertQuery(e *RefreshTokenEntity, p *RefreshTokenPatch, inf ...string) (string, []interface{}, error) {
+	upsert := NewComposer(26)
+	columns := bytes.NewBuffer(nil)
+	buf := bytes.NewBufferString("INSERT INTO ")
+	buf.WriteString(r.Table)
+
+	if !e.CreatedAt.IsZero() {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableRefreshTokenColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.CreatedAt)
+		upsert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableRefreshTokenColumnCreatedBy); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.CreatedBy)
+	upsert.Dirty = true
+
+	if e.ExpireAt.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableRefreshTokenColumnExpireAt); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ExpireAt)
+		upsert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableRefreshTokenColumnFamily); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.Family)
+	upsert.Dirty = true
+
+	if e.LastUsedAt.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableRefreshTokenColumnLastUsedAt); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.LastUsedAt)
+		upsert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableRefreshTokenColumnNotes); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.Notes)
+	upsert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableRefreshTokenColumnPrefix); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.Prefix)
+	upsert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableRefreshTokenColumnRevoked); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.Revoked)
+	upsert.Dirty = true
+
+	if e.RotatedAt.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableRefreshTokenColumnRotatedAt); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.RotatedAt)
+		upsert.Dirty = true
+	}
+
+	if e.Secret != nil {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableRefreshTokenColumnSecret); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.Secret)
+		upsert.Dirty = true
+	}
+
+	if e.UpdatedAt.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableRefreshTokenColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.UpdatedAt)
+		upsert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableRefreshTokenColumnUpdatedBy); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.UpdatedBy)
+	upsert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableRefreshTokenColumnUserID); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.UserID)
+	upsert.Dirty = true
+
+	if upsert.Dirty {
+		buf.WriteString(" (")
+		buf.ReadFrom(columns)
+		buf.WriteString(") VALUES (")
+		buf.ReadFrom(upsert)
+		buf.WriteString(")")
+	}
+	buf.WriteString(" ON CONFLICT ")
+	if len(inf) > 0 {
+		upsert.Dirty = false
+		if p.CreatedAt.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableRefreshTokenColumnCreatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.CreatedAt)
+			upsert.Dirty = true
+
+		}
+		if p.CreatedBy.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableRefreshTokenColumnCreatedBy); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.CreatedBy)
+			upsert.Dirty = true
+		}
+
+		if p.ExpireAt.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableRefreshTokenColumnExpireAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ExpireAt)
+			upsert.Dirty = true
+
+		}
+		if p.Family.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableRefreshTokenColumnFamily); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Family)
+			upsert.Dirty = true
+		}
+
+		if p.LastUsedAt.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableRefreshTokenColumnLastUsedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.LastUsedAt)
+			upsert.Dirty = true
+
+		}
+		if p.Notes.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableRefreshTokenColumnNotes); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Notes)
+			upsert.Dirty = true
+		}
+
+		if p.Prefix.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableRefreshTokenColumnPrefix); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Prefix)
+			upsert.Dirty = true
+		}
+
+		if p.Revoked.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableRefreshTokenColumnRevoked); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Revoked)
+			upsert.Dirty = true
+		}
+
+		if p.RotatedAt.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableRefreshTokenColumnRotatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.RotatedAt)
+			upsert.Dirty = true
+
+		}
+		if p.Secret != nil {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableRefreshTokenColumnSecret); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Secret)
+			upsert.Dirty = true
+
+		}
+		if p.UpdatedAt.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableRefreshTokenColumnUpdatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.UpdatedAt)
+			upsert.Dirty = true
+
+		} else {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableRefreshTokenColumnUpdatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("=NOW()"); err != nil {
+				return "", nil, err
+			}
+			upsert.Dirty = true
+		}
+		if p.UpdatedBy.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableRefreshTokenColumnUpdatedBy); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.UpdatedBy)
+			upsert.Dirty = true
+		}
+
+		if p.UserID.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableRefreshTokenColumnUserID); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.UserID)
+			upsert.Dirty = true
+		}
+
+	}
+	if len(inf) > 0 && upsert.Dirty {
+		buf.WriteString("(")
+		for j, i := range inf {
+			if j != 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString(i)
+		}
+		buf.WriteString(")")
+		buf.WriteString(" DO UPDATE SET ")
+		buf.ReadFrom(upsert)
+	} else {
+		buf.WriteString(" DO NOTHING ")
+	}
+	if upsert.Dirty {
+		buf.WriteString(" RETURNING ")
+		if len(r.Columns) > 0 {
+			buf.WriteString(strings.Join(r.Columns, ", "))
+		} else {
+			buf.WriteString("created_at, created_by, expire_at, family, last_used_at, notes, prefix, revoked, rotated_at, secret, updated_at, updated_by, user_id")
+		}
+	}
+	return buf.String(), upsert.Args(), nil
+}
+
+func (r *RefreshTokenRepositoryBase) upsert(ctx context.Context, tx *sql.Tx, e *RefreshTokenEntity, p *RefreshTokenPatch, inf ...string) (*RefreshTokenEntity, error) {
+	query, args, err := r.UpsertQuery(e, p, inf...)
+	if err != nil {
+		return nil, err
+	}
+
+	var row *sql.Row
+	if tx == nil {
+		row = r.DB.QueryRowContext(ctx, query, args...)
+	} else {
+		row = tx.QueryRowContext(ctx, query, args...)
+	}
+	err = row.Scan(
+		&e.CreatedAt,
+		&e.CreatedBy,
+		&e.ExpireAt,
+		&e.Family,
+		&e.LastUsedAt,
+		&e.Notes,
+		&e.Prefix,
+		&e.Revoked,
+		&e.RotatedAt,
+		&e.Secret,
+		&e.UpdatedAt,
+		&e.UpdatedBy,
+		&e.UserID,
+	)
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableRefreshToken, "upsert", query, args...)
+		} else {
+			r.Log(err, TableRefreshToken, "upsert tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (r *RefreshTokenRepositoryBase) Upsert(ctx context.Context, e *RefreshTokenEntity, p *RefreshTokenPatch, inf ...string) (*RefreshTokenEntity, error) {
+	return r.upsert(ctx, nil, e, p, inf...)
+}
+
+func (r *RefreshTokenRepositoryBase) count(ctx context.Context, tx *sql.Tx, exp *RefreshTokenCountExpr) (int64, error) {
+	query, args, err := r.FindQuery(&RefreshTokenFindExpr{
+		Where:   exp.Where,
+		Columns: []string{"COUNT(*)"},
+
+		JoinUser:     exp.JoinUser,
+		JoinAuthor:   exp.JoinAuthor,
+		JoinModifier: exp.JoinModifier,
+	})
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, query, args...).Scan(&count)
+	} else {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(&count)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableRefreshToken, "count", query, args...)
+		} else {
+			r.Log(err, TableRefreshToken, "count tx", query, args...)
+		}
+	}
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *RefreshTokenRepositoryBase) Count(ctx context.Context, exp *RefreshTokenCountExpr) (int64, error) {
+	return r.count(ctx, nil, exp)
+}
+
+type RefreshTokenRepositoryBaseTx struct {
+	base *RefreshTokenRepositoryBase
+	tx   *sql.Tx
+}
+
+func (r RefreshTokenRepositoryBaseTx) Commit() error {
+	return r.tx.Commit()
+}
+
+func (r RefreshTokenRepositoryBaseTx) Rollback() error {
+	return r.tx.Rollback()
+}
+
+func (r *RefreshTokenRepositoryBaseTx) Insert(ctx context.Context, e *RefreshTokenEntity) (*RefreshTokenEntity, error) {
+	return r.base.insert(ctx, r.tx, e)
+}
+
+func (r *RefreshTokenRepositoryBaseTx) Find(ctx context.Context, fe *RefreshTokenFindExpr) ([]*RefreshTokenEntity, error) {
+	return r.base.find(ctx, r.tx, fe)
+}
+
+func (r *RefreshTokenRepositoryBaseTx) FindIter(ctx context.Context, fe *RefreshTokenFindExpr) (*RefreshTokenIterator, error) {
+	return r.base.findIter(ctx, r.tx, fe)
+}
+
+func (r *RefreshTokenRepositoryBaseTx) UpdateOneByPrefix(ctx context.Context, refreshTokenPrefix string, p *RefreshTokenPatch) (*RefreshTokenEntity, error) {
+	return r.base.updateOneByPrefix(ctx, r.tx, refreshTokenPrefix, p)
+}
+
+func (r *RefreshTokenRepositoryBaseTx) Upsert(ctx context.Context, e *RefreshTokenEntity, p *RefreshTokenPatch, inf ...string) (*RefreshTokenEntity, error) {
+	return r.base.upsert(ctx, r.tx, e, p, inf...)
+}
+
+func (r *RefreshTokenRepositoryBaseTx) Count(ctx context.Context, exp *RefreshTokenCountExpr) (int64, error) {
+	return r.base.count(ctx, r.tx, exp)
+}
+
+const (
+	TableLoginFailureConstraintKindSubjectUnique = "charon.login_failure_kind_subject_key"
+	TableLoginFailureConstraintPrimaryKey        = "charon.login_failure_id_pkey"
+)
+
+const (
+	TableLoginFailure                    = "charon.login_failure"
+	TableLoginFailureColumnCreatedAt     = "created_at"
+	TableLoginFailureColumnFailures      = "failures"
+	TableLoginFailureColumnID            = "id"
+	TableLoginFailureColumnKind          = "kind"
+	TableLoginFailureColumnLastFailureAt = "last_failure_at"
+	TableLoginFailureColumnSubject       = "subject"
+	TableLoginFailureColumnUpdatedAt     = "updated_at"
+)
+
+var TableLoginFailureColumns = []string{
+	TableLoginFailureColumnCreatedAt,
+	TableLoginFailureColumnFailures,
+	TableLoginFailureColumnID,
+	TableLoginFailureColumnKind,
+	TableLoginFailureColumnLastFailureAt,
+	TableLoginFailureColumnSubject,
+	TableLoginFailureColumnUpdatedAt,
+}
+
+// LoginFailureEntity ...
+type LoginFailureEntity struct {
+	// CreatedAt ...
+	CreatedAt time.Time
+	// Failures ...
+	Failures int64
+	// ID ...
+	ID int64
+	// Kind ...
+	Kind string
+	// LastFailureAt ...
+	LastFailureAt time.Time
+	// Subject ...
+	Subject string
+	// UpdatedAt ...
+	UpdatedAt pq.NullTime
+}
+
+func (e *LoginFailureEntity) Prop(cn string) (interface{}, bool) {
+	switch cn {
+
+	case TableLoginFailureColumnCreatedAt:
+		return &e.CreatedAt, true
+	case TableLoginFailureColumnFailures:
+		return &e.Failures, true
+	case TableLoginFailureColumnID:
+		return &e.ID, true
+	case TableLoginFailureColumnKind:
+		return &e.Kind, true
+	case TableLoginFailureColumnLastFailureAt:
+		return &e.LastFailureAt, true
+	case TableLoginFailureColumnSubject:
+		return &e.Subject, true
+	case TableLoginFailureColumnUpdatedAt:
+		return &e.UpdatedAt, true
+	default:
+		return nil, false
+	}
+}
+
+func (e *LoginFailureEntity) Props(cns ...string) ([]interface{}, error) {
+	if len(cns) == 0 {
+		cns = TableLoginFailureColumns
+	}
+	res := make([]interface{}, 0, len(cns))
+	for _, cn := range cns {
+		if prop, ok := e.Prop(cn); ok {
+			res = append(res, prop)
+		} else {
+			return nil, fmt.Errorf("unexpected column provided: %s", cn)
+		}
+	}
+	return res, nil
+}
+
+// ScanLoginFailureRows helps to scan rows straight to the slice of entities.
+func ScanLoginFailureRows(rows Rows) (entities []*LoginFailureEntity, err error) {
+	for rows.Next() {
+		var ent LoginFailureEntity
+		err = rows.Scan(
+			&ent.CreatedAt,
+			&ent.Failures,
+			&ent.ID,
+			&ent.Kind,
+			&ent.LastFailureAt,
+			&ent.Subject,
+			&ent.UpdatedAt,
+		)
+		if err != nil {
+			return
+		}
+
+		entities = append(entities, &ent)
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	return
+}
+
+// LoginFailureIterator is not thread safe.
+type LoginFailureIterator struct {
+	rows Rows
+	cols []string
+	expr *LoginFailureFindExpr
+}
+
+func (i *LoginFailureIterator) Next() bool {
+	return i.rows.Next()
+}
+
+func (i *LoginFailureIterator) Close() error {
+	return i.rows.Close()
+}
+
+func (i *LoginFailureIterator) Err() error {
+	return i.rows.Err()
+}
+
+// Columns is wrapper around sql.Rows.Columns method, that also cache output inside iterator.
+func (i *LoginFailureIterator) Columns() ([]string, error) {
+	if i.cols == nil {
+		cols, err := i.rows.Columns()
+		if err != nil {
+			return nil, err
+		}
+		i.cols = cols
+	}
+	return i.cols, nil
+}
+
+// Ent is wrapper around LoginFailure method that makes iterator more generic.
+func (i *LoginFailureIterator) Ent() (interface{}, error) {
+	return i.LoginFailure()
+}
+
+func (i *LoginFailureIterator) LoginFailure() (*LoginFailureEntity, error) {
+	var ent LoginFailureEntity
+	cols, err := i.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	props, err := ent.Props(cols...)
+	if err != nil {
+		return nil, err
+	}
+	if err := i.rows.Scan(props...); err != nil {
+		return nil, err
+	}
+	return &ent, nil
+}
+
+type LoginFailureCriteria struct {
+	CreatedAt              *qtypes.Timestamp
+	Failures               *qtypes.Int64
+	ID                     *qtypes.Int64
+	Kind                   *qtypes.String
+	LastFailureAt          *qtypes.Timestamp
+	Subject                *qtypes.String
+	UpdatedAt              *qtypes.Timestamp
+	operator               string
+	child, sibling, parent *LoginFailureCriteria
+}
+
+func LoginFailureOperand(operator string, operands ...*LoginFailureCriteria) *LoginFailureCriteria {
+	if len(operands) == 0 {
+		return &LoginFailureCriteria{operator: operator}
+	}
+
+	parent := &LoginFailureCriteria{
+		operator: operator,
+		child:    operands[0],
+	}
+
+	for i := 0; i < len(operands); i++ {
+		if i < len(operands)-1 {
+			operands[i].sibling = operands[i+1]
+		}
+		operands[i].parent = parent
+	}
+
+	return parent
+}
+
+func LoginFailureOr(operands ...*LoginFailureCriteria) *LoginFailureCriteria {
+	return LoginFailureOperand("OR", operands...)
+}
+
+func LoginFailureAnd(operands ...*LoginFailureCriteria) *LoginFailureCriteria {
+	return LoginFailureOperand("AND", operands...)
+}
+
+type LoginFailureFindExpr struct {
+	Where         *LoginFailureCriteria
+	Offset, Limit int64
+	Columns       []string
+	OrderBy       []RowOrder
+}
+
+type LoginFailureJoin struct {
+	On, Where *LoginFailureCriteria
+	Fetch     bool
+	Kind      JoinType
+}
+
+type LoginFailureCountExpr struct {
+	Where *LoginFailureCriteria
+}
+
+type LoginFailurePatch struct {
+	CreatedAt     pq.NullTime
+	Failures      ntypes.Int64
+	Kind          ntypes.String
+	LastFailureAt pq.NullTime
+	Subject       ntypes.String
+	UpdatedAt     pq.NullTime
+}
+
+type LoginFailureRepositoryBase struct {
+	Table   string
+	Columns []string
+	DB      *sql.DB
+	Log     LogFunc
+}
+
+func (r *LoginFailureRepositoryBase) Tx(tx *sql.Tx) (*LoginFailureRepositoryBaseTx, error) {
+	return &LoginFailureRepositoryBaseTx{
+		base: r,
+		tx:   tx,
+	}, nil
+}
+
+func (r *LoginFailureRepositoryBase) BeginTx(ctx context.Context) (*LoginFailureRepositoryBaseTx, error) {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return r.Tx(tx)
+}
+
+func (r LoginFailureRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *LoginFailureRepositoryBaseTx) error, attempts int) (err error) {
+	return RunInTransaction(ctx, r.DB, func(tx *sql.Tx) error {
+		rtx, err := r.Tx(tx)
+		if err != nil {
+			return err
+		}
+		return fn(rtx)
+	}, attempts)
+}
+
+func (r *LoginFailureRepositoryBase) InsertQuery(e *LoginFailureEntity, read bool) (string, []interface{}, error) {
+	insert := NewComposer(7)
+	columns := bytes.NewBuffer(nil)
+	buf := bytes.NewBufferString("INSERT INTO ")
+	buf.WriteString(r.Table)
+
+	if !e.CreatedAt.IsZero() {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableLoginFailureColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.CreatedAt)
+		insert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableLoginFailureColumnFailures); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.Failures)
+	insert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableLoginFailureColumnKind); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.Kind)
+	insert.Dirty = true
+
+	if !e.LastFailureAt.IsZero() {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableLoginFailureColumnLastFailureAt); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.LastFailureAt)
+		insert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableLoginFailureColumnSubject); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.Subject)
+	insert.Dirty = true
+
+	if e.UpdatedAt.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableLoginFailureColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.UpdatedAt)
+		insert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		buf.WriteString(" (")
+		buf.ReadFrom(columns)
+		buf.WriteString(") VALUES (")
+		buf.ReadFrom(insert)
+		buf.WriteString(") ")
+		if read {
+			buf.WriteString("RETURNING ")
+			if len(r.Columns) > 0 {
+				buf.WriteString(strings.Join(r.Columns, ", "))
+			} else {
+				buf.WriteString("created_at, failures, id, kind, last_failure_at, subject, updated_at")
+			}
+		}
+	}
+	return buf.String(), insert.Args(), nil
+}
+
+func (r *LoginFailureRepositoryBase) insert(ctx context.Context, tx *sql.Tx, e *LoginFailureEntity) (*LoginFailureEntity, error) {
+	query, args, err := r.InsertQuery(e, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var row *sql.Row
+	if tx == nil {
+		row = r.DB.QueryRowContext(ctx, query, args...)
+	} else {
+		row = tx.QueryRowContext(ctx, query, args...)
+	}
+	err = row.Scan(
+		&e.CreatedAt,
+		&e.Failures,
+		&e.ID,
+		&e.Kind,
+		&e.LastFailureAt,
+		&e.Subject,
+		&e.UpdatedAt,
+	)
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableLoginFailure, "insert", query, args...)
+		} else {
+			r.Log(err, TableLoginFailure, "insert tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (r *LoginFailureRepositoryBase) Insert(ctx context.Context, e *LoginFailureEntity) (*LoginFailureEntity, error) {
+	return r.insert(ctx, nil, e)
+}
+
+func LoginFailureCriteriaWhereClause(comp *Composer, c *LoginFailureCriteria, id int) error {
+	if c.child == nil {
+		return _LoginFailureCriteriaWhereClause(comp, c, id)
+	}
+	node := c
+	sibling := false
+	for {
+		if !sibling {
+			if node.child != nil {
+				if node.parent != nil {
+					comp.WriteString("(")
+				}
+				node = node.child
+				continue
+			} else {
+				comp.Dirty = false
+				comp.WriteString("(")
+				if err := _LoginFailureCriteriaWhereClause(comp, node, id); err != nil {
+					return err
+				}
+				comp.WriteString(")")
+			}
+		}
+		if node.sibling != nil {
+			sibling = false
+			comp.WriteString(" ")
+			comp.WriteString(node.parent.operator)
+			comp.WriteString(" ")
+			node = node.sibling
+			continue
+		}
+		if node.parent != nil {
+			sibling = true
+			if node.parent.parent != nil {
+				comp.WriteString(")")
+			}
+			node = node.parent
+			continue
+		}
+
+		break
+	}
+	return nil
+}
+
+func _LoginFailureCriteriaWhereClause(comp *Composer, c *LoginFailureCriteria, id int) error {
+	QueryTimestampWhereClause(c.CreatedAt, id, TableLoginFailureColumnCreatedAt, comp, And)
+
+	QueryInt64WhereClause(c.Failures, id, TableLoginFailureColumnFailures, comp, And)
+
+	QueryInt64WhereClause(c.ID, id, TableLoginFailureColumnID, comp, And)
+
+	QueryStringWhereClause(c.Kind, id, TableLoginFailureColumnKind, comp, And)
+
+	QueryTimestampWhereClause(c.LastFailureAt, id, TableLoginFailureColumnLastFailureAt, comp, And)
+
+	QueryStringWhereClause(c.Subject, id, TableLoginFailureColumnSubject, comp, And)
+
+	QueryTimestampWhereClause(c.UpdatedAt, id, TableLoginFailureColumnUpdatedAt, comp, And)
+
+	return nil
+}
+
+func (r *LoginFailureRepositoryBase) FindQuery(fe *LoginFailureFindExpr) (string, []interface{}, error) {
+	comp := NewComposer(7)
+	buf := bytes.NewBufferString("SELECT ")
+	if len(fe.Columns) == 0 {
+		buf.WriteString("t0.created_at, t0.failures, t0.id, t0.kind, t0.last_failure_at, t0.subject, t0.updated_at")
+	} else {
+		buf.WriteString(strings.Join(fe.Columns, ", "))
+	}
+	buf.WriteString(" FROM ")
+	buf.WriteString(r.Table)
+	buf.WriteString(" AS t0")
+	if comp.Dirty {
+		buf.ReadFrom(comp)
+		comp.Dirty = false
+	}
+	if fe.Where != nil {
+		if err := LoginFailureCriteriaWhereClause(comp, fe.Where, 0); err != nil {
+			return "", nil, err
+		}
+	}
+	if comp.Dirty {
+		if _, err := buf.WriteString(" WHERE "); err != nil {
+			return "", nil, err
+		}
+		buf.ReadFrom(comp)
+	}
+
+	if len(fe.OrderBy) > 0 {
+		i := 0
+		for _, order := range fe.OrderBy {
+			for _, columnName := range TableLoginFailureColumns {
+				if order.Name == columnName {
+					if i == 0 {
+						comp.WriteString(" ORDER BY ")
+					}
+					if i > 0 {
+						if _, err := comp.WriteString(", "); err != nil {
+							return "", nil, err
+						}
+					}
+					if _, err := comp.WriteString(order.Name); err != nil {
+						return "", nil, err
+					}
+					if order.Descending {
+						if _, err := comp.WriteString(" DESC"); err != nil {
+							return "", nil, err
+						}
+					}
+					i++
+					break
+				}
+			}
+		}
+	}
+	if fe.Offset > 0 {
+		if _, err := comp.WriteString(" OFFSET "); err != nil {
+			return "", nil, err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		if _, err := comp.WriteString(" "); err != nil {
+			return "", nil, err
+		}
+		comp.Add(fe.Offset)
+	}
+	if fe.Limit > 0 {
+		if _, err := comp.WriteString(" LIMIT "); err != nil {
+			return "", nil, err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		if _, err := comp.WriteString(" "); err != nil {
+			return "", nil, err
+		}
+		comp.Add(fe.Limit)
+	}
+
+	buf.ReadFrom(comp)
+
+	return buf.String(), comp.Args(), nil
+}
+
+func (r *LoginFailureRepositoryBase) find(ctx context.Context, tx *sql.Tx, fe *LoginFailureFindExpr) ([]*LoginFailureEntity, error) {
+	query, args, err := r.FindQuery(fe)
+	if err != nil {
+		return nil, err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableLoginFailure, "find", query, args...)
+		} else {
+			r.Log(err, TableLoginFailure, "find tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		entities []*LoginFailureEntity
+		props    []interface{}
+	)
+	for rows.Next() {
+		var ent LoginFailureEntity
+		if props, err = ent.Props(); err != nil {
+			return nil, err
+		}
+		err = rows.Scan(props...)
+		if err != nil {
+			return nil, err
+		}
+
+		entities = append(entities, &ent)
+	}
+	err = rows.Err()
+	if r.Log != nil {
+		r.Log(err, TableLoginFailure, "find", query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return entities, nil
+}
+
+func (r *LoginFailureRepositoryBase) Find(ctx context.Context, fe *LoginFailureFindExpr) ([]*LoginFailureEntity, error) {
+	return r.find(ctx, nil, fe)
+}
+
+func (r *LoginFailureRepositoryBase) findIter(ctx context.Context, tx *sql.Tx, fe *LoginFailureFindExpr) (*LoginFailureIterator, error) {
+	query, args, err := r.FindQuery(fe)
+	if err != nil {
+		return nil, err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableLoginFailure, "find iter", query, args...)
+		} else {
+			r.Log(err, TableLoginFailure, "find iter tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &LoginFailureIterator{
+		rows: rows,
+		expr: fe,
+		cols: fe.Columns,
+	}, nil
+}
+
+func (r *LoginFailureRepositoryBase) FindIter(ctx context.Context, fe *LoginFailureFindExpr) (*LoginFailureIterator, error) {
+	return r.findIter(ctx, nil, fe)
+}
+
+func (r *LoginFailureRepositoryBase) findOneByID(ctx context.Context, tx *sql.Tx, pk int64) (*LoginFailureEntity, error) {
+	find := NewComposer(7)
+	find.WriteString("SELECT ")
+	if len(r.Columns) == 0 {
+		find.WriteString("created_at, failures, id, kind, last_failure_at, subject, updated_at")
+	} else {
+		find.WriteString(strings.Join(r.Columns, ", "))
+	}
+	find.WriteString(" FROM ")
+	find.WriteString(TableLoginFailure)
+	find.WriteString(" WHERE ")
+	find.WriteString(TableLoginFailureColumnID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(pk)
+	var (
+		ent LoginFailureEntity
+	)
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
+	} else {
+		err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableLoginFailure, "find by primary key", find.String(), find.Args()...)
+		} else {
+			r.Log(err, TableLoginFailure, "find by primary key tx", find.String(), find.Args()...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ent, nil
+}
+
+func (r *LoginFailureRepositoryBase) FindOneByID(ctx context.Context, pk int64) (*LoginFailureEntity, error) {
+	return r.findOneByID(ctx, nil, pk)
+}
+
+func (r *LoginFailureRepositoryBase) findOneByKindAndSubject(ctx context.Context, tx *sql.Tx, loginFailureKind string, loginFailureSubject string) (*LoginFailureEntity, error) {
+	find := NewComposer(7)
+	find.WriteString("SELECT ")
+	if len(r.Columns) == 0 {
+		find.WriteString("created_at, failures, id, kind, last_failure_at, subject, updated_at")
+	} else {
+		find.WriteString(strings.Join(r.Columns, ", "))
+	}
+	find.WriteString(" FROM ")
+	find.WriteString(TableLoginFailure)
+	find.WriteString(" WHERE ")
+	find.WriteString(TableLoginFailureColumnKind)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(loginFailureKind)
+	find.WriteString(" AND ")
+	find.WriteString(TableLoginFailureColumnSubject)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(loginFailureSubject)
+
+	var (
+		ent LoginFailureEntity
+	)
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
+	} else {
+		err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &ent, nil
+}
+
+func (r *LoginFailureRepositoryBase) FindOneByKindAndSubject(ctx context.Context, loginFailureKind string, loginFailureSubject string) (*LoginFailureEntity, error) {
+	return r.findOneByKindAndSubject(ctx, nil, loginFailureKind, loginFailureSubject)
+}
+
+func (r *LoginFailureRepositoryBase) UpdateOneByIDQuery(pk int64, p *LoginFailurePatch) (string, []interface{}, error) {
+	buf := bytes.NewBufferString("UPDATE ")
+	buf.WriteString(r.Table)
+	update := NewComposer(7)
+	if p.CreatedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableLoginFailureColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.CreatedAt)
+		update.Dirty = true
+
+	}
+	if p.Failures.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableLoginFailureColumnFailures); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Failures)
+		update.Dirty = true
+	}
+
+	if p.Kind.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableLoginFailureColumnKind); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Kind)
+		update.Dirty = true
+	}
+
+	if p.LastFailureAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableLoginFailureColumnLastFailureAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.LastFailureAt)
+		update.Dirty = true
+
+	}
+	if p.Subject.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableLoginFailureColumnSubject); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Subject)
+		update.Dirty = true
+	}
+
+	if p.UpdatedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableLoginFailureColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.UpdatedAt)
+		update.Dirty = true
+
+	} else {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableLoginFailureColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("=NOW()"); err != nil {
+			return "", nil, err
+		}
+		update.Dirty = true
+	}
+	if !update.Dirty {
+		return "", nil, errors.New("LoginFailure update failure, nothing to update")
+	}
+	buf.WriteString(" SET ")
+	buf.ReadFrom(update)
+	buf.WriteString(" WHERE ")
+
+	update.WriteString(TableLoginFailureColumnID)
+	update.WriteString("=")
+	update.WritePlaceholder()
+	update.Add(pk)
+
+	buf.ReadFrom(update)
+	buf.WriteString(" RETURNING ")
+	if len(r.Columns) > 0 {
+		buf.WriteString(strings.Join(r.Columns, ", "))
+	} else {
+		buf.WriteString("created_at, failures, id, kind, last_failure_at, subject, updated_at")
+	}
+	return buf.String(), update.Args(), nil
+}
+
+func (r *LoginFailureRepositoryBase) updateOneByID(ctx context.Context, tx *sql.Tx, pk int64, p *LoginFailurePatch) (*LoginFailureEntity, error) {
+	query, args, err := r.UpdateOneByIDQuery(pk, p)
+	if err != nil {
+		return nil, err
+	}
+	var ent LoginFailureEntity
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, query, args...).Scan(props...)
+	} else {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(props...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableLoginFailure, "update by primary key", query, args...)
+		} else {
+			r.Log(err, TableLoginFailure, "update by primary key tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ent, nil
+}
+
+func (r *LoginFailureRepositoryBase) UpdateOneByID(ctx context.Context, pk int64, p *LoginFailurePatch) (*LoginFailureEntity, error) {
+	return r.updateOneByID(ctx, nil, pk, p)
+}
+
+func (r *LoginFailureRepositoryBase) FindOneByIDAndUpdate(ctx context.Context, pk int64, p *LoginFailurePatch) (before, after *LoginFailureEntity, err error) {
+	find := NewComposer(7)
+	find.WriteString("SELECT ")
+	if len(r.Columns) == 0 {
+		find.WriteString("created_at, failures, id, kind, last_failure_at, subject, updated_at")
+	} else {
+		find.WriteString(strings.Join(r.Columns, ", "))
+	}
+	find.WriteString(" FROM ")
+	find.WriteString(TableLoginFailure)
+	find.WriteString(" WHERE ")
+	find.WriteString(TableLoginFailureColumnID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(pk)
+	find.WriteString(" FOR UPDATE")
+	query, args, err := r.UpdateOneByIDQuery(pk, p)
+	if err != nil {
+		return
+	}
+	var (
+		oldEnt, newEnt LoginFailureEntity
+	)
+	oldProps, err := oldEnt.Props(r.Columns...)
+	if err != nil {
+		return
+	}
+	newProps, err := newEnt.Props(r.Columns...)
+	if err != nil {
+		return
+	}
+	tx, err := r.DB.Begin()
+	if err != nil {
+		return
+	}
+	err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(oldProps...)
+	if r.Log != nil {
+		r.Log(err, TableLoginFailure, "find by primary key", find.String(), find.Args()...)
+	}
+	if err != nil {
+		tx.Rollback()
+		return
+	}
+	err = tx.QueryRowContext(ctx, query, args...).Scan(newProps...)
+	if r.Log != nil {
+		r.Log(err, TableLoginFailure, "update by primary key", query, args...)
+	}
+	if err != nil {
+		tx.Rollback()
+		return
+	}
+	err = tx.Commit()
+	if err != nil {
+		return
+	}
+	return &oldEnt, &newEnt, nil
+}
+
+func (r *LoginFailureRepositoryBase) UpdateOneByKindAndSubjectQuery(loginFailureKind string, loginFailureSubject string, p *LoginFailurePatch) (string, []interface{}, error) {
+	buf := bytes.NewBufferString("UPDATE ")
+	buf.WriteString(r.Table)
+	update := NewComposer(2)
+	if p.CreatedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableLoginFailureColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.CreatedAt)
+		update.Dirty = true
+
+	}
+	if p.Failures.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableLoginFailureColumnFailures); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Failures)
+		update.Dirty = true
+	}
+
+	if p.Kind.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableLoginFailureColumnKind); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Kind)
+		update.Dirty = true
+	}
+
+	if p.LastFailureAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableLoginFailureColumnLastFailureAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.LastFailureAt)
+		update.Dirty = true
+
+	}
+	if p.Subject.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableLoginFailureColumnSubject); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Subject)
+		update.Dirty = true
+	}
+
+	if p.UpdatedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableLoginFailureColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.UpdatedAt)
+		update.Dirty = true
+
+	} else {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableLoginFailureColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("=NOW()"); err != nil {
+			return "", nil, err
+		}
+		update.Dirty = true
+	}
+	if !update.Dirty {
+		return "", nil, errors.New("login_failure update failure, nothing to update")
+	}
+	buf.WriteString(" SET ")
+	buf.ReadFrom(update)
+	buf.WriteString(" WHERE ")
+	update.WriteString(TableLoginFailureColumnKind)
+	update.WriteString("=")
+	update.WritePlaceholder()
+	update.Add(loginFailureKind)
+	update.WriteString(" AND ")
+	update.WriteString(TableLoginFailureColumnSubject)
+	update.WriteString("=")
+	update.WritePlaceholder()
+	update.Add(loginFailureSubject)
+	buf.ReadFrom(update)
+	buf.WriteString(" RETURNING ")
+	if len(r.Columns) > 0 {
+		buf.WriteString(strings.Join(r.Columns, ", "))
+	} else {
+		buf.WriteString("created_at, failures, id, kind, last_failure_at, subject, updated_at")
+	}
+	return buf.String(), update.Args(), nil
+}
+
+func (r *LoginFailureRepositoryBase) updateOneByKindAndSubject(ctx context.Context, tx *sql.Tx, loginFailureKind string, loginFailureSubject string, p *LoginFailurePatch) (*LoginFailureEntity, error) {
+	query, args, err := r.UpdateOneByKindAndSubjectQuery(loginFailureKind, loginFailureSubject, p)
+	if err != nil {
+		return nil, err
+	}
+	var ent LoginFailureEntity
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+
+	var row *sql.Row
+	if tx == nil {
+		row = r.DB.QueryRowContext(ctx, query, args...)
+	} else {
+		row = tx.QueryRowContext(ctx, query, args...)
+	}
+	err = row.Scan(props...)
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableLoginFailure, "update one by unique", query, args...)
+		} else {
+			r.Log(err, TableLoginFailure, "update one by unique tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ent, nil
+}
+
+func (r *LoginFailureRepositoryBase) UpdateOneByKindAndSubject(ctx context.Context, loginFailureKind string, loginFailureSubject string, p *LoginFailurePatch) (*LoginFailureEntity, error) {
+	return r.updateOneByKindAndSubject(ctx, nil, loginFailureKind, loginFailureSubject, p)
+}
+
+func (r *LoginFailureRepositoryBase) UpsertQuery(e *LoginFailureEntity, p *LoginFailurePatch, inf ...string) (string, []interface{}, error) {
+	upsert := NewComposer(14)
+	columns := bytes.NewBuffer(nil)
+	buf := bytes.NewBufferString("INSERT INTO ")
+	buf.WriteString(r.Table)
+
+	if !e.CreatedAt.IsZero() {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableLoginFailureColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.CreatedAt)
+		upsert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableLoginFailureColumnFailures); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.Failures)
+	upsert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableLoginFailureColumnKind); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.Kind)
+	upsert.Dirty = true
+
+	if !e.LastFailureAt.IsZero() {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableLoginFailureColumnLastFailureAt); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.LastFailureAt)
+		upsert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableLoginFailureColumnSubject); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.Subject)
+	upsert.Dirty = true
+
+	if e.UpdatedAt.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableLoginFailureColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.UpdatedAt)
+		upsert.Dirty = true
+	}
+
+	if upsert.Dirty {
+		buf.WriteString(" (")
+		buf.ReadFrom(columns)
+		buf.WriteString(") VALUES (")
+		buf.ReadFrom(upsert)
+		buf.WriteString(")")
+	}
+	buf.WriteString(" ON CONFLICT ")
+	if len(inf) > 0 {
+		upsert.Dirty = false
+		if p.CreatedAt.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableLoginFailureColumnCreatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.CreatedAt)
+			upsert.Dirty = true
+
+		}
+		if p.Failures.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableLoginFailureColumnFailures); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Failures)
+			upsert.Dirty = true
+		}
+
+		if p.Kind.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableLoginFailureColumnKind); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Kind)
+			upsert.Dirty = true
+		}
+
+		if p.LastFailureAt.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableLoginFailureColumnLastFailureAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.LastFailureAt)
+			upsert.Dirty = true
+
+		}
+		if p.Subject.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableLoginFailureColumnSubject); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Subject)
+			upsert.Dirty = true
+		}
+
+		if p.UpdatedAt.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableLoginFailureColumnUpdatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.UpdatedAt)
+			upsert.Dirty = true
+
+		} else {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableLoginFailureColumnUpdatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("=NOW()"); err != nil {
+				return "", nil, err
+			}
+			upsert.Dirty = true
+		}
+	}
+	if len(inf) > 0 && upsert.Dirty {
+		buf.WriteString("(")
+		for j, i := range inf {
+			if j != 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString(i)
+		}
+		buf.WriteString(")")
+		buf.WriteString(" DO UPDATE SET ")
+		buf.ReadFrom(upsert)
+	} else {
+		buf.WriteString(" DO NOTHING ")
+	}
+	if upsert.Dirty {
+		buf.WriteString(" RETURNING ")
+		if len(r.Columns) > 0 {
+			buf.WriteString(strings.Join(r.Columns, ", "))
+		} else {
+			buf.WriteString("created_at, failures, id, kind, last_failure_at, subject, updated_at")
+		}
+	}
+	return buf.String(), upsert.Args(), nil
+}
+
+func (r *LoginFailureRepositoryBase) upsert(ctx context.Context, tx *sql.Tx, e *LoginFailureEntity, p *LoginFailurePatch, inf ...string) (*LoginFailureEntity, error) {
+	query, args, err := r.UpsertQuery(e, p, inf...)
+	if err != nil {
+		return nil, err
+	}
+
+	var row *sql.Row
+	if tx == nil {
+		row = r.DB.QueryRowContext(ctx, query, args...)
+	} else {
+		row = tx.QueryRowContext(ctx, query, args...)
+	}
+	err = row.Scan(
+		&e.CreatedAt,
+		&e.Failures,
+		&e.ID,
+		&e.Kind,
+		&e.LastFailureAt,
+		&e.Subject,
+		&e.UpdatedAt,
+	)
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableLoginFailure, "upsert", query, args...)
+		} else {
+			r.Log(err, TableLoginFailure, "upsert tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (r *LoginFailureRepositoryBase) Upsert(ctx context.Context, e *LoginFailureEntity, p *LoginFailurePatch, inf ...string) (*LoginFailureEntity, error) {
+	return r.upsert(ctx, nil, e, p, inf...)
+}
+
+func (r *LoginFailureRepositoryBase) count(ctx context.Context, tx *sql.Tx, exp *LoginFailureCountExpr) (int64, error) {
+	query, args, err := r.FindQuery(&LoginFailureFindExpr{
+		Where:   exp.Where,
+		Columns: []string{"COUNT(*)"},
+	})
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, query, args...).Scan(&count)
+	} else {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(&count)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableLoginFailure, "count", query, args...)
+		} else {
+			r.Log(err, TableLoginFailure, "count tx", query, args...)
+		}
+	}
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *LoginFailureRepositoryBase) Count(ctx context.Context, exp *LoginFailureCountExpr) (int64, error) {
+	return r.count(ctx, nil, exp)
+}
+
+func (r *LoginFailureRepositoryBase) deleteOneByID(ctx context.Context, tx *sql.Tx, pk int64) (int64, error) {
+	find := NewComposer(7)
+	find.WriteString("DELETE FROM ")
+	find.WriteString(TableLoginFailure)
+	find.WriteString(" WHERE ")
+	find.WriteString(TableLoginFailureColumnID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(pk)
+	var (
+		err error
+		res sql.Result
+	)
+	if tx == nil {
+		res, err = r.DB.ExecContext(ctx, find.String(), find.Args()...)
+	} else {
+		res, err = tx.ExecContext(ctx, find.String(), find.Args()...)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+func (r *LoginFailureRepositoryBase) DeleteOneByID(ctx context.Context, pk int64) (int64, error) {
+	return r.deleteOneByID(ctx, nil, pk)
+}
+
+type LoginFailureRepositoryBaseTx struct {
+	base *LoginFailureRepositoryBase
+	tx   *sql.Tx
+}
+
+func (r LoginFailureRepositoryBaseTx) Commit() error {
+	return r.tx.Commit()
+}
+
+func (r LoginFailureRepositoryBaseTx) Rollback() error {
+	return r.tx.Rollback()
+}
+
+func (r *LoginFailureRepositoryBaseTx) Insert(ctx context.Context, e *LoginFailureEntity) (*LoginFailureEntity, error) {
+	return r.base.insert(ctx, r.tx, e)
+}
+
+func (r *LoginFailureRepositoryBaseTx) Find(ctx context.Context, fe *LoginFailureFindExpr) ([]*LoginFailureEntity, error) {
+	return r.base.find(ctx, r.tx, fe)
+}
+
+func (r *LoginFailureRepositoryBaseTx) FindIter(ctx context.Context, fe *LoginFailureFindExpr) (*LoginFailureIterator, error) {
+	return r.base.findIter(ctx, r.tx, fe)
+}
+
+func (r *LoginFailureRepositoryBaseTx) FindOneByID(ctx context.Context, pk int64) (*LoginFailureEntity, error) {
+	return r.base.findOneByID(ctx, r.tx, pk)
+}
+
+func (r *LoginFailureRepositoryBaseTx) UpdateOneByID(ctx context.Context, pk int64, p *LoginFailurePatch) (*LoginFailureEntity, error) {
+	return r.base.updateOneByID(ctx, r.tx, pk, p)
+}
+
+func (r *LoginFailureRepositoryBaseTx) UpdateOneByKindAndSubject(ctx context.Context, loginFailureKind string, loginFailureSubject string, p *LoginFailurePatch) (*LoginFailureEntity, error) {
+	return r.base.updateOneByKindAndSubject(ctx, r.tx, loginFailureKind, loginFailureSubject, p)
+}
+
+func (r *LoginFailureRepositoryBaseTx) Upsert(ctx context.Context, e *LoginFailureEntity, p *LoginFailurePatch, inf ...string) (*LoginFailureEntity, error) {
+	return r.base.upsert(ctx, r.tx, e, p, inf...)
+}
+
+func (r *LoginFailureRepositoryBaseTx) Count(ctx context.Context, exp *LoginFailureCountExpr) (int64, error) {
+	return r.base.count(ctx, r.tx, exp)
+}
+
+func (r *LoginFailureRepositoryBaseTx) DeleteOneByID(ctx context.Context, pk int64) (int64, error) {
+	return r.base.deleteOneByID(ctx, r.tx, pk)
+}
+
+const (
+	TableAuditEventConstraintPrimaryKey = "charon.audit_event_id_pkey"
+)
+
+const (
+	TableAuditEvent                 = "charon.audit_event"
+	TableAuditEventColumnActorID    = "actor_id"
+	TableAuditEventColumnAfter      = "after"
+	TableAuditEventColumnBefore     = "before"
+	TableAuditEventColumnCreatedAt  = "created_at"
+	TableAuditEventColumnID         = "id"
+	TableAuditEventColumnPeer       = "peer"
+	TableAuditEventColumnRPC        = "rpc"
+	TableAuditEventColumnTargetID   = "target_id"
+	TableAuditEventColumnTargetKind = "target_kind"
+)
+
+var TableAuditEventColumns = []string{
+	TableAuditEventColumnActorID,
+	TableAuditEventColumnAfter,
+	TableAuditEventColumnBefore,
+	TableAuditEventColumnCreatedAt,
+	TableAuditEventColumnID,
+	TableAuditEventColumnPeer,
+	TableAuditEventColumnRPC,
+	TableAuditEventColumnTargetID,
+	TableAuditEventColumnTargetKind,
+}
+
+// AuditEventEntity ...
+type AuditEventEntity struct {
+	// ActorID ...
+	ActorID ntypes.Int64
+	// After ...
+	After []byte
+	// Before ...
+	Before []byte
+	// CreatedAt ...
+	CreatedAt time.Time
+	// ID ...
+	ID int64
+	// Peer ...
+	Peer string
+	// RPC ...
+	RPC string
+	// TargetID ...
+	TargetID ntypes.Int64
+	// TargetKind ...
+	TargetKind string
+}
+
+func (e *AuditEventEntity) Prop(cn string) (interface{}, bool) {
+	switch cn {
+
+	case TableAuditEventColumnActorID:
+		return &e.ActorID, true
+	case TableAuditEventColumnAfter:
+		return &e.After, true
+	case TableAuditEventColumnBefore:
+		return &e.Before, true
+	case TableAuditEventColumnCreatedAt:
+		return &e.CreatedAt, true
+	case TableAuditEventColumnID:
+		return &e.ID, true
+	case TableAuditEventColumnPeer:
+		return &e.Peer, true
+	case TableAuditEventColumnRPC:
+		return &e.RPC, true
+	case TableAuditEventColumnTargetID:
+		return &e.TargetID, true
+	case TableAuditEventColumnTargetKind:
+		return &e.TargetKind, true
+	default:
+		return nil, false
+	}
+}
+
+func (e *AuditEventEntity) Props(cns ...string) ([]interface{}, error) {
+	if len(cns) == 0 {
+		cns = TableAuditEventColumns
+	}
+	res := make([]interface{}, 0, len(cns))
+	for _, cn := range cns {
+		if prop, ok := e.Prop(cn); ok {
+			res = append(res, prop)
+		} else {
+			return nil, fmt.Errorf("unexpected column provided: %s", cn)
+		}
+	}
+	return res, nil
+}
+
+// ScanAuditEventRows helps to scan rows straight to the slice of entities.
+func ScanAuditEventRows(rows Rows) (entities []*AuditEventEntity, err error) {
+	for rows.Next() {
+		var ent AuditEventEntity
+		err = rows.Scan(
+			&ent.ActorID,
+			&ent.After,
+			&ent.Before,
+			&ent.CreatedAt,
+			&ent.ID,
+			&ent.Peer,
+			&ent.RPC,
+			&ent.TargetID,
+			&ent.TargetKind,
+		)
+		if err != nil {
+			return
+		}
+
+		entities = append(entities, &ent)
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	return
+}
+
+// AuditEventIterator is not thread safe.
+type AuditEventIterator struct {
+	rows Rows
+	cols []string
+	expr *AuditEventFindExpr
+}
+
+func (i *AuditEventIterator) Next() bool {
+	return i.rows.Next()
+}
+
+func (i *AuditEventIterator) Close() error {
+	return i.rows.Close()
+}
+
+func (i *AuditEventIterator) Err() error {
+	return i.rows.Err()
+}
+
+// Columns is wrapper around sql.Rows.Columns method, that also cache output inside iterator.
+func (i *AuditEventIterator) Columns() ([]string, error) {
+	if i.cols == nil {
+		cols, err := i.rows.Columns()
+		if err != nil {
+			return nil, err
+		}
+		i.cols = cols
+	}
+	return i.cols, nil
+}
+
+// Ent is wrapper around AuditEvent method that makes iterator more generic.
+func (i *AuditEventIterator) Ent() (interface{}, error) {
+	return i.AuditEvent()
+}
+
+func (i *AuditEventIterator) AuditEvent() (*AuditEventEntity, error) {
+	var ent AuditEventEntity
+	cols, err := i.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	props, err := ent.Props(cols...)
+	if err != nil {
+		return nil, err
+	}
+	if err := i.rows.Scan(props...); err != nil {
+		return nil, err
+	}
+	return &ent, nil
+}
+
+type AuditEventCriteria struct {
+	ActorID                *qtypes.Int64
+	After                  []byte
+	Before                 []byte
+	CreatedAt              *qtypes.Timestamp
+	ID                     *qtypes.Int64
+	Peer                   *qtypes.String
+	RPC                    *qtypes.String
+	TargetID               *qtypes.Int64
+	TargetKind             *qtypes.String
+	operator               string
+	child, sibling, parent *AuditEventCriteria
+}
+
+func AuditEventOperand(operator string, operands ...*AuditEventCriteria) *AuditEventCriteria {
+	if len(operands) == 0 {
+		return &AuditEventCriteria{operator: operator}
+	}
+
+	parent := &AuditEventCriteria{
+		operator: operator,
+		child:    operands[0],
+	}
+
+	for i := 0; i < len(operands); i++ {
+		if i < len(operands)-1 {
+			operands[i].sibling = operands[i+1]
+		}
+		operands[i].parent = parent
+	}
+
+	return parent
+}
+
+func AuditEventOr(operands ...*AuditEventCriteria) *AuditEventCriteria {
+	return AuditEventOperand("OR", operands...)
+}
+
+func AuditEventAnd(operands ...*AuditEventCriteria) *AuditEventCriteria {
+	return AuditEventOperand("AND", operands...)
+}
+
+type AuditEventFindExpr struct {
+	Where         *AuditEventCriteria
+	Offset, Limit int64
+	Columns       []string
+	OrderBy       []RowOrder
+}
+
+type AuditEventJoin struct {
+	On, Where *AuditEventCriteria
+	Fetch     bool
+	Kind      JoinType
+}
+
+type AuditEventCountExpr struct {
+	Where *AuditEventCriteria
+}
+
+type AuditEventPatch struct {
+	ActorID    ntypes.Int64
+	After      []byte
+	Before     []byte
+	CreatedAt  pq.NullTime
+	Peer       ntypes.String
+	RPC        ntypes.String
+	TargetID   ntypes.Int64
+	TargetKind ntypes.String
+}
+
+type AuditEventRepositoryBase struct {
+	Table   string
+	Columns []string
+	DB      *sql.DB
+	Log     LogFunc
+}
+
+func (r *AuditEventRepositoryBase) Tx(tx *sql.Tx) (*AuditEventRepositoryBaseTx, error) {
+	return &AuditEventRepositoryBaseTx{
+		base: r,
+		tx:   tx,
+	}, nil
+}
+
+func (r *AuditEventRepositoryBase) BeginTx(ctx context.Context) (*AuditEventRepositoryBaseTx, error) {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return r.Tx(tx)
+}
+
+func (r AuditEventRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *AuditEventRepositoryBaseTx) error, attempts int) (err error) {
+	return RunInTransaction(ctx, r.DB, func(tx *sql.Tx) error {
+		rtx, err := r.Tx(tx)
+		if err != nil {
+			return err
+		}
+		return fn(rtx)
+	}, attempts)
+}
+
+func (r *AuditEventRepositoryBase) InsertQuery(e *AuditEventEntity, read bool) (string, []interface{}, error) {
+	insert := NewComposer(9)
+	columns := bytes.NewBuffer(nil)
+	buf := bytes.NewBufferString("INSERT INTO ")
+	buf.WriteString(r.Table)
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableAuditEventColumnActorID); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.ActorID)
+	insert.Dirty = true
+
+	if e.After != nil {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableAuditEventColumnAfter); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.After)
+		insert.Dirty = true
+	}
+
+	if e.Before != nil {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableAuditEventColumnBefore); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.Before)
+		insert.Dirty = true
+	}
+
+	if !e.CreatedAt.IsZero() {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableAuditEventColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.CreatedAt)
+		insert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableAuditEventColumnPeer); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.Peer)
+	insert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableAuditEventColumnRPC); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.RPC)
+	insert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableAuditEventColumnTargetID); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.TargetID)
+	insert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableAuditEventColumnTargetKind); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.TargetKind)
+	insert.Dirty = true
+
+	if columns.Len() > 0 {
+		buf.WriteString(" (")
+		buf.ReadFrom(columns)
+		buf.WriteString(") VALUES (")
+		buf.ReadFrom(insert)
+		buf.WriteString(") ")
+		if read {
+			buf.WriteString("RETURNING ")
+			if len(r.Columns) > 0 {
+				buf.WriteString(strings.Join(r.Columns, ", "))
+			} else {
+				buf.WriteString("actor_id, after, before, created_at, id, peer, rpc, target_id, target_kind")
+			}
+		}
+	}
+	return buf.String(), insert.Args(), nil
+}
+
+func (r *AuditEventRepositoryBase) insert(ctx context.Context, tx *sql.Tx, e *AuditEventEntity) (*AuditEventEntity, error) {
+	query, args, err := r.InsertQuery(e, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var row *sql.Row
+	if tx == nil {
+		row = r.DB.QueryRowContext(ctx, query, args...)
+	} else {
+		row = tx.QueryRowContext(ctx, query, args...)
+	}
+	err = row.Scan(
+		&e.ActorID,
+		&e.After,
+		&e.Before,
+		&e.CreatedAt,
+		&e.ID,
+		&e.Peer,
+		&e.RPC,
+		&e.TargetID,
+		&e.TargetKind,
+	)
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableAuditEvent, "insert", query, args...)
+		} else {
+			r.Log(err, TableAuditEvent, "insert tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (r *AuditEventRepositoryBase) Insert(ctx context.Context, e *AuditEventEntity) (*AuditEventEntity, error) {
+	return r.insert(ctx, nil, e)
+}
+
+func AuditEventCriteriaWhereClause(comp *Composer, c *AuditEventCriteria, id int) error {
+	if c.child == nil {
+		return _AuditEventCriteriaWhereClause(comp, c, id)
+	}
+	node := c
+	sibling := false
+	for {
+		if !sibling {
+			if node.child != nil {
+				if node.parent != nil {
+					comp.WriteString("(")
+				}
+				node = node.child
+				continue
+			} else {
+				comp.Dirty = false
+				comp.WriteString("(")
+				if err := _AuditEventCriteriaWhereClause(comp, node, id); err != nil {
+					return err
+				}
+				comp.WriteString(")")
+			}
+		}
+		if node.sibling != nil {
+			sibling = false
+			comp.WriteString(" ")
+			comp.WriteString(node.parent.operator)
+			comp.WriteString(" ")
+			node = node.sibling
+			continue
+		}
+		if node.parent != nil {
+			sibling = true
+			if node.parent.parent != nil {
+				comp.WriteString(")")
+			}
+			node = node.parent
+			continue
+		}
+
+		break
+	}
+	return nil
+}
+
+func _AuditEventCriteriaWhereClause(comp *Composer, c *AuditEventCriteria, id int) error {
+	QueryInt64WhereClause(c.ActorID, id, TableAuditEventColumnActorID, comp, And)
+
+	if c.After != nil {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableAuditEventColumnAfter); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.After)
+		comp.Dirty = true
+	}
+	if c.Before != nil {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableAuditEventColumnBefore); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.Before)
+		comp.Dirty = true
+	}
+	QueryTimestampWhereClause(c.CreatedAt, id, TableAuditEventColumnCreatedAt, comp, And)
+
+	QueryInt64WhereClause(c.ID, id, TableAuditEventColumnID, comp, And)
+
+	QueryStringWhereClause(c.Peer, id, TableAuditEventColumnPeer, comp, And)
+
+	QueryStringWhereClause(c.RPC, id, TableAuditEventColumnRPC, comp, And)
+
+	QueryInt64WhereClause(c.TargetID, id, TableAuditEventColumnTargetID, comp, And)
+
+	QueryStringWhereClause(c.TargetKind, id, TableAuditEventColumnTargetKind, comp, And)
+
+	return nil
+}
+
+func (r *AuditEventRepositoryBase) FindQuery(fe *AuditEventFindExpr) (string, []interface{}, error) {
+	comp := NewComposer(9)
+	buf := bytes.NewBufferString("SELECT ")
+	if len(fe.Columns) == 0 {
+		buf.WriteString("t0.actor_id, t0.after, t0.before, t0.created_at, t0.id, t0.peer, t0.rpc, t0.target_id, t0.target_kind")
+	} else {
+		buf.WriteString(strings.Join(fe.Columns, ", "))
+	}
+	buf.WriteString(" FROM ")
+	buf.WriteString(r.Table)
+	buf.WriteString(" AS t0")
+	if comp.Dirty {
+		buf.ReadFrom(comp)
+		comp.Dirty = false
+	}
+	if fe.Where != nil {
+		if err := AuditEventCriteriaWhereClause(comp, fe.Where, 0); err != nil {
+			return "", nil, err
+		}
+	}
+	if comp.Dirty {
+		if _, err := buf.WriteString(" WHERE "); err != nil {
+			return "", nil, err
+		}
+		buf.ReadFrom(comp)
+	}
+
+	if len(fe.OrderBy) > 0 {
+		i := 0
+		for _, order := range fe.OrderBy {
+			for _, columnName := range TableAuditEventColumns {
+				if order.Name == columnName {
+					if i == 0 {
+						comp.WriteString(" ORDER BY ")
+					}
+					if i > 0 {
+						if _, err := comp.WriteString(", "); err != nil {
+							return "", nil, err
+						}
+					}
+					if _, err := comp.WriteString(order.Name); err != nil {
+						return "", nil, err
+					}
+					if order.Descending {
+						if _, err := comp.WriteString(" DESC"); err != nil {
+							return "", nil, err
+						}
+					}
+					i++
+					break
+				}
+			}
+		}
+	}
+	if fe.Offset > 0 {
+		if _, err := comp.WriteString(" OFFSET "); err != nil {
+			return "", nil, err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		if _, err := comp.WriteString(" "); err != nil {
+			return "", nil, err
+		}
+		comp.Add(fe.Offset)
+	}
+	if fe.Limit > 0 {
+		if _, err := comp.WriteString(" LIMIT "); err != nil {
+			return "", nil, err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		if _, err := comp.WriteString(" "); err != nil {
+			return "", nil, err
+		}
+		comp.Add(fe.Limit)
+	}
+
+	buf.ReadFrom(comp)
+
+	return buf.String(), comp.Args(), nil
+}
+
+func (r *AuditEventRepositoryBase) find(ctx context.Context, tx *sql.Tx, fe *AuditEventFindExpr) ([]*AuditEventEntity, error) {
+	query, args, err := r.FindQuery(fe)
+	if err != nil {
+		return nil, err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableAuditEvent, "find", query, args...)
+		} else {
+			r.Log(err, TableAuditEvent, "find tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		entities []*AuditEventEntity
+		props    []interface{}
+	)
+	for rows.Next() {
+		var ent AuditEventEntity
+		if props, err = ent.Props(); err != nil {
+			return nil, err
+		}
+		err = rows.Scan(props...)
+		if err != nil {
+			return nil, err
+		}
+
+		entities = append(entities, &ent)
+	}
+	err = rows.Err()
+	if r.Log != nil {
+		r.Log(err, TableAuditEvent, "find", query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return entities, nil
+}
+
+func (r *AuditEventRepositoryBase) Find(ctx context.Context, fe *AuditEventFindExpr) ([]*AuditEventEntity, error) {
+	return r.find(ctx, nil, fe)
+}
+
+func (r *AuditEventRepositoryBase) findIter(ctx context.Context, tx *sql.Tx, fe *AuditEventFindExpr) (*AuditEventIterator, error) {
+	query, args, err := r.FindQuery(fe)
+	if err != nil {
+		return nil, err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableAuditEvent, "find iter", query, args...)
+		} else {
+			r.Log(err, TableAuditEvent, "find iter tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &AuditEventIterator{
+		rows: rows,
+		expr: fe,
+		cols: fe.Columns,
+	}, nil
+}
+
+func (r *AuditEventRepositoryBase) FindIter(ctx context.Context, fe *AuditEventFindExpr) (*AuditEventIterator, error) {
+	return r.findIter(ctx, nil, fe)
+}
+
+func (r *AuditEventRepositoryBase) findOneByID(ctx context.Context, tx *sql.Tx, pk int64) (*AuditEventEntity, error) {
+	find := NewComposer(9)
+	find.WriteString("SELECT ")
+	if len(r.Columns) == 0 {
+		find.WriteString("actor_id, after, before, created_at, id, peer, rpc, target_id, target_kind")
+	} else {
+		find.WriteString(strings.Join(r.Columns, ", "))
+	}
+	find.WriteString(" FROM ")
+	find.WriteString(TableAuditEvent)
+	find.WriteString(" WHERE ")
+	find.WriteString(TableAuditEventColumnID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(pk)
+	var (
+		ent AuditEventEntity
+	)
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
+	} else {
+		err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableAuditEvent, "find by primary key", find.String(), find.Args()...)
+		} else {
+			r.Log(err, TableAuditEvent, "find by primary key tx", find.String(), find.Args()...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ent, nil
+}
+
+func (r *AuditEventRepositoryBase) FindOneByID(ctx context.Context, pk int64) (*AuditEventEntity, error) {
+	return r.findOneByID(ctx, nil, pk)
+}
+
+func (r *AuditEventRepositoryBase) UpdateOneByIDQuery(pk int64, p *AuditEventPatch) (string, []interface{}, error) {
+	buf := bytes.NewBufferString("UPDATE ")
+	buf.WriteString(r.Table)
+	update := NewComposer(9)
+	if p.ActorID.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableAuditEventColumnActorID); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.ActorID)
+		update.Dirty = true
+	}
+
+	if p.After != nil {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableAuditEventColumnAfter); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.After)
+		update.Dirty = true
+
+	}
+	if p.Before != nil {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableAuditEventColumnBefore); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Before)
+		update.Dirty = true
+
+	}
+	if p.CreatedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableAuditEventColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.CreatedAt)
+		update.Dirty = true
+
+	}
+	if p.Peer.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableAuditEventColumnPeer); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Peer)
+		update.Dirty = true
+	}
+
+	if p.RPC.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableAuditEventColumnRPC); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.RPC)
+		update.Dirty = true
+	}
+
+	if p.TargetID.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableAuditEventColumnTargetID); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.TargetID)
+		update.Dirty = true
+	}
+
+	if p.TargetKind.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableAuditEventColumnTargetKind); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.TargetKind)
+		update.Dirty = true
+	}
+
+	if !update.Dirty {
+		return "", nil, errors.New("AuditEvent update failure, nothing to update")
+	}
+	buf.WriteString(" SET ")
+	buf.ReadFrom(update)
+	buf.WriteString(" WHERE ")
+
+	update.WriteString(TableAuditEventColumnID)
+	update.WriteString("=")
+	update.WritePlaceholder()
+	update.Add(pk)
+
+	buf.ReadFrom(update)
+	buf.WriteString(" RETURNING ")
+	if len(r.Columns) > 0 {
+		buf.WriteString(strings.Join(r.Columns, ", "))
+	} else {
+		buf.WriteString("actor_id, after, before, created_at, id, peer, rpc, target_id, target_kind")
+	}
+	return buf.String(), update.Args(), nil
+}
+
+func (r *AuditEventRepositoryBase) updateOneByID(ctx context.Context, tx *sql.Tx, pk int64, p *AuditEventPatch) (*AuditEventEntity, error) {
+	query, args, err := r.UpdateOneByIDQuery(pk, p)
+	if err != nil {
+		return nil, err
+	}
+	var ent AuditEventEntity
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, query, args...).Scan(props...)
+	} else {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(props...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableAuditEvent, "update by primary key", query, args...)
+		} else {
+			r.Log(err, TableAuditEvent, "update by primary key tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ent, nil
+}
+
+func (r *AuditEventRepositoryBase) UpdateOneByID(ctx context.Context, pk int64, p *AuditEventPatch) (*AuditEventEntity, error) {
+	return r.updateOneByID(ctx, nil, pk, p)
+}
+
+func (r *AuditEventRepositoryBase) FindOneByIDAndUpdate(ctx context.Context, pk int64, p *AuditEventPatch) (before, after *AuditEventEntity, err error) {
+	find := NewComposer(9)
+	find.WriteString("SELECT ")
+	if len(r.Columns) == 0 {
+		find.WriteString("actor_id, after, before, created_at, id, peer, rpc, target_id, target_kind")
+	} else {
+		find.WriteString(strings.Join(r.Columns, ", "))
+	}
+	find.WriteString(" FROM ")
+	find.WriteString(TableAuditEvent)
+	find.WriteString(" WHERE ")
+	find.WriteString(TableAuditEventColumnID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(pk)
+	find.WriteString(" FOR UPDATE")
+	query, args, err := r.UpdateOneByIDQuery(pk, p)
+	if err != nil {
+		return
+	}
+	var (
+		oldEnt, newEnt AuditEventEntity
+	)
+	oldProps, err := oldEnt.Props(r.Columns...)
+	if err != nil {
+		return
+	}
+	newProps, err := newEnt.Props(r.Columns...)
+	if err != nil {
+		return
+	}
+	tx, err := r.DB.Begin()
+	if err != nil {
+		return
+	}
+	err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(oldProps...)
+	if r.Log != nil {
+		r.Log(err, TableAuditEvent, "find by primary key", find.String(), find.Args()...)
+	}
+	if err != nil {
+		tx.Rollback()
+		return
+	}
+	err = tx.QueryRowContext(ctx, query, args...).Scan(newProps...)
+	if r.Log != nil {
+		r.Log(err, TableAuditEvent, "update by primary key", query, args...)
+	}
+	if err != nil {
+		tx.Rollback()
+		return
+	}
+	err = tx.Commit()
+	if err != nil {
+		return
+	}
+	return &oldEnt, &newEnt, nil
+}
+
+func (r *AuditEventRepositoryBase) UpsertQuery(e *AuditEventEntity, p *AuditEventPatch, inf ...string) (string, []interface{}, error) {
+	upsert := NewComposer(18)
+	columns := bytes.NewBuffer(nil)
+	buf := bytes.NewBufferString("INSERT INTO ")
+	buf.WriteString(r.Table)
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableAuditEventColumnActorID); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.ActorID)
+	upsert.Dirty = true
+
+	if e.After != nil {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableAuditEventColumnAfter); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.After)
+		upsert.Dirty = true
+	}
+
+	if e.Before != nil {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableAuditEventColumnBefore); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.Before)
+		upsert.Dirty = true
+	}
+
+	if !e.CreatedAt.IsZero() {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableAuditEventColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.CreatedAt)
+		upsert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableAuditEventColumnPeer); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.Peer)
+	upsert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableAuditEventColumnRPC); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.RPC)
+	upsert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableAuditEventColumnTargetID); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.TargetID)
+	upsert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableAuditEventColumnTargetKind); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.TargetKind)
+	upsert.Dirty = true
+
+	if upsert.Dirty {
+		buf.WriteString(" (")
+		buf.ReadFrom(columns)
+		buf.WriteString(") VALUES (")
+		buf.ReadFrom(upsert)
+		buf.WriteString(")")
+	}
+	buf.WriteString(" ON CONFLICT ")
+	if len(inf) > 0 {
+		upsert.Dirty = false
+		if p.ActorID.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableAuditEventColumnActorID); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ActorID)
+			upsert.Dirty = true
+		}
+
+		if p.After != nil {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableAuditEventColumnAfter); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.After)
+			upsert.Dirty = true
+
+		}
+		if p.Before != nil {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableAuditEventColumnBefore); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Before)
+			upsert.Dirty = true
+
+		}
+		if p.CreatedAt.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableAuditEventColumnCreatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.CreatedAt)
+			upsert.Dirty = true
+
+		}
+		if p.Peer.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableAuditEventColumnPeer); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Peer)
+			upsert.Dirty = true
+		}
+
+		if p.RPC.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableAuditEventColumnRPC); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.RPC)
+			upsert.Dirty = true
+		}
+
+		if p.TargetID.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableAuditEventColumnTargetID); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.TargetID)
+			upsert.Dirty = true
+		}
+
+		if p.TargetKind.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableAuditEventColumnTargetKind); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.TargetKind)
+			upsert.Dirty = true
+		}
+
+	}
+	if len(inf) > 0 && upsert.Dirty {
+		buf.WriteString("(")
+		for j, i := range inf {
+			if j != 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString(i)
+		}
+		buf.WriteString(")")
+		buf.WriteString(" DO UPDATE SET ")
+		buf.ReadFrom(upsert)
+	} else {
+		buf.WriteString(" DO NOTHING ")
+	}
+	if upsert.Dirty {
+		buf.WriteString(" RETURNING ")
+		if len(r.Columns) > 0 {
+			buf.WriteString(strings.Join(r.Columns, ", "))
+		} else {
+			buf.WriteString("actor_id, after, before, created_at, id, peer, rpc, target_id, target_kind")
+		}
+	}
+	return buf.String(), upsert.Args(), nil
+}
+
+func (r *AuditEventRepositoryBase) upsert(ctx context.Context, tx *sql.Tx, e *AuditEventEntity, p *AuditEventPatch, inf ...string) (*AuditEventEntity, error) {
+	query, args, err := r.UpsertQuery(e, p, inf...)
+	if err != nil {
+		return nil, err
+	}
+
+	var row *sql.Row
+	if tx == nil {
+		row = r.DB.QueryRowContext(ctx, query, args...)
+	} else {
+		row = tx.QueryRowContext(ctx, query, args...)
+	}
+	err = row.Scan(
+		&e.ActorID,
+		&e.After,
+		&e.Before,
+		&e.CreatedAt,
+		&e.ID,
+		&e.Peer,
+		&e.RPC,
+		&e.TargetID,
+		&e.TargetKind,
+	)
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableAuditEvent, "upsert", query, args...)
+		} else {
+			r.Log(err, TableAuditEvent, "upsert tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (r *AuditEventRepositoryBase) Upsert(ctx context.Context, e *AuditEventEntity, p *AuditEventPatch, inf ...string) (*AuditEventEntity, error) {
+	return r.upsert(ctx, nil, e, p, inf...)
+}
+
+func (r *AuditEventRepositoryBase) count(ctx context.Context, tx *sql.Tx, exp *AuditEventCountExpr) (int64, error) {
+	query, args, err := r.FindQuery(&AuditEventFindExpr{
+		Where:   exp.Where,
+		Columns: []string{"COUNT(*)"},
+	})
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, query, args...).Scan(&count)
+	} else {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(&count)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableAuditEvent, "count", query, args...)
+		} else {
+			r.Log(err, TableAuditEvent, "count tx", query, args...)
+		}
+	}
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *AuditEventRepositoryBase) Count(ctx context.Context, exp *AuditEventCountExpr) (int64, error) {
+	return r.count(ctx, nil, exp)
+}
+
+func (r *AuditEventRepositoryBase) deleteOneByID(ctx context.Context, tx *sql.Tx, pk int64) (int64, error) {
+	find := NewComposer(9)
+	find.WriteString("DELETE FROM ")
+	find.WriteString(TableAuditEvent)
+	find.WriteString(" WHERE ")
+	find.WriteString(TableAuditEventColumnID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(pk)
+	var (
+		err error
+		res sql.Result
+	)
+	if tx == nil {
+		res, err = r.DB.ExecContext(ctx, find.String(), find.Args()...)
+	} else {
+		res, err = tx.ExecContext(ctx, find.String(), find.Args()...)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+func (r *AuditEventRepositoryBase) DeleteOneByID(ctx context.Context, pk int64) (int64, error) {
+	return r.deleteOneByID(ctx, nil, pk)
+}
+
+type AuditEventRepositoryBaseTx struct {
+	base *AuditEventRepositoryBase
+	tx   *sql.Tx
+}
+
+func (r AuditEventRepositoryBaseTx) Commit() error {
+	return r.tx.Commit()
+}
+
+func (r AuditEventRepositoryBaseTx) Rollback() error {
+	return r.tx.Rollback()
+}
+
+func (r *AuditEventRepositoryBaseTx) Insert(ctx context.Context, e *AuditEventEntity) (*AuditEventEntity, error) {
+	return r.base.insert(ctx, r.tx, e)
+}
+
+func (r *AuditEventRepositoryBaseTx) Find(ctx context.Context, fe *AuditEventFindExpr) ([]*AuditEventEntity, error) {
+	return r.base.find(ctx, r.tx, fe)
+}
+
+func (r *AuditEventRepositoryBaseTx) FindIter(ctx context.Context, fe *AuditEventFindExpr) (*AuditEventIterator, error) {
+	return r.base.findIter(ctx, r.tx, fe)
+}
+
+func (r *AuditEventRepositoryBaseTx) FindOneByID(ctx context.Context, pk int64) (*AuditEventEntity, error) {
+	return r.base.findOneByID(ctx, r.tx, pk)
+}
+
+func (r *AuditEventRepositoryBaseTx) UpdateOneByID(ctx context.Context, pk int64, p *AuditEventPatch) (*AuditEventEntity, error) {
+	return r.base.updateOneByID(ctx, r.tx, pk, p)
+}
+
+func (r *AuditEventRepositoryBaseTx) Upsert(ctx context.Context, e *AuditEventEntity, p *AuditEventPatch, inf ...string) (*AuditEventEntity, error) {
+	return r.base.upsert(ctx, r.tx, e, p, inf...)
+}
+
+func (r *AuditEventRepositoryBaseTx) Count(ctx context.Context, exp *AuditEventCountExpr) (int64, error) {
+	return r.base.count(ctx, r.tx, exp)
+}
+
+func (r *AuditEventRepositoryBaseTx) DeleteOneByID(ctx context.Context, pk int64) (int64, error) {
+	return r.base.deleteOneByID(ctx, r.tx, pk)
+}
+
+const (
+	TableSessionConstraintAccessTokenUnique = "charon.session_access_token_key"
+	TableSessionConstraintPrimaryKey        = "charon.session_id_pkey"
+)
+
+const (
+	TableSession                    = "charon.session"
+	TableSessionColumnAccessToken   = "access_token"
+	TableSessionColumnBag           = "bag"
+	TableSessionColumnCreatedAt     = "created_at"
+	TableSessionColumnExpireAt      = "expire_at"
+	TableSessionColumnID            = "id"
+	TableSessionColumnRefreshToken  = "refresh_token"
+	TableSessionColumnSubjectClient = "subject_client"
+	TableSessionColumnSubjectID     = "subject_id"
+)
+
+var TableSessionColumns = []string{
+	TableSessionColumnAccessToken,
+	TableSessionColumnBag,
+	TableSessionColumnCreatedAt,
+	TableSessionColumnExpireAt,
+	TableSessionColumnID,
+	TableSessionColumnRefreshToken,
+	TableSessionColumnSubjectClient,
+	TableSessionColumnSubjectID,
+}
+
+// SessionEntity ...
+type SessionEntity struct {
+	// AccessToken ...
+	AccessToken string
+	// Bag ...
+	Bag []byte
+	// CreatedAt ...
+	CreatedAt time.Time
+	// ExpireAt ...
+	ExpireAt time.Time
+	// ID ...
+	ID int64
+	// RefreshToken ...
+	RefreshToken string
+	// SubjectClient ...
+	SubjectClient string
+	// SubjectID ...
+	SubjectID string
+}
+
+func (e *SessionEntity) Prop(cn string) (interface{}, bool) {
+	switch cn {
+
+	case TableSessionColumnAccessToken:
+		return &e.AccessToken, true
+	case TableSessionColumnBag:
+		return &e.Bag, true
+	case TableSessionColumnCreatedAt:
+		return &e.CreatedAt, true
+	case TableSessionColumnExpireAt:
+		return &e.ExpireAt, true
+	case TableSessionColumnID:
+		return &e.ID, true
+	case TableSessionColumnRefreshToken:
+		return &e.RefreshToken, true
+	case TableSessionColumnSubjectClient:
+		return &e.SubjectClient, true
+	case TableSessionColumnSubjectID:
+		return &e.SubjectID, true
+	default:
+		return nil, false
+	}
+}
+
+func (e *SessionEntity) Props(cns ...string) ([]interface{}, error) {
+	if len(cns) == 0 {
+		cns = TableSessionColumns
+	}
+	res := make([]interface{}, 0, len(cns))
+	for _, cn := range cns {
+		if prop, ok := e.Prop(cn); ok {
+			res = append(res, prop)
+		} else {
+			return nil, fmt.Errorf("unexpected column provided: %s", cn)
+		}
+	}
+	return res, nil
+}
+
+// ScanSessionRows helps to scan rows straight to the slice of entities.
+func ScanSessionRows(rows Rows) (entities []*SessionEntity, err error) {
+	for rows.Next() {
+		var ent SessionEntity
+		err = rows.Scan(
+			&ent.AccessToken,
+			&ent.Bag,
+			&ent.CreatedAt,
+			&ent.ExpireAt,
+			&ent.ID,
+			&ent.RefreshToken,
+			&ent.SubjectClient,
+			&ent.SubjectID,
+		)
+		if err != nil {
+			return
+		}
+
+		entities = append(entities, &ent)
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	return
+}
+
+// SessionIterator is not thread safe.
+type SessionIterator struct {
+	rows Rows
+	cols []string
+	expr *SessionFindExpr
+}
+
+func (i *SessionIterator) Next() bool {
+	return i.rows.Next()
+}
+
+func (i *SessionIterator) Close() error {
+	return i.rows.Close()
+}
+
+func (i *SessionIterator) Err() error {
+	return i.rows.Err()
+}
+
+// Columns is wrapper around sql.Rows.Columns method, that also cache output inside iterator.
+func (i *SessionIterator) Columns() ([]string, error) {
+	if i.cols == nil {
+		cols, err := i.rows.Columns()
+		if err != nil {
+			return nil, err
+		}
+		i.cols = cols
+	}
+	return i.cols, nil
+}
+
+// Ent is wrapper around Session method that makes iterator more generic.
+func (i *SessionIterator) Ent() (interface{}, error) {
+	return i.Session()
+}
+
+func (i *SessionIterator) Session() (*SessionEntity, error) {
+	var ent SessionEntity
+	cols, err := i.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	props, err := ent.Props(cols...)
+	if err != nil {
+		return nil, err
+	}
+	if err := i.rows.Scan(props...); err != nil {
+		return nil, err
+	}
+	return &ent, nil
+}
+
+type SessionCriteria struct {
+	AccessToken            *qtypes.String
+	Bag                    []byte
+	CreatedAt              *qtypes.Timestamp
+	ExpireAt               *qtypes.Timestamp
+	ID                     *qtypes.Int64
+	RefreshToken           *qtypes.String
+	SubjectClient          *qtypes.String
+	SubjectID              *qtypes.String
+	operator               string
+	child, sibling, parent *SessionCriteria
+}
+
+func SessionOperand(operator string, operands ...*SessionCriteria) *SessionCriteria {
+	if len(operands) == 0 {
+		return &SessionCriteria{operator: operator}
+	}
+
+	parent := &SessionCriteria{
+		operator: operator,
+		child:    operands[0],
+	}
+
+	for i := 0; i < len(operands); i++ {
+		if i < len(operands)-1 {
+			operands[i].sibling = operands[i+1]
+		}
+		operands[i].parent = parent
+	}
+
+	return parent
+}
+
+func SessionOr(operands ...*SessionCriteria) *SessionCriteria {
+	return SessionOperand("OR", operands...)
+}
+
+func SessionAnd(operands ...*SessionCriteria) *SessionCriteria {
+	return SessionOperand("AND", operands...)
+}
+
+type SessionFindExpr struct {
+	Where         *SessionCriteria
+	Offset, Limit int64
+	Columns       []string
+	OrderBy       []RowOrder
+}
+
+type SessionJoin struct {
+	On, Where *SessionCriteria
+	Fetch     bool
+	Kind      JoinType
+}
+
+type SessionCountExpr struct {
+	Where *SessionCriteria
+}
+
+type SessionPatch struct {
+	AccessToken   ntypes.String
+	Bag           []byte
+	CreatedAt     pq.NullTime
+	ExpireAt      pq.NullTime
+	RefreshToken  ntypes.String
+	SubjectClient ntypes.String
+	SubjectID     ntypes.String
+}
+
+type SessionRepositoryBase struct {
+	Table   string
+	Columns []string
+	DB      *sql.DB
+	Log     LogFunc
+}
+
+func (r *SessionRepositoryBase) Tx(tx *sql.Tx) (*SessionRepositoryBaseTx, error) {
+	return &SessionRepositoryBaseTx{
+		base: r,
+		tx:   tx,
+	}, nil
+}
+
+func (r *SessionRepositoryBase) BeginTx(ctx context.Context) (*SessionRepositoryBaseTx, error) {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return r.Tx(tx)
+}
+
+func (r SessionRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *SessionRepositoryBaseTx) error, attempts int) (err error) {
+	return RunInTransaction(ctx, r.DB, func(tx *sql.Tx) error {
+		rtx, err := r.Tx(tx)
+		if err != nil {
+			return err
+		}
+		return fn(rtx)
+	}, attempts)
+}
+
+func (r *SessionRepositoryBase) InsertQuery(e *SessionEntity, read bool) (string, []interface{}, error) {
+	insert := NewComposer(8)
+	columns := bytes.NewBuffer(nil)
+	buf := bytes.NewBufferString("INSERT INTO ")
+	buf.WriteString(r.Table)
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableSessionColumnAccessToken); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.AccessToken)
+	insert.Dirty = true
+
+	if e.Bag != nil {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableSessionColumnBag); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.Bag)
+		insert.Dirty = true
+	}
+
+	if !e.CreatedAt.IsZero() {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableSessionColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.CreatedAt)
+		insert.Dirty = true
+	}
+
+	if !e.ExpireAt.IsZero() {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableSessionColumnExpireAt); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ExpireAt)
+		insert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableSessionColumnRefreshToken); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.RefreshToken)
+	insert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableSessionColumnSubjectClient); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.SubjectClient)
+	insert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableSessionColumnSubjectID); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.SubjectID)
+	insert.Dirty = true
+
+	if columns.Len() > 0 {
+		buf.WriteString(" (")
+		buf.ReadFrom(columns)
+		buf.WriteString(") VALUES (")
+		buf.ReadFrom(insert)
+		buf.WriteString(") ")
+		if read {
+			buf.WriteString("RETURNING ")
+			if len(r.Columns) > 0 {
+				buf.WriteString(strings.Join(r.Columns, ", "))
+			} else {
+				buf.WriteString("access_token, bag, created_at, expire_at, id, refresh_token, subject_client, subject_id")
+			}
+		}
+	}
+	return buf.String(), insert.Args(), nil
+}
+
+func (r *SessionRepositoryBase) insert(ctx context.Context, tx *sql.Tx, e *SessionEntity) (*SessionEntity, error) {
+	query, args, err := r.InsertQuery(e, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var row *sql.Row
+	if tx == nil {
+		row = r.DB.QueryRowContext(ctx, query, args...)
+	} else {
+		row = tx.QueryRowContext(ctx, query, args...)
+	}
+	err = row.Scan(
+		&e.AccessToken,
+		&e.Bag,
+		&e.CreatedAt,
+		&e.ExpireAt,
+		&e.ID,
+		&e.RefreshToken,
+		&e.SubjectClient,
+		&e.SubjectID,
+	)
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableSession, "insert", query, args...)
+		} else {
+			r.Log(err, TableSession, "insert tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (r *SessionRepositoryBase) Insert(ctx context.Context, e *SessionEntity) (*SessionEntity, error) {
+	return r.insert(ctx, nil, e)
+}
+
+func SessionCriteriaWhereClause(comp *Composer, c *SessionCriteria, id int) error {
+	if c.child == nil {
+		return _SessionCriteriaWhereClause(comp, c, id)
+	}
+	node := c
+	sibling := false
+	for {
+		if !sibling {
+			if node.child != nil {
+				if node.parent != nil {
+					comp.WriteString("(")
+				}
+				node = node.child
+				continue
+			} else {
+				comp.Dirty = false
+				comp.WriteString("(")
+				if err := _SessionCriteriaWhereClause(comp, node, id); err != nil {
+					return err
+				}
+				comp.WriteString(")")
+			}
+		}
+		if node.sibling != nil {
+			sibling = false
+			comp.WriteString(" ")
+			comp.WriteString(node.parent.operator)
+			comp.WriteString(" ")
+			node = node.sibling
+			continue
+		}
+		if node.parent != nil {
+			sibling = true
+			if node.parent.parent != nil {
+				comp.WriteString(")")
+			}
+			node = node.parent
+			continue
+		}
+
+		break
+	}
+	return nil
+}
+
+func _SessionCriteriaWhereClause(comp *Composer, c *SessionCriteria, id int) error {
+	QueryStringWhereClause(c.AccessToken, id, TableSessionColumnAccessToken, comp, And)
+
+	if c.Bag != nil {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableSessionColumnBag); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.Bag)
+		comp.Dirty = true
+	}
+	QueryTimestampWhereClause(c.CreatedAt, id, TableSessionColumnCreatedAt, comp, And)
+
+	QueryTimestampWhereClause(c.ExpireAt, id, TableSessionColumnExpireAt, comp, And)
+
+	QueryInt64WhereClause(c.ID, id, TableSessionColumnID, comp, And)
+
+	QueryStringWhereClause(c.RefreshToken, id, TableSessionColumnRefreshToken, comp, And)
+
+	QueryStringWhereClause(c.SubjectClient, id, TableSessionColumnSubjectClient, comp, And)
+
+	QueryStringWhereClause(c.SubjectID, id, TableSessionColumnSubjectID, comp, And)
+
+	return nil
+}
+
+func (r *SessionRepositoryBase) FindQuery(fe *SessionFindExpr) (string, []interface{}, error) {
+	comp := NewComposer(8)
+	buf := bytes.NewBufferString("SELECT ")
+	if len(fe.Columns) == 0 {
+		buf.WriteString("t0.access_token, t0.bag, t0.created_at, t0.expire_at, t0.id, t0.refresh_token, t0.subject_client, t0.subject_id")
+	} else {
+		buf.WriteString(strings.Join(fe.Columns, ", "))
+	}
+	buf.WriteString(" FROM ")
+	buf.WriteString(r.Table)
+	buf.WriteString(" AS t0")
+	if comp.Dirty {
+		buf.ReadFrom(comp)
+		comp.Dirty = false
+	}
+	if fe.Where != nil {
+		if err := SessionCriteriaWhereClause(comp, fe.Where, 0); err != nil {
+			return "", nil, err
+		}
+	}
+	if comp.Dirty {
+		if _, err := buf.WriteString(" WHERE "); err != nil {
+			return "", nil, err
+		}
+		buf.ReadFrom(comp)
+	}
+
+	if len(fe.OrderBy) > 0 {
+		i := 0
+		for _, order := range fe.OrderBy {
+			for _, columnName := range TableSessionColumns {
+				if order.Name == columnName {
+					if i == 0 {
+						comp.WriteString(" ORDER BY ")
+					}
+					if i > 0 {
+						if _, err := comp.WriteString(", "); err != nil {
+							return "", nil, err
+						}
+					}
+					if _, err := comp.WriteString(order.Name); err != nil {
+						return "", nil, err
+					}
+					if order.Descending {
+						if _, err := comp.WriteString(" DESC"); err != nil {
+							return "", nil, err
+						}
+					}
+					i++
+					break
+				}
+			}
+		}
+	}
+	if fe.Offset > 0 {
+		if _, err := comp.WriteString(" OFFSET "); err != nil {
+			return "", nil, err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		if _, err := comp.WriteString(" "); err != nil {
+			return "", nil, err
+		}
+		comp.Add(fe.Offset)
+	}
+	if fe.Limit > 0 {
+		if _, err := comp.WriteString(" LIMIT "); err != nil {
+			return "", nil, err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		if _, err := comp.WriteString(" "); err != nil {
+			return "", nil, err
+		}
+		comp.Add(fe.Limit)
+	}
+
+	buf.ReadFrom(comp)
+
+	return buf.String(), comp.Args(), nil
+}
+
+func (r *SessionRepositoryBase) find(ctx context.Context, tx *sql.Tx, fe *SessionFindExpr) ([]*SessionEntity, error) {
+	query, args, err := r.FindQuery(fe)
+	if err != nil {
+		return nil, err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableSession, "find", query, args...)
+		} else {
+			r.Log(err, TableSession, "find tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		entities []*SessionEntity
+		props    []interface{}
+	)
+	for rows.Next() {
+		var ent SessionEntity
+		if props, err = ent.Props(); err != nil {
+			return nil, err
+		}
+		err = rows.Scan(props...)
+		if err != nil {
+			return nil, err
+		}
+
+		entities = append(entities, &ent)
+	}
+	err = rows.Err()
+	if r.Log != nil {
+		r.Log(err, TableSession, "find", query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return entities, nil
+}
+
+func (r *SessionRepositoryBase) Find(ctx context.Context, fe *SessionFindExpr) ([]*SessionEntity, error) {
+	return r.find(ctx, nil, fe)
+}
+
+func (r *SessionRepositoryBase) findIter(ctx context.Context, tx *sql.Tx, fe *SessionFindExpr) (*SessionIterator, error) {
+	query, args, err := r.FindQuery(fe)
+	if err != nil {
+		return nil, err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableSession, "find iter", query, args...)
+		} else {
+			r.Log(err, TableSession, "find iter tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &SessionIterator{
+		rows: rows,
+		expr: fe,
+		cols: fe.Columns,
+	}, nil
+}
+
+func (r *SessionRepositoryBase) FindIter(ctx context.Context, fe *SessionFindExpr) (*SessionIterator, error) {
+	return r.findIter(ctx, nil, fe)
+}
+
+func (r *SessionRepositoryBase) findOneByID(ctx context.Context, tx *sql.Tx, pk int64) (*SessionEntity, error) {
+	find := NewComposer(8)
+	find.WriteString("SELECT ")
+	if len(r.Columns) == 0 {
+		find.WriteString("access_token, bag, created_at, expire_at, id, refresh_token, subject_client, subject_id")
+	} else {
+		find.WriteString(strings.Join(r.Columns, ", "))
+	}
+	find.WriteString(" FROM ")
+	find.WriteString(TableSession)
+	find.WriteString(" WHERE ")
+	find.WriteString(TableSessionColumnID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(pk)
+	var (
+		ent SessionEntity
+	)
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
+	} else {
+		err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableSession, "find by primary key", find.String(), find.Args()...)
+		} else {
+			r.Log(err, TableSession, "find by primary key tx", find.String(), find.Args()...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ent, nil
+}
+
+func (r *SessionRepositoryBase) FindOneByID(ctx context.Context, pk int64) (*SessionEntity, error) {
+	return r.findOneByID(ctx, nil, pk)
+}
+
+func (r *SessionRepositoryBase) findOneByAccessToken(ctx context.Context, tx *sql.Tx, sessionAccessToken string) (*SessionEntity, error) {
+	find := NewComposer(8)
+	find.WriteString("SELECT ")
+	if len(r.Columns) == 0 {
+		find.WriteString("access_token, bag, created_at, expire_at, id, refresh_token, subject_client, subject_id")
+	} else {
+		find.WriteString(strings.Join(r.Columns, ", "))
+	}
+	find.WriteString(" FROM ")
+	find.WriteString(TableSession)
+	find.WriteString(" WHERE ")
+	find.WriteString(TableSessionColumnAccessToken)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(sessionAccessToken)
+
+	var (
+		ent SessionEntity
+	)
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
+	} else {
+		err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &ent, nil
+}
+
+func (r *SessionRepositoryBase) FindOneByAccessToken(ctx context.Context, sessionAccessToken string) (*SessionEntity, error) {
+	return r.findOneByAccessToken(ctx, nil, sessionAccessToken)
+}
+
+func (r *SessionRepositoryBase) UpdateOneByIDQuery(pk int64, p *SessionPatch) (string, []interface{}, error) {
+	buf := bytes.NewBufferString("UPDATE ")
+	buf.WriteString(r.Table)
+	update := NewComposer(8)
+	if p.AccessToken.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableSessionColumnAccessToken); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.AccessToken)
+		update.Dirty = true
+	}
+
+	if p.Bag != nil {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableSessionColumnBag); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Bag)
+		update.Dirty = true
+
+	}
+	if p.CreatedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableSessionColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.CreatedAt)
+		update.Dirty = true
+
+	}
+	if p.ExpireAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableSessionColumnExpireAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.ExpireAt)
+		update.Dirty = true
+
+	}
+	if p.RefreshToken.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableSessionColumnRefreshToken); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.RefreshToken)
+		update.Dirty = true
+	}
+
+	if p.SubjectClient.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableSessionColumnSubjectClient); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.SubjectClient)
+		update.Dirty = true
+	}
+
+	if p.SubjectID.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableSessionColumnSubjectID); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.SubjectID)
+		update.Dirty = true
+	}
+
+	if !update.Dirty {
+		return "", nil, errors.New("Session update failure, nothing to update")
+	}
+	buf.WriteString(" SET ")
+	buf.ReadFrom(update)
+	buf.WriteString(" WHERE ")
+
+	update.WriteString(TableSessionColumnID)
+	update.WriteString("=")
+	update.WritePlaceholder()
+	update.Add(pk)
+
+	buf.ReadFrom(update)
+	buf.WriteString(" RETURNING ")
+	if len(r.Columns) > 0 {
+		buf.WriteString(strings.Join(r.Columns, ", "))
+	} else {
+		buf.WriteString("access_token, bag, created_at, expire_at, id, refresh_token, subject_client, subject_id")
+	}
+	return buf.String(), update.Args(), nil
+}
+
+func (r *SessionRepositoryBase) updateOneByID(ctx context.Context, tx *sql.Tx, pk int64, p *SessionPatch) (*SessionEntity, error) {
+	query, args, err := r.UpdateOneByIDQuery(pk, p)
+	if err != nil {
+		return nil, err
+	}
+	var ent SessionEntity
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, query, args...).Scan(props...)
+	} else {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(props...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableSession, "update by primary key", query, args...)
+		} else {
+			r.Log(err, TableSession, "update by primary key tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ent, nil
+}
+
+func (r *SessionRepositoryBase) UpdateOneByID(ctx context.Context, pk int64, p *SessionPatch) (*SessionEntity, error) {
+	return r.updateOneByID(ctx, nil, pk, p)
+}
+
+func (r *SessionRepositoryBase) FindOneByIDAndUpdate(ctx context.Context, pk int64, p *SessionPatch) (before, after *SessionEntity, err error) {
+	find := NewComposer(8)
+	find.WriteString("SELECT ")
+	if len(r.Columns) == 0 {
+		find.WriteString("access_token, bag, created_at, expire_at, id, refresh_token, subject_client, subject_id")
+	} else {
+		find.WriteString(strings.Join(r.Columns, ", "))
+	}
+	find.WriteString(" FROM ")
+	find.WriteString(TableSession)
+	find.WriteString(" WHERE ")
+	find.WriteString(TableSessionColumnID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(pk)
+	find.WriteString(" FOR UPDATE")
+	query, args, err := r.UpdateOneByIDQuery(pk, p)
+	if err != nil {
+		return
+	}
+	var (
+		oldEnt, newEnt SessionEntity
+	)
+	oldProps, err := oldEnt.Props(r.Columns...)
+	if err != nil {
+		return
+	}
+	newProps, err := newEnt.Props(r.Columns...)
+	if err != nil {
+		return
+	}
+	tx, err := r.DB.Begin()
+	if err != nil {
+		return
+	}
+	err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(oldProps...)
+	if r.Log != nil {
+		r.Log(err, TableSession, "find by primary key", find.String(), find.Args()...)
+	}
+	if err != nil {
+		tx.Rollback()
+		return
+	}
+	err = tx.QueryRowContext(ctx, query, args...).Scan(newProps...)
+	if r.Log != nil {
+		r.Log(err, TableSession, "update by primary key", query, args...)
+	}
+	if err != nil {
+		tx.Rollback()
+		return
+	}
+	err = tx.Commit()
+	if err != nil {
+		return
+	}
+	return &oldEnt, &newEnt, nil
+}
+
+func (r *SessionRepositoryBase) UpdateOneByAccessTokenQuery(sessionAccessToken string, p *SessionPatch) (string, []interface{}, error) {
+	buf := bytes.NewBufferString("UPDATE ")
+	buf.WriteString(r.Table)
+	update := NewComposer(1)
+	if p.AccessToken.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableSessionColumnAccessToken); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.AccessToken)
+		update.Dirty = true
+	}
+
+	if p.Bag != nil {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableSessionColumnBag); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Bag)
+		update.Dirty = true
+
+	}
+	if p.CreatedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableSessionColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.CreatedAt)
+		update.Dirty = true
+
+	}
+	if p.ExpireAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableSessionColumnExpireAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.ExpireAt)
+		update.Dirty = true
+
+	}
+	if p.RefreshToken.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableSessionColumnRefreshToken); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.RefreshToken)
+		update.Dirty = true
+	}
+
+	if p.SubjectClient.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableSessionColumnSubjectClient); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.SubjectClient)
+		update.Dirty = true
+	}
+
+	if p.SubjectID.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableSessionColumnSubjectID); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.SubjectID)
+		update.Dirty = true
+	}
+
+	if !update.Dirty {
+		return "", nil, errors.New("session update failure, nothing to update")
+	}
+	buf.WriteString(" SET ")
+	buf.ReadFrom(update)
+	buf.WriteString(" WHERE ")
+	update.WriteString(TableSessionColumnAccessToken)
+	update.WriteString("=")
+	update.WritePlaceholder()
+	update.Add(sessionAccessToken)
+	buf.ReadFrom(update)
+	buf.WriteString(" RETURNING ")
+	if len(r.Columns) > 0 {
+		buf.WriteString(strings.Join(r.Columns, ", "))
+	} else {
+		buf.WriteString("access_token, bag, created_at, expire_at, id, refresh_token, subject_client, subject_id")
+	}
+	return buf.String(), update.Args(), nil
+}
+
+func (r *SessionRepositoryBase) updateOneByAccessToken(ctx context.Context, tx *sql.Tx, sessionAccessToken string, p *SessionPatch) (*SessionEntity, error) {
+	query, args, err := r.UpdateOneByAccessTokenQuery(sessionAccessToken, p)
+	if err != nil {
+		return nil, err
+	}
+	var ent SessionEntity
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+
+	var row *sql.Row
+	if tx == nil {
+		row = r.DB.QueryRowContext(ctx, query, args...)
+	} else {
+		row = tx.QueryRowContext(ctx, query, args...)
+	}
+	err = row.Scan(props...)
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableSession, "update one by unique", query, args...)
+		} else {
+			r.Log(err, TableSession, "update one by unique tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ent, nil
+}
+
+func (r *SessionRepositoryBase) UpdateOneByAccessToken(ctx context.Context, sessionAccessToken string, p *SessionPatch) (*SessionEntity, error) {
+	return r.updateOneByAccessToken(ctx, nil, sessionAccessToken, p)
+}
+
+func (r *SessionRepositoryBase) UpsertQuery(e *SessionEntity, p *SessionPatch, inf ...string) (string, []interface{}, error) {
+	upsert := NewComposer(16)
+	columns := bytes.NewBuffer(nil)
+	buf := bytes.NewBufferString("INSERT INTO ")
+	buf.WriteString(r.Table)
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableSessionColumnAccessToken); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.AccessToken)
+	upsert.Dirty = true
+
+	if e.Bag != nil {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableSessionColumnBag); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.Bag)
+		upsert.Dirty = true
+	}
+
+	if !e.CreatedAt.IsZero() {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableSessionColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.CreatedAt)
+		upsert.Dirty = true
+	}
+
+	if !e.ExpireAt.IsZero() {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableSessionColumnExpireAt); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ExpireAt)
+		upsert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableSessionColumnRefreshToken); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.RefreshToken)
+	upsert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableSessionColumnSubjectClient); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.SubjectClient)
+	upsert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableSessionColumnSubjectID); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.SubjectID)
+	upsert.Dirty = true
+
+	if upsert.Dirty {
+		buf.WriteString(" (")
+		buf.ReadFrom(columns)
+		buf.WriteString(") VALUES (")
+		buf.ReadFrom(upsert)
+		buf.WriteString(")")
+	}
+	buf.WriteString(" ON CONFLICT ")
+	if len(inf) > 0 {
+		upsert.Dirty = false
+		if p.AccessToken.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableSessionColumnAccessToken); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.AccessToken)
+			upsert.Dirty = true
+		}
+
+		if p.Bag != nil {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableSessionColumnBag); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Bag)
+			upsert.Dirty = true
+
+		}
+		if p.CreatedAt.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableSessionColumnCreatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.CreatedAt)
+			upsert.Dirty = true
+
+		}
+		if p.ExpireAt.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableSessionColumnExpireAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ExpireAt)
+			upsert.Dirty = true
+
+		}
+		if p.RefreshToken.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableSessionColumnRefreshToken); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.RefreshToken)
+			upsert.Dirty = true
+		}
+
+		if p.SubjectClient.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableSessionColumnSubjectClient); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.SubjectClient)
+			upsert.Dirty = true
+		}
+
+		if p.SubjectID.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableSessionColumnSubjectID); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.SubjectID)
+			upsert.Dirty = true
+		}
+
+	}
+	if len(inf) > 0 && upsert.Dirty {
+		buf.WriteString("(")
+		for j, i := range inf {
+			if j != 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString(i)
+		}
+		buf.WriteString(")")
+		buf.WriteString(" DO UPDATE SET ")
+		buf.ReadFrom(upsert)
+	} else {
+		buf.WriteString(" DO NOTHING ")
+	}
+	if upsert.Dirty {
+		buf.WriteString(" RETURNING ")
+		if len(r.Columns) > 0 {
+			buf.WriteString(strings.Join(r.Columns, ", "))
+		} else {
+			buf.WriteString("access_token, bag, created_at, expire_at, id, refresh_token, subject_client, subject_id")
+		}
+	}
+	return buf.String(), upsert.Args(), nil
+}
+
+func (r *SessionRepositoryBase) upsert(ctx context.Context, tx *sql.Tx, e *SessionEntity, p *SessionPatch, inf ...string) (*SessionEntity, error) {
+	query, args, err := r.UpsertQuery(e, p, inf...)
+	if err != nil {
+		return nil, err
+	}
+
+	var row *sql.Row
+	if tx == nil {
+		row = r.DB.QueryRowContext(ctx, query, args...)
+	} else {
+		row = tx.QueryRowContext(ctx, query, args...)
+	}
+	err = row.Scan(
+		&e.AccessToken,
+		&e.Bag,
+		&e.CreatedAt,
+		&e.ExpireAt,
+		&e.ID,
+		&e.RefreshToken,
+		&e.SubjectClient,
+		&e.SubjectID,
+	)
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableSession, "upsert", query, args...)
+		} else {
+			r.Log(err, TableSession, "upsert tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (r *SessionRepositoryBase) Upsert(ctx context.Context, e *SessionEntity, p *SessionPatch, inf ...string) (*SessionEntity, error) {
+	return r.upsert(ctx, nil, e, p, inf...)
+}
+
+func (r *SessionRepositoryBase) count(ctx context.Context, tx *sql.Tx, exp *SessionCountExpr) (int64, error) {
+	query, args, err := r.FindQuery(&SessionFindExpr{
+		Where:   exp.Where,
+		Columns: []string{"COUNT(*)"},
+	})
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, query, args...).Scan(&count)
+	} else {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(&count)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableSession, "count", query, args...)
+		} else {
+			r.Log(err, TableSession, "count tx", query, args...)
+		}
+	}
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *SessionRepositoryBase) Count(ctx context.Context, exp *SessionCountExpr) (int64, error) {
+	return r.count(ctx, nil, exp)
+}
+
+func (r *SessionRepositoryBase) deleteOneByID(ctx context.Context, tx *sql.Tx, pk int64) (int64, error) {
+	find := NewComposer(8)
+	find.WriteString("DELETE FROM ")
+	find.WriteString(TableSession)
+	find.WriteString(" WHERE ")
+	find.WriteString(TableSessionColumnID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(pk)
+	var (
+		err error
+		res sql.Result
+	)
+	if tx == nil {
+		res, err = r.DB.ExecContext(ctx, find.String(), find.Args()...)
+	} else {
+		res, err = tx.ExecContext(ctx, find.String(), find.Args()...)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+func (r *SessionRepositoryBase) DeleteOneByID(ctx context.Context, pk int64) (int64, error) {
+	return r.deleteOneByID(ctx, nil, pk)
+}
+
+type SessionRepositoryBaseTx struct {
+	base *SessionRepositoryBase
+	tx   *sql.Tx
+}
+
+func (r SessionRepositoryBaseTx) Commit() error {
+	return r.tx.Commit()
+}
+
+func (r SessionRepositoryBaseTx) Rollback() error {
+	return r.tx.Rollback()
+}
+
+func (r *SessionRepositoryBaseTx) Insert(ctx context.Context, e *SessionEntity) (*SessionEntity, error) {
+	return r.base.insert(ctx, r.tx, e)
+}
+
+func (r *SessionRepositoryBaseTx) Find(ctx context.Context, fe *SessionFindExpr) ([]*SessionEntity, error) {
+	return r.base.find(ctx, r.tx, fe)
+}
+
+func (r *SessionRepositoryBaseTx) FindIter(ctx context.Context, fe *SessionFindExpr) (*SessionIterator, error) {
+	return r.base.findIter(ctx, r.tx, fe)
+}
+
+func (r *SessionRepositoryBaseTx) FindOneByID(ctx context.Context, pk int64) (*SessionEntity, error) {
+	return r.base.findOneByID(ctx, r.tx, pk)
+}
+
+func (r *SessionRepositoryBaseTx) UpdateOneByID(ctx context.Context, pk int64, p *SessionPatch) (*SessionEntity, error) {
+	return r.base.updateOneByID(ctx, r.tx, pk, p)
+}
+
+func (r *SessionRepositoryBaseTx) UpdateOneByAccessToken(ctx context.Context, sessionAccessToken string, p *SessionPatch) (*SessionEntity, error) {
+	return r.base.updateOneByAccessToken(ctx, r.tx, sessionAccessToken, p)
+}
+
+func (r *SessionRepositoryBaseTx) Upsert(ctx context.Context, e *SessionEntity, p *SessionPatch, inf ...string) (*SessionEntity, error) {
+	return r.base.upsert(ctx, r.tx, e, p, inf...)
+}
+
+func (r *SessionRepositoryBaseTx) Count(ctx context.Context, exp *SessionCountExpr) (int64, error) {
+	return r.base.count(ctx, r.tx, exp)
+}
+
+func (r *SessionRepositoryBaseTx) DeleteOneByID(ctx context.Context, pk int64) (int64, error) {
+	return r.base.deleteOneByID(ctx, r.tx, pk)
+}
+
+const (
+	TableOauthClientConstraintPrimaryKey          = "charon.oauth_client_id_pkey"
+	TableOauthClientConstraintClientIDUnique      = "charon.oauth_client_client_id_key"
+	TableOauthClientConstraintCreatedByForeignKey = "charon.oauth_client_created_by_fkey"
+	TableOauthClientConstraintUpdatedByForeignKey = "charon.oauth_client_updated_by_fkey"
+)
+
+const (
+	TableOauthClient                   = "charon.oauth_client"
+	TableOauthClientColumnClientID     = "client_id"
+	TableOauthClientColumnCreatedAt    = "created_at"
+	TableOauthClientColumnCreatedBy    = "created_by"
+	TableOauthClientColumnID           = "id"
+	TableOauthClientColumnName         = "name"
+	TableOauthClientColumnRedirectUris = "redirect_uris"
+	TableOauthClientColumnSecret       = "secret"
+	TableOauthClientColumnUpdatedAt    = "updated_at"
+	TableOauthClientColumnUpdatedBy    = "updated_by"
+)
+
+var TableOauthClientColumns = []string{
+	TableOauthClientColumnClientID,
+	TableOauthClientColumnCreatedAt,
+	TableOauthClientColumnCreatedBy,
+	TableOauthClientColumnID,
+	TableOauthClientColumnName,
+	TableOauthClientColumnRedirectUris,
+	TableOauthClientColumnSecret,
+	TableOauthClientColumnUpdatedAt,
+	TableOauthClientColumnUpdatedBy,
+}
+
+// OauthClientEntity ...
+type OauthClientEntity struct {
+	// ClientID ...
+	ClientID string
+	// CreatedAt ...
+	CreatedAt time.Time
+	// CreatedBy ...
+	CreatedBy ntypes.Int64
+	// ID ...
+	ID int64
+	// Name ...
+	Name string
+	// RedirectUris ...
+	RedirectUris string
+	// Secret ...
+	Secret []byte
+	// UpdatedAt ...
+	UpdatedAt pq.NullTime
+	// UpdatedBy ...
+	UpdatedBy ntypes.Int64
+	// Author ...
+	Author *UserEntity
+	// Modifier ...
+	Modifier *UserEntity
+}
+
+func (e *OauthClientEntity) Prop(cn string) (interface{}, bool) {
+	switch cn {
+
+	case TableOauthClientColumnClientID:
+		return &e.ClientID, true
+	case TableOauthClientColumnCreatedAt:
+		return &e.CreatedAt, true
+	case TableOauthClientColumnCreatedBy:
+		return &e.CreatedBy, true
+	case TableOauthClientColumnID:
+		return &e.ID, true
+	case TableOauthClientColumnName:
+		return &e.Name, true
+	case TableOauthClientColumnRedirectUris:
+		return &e.RedirectUris, true
+	case TableOauthClientColumnSecret:
+		return &e.Secret, true
+	case TableOauthClientColumnUpdatedAt:
+		return &e.UpdatedAt, true
+	case TableOauthClientColumnUpdatedBy:
+		return &e.UpdatedBy, true
+	default:
+		return nil, false
+	}
+}
+
+func (e *OauthClientEntity) Props(cns ...string) ([]interface{}, error) {
+	if len(cns) == 0 {
+		cns = TableOauthClientColumns
+	}
+	res := make([]interface{}, 0, len(cns))
+	for _, cn := range cns {
+		if prop, ok := e.Prop(cn); ok {
+			res = append(res, prop)
+		} else {
+			return nil, fmt.Errorf("unexpected column provided: %s", cn)
+		}
+	}
+	return res, nil
+}
+
+// ScanOauthClientRows helps to scan rows straight to the slice of entities.
+func ScanOauthClientRows(rows Rows) (entities []*OauthClientEntity, err error) {
+	for rows.Next() {
+		var ent OauthClientEntity
+		err = rows.Scan(
+			&ent.ClientID,
+			&ent.CreatedAt,
+			&ent.CreatedBy,
+			&ent.ID,
+			&ent.Name,
+			&ent.RedirectUris,
+			&ent.Secret,
+			&ent.UpdatedAt,
+			&ent.UpdatedBy,
+		)
+		if err != nil {
+			return
+		}
+
+		entities = append(entities, &ent)
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	return
+}
+
+// OauthClientIterator is not thread safe.
+type OauthClientIterator struct {
+	rows Rows
+	cols []string
+	expr *OauthClientFindExpr
+}
+
+func (i *OauthClientIterator) Next() bool {
+	return i.rows.Next()
+}
+
+func (i *OauthClientIterator) Close() error {
+	return i.rows.Close()
+}
+
+func (i *OauthClientIterator) Err() error {
+	return i.rows.Err()
+}
+
+// Columns is wrapper around sql.Rows.Columns method, that also cache output inside iterator.
+func (i *OauthClientIterator) Columns() ([]string, error) {
+	if i.cols == nil {
+		cols, err := i.rows.Columns()
+		if err != nil {
+			return nil, err
+		}
+		i.cols = cols
+	}
+	return i.cols, nil
+}
+
+// Ent is wrapper around OauthClient method that makes iterator more generic.
+func (i *OauthClientIterator) Ent() (interface{}, error) {
+	return i.OauthClient()
+}
+
+func (i *OauthClientIterator) OauthClient() (*OauthClientEntity, error) {
+	var ent OauthClientEntity
+	cols, err := i.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	props, err := ent.Props(cols...)
+	if err != nil {
+		return nil, err
+	}
+	var prop []interface{}
+	if i.expr.JoinAuthor != nil && i.expr.JoinAuthor.Kind.Actionable() && i.expr.JoinAuthor.Fetch {
+		ent.Author = &UserEntity{}
+		if prop, err = ent.Author.Props(); err != nil {
+			return nil, err
+		}
+		props = append(props, prop...)
+	}
+	if i.expr.JoinModifier != nil && i.expr.JoinModifier.Kind.Actionable() && i.expr.JoinModifier.Fetch {
+		ent.Modifier = &UserEntity{}
+		if prop, err = ent.Modifier.Props(); err != nil {
+			return nil, err
+		}
+		props = append(props, prop...)
+	}
+	if err := i.rows.Scan(props...); err != nil {
+		return nil, err
+	}
+	return &ent, nil
+}
+
+type OauthClientCriteria struct {
+	ClientID               *qtypes.String
+	CreatedAt              *qtypes.Timestamp
+	CreatedBy              *qtypes.Int64
+	ID                     *qtypes.Int64
+	Name                   *qtypes.String
+	RedirectUris           *qtypes.String
+	Secret                 []byte
+	UpdatedAt              *qtypes.Timestamp
+	UpdatedBy              *qtypes.Int64
+	operator               string
+	child, sibling, parent *OauthClientCriteria
+}
+
+func OauthClientOperand(operator string, operands ...*OauthClientCriteria) *OauthClientCriteria {
+	if len(operands) == 0 {
+		return &OauthClientCriteria{operator: operator}
+	}
+
+	parent := &OauthClientCriteria{
+		operator: operator,
+		child:    operands[0],
+	}
+
+	for i := 0; i < len(operands); i++ {
+		if i < len(operands)-1 {
+			operands[i].sibling = operands[i+1]
+		}
+		operands[i].parent = parent
+	}
+
+	return parent
+}
+
+func OauthClientOr(operands ...*OauthClientCriteria) *OauthClientCriteria {
+	return OauthClientOperand("OR", operands...)
+}
+
+func OauthClientAnd(operands ...*OauthClientCriteria) *OauthClientCriteria {
+	return OauthClientOperand("AND", operands...)
+}
+
+type OauthClientFindExpr struct {
+	Where         *OauthClientCriteria
+	Offset, Limit int64
+	Columns       []string
+	OrderBy       []RowOrder
+	JoinAuthor    *UserJoin
+	JoinModifier  *UserJoin
+}
+
+type OauthClientJoin struct {
+	On, Where    *OauthClientCriteria
+	Fetch        bool
+	Kind         JoinType
+	JoinAuthor   *UserJoin
+	JoinModifier *UserJoin
+}
+
+type OauthClientCountExpr struct {
+	Where        *OauthClientCriteria
+	JoinAuthor   *UserJoin
+	JoinModifier *UserJoin
+}
+
+type OauthClientPatch struct {
+	ClientID     ntypes.String
+	CreatedAt    pq.NullTime
+	CreatedBy    ntypes.Int64
+	Name         ntypes.String
+	RedirectUris ntypes.String
+	Secret       []byte
+	UpdatedAt    pq.NullTime
+	UpdatedBy    ntypes.Int64
+}
+
+type OauthClientRepositoryBase struct {
+	Table   string
+	Columns []string
+	DB      *sql.DB
+	Log     LogFunc
+}
+
+func (r *OauthClientRepositoryBase) Tx(tx *sql.Tx) (*OauthClientRepositoryBaseTx, error) {
+	return &OauthClientRepositoryBaseTx{
+		base: r,
+		tx:   tx,
+	}, nil
+}
+
+func (r *OauthClientRepositoryBase) BeginTx(ctx context.Context) (*OauthClientRepositoryBaseTx, error) {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return r.Tx(tx)
+}
+
+func (r OauthClientRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *OauthClientRepositoryBaseTx) error, attempts int) (err error) {
+	return RunInTransaction(ctx, r.DB, func(tx *sql.Tx) error {
+		rtx, err := r.Tx(tx)
+		if err != nil {
+			return err
+		}
+		return fn(rtx)
+	}, attempts)
+}
+
+func (r *OauthClientRepositoryBase) InsertQuery(e *OauthClientEntity, read bool) (string, []interface{}, error) {
+	insert := NewComposer(9)
+	columns := bytes.NewBuffer(nil)
+	buf := bytes.NewBufferString("INSERT INTO ")
+	buf.WriteString(r.Table)
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableOauthClientColumnClientID); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.ClientID)
+	insert.Dirty = true
+
+	if !e.CreatedAt.IsZero() {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableOauthClientColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.CreatedAt)
+		insert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableOauthClientColumnCreatedBy); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.CreatedBy)
+	insert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableOauthClientColumnName); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.Name)
+	insert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableOauthClientColumnRedirectUris); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.RedirectUris)
+	insert.Dirty = true
+
+	if e.Secret != nil {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableOauthClientColumnSecret); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.Secret)
+		insert.Dirty = true
+	}
+
+	if e.UpdatedAt.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableOauthClientColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.UpdatedAt)
+		insert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableOauthClientColumnUpdatedBy); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.UpdatedBy)
+	insert.Dirty = true
+
+	if columns.Len() > 0 {
+		buf.WriteString(" (")
+		buf.ReadFrom(columns)
+		buf.WriteString(") VALUES (")
+		buf.ReadFrom(insert)
+		buf.WriteString(") ")
+		if read {
+			buf.WriteString("RETURNING ")
+			if len(r.Columns) > 0 {
+				buf.WriteString(strings.Join(r.Columns, ", "))
+			} else {
+				buf.WriteString("client_id, created_at, created_by, id, name, redirect_uris, secret, updated_at, updated_by")
+			}
+		}
+	}
+	return buf.String(), insert.Args(), nil
+}
+
+func (r *OauthClientRepositoryBase) insert(ctx context.Context, tx *sql.Tx, e *OauthClientEntity) (*OauthClientEntity, error) {
+	query, args, err := r.InsertQuery(e, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var row *sql.Row
+	if tx == nil {
+		row = r.DB.QueryRowContext(ctx, query, args...)
+	} else {
+		row = tx.QueryRowContext(ctx, query, args...)
+	}
+	err = row.Scan(
+		&e.ClientID,
+		&e.CreatedAt,
+		&e.CreatedBy,
+		&e.ID,
+		&e.Name,
+		&e.RedirectUris,
+		&e.Secret,
+		&e.UpdatedAt,
+		&e.UpdatedBy,
+	)
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableOauthClient, "insert", query, args...)
+		} else {
+			r.Log(err, TableOauthClient, "insert tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (r *OauthClientRepositoryBase) Insert(ctx context.Context, e *OauthClientEntity) (*OauthClientEntity, error) {
+	return r.insert(ctx, nil, e)
+}
+
+func OauthClientCriteriaWhereClause(comp *Composer, c *OauthClientCriteria, id int) error {
+	if c.child == nil {
+		return _OauthClientCriteriaWhereClause(comp, c, id)
+	}
+	node := c
+	sibling := false
+	for {
+		if !sibling {
+			if node.child != nil {
+				if node.parent != nil {
+					comp.WriteString("(")
+				}
+				node = node.child
+				continue
+			} else {
+				comp.Dirty = false
+				comp.WriteString("(")
+				if err := _OauthClientCriteriaWhereClause(comp, node, id); err != nil {
+					return err
+				}
+				comp.WriteString(")")
+			}
+		}
+		if node.sibling != nil {
+			sibling = false
+			comp.WriteString(" ")
+			comp.WriteString(node.parent.operator)
+			comp.WriteString(" ")
+			node = node.sibling
+			continue
+		}
+		if node.parent != nil {
+			sibling = true
+			if node.parent.parent != nil {
+				comp.WriteString(")")
+			}
+			node = node.parent
+			continue
+		}
+
+		break
+	}
+	return nil
+}
+
+func _OauthClientCriteriaWhereClause(comp *Composer, c *OauthClientCriteria, id int) error {
+	QueryStringWhereClause(c.ClientID, id, TableOauthClientColumnClientID, comp, And)
+
+	QueryTimestampWhereClause(c.CreatedAt, id, TableOauthClientColumnCreatedAt, comp, And)
+
+	QueryInt64WhereClause(c.CreatedBy, id, TableOauthClientColumnCreatedBy, comp, And)
+
+	QueryInt64WhereClause(c.ID, id, TableOauthClientColumnID, comp, And)
+
+	QueryStringWhereClause(c.Name, id, TableOauthClientColumnName, comp, And)
+
+	QueryStringWhereClause(c.RedirectUris, id, TableOauthClientColumnRedirectUris, comp, And)
+
+	if c.Secret != nil {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableOauthClientColumnSecret); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.Secret)
+		comp.Dirty = true
+	}
+	QueryTimestampWhereClause(c.UpdatedAt, id, TableOauthClientColumnUpdatedAt, comp, And)
+
+	QueryInt64WhereClause(c.UpdatedBy, id, TableOauthClientColumnUpdatedBy, comp, And)
+
+	return nil
+}
+
+func (r *OauthClientRepositoryBase) FindQuery(fe *OauthClientFindExpr) (string, []interface{}, error) {
+	comp := NewComposer(9)
+	buf := bytes.NewBufferString("SELECT ")
+	if len(fe.Columns) == 0 {
+		buf.WriteString("t0.client_id, t0.created_at, t0.created_by, t0.id, t0.name, t0.redirect_uris, t0.secret, t0.updated_at, t0.updated_by")
+	} else {
+		buf.WriteString(strings.Join(fe.Columns, ", "))
+	}
+	if fe.JoinAuthor != nil && fe.JoinAuthor.Kind.Actionable() && fe.JoinAuthor.Fetch {
+		buf.WriteString(", t1.confirmation_token, t1.confirmation_token_expire_at, t1.created_at, t1.created_by, t1.deleted_at, t1.first_name, t1.id, t1.is_active, t1.is_confirmed, t1.is_service_account, t1.is_staff, t1.is_superuser, t1.last_login_at, t1.last_name, t1.locked_at, t1.password, t1.password_reset_token, t1.password_reset_token_expire_at, t1.two_factor_challenge, t1.two_factor_challenge_expire_at, t1.two_factor_confirmed_at, t1.two_factor_last_step, t1.two_factor_recovery_codes, t1.two_factor_secret, t1.updated_at, t1.updated_by, t1.username")
+	}
+	if fe.JoinModifier != nil && fe.JoinModifier.Kind.Actionable() && fe.JoinModifier.Fetch {
+		buf.WriteString(", t2.confirmation_token, t2.confirmation_token_expire_at, t2.created_at, t2.created_by, t2.deleted_at, t2.first_name, t2.id, t2.is_active, t2.is_confirmed, t2.is_service_account, t2.is_staff, t2.is_superuser, t2.last_login_at, t2.last_name, t2.locked_at, t2.password, t2.password_reset_token, t2.password_reset_token_expire_at, t2.two_factor_challenge, t2.two_factor_challenge_expire_at, t2.two_factor_confirmed_at, t2.two_factor_last_step, t2.two_factor_recovery_codes, t2.two_factor_secret, t2.updated_at, t2.updated_by, t2.username")
+	}
+	buf.WriteString(" FROM ")
+	buf.WriteString(r.Table)
+	buf.WriteString(" AS t0")
+	if fe.JoinAuthor != nil && fe.JoinAuthor.Kind.Actionable() {
+		joinClause(comp, fe.JoinAuthor.Kind, "charon.user AS t1 ON t0.created_by=t1.id")
+		if fe.JoinAuthor.On != nil {
+			comp.Dirty = true
+			if err := UserCriteriaWhereClause(comp, fe.JoinAuthor.On, 1); err != nil {
+				return "", nil, err
+			}
+		}
+	}
+	if fe.JoinModifier != nil && fe.JoinModifier.Kind.Actionable() {
+		joinClause(comp, fe.JoinModifier.Kind, "charon.user AS t2 ON t0.updated_by=t2.id")
+		if fe.JoinModifier.On != nil {
+			comp.Dirty = true
+			if err := UserCriteriaWhereClause(comp, fe.JoinModifier.On, 2); err != nil {
+				return "", nil, err
+			}
+		}
+	}
+	if comp.Dirty {
+		buf.ReadFrom(comp)
+		comp.Dirty = false
+	}
+	if fe.Where != nil {
+		if err := OauthClientCriteriaWhereClause(comp, fe.Where, 0); err != nil {
+			return "", nil, err
+		}
+	}
+	if fe.JoinAuthor != nil && fe.JoinAuthor.Kind.Actionable() && fe.JoinAuthor.Where != nil {
+		if err := UserCriteriaWhereClause(comp, fe.JoinAuthor.Where, 1); err != nil {
+			return "", nil, err
+		}
+	}
+	if fe.JoinModifier != nil && fe.JoinModifier.Kind.Actionable() && fe.JoinModifier.Where != nil {
+		if err := UserCriteriaWhereClause(comp, fe.JoinModifier.Where, 2); err != nil {
+			return "", nil, err
+		}
+	}
+	if comp.Dirty {
+		if _, err := buf.WriteString(" WHERE "); err != nil {
+			return "", nil, err
+		}
+		buf.ReadFrom(comp)
+	}
+
+	if len(fe.OrderBy) > 0 {
+		i := 0
+		for _, order := range fe.OrderBy {
+			for _, columnName := range TableOauthClientColumns {
+				if order.Name == columnName {
+					if i == 0 {
+						comp.WriteString(" ORDER BY ")
+					}
+					if i > 0 {
+						if _, err := comp.WriteString(", "); err != nil {
+							return "", nil, err
+						}
+					}
+					if _, err := comp.WriteString(order.Name); err != nil {
+						return "", nil, err
+					}
+					if order.Descending {
+						if _, err := comp.WriteString(" DESC"); err != nil {
+							return "", nil, err
+						}
+					}
+					i++
+					break
+				}
+			}
+		}
+	}
+	if fe.Offset > 0 {
+		if _, err := comp.WriteString(" OFFSET "); err != nil {
+			return "", nil, err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		if _, err := comp.WriteString(" "); err != nil {
+			return "", nil, err
+		}
+		comp.Add(fe.Offset)
+	}
+	if fe.Limit > 0 {
+		if _, err := comp.WriteString(" LIMIT "); err != nil {
+			return "", nil, err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		if _, err := comp.WriteString(" "); err != nil {
+			return "", nil, err
+		}
+		comp.Add(fe.Limit)
+	}
+
+	buf.ReadFrom(comp)
+
+	return buf.String(), comp.Args(), nil
+}
+
+func (r *OauthClientRepositoryBase) find(ctx context.Context, tx *sql.Tx, fe *OauthClientFindExpr) ([]*OauthClientEntity, error) {
+	query, args, err := r.FindQuery(fe)
+	if err != nil {
+		return nil, err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableOauthClient, "find", query, args...)
+		} else {
+			r.Log(err, TableOauthClient, "find tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		entities []*OauthClientEntity
+		props    []interface{}
+	)
+	for rows.Next() {
+		var ent OauthClientEntity
+		if props, err = ent.Props(); err != nil {
+			return nil, err
+		}
+		var prop []interface{}
+		if fe.JoinAuthor != nil && fe.JoinAuthor.Kind.Actionable() && fe.JoinAuthor.Fetch {
+			ent.Author = &UserEntity{}
+			if prop, err = ent.Author.Props(); err != nil {
+				return nil, err
+			}
+			props = append(props, prop...)
+		}
+		if fe.JoinModifier != nil && fe.JoinModifier.Kind.Actionable() && fe.JoinModifier.Fetch {
+			ent.Modifier = &UserEntity{}
+			if prop, err = ent.Modifier.Props(); err != nil {
+				return nil, err
+			}
+			props = append(props, prop...)
+		}
+		err = rows.Scan(props...)
+		if err != nil {
+			return nil, err
+		}
+
+		entities = append(entities, &ent)
+	}
+	err = rows.Err()
+	if r.Log != nil {
+		r.Log(err, TableOauthClient, "find", query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return entities, nil
+}
+
+func (r *OauthClientRepositoryBase) Find(ctx context.Context, fe *OauthClientFindExpr) ([]*OauthClientEntity, error) {
+	return r.find(ctx, nil, fe)
+}
+
+func (r *OauthClientRepositoryBase) findIter(ctx context.Context, tx *sql.Tx, fe *OauthClientFindExpr) (*OauthClientIterator, error) {
+	query, args, err := r.FindQuery(fe)
+	if err != nil {
+		return nil, err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableOauthClient, "find iter", query, args...)
+		} else {
+			r.Log(err, TableOauthClient, "find iter tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &OauthClientIterator{
+		rows: rows,
+		expr: fe,
+		cols: fe.Columns,
+	}, nil
+}
+
+func (r *OauthClientRepositoryBase) FindIter(ctx context.Context, fe *OauthClientFindExpr) (*OauthClientIterator, error) {
+	return r.findIter(ctx, nil, fe)
+}
+
+func (r *OauthClientRepositoryBase) findOneByID(ctx context.Context, tx *sql.Tx, pk int64) (*OauthClientEntity, error) {
+	find := NewComposer(9)
+	find.WriteString("SELECT ")
+	if len(r.Columns) == 0 {
+		find.WriteString("client_id, created_at, created_by, id, name, redirect_uris, secret, updated_at, updated_by")
+	} else {
+		find.WriteString(strings.Join(r.Columns, ", "))
+	}
+	find.WriteString(" FROM ")
+	find.WriteString(TableOauthClient)
+	find.WriteString(" WHERE ")
+	find.WriteString(TableOauthClientColumnID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(pk)
+	var (
+		ent OauthClientEntity
+	)
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
+	} else {
+		err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableOauthClient, "find by primary key", find.String(), find.Args()...)
+		} else {
+			r.Log(err, TableOauthClient, "find by primary key tx", find.String(), find.Args()...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ent, nil
+}
+
+func (r *OauthClientRepositoryBase) FindOneByID(ctx context.Context, pk int64) (*OauthClientEntity, error) {
+	return r.findOneByID(ctx, nil, pk)
+}
+
+func (r *OauthClientRepositoryBase) findOneByClientID(ctx context.Context, tx *sql.Tx, oauthClientClientID string) (*OauthClientEntity, error) {
+	find := NewComposer(9)
+	find.WriteString("SELECT ")
+	if len(r.Columns) == 0 {
+		find.WriteString("client_id, created_at, created_by, id, name, redirect_uris, secret, updated_at, updated_by")
+	} else {
+		find.WriteString(strings.Join(r.Columns, ", "))
+	}
+	find.WriteString(" FROM ")
+	find.WriteString(TableOauthClient)
+	find.WriteString(" WHERE ")
+	find.WriteString(TableOauthClientColumnClientID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(oauthClientClientID)
+
+	var (
+		ent OauthClientEntity
+	)
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
+	} else {
+		err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &ent, nil
+}
+
+func (r *OauthClientRepositoryBase) FindOneByClientID(ctx context.Context, oauthClientClientID string) (*OauthClientEntity, error) {
+	return r.findOneByClientID(ctx, nil, oauthClientClientID)
+}
+
+func (r *OauthClientRepositoryBase) UpdateOneByIDQuery(pk int64, p *OauthClientPatch) (string, []interface{}, error) {
+	buf := bytes.NewBufferString("UPDATE ")
+	buf.WriteString(r.Table)
+	update := NewComposer(9)
+	if p.ClientID.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthClientColumnClientID); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.ClientID)
+		update.Dirty = true
+	}
+
+	if p.CreatedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthClientColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.CreatedAt)
+		update.Dirty = true
+
+	}
+	if p.CreatedBy.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthClientColumnCreatedBy); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.CreatedBy)
+		update.Dirty = true
+	}
+
+	if p.Name.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthClientColumnName); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Name)
+		update.Dirty = true
+	}
+
+	if p.RedirectUris.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthClientColumnRedirectUris); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.RedirectUris)
+		update.Dirty = true
+	}
+
+	if p.Secret != nil {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthClientColumnSecret); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Secret)
+		update.Dirty = true
+
+	}
+	if p.UpdatedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthClientColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.UpdatedAt)
+		update.Dirty = true
+
+	} else {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthClientColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("=NOW()"); err != nil {
+			return "", nil, err
+		}
+		update.Dirty = true
+	}
+	if p.UpdatedBy.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthClientColumnUpdatedBy); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.UpdatedBy)
+		update.Dirty = true
+	}
+
+	if !update.Dirty {
+		return "", nil, errors.New("OauthClient update failure, nothing to update")
+	}
+	buf.WriteString(" SET ")
+	buf.ReadFrom(update)
+	buf.WriteString(" WHERE ")
+
+	update.WriteString(TableOauthClientColumnID)
+	update.WriteString("=")
+	update.WritePlaceholder()
+	update.Add(pk)
+
+	buf.ReadFrom(update)
+	buf.WriteString(" RETURNING ")
+	if len(r.Columns) > 0 {
+		buf.WriteString(strings.Join(r.Columns, ", "))
+	} else {
+		buf.WriteString("client_id, created_at, created_by, id, name, redirect_uris, secret, updated_at, updated_by")
+	}
+	return buf.String(), update.Args(), nil
+}
+
+func (r *OauthClientRepositoryBase) updateOneByID(ctx context.Context, tx *sql.Tx, pk int64, p *OauthClientPatch) (*OauthClientEntity, error) {
+	query, args, err := r.UpdateOneByIDQuery(pk, p)
+	if err != nil {
+		return nil, err
+	}
+	var ent OauthClientEntity
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, query, args...).Scan(props...)
+	} else {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(props...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableOauthClient, "update by primary key", query, args...)
+		} else {
+			r.Log(err, TableOauthClient, "update by primary key tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ent, nil
+}
+
+func (r *OauthClientRepositoryBase) UpdateOneByID(ctx context.Context, pk int64, p *OauthClientPatch) (*OauthClientEntity, error) {
+	return r.updateOneByID(ctx, nil, pk, p)
+}
+
+func (r *OauthClientRepositoryBase) FindOneByIDAndUpdate(ctx context.Context, pk int64, p *OauthClientPatch) (before, after *OauthClientEntity, err error) {
+	find := NewComposer(9)
+	find.WriteString("SELECT ")
+	if len(r.Columns) == 0 {
+		find.WriteString("client_id, created_at, created_by, id, name, redirect_uris, secret, updated_at, updated_by")
+	} else {
+		find.WriteString(strings.Join(r.Columns, ", "))
+	}
+	find.WriteString(" FROM ")
+	find.WriteString(TableOauthClient)
+	find.WriteString(" WHERE ")
+	find.WriteString(TableOauthClientColumnID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(pk)
+	find.WriteString(" FOR UPDATE")
+	query, args, err := r.UpdateOneByIDQuery(pk, p)
+	if err != nil {
+		return
+	}
+	var (
+		oldEnt, newEnt OauthClientEntity
+	)
+	oldProps, err := oldEnt.Props(r.Columns...)
+	if err != nil {
+		return
+	}
+	newProps, err := newEnt.Props(r.Columns...)
+	if err != nil {
+		return
+	}
+	tx, err := r.DB.Begin()
+	if err != nil {
+		return
+	}
+	err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(oldProps...)
+	if r.Log != nil {
+		r.Log(err, TableOauthClient, "find by primary key", find.String(), find.Args()...)
+	}
+	if err != nil {
+		tx.Rollback()
+		return
+	}
+	err = tx.QueryRowContext(ctx, query, args...).Scan(newProps...)
+	if r.Log != nil {
+		r.Log(err, TableOauthClient, "update by primary key", query, args...)
+	}
+	if err != nil {
+		tx.Rollback()
+		return
+	}
+	err = tx.Commit()
+	if err != nil {
+		return
+	}
+	return &oldEnt, &newEnt, nil
+}
+
+func (r *OauthClientRepositoryBase) UpdateOneByClientIDQuery(oauthClientClientID string, p *OauthClientPatch) (string, []interface{}, error) {
+	buf := bytes.NewBufferString("UPDATE ")
+	buf.WriteString(r.Table)
+	update := NewComposer(1)
+	if p.ClientID.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthClientColumnClientID); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.ClientID)
+		update.Dirty = true
+	}
+
+	if p.CreatedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthClientColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.CreatedAt)
+		update.Dirty = true
+
+	}
+	if p.CreatedBy.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthClientColumnCreatedBy); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.CreatedBy)
+		update.Dirty = true
+	}
+
+	if p.Name.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthClientColumnName); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Name)
+		update.Dirty = true
+	}
+
+	if p.RedirectUris.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthClientColumnRedirectUris); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.RedirectUris)
+		update.Dirty = true
+	}
+
+	if p.Secret != nil {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthClientColumnSecret); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Secret)
+		update.Dirty = true
+
+	}
+	if p.UpdatedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthClientColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.UpdatedAt)
+		update.Dirty = true
+
+	} else {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthClientColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("=NOW()"); err != nil {
+			return "", nil, err
+		}
+		update.Dirty = true
+	}
+	if p.UpdatedBy.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthClientColumnUpdatedBy); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.UpdatedBy)
+		update.Dirty = true
+	}
+
+	if !update.Dirty {
+		return "", nil, errors.New("oauth_client update failure, nothing to update")
+	}
+	buf.WriteString(" SET ")
+	buf.ReadFrom(update)
+	buf.WriteString(" WHERE ")
+	update.WriteString(TableOauthClientColumnClientID)
+	update.WriteString("=")
+	update.WritePlaceholder()
+	update.Add(oauthClientClientID)
+	buf.ReadFrom(update)
+	buf.WriteString(" RETURNING ")
+	if len(r.Columns) > 0 {
+		buf.WriteString(strings.Join(r.Columns, ", "))
+	} else {
+		buf.WriteString("client_id, created_at, created_by, id, name, redirect_uris, secret, updated_at, updated_by")
+	}
+	return buf.String(), update.Args(), nil
+}
+
+func (r *OauthClientRepositoryBase) updateOneByClientID(ctx context.Context, tx *sql.Tx, oauthClientClientID string, p *OauthClientPatch) (*OauthClientEntity, error) {
+	query, args, err := r.UpdateOneByClientIDQuery(oauthClientClientID, p)
+	if err != nil {
+		return nil, err
+	}
+	var ent OauthClientEntity
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+
+	var row *sql.Row
+	if tx == nil {
+		row = r.DB.QueryRowContext(ctx, query, args...)
+	} else {
+		row = tx.QueryRowContext(ctx, query, args...)
+	}
+	err = row.Scan(props...)
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableOauthClient, "update one by unique", query, args...)
+		} else {
+			r.Log(err, TableOauthClient, "update one by unique tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ent, nil
+}
+
+func (r *OauthClientRepositoryBase) UpdateOneByClientID(ctx context.Context, oauthClientClientID string, p *OauthClientPatch) (*OauthClientEntity, error) {
+	return r.updateOneByClientID(ctx, nil, oauthClientClientID, p)
+}
+
+func (r *OauthClientRepositoryBase) UpsertQuery(e *OauthClientEntity, p *OauthClientPatch, inf ...string) (string, []interface{}, error) {
+	upsert := NewComposer(18)
+	columns := bytes.NewBuffer(nil)
+	buf := bytes.NewBufferString("INSERT INTO ")
+	buf.WriteString(r.Table)
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableOauthClientColumnClientID); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.ClientID)
+	upsert.Dirty = true
+
+	if !e.CreatedAt.IsZero() {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableOauthClientColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.CreatedAt)
+		upsert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableOauthClientColumnCreatedBy); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.CreatedBy)
+	upsert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableOauthClientColumnName); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.Name)
+	upsert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableOauthClientColumnRedirectUris); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.RedirectUris)
+	upsert.Dirty = true
+
+	if e.Secret != nil {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableOauthClientColumnSecret); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.Secret)
+		upsert.Dirty = true
+	}
+
+	if e.UpdatedAt.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableOauthClientColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.UpdatedAt)
+		upsert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableOauthClientColumnUpdatedBy); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.UpdatedBy)
+	upsert.Dirty = true
+
+	if upsert.Dirty {
+		buf.WriteString(" (")
+		buf.ReadFrom(columns)
+		buf.WriteString(") VALUES (")
+		buf.ReadFrom(upsert)
+		buf.WriteString(")")
+	}
+	buf.WriteString(" ON CONFLICT ")
+	if len(inf) > 0 {
+		upsert.Dirty = false
+		if p.ClientID.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableOauthClientColumnClientID); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ClientID)
+			upsert.Dirty = true
+		}
+
+		if p.CreatedAt.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableOauthClientColumnCreatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.CreatedAt)
+			upsert.Dirty = true
+
+		}
+		if p.CreatedBy.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableOauthClientColumnCreatedBy); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.CreatedBy)
+			upsert.Dirty = true
+		}
+
+		if p.Name.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableOauthClientColumnName); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Name)
+			upsert.Dirty = true
+		}
+
+		if p.RedirectUris.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableOauthClientColumnRedirectUris); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.RedirectUris)
+			upsert.Dirty = true
+		}
+
+		if p.Secret != nil {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableOauthClientColumnSecret); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Secret)
+			upsert.Dirty = true
+
+		}
+		if p.UpdatedAt.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableOauthClientColumnUpdatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.UpdatedAt)
+			upsert.Dirty = true
+
+		} else {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableOauthClientColumnUpdatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("=NOW()"); err != nil {
+				return "", nil, err
+			}
+			upsert.Dirty = true
+		}
+		if p.UpdatedBy.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableOauthClientColumnUpdatedBy); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.UpdatedBy)
+			upsert.Dirty = true
+		}
+
+	}
+	if len(inf) > 0 && upsert.Dirty {
+		buf.WriteString("(")
+		for j, i := range inf {
+			if j != 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString(i)
+		}
+		buf.WriteString(")")
+		buf.WriteString(" DO UPDATE SET ")
+		buf.ReadFrom(upsert)
+	} else {
+		buf.WriteString(" DO NOTHING ")
+	}
+	if upsert.Dirty {
+		buf.WriteString(" RETURNING ")
+		if len(r.Columns) > 0 {
+			buf.WriteString(strings.Join(r.Columns, ", "))
+		} else {
+			buf.WriteString("client_id, created_at, created_by, id, name, redirect_uris, secret, updated_at, updated_by")
+		}
+	}
+	return buf.String(), upsert.Args(), nil
+}
+
+func (r *OauthClientRepositoryBase) upsert(ctx context.Context, tx *sql.Tx, e *OauthClientEntity, p *OauthClientPatch, inf ...string) (*OauthClientEntity, error) {
+	query, args, err := r.UpsertQuery(e, p, inf...)
+	if err != nil {
+		return nil, err
+	}
+
+	var row *sql.Row
+	if tx == nil {
+		row = r.DB.QueryRowContext(ctx, query, args...)
+	} else {
+		row = tx.QueryRowContext(ctx, query, args...)
+	}
+	err = row.Scan(
+		&e.ClientID,
+		&e.CreatedAt,
+		&e.CreatedBy,
+		&e.ID,
+		&e.Name,
+		&e.RedirectUris,
+		&e.Secret,
+		&e.UpdatedAt,
+		&e.UpdatedBy,
+	)
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableOauthClient, "upsert", query, args...)
+		} else {
+			r.Log(err, TableOauthClient, "upsert tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (r *OauthClientRepositoryBase) Upsert(ctx context.Context, e *OauthClientEntity, p *OauthClientPatch, inf ...string) (*OauthClientEntity, error) {
+	return r.upsert(ctx, nil, e, p, inf...)
+}
+
+func (r *OauthClientRepositoryBase) count(ctx context.Context, tx *sql.Tx, exp *OauthClientCountExpr) (int64, error) {
+	query, args, err := r.FindQuery(&OauthClientFindExpr{
+		Where:   exp.Where,
+		Columns: []string{"COUNT(*)"},
+
+		JoinAuthor:   exp.JoinAuthor,
+		JoinModifier: exp.JoinModifier,
+	})
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, query, args...).Scan(&count)
+	} else {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(&count)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableOauthClient, "count", query, args...)
+		} else {
+			r.Log(err, TableOauthClient, "count tx", query, args...)
+		}
+	}
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *OauthClientRepositoryBase) Count(ctx context.Context, exp *OauthClientCountExpr) (int64, error) {
+	return r.count(ctx, nil, exp)
+}
+
+func (r *OauthClientRepositoryBase) deleteOneByID(ctx context.Context, tx *sql.Tx, pk int64) (int64, error) {
+	find := NewComposer(9)
+	find.WriteString("DELETE FROM ")
+	find.WriteString(TableOauthClient)
+	find.WriteString(" WHERE ")
+	find.WriteString(TableOauthClientColumnID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(pk)
+	var (
+		err error
+		res sql.Result
+	)
+	if tx == nil {
+		res, err = r.DB.ExecContext(ctx, find.String(), find.Args()...)
+	} else {
+		res, err = tx.ExecContext(ctx, find.String(), find.Args()...)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+func (r *OauthClientRepositoryBase) DeleteOneByID(ctx context.Context, pk int64) (int64, error) {
+	return r.deleteOneByID(ctx, nil, pk)
+}
+
+type OauthClientRepositoryBaseTx struct {
+	base *OauthClientRepositoryBase
+	tx   *sql.Tx
+}
+
+func (r OauthClientRepositoryBaseTx) Commit() error {
+	return r.tx.Commit()
+}
+
+func (r OauthClientRepositoryBaseTx) Rollback() error {
+	return r.tx.Rollback()
+}
+
+func (r *OauthClientRepositoryBaseTx) Insert(ctx context.Context, e *OauthClientEntity) (*OauthClientEntity, error) {
+	return r.base.insert(ctx, r.tx, e)
+}
+
+func (r *OauthClientRepositoryBaseTx) Find(ctx context.Context, fe *OauthClientFindExpr) ([]*OauthClientEntity, error) {
+	return r.base.find(ctx, r.tx, fe)
+}
+
+func (r *OauthClientRepositoryBaseTx) FindIter(ctx context.Context, fe *OauthClientFindExpr) (*OauthClientIterator, error) {
+	return r.base.findIter(ctx, r.tx, fe)
+}
+
+func (r *OauthClientRepositoryBaseTx) FindOneByID(ctx context.Context, pk int64) (*OauthClientEntity, error) {
+	return r.base.findOneByID(ctx, r.tx, pk)
+}
+
+func (r *OauthClientRepositoryBaseTx) UpdateOneByID(ctx context.Context, pk int64, p *OauthClientPatch) (*OauthClientEntity, error) {
+	return r.base.updateOneByID(ctx, r.tx, pk, p)
+}
+
+func (r *OauthClientRepositoryBaseTx) UpdateOneByClientID(ctx context.Context, oauthClientClientID string, p *OauthClientPatch) (*OauthClientEntity, error) {
+	return r.base.updateOneByClientID(ctx, r.tx, oauthClientClientID, p)
+}
+
+func (r *OauthClientRepositoryBaseTx) Upsert(ctx context.Context, e *OauthClientEntity, p *OauthClientPatch, inf ...string) (*OauthClientEntity, error) {
+	return r.base.upsert(ctx, r.tx, e, p, inf...)
+}
+
+func (r *OauthClientRepositoryBaseTx) Count(ctx context.Context, exp *OauthClientCountExpr) (int64, error) {
+	return r.base.count(ctx, r.tx, exp)
+}
+
+func (r *OauthClientRepositoryBaseTx) DeleteOneByID(ctx context.Context, pk int64) (int64, error) {
+	return r.base.deleteOneByID(ctx, r.tx, pk)
+}
+
+const (
+	TableOauthConsentConstraintUserIDForeignKey     = "charon.oauth_consent_user_id_fkey"
+	TableOauthConsentConstraintClientIDForeignKey   = "charon.oauth_consent_client_id_fkey"
+	TableOauthConsentConstraintUserIDClientIDUnique = "charon.oauth_consent_user_id_client_id_key"
+	TableOauthConsentConstraintPrimaryKey           = "charon.oauth_consent_id_pkey"
+)
+
+const (
+	TableOauthConsent                = "charon.oauth_consent"
+	TableOauthConsentColumnClientID  = "client_id"
+	TableOauthConsentColumnCreatedAt = "created_at"
+	TableOauthConsentColumnID        = "id"
+	TableOauthConsentColumnScope     = "scope"
+	TableOauthConsentColumnUpdatedAt = "updated_at"
+	TableOauthConsentColumnUserID    = "user_id"
+)
+
+var TableOauthConsentColumns = []string{
+	TableOauthConsentColumnClientID,
+	TableOauthConsentColumnCreatedAt,
+	TableOauthConsentColumnID,
+	TableOauthConsentColumnScope,
+	TableOauthConsentColumnUpdatedAt,
+	TableOauthConsentColumnUserID,
+}
+
+// OauthConsentEntity ...
+type OauthConsentEntity struct {
+	// ClientID ...
+	ClientID int64
+	// CreatedAt ...
+	CreatedAt time.Time
+	// ID ...
+	ID int64
+	// Scope ...
+	Scope string
+	// UpdatedAt ...
+	UpdatedAt pq.NullTime
+	// UserID ...
+	UserID int64
+	// User ...
+	User *UserEntity
+	// OauthClient ...
+	OauthClient *OauthClientEntity
+}
+
+func (e *OauthConsentEntity) Prop(cn string) (interface{}, bool) {
+	switch cn {
+
+	case TableOauthConsentColumnClientID:
+		return &e.ClientID, true
+	case TableOauthConsentColumnCreatedAt:
+		return &e.CreatedAt, true
+	case TableOauthConsentColumnID:
+		return &e.ID, true
+	case TableOauthConsentColumnScope:
+		return &e.Scope, true
+	case TableOauthConsentColumnUpdatedAt:
+		return &e.UpdatedAt, true
+	case TableOauthConsentColumnUserID:
+		return &e.UserID, true
+	default:
+		return nil, false
+	}
+}
+
+func (e *OauthConsentEntity) Props(cns ...string) ([]interface{}, error) {
+	if len(cns) == 0 {
+		cns = TableOauthConsentColumns
+	}
+	res := make([]interface{}, 0, len(cns))
+	for _, cn := range cns {
+		if prop, ok := e.Prop(cn); ok {
+			res = append(res, prop)
+		} else {
+			return nil, fmt.Errorf("unexpected column provided: %s", cn)
+		}
+	}
+	return res, nil
+}
+
+// ScanOauthConsentRows helps to scan rows straight to the slice of entities.
+func ScanOauthConsentRows(rows Rows) (entities []*OauthConsentEntity, err error) {
+	for rows.Next() {
+		var ent OauthConsentEntity
+		err = rows.Scan(
+			&ent.ClientID,
+			&ent.CreatedAt,
+			&ent.ID,
+			&ent.Scope,
+			&ent.UpdatedAt,
+			&ent.UserID,
+		)
+		if err != nil {
+			return
+		}
+
+		entities = append(entities, &ent)
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	return
+}
+
+// OauthConsentIterator is not thread safe.
+type OauthConsentIterator struct {
+	rows Rows
+	cols []string
+	expr *OauthConsentFindExpr
+}
+
+func (i *OauthConsentIterator) Next() bool {
+	return i.rows.Next()
+}
+
+func (i *OauthConsentIterator) Close() error {
+	return i.rows.Close()
+}
+
+func (i *OauthConsentIterator) Err() error {
+	return i.rows.Err()
+}
+
+// Columns is wrapper around sql.Rows.Columns method, that also cache output inside iterator.
+func (i *OauthConsentIterator) Columns() ([]string, error) {
+	if i.cols == nil {
+		cols, err := i.rows.Columns()
+		if err != nil {
+			return nil, err
+		}
+		i.cols = cols
+	}
+	return i.cols, nil
+}
+
+// Ent is wrapper around OauthConsent method that makes iterator more generic.
+func (i *OauthConsentIterator) Ent() (interface{}, error) {
+	return i.OauthConsent()
+}
+
+func (i *OauthConsentIterator) OauthConsent() (*OauthConsentEntity, error) {
+	var ent OauthConsentEntity
+	cols, err := i.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	props, err := ent.Props(cols...)
+	if err != nil {
+		return nil, err
+	}
+	var prop []interface{}
+	if i.expr.JoinUser != nil && i.expr.JoinUser.Kind.Actionable() && i.expr.JoinUser.Fetch {
+		ent.User = &UserEntity{}
+		if prop, err = ent.User.Props(); err != nil {
+			return nil, err
+		}
+		props = append(props, prop...)
+	}
+	if i.expr.JoinOauthClient != nil && i.expr.JoinOauthClient.Kind.Actionable() && i.expr.JoinOauthClient.Fetch {
+		ent.OauthClient = &OauthClientEntity{}
+		if prop, err = ent.OauthClient.Props(); err != nil {
+			return nil, err
+		}
+		props = append(props, prop...)
+	}
+	if err := i.rows.Scan(props...); err != nil {
+		return nil, err
+	}
+	return &ent, nil
+}
+
+type OauthConsentCriteria struct {
+	ClientID               *qtypes.Int64
+	CreatedAt              *qtypes.Timestamp
+	ID                     *qtypes.Int64
+	Scope                  *qtypes.String
+	UpdatedAt              *qtypes.Timestamp
+	UserID                 *qtypes.Int64
+	operator               string
+	child, sibling, parent *OauthConsentCriteria
+}
+
+func OauthConsentOperand(operator string, operands ...*OauthConsentCriteria) *OauthConsentCriteria {
+	if len(operands) == 0 {
+		return &OauthConsentCriteria{operator: operator}
+	}
+
+	parent := &OauthConsentCriteria{
+		operator: operator,
+		child:    operands[0],
+	}
+
+	for i := 0; i < len(operands); i++ {
+		if i < len(operands)-1 {
+			operands[i].sibling = operands[i+1]
+		}
+		operands[i].parent = parent
+	}
+
+	return parent
+}
+
+func OauthConsentOr(operands ...*OauthConsentCriteria) *OauthConsentCriteria {
+	return OauthConsentOperand("OR", operands...)
+}
+
+func OauthConsentAnd(operands ...*OauthConsentCriteria) *OauthConsentCriteria {
+	return OauthConsentOperand("AND", operands...)
+}
+
+type OauthConsentFindExpr struct {
+	Where           *OauthConsentCriteria
+	Offset, Limit   int64
+	Columns         []string
+	OrderBy         []RowOrder
+	JoinUser        *UserJoin
+	JoinOauthClient *OauthClientJoin
+}
+
+type OauthConsentJoin struct {
+	On, Where       *OauthConsentCriteria
+	Fetch           bool
+	Kind            JoinType
+	JoinUser        *UserJoin
+	JoinOauthClient *OauthClientJoin
+}
+
+type OauthConsentCountExpr struct {
+	Where           *OauthConsentCriteria
+	JoinUser        *UserJoin
+	JoinOauthClient *OauthClientJoin
+}
+
+type OauthConsentPatch struct {
+	ClientID  ntypes.Int64
+	CreatedAt pq.NullTime
+	Scope     ntypes.String
+	UpdatedAt pq.NullTime
+	UserID    ntypes.Int64
+}
+
+type OauthConsentRepositoryBase struct {
+	Table   string
+	Columns []string
+	DB      *sql.DB
+	Log     LogFunc
+}
+
+func (r *OauthConsentRepositoryBase) Tx(tx *sql.Tx) (*OauthConsentRepositoryBaseTx, error) {
+	return &OauthConsentRepositoryBaseTx{
+		base: r,
+		tx:   tx,
+	}, nil
+}
+
+func (r *OauthConsentRepositoryBase) BeginTx(ctx context.Context) (*OauthConsentRepositoryBaseTx, error) {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return r.Tx(tx)
+}
+
+func (r OauthConsentRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *OauthConsentRepositoryBaseTx) error, attempts int) (err error) {
+	return RunInTransaction(ctx, r.DB, func(tx *sql.Tx) error {
+		rtx, err := r.Tx(tx)
+		if err != nil {
+			return err
+		}
+		return fn(rtx)
+	}, attempts)
+}
+
+func (r *OauthConsentRepositoryBase) InsertQuery(e *OauthConsentEntity, read bool) (string, []interface{}, error) {
+	insert := NewComposer(6)
+	columns := bytes.NewBuffer(nil)
+	buf := bytes.NewBufferString("INSERT INTO ")
+	buf.WriteString(r.Table)
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableOauthConsentColumnClientID); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.ClientID)
+	insert.Dirty = true
+
+	if !e.CreatedAt.IsZero() {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableOauthConsentColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.CreatedAt)
+		insert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableOauthConsentColumnScope); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.Scope)
+	insert.Dirty = true
+
+	if e.UpdatedAt.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableOauthConsentColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.UpdatedAt)
+		insert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableOauthConsentColumnUserID); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.UserID)
+	insert.Dirty = true
+
+	if columns.Len() > 0 {
+		buf.WriteString(" (")
+		buf.ReadFrom(columns)
+		buf.WriteString(") VALUES (")
+		buf.ReadFrom(insert)
+		buf.WriteString(") ")
+		if read {
+			buf.WriteString("RETURNING ")
+			if len(r.Columns) > 0 {
+				buf.WriteString(strings.Join(r.Columns, ", "))
+			} else {
+				buf.WriteString("client_id, created_at, id, scope, updated_at, user_id")
+			}
+		}
+	}
+	return buf.String(), insert.Args(), nil
+}
+
+func (r *OauthConsentRepositoryBase) insert(ctx context.Context, tx *sql.Tx, e *OauthConsentEntity) (*OauthConsentEntity, error) {
+	query, args, err := r.InsertQuery(e, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var row *sql.Row
+	if tx == nil {
+		row = r.DB.QueryRowContext(ctx, query, args...)
+	} else {
+		row = tx.QueryRowContext(ctx, query, args...)
+	}
+	err = row.Scan(
+		&e.ClientID,
+		&e.CreatedAt,
+		&e.ID,
+		&e.Scope,
+		&e.UpdatedAt,
+		&e.UserID,
+	)
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableOauthConsent, "insert", query, args...)
+		} else {
+			r.Log(err, TableOauthConsent, "insert tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (r *OauthConsentRepositoryBase) Insert(ctx context.Context, e *OauthConsentEntity) (*OauthConsentEntity, error) {
+	return r.insert(ctx, nil, e)
+}
+
+func OauthConsentCriteriaWhereClause(comp *Composer, c *OauthConsentCriteria, id int) error {
+	if c.child == nil {
+		return _OauthConsentCriteriaWhereClause(comp, c, id)
+	}
+	node := c
+	sibling := false
+	for {
+		if !sibling {
+			if node.child != nil {
+				if node.parent != nil {
+					comp.WriteString("(")
+				}
+				node = node.child
+				continue
+			} else {
+				comp.Dirty = false
+				comp.WriteString("(")
+				if err := _OauthConsentCriteriaWhereClause(comp, node, id); err != nil {
+					return err
+				}
+				comp.WriteString(")")
+			}
+		}
+		if node.sibling != nil {
+			sibling = false
+			comp.WriteString(" ")
+			comp.WriteString(node.parent.operator)
+			comp.WriteString(" ")
+			node = node.sibling
+			continue
+		}
+		if node.parent != nil {
+			sibling = true
+			if node.parent.parent != nil {
+				comp.WriteString(")")
+			}
+			node = node.parent
+			continue
+		}
+
+		break
+	}
+	return nil
+}
+
+func _OauthConsentCriteriaWhereClause(comp *Composer, c *OauthConsentCriteria, id int) error {
+	QueryInt64WhereClause(c.ClientID, id, TableOauthConsentColumnClientID, comp, And)
+
+	QueryTimestampWhereClause(c.CreatedAt, id, TableOauthConsentColumnCreatedAt, comp, And)
+
+	QueryInt64WhereClause(c.ID, id, TableOauthConsentColumnID, comp, And)
+
+	QueryStringWhereClause(c.Scope, id, TableOauthConsentColumnScope, comp, And)
+
+	QueryTimestampWhereClause(c.UpdatedAt, id, TableOauthConsentColumnUpdatedAt, comp, And)
+
+	QueryInt64WhereClause(c.UserID, id, TableOauthConsentColumnUserID, comp, And)
+
+	return nil
+}
+
+func (r *OauthConsentRepositoryBase) FindQuery(fe *OauthConsentFindExpr) (string, []interface{}, error) {
+	comp := NewComposer(6)
+	buf := bytes.NewBufferString("SELECT ")
+	if len(fe.Columns) == 0 {
+		buf.WriteString("t0.client_id, t0.created_at, t0.id, t0.scope, t0.updated_at, t0.user_id")
+	} else {
+		buf.WriteString(strings.Join(fe.Columns, ", "))
+	}
+	if fe.JoinUser != nil && fe.JoinUser.Kind.Actionable() && fe.JoinUser.Fetch {
+		buf.WriteString(", t1.confirmation_token, t1.confirmation_token_expire_at, t1.created_at, t1.created_by, t1.deleted_at, t1.first_name, t1.id, t1.is_active, t1.is_confirmed, t1.is_service_account, t1.is_staff, t1.is_superuser, t1.last_login_at, t1.last_name, t1.locked_at, t1.password, t1.password_reset_token, t1.password_reset_token_expire_at, t1.two_factor_challenge, t1.two_factor_challenge_expire_at, t1.two_factor_confirmed_at, t1.two_factor_last_step, t1.two_factor_recovery_codes, t1.two_factor_secret, t1.updated_at, t1.updated_by, t1.username")
+	}
+	if fe.JoinOauthClient != nil && fe.JoinOauthClient.Kind.Actionable() && fe.JoinOauthClient.Fetch {
+		buf.WriteString(", t2.client_id, t2.created_at, t2.created_by, t2.id, t2.name, t2.redirect_uris, t2.secret, t2.updated_at, t2.updated_by")
+	}
+	buf.WriteString(" FROM ")
+	buf.WriteString(r.Table)
+	buf.WriteString(" AS t0")
+	if fe.JoinUser != nil && fe.JoinUser.Kind.Actionable() {
+		joinClause(comp, fe.JoinUser.Kind, "charon.user AS t1 ON t0.user_id=t1.id")
+		if fe.JoinUser.On != nil {
+			comp.Dirty = true
+			if err := UserCriteriaWhereClause(comp, fe.JoinUser.On, 1); err != nil {
+				return "", nil, err
+			}
+		}
+	}
+	if fe.JoinOauthClient != nil && fe.JoinOauthClient.Kind.Actionable() {
+		joinClause(comp, fe.JoinOauthClient.Kind, "charon.oauth_client AS t2 ON t0.client_id=t2.id")
+		if fe.JoinOauthClient.On != nil {
+			comp.Dirty = true
+			if err := OauthClientCriteriaWhereClause(comp, fe.JoinOauthClient.On, 2); err != nil {
+				return "", nil, err
+			}
+		}
+	}
+	if comp.Dirty {
+		buf.ReadFrom(comp)
+		comp.Dirty = false
+	}
+	if fe.Where != nil {
+		if err := OauthConsentCriteriaWhereClause(comp, fe.Where, 0); err != nil {
+			return "", nil, err
+		}
+	}
+	if fe.JoinUser != nil && fe.JoinUser.Kind.Actionable() && fe.JoinUser.Where != nil {
+		if err := UserCriteriaWhereClause(comp, fe.JoinUser.Where, 1); err != nil {
+			return "", nil, err
+		}
+	}
+	if fe.JoinOauthClient != nil && fe.JoinOauthClient.Kind.Actionable() && fe.JoinOauthClient.Where != nil {
+		if err := OauthClientCriteriaWhereClause(comp, fe.JoinOauthClient.Where, 2); err != nil {
+			return "", nil, err
+		}
+	}
+	if comp.Dirty {
+		if _, err := buf.WriteString(" WHERE "); err != nil {
+			return "", nil, err
+		}
+		buf.ReadFrom(comp)
+	}
+
+	if len(fe.OrderBy) > 0 {
+		i := 0
+		for _, order := range fe.OrderBy {
+			for _, columnName := range TableOauthConsentColumns {
+				if order.Name == columnName {
+					if i == 0 {
+						comp.WriteString(" ORDER BY ")
+					}
+					if i > 0 {
+						if _, err := comp.WriteString(", "); err != nil {
+							return "", nil, err
+						}
+					}
+					if _, err := comp.WriteString(order.Name); err != nil {
+						return "", nil, err
+					}
+					if order.Descending {
+						if _, err := comp.WriteString(" DESC"); err != nil {
+							return "", nil, err
+						}
+					}
+					i++
+					break
+				}
+			}
+		}
+	}
+	if fe.Offset > 0 {
+		if _, err := comp.WriteString(" OFFSET "); err != nil {
+			return "", nil, err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		if _, err := comp.WriteString(" "); err != nil {
+			return "", nil, err
+		}
+		comp.Add(fe.Offset)
+	}
+	if fe.Limit > 0 {
+		if _, err := comp.WriteString(" LIMIT "); err != nil {
+			return "", nil, err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		if _, err := comp.WriteString(" "); err != nil {
+			return "", nil, err
+		}
+		comp.Add(fe.Limit)
+	}
+
+	buf.ReadFrom(comp)
+
+	return buf.String(), comp.Args(), nil
+}
+
+func (r *OauthConsentRepositoryBase) find(ctx context.Context, tx *sql.Tx, fe *OauthConsentFindExpr) ([]*OauthConsentEntity, error) {
+	query, args, err := r.FindQuery(fe)
+	if err != nil {
+		return nil, err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableOauthConsent, "find", query, args...)
+		} else {
+			r.Log(err, TableOauthConsent, "find tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		entities []*OauthConsentEntity
+		props    []interface{}
+	)
+	for rows.Next() {
+		var ent OauthConsentEntity
+		if props, err = ent.Props(); err != nil {
+			return nil, err
+		}
+		var prop []interface{}
+		if fe.JoinUser != nil && fe.JoinUser.Kind.Actionable() && fe.JoinUser.Fetch {
+			ent.User = &UserEntity{}
+			if prop, err = ent.User.Props(); err != nil {
+				return nil, err
+			}
+			props = append(props, prop...)
+		}
+		if fe.JoinOauthClient != nil && fe.JoinOauthClient.Kind.Actionable() && fe.JoinOauthClient.Fetch {
+			ent.OauthClient = &OauthClientEntity{}
+			if prop, err = ent.OauthClient.Props(); err != nil {
+				return nil, err
+			}
+			props = append(props, prop...)
+		}
+		err = rows.Scan(props...)
+		if err != nil {
+			return nil, err
+		}
+
+		entities = append(entities, &ent)
+	}
+	err = rows.Err()
+	if r.Log != nil {
+		r.Log(err, TableOauthConsent, "find", query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return entities, nil
+}
+
+func (r *OauthConsentRepositoryBase) Find(ctx context.Context, fe *OauthConsentFindExpr) ([]*OauthConsentEntity, error) {
+	return r.find(ctx, nil, fe)
+}
+
+func (r *OauthConsentRepositoryBase) findIter(ctx context.Context, tx *sql.Tx, fe *OauthConsentFindExpr) (*OauthConsentIterator, error) {
+	query, args, err := r.FindQuery(fe)
+	if err != nil {
+		return nil, err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableOauthConsent, "find iter", query, args...)
+		} else {
+			r.Log(err, TableOauthConsent, "find iter tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &OauthConsentIterator{
+		rows: rows,
+		expr: fe,
+		cols: fe.Columns,
+	}, nil
+}
+
+func (r *OauthConsentRepositoryBase) FindIter(ctx context.Context, fe *OauthConsentFindExpr) (*OauthConsentIterator, error) {
+	return r.findIter(ctx, nil, fe)
+}
+
+func (r *OauthConsentRepositoryBase) findOneByID(ctx context.Context, tx *sql.Tx, pk int64) (*OauthConsentEntity, error) {
+	find := NewComposer(6)
+	find.WriteString("SELECT ")
+	if len(r.Columns) == 0 {
+		find.WriteString("client_id, created_at, id, scope, updated_at, user_id")
+	} else {
+		find.WriteString(strings.Join(r.Columns, ", "))
+	}
+	find.WriteString(" FROM ")
+	find.WriteString(TableOauthConsent)
+	find.WriteString(" WHERE ")
+	find.WriteString(TableOauthConsentColumnID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(pk)
+	var (
+		ent OauthConsentEntity
+	)
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
+	} else {
+		err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableOauthConsent, "find by primary key", find.String(), find.Args()...)
+		} else {
+			r.Log(err, TableOauthConsent, "find by primary key tx", find.String(), find.Args()...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ent, nil
+}
+
+func (r *OauthConsentRepositoryBase) FindOneByID(ctx context.Context, pk int64) (*OauthConsentEntity, error) {
+	return r.findOneByID(ctx, nil, pk)
+}
+
+func (r *OauthConsentRepositoryBase) findOneByUserIDAndClientID(ctx context.Context, tx *sql.Tx, oauthConsentUserID int64, oauthConsentClientID int64) (*OauthConsentEntity, error) {
+	find := NewComposer(6)
+	find.WriteString("SELECT ")
+	if len(r.Columns) == 0 {
+		find.WriteString("client_id, created_at, id, scope, updated_at, user_id")
+	} else {
+		find.WriteString(strings.Join(r.Columns, ", "))
+	}
+	find.WriteString(" FROM ")
+	find.WriteString(TableOauthConsent)
+	find.WriteString(" WHERE ")
+	find.WriteString(TableOauthConsentColumnUserID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(oauthConsentUserID)
+	find.WriteString(" AND ")
+	find.WriteString(TableOauthConsentColumnClientID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(oauthConsentClientID)
+
+	var (
+		ent OauthConsentEntity
+	)
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
+	} else {
+		err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &ent, nil
+}
+
+func (r *OauthConsentRepositoryBase) FindOneByUserIDAndClientID(ctx context.Context, oauthConsentUserID int64, oauthConsentClientID int64) (*OauthConsentEntity, error) {
+	return r.findOneByUserIDAndClientID(ctx, nil, oauthConsentUserID, oauthConsentClientID)
+}
+
+func (r *OauthConsentRepositoryBase) UpdateOneByIDQuery(pk int64, p *OauthConsentPatch) (string, []interface{}, error) {
+	buf := bytes.NewBufferString("UPDATE ")
+	buf.WriteString(r.Table)
+	update := NewComposer(6)
+	if p.ClientID.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthConsentColumnClientID); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.ClientID)
+		update.Dirty = true
+	}
+
+	if p.CreatedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthConsentColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.CreatedAt)
+		update.Dirty = true
+
+	}
+	if p.Scope.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthConsentColumnScope); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Scope)
+		update.Dirty = true
+	}
+
+	if p.UpdatedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthConsentColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.UpdatedAt)
+		update.Dirty = true
+
+	} else {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthConsentColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("=NOW()"); err != nil {
+			return "", nil, err
+		}
+		update.Dirty = true
+	}
+	if p.UserID.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthConsentColumnUserID); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.UserID)
+		update.Dirty = true
+	}
+
+	if !update.Dirty {
+		return "", nil, errors.New("OauthConsent update failure, nothing to update")
+	}
+	buf.WriteString(" SET ")
+	buf.ReadFrom(update)
+	buf.WriteString(" WHERE ")
+
+	update.WriteString(TableOauthConsentColumnID)
+	update.WriteString("=")
+	update.WritePlaceholder()
+	update.Add(pk)
+
+	buf.ReadFrom(update)
+	buf.WriteString(" RETURNING ")
+	if len(r.Columns) > 0 {
+		buf.WriteString(strings.Join(r.Columns, ", "))
+	} else {
+		buf.WriteString("client_id, created_at, id, scope, updated_at, user_id")
+	}
+	return buf.String(), update.Args(), nil
+}
+
+func (r *OauthConsentRepositoryBase) updateOneByID(ctx context.Context, tx *sql.Tx, pk int64, p *OauthConsentPatch) (*OauthConsentEntity, error) {
+	query, args, err := r.UpdateOneByIDQuery(pk, p)
+	if err != nil {
+		return nil, err
+	}
+	var ent OauthConsentEntity
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, query, args...).Scan(props...)
+	} else {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(props...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableOauthConsent, "update by primary key", query, args...)
+		} else {
+			r.Log(err, TableOauthConsent, "update by primary key tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ent, nil
+}
+
+func (r *OauthConsentRepositoryBase) UpdateOneByID(ctx context.Context, pk int64, p *OauthConsentPatch) (*OauthConsentEntity, error) {
+	return r.updateOneByID(ctx, nil, pk, p)
+}
+
+func (r *OauthConsentRepositoryBase) FindOneByIDAndUpdate(ctx context.Context, pk int64, p *OauthConsentPatch) (before, after *OauthConsentEntity, err error) {
+	find := NewComposer(6)
+	find.WriteString("SELECT ")
+	if len(r.Columns) == 0 {
+		find.WriteString("client_id, created_at, id, scope, updated_at, user_id")
+	} else {
+		find.WriteString(strings.Join(r.Columns, ", "))
+	}
+	find.WriteString(" FROM ")
+	find.WriteString(TableOauthConsent)
+	find.WriteString(" WHERE ")
+	find.WriteString(TableOauthConsentColumnID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(pk)
+	find.WriteString(" FOR UPDATE")
+	query, args, err := r.UpdateOneByIDQuery(pk, p)
+	if err != nil {
+		return
+	}
+	var (
+		oldEnt, newEnt OauthConsentEntity
+	)
+	oldProps, err := oldEnt.Props(r.Columns...)
+	if err != nil {
+		return
+	}
+	newProps, err := newEnt.Props(r.Columns...)
+	if err != nil {
+		return
+	}
+	tx, err := r.DB.Begin()
+	if err != nil {
+		return
+	}
+	err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(oldProps...)
+	if r.Log != nil {
+		r.Log(err, TableOauthConsent, "find by primary key", find.String(), find.Args()...)
+	}
+	if err != nil {
+		tx.Rollback()
+		return
+	}
+	err = tx.QueryRowContext(ctx, query, args...).Scan(newProps...)
+	if r.Log != nil {
+		r.Log(err, TableOauthConsent, "update by primary key", query, args...)
+	}
+	if err != nil {
+		tx.Rollback()
+		return
+	}
+	err = tx.Commit()
+	if err != nil {
+		return
+	}
+	return &oldEnt, &newEnt, nil
+}
+
+func (r *OauthConsentRepositoryBase) UpdateOneByUserIDAndClientIDQuery(oauthConsentUserID int64, oauthConsentClientID int64, p *OauthConsentPatch) (string, []interface{}, error) {
+	buf := bytes.NewBufferString("UPDATE ")
+	buf.WriteString(r.Table)
+	update := NewComposer(2)
+	if p.ClientID.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthConsentColumnClientID); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.ClientID)
+		update.Dirty = true
+	}
+
+	if p.CreatedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthConsentColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.CreatedAt)
+		update.Dirty = true
+
+	}
+	if p.Scope.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthConsentColumnScope); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Scope)
+		update.Dirty = true
+	}
+
+	if p.UpdatedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthConsentColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.UpdatedAt)
+		update.Dirty = true
+
+	} else {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthConsentColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("=NOW()"); err != nil {
+			return "", nil, err
+		}
+		update.Dirty = true
+	}
+	if p.UserID.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthConsentColumnUserID); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.UserID)
+		update.Dirty = true
+	}
+
+	if !update.Dirty {
+		return "", nil, errors.New("oauth_consent update failure, nothing to update")
+	}
+	buf.WriteString(" SET ")
+	buf.ReadFrom(update)
+	buf.WriteString(" WHERE ")
+	update.WriteString(TableOauthConsentColumnUserID)
+	update.WriteString("=")
+	update.WritePlaceholder()
+	update.Add(oauthConsentUserID)
+	update.WriteString(" AND ")
+	update.WriteString(TableOauthConsentColumnClientID)
+	update.WriteString("=")
+	update.WritePlaceholder()
+	update.Add(oauthConsentClientID)
+	buf.ReadFrom(update)
+	buf.WriteString(" RETURNING ")
+	if len(r.Columns) > 0 {
+		buf.WriteString(strings.Join(r.Columns, ", "))
+	} else {
+		buf.WriteString("client_id, created_at, id, scope, updated_at, user_id")
+	}
+	return buf.String(), update.Args(), nil
+}
+
+func (r *OauthConsentRepositoryBase) updateOneByUserIDAndClientID(ctx context.Context, tx *sql.Tx, oauthConsentUserID int64, oauthConsentClientID int64, p *OauthConsentPatch) (*OauthConsentEntity, error) {
+	query, args, err := r.UpdateOneByUserIDAndClientIDQuery(oauthConsentUserID, oauthConsentClientID, p)
+	if err != nil {
+		return nil, err
+	}
+	var ent OauthConsentEntity
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+
+	var row *sql.Row
+	if tx == nil {
+		row = r.DB.QueryRowContext(ctx, query, args...)
+	} else {
+		row = tx.QueryRowContext(ctx, query, args...)
+	}
+	err = row.Scan(props...)
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableOauthConsent, "update one by unique", query, args...)
+		} else {
+			r.Log(err, TableOauthConsent, "update one by unique tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ent, nil
+}
+
+func (r *OauthConsentRepositoryBase) UpdateOneByUserIDAndClientID(ctx context.Context, oauthConsentUserID int64, oauthConsentClientID int64, p *OauthConsentPatch) (*OauthConsentEntity, error) {
+	return r.updateOneByUserIDAndClientID(ctx, nil, oauthConsentUserID, oauthConsentClientID, p)
+}
+
+func (r *OauthConsentRepositoryBase) UpsertQuery(e *OauthConsentEntity, p *OauthConsentPatch, inf ...string) (string, []interface{}, error) {
+	upsert := NewComposer(12)
+	columns := bytes.NewBuffer(nil)
+	buf := bytes.NewBufferString("INSERT INTO ")
+	buf.WriteString(r.Table)
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableOauthConsentColumnClientID); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.ClientID)
+	upsert.Dirty = true
+
+	if !e.CreatedAt.IsZero() {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableOauthConsentColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.CreatedAt)
+		upsert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableOauthConsentColumnScope); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.Scope)
+	upsert.Dirty = true
+
+	if e.UpdatedAt.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableOauthConsentColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.UpdatedAt)
+		upsert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableOauthConsentColumnUserID); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.UserID)
+	upsert.Dirty = true
+
+	if upsert.Dirty {
+		buf.WriteString(" (")
+		buf.ReadFrom(columns)
+		buf.WriteString(") VALUES (")
+		buf.ReadFrom(upsert)
+		buf.WriteString(")")
+	}
+	buf.WriteString(" ON CONFLICT ")
+	if len(inf) > 0 {
+		upsert.Dirty = false
+		if p.ClientID.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableOauthConsentColumnClientID); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ClientID)
+			upsert.Dirty = true
+		}
+
+		if p.CreatedAt.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableOauthConsentColumnCreatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.CreatedAt)
+			upsert.Dirty = true
+
+		}
+		if p.Scope.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableOauthConsentColumnScope); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Scope)
+			upsert.Dirty = true
+		}
+
+		if p.UpdatedAt.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableOauthConsentColumnUpdatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.UpdatedAt)
+			upsert.Dirty = true
+
+		} else {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableOauthConsentColumnUpdatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("=NOW()"); err != nil {
+				return "", nil, err
+			}
+			upsert.Dirty = true
+		}
+		if p.UserID.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableOauthConsentColumnUserID); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.UserID)
+			upsert.Dirty = true
+		}
+
+	}
+	if len(inf) > 0 && upsert.Dirty {
+		buf.WriteString("(")
+		for j, i := range inf {
+			if j != 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString(i)
+		}
+		buf.WriteString(")")
+		buf.WriteString(" DO UPDATE SET ")
+		buf.ReadFrom(upsert)
+	} else {
+		buf.WriteString(" DO NOTHING ")
+	}
+	if upsert.Dirty {
+		buf.WriteString(" RETURNING ")
+		if len(r.Columns) > 0 {
+			buf.WriteString(strings.Join(r.Columns, ", "))
+		} else {
+			buf.WriteString("client_id, created_at, id, scope, updated_at, user_id")
+		}
+	}
+	return buf.String(), upsert.Args(), nil
+}
+
+func (r *OauthConsentRepositoryBase) upsert(ctx context.Context, tx *sql.Tx, e *OauthConsentEntity, p *OauthConsentPatch, inf ...string) (*OauthConsentEntity, error) {
+	query, args, err := r.UpsertQuery(e, p, inf...)
+	if err != nil {
+		return nil, err
+	}
+
+	var row *sql.Row
+	if tx == nil {
+		row = r.DB.QueryRowContext(ctx, query, args...)
+	} else {
+		row = tx.QueryRowContext(ctx, query, args...)
+	}
+	err = row.Scan(
+		&e.ClientID,
+		&e.CreatedAt,
+		&e.ID,
+		&e.Scope,
+		&e.UpdatedAt,
+		&e.UserID,
+	)
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableOauthConsent, "upsert", query, args...)
+		} else {
+			r.Log(err, TableOauthConsent, "upsert tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (r *OauthConsentRepositoryBase) Upsert(ctx context.Context, e *OauthConsentEntity, p *OauthConsentPatch, inf ...string) (*OauthConsentEntity, error) {
+	return r.upsert(ctx, nil, e, p, inf...)
+}
+
+func (r *OauthConsentRepositoryBase) count(ctx context.Context, tx *sql.Tx, exp *OauthConsentCountExpr) (int64, error) {
+	query, args, err := r.FindQuery(&OauthConsentFindExpr{
+		Where:   exp.Where,
+		Columns: []string{"COUNT(*)"},
+
+		JoinUser:        exp.JoinUser,
+		JoinOauthClient: exp.JoinOauthClient,
+	})
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, query, args...).Scan(&count)
+	} else {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(&count)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableOauthConsent, "count", query, args...)
+		} else {
+			r.Log(err, TableOauthConsent, "count tx", query, args...)
+		}
+	}
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *OauthConsentRepositoryBase) Count(ctx context.Context, exp *OauthConsentCountExpr) (int64, error) {
+	return r.count(ctx, nil, exp)
+}
+
+func (r *OauthConsentRepositoryBase) deleteOneByID(ctx context.Context, tx *sql.Tx, pk int64) (int64, error) {
+	find := NewComposer(6)
+	find.WriteString("DELETE FROM ")
+	find.WriteString(TableOauthConsent)
+	find.WriteString(" WHERE ")
+	find.WriteString(TableOauthConsentColumnID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(pk)
+	var (
+		err error
+		res sql.Result
+	)
+	if tx == nil {
+		res, err = r.DB.ExecContext(ctx, find.String(), find.Args()...)
+	} else {
+		res, err = tx.ExecContext(ctx, find.String(), find.Args()...)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+func (r *OauthConsentRepositoryBase) DeleteOneByID(ctx context.Context, pk int64) (int64, error) {
+	return r.deleteOneByID(ctx, nil, pk)
+}
+
+type OauthConsentRepositoryBaseTx struct {
+	base *OauthConsentRepositoryBase
+	tx   *sql.Tx
+}
+
+func (r OauthConsentRepositoryBaseTx) Commit() error {
+	return r.tx.Commit()
+}
+
+func (r OauthConsentRepositoryBaseTx) Rollback() error {
+	return r.tx.Rollback()
+}
+
+func (r *OauthConsentRepositoryBaseTx) Insert(ctx context.Context, e *OauthConsentEntity) (*OauthConsentEntity, error) {
+	return r.base.insert(ctx, r.tx, e)
+}
+
+func (r *OauthConsentRepositoryBaseTx) Find(ctx context.Context, fe *OauthConsentFindExpr) ([]*OauthConsentEntity, error) {
+	return r.base.find(ctx, r.tx, fe)
+}
+
+func (r *OauthConsentRepositoryBaseTx) FindIter(ctx context.Context, fe *OauthConsentFindExpr) (*OauthConsentIterator, error) {
+	return r.base.findIter(ctx, r.tx, fe)
+}
+
+func (r *OauthConsentRepositoryBaseTx) FindOneByID(ctx context.Context, pk int64) (*OauthConsentEntity, error) {
+	return r.base.findOneByID(ctx, r.tx, pk)
+}
+
+func (r *OauthConsentRepositoryBaseTx) UpdateOneByID(ctx context.Context, pk int64, p *OauthConsentPatch) (*OauthConsentEntity, error) {
+	return r.base.updateOneByID(ctx, r.tx, pk, p)
+}
+
+func (r *OauthConsentRepositoryBaseTx) UpdateOneByUserIDAndClientID(ctx context.Context, oauthConsentUserID int64, oauthConsentClientID int64, p *OauthConsentPatch) (*OauthConsentEntity, error) {
+	return r.base.updateOneByUserIDAndClientID(ctx, r.tx, oauthConsentUserID, oauthConsentClientID, p)
+}
+
+func (r *OauthConsentRepositoryBaseTx) Upsert(ctx context.Context, e *OauthConsentEntity, p *OauthConsentPatch, inf ...string) (*OauthConsentEntity, error) {
+	return r.base.upsert(ctx, r.tx, e, p, inf...)
+}
+
+func (r *OauthConsentRepositoryBaseTx) Count(ctx context.Context, exp *OauthConsentCountExpr) (int64, error) {
+	return r.base.count(ctx, r.tx, exp)
+}
+
+func (r *OauthConsentRepositoryBaseTx) DeleteOneByID(ctx context.Context, pk int64) (int64, error) {
+	return r.base.deleteOneByID(ctx, r.tx, pk)
+}
+
+const (
+	TableOauthAuthorizationCodeConstraintCodeUnique         = "charon.oauth_authorization_code_code_key"
+	TableOauthAuthorizationCodeConstraintClientIDForeignKey = "charon.oauth_authorization_code_client_id_fkey"
+	TableOauthAuthorizationCodeConstraintUserIDForeignKey   = "charon.oauth_authorization_code_user_id_fkey"
+	TableOauthAuthorizationCodeConstraintPrimaryKey         = "charon.oauth_authorization_code_id_pkey"
+)
+
+const (
+	TableOauthAuthorizationCode                    = "charon.oauth_authorization_code"
+	TableOauthAuthorizationCodeColumnAuthTime      = "auth_time"
+	TableOauthAuthorizationCodeColumnClientID      = "client_id"
+	TableOauthAuthorizationCodeColumnCode          = "code"
+	TableOauthAuthorizationCodeColumnCodeChallenge = "code_challenge"
+	TableOauthAuthorizationCodeColumnCreatedAt     = "created_at"
+	TableOauthAuthorizationCodeColumnExpireAt      = "expire_at"
+	TableOauthAuthorizationCodeColumnID            = "id"
+	TableOauthAuthorizationCodeColumnNonce         = "nonce"
+	TableOauthAuthorizationCodeColumnRedirectURI   = "redirect_uri"
+	TableOauthAuthorizationCodeColumnScope         = "scope"
+	TableOauthAuthorizationCodeColumnUserID        = "user_id"
+)
+
+var TableOauthAuthorizationCodeColumns = []string{
+	TableOauthAuthorizationCodeColumnAuthTime,
+	TableOauthAuthorizationCodeColumnClientID,
+	TableOauthAuthorizationCodeColumnCode,
+	TableOauthAuthorizationCodeColumnCodeChallenge,
+	TableOauthAuthorizationCodeColumnCreatedAt,
+	TableOauthAuthorizationCodeColumnExpireAt,
+	TableOauthAuthorizationCodeColumnID,
+	TableOauthAuthorizationCodeColumnNonce,
+	TableOauthAuthorizationCodeColumnRedirectURI,
+	TableOauthAuthorizationCodeColumnScope,
+	TableOauthAuthorizationCodeColumnUserID,
+}
+
+// OauthAuthorizationCodeEntity ...
+type OauthAuthorizationCodeEntity struct {
+	// AuthTime ...
+	AuthTime time.Time
+	// ClientID ...
+	ClientID int64
+	// Code ...
+	Code string
+	// CodeChallenge ...
+	CodeChallenge string
+	// CreatedAt ...
+	CreatedAt time.Time
+	// ExpireAt ...
+	ExpireAt time.Time
+	// ID ...
+	ID int64
+	// Nonce ...
+	Nonce string
+	// RedirectURI ...
+	RedirectURI string
+	// Scope ...
+	Scope string
+	// UserID ...
+	UserID int64
+	// OauthClient ...
+	OauthClient *OauthClientEntity
+	// User ...
+	User *UserEntity
+}
+
+func (e *OauthAuthorizationCodeEntity) Prop(cn string) (interface{}, bool) {
+	switch cn {
+
+	case TableOauthAuthorizationCodeColumnAuthTime:
+		return &e.AuthTime, true
+	case TableOauthAuthorizationCodeColumnClientID:
+		return &e.ClientID, true
+	case TableOauthAuthorizationCodeColumnCode:
+		return &e.Code, true
+	case TableOauthAuthorizationCodeColumnCodeChallenge:
+		return &e.CodeChallenge, true
+	case TableOauthAuthorizationCodeColumnCreatedAt:
+		return &e.CreatedAt, true
+	case TableOauthAuthorizationCodeColumnExpireAt:
+		return &e.ExpireAt, true
+	case TableOauthAuthorizationCodeColumnID:
+		return &e.ID, true
+	case TableOauthAuthorizationCodeColumnNonce:
+		return &e.Nonce, true
+	case TableOauthAuthorizationCodeColumnRedirectURI:
+		return &e.RedirectURI, true
+	case TableOauthAuthorizationCodeColumnScope:
+		return &e.Scope, true
+	case TableOauthAuthorizationCodeColumnUserID:
+		return &e.UserID, true
+	default:
+		return nil, false
+	}
+}
+
+func (e *OauthAuthorizationCodeEntity) Props(cns ...string) ([]interface{}, error) {
+	if len(cns) == 0 {
+		cns = TableOauthAuthorizationCodeColumns
+	}
+	res := make([]interface{}, 0, len(cns))
+	for _, cn := range cns {
+		if prop, ok := e.Prop(cn); ok {
+			res = append(res, prop)
+		} else {
+			return nil, fmt.Errorf("unexpected column provided: %s", cn)
+		}
+	}
+	return res, nil
+}
+
+// ScanOauthAuthorizationCodeRows helps to scan rows straight to the slice of entities.
+func ScanOauthAuthorizationCodeRows(rows Rows) (entities []*OauthAuthorizationCodeEntity, err error) {
+	for rows.Next() {
+		var ent OauthAuthorizationCodeEntity
+		err = rows.Scan(
+			&ent.AuthTime,
+			&ent.ClientID,
+			&ent.Code,
+			&ent.CodeChallenge,
+			&ent.CreatedAt,
+			&ent.ExpireAt,
+			&ent.ID,
+			&ent.Nonce,
+			&ent.RedirectURI,
+			&ent.Scope,
+			&ent.UserID,
+		)
+		if err != nil {
+			return
+		}
+
+		entities = append(entities, &ent)
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	return
+}
+
+// OauthAuthorizationCodeIterator is not thread safe.
+type OauthAuthorizationCodeIterator struct {
+	rows Rows
+	cols []string
+	expr *OauthAuthorizationCodeFindExpr
+}
+
+func (i *OauthAuthorizationCodeIterator) Next() bool {
+	return i.rows.Next()
+}
+
+func (i *OauthAuthorizationCodeIterator) Close() error {
+	return i.rows.Close()
+}
+
+func (i *OauthAuthorizationCodeIterator) Err() error {
+	return i.rows.Err()
+}
+
+// Columns is wrapper around sql.Rows.Columns method, that also cache output inside iterator.
+func (i *OauthAuthorizationCodeIterator) Columns() ([]string, error) {
+	if i.cols == nil {
+		cols, err := i.rows.Columns()
+		if err != nil {
+			return nil, err
+		}
+		i.cols = cols
+	}
+	return i.cols, nil
+}
+
+// Ent is wrapper around OauthAuthorizationCode method that makes iterator more generic.
+func (i *OauthAuthorizationCodeIterator) Ent() (interface{}, error) {
+	return i.OauthAuthorizationCode()
+}
+
+func (i *OauthAuthorizationCodeIterator) OauthAuthorizationCode() (*OauthAuthorizationCodeEntity, error) {
+	var ent OauthAuthorizationCodeEntity
+	cols, err := i.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	props, err := ent.Props(cols...)
+	if err != nil {
+		return nil, err
+	}
+	var prop []interface{}
+	if i.expr.JoinOauthClient != nil && i.expr.JoinOauthClient.Kind.Actionable() && i.expr.JoinOauthClient.Fetch {
+		ent.OauthClient = &OauthClientEntity{}
+		if prop, err = ent.OauthClient.Props(); err != nil {
+			return nil, err
+		}
+		props = append(props, prop...)
+	}
+	if i.expr.JoinUser != nil && i.expr.JoinUser.Kind.Actionable() && i.expr.JoinUser.Fetch {
+		ent.User = &UserEntity{}
+		if prop, err = ent.User.Props(); err != nil {
+			return nil, err
+		}
+		props = append(props, prop...)
+	}
+	if err := i.rows.Scan(props...); err != nil {
+		return nil, err
+	}
+	return &ent, nil
+}
+
+type OauthAuthorizationCodeCriteria struct {
+	AuthTime               *qtypes.Timestamp
+	ClientID               *qtypes.Int64
+	Code                   *qtypes.String
+	CodeChallenge          *qtypes.String
+	CreatedAt              *qtypes.Timestamp
+	ExpireAt               *qtypes.Timestamp
+	ID                     *qtypes.Int64
+	Nonce                  *qtypes.String
+	RedirectURI            *qtypes.String
+	Scope                  *qtypes.String
+	UserID                 *qtypes.Int64
+	operator               string
+	child, sibling, parent *OauthAuthorizationCodeCriteria
+}
+
+func OauthAuthorizationCodeOperand(operator string, operands ...*OauthAuthorizationCodeCriteria) *OauthAuthorizationCodeCriteria {
+	if len(operands) == 0 {
+		return &OauthAuthorizationCodeCriteria{operator: operator}
+	}
+
+	parent := &OauthAuthorizationCodeCriteria{
+		operator: operator,
+		child:    operands[0],
+	}
+
+	for i := 0; i < len(operands); i++ {
+		if i < len(operands)-1 {
+			operands[i].sibling = operands[i+1]
+		}
+		operands[i].parent = parent
+	}
+
+	return parent
+}
+
+func OauthAuthorizationCodeOr(operands ...*OauthAuthorizationCodeCriteria) *OauthAuthorizationCodeCriteria {
+	return OauthAuthorizationCodeOperand("OR", operands...)
+}
+
+func OauthAuthorizationCodeAnd(operands ...*OauthAuthorizationCodeCriteria) *OauthAuthorizationCodeCriteria {
+	return OauthAuthorizationCodeOperand("AND", operands...)
+}
+
+type OauthAuthorizationCodeFindExpr struct {
+	Where           *OauthAuthorizationCodeCriteria
+	Offset, Limit   int64
+	Columns         []string
+	OrderBy         []RowOrder
+	JoinOauthClient *OauthClientJoin
+	JoinUser        *UserJoin
+}
+
+type OauthAuthorizationCodeJoin struct {
+	On, Where       *OauthAuthorizationCodeCriteria
+	Fetch           bool
+	Kind            JoinType
+	JoinOauthClient *OauthClientJoin
+	JoinUser        *UserJoin
+}
+
+type OauthAuthorizationCodeCountExpr struct {
+	Where           *OauthAuthorizationCodeCriteria
+	JoinOauthClient *OauthClientJoin
+	JoinUser        *UserJoin
+}
+
+type OauthAuthorizationCodePatch struct {
+	AuthTime      pq.NullTime
+	ClientID      ntypes.Int64
+	Code          ntypes.String
+	CodeChallenge ntypes.String
+	CreatedAt     pq.NullTime
+	ExpireAt      pq.NullTime
+	Nonce         ntypes.String
+	RedirectURI   ntypes.String
+	Scope         ntypes.String
+	UserID        ntypes.Int64
+}
+
+type OauthAuthorizationCodeRepositoryBase struct {
+	Table   string
+	Columns []string
+	DB      *sql.DB
+	Log     LogFunc
+}
+
+func (r *OauthAuthorizationCodeRepositoryBase) Tx(tx *sql.Tx) (*OauthAuthorizationCodeRepositoryBaseTx, error) {
+	return &OauthAuthorizationCodeRepositoryBaseTx{
+		base: r,
+		tx:   tx,
+	}, nil
+}
+
+func (r *OauthAuthorizationCodeRepositoryBase) BeginTx(ctx context.Context) (*OauthAuthorizationCodeRepositoryBaseTx, error) {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return r.Tx(tx)
+}
+
+func (r OauthAuthorizationCodeRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *OauthAuthorizationCodeRepositoryBaseTx) error, attempts int) (err error) {
+	return RunInTransaction(ctx, r.DB, func(tx *sql.Tx) error {
+		rtx, err := r.Tx(tx)
+		if err != nil {
+			return err
+		}
+		return fn(rtx)
+	}, attempts)
+}
+
+func (r *OauthAuthorizationCodeRepositoryBase) InsertQuery(e *OauthAuthorizationCodeEntity, read bool) (string, []interface{}, error) {
+	insert := NewComposer(11)
+	columns := bytes.NewBuffer(nil)
+	buf := bytes.NewBufferString("INSERT INTO ")
+	buf.WriteString(r.Table)
+
+	if !e.AuthTime.IsZero() {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableOauthAuthorizationCodeColumnAuthTime); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.AuthTime)
+		insert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableOauthAuthorizationCodeColumnClientID); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.ClientID)
+	insert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableOauthAuthorizationCodeColumnCode); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.Code)
+	insert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableOauthAuthorizationCodeColumnCodeChallenge); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.CodeChallenge)
+	insert.Dirty = true
+
+	if !e.CreatedAt.IsZero() {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableOauthAuthorizationCodeColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.CreatedAt)
+		insert.Dirty = true
+	}
+
+	if !e.ExpireAt.IsZero() {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableOauthAuthorizationCodeColumnExpireAt); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ExpireAt)
+		insert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableOauthAuthorizationCodeColumnNonce); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.Nonce)
+	insert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableOauthAuthorizationCodeColumnRedirectURI); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.RedirectURI)
+	insert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableOauthAuthorizationCodeColumnScope); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.Scope)
+	insert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableOauthAuthorizationCodeColumnUserID); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.UserID)
+	insert.Dirty = true
+
+	if columns.Len() > 0 {
+		buf.WriteString(" (")
+		buf.ReadFrom(columns)
+		buf.WriteString(") VALUES (")
+		buf.ReadFrom(insert)
+		buf.WriteString(") ")
+		if read {
+			buf.WriteString("RETURNING ")
+			if len(r.Columns) > 0 {
+				buf.WriteString(strings.Join(r.Columns, ", "))
+			} else {
+				buf.WriteString("auth_time, client_id, code, code_challenge, created_at, expire_at, id, nonce, redirect_uri, scope, user_id")
+			}
+		}
+	}
+	return buf.String(), insert.Args(), nil
+}
+
+func (r *OauthAuthorizationCodeRepositoryBase) insert(ctx context.Context, tx *sql.Tx, e *OauthAuthorizationCodeEntity) (*OauthAuthorizationCodeEntity, error) {
+	query, args, err := r.InsertQuery(e, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var row *sql.Row
+	if tx == nil {
+		row = r.DB.QueryRowContext(ctx, query, args...)
+	} else {
+		row = tx.QueryRowContext(ctx, query, args...)
+	}
+	err = row.Scan(
+		&e.AuthTime,
+		&e.ClientID,
+		&e.Code,
+		&e.CodeChallenge,
+		&e.CreatedAt,
+		&e.ExpireAt,
+		&e.ID,
+		&e.Nonce,
+		&e.RedirectURI,
+		&e.Scope,
+		&e.UserID,
+	)
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableOauthAuthorizationCode, "insert", query, args...)
+		} else {
+			r.Log(err, TableOauthAuthorizationCode, "insert tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (r *OauthAuthorizationCodeRepositoryBase) Insert(ctx context.Context, e *OauthAuthorizationCodeEntity) (*OauthAuthorizationCodeEntity, error) {
+	return r.insert(ctx, nil, e)
+}
+
+func OauthAuthorizationCodeCriteriaWhereClause(comp *Composer, c *OauthAuthorizationCodeCriteria, id int) error {
+	if c.child == nil {
+		return _OauthAuthorizationCodeCriteriaWhereClause(comp, c, id)
+	}
+	node := c
+	sibling := false
+	for {
+		if !sibling {
+			if node.child != nil {
+				if node.parent != nil {
+					comp.WriteString("(")
+				}
+				node = node.child
+				continue
+			} else {
+				comp.Dirty = false
+				comp.WriteString("(")
+				if err := _OauthAuthorizationCodeCriteriaWhereClause(comp, node, id); err != nil {
+					return err
+				}
+				comp.WriteString(")")
+			}
+		}
+		if node.sibling != nil {
+			sibling = false
+			comp.WriteString(" ")
+			comp.WriteString(node.parent.operator)
+			comp.WriteString(" ")
+			node = node.sibling
+			continue
+		}
+		if node.parent != nil {
+			sibling = true
+			if node.parent.parent != nil {
+				comp.WriteString(")")
+			}
+			node = node.parent
+			continue
+		}
+
+		break
+	}
+	return nil
+}
+
+func _OauthAuthorizationCodeCriteriaWhereClause(comp *Composer, c *OauthAuthorizationCodeCriteria, id int) error {
+	QueryTimestampWhereClause(c.AuthTime, id, TableOauthAuthorizationCodeColumnAuthTime, comp, And)
+
+	QueryInt64WhereClause(c.ClientID, id, TableOauthAuthorizationCodeColumnClientID, comp, And)
+
+	QueryStringWhereClause(c.Code, id, TableOauthAuthorizationCodeColumnCode, comp, And)
+
+	QueryStringWhereClause(c.CodeChallenge, id, TableOauthAuthorizationCodeColumnCodeChallenge, comp, And)
+
+	QueryTimestampWhereClause(c.CreatedAt, id, TableOauthAuthorizationCodeColumnCreatedAt, comp, And)
+
+	QueryTimestampWhereClause(c.ExpireAt, id, TableOauthAuthorizationCodeColumnExpireAt, comp, And)
+
+	QueryInt64WhereClause(c.ID, id, TableOauthAuthorizationCodeColumnID, comp, And)
+
+	QueryStringWhereClause(c.Nonce, id, TableOauthAuthorizationCodeColumnNonce, comp, And)
+
+	QueryStringWhereClause(c.RedirectURI, id, TableOauthAuthorizationCodeColumnRedirectURI, comp, And)
+
+	QueryStringWhereClause(c.Scope, id, TableOauthAuthorizationCodeColumnScope, comp, And)
+
+	QueryInt64WhereClause(c.UserID, id, TableOauthAuthorizationCodeColumnUserID, comp, And)
+
+	return nil
+}
+
+func (r *OauthAuthorizationCodeRepositoryBase) FindQuery(fe *OauthAuthorizationCodeFindExpr) (string, []interface{}, error) {
+	comp := NewComposer(11)
+	buf := bytes.NewBufferString("SELECT ")
+	if len(fe.Columns) == 0 {
+		buf.WriteString("t0.auth_time, t0.client_id, t0.code, t0.code_challenge, t0.created_at, t0.expire_at, t0.id, t0.nonce, t0.redirect_uri, t0.scope, t0.user_id")
+	} else {
+		buf.WriteString(strings.Join(fe.Columns, ", "))
+	}
+	if fe.JoinOauthClient != nil && fe.JoinOauthClient.Kind.Actionable() && fe.JoinOauthClient.Fetch {
+		buf.WriteString(", t1.client_id, t1.created_at, t1.created_by, t1.id, t1.name, t1.redirect_uris, t1.secret, t1.updated_at, t1.updated_by")
+	}
+	if fe.JoinUser != nil && fe.JoinUser.Kind.Actionable() && fe.JoinUser.Fetch {
+		buf.WriteString(", t2.confirmation_token, t2.confirmation_token_expire_at, t2.created_at, t2.created_by, t2.deleted_at, t2.first_name, t2.id, t2.is_active, t2.is_confirmed, t2.is_service_account, t2.is_staff, t2.is_superuser, t2.last_login_at, t2.last_name, t2.locked_at, t2.password, t2.password_reset_token, t2.password_reset_token_expire_at, t2.two_factor_challenge, t2.two_factor_challenge_expire_at, t2.two_factor_confirmed_at, t2.two_factor_last_step, t2.two_factor_recovery_codes, t2.two_factor_secret, t2.updated_at, t2.updated_by, t2.username")
+	}
+	buf.WriteString(" FROM ")
+	buf.WriteString(r.Table)
+	buf.WriteString(" AS t0")
+	if fe.JoinOauthClient != nil && fe.JoinOauthClient.Kind.Actionable() {
+		joinClause(comp, fe.JoinOauthClient.Kind, "charon.oauth_client AS t1 ON t0.client_id=t1.id")
+		if fe.JoinOauthClient.On != nil {
+			comp.Dirty = true
+			if err := OauthClientCriteriaWhereClause(comp, fe.JoinOauthClient.On, 1); err != nil {
+				return "", nil, err
+			}
+		}
+	}
+	if fe.JoinUser != nil && fe.JoinUser.Kind.Actionable() {
+		joinClause(comp, fe.JoinUser.Kind, "charon.user AS t2 ON t0.user_id=t2.id")
+		if fe.JoinUser.On != nil {
+			comp.Dirty = true
+			if err := UserCriteriaWhereClause(comp, fe.JoinUser.On, 2); err != nil {
+				return "", nil, err
+			}
+		}
+	}
+	if comp.Dirty {
+		buf.ReadFrom(comp)
+		comp.Dirty = false
+	}
+	if fe.Where != nil {
+		if err := OauthAuthorizationCodeCriteriaWhereClause(comp, fe.Where, 0); err != nil {
+			return "", nil, err
+		}
+	}
+	if fe.JoinOauthClient != nil && fe.JoinOauthClient.Kind.Actionable() && fe.JoinOauthClient.Where != nil {
+		if err := OauthClientCriteriaWhereClause(comp, fe.JoinOauthClient.Where, 1); err != nil {
+			return "", nil, err
+		}
+	}
+	if fe.JoinUser != nil && fe.JoinUser.Kind.Actionable() && fe.JoinUser.Where != nil {
+		if err := UserCriteriaWhereClause(comp, fe.JoinUser.Where, 2); err != nil {
+			return "", nil, err
+		}
+	}
+	if comp.Dirty {
+		if _, err := buf.WriteString(" WHERE "); err != nil {
+			return "", nil, err
+		}
+		buf.ReadFrom(comp)
+	}
+
+	if len(fe.OrderBy) > 0 {
+		i := 0
+		for _, order := range fe.OrderBy {
+			for _, columnName := range TableOauthAuthorizationCodeColumns {
+				if order.Name == columnName {
+					if i == 0 {
+						comp.WriteString(" ORDER BY ")
+					}
+					if i > 0 {
+						if _, err := comp.WriteString(", "); err != nil {
+							return "", nil, err
+						}
+					}
+					if _, err := comp.WriteString(order.Name); err != nil {
+						return "", nil, err
+					}
+					if order.Descending {
+						if _, err := comp.WriteString(" DESC"); err != nil {
+							return "", nil, err
+						}
+					}
+					i++
+					break
+				}
+			}
+		}
+	}
+	if fe.Offset > 0 {
+		if _, err := comp.WriteString(" OFFSET "); err != nil {
+			return "", nil, err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		if _, err := comp.WriteString(" "); err != nil {
+			return "", nil, err
+		}
+		comp.Add(fe.Offset)
+	}
+	if fe.Limit > 0 {
+		if _, err := comp.WriteString(" LIMIT "); err != nil {
+			return "", nil, err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		if _, err := comp.WriteString(" "); err != nil {
+			return "", nil, err
+		}
+		comp.Add(fe.Limit)
+	}
+
+	buf.ReadFrom(comp)
+
+	return buf.String(), comp.Args(), nil
+}
+
+func (r *OauthAuthorizationCodeRepositoryBase) find(ctx context.Context, tx *sql.Tx, fe *OauthAuthorizationCodeFindExpr) ([]*OauthAuthorizationCodeEntity, error) {
+	query, args, err := r.FindQuery(fe)
+	if err != nil {
+		return nil, err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableOauthAuthorizationCode, "find", query, args...)
+		} else {
+			r.Log(err, TableOauthAuthorizationCode, "find tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		entities []*OauthAuthorizationCodeEntity
+		props    []interface{}
+	)
+	for rows.Next() {
+		var ent OauthAuthorizationCodeEntity
+		if props, err = ent.Props(); err != nil {
+			return nil, err
+		}
+		var prop []interface{}
+		if fe.JoinOauthClient != nil && fe.JoinOauthClient.Kind.Actionable() && fe.JoinOauthClient.Fetch {
+			ent.OauthClient = &OauthClientEntity{}
+			if prop, err = ent.OauthClient.Props(); err != nil {
+				return nil, err
+			}
+			props = append(props, prop...)
+		}
+		if fe.JoinUser != nil && fe.JoinUser.Kind.Actionable() && fe.JoinUser.Fetch {
+			ent.User = &UserEntity{}
+			if prop, err = ent.User.Props(); err != nil {
+				return nil, err
+			}
+			props = append(props, prop...)
+		}
+		err = rows.Scan(props...)
+		if err != nil {
+			return nil, err
+		}
+
+		entities = append(entities, &ent)
+	}
+	err = rows.Err()
+	if r.Log != nil {
+		r.Log(err, TableOauthAuthorizationCode, "find", query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return entities, nil
+}
+
+func (r *OauthAuthorizationCodeRepositoryBase) Find(ctx context.Context, fe *OauthAuthorizationCodeFindExpr) ([]*OauthAuthorizationCodeEntity, error) {
+	return r.find(ctx, nil, fe)
+}
+
+func (r *OauthAuthorizationCodeRepositoryBase) findIter(ctx context.Context, tx *sql.Tx, fe *OauthAuthorizationCodeFindExpr) (*OauthAuthorizationCodeIterator, error) {
+	query, args, err := r.FindQuery(fe)
+	if err != nil {
+		return nil, err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableOauthAuthorizationCode, "find iter", query, args...)
+		} else {
+			r.Log(err, TableOauthAuthorizationCode, "find iter tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &OauthAuthorizationCodeIterator{
+		rows: rows,
+		expr: fe,
+		cols: fe.Columns,
+	}, nil
+}
+
+func (r *OauthAuthorizationCodeRepositoryBase) FindIter(ctx context.Context, fe *OauthAuthorizationCodeFindExpr) (*OauthAuthorizationCodeIterator, error) {
+	return r.findIter(ctx, nil, fe)
+}
+
+func (r *OauthAuthorizationCodeRepositoryBase) findOneByID(ctx context.Context, tx *sql.Tx, pk int64) (*OauthAuthorizationCodeEntity, error) {
+	find := NewComposer(11)
+	find.WriteString("SELECT ")
+	if len(r.Columns) == 0 {
+		find.WriteString("auth_time, client_id, code, code_challenge, created_at, expire_at, id, nonce, redirect_uri, scope, user_id")
+	} else {
+		find.WriteString(strings.Join(r.Columns, ", "))
+	}
+	find.WriteString(" FROM ")
+	find.WriteString(TableOauthAuthorizationCode)
+	find.WriteString(" WHERE ")
+	find.WriteString(TableOauthAuthorizationCodeColumnID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(pk)
+	var (
+		ent OauthAuthorizationCodeEntity
+	)
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
+	} else {
+		err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableOauthAuthorizationCode, "find by primary key", find.String(), find.Args()...)
+		} else {
+			r.Log(err, TableOauthAuthorizationCode, "find by primary key tx", find.String(), find.Args()...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ent, nil
+}
+
+func (r *OauthAuthorizationCodeRepositoryBase) FindOneByID(ctx context.Context, pk int64) (*OauthAuthorizationCodeEntity, error) {
+	return r.findOneByID(ctx, nil, pk)
+}
+
+func (r *OauthAuthorizationCodeRepositoryBase) findOneByCode(ctx context.Context, tx *sql.Tx, oauthAuthorizationCodeCode string) (*OauthAuthorizationCodeEntity, error) {
+	find := NewComposer(11)
+	find.WriteString("SELECT ")
+	if len(r.Columns) == 0 {
+		find.WriteString("auth_time, client_id, code, code_challenge, created_at, expire_at, id, nonce, redirect_uri, scope, user_id")
+	} else {
+		find.WriteString(strings.Join(r.Columns, ", "))
+	}
+	find.WriteString(" FROM ")
+	find.WriteString(TableOauthAuthorizationCode)
+	find.WriteString(" WHERE ")
+	find.WriteString(TableOauthAuthorizationCodeColumnCode)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(oauthAuthorizationCodeCode)
+
+	var (
+		ent OauthAuthorizationCodeEntity
+	)
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
+	} else {
+		err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &ent, nil
+}
+
+func (r *OauthAuthorizationCodeRepositoryBase) FindOneByCode(ctx context.Context, oauthAuthorizationCodeCode string) (*OauthAuthorizationCodeEntity, error) {
+	return r.findOneByCode(ctx, nil, oauthAuthorizationCodeCode)
+}
+
+func (r *OauthAuthorizationCodeRepositoryBase) UpdateOneByIDQuery(pk int64, p *OauthAuthorizationCodePatch) (string, []interface{}, error) {
+	buf := bytes.NewBufferString("UPDATE ")
+	buf.WriteString(r.Table)
+	update := NewComposer(11)
+	if p.AuthTime.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthAuthorizationCodeColumnAuthTime); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.AuthTime)
+		update.Dirty = true
+
+	}
+	if p.ClientID.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthAuthorizationCodeColumnClientID); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.ClientID)
+		update.Dirty = true
+	}
+
+	if p.Code.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthAuthorizationCodeColumnCode); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Code)
+		update.Dirty = true
+	}
+
+	if p.CodeChallenge.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthAuthorizationCodeColumnCodeChallenge); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.CodeChallenge)
+		update.Dirty = true
+	}
+
+	if p.CreatedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthAuthorizationCodeColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.CreatedAt)
+		update.Dirty = true
+
+	}
+	if p.ExpireAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthAuthorizationCodeColumnExpireAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.ExpireAt)
+		update.Dirty = true
+
+	}
+	if p.Nonce.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthAuthorizationCodeColumnNonce); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Nonce)
+		update.Dirty = true
+	}
+
+	if p.RedirectURI.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthAuthorizationCodeColumnRedirectURI); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.RedirectURI)
+		update.Dirty = true
+	}
+
+	if p.Scope.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthAuthorizationCodeColumnScope); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Scope)
+		update.Dirty = true
+	}
+
+	if p.UserID.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthAuthorizationCodeColumnUserID); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.UserID)
+		update.Dirty = true
+	}
+
+	if !update.Dirty {
+		return "", nil, errors.New("OauthAuthorizationCode update failure, nothing to update")
+	}
+	buf.WriteString(" SET ")
+	buf.ReadFrom(update)
+	buf.WriteString(" WHERE ")
+
+	update.WriteString(TableOauthAuthorizationCodeColumnID)
+	update.WriteString("=")
+	update.WritePlaceholder()
+	update.Add(pk)
+
+	buf.ReadFrom(update)
+	buf.WriteString(" RETURNING ")
+	if len(r.Columns) > 0 {
+		buf.WriteString(strings.Join(r.Columns, ", "))
+	} else {
+		buf.WriteString("auth_time, client_id, code, code_challenge, created_at, expire_at, id, nonce, redirect_uri, scope, user_id")
+	}
+	return buf.String(), update.Args(), nil
+}
+
+func (r *OauthAuthorizationCodeRepositoryBase) updateOneByID(ctx context.Context, tx *sql.Tx, pk int64, p *OauthAuthorizationCodePatch) (*OauthAuthorizationCodeEntity, error) {
+	query, args, err := r.UpdateOneByIDQuery(pk, p)
+	if err != nil {
+		return nil, err
+	}
+	var ent OauthAuthorizationCodeEntity
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, query, args...).Scan(props...)
+	} else {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(props...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableOauthAuthorizationCode, "update by primary key", query, args...)
+		} else {
+			r.Log(err, TableOauthAuthorizationCode, "update by primary key tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ent, nil
+}
+
+func (r *OauthAuthorizationCodeRepositoryBase) UpdateOneByID(ctx context.Context, pk int64, p *OauthAuthorizationCodePatch) (*OauthAuthorizationCodeEntity, error) {
+	return r.updateOneByID(ctx, nil, pk, p)
+}
+
+func (r *OauthAuthorizationCodeRepositoryBase) FindOneByIDAndUpdate(ctx context.Context, pk int64, p *OauthAuthorizationCodePatch) (before, after *OauthAuthorizationCodeEntity, err error) {
+	find := NewComposer(11)
+	find.WriteString("SELECT ")
+	if len(r.Columns) == 0 {
+		find.WriteString("auth_time, client_id, code, code_challenge, created_at, expire_at, id, nonce, redirect_uri, scope, user_id")
+	} else {
+		find.WriteString(strings.Join(r.Columns, ", "))
+	}
+	find.WriteString(" FROM ")
+	find.WriteString(TableOauthAuthorizationCode)
+	find.WriteString(" WHERE ")
+	find.WriteString(TableOauthAuthorizationCodeColumnID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(pk)
+	find.WriteString(" FOR UPDATE")
+	query, args, err := r.UpdateOneByIDQuery(pk, p)
+	if err != nil {
+		return
+	}
+	var (
+		oldEnt, newEnt OauthAuthorizationCodeEntity
+	)
+	oldProps, err := oldEnt.Props(r.Columns...)
+	if err != nil {
+		return
+	}
+	newProps, err := newEnt.Props(r.Columns...)
+	if err != nil {
+		return
+	}
+	tx, err := r.DB.Begin()
+	if err != nil {
+		return
+	}
+	err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(oldProps...)
+	if r.Log != nil {
+		r.Log(err, TableOauthAuthorizationCode, "find by primary key", find.String(), find.Args()...)
+	}
+	if err != nil {
+		tx.Rollback()
+		return
+	}
+	err = tx.QueryRowContext(ctx, query, args...).Scan(newProps...)
+	if r.Log != nil {
+		r.Log(err, TableOauthAuthorizationCode, "update by primary key", query, args...)
+	}
+	if err != nil {
+		tx.Rollback()
+		return
+	}
+	err = tx.Commit()
+	if err != nil {
+		return
+	}
+	return &oldEnt, &newEnt, nil
+}
+
+func (r *OauthAuthorizationCodeRepositoryBase) UpdateOneByCodeQuery(oauthAuthorizationCodeCode string, p *OauthAuthorizationCodePatch) (string, []interface{}, error) {
+	buf := bytes.NewBufferString("UPDATE ")
+	buf.WriteString(r.Table)
+	update := NewComposer(1)
+	if p.AuthTime.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthAuthorizationCodeColumnAuthTime); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.AuthTime)
+		update.Dirty = true
+
+	}
+	if p.ClientID.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthAuthorizationCodeColumnClientID); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.ClientID)
+		update.Dirty = true
+	}
+
+	if p.Code.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthAuthorizationCodeColumnCode); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Code)
+		update.Dirty = true
+	}
+
+	if p.CodeChallenge.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthAuthorizationCodeColumnCodeChallenge); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.CodeChallenge)
+		update.Dirty = true
+	}
+
+	if p.CreatedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthAuthorizationCodeColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.CreatedAt)
+		update.Dirty = true
+
+	}
+	if p.ExpireAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthAuthorizationCodeColumnExpireAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.ExpireAt)
+		update.Dirty = true
+
+	}
+	if p.Nonce.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthAuthorizationCodeColumnNonce); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Nonce)
+		update.Dirty = true
+	}
+
+	if p.RedirectURI.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthAuthorizationCodeColumnRedirectURI); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.RedirectURI)
+		update.Dirty = true
+	}
+
+	if p.Scope.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthAuthorizationCodeColumnScope); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Scope)
+		update.Dirty = true
+	}
+
+	if p.UserID.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableOauthAuthorizationCodeColumnUserID); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.UserID)
+		update.Dirty = true
+	}
+
+	if !update.Dirty {
+		return "", nil, errors.New("oauth_authorization_code update failure, nothing to update")
+	}
+	buf.WriteString(" SET ")
+	buf.ReadFrom(update)
+	buf.WriteString(" WHERE ")
+	update.WriteString(TableOauthAuthorizationCodeColumnCode)
+	update.WriteString("=")
+	update.WritePlaceholder()
+	update.Add(oauthAuthorizationCodeCode)
+	buf.ReadFrom(update)
+	buf.WriteString(" RETURNING ")
+	if len(r.Columns) > 0 {
+		buf.WriteString(strings.Join(r.Columns, ", "))
+	} else {
+		buf.WriteString("auth_time, client_id, code, code_challenge, created_at, expire_at, id, nonce, redirect_uri, scope, user_id")
+	}
+	return buf.String(), update.Args(), nil
+}
+
+func (r *OauthAuthorizationCodeRepositoryBase) updateOneByCode(ctx context.Context, tx *sql.Tx, oauthAuthorizationCodeCode string, p *OauthAuthorizationCodePatch) (*OauthAuthorizationCodeEntity, error) {
+	query, args, err := r.UpdateOneByCodeQuery(oauthAuthorizationCodeCode, p)
+	if err != nil {
+		return nil, err
+	}
+	var ent OauthAuthorizationCodeEntity
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+
+	var row *sql.Row
+	if tx == nil {
+		row = r.DB.QueryRowContext(ctx, query, args...)
+	} else {
+		row = tx.QueryRowContext(ctx, query, args...)
+	}
+	err = row.Scan(props...)
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableOauthAuthorizationCode, "update one by unique", query, args...)
+		} else {
+			r.Log(err, TableOauthAuthorizationCode, "update one by unique tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ent, nil
+}
+
+func (r *OauthAuthorizationCodeRepositoryBase) UpdateOneByCode(ctx context.Context, oauthAuthorizationCodeCode string, p *OauthAuthorizationCodePatch) (*OauthAuthorizationCodeEntity, error) {
+	return r.updateOneByCode(ctx, nil, oauthAuthorizationCodeCode, p)
+}
+
+func (r *OauthAuthorizationCodeRepositoryBase) UpsertQuery(e *OauthAuthorizationCodeEntity, p *OauthAuthorizationCodePatch, inf ...string) (string, []interface{}, error) {
+	upsert := NewComposer(22)
+	columns := bytes.NewBuffer(nil)
+	buf := bytes.NewBufferString("INSERT INTO ")
+	buf.WriteString(r.Table)
+
+	if !e.AuthTime.IsZero() {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableOauthAuthorizationCodeColumnAuthTime); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.AuthTime)
+		upsert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableOauthAuthorizationCodeColumnClientID); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.ClientID)
+	upsert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableOauthAuthorizationCodeColumnCode); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.Code)
+	upsert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableOauthAuthorizationCodeColumnCodeChallenge); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.CodeChallenge)
+	upsert.Dirty = true
+
+	if !e.CreatedAt.IsZero() {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableOauthAuthorizationCodeColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.CreatedAt)
+		upsert.Dirty = true
+	}
+
+	if !e.ExpireAt.IsZero() {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableOauthAuthorizationCodeColumnExpireAt); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ExpireAt)
+		upsert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableOauthAuthorizationCodeColumnNonce); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.Nonce)
+	upsert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableOauthAuthorizationCodeColumnRedirectURI); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.RedirectURI)
+	upsert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableOauthAuthorizationCodeColumnScope); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.Scope)
+	upsert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableOauthAuthorizationCodeColumnUserID); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.UserID)
+	upsert.Dirty = true
+
+	if upsert.Dirty {
+		buf.WriteString(" (")
+		buf.ReadFrom(columns)
+		buf.WriteString(") VALUES (")
+		buf.ReadFrom(upsert)
+		buf.WriteString(")")
+	}
+	buf.WriteString(" ON CONFLICT ")
+	if len(inf) > 0 {
+		upsert.Dirty = false
+		if p.AuthTime.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableOauthAuthorizationCodeColumnAuthTime); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.AuthTime)
+			upsert.Dirty = true
+
+		}
+		if p.ClientID.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableOauthAuthorizationCodeColumnClientID); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ClientID)
+			upsert.Dirty = true
+		}
+
+		if p.Code.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableOauthAuthorizationCodeColumnCode); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Code)
+			upsert.Dirty = true
+		}
+
+		if p.CodeChallenge.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableOauthAuthorizationCodeColumnCodeChallenge); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.CodeChallenge)
+			upsert.Dirty = true
+		}
+
+		if p.CreatedAt.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableOauthAuthorizationCodeColumnCreatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.CreatedAt)
+			upsert.Dirty = true
+
+		}
+		if p.ExpireAt.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableOauthAuthorizationCodeColumnExpireAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ExpireAt)
+			upsert.Dirty = true
+
+		}
+		if p.Nonce.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableOauthAuthorizationCodeColumnNonce); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Nonce)
+			upsert.Dirty = true
+		}
+
+		if p.RedirectURI.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableOauthAuthorizationCodeColumnRedirectURI); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.RedirectURI)
+			upsert.Dirty = true
+		}
+
+		if p.Scope.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableOauthAuthorizationCodeColumnScope); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Scope)
+			upsert.Dirty = true
+		}
+
+		if p.UserID.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableOauthAuthorizationCodeColumnUserID); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.UserID)
+			upsert.Dirty = true
+		}
+
+	}
+	if len(inf) > 0 && upsert.Dirty {
+		buf.WriteString("(")
+		for j, i := range inf {
+			if j != 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString(i)
+		}
+		buf.WriteString(")")
+		buf.WriteString(" DO UPDATE SET ")
+		buf.ReadFrom(upsert)
+	} else {
+		buf.WriteString(" DO NOTHING ")
+	}
+	if upsert.Dirty {
+		buf.WriteString(" RETURNING ")
+		if len(r.Columns) > 0 {
+			buf.WriteString(strings.Join(r.Columns, ", "))
+		} else {
+			buf.WriteString("auth_time, client_id, code, code_challenge, created_at, expire_at, id, nonce, redirect_uri, scope, user_id")
+		}
+	}
+	return buf.String(), upsert.Args(), nil
+}
+
+func (r *OauthAuthorizationCodeRepositoryBase) upsert(ctx context.Context, tx *sql.Tx, e *OauthAuthorizationCodeEntity, p *OauthAuthorizationCodePatch, inf ...string) (*OauthAuthorizationCodeEntity, error) {
+	query, args, err := r.UpsertQuery(e, p, inf...)
+	if err != nil {
+		return nil, err
+	}
+
+	var row *sql.Row
+	if tx == nil {
+		row = r.DB.QueryRowContext(ctx, query, args...)
+	} else {
+		row = tx.QueryRowContext(ctx, query, args...)
+	}
+	err = row.Scan(
+		&e.AuthTime,
+		&e.ClientID,
+		&e.Code,
+		&e.CodeChallenge,
+		&e.CreatedAt,
+		&e.ExpireAt,
+		&e.ID,
+		&e.Nonce,
+		&e.RedirectURI,
+		&e.Scope,
+		&e.UserID,
+	)
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableOauthAuthorizationCode, "upsert", query, args...)
+		} else {
+			r.Log(err, TableOauthAuthorizationCode, "upsert tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (r *OauthAuthorizationCodeRepositoryBase) Upsert(ctx context.Context, e *OauthAuthorizationCodeEntity, p *OauthAuthorizationCodePatch, inf ...string) (*OauthAuthorizationCodeEntity, error) {
+	return r.upsert(ctx, nil, e, p, inf...)
+}
+
+func (r *OauthAuthorizationCodeRepositoryBase) count(ctx context.Context, tx *sql.Tx, exp *OauthAuthorizationCodeCountExpr) (int64, error) {
+	query, args, err := r.FindQuery(&OauthAuthorizationCodeFindExpr{
+		Where:   exp.Where,
+		Columns: []string{"COUNT(*)"},
+
+		JoinOauthClient: exp.JoinOauthClient,
+		JoinUser:        exp.JoinUser,
+	})
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, query, args...).Scan(&count)
+	} else {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(&count)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableOauthAuthorizationCode, "count", query, args...)
+		} else {
+			r.Log(err, TableOauthAuthorizationCode, "count tx", query, args...)
+		}
+	}
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *OauthAuthorizationCodeRepositoryBase) Count(ctx context.Context, exp *OauthAuthorizationCodeCountExpr) (int64, error) {
+	return r.count(ctx, nil, exp)
+}
+
+func (r *OauthAuthorizationCodeRepositoryBase) deleteOneByID(ctx context.Context, tx *sql.Tx, pk int64) (int64, error) {
+	find := NewComposer(11)
+	find.WriteString("DELETE FROM ")
+	find.WriteString(TableOauthAuthorizationCode)
+	find.WriteString(" WHERE ")
+	find.WriteString(TableOauthAuthorizationCodeColumnID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(pk)
+	var (
+		err error
+		res sql.Result
+	)
+	if tx == nil {
+		res, err = r.DB.ExecContext(ctx, find.String(), find.Args()...)
+	} else {
+		res, err = tx.ExecContext(ctx, find.String(), find.Args()...)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+func (r *OauthAuthorizationCodeRepositoryBase) DeleteOneByID(ctx context.Context, pk int64) (int64, error) {
+	return r.deleteOneByID(ctx, nil, pk)
+}
+
+type OauthAuthorizationCodeRepositoryBaseTx struct {
+	base *OauthAuthorizationCodeRepositoryBase
+	tx   *sql.Tx
+}
+
+func (r OauthAuthorizationCodeRepositoryBaseTx) Commit() error {
+	return r.tx.Commit()
+}
+
+func (r OauthAuthorizationCodeRepositoryBaseTx) Rollback() error {
+	return r.tx.Rollback()
+}
+
+func (r *OauthAuthorizationCodeRepositoryBaseTx) Insert(ctx context.Context, e *OauthAuthorizationCodeEntity) (*OauthAuthorizationCodeEntity, error) {
+	return r.base.insert(ctx, r.tx, e)
+}
+
+func (r *OauthAuthorizationCodeRepositoryBaseTx) Find(ctx context.Context, fe *OauthAuthorizationCodeFindExpr) ([]*OauthAuthorizationCodeEntity, error) {
+	return r.base.find(ctx, r.tx, fe)
+}
+
+func (r *OauthAuthorizationCodeRepositoryBaseTx) FindIter(ctx context.Context, fe *OauthAuthorizationCodeFindExpr) (*OauthAuthorizationCodeIterator, error) {
+	return r.base.findIter(ctx, r.tx, fe)
+}
+
+func (r *OauthAuthorizationCodeRepositoryBaseTx) FindOneByID(ctx context.Context, pk int64) (*OauthAuthorizationCodeEntity, error) {
+	return r.base.findOneByID(ctx, r.tx, pk)
+}
+
+func (r *OauthAuthorizationCodeRepositoryBaseTx) UpdateOneByID(ctx context.Context, pk int64, p *OauthAuthorizationCodePatch) (*OauthAuthorizationCodeEntity, error) {
+	return r.base.updateOneByID(ctx, r.tx, pk, p)
+}
+
+func (r *OauthAuthorizationCodeRepositoryBaseTx) UpdateOneByCode(ctx context.Context, oauthAuthorizationCodeCode string, p *OauthAuthorizationCodePatch) (*OauthAuthorizationCodeEntity, error) {
+	return r.base.updateOneByCode(ctx, r.tx, oauthAuthorizationCodeCode, p)
+}
+
+func (r *OauthAuthorizationCodeRepositoryBaseTx) Upsert(ctx context.Context, e *OauthAuthorizationCodeEntity, p *OauthAuthorizationCodePatch, inf ...string) (*OauthAuthorizationCodeEntity, error) {
+	return r.base.upsert(ctx, r.tx, e, p, inf...)
+}
+
+func (r *OauthAuthorizationCodeRepositoryBaseTx) Count(ctx context.Context, exp *OauthAuthorizationCodeCountExpr) (int64, error) {
+	return r.base.count(ctx, r.tx, exp)
+}
+
+func (r *OauthAuthorizationCodeRepositoryBaseTx) DeleteOneByID(ctx context.Context, pk int64) (int64, error) {
+	return r.base.deleteOneByID(ctx, r.tx, pk)
+}
+
+const (
+	TableUserIdentityConstraintUserIDForeignKey    = "charon.user_identity_user_id_fkey"
+	TableUserIdentityConstraintIssuerSubjectUnique = "charon.user_identity_issuer_subject_key"
+	TableUserIdentityConstraintPrimaryKey          = "charon.user_identity_id_pkey"
+)
+
+const (
+	TableUserIdentity                = "charon.user_identity"
+	TableUserIdentityColumnCreatedAt = "created_at"
+	TableUserIdentityColumnID        = "id"
+	TableUserIdentityColumnIssuer    = "issuer"
+	TableUserIdentityColumnSubject   = "subject"
+	TableUserIdentityColumnUpdatedAt = "updated_at"
+	TableUserIdentityColumnUserID    = "user_id"
+)
+
+var TableUserIdentityColumns = []string{
+	TableUserIdentityColumnCreatedAt,
+	TableUserIdentityColumnID,
+	TableUserIdentityColumnIssuer,
+	TableUserIdentityColumnSubject,
+	TableUserIdentityColumnUpdatedAt,
+	TableUserIdentityColumnUserID,
+}
+
+// UserIdentityEntity ...
+type UserIdentityEntity struct {
+	// CreatedAt ...
+	CreatedAt time.Time
+	// ID ...
+	ID int64
+	// Issuer ...
+	Issuer string
+	// Subject ...
+	Subject string
+	// UpdatedAt ...
+	UpdatedAt pq.NullTime
+	// UserID ...
+	UserID int64
+	// User ...
+	User *UserEntity
+}
+
+func (e *UserIdentityEntity) Prop(cn string) (interface{}, bool) {
+	switch cn {
+
+	case TableUserIdentityColumnCreatedAt:
+		return &e.CreatedAt, true
+	case TableUserIdentityColumnID:
+		return &e.ID, true
+	case TableUserIdentityColumnIssuer:
+		return &e.Issuer, true
+	case TableUserIdentityColumnSubject:
+		return &e.Subject, true
+	case TableUserIdentityColumnUpdatedAt:
+		return &e.UpdatedAt, true
+	case TableUserIdentityColumnUserID:
+		return &e.UserID, true
+	default:
+		return nil, false
+	}
+}
+
+func (e *UserIdentityEntity) Props(cns ...string) ([]interface{}, error) {
+	if len(cns) == 0 {
+		cns = TableUserIdentityColumns
+	}
+	res := make([]interface{}, 0, len(cns))
+	for _, cn := range cns {
+		if prop, ok := e.Prop(cn); ok {
+			res = append(res, prop)
+		} else {
+			return nil, fmt.Errorf("unexpected column provided: %s", cn)
+		}
+	}
+	return res, nil
+}
+
+// ScanUserIdentityRows helps to scan rows straight to the slice of entities.
+func ScanUserIdentityRows(rows Rows) (entities []*UserIdentityEntity, err error) {
+	for rows.Next() {
+		var ent UserIdentityEntity
+		err = rows.Scan(
+			&ent.CreatedAt,
+			&ent.ID,
+			&ent.Issuer,
+			&ent.Subject,
+			&ent.UpdatedAt,
+			&ent.UserID,
+		)
+		if err != nil {
+			return
+		}
+
+		entities = append(entities, &ent)
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	return
+}
+
+// UserIdentityIterator is not thread safe.
+type UserIdentityIterator struct {
+	rows Rows
+	cols []string
+	expr *UserIdentityFindExpr
+}
+
+func (i *UserIdentityIterator) Next() bool {
+	return i.rows.Next()
+}
+
+func (i *UserIdentityIterator) Close() error {
+	return i.rows.Close()
+}
+
+func (i *UserIdentityIterator) Err() error {
+	return i.rows.Err()
+}
+
+// Columns is wrapper around sql.Rows.Columns method, that also cache output inside iterator.
+func (i *UserIdentityIterator) Columns() ([]string, error) {
+	if i.cols == nil {
+		cols, err := i.rows.Columns()
+		if err != nil {
+			return nil, err
+		}
+		i.cols = cols
+	}
+	return i.cols, nil
+}
+
+// Ent is wrapper around UserIdentity method that makes iterator more generic.
+func (i *UserIdentityIterator) Ent() (interface{}, error) {
+	return i.UserIdentity()
+}
+
+func (i *UserIdentityIterator) UserIdentity() (*UserIdentityEntity, error) {
+	var ent UserIdentityEntity
+	cols, err := i.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	props, err := ent.Props(cols...)
+	if err != nil {
+		return nil, err
+	}
+	var prop []interface{}
+	if i.expr.JoinUser != nil && i.expr.JoinUser.Kind.Actionable() && i.expr.JoinUser.Fetch {
+		ent.User = &UserEntity{}
+		if prop, err = ent.User.Props(); err != nil {
+			return nil, err
+		}
+		props = append(props, prop...)
+	}
+	if err := i.rows.Scan(props...); err != nil {
+		return nil, err
+	}
+	return &ent, nil
+}
+
+type UserIdentityCriteria struct {
+	CreatedAt              *qtypes.Timestamp
+	ID                     *qtypes.Int64
+	Issuer                 *qtypes.String
+	Subject                *qtypes.String
+	UpdatedAt              *qtypes.Timestamp
+	UserID                 *qtypes.Int64
+	operator               string
+	child, sibling, parent *UserIdentityCriteria
+}
+
+func UserIdentityOperand(operator string, operands ...*UserIdentityCriteria) *UserIdentityCriteria {
+	if len(operands) == 0 {
+		return &UserIdentityCriteria{operator: operator}
+	}
+
+	parent := &UserIdentityCriteria{
+		operator: operator,
+		child:    operands[0],
+	}
+
+	for i := 0; i < len(operands); i++ {
+		if i < len(operands)-1 {
+			operands[i].sibling = operands[i+1]
+		}
+		operands[i].parent = parent
+	}
+
+	return parent
+}
+
+func UserIdentityOr(operands ...*UserIdentityCriteria) *UserIdentityCriteria {
+	return UserIdentityOperand("OR", operands...)
+}
+
+func UserIdentityAnd(operands ...*UserIdentityCriteria) *UserIdentityCriteria {
+	return UserIdentityOperand("AND", operands...)
+}
+
+type UserIdentityFindExpr struct {
+	Where         *UserIdentityCriteria
+	Offset, Limit int64
+	Columns       []string
+	OrderBy       []RowOrder
+	JoinUser      *UserJoin
+}
+
+type UserIdentityJoin struct {
+	On, Where *UserIdentityCriteria
+	Fetch     bool
+	Kind      JoinType
+	JoinUser  *UserJoin
+}
+
+type UserIdentityCountExpr struct {
+	Where    *UserIdentityCriteria
+	JoinUser *UserJoin
+}
+
+type UserIdentityPatch struct {
+	CreatedAt pq.NullTime
+	Issuer    ntypes.String
+	Subject   ntypes.String
+	UpdatedAt pq.NullTime
+	UserID    ntypes.Int64
+}
+
+type UserIdentityRepositoryBase struct {
+	Table   string
+	Columns []string
+	DB      *sql.DB
+	Log     LogFunc
+}
+
+func (r *UserIdentityRepositoryBase) Tx(tx *sql.Tx) (*UserIdentityRepositoryBaseTx, error) {
+	return &UserIdentityRepositoryBaseTx{
+		base: r,
+		tx:   tx,
+	}, nil
+}
+
+func (r *UserIdentityRepositoryBase) BeginTx(ctx context.Context) (*UserIdentityRepositoryBaseTx, error) {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return r.Tx(tx)
+}
+
+func (r UserIdentityRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *UserIdentityRepositoryBaseTx) error, attempts int) (err error) {
+	return RunInTransaction(ctx, r.DB, func(tx *sql.Tx) error {
+		rtx, err := r.Tx(tx)
+		if err != nil {
+			return err
+		}
+		return fn(rtx)
+	}, attempts)
+}
+
+func (r *UserIdentityRepositoryBase) InsertQuery(e *UserIdentityEntity, read bool) (string, []interface{}, error) {
+	insert := NewComposer(6)
+	columns := bytes.NewBuffer(nil)
+	buf := bytes.NewBufferString("INSERT INTO ")
+	buf.WriteString(r.Table)
+
+	if !e.CreatedAt.IsZero() {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableUserIdentityColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.CreatedAt)
+		insert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableUserIdentityColumnIssuer); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.Issuer)
+	insert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableUserIdentityColumnSubject); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.Subject)
+	insert.Dirty = true
+
+	if e.UpdatedAt.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableUserIdentityColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.UpdatedAt)
+		insert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableUserIdentityColumnUserID); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.UserID)
+	insert.Dirty = true
+
+	if columns.Len() > 0 {
+		buf.WriteString(" (")
+		buf.ReadFrom(columns)
+		buf.WriteString(") VALUES (")
+		buf.ReadFrom(insert)
+		buf.WriteString(") ")
+		if read {
+			buf.WriteString("RETURNING ")
+			if len(r.Columns) > 0 {
+				buf.WriteString(strings.Join(r.Columns, ", "))
+			} else {
+				buf.WriteString("created_at, id, issuer, subject, updated_at, user_id")
+			}
+		}
+	}
+	return buf.String(), insert.Args(), nil
+}
+
+func (r *UserIdentityRepositoryBase) insert(ctx context.Context, tx *sql.Tx, e *UserIdentityEntity) (*UserIdentityEntity, error) {
+	query, args, err := r.InsertQuery(e, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var row *sql.Row
+	if tx == nil {
+		row = r.DB.QueryRowContext(ctx, query, args...)
+	} else {
+		row = tx.QueryRowContext(ctx, query, args...)
+	}
+	err = row.Scan(
+		&e.CreatedAt,
+		&e.ID,
+		&e.Issuer,
+		&e.Subject,
+		&e.UpdatedAt,
+		&e.UserID,
+	)
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableUserIdentity, "insert", query, args...)
+		} else {
+			r.Log(err, TableUserIdentity, "insert tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (r *UserIdentityRepositoryBase) Insert(ctx context.Context, e *UserIdentityEntity) (*UserIdentityEntity, error) {
+	return r.insert(ctx, nil, e)
+}
+
+func UserIdentityCriteriaWhereClause(comp *Composer, c *UserIdentityCriteria, id int) error {
+	if c.child == nil {
+		return _UserIdentityCriteriaWhereClause(comp, c, id)
+	}
+	node := c
+	sibling := false
+	for {
+		if !sibling {
+			if node.child != nil {
+				if node.parent != nil {
+					comp.WriteString("(")
+				}
+				node = node.child
+				continue
+			} else {
+				comp.Dirty = false
+				comp.WriteString("(")
+				if err := _UserIdentityCriteriaWhereClause(comp, node, id); err != nil {
+					return err
+				}
+				comp.WriteString(")")
+			}
+		}
+		if node.sibling != nil {
+			sibling = false
+			comp.WriteString(" ")
+			comp.WriteString(node.parent.operator)
+			comp.WriteString(" ")
+			node = node.sibling
+			continue
+		}
+		if node.parent != nil {
+			sibling = true
+			if node.parent.parent != nil {
+				comp.WriteString(")")
+			}
+			node = node.parent
+			continue
+		}
+
+		break
+	}
+	return nil
+}
+
+func _UserIdentityCriteriaWhereClause(comp *Composer, c *UserIdentityCriteria, id int) error {
+	QueryTimestampWhereClause(c.CreatedAt, id, TableUserIdentityColumnCreatedAt, comp, And)
+
+	QueryInt64WhereClause(c.ID, id, TableUserIdentityColumnID, comp, And)
+
+	QueryStringWhereClause(c.Issuer, id, TableUserIdentityColumnIssuer, comp, And)
+
+	QueryStringWhereClause(c.Subject, id, TableUserIdentityColumnSubject, comp, And)
+
+	QueryTimestampWhereClause(c.UpdatedAt, id, TableUserIdentityColumnUpdatedAt, comp, And)
+
+	QueryInt64WhereClause(c.UserID, id, TableUserIdentityColumnUserID, comp, And)
+
+	return nil
+}
+
+func (r *UserIdentityRepositoryBase) FindQuery(fe *UserIdentityFindExpr) (string, []interface{}, error) {
+	comp := NewComposer(6)
+	buf := bytes.NewBufferString("SELECT ")
+	if len(fe.Columns) == 0 {
+		buf.WriteString("t0.created_at, t0.id, t0.issuer, t0.subject, t0.updated_at, t0.user_id")
+	} else {
+		buf.WriteString(strings.Join(fe.Columns, ", "))
+	}
+	if fe.JoinUser != nil && fe.JoinUser.Kind.Actionable() && fe.JoinUser.Fetch {
+		buf.WriteString(", t1.confirmation_token, t1.confirmation_token_expire_at, t1.created_at, t1.created_by, t1.deleted_at, t1.first_name, t1.id, t1.is_active, t1.is_confirmed, t1.is_service_account, t1.is_staff, t1.is_superuser, t1.last_login_at, t1.last_name, t1.locked_at, t1.password, t1.password_reset_token, t1.password_reset_token_expire_at, t1.two_factor_challenge, t1.two_factor_challenge_expire_at, t1.two_factor_confirmed_at, t1.two_factor_last_step, t1.two_factor_recovery_codes, t1.two_factor_secret, t1.updated_at, t1.updated_by, t1.username")
+	}
+	buf.WriteString(" FROM ")
+	buf.WriteString(r.Table)
+	buf.WriteString(" AS t0")
+	if fe.JoinUser != nil && fe.JoinUser.Kind.Actionable() {
+		joinClause(comp, fe.JoinUser.Kind, "charon.user AS t1 ON t0.user_id=t1.id")
+		if fe.JoinUser.On != nil {
+			comp.Dirty = true
+			if err := UserCriteriaWhereClause(comp, fe.JoinUser.On, 1); err != nil {
+				return "", nil, err
+			}
+		}
+	}
+	if comp.Dirty {
+		buf.ReadFrom(comp)
+		comp.Dirty = false
+	}
+	if fe.Where != nil {
+		if err := UserIdentityCriteriaWhereClause(comp, fe.Where, 0); err != nil {
+			return "", nil, err
+		}
+	}
+	if fe.JoinUser != nil && fe.JoinUser.Kind.Actionable() && fe.JoinUser.Where != nil {
+		if err := UserCriteriaWhereClause(comp, fe.JoinUser.Where, 1); err != nil {
+			return "", nil, err
+		}
+	}
+	if comp.Dirty {
+		if _, err := buf.WriteString(" WHERE "); err != nil {
+			return "", nil, err
+		}
+		buf.ReadFrom(comp)
+	}
+
+	if len(fe.OrderBy) > 0 {
+		i := 0
+		for _, order := range fe.OrderBy {
+			for _, columnName := range TableUserIdentityColumns {
+				if order.Name == columnName {
+					if i == 0 {
+						comp.WriteString(" ORDER BY ")
+					}
+					if i > 0 {
+						if _, err := comp.WriteString(", "); err != nil {
+							return "", nil, err
+						}
+					}
+					if _, err := comp.WriteString(order.Name); err != nil {
+						return "", nil, err
+					}
+					if order.Descending {
+						if _, err := comp.WriteString(" DESC"); err != nil {
+							return "", nil, err
+						}
+					}
+					i++
+					break
+				}
+			}
+		}
+	}
+	if fe.Offset > 0 {
+		if _, err := comp.WriteString(" OFFSET "); err != nil {
+			return "", nil, err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		if _, err := comp.WriteString(" "); err != nil {
+			return "", nil, err
+		}
+		comp.Add(fe.Offset)
+	}
+	if fe.Limit > 0 {
+		if _, err := comp.WriteString(" LIMIT "); err != nil {
+			return "", nil, err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		if _, err := comp.WriteString(" "); err != nil {
+			return "", nil, err
+		}
+		comp.Add(fe.Limit)
+	}
+
+	buf.ReadFrom(comp)
+
+	return buf.String(), comp.Args(), nil
+}
+
+func (r *UserIdentityRepositoryBase) find(ctx context.Context, tx *sql.Tx, fe *UserIdentityFindExpr) ([]*UserIdentityEntity, error) {
+	query, args, err := r.FindQuery(fe)
+	if err != nil {
+		return nil, err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableUserIdentity, "find", query, args...)
+		} else {
+			r.Log(err, TableUserIdentity, "find tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		entities []*UserIdentityEntity
+		props    []interface{}
+	)
+	for rows.Next() {
+		var ent UserIdentityEntity
+		if props, err = ent.Props(); err != nil {
+			return nil, err
+		}
+		var prop []interface{}
+		if fe.JoinUser != nil && fe.JoinUser.Kind.Actionable() && fe.JoinUser.Fetch {
+			ent.User = &UserEntity{}
+			if prop, err = ent.User.Props(); err != nil {
+				return nil, err
+			}
+			props = append(props, prop...)
+		}
+		err = rows.Scan(props...)
+		if err != nil {
+			return nil, err
+		}
+
+		entities = append(entities, &ent)
+	}
+	err = rows.Err()
+	if r.Log != nil {
+		r.Log(err, TableUserIdentity, "find", query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return entities, nil
+}
+
+func (r *UserIdentityRepositoryBase) Find(ctx context.Context, fe *UserIdentityFindExpr) ([]*UserIdentityEntity, error) {
+	return r.find(ctx, nil, fe)
+}
+
+func (r *UserIdentityRepositoryBase) findIter(ctx context.Context, tx *sql.Tx, fe *UserIdentityFindExpr) (*UserIdentityIterator, error) {
+	query, args, err := r.FindQuery(fe)
+	if err != nil {
+		return nil, err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableUserIdentity, "find iter", query, args...)
+		} else {
+			r.Log(err, TableUserIdentity, "find iter tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &UserIdentityIterator{
+		rows: rows,
+		expr: fe,
+		cols: fe.Columns,
+	}, nil
+}
+
+func (r *UserIdentityRepositoryBase) FindIter(ctx context.Context, fe *UserIdentityFindExpr) (*UserIdentityIterator, error) {
+	return r.findIter(ctx, nil, fe)
+}
+
+func (r *UserIdentityRepositoryBase) findOneByID(ctx context.Context, tx *sql.Tx, pk int64) (*UserIdentityEntity, error) {
+	find := NewComposer(6)
+	find.WriteString("SELECT ")
+	if len(r.Columns) == 0 {
+		find.WriteString("created_at, id, issuer, subject, updated_at, user_id")
+	} else {
+		find.WriteString(strings.Join(r.Columns, ", "))
+	}
+	find.WriteString(" FROM ")
+	find.WriteString(TableUserIdentity)
+	find.WriteString(" WHERE ")
+	find.WriteString(TableUserIdentityColumnID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(pk)
+	var (
+		ent UserIdentityEntity
+	)
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
+	} else {
+		err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableUserIdentity, "find by primary key", find.String(), find.Args()...)
+		} else {
+			r.Log(err, TableUserIdentity, "find by primary key tx", find.String(), find.Args()...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ent, nil
+}
+
+func (r *UserIdentityRepositoryBase) FindOneByID(ctx context.Context, pk int64) (*UserIdentityEntity, error) {
+	return r.findOneByID(ctx, nil, pk)
+}
+
+func (r *UserIdentityRepositoryBase) findOneByIssuerAndSubject(ctx context.Context, tx *sql.Tx, userIdentityIssuer string, userIdentitySubject string) (*UserIdentityEntity, error) {
+	find := NewComposer(6)
+	find.WriteString("SELECT ")
+	if len(r.Columns) == 0 {
+		find.WriteString("created_at, id, issuer, subject, updated_at, user_id")
+	} else {
+		find.WriteString(strings.Join(r.Columns, ", "))
+	}
+	find.WriteString(" FROM ")
+	find.WriteString(TableUserIdentity)
+	find.WriteString(" WHERE ")
+	find.WriteString(TableUserIdentityColumnIssuer)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(userIdentityIssuer)
+	find.WriteString(" AND ")
+	find.WriteString(TableUserIdentityColumnSubject)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(userIdentitySubject)
+
+	var (
+		ent UserIdentityEntity
+	)
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
+	} else {
+		err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &ent, nil
+}
+
+func (r *UserIdentityRepositoryBase) FindOneByIssuerAndSubject(ctx context.Context, userIdentityIssuer string, userIdentitySubject string) (*UserIdentityEntity, error) {
+	return r.findOneByIssuerAndSubject(ctx, nil, userIdentityIssuer, userIdentitySubject)
+}
+
+func (r *UserIdentityRepositoryBase) UpdateOneByIDQuery(pk int64, p *UserIdentityPatch) (string, []interface{}, error) {
+	buf := bytes.NewBufferString("UPDATE ")
+	buf.WriteString(r.Table)
+	update := NewComposer(6)
+	if p.CreatedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserIdentityColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.CreatedAt)
+		update.Dirty = true
+
+	}
+	if p.Issuer.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserIdentityColumnIssuer); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Issuer)
+		update.Dirty = true
+	}
+
+	if p.Subject.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserIdentityColumnSubject); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Subject)
+		update.Dirty = true
+	}
+
+	if p.UpdatedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserIdentityColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.UpdatedAt)
+		update.Dirty = true
+
+	} else {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserIdentityColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("=NOW()"); err != nil {
+			return "", nil, err
+		}
+		update.Dirty = true
+	}
+	if p.UserID.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserIdentityColumnUserID); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.UserID)
+		update.Dirty = true
+	}
+
+	if !update.Dirty {
+		return "", nil, errors.New("UserIdentity update failure, nothing to update")
+	}
+	buf.WriteString(" SET ")
+	buf.ReadFrom(update)
+	buf.WriteString(" WHERE ")
+
+	update.WriteString(TableUserIdentityColumnID)
+	update.WriteString("=")
+	update.WritePlaceholder()
+	update.Add(pk)
+
+	buf.ReadFrom(update)
+	buf.WriteString(" RETURNING ")
+	if len(r.Columns) > 0 {
+		buf.WriteString(strings.Join(r.Columns, ", "))
+	} else {
+		buf.WriteString("created_at, id, issuer, subject, updated_at, user_id")
+	}
+	return buf.String(), update.Args(), nil
+}
+
+func (r *UserIdentityRepositoryBase) updateOneByID(ctx context.Context, tx *sql.Tx, pk int64, p *UserIdentityPatch) (*UserIdentityEntity, error) {
+	query, args, err := r.UpdateOneByIDQuery(pk, p)
+	if err != nil {
+		return nil, err
+	}
+	var ent UserIdentityEntity
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, query, args...).Scan(props...)
+	} else {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(props...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableUserIdentity, "update by primary key", query, args...)
+		} else {
+			r.Log(err, TableUserIdentity, "update by primary key tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ent, nil
+}
+
+func (r *UserIdentityRepositoryBase) UpdateOneByID(ctx context.Context, pk int64, p *UserIdentityPatch) (*UserIdentityEntity, error) {
+	return r.updateOneByID(ctx, nil, pk, p)
+}
+
+func (r *UserIdentityRepositoryBase) FindOneByIDAndUpdate(ctx context.Context, pk int64, p *UserIdentityPatch) (before, after *UserIdentityEntity, err error) {
+	find := NewComposer(6)
+	find.WriteString("SELECT ")
+	if len(r.Columns) == 0 {
+		find.WriteString("created_at, id, issuer, subject, updated_at, user_id")
+	} else {
+		find.WriteString(strings.Join(r.Columns, ", "))
+	}
+	find.WriteString(" FROM ")
+	find.WriteString(TableUserIdentity)
+	find.WriteString(" WHERE ")
+	find.WriteString(TableUserIdentityColumnID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(pk)
+	find.WriteString(" FOR UPDATE")
+	query, args, err := r.UpdateOneByIDQuery(pk, p)
+	if err != nil {
+		return
+	}
+	var (
+		oldEnt, newEnt UserIdentityEntity
+	)
+	oldProps, err := oldEnt.Props(r.Columns...)
+	if err != nil {
+		return
+	}
+	newProps, err := newEnt.Props(r.Columns...)
+	if err != nil {
+		return
+	}
+	tx, err := r.DB.Begin()
+	if err != nil {
+		return
+	}
+	err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(oldProps...)
+	if r.Log != nil {
+		r.Log(err, TableUserIdentity, "find by primary key", find.String(), find.Args()...)
+	}
+	if err != nil {
+		tx.Rollback()
+		return
+	}
+	err = tx.QueryRowContext(ctx, query, args...).Scan(newProps...)
+	if r.Log != nil {
+		r.Log(err, TableUserIdentity, "update by primary key", query, args...)
+	}
+	if err != nil {
+		tx.Rollback()
+		return
+	}
+	err = tx.Commit()
+	if err != nil {
+		return
+	}
+	return &oldEnt, &newEnt, nil
+}
+
+func (r *UserIdentityRepositoryBase) UpdateOneByIssuerAndSubjectQuery(userIdentityIssuer string, userIdentitySubject string, p *UserIdentityPatch) (string, []interface{}, error) {
+	buf := bytes.NewBufferString("UPDATE ")
+	buf.WriteString(r.Table)
+	update := NewComposer(2)
+	if p.CreatedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserIdentityColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.CreatedAt)
+		update.Dirty = true
+
+	}
+	if p.Issuer.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserIdentityColumnIssuer); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Issuer)
+		update.Dirty = true
+	}
+
+	if p.Subject.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserIdentityColumnSubject); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Subject)
+		update.Dirty = true
+	}
+
+	if p.UpdatedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserIdentityColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.UpdatedAt)
+		update.Dirty = true
+
+	} else {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserIdentityColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("=NOW()"); err != nil {
+			return "", nil, err
+		}
+		update.Dirty = true
+	}
+	if p.UserID.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableUserIdentityColumnUserID); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.UserID)
+		update.Dirty = true
+	}
+
+	if !update.Dirty {
+		return "", nil, errors.New("user_identity update failure, nothing to update")
+	}
+	buf.WriteString(" SET ")
+	buf.ReadFrom(update)
+	buf.WriteString(" WHERE ")
+	update.WriteString(TableUserIdentityColumnIssuer)
+	update.WriteString("=")
+	update.WritePlaceholder()
+	update.Add(userIdentityIssuer)
+	update.WriteString(" AND ")
+	update.WriteString(TableUserIdentityColumnSubject)
+	update.WriteString("=")
+	update.WritePlaceholder()
+	update.Add(userIdentitySubject)
+	buf.ReadFrom(update)
+	buf.WriteString(" RETURNING ")
+	if len(r.Columns) > 0 {
+		buf.WriteString(strings.Join(r.Columns, ", "))
+	} else {
+		buf.WriteString("created_at, id, issuer, subject, updated_at, user_id")
+	}
+	return buf.String(), update.Args(), nil
+}
+
+func (r *UserIdentityRepositoryBase) updateOneByIssuerAndSubject(ctx context.Context, tx *sql.Tx, userIdentityIssuer string, userIdentitySubject string, p *UserIdentityPatch) (*UserIdentityEntity, error) {
+	query, args, err := r.UpdateOneByIssuerAndSubjectQuery(userIdentityIssuer, userIdentitySubject, p)
+	if err != nil {
+		return nil, err
+	}
+	var ent UserIdentityEntity
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+
+	var row *sql.Row
+	if tx == nil {
+		row = r.DB.QueryRowContext(ctx, query, args...)
+	} else {
+		row = tx.QueryRowContext(ctx, query, args...)
+	}
+	err = row.Scan(props...)
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableUserIdentity, "update one by unique", query, args...)
+		} else {
+			r.Log(err, TableUserIdentity, "update one by unique tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ent, nil
+}
+
+func (r *UserIdentityRepositoryBase) UpdateOneByIssuerAndSubject(ctx context.Context, userIdentityIssuer string, userIdentitySubject string, p *UserIdentityPatch) (*UserIdentityEntity, error) {
+	return r.updateOneByIssuerAndSubject(ctx, nil, userIdentityIssuer, userIdentitySubject, p)
+}
+
+func (r *UserIdentityRepositoryBase) UpsertQuery(e *UserIdentityEntity, p *UserIdentityPatch, inf ...string) (string, []interface{}, error) {
+	upsert := NewComposer(12)
+	columns := bytes.NewBuffer(nil)
+	buf := bytes.NewBufferString("INSERT INTO ")
+	buf.WriteString(r.Table)
+
+	if !e.CreatedAt.IsZero() {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableUserIdentityColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.CreatedAt)
+		upsert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableUserIdentityColumnIssuer); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.Issuer)
+	upsert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableUserIdentityColumnSubject); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.Subject)
+	upsert.Dirty = true
+
+	if e.UpdatedAt.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableUserIdentityColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.UpdatedAt)
+		upsert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableUserIdentityColumnUserID); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.UserID)
+	upsert.Dirty = true
+
+	if upsert.Dirty {
+		buf.WriteString(" (")
+		buf.ReadFrom(columns)
+		buf.WriteString(") VALUES (")
+		buf.ReadFrom(upsert)
+		buf.WriteString(")")
+	}
+	buf.WriteString(" ON CONFLICT ")
+	if len(inf) > 0 {
+		upsert.Dirty = false
+		if p.CreatedAt.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableUserIdentityColumnCreatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.CreatedAt)
+			upsert.Dirty = true
+
+		}
+		if p.Issuer.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableUserIdentityColumnIssuer); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Issuer)
+			upsert.Dirty = true
+		}
+
+		if p.Subject.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableUserIdentityColumnSubject); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Subject)
+			upsert.Dirty = true
+		}
+
+		if p.UpdatedAt.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableUserIdentityColumnUpdatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.UpdatedAt)
+			upsert.Dirty = true
+
+		} else {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableUserIdentityColumnUpdatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("=NOW()"); err != nil {
+				return "", nil, err
+			}
+			upsert.Dirty = true
+		}
+		if p.UserID.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableUserIdentityColumnUserID); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.UserID)
+			upsert.Dirty = true
+		}
+
+	}
+	if len(inf) > 0 && upsert.Dirty {
+		buf.WriteString("(")
+		for j, i := range inf {
+			if j != 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString(i)
+		}
+		buf.WriteString(")")
+		buf.WriteString(" DO UPDATE SET ")
+		buf.ReadFrom(upsert)
+	} else {
+		buf.WriteString(" DO NOTHING ")
+	}
+	if upsert.Dirty {
+		buf.WriteString(" RETURNING ")
+		if len(r.Columns) > 0 {
+			buf.WriteString(strings.Join(r.Columns, ", "))
+		} else {
+			buf.WriteString("created_at, id, issuer, subject, updated_at, user_id")
+		}
+	}
+	return buf.String(), upsert.Args(), nil
+}
+
+func (r *UserIdentityRepositoryBase) upsert(ctx context.Context, tx *sql.Tx, e *UserIdentityEntity, p *UserIdentityPatch, inf ...string) (*UserIdentityEntity, error) {
+	query, args, err := r.UpsertQuery(e, p, inf...)
+	if err != nil {
+		return nil, err
+	}
+
+	var row *sql.Row
+	if tx == nil {
+		row = r.DB.QueryRowContext(ctx, query, args...)
+	} else {
+		row = tx.QueryRowContext(ctx, query, args...)
+	}
+	err = row.Scan(
+		&e.CreatedAt,
+		&e.ID,
+		&e.Issuer,
+		&e.Subject,
+		&e.UpdatedAt,
+		&e.UserID,
+	)
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableUserIdentity, "upsert", query, args...)
+		} else {
+			r.Log(err, TableUserIdentity, "upsert tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (r *UserIdentityRepositoryBase) Upsert(ctx context.Context, e *UserIdentityEntity, p *UserIdentityPatch, inf ...string) (*UserIdentityEntity, error) {
+	return r.upsert(ctx, nil, e, p, inf...)
+}
+
+func (r *UserIdentityRepositoryBase) count(ctx context.Context, tx *sql.Tx, exp *UserIdentityCountExpr) (int64, error) {
+	query, args, err := r.FindQuery(&UserIdentityFindExpr{
+		Where:   exp.Where,
+		Columns: []string{"COUNT(*)"},
+
+		JoinUser: exp.JoinUser,
+	})
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, query, args...).Scan(&count)
+	} else {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(&count)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableUserIdentity, "count", query, args...)
+		} else {
+			r.Log(err, TableUserIdentity, "count tx", query, args...)
+		}
+	}
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *UserIdentityRepositoryBase) Count(ctx context.Context, exp *UserIdentityCountExpr) (int64, error) {
+	return r.count(ctx, nil, exp)
+}
+
+func (r *UserIdentityRepositoryBase) deleteOneByID(ctx context.Context, tx *sql.Tx, pk int64) (int64, error) {
+	find := NewComposer(6)
+	find.WriteString("DELETE FROM ")
+	find.WriteString(TableUserIdentity)
+	find.WriteString(" WHERE ")
+	find.WriteString(TableUserIdentityColumnID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(pk)
+	var (
+		err error
+		res sql.Result
+	)
+	if tx == nil {
+		res, err = r.DB.ExecContext(ctx, find.String(), find.Args()...)
+	} else {
+		res, err = tx.ExecContext(ctx, find.String(), find.Args()...)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+func (r *UserIdentityRepositoryBase) DeleteOneByID(ctx context.Context, pk int64) (int64, error) {
+	return r.deleteOneByID(ctx, nil, pk)
+}
+
+type UserIdentityRepositoryBaseTx struct {
+	base *UserIdentityRepositoryBase
+	tx   *sql.Tx
+}
+
+func (r UserIdentityRepositoryBaseTx) Commit() error {
+	return r.tx.Commit()
+}
+
+func (r UserIdentityRepositoryBaseTx) Rollback() error {
+	return r.tx.Rollback()
+}
+
+func (r *UserIdentityRepositoryBaseTx) Insert(ctx context.Context, e *UserIdentityEntity) (*UserIdentityEntity, error) {
+	return r.base.insert(ctx, r.tx, e)
+}
+
+func (r *UserIdentityRepositoryBaseTx) Find(ctx context.Context, fe *UserIdentityFindExpr) ([]*UserIdentityEntity, error) {
+	return r.base.find(ctx, r.tx, fe)
+}
+
+func (r *UserIdentityRepositoryBaseTx) FindIter(ctx context.Context, fe *UserIdentityFindExpr) (*UserIdentityIterator, error) {
+	return r.base.findIter(ctx, r.tx, fe)
+}
+
+func (r *UserIdentityRepositoryBaseTx) FindOneByID(ctx context.Context, pk int64) (*UserIdentityEntity, error) {
+	return r.base.findOneByID(ctx, r.tx, pk)
+}
+
+func (r *UserIdentityRepositoryBaseTx) UpdateOneByID(ctx context.Context, pk int64, p *UserIdentityPatch) (*UserIdentityEntity, error) {
+	return r.base.updateOneByID(ctx, r.tx, pk, p)
+}
+
+func (r *UserIdentityRepositoryBaseTx) UpdateOneByIssuerAndSubject(ctx context.Context, userIdentityIssuer string, userIdentitySubject string, p *UserIdentityPatch) (*UserIdentityEntity, error) {
+	return r.base.updateOneByIssuerAndSubject(ctx, r.tx, userIdentityIssuer, userIdentitySubject, p)
+}
+
+func (r *UserIdentityRepositoryBaseTx) Upsert(ctx context.Context, e *UserIdentityEntity, p *UserIdentityPatch, inf ...string) (*UserIdentityEntity, error) {
+	return r.base.upsert(ctx, r.tx, e, p, inf...)
+}
+
+func (r *UserIdentityRepositoryBaseTx) Count(ctx context.Context, exp *UserIdentityCountExpr) (int64, error) {
+	return r.base.count(ctx, r.tx, exp)
+}
+
+func (r *UserIdentityRepositoryBaseTx) DeleteOneByID(ctx context.Context, pk int64) (int64, error) {
+	return r.base.deleteOneByID(ctx, r.tx, pk)
+}
+
+const (
+	TableAPIKeyConstraintPrefixUnique        = "charon.api_key_prefix_key"
+	TableAPIKeyConstraintUserIDForeignKey    = "charon.api_key_user_id_fkey"
+	TableAPIKeyConstraintPrimaryKey          = "charon.api_key_id_pkey"
+	TableAPIKeyConstraintCreatedByForeignKey = "charon.api_key_created_by_fkey"
+	TableAPIKeyConstraintUpdatedByForeignKey = "charon.api_key_updated_by_fkey"
+)
+
+const (
+	TableAPIKey                  = "charon.api_key"
+	TableAPIKeyColumnCreatedAt   = "created_at"
+	TableAPIKeyColumnCreatedBy   = "created_by"
+	TableAPIKeyColumnExpireAt    = "expire_at"
+	TableAPIKeyColumnID          = "id"
+	TableAPIKeyColumnLastUsedAt  = "last_used_at"
+	TableAPIKeyColumnNotes       = "notes"
+	TableAPIKeyColumnPermissions = "permissions"
+	TableAPIKeyColumnPrefix      = "prefix"
+	TableAPIKeyColumnRevoked     = "revoked"
+	TableAPIKeyColumnSecret      = "secret"
+	TableAPIKeyColumnUpdatedAt   = "updated_at"
+	TableAPIKeyColumnUpdatedBy   = "updated_by"
+	TableAPIKeyColumnUserID      = "user_id"
+)
+
+var TableAPIKeyColumns = []string{
+	TableAPIKeyColumnCreatedAt,
+	TableAPIKeyColumnCreatedBy,
+	TableAPIKeyColumnExpireAt,
+	TableAPIKeyColumnID,
+	TableAPIKeyColumnLastUsedAt,
+	TableAPIKeyColumnNotes,
+	TableAPIKeyColumnPermissions,
+	TableAPIKeyColumnPrefix,
+	TableAPIKeyColumnRevoked,
+	TableAPIKeyColumnSecret,
+	TableAPIKeyColumnUpdatedAt,
+	TableAPIKeyColumnUpdatedBy,
+	TableAPIKeyColumnUserID,
+}
+
+// APIKeyEntity ...
+type APIKeyEntity struct {
+	// CreatedAt ...
+	CreatedAt time.Time
+	// CreatedBy ...
+	CreatedBy ntypes.Int64
+	// ExpireAt ...
+	ExpireAt pq.NullTime
+	// ID ...
+	ID int64
+	// LastUsedAt ...
+	LastUsedAt pq.NullTime
+	// Notes ...
+	Notes ntypes.String
+	// Permissions ...
+	Permissions string
+	// Prefix ...
+	Prefix string
+	// Revoked ...
+	Revoked bool
+	// Secret ...
+	Secret []byte
+	// UpdatedAt ...
+	UpdatedAt pq.NullTime
+	// UpdatedBy ...
+	UpdatedBy ntypes.Int64
+	// UserID ...
+	UserID int64
+	// User ...
+	User *UserEntity
+	// Author ...
+	Author *UserEntity
+	// Modifier ...
+	Modifier *UserEntity
+}
+
+func (e *APIKeyEntity) Prop(cn string) (interface{}, bool) {
+	switch cn {
+
+	case TableAPIKeyColumnCreatedAt:
+		return &e.CreatedAt, true
+	case TableAPIKeyColumnCreatedBy:
+		return &e.CreatedBy, true
+	case TableAPIKeyColumnExpireAt:
+		return &e.ExpireAt, true
+	case TableAPIKeyColumnID:
+		return &e.ID, true
+	case TableAPIKeyColumnLastUsedAt:
+		return &e.LastUsedAt, true
+	case TableAPIKeyColumnNotes:
+		return &e.Notes, true
+	case TableAPIKeyColumnPermissions:
+		return &e.Permissions, true
+	case TableAPIKeyColumnPrefix:
+		return &e.Prefix, true
+	case TableAPIKeyColumnRevoked:
+		return &e.Revoked, true
+	case TableAPIKeyColumnSecret:
+		return &e.Secret, true
+	case TableAPIKeyColumnUpdatedAt:
+		return &e.UpdatedAt, true
+	case TableAPIKeyColumnUpdatedBy:
+		return &e.UpdatedBy, true
+	case TableAPIKeyColumnUserID:
+		return &e.UserID, true
+	default:
+		return nil, false
+	}
+}
+
+func (e *APIKeyEntity) Props(cns ...string) ([]interface{}, error) {
+	if len(cns) == 0 {
+		cns = TableAPIKeyColumns
+	}
+	res := make([]interface{}, 0, len(cns))
+	for _, cn := range cns {
+		if prop, ok := e.Prop(cn); ok {
+			res = append(res, prop)
+		} else {
+			return nil, fmt.Errorf("unexpected column provided: %s", cn)
+		}
+	}
+	return res, nil
+}
+
+// ScanAPIKeyRows helps to scan rows straight to the slice of entities.
+func ScanAPIKeyRows(rows Rows) (entities []*APIKeyEntity, err error) {
+	for rows.Next() {
+		var ent APIKeyEntity
+		err = rows.Scan(
+			&ent.CreatedAt,
+			&ent.CreatedBy,
+			&ent.ExpireAt,
+			&ent.ID,
+			&ent.LastUsedAt,
+			&ent.Notes,
+			&ent.Permissions,
+			&ent.Prefix,
+			&ent.Revoked,
+			&ent.Secret,
+			&ent.UpdatedAt,
+			&ent.UpdatedBy,
+			&ent.UserID,
+		)
+		if err != nil {
+			return
+		}
+
+		entities = append(entities, &ent)
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	return
+}
+
+// APIKeyIterator is not thread safe.
+type APIKeyIterator struct {
+	rows Rows
+	cols []string
+	expr *APIKeyFindExpr
+}
+
+func (i *APIKeyIterator) Next() bool {
+	return i.rows.Next()
+}
+
+func (i *APIKeyIterator) Close() error {
+	return i.rows.Close()
+}
+
+func (i *APIKeyIterator) Err() error {
+	return i.rows.Err()
+}
+
+// Columns is wrapper around sql.Rows.Columns method, that also cache output inside iterator.
+func (i *APIKeyIterator) Columns() ([]string, error) {
+	if i.cols == nil {
+		cols, err := i.rows.Columns()
+		if err != nil {
+			return nil, err
+		}
+		i.cols = cols
+	}
+	return i.cols, nil
+}
+
+// Ent is wrapper around APIKey method that makes iterator more generic.
+func (i *APIKeyIterator) Ent() (interface{}, error) {
+	return i.APIKey()
+}
+
+func (i *APIKeyIterator) APIKey() (*APIKeyEntity, error) {
+	var ent APIKeyEntity
+	cols, err := i.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	props, err := ent.Props(cols...)
+	if err != nil {
+		return nil, err
+	}
+	var prop []interface{}
+	if i.expr.JoinUser != nil && i.expr.JoinUser.Kind.Actionable() && i.expr.JoinUser.Fetch {
+		ent.User = &UserEntity{}
+		if prop, err = ent.User.Props(); err != nil {
+			return nil, err
+		}
+		props = append(props, prop...)
+	}
+	if i.expr.JoinAuthor != nil && i.expr.JoinAuthor.Kind.Actionable() && i.expr.JoinAuthor.Fetch {
+		ent.Author = &UserEntity{}
+		if prop, err = ent.Author.Props(); err != nil {
+			return nil, err
+		}
+		props = append(props, prop...)
+	}
+	if i.expr.JoinModifier != nil && i.expr.JoinModifier.Kind.Actionable() && i.expr.JoinModifier.Fetch {
+		ent.Modifier = &UserEntity{}
+		if prop, err = ent.Modifier.Props(); err != nil {
+			return nil, err
+		}
+		props = append(props, prop...)
+	}
+	if err := i.rows.Scan(props...); err != nil {
+		return nil, err
+	}
+	return &ent, nil
+}
+
+type APIKeyCriteria struct {
+	CreatedAt              *qtypes.Timestamp
+	CreatedBy              *qtypes.Int64
+	ExpireAt               *qtypes.Timestamp
+	ID                     *qtypes.Int64
+	LastUsedAt             *qtypes.Timestamp
+	Notes                  *qtypes.String
+	Permissions            *qtypes.String
+	Prefix                 *qtypes.String
+	Revoked                ntypes.Bool
+	Secret                 []byte
+	UpdatedAt              *qtypes.Timestamp
+	UpdatedBy              *qtypes.Int64
+	UserID                 *qtypes.Int64
+	operator               string
+	child, sibling, parent *APIKeyCriteria
+}
+
+func APIKeyOperand(operator string, operands ...*APIKeyCriteria) *APIKeyCriteria {
+	if len(operands) == 0 {
+		return &APIKeyCriteria{operator: operator}
+	}
+
+	parent := &APIKeyCriteria{
+		operator: operator,
+		child:    operands[0],
+	}
+
+	for i := 0; i < len(operands); i++ {
+		if i < len(operands)-1 {
+			operands[i].sibling = operands[i+1]
+		}
+		operands[i].parent = parent
+	}
+
+	return parent
+}
+
+func APIKeyOr(operands ...*APIKeyCriteria) *APIKeyCriteria {
+	return APIKeyOperand("OR", operands...)
+}
+
+func APIKeyAnd(operands ...*APIKeyCriteria) *APIKeyCriteria {
+	return APIKeyOperand("AND", operands...)
+}
+
+type APIKeyFindExpr struct {
+	Where         *APIKeyCriteria
+	Offset, Limit int64
+	Columns       []string
+	OrderBy       []RowOrder
+	JoinUser      *UserJoin
+	JoinAuthor    *UserJoin
+	JoinModifier  *UserJoin
+}
+
+type APIKeyJoin struct {
+	On, Where    *APIKeyCriteria
+	Fetch        bool
+	Kind         JoinType
+	JoinUser     *UserJoin
+	JoinAuthor   *UserJoin
+	JoinModifier *UserJoin
+}
+
+type APIKeyCountExpr struct {
+	Where        *APIKeyCriteria
+	JoinUser     *UserJoin
+	JoinAuthor   *UserJoin
+	JoinModifier *UserJoin
+}
+
+type APIKeyPatch struct {
+	CreatedAt   pq.NullTime
+	CreatedBy   ntypes.Int64
+	ExpireAt    pq.NullTime
+	LastUsedAt  pq.NullTime
+	Notes       ntypes.String
+	Permissions ntypes.String
+	Prefix      ntypes.String
+	Revoked     ntypes.Bool
+	Secret      []byte
+	UpdatedAt   pq.NullTime
+	UpdatedBy   ntypes.Int64
+	UserID      ntypes.Int64
+}
+
+type APIKeyRepositoryBase struct {
+	Table   string
+	Columns []string
+	DB      *sql.DB
+	Log     LogFunc
+}
+
+func (r *APIKeyRepositoryBase) Tx(tx *sql.Tx) (*APIKeyRepositoryBaseTx, error) {
+	return &APIKeyRepositoryBaseTx{
+		base: r,
+		tx:   tx,
+	}, nil
+}
+
+func (r *APIKeyRepositoryBase) BeginTx(ctx context.Context) (*APIKeyRepositoryBaseTx, error) {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return r.Tx(tx)
+}
+
+func (r APIKeyRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *APIKeyRepositoryBaseTx) error, attempts int) (err error) {
+	return RunInTransaction(ctx, r.DB, func(tx *sql.Tx) error {
+		rtx, err := r.Tx(tx)
+		if err != nil {
+			return err
+		}
+		return fn(rtx)
+	}, attempts)
+}
+
+func (r *APIKeyRepositoryBase) InsertQuery(e *APIKeyEntity, read bool) (string, []interface{}, error) {
+	insert := NewComposer(13)
+	columns := bytes.NewBuffer(nil)
+	buf := bytes.NewBufferString("INSERT INTO ")
+	buf.WriteString(r.Table)
+
+	if !e.CreatedAt.IsZero() {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableAPIKeyColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.CreatedAt)
+		insert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableAPIKeyColumnCreatedBy); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.CreatedBy)
+	insert.Dirty = true
+
+	if e.ExpireAt.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableAPIKeyColumnExpireAt); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ExpireAt)
+		insert.Dirty = true
+	}
+
+	if e.LastUsedAt.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableAPIKeyColumnLastUsedAt); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.LastUsedAt)
+		insert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableAPIKeyColumnNotes); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.Notes)
+	insert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableAPIKeyColumnPermissions); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.Permissions)
+	insert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableAPIKeyColumnPrefix); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.Prefix)
+	insert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableAPIKeyColumnRevoked); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.Revoked)
+	insert.Dirty = true
+
+	if e.Secret != nil {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableAPIKeyColumnSecret); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.Secret)
+		insert.Dirty = true
+	}
+
+	if e.UpdatedAt.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableAPIKeyColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.UpdatedAt)
+		insert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableAPIKeyColumnUpdatedBy); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.UpdatedBy)
+	insert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableAPIKeyColumnUserID); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.UserID)
+	insert.Dirty = true
+
+	if columns.Len() > 0 {
+		buf.WriteString(" (")
+		buf.ReadFrom(columns)
+		buf.WriteString(") VALUES (")
+		buf.ReadFrom(insert)
+		buf.WriteString(") ")
+		if read {
+			buf.WriteString("RETURNING ")
+			if len(r.Columns) > 0 {
+				buf.WriteString(strings.Join(r.Columns, ", "))
+			} else {
+				buf.WriteString("created_at, created_by, expire_at, id, last_used_at, notes, permissions, prefix, revoked, secret, updated_at, updated_by, user_id")
+			}
+		}
+	}
+	return buf.String(), insert.Args(), nil
+}
+
+func (r *APIKeyRepositoryBase) insert(ctx context.Context, tx *sql.Tx, e *APIKeyEntity) (*APIKeyEntity, error) {
+	query, args, err := r.InsertQuery(e, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var row *sql.Row
+	if tx == nil {
+		row = r.DB.QueryRowContext(ctx, query, args...)
+	} else {
+		row = tx.QueryRowContext(ctx, query, args...)
+	}
+	err = row.Scan(
+		&e.CreatedAt,
+		&e.CreatedBy,
+		&e.ExpireAt,
+		&e.ID,
+		&e.LastUsedAt,
+		&e.Notes,
+		&e.Permissions,
+		&e.Prefix,
+		&e.Revoked,
+		&e.Secret,
+		&e.UpdatedAt,
+		&e.UpdatedBy,
+		&e.UserID,
+	)
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableAPIKey, "insert", query, args...)
+		} else {
+			r.Log(err, TableAPIKey, "insert tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (r *APIKeyRepositoryBase) Insert(ctx context.Context, e *APIKeyEntity) (*APIKeyEntity, error) {
+	return r.insert(ctx, nil, e)
+}
+
+func APIKeyCriteriaWhereClause(comp *Composer, c *APIKeyCriteria, id int) error {
+	if c.child == nil {
+		return _APIKeyCriteriaWhereClause(comp, c, id)
+	}
+	node := c
+	sibling := false
+	for {
+		if !sibling {
+			if node.child != nil {
+				if node.parent != nil {
+					comp.WriteString("(")
+				}
+				node = node.child
+				continue
+			} else {
+				comp.Dirty = false
+				comp.WriteString("(")
+				if err := _APIKeyCriteriaWhereClause(comp, node, id); err != nil {
+					return err
+				}
+				comp.WriteString(")")
+			}
+		}
+		if node.sibling != nil {
+			sibling = false
+			comp.WriteString(" ")
+			comp.WriteString(node.parent.operator)
+			comp.WriteString(" ")
+			node = node.sibling
+			continue
+		}
+		if node.parent != nil {
+			sibling = true
+			if node.parent.parent != nil {
+				comp.WriteString(")")
+			}
+			node = node.parent
+			continue
+		}
+
+		break
+	}
+	return nil
+}
+
+func _APIKeyCriteriaWhereClause(comp *Composer, c *APIKeyCriteria, id int) error {
+	QueryTimestampWhereClause(c.CreatedAt, id, TableAPIKeyColumnCreatedAt, comp, And)
+
+	QueryInt64WhereClause(c.CreatedBy, id, TableAPIKeyColumnCreatedBy, comp, And)
+
+	QueryTimestampWhereClause(c.ExpireAt, id, TableAPIKeyColumnExpireAt, comp, And)
+
+	QueryInt64WhereClause(c.ID, id, TableAPIKeyColumnID, comp, And)
+
+	QueryTimestampWhereClause(c.LastUsedAt, id, TableAPIKeyColumnLastUsedAt, comp, And)
+
+	QueryStringWhereClause(c.Notes, id, TableAPIKeyColumnNotes, comp, And)
+
+	QueryStringWhereClause(c.Permissions, id, TableAPIKeyColumnPermissions, comp, And)
+
+	QueryStringWhereClause(c.Prefix, id, TableAPIKeyColumnPrefix, comp, And)
+
+	if c.Revoked.Valid {
+		if comp.Dirty {
+			if _, err := comp.WriteString(" AND "); err != nil {
+				return err
+			}
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableAPIKeyColumnRevoked); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.Revoked)
+		comp.Dirty = true
+	}
+
+	if c.Secret != nil {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableAPIKeyColumnSecret); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.Secret)
+		comp.Dirty = true
+	}
+	QueryTimestampWhereClause(c.UpdatedAt, id, TableAPIKeyColumnUpdatedAt, comp, And)
+
+	QueryInt64WhereClause(c.UpdatedBy, id, TableAPIKeyColumnUpdatedBy, comp, And)
+
+	QueryInt64WhereClause(c.UserID, id, TableAPIKeyColumnUserID, comp, And)
+
+	return nil
+}
+
+func (r *APIKeyRepositoryBase) FindQuery(fe *APIKeyFindExpr) (string, []interface{}, error) {
+	comp := NewComposer(13)
+	buf := bytes.NewBufferString("SELECT ")
+	if len(fe.Columns) == 0 {
+		buf.WriteString("t0.created_at, t0.created_by, t0.expire_at, t0.id, t0.last_used_at, t0.notes, t0.permissions, t0.prefix, t0.revoked, t0.secret, t0.updated_at, t0.updated_by, t0.user_id")
+	} else {
+		buf.WriteString(strings.Join(fe.Columns, ", "))
+	}
+	if fe.JoinUser != nil && fe.JoinUser.Kind.Actionable() && fe.JoinUser.Fetch {
+		buf.WriteString(", t1.confirmation_token, t1.confirmation_token_expire_at, t1.created_at, t1.created_by, t1.deleted_at, t1.first_name, t1.id, t1.is_active, t1.is_confirmed, t1.is_service_account, t1.is_staff, t1.is_superuser, t1.last_login_at, t1.last_name, t1.locked_at, t1.password, t1.password_reset_token, t1.password_reset_token_expire_at, t1.two_factor_challenge, t1.two_factor_challenge_expire_at, t1.two_factor_confirmed_at, t1.two_factor_last_step, t1.two_factor_recovery_codes, t1.two_factor_secret, t1.updated_at, t1.updated_by, t1.username")
+	}
+	if fe.JoinAuthor != nil && fe.JoinAuthor.Kind.Actionable() && fe.JoinAuthor.Fetch {
+		buf.WriteString(", t2.confirmation_token, t2.confirmation_token_expire_at, t2.created_at, t2.created_by, t2.deleted_at, t2.first_name, t2.id, t2.is_active, t2.is_confirmed, t2.is_service_account, t2.is_staff, t2.is_superuser, t2.last_login_at, t2.last_name, t2.locked_at, t2.password, t2.password_reset_token, t2.password_reset_token_expire_at, t2.two_factor_challenge, t2.two_factor_challenge_expire_at, t2.two_factor_confirmed_at, t2.two_factor_last_step, t2.two_factor_recovery_codes, t2.two_factor_secret, t2.updated_at, t2.updated_by, t2.username")
+	}
+	if fe.JoinModifier != nil && fe.JoinModifier.Kind.Actionable() && fe.JoinModifier.Fetch {
+		buf.WriteString(", t3.confirmation_token, t3.confirmation_token_expire_at, t3.created_at, t3.created_by, t3.deleted_at, t3.first_name, t3.id, t3.is_active, t3.is_confirmed, t3.is_service_account, t3.is_staff, t3.is_superuser, t3.last_login_at, t3.last_name, t3.locked_at, t3.password, t3.password_reset_token, t3.password_reset_token_expire_at, t3.two_factor_challenge, t3.two_factor_challenge_expire_at, t3.two_factor_confirmed_at, t3.two_factor_last_step, t3.two_factor_recovery_codes, t3.two_factor_secret, t3.updated_at, t3.updated_by, t3.username")
+	}
+	buf.WriteString(" FROM ")
+	buf.WriteString(r.Table)
+	buf.WriteString(" AS t0")
+	if fe.JoinUser != nil && fe.JoinUser.Kind.Actionable() {
+		joinClause(comp, fe.JoinUser.Kind, "charon.user AS t1 ON t0.user_id=t1.id")
+		if fe.JoinUser.On != nil {
+			comp.Dirty = true
+			if err := UserCriteriaWhereClause(comp, fe.JoinUser.On, 1); err != nil {
+				return "", nil, err
+			}
+		}
+	}
+	if fe.JoinAuthor != nil && fe.JoinAuthor.Kind.Actionable() {
+		joinClause(comp, fe.JoinAuthor.Kind, "charon.user AS t2 ON t0.created_by=t2.id")
+		if fe.JoinAuthor.On != nil {
+			comp.Dirty = true
+			if err := UserCriteriaWhereClause(comp, fe.JoinAuthor.On, 2); err != nil {
+				return "", nil, err
+			}
+		}
+	}
+	if fe.JoinModifier != nil && fe.JoinModifier.Kind.Actionable() {
+		joinClause(comp, fe.JoinModifier.Kind, "charon.user AS t3 ON t0.updated_by=t3.id")
+		if fe.JoinModifier.On != nil {
+			comp.Dirty = true
+			if err := UserCriteriaWhereClause(comp, fe.JoinModifier.On, 3); err != nil {
+				return "", nil, err
+			}
+		}
+	}
+	if comp.Dirty {
+		buf.ReadFrom(comp)
+		comp.Dirty = false
+	}
+	if fe.Where != nil {
+		if err := APIKeyCriteriaWhereClause(comp, fe.Where, 0); err != nil {
+			return "", nil, err
+		}
+	}
+	if fe.JoinUser != nil && fe.JoinUser.Kind.Actionable() && fe.JoinUser.Where != nil {
+		if err := UserCriteriaWhereClause(comp, fe.JoinUser.Where, 1); err != nil {
+			return "", nil, err
+		}
+	}
+	if fe.JoinAuthor != nil && fe.JoinAuthor.Kind.Actionable() && fe.JoinAuthor.Where != nil {
+		if err := UserCriteriaWhereClause(comp, fe.JoinAuthor.Where, 2); err != nil {
+			return "", nil, err
+		}
+	}
+	if fe.JoinModifier != nil && fe.JoinModifier.Kind.Actionable() && fe.JoinModifier.Where != nil {
+		if err := UserCriteriaWhereClause(comp, fe.JoinModifier.Where, 3); err != nil {
+			return "", nil, err
+		}
+	}
+	if comp.Dirty {
+		if _, err := buf.WriteString(" WHERE "); err != nil {
+			return "", nil, err
+		}
+		buf.ReadFrom(comp)
+	}
+
+	if len(fe.OrderBy) > 0 {
+		i := 0
+		for _, order := range fe.OrderBy {
+			for _, columnName := range TableAPIKeyColumns {
+				if order.Name == columnName {
+					if i == 0 {
+						comp.WriteString(" ORDER BY ")
+					}
+					if i > 0 {
+						if _, err := comp.WriteString(", "); err != nil {
+							return "", nil, err
+						}
+					}
+					if _, err := comp.WriteString(order.Name); err != nil {
+						return "", nil, err
+					}
+					if order.Descending {
+						if _, err := comp.WriteString(" DESC"); err != nil {
+							return "", nil, err
+						}
+					}
+					i++
+					break
+				}
+			}
+		}
+	}
+	if fe.Offset > 0 {
+		if _, err := comp.WriteString(" OFFSET "); err != nil {
+			return "", nil, err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		if _, err := comp.WriteString(" "); err != nil {
+			return "", nil, err
+		}
+		comp.Add(fe.Offset)
+	}
+	if fe.Limit > 0 {
+		if _, err := comp.WriteString(" LIMIT "); err != nil {
+			return "", nil, err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		if _, err := comp.WriteString(" "); err != nil {
+			return "", nil, err
+		}
+		comp.Add(fe.Limit)
+	}
+
+	buf.ReadFrom(comp)
+
+	return buf.String(), comp.Args(), nil
+}
+
+func (r *APIKeyRepositoryBase) find(ctx context.Context, tx *sql.Tx, fe *APIKeyFindExpr) ([]*APIKeyEntity, error) {
+	query, args, err := r.FindQuery(fe)
+	if err != nil {
+		return nil, err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableAPIKey, "find", query, args...)
+		} else {
+			r.Log(err, TableAPIKey, "find tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		entities []*APIKeyEntity
+		props    []interface{}
+	)
+	for rows.Next() {
+		var ent APIKeyEntity
+		if props, err = ent.Props(); err != nil {
+			return nil, err
+		}
+		var prop []interface{}
+		if fe.JoinUser != nil && fe.JoinUser.Kind.Actionable() && fe.JoinUser.Fetch {
+			ent.User = &UserEntity{}
+			if prop, err = ent.User.Props(); err != nil {
+				return nil, err
+			}
+			props = append(props, prop...)
+		}
+		if fe.JoinAuthor != nil && fe.JoinAuthor.Kind.Actionable() && fe.JoinAuthor.Fetch {
+			ent.Author = &UserEntity{}
+			if prop, err = ent.Author.Props(); err != nil {
+				return nil, err
+			}
+			props = append(props, prop...)
+		}
+		if fe.JoinModifier != nil && fe.JoinModifier.Kind.Actionable() && fe.JoinModifier.Fetch {
+			ent.Modifier = &UserEntity{}
+			if prop, err = ent.Modifier.Props(); err != nil {
+				return nil, err
+			}
+			props = append(props, prop...)
+		}
+		err = rows.Scan(props...)
+		if err != nil {
+			return nil, err
+		}
+
+		entities = append(entities, &ent)
+	}
+	err = rows.Err()
+	if r.Log != nil {
+		r.Log(err, TableAPIKey, "find", query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return entities, nil
+}
+
+func (r *APIKeyRepositoryBase) Find(ctx context.Context, fe *APIKeyFindExpr) ([]*APIKeyEntity, error) {
+	return r.find(ctx, nil, fe)
+}
+
+func (r *APIKeyRepositoryBase) findIter(ctx context.Context, tx *sql.Tx, fe *APIKeyFindExpr) (*APIKeyIterator, error) {
+	query, args, err := r.FindQuery(fe)
+	if err != nil {
+		return nil, err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableAPIKey, "find iter", query, args...)
+		} else {
+			r.Log(err, TableAPIKey, "find iter tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &APIKeyIterator{
+		rows: rows,
+		expr: fe,
+		cols: fe.Columns,
+	}, nil
+}
+
+func (r *APIKeyRepositoryBase) FindIter(ctx context.Context, fe *APIKeyFindExpr) (*APIKeyIterator, error) {
+	return r.findIter(ctx, nil, fe)
+}
+
+func (r *APIKeyRepositoryBase) findOneByID(ctx context.Context, tx *sql.Tx, pk int64) (*APIKeyEntity, error) {
+	find := NewComposer(13)
+	find.WriteString("SELECT ")
+	if len(r.Columns) == 0 {
+		find.WriteString("created_at, created_by, expire_at, id, last_used_at, notes, permissions, prefix, revoked, secret, updated_at, updated_by, user_id")
+	} else {
+		find.WriteString(strings.Join(r.Columns, ", "))
+	}
+	find.WriteString(" FROM ")
+	find.WriteString(TableAPIKey)
+	find.WriteString(" WHERE ")
+	find.WriteString(TableAPIKeyColumnID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(pk)
+	var (
+		ent APIKeyEntity
+	)
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
+	} else {
+		err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableAPIKey, "find by primary key", find.String(), find.Args()...)
+		} else {
+			r.Log(err, TableAPIKey, "find by primary key tx", find.String(), find.Args()...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ent, nil
+}
+
+func (r *APIKeyRepositoryBase) FindOneByID(ctx context.Context, pk int64) (*APIKeyEntity, error) {
+	return r.findOneByID(ctx, nil, pk)
+}
+
+func (r *APIKeyRepositoryBase) findOneByPrefix(ctx context.Context, tx *sql.Tx, apiKeyPrefix string) (*APIKeyEntity, error) {
+	find := NewComposer(13)
+	find.WriteString("SELECT ")
+	if len(r.Columns) == 0 {
+		find.WriteString("created_at, created_by, expire_at, id, last_used_at, notes, permissions, prefix, revoked, secret, updated_at, updated_by, user_id")
+	} else {
+		find.WriteString(strings.Join(r.Columns, ", "))
+	}
+	find.WriteString(" FROM ")
+	find.WriteString(TableAPIKey)
+	find.WriteString(" WHERE ")
+	find.WriteString(TableAPIKeyColumnPrefix)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(apiKeyPrefix)
+
+	var (
+		ent APIKeyEntity
+	)
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
+	} else {
+		err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &ent, nil
+}
+
+func (r *APIKeyRepositoryBase) FindOneByPrefix(ctx context.Context, apiKeyPrefix string) (*APIKeyEntity, error) {
+	return r.findOneByPrefix(ctx, nil, apiKeyPrefix)
+}
+
+func (r *APIKeyRepositoryBase) UpdateOneByIDQuery(pk int64, p *APIKeyPatch) (string, []interface{}, error) {
+	buf := bytes.NewBufferString("UPDATE ")
+	buf.WriteString(r.Table)
+	update := NewComposer(13)
+	if p.CreatedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableAPIKeyColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.CreatedAt)
+		update.Dirty = true
+
+	}
+	if p.CreatedBy.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableAPIKeyColumnCreatedBy); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.CreatedBy)
+		update.Dirty = true
+	}
+
+	if p.ExpireAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableAPIKeyColumnExpireAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.ExpireAt)
+		update.Dirty = true
+
+	}
+	if p.LastUsedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableAPIKeyColumnLastUsedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.LastUsedAt)
+		update.Dirty = true
+
+	}
+	if p.Notes.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableAPIKeyColumnNotes); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Notes)
+		update.Dirty = true
+	}
+
+	if p.Permissions.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableAPIKeyColumnPermissions); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Permissions)
+		update.Dirty = true
+	}
+
+	if p.Prefix.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableAPIKeyColumnPrefix); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Prefix)
+		update.Dirty = true
+	}
+
+	if p.Revoked.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableAPIKeyColumnRevoked); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Revoked)
+		update.Dirty = true
+	}
+
+	if p.Secret != nil {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableAPIKeyColumnSecret); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Secret)
+		update.Dirty = true
+
+	}
+	if p.UpdatedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableAPIKeyColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.UpdatedAt)
+		update.Dirty = true
+
+	} else {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableAPIKeyColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("=NOW()"); err != nil {
+			return "", nil, err
+		}
+		update.Dirty = true
+	}
+	if p.UpdatedBy.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableAPIKeyColumnUpdatedBy); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.UpdatedBy)
+		update.Dirty = true
+	}
+
+	if p.UserID.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableAPIKeyColumnUserID); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.UserID)
+		update.Dirty = true
+	}
+
+	if !update.Dirty {
+		return "", nil, errors.New("APIKey update failure, nothing to update")
+	}
+	buf.WriteString(" SET ")
+	buf.ReadFrom(update)
+	buf.WriteString(" WHERE ")
+
+	update.WriteString(TableAPIKeyColumnID)
+	update.WriteString("=")
+	update.WritePlaceholder()
+	update.Add(pk)
+
+	buf.ReadFrom(update)
+	buf.WriteString(" RETURNING ")
+	if len(r.Columns) > 0 {
+		buf.WriteString(strings.Join(r.Columns, ", "))
+	} else {
+		buf.WriteString("created_at, created_by, expire_at, id, last_used_at, notes, permissions, prefix, revoked, secret, updated_at, updated_by, user_id")
+	}
+	return buf.String(), update.Args(), nil
+}
+
+func (r *APIKeyRepositoryBase) updateOneByID(ctx context.Context, tx *sql.Tx, pk int64, p *APIKeyPatch) (*APIKeyEntity, error) {
+	query, args, err := r.UpdateOneByIDQuery(pk, p)
+	if err != nil {
+		return nil, err
+	}
+	var ent APIKeyEntity
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, query, args...).Scan(props...)
+	} else {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(props...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableAPIKey, "update by primary key", query, args...)
+		} else {
+			r.Log(err, TableAPIKey, "update by primary key tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ent, nil
+}
+
+func (r *APIKeyRepositoryBase) UpdateOneByID(ctx context.Context, pk int64, p *APIKeyPatch) (*APIKeyEntity, error) {
+	return r.updateOneByID(ctx, nil, pk, p)
+}
+
+func (r *APIKeyRepositoryBase) FindOneByIDAndUpdate(ctx context.Context, pk int64, p *APIKeyPatch) (before, after *APIKeyEntity, err error) {
+	find := NewComposer(13)
+	find.WriteString("SELECT ")
+	if len(r.Columns) == 0 {
+		find.WriteString("created_at, created_by, expire_at, id, last_used_at, notes, permissions, prefix, revoked, secret, updated_at, updated_by, user_id")
+	} else {
+		find.WriteString(strings.Join(r.Columns, ", "))
+	}
+	find.WriteString(" FROM ")
+	find.WriteString(TableAPIKey)
+	find.WriteString(" WHERE ")
+	find.WriteString(TableAPIKeyColumnID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(pk)
+	find.WriteString(" FOR UPDATE")
+	query, args, err := r.UpdateOneByIDQuery(pk, p)
+	if err != nil {
+		return
+	}
+	var (
+		oldEnt, newEnt APIKeyEntity
+	)
+	oldProps, err := oldEnt.Props(r.Columns...)
+	if err != nil {
+		return
+	}
+	newProps, err := newEnt.Props(r.Columns...)
+	if err != nil {
+		return
+	}
+	tx, err := r.DB.Begin()
+	if err != nil {
+		return
+	}
+	err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(oldProps...)
+	if r.Log != nil {
+		r.Log(err, TableAPIKey, "find by primary key", find.String(), find.Args()...)
+	}
+	if err != nil {
+		tx.Rollback()
+		return
+	}
+	err = tx.QueryRowContext(ctx, query, args...).Scan(newProps...)
+	if r.Log != nil {
+		r.Log(err, TableAPIKey, "update by primary key", query, args...)
+	}
+	if err != nil {
+		tx.Rollback()
+		return
+	}
+	err = tx.Commit()
+	if err != nil {
+		return
+	}
+	return &oldEnt, &newEnt, nil
+}
+
+func (r *APIKeyRepositoryBase) UpdateOneByPrefixQuery(apiKeyPrefix string, p *APIKeyPatch) (string, []interface{}, error) {
+	buf := bytes.NewBufferString("UPDATE ")
+	buf.WriteString(r.Table)
+	update := NewComposer(1)
+	if p.CreatedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableAPIKeyColumnCreatedAt); err != nil {
 			return "", nil, err
 		}
 		if _, err := update.WriteString("="); err != nil {
@@ -11641,7 +30173,7 @@ func (r *RefreshTokenRepositoryBase) UpdateOneByTokenQuery(refreshTokenToken str
 				return "", nil, err
 			}
 		}
-		if _, err := update.WriteString(TableRefreshTokenColumnCreatedBy); err != nil {
+		if _, err := update.WriteString(TableAPIKeyColumnCreatedBy); err != nil {
 			return "", nil, err
 		}
 		if _, err := update.WriteString("="); err != nil {
@@ -11660,7 +30192,7 @@ func (r *RefreshTokenRepositoryBase) UpdateOneByTokenQuery(refreshTokenToken str
 				return "", nil, err
 			}
 		}
-		if _, err := update.WriteString(TableRefreshTokenColumnExpireAt); err != nil {
+		if _, err := update.WriteString(TableAPIKeyColumnExpireAt); err != nil {
 			return "", nil, err
 		}
 		if _, err := update.WriteString("="); err != nil {
@@ -11679,7 +30211,7 @@ func (r *RefreshTokenRepositoryBase) UpdateOneByTokenQuery(refreshTokenToken str
 				return "", nil, err
 			}
 		}
-		if _, err := update.WriteString(TableRefreshTokenColumnLastUsedAt); err != nil {
+		if _, err := update.WriteString(TableAPIKeyColumnLastUsedAt); err != nil {
 			return "", nil, err
 		}
 		if _, err := update.WriteString("="); err != nil {
@@ -11698,7 +30230,7 @@ func (r *RefreshTokenRepositoryBase) UpdateOneByTokenQuery(refreshTokenToken str
 				return "", nil, err
 			}
 		}
-		if _, err := update.WriteString(TableRefreshTokenColumnNotes); err != nil {
+		if _, err := update.WriteString(TableAPIKeyColumnNotes); err != nil {
 			return "", nil, err
 		}
 		if _, err := update.WriteString("="); err != nil {
@@ -11711,13 +30243,51 @@ func (r *RefreshTokenRepositoryBase) UpdateOneByTokenQuery(refreshTokenToken str
 		update.Dirty = true
 	}
 
+	if p.Permissions.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableAPIKeyColumnPermissions); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Permissions)
+		update.Dirty = true
+	}
+
+	if p.Prefix.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableAPIKeyColumnPrefix); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Prefix)
+		update.Dirty = true
+	}
+
 	if p.Revoked.Valid {
 		if update.Dirty {
 			if _, err := update.WriteString(", "); err != nil {
 				return "", nil, err
 			}
 		}
-		if _, err := update.WriteString(TableRefreshTokenColumnRevoked); err != nil {
+		if _, err := update.WriteString(TableAPIKeyColumnRevoked); err != nil {
 			return "", nil, err
 		}
 		if _, err := update.WriteString("="); err != nil {
@@ -11730,13 +30300,13 @@ func (r *RefreshTokenRepositoryBase) UpdateOneByTokenQuery(refreshTokenToken str
 		update.Dirty = true
 	}
 
-	if p.Token.Valid {
+	if p.Secret != nil {
 		if update.Dirty {
 			if _, err := update.WriteString(", "); err != nil {
 				return "", nil, err
 			}
 		}
-		if _, err := update.WriteString(TableRefreshTokenColumnToken); err != nil {
+		if _, err := update.WriteString(TableAPIKeyColumnSecret); err != nil {
 			return "", nil, err
 		}
 		if _, err := update.WriteString("="); err != nil {
@@ -11745,17 +30315,17 @@ func (r *RefreshTokenRepositoryBase) UpdateOneByTokenQuery(refreshTokenToken str
 		if err := update.WritePlaceholder(); err != nil {
 			return "", nil, err
 		}
-		update.Add(p.Token)
+		update.Add(p.Secret)
 		update.Dirty = true
-	}
 
+	}
 	if p.UpdatedAt.Valid {
 		if update.Dirty {
 			if _, err := update.WriteString(", "); err != nil {
 				return "", nil, err
 			}
 		}
-		if _, err := update.WriteString(TableRefreshTokenColumnUpdatedAt); err != nil {
+		if _, err := update.WriteString(TableAPIKeyColumnUpdatedAt); err != nil {
 			return "", nil, err
 		}
 		if _, err := update.WriteString("="); err != nil {
@@ -11773,7 +30343,7 @@ func (r *RefreshTokenRepositoryBase) UpdateOneByTokenQuery(refreshTokenToken str
 				return "", nil, err
 			}
 		}
-		if _, err := update.WriteString(TableRefreshTokenColumnUpdatedAt); err != nil {
+		if _, err := update.WriteString(TableAPIKeyColumnUpdatedAt); err != nil {
 			return "", nil, err
 		}
 		if _, err := update.WriteString("=NOW()"); err != nil {
@@ -11787,7 +30357,7 @@ func (r *RefreshTokenRepositoryBase) UpdateOneByTokenQuery(refreshTokenToken str
 				return "", nil, err
 			}
 		}
-		if _, err := update.WriteString(TableRefreshTokenColumnUpdatedBy); err != nil {
+		if _, err := update.WriteString(TableAPIKeyColumnUpdatedBy); err != nil {
 			return "", nil, err
 		}
 		if _, err := update.WriteString("="); err != nil {
@@ -11806,7 +30376,7 @@ func (r *RefreshTokenRepositoryBase) UpdateOneByTokenQuery(refreshTokenToken str
 				return "", nil, err
 			}
 		}
-		if _, err := update.WriteString(TableRefreshTokenColumnUserID); err != nil {
+		if _, err := update.WriteString(TableAPIKeyColumnUserID); err != nil {
 			return "", nil, err
 		}
 		if _, err := update.WriteString("="); err != nil {
@@ -11820,31 +30390,31 @@ func (r *RefreshTokenRepositoryBase) UpdateOneByTokenQuery(refreshTokenToken str
 	}
 
 	if !update.Dirty {
-		return "", nil, errors.New("refresh_token update failure, nothing to update")
+		return "", nil, errors.New("api_key update failure, nothing to update")
 	}
 	buf.WriteString(" SET ")
 	buf.ReadFrom(update)
 	buf.WriteString(" WHERE ")
-	update.WriteString(TableRefreshTokenColumnToken)
+	update.WriteString(TableAPIKeyColumnPrefix)
 	update.WriteString("=")
 	update.WritePlaceholder()
-	update.Add(refreshTokenToken)
+	update.Add(apiKeyPrefix)
 	buf.ReadFrom(update)
 	buf.WriteString(" RETURNING ")
 	if len(r.Columns) > 0 {
 		buf.WriteString(strings.Join(r.Columns, ", "))
 	} else {
-		buf.WriteString("created_at, created_by, expire_at, last_used_at, notes, revoked, token, updated_at, updated_by, user_id")
+		buf.WriteString("created_at, created_by, expire_at, id, last_used_at, notes, permissions, prefix, revoked, secret, updated_at, updated_by, user_id")
 	}
 	return buf.String(), update.Args(), nil
 }
 
-func (r *RefreshTokenRepositoryBase) updateOneByToken(ctx context.Context, tx *sql.Tx, refreshTokenToken string, p *RefreshTokenPatch) (*RefreshTokenEntity, error) {
-	query, args, err := r.UpdateOneByTokenQuery(refreshTokenToken, p)
+func (r *APIKeyRepositoryBase) updateOneByPrefix(ctx context.Context, tx *sql.Tx, apiKeyPrefix string, p *APIKeyPatch) (*APIKeyEntity, error) {
+	query, args, err := r.UpdateOneByPrefixQuery(apiKeyPrefix, p)
 	if err != nil {
 		return nil, err
 	}
-	var ent RefreshTokenEntity
+	var ent APIKeyEntity
 	props, err := ent.Props(r.Columns...)
 	if err != nil {
 		return nil, err
@@ -11859,9 +30429,9 @@ func (r *RefreshTokenRepositoryBase) updateOneByToken(ctx context.Context, tx *s
 	err = row.Scan(props...)
 	if r.Log != nil {
 		if tx == nil {
-			r.Log(err, TableRefreshToken, "update one by unique", query, args...)
+			r.Log(err, TableAPIKey, "update one by unique", query, args...)
 		} else {
-			r.Log(err, TableRefreshToken, "update one by unique tx", query, args...)
+			r.Log(err, TableAPIKey, "update one by unique tx", query, args...)
 		}
 	}
 	if err != nil {
@@ -11870,12 +30440,12 @@ func (r *RefreshTokenRepositoryBase) updateOneByToken(ctx context.Context, tx *s
 	return &ent, nil
 }
 
-func (r *RefreshTokenRepositoryBase) UpdateOneByToken(ctx context.Context, refreshTokenToken string, p *RefreshTokenPatch) (*RefreshTokenEntity, error) {
-	return r.updateOneByToken(ctx, nil, refreshTokenToken, p)
+func (r *APIKeyRepositoryBase) UpdateOneByPrefix(ctx context.Context, apiKeyPrefix string, p *APIKeyPatch) (*APIKeyEntity, error) {
+	return r.updateOneByPrefix(ctx, nil, apiKeyPrefix, p)
 }
 
-func (r *RefreshTokenRepositoryBase) UpsertQuery(e *RefreshTokenEntity, p *RefreshTokenPatch, inf ...string) (string, []interface{}, error) {
-	upsert := NewComposer(20)
+func (r *APIKeyRepositoryBase) UpsertQuery(e *APIKeyEntity, p *APIKeyPatch, inf ...string) (string, []interface{}, error) {
+	upsert := NewComposer(26)
 	columns := bytes.NewBuffer(nil)
 	buf := bytes.NewBufferString("INSERT INTO ")
 	buf.WriteString(r.Table)
@@ -11886,7 +30456,7 @@ func (r *RefreshTokenRepositoryBase) UpsertQuery(e *RefreshTokenEntity, p *Refre
 				return "", nil, err
 			}
 		}
-		if _, err := columns.WriteString(TableRefreshTokenColumnCreatedAt); err != nil {
+		if _, err := columns.WriteString(TableAPIKeyColumnCreatedAt); err != nil {
 			return "", nil, err
 		}
 		if upsert.Dirty {
@@ -11906,7 +30476,7 @@ func (r *RefreshTokenRepositoryBase) UpsertQuery(e *RefreshTokenEntity, p *Refre
 			return "", nil, err
 		}
 	}
-	if _, err := columns.WriteString(TableRefreshTokenColumnCreatedBy); err != nil {
+	if _, err := columns.WriteString(TableAPIKeyColumnCreatedBy); err != nil {
 		return "", nil, err
 	}
 	if upsert.Dirty {
@@ -11926,7 +30496,7 @@ func (r *RefreshTokenRepositoryBase) UpsertQuery(e *RefreshTokenEntity, p *Refre
 				return "", nil, err
 			}
 		}
-		if _, err := columns.WriteString(TableRefreshTokenColumnExpireAt); err != nil {
+		if _, err := columns.WriteString(TableAPIKeyColumnExpireAt); err != nil {
 			return "", nil, err
 		}
 		if upsert.Dirty {
@@ -11947,7 +30517,7 @@ func (r *RefreshTokenRepositoryBase) UpsertQuery(e *RefreshTokenEntity, p *Refre
 				return "", nil, err
 			}
 		}
-		if _, err := columns.WriteString(TableRefreshTokenColumnLastUsedAt); err != nil {
+		if _, err := columns.WriteString(TableAPIKeyColumnLastUsedAt); err != nil {
 			return "", nil, err
 		}
 		if upsert.Dirty {
@@ -11967,7 +30537,7 @@ func (r *RefreshTokenRepositoryBase) UpsertQuery(e *RefreshTokenEntity, p *Refre
 			return "", nil, err
 		}
 	}
-	if _, err := columns.WriteString(TableRefreshTokenColumnNotes); err != nil {
+	if _, err := columns.WriteString(TableAPIKeyColumnNotes); err != nil {
 		return "", nil, err
 	}
 	if upsert.Dirty {
@@ -11986,7 +30556,7 @@ func (r *RefreshTokenRepositoryBase) UpsertQuery(e *RefreshTokenEntity, p *Refre
 			return "", nil, err
 		}
 	}
-	if _, err := columns.WriteString(TableRefreshTokenColumnRevoked); err != nil {
+	if _, err := columns.WriteString(TableAPIKeyColumnPermissions); err != nil {
 		return "", nil, err
 	}
 	if upsert.Dirty {
@@ -11997,7 +30567,26 @@ func (r *RefreshTokenRepositoryBase) UpsertQuery(e *RefreshTokenEntity, p *Refre
 	if err := upsert.WritePlaceholder(); err != nil {
 		return "", nil, err
 	}
-	upsert.Add(e.Revoked)
+	upsert.Add(e.Permissions)
+	upsert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableAPIKeyColumnPrefix); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.Prefix)
 	upsert.Dirty = true
 
 	if columns.Len() > 0 {
@@ -12005,7 +30594,7 @@ func (r *RefreshTokenRepositoryBase) UpsertQuery(e *RefreshTokenEntity, p *Refre
 			return "", nil, err
 		}
 	}
-	if _, err := columns.WriteString(TableRefreshTokenColumnToken); err != nil {
+	if _, err := columns.WriteString(TableAPIKeyColumnRevoked); err != nil {
 		return "", nil, err
 	}
 	if upsert.Dirty {
@@ -12016,16 +30605,37 @@ func (r *RefreshTokenRepositoryBase) UpsertQuery(e *RefreshTokenEntity, p *Refre
 	if err := upsert.WritePlaceholder(); err != nil {
 		return "", nil, err
 	}
-	upsert.Add(e.Token)
+	upsert.Add(e.Revoked)
 	upsert.Dirty = true
 
+	if e.Secret != nil {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableAPIKeyColumnSecret); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.Secret)
+		upsert.Dirty = true
+	}
+
 	if e.UpdatedAt.Valid {
 		if columns.Len() > 0 {
 			if _, err := columns.WriteString(", "); err != nil {
 				return "", nil, err
 			}
 		}
-		if _, err := columns.WriteString(TableRefreshTokenColumnUpdatedAt); err != nil {
+		if _, err := columns.WriteString(TableAPIKeyColumnUpdatedAt); err != nil {
 			return "", nil, err
 		}
 		if upsert.Dirty {
@@ -12045,7 +30655,7 @@ func (r *RefreshTokenRepositoryBase) UpsertQuery(e *RefreshTokenEntity, p *Refre
 			return "", nil, err
 		}
 	}
-	if _, err := columns.WriteString(TableRefreshTokenColumnUpdatedBy); err != nil {
+	if _, err := columns.WriteString(TableAPIKeyColumnUpdatedBy); err != nil {
 		return "", nil, err
 	}
 	if upsert.Dirty {
@@ -12064,7 +30674,7 @@ func (r *RefreshTokenRepositoryBase) UpsertQuery(e *RefreshTokenEntity, p *Refre
 			return "", nil, err
 		}
 	}
-	if _, err := columns.WriteString(TableRefreshTokenColumnUserID); err != nil {
+	if _, err := columns.WriteString(TableAPIKeyColumnUserID); err != nil {
 		return "", nil, err
 	}
 	if upsert.Dirty {
@@ -12094,7 +30704,7 @@ func (r *RefreshTokenRepositoryBase) UpsertQuery(e *RefreshTokenEntity, p *Refre
 					return "", nil, err
 				}
 			}
-			if _, err := upsert.WriteString(TableRefreshTokenColumnCreatedAt); err != nil {
+			if _, err := upsert.WriteString(TableAPIKeyColumnCreatedAt); err != nil {
 				return "", nil, err
 			}
 			if _, err := upsert.WriteString("="); err != nil {
@@ -12113,7 +30723,7 @@ func (r *RefreshTokenRepositoryBase) UpsertQuery(e *RefreshTokenEntity, p *Refre
 					return "", nil, err
 				}
 			}
-			if _, err := upsert.WriteString(TableRefreshTokenColumnCreatedBy); err != nil {
+			if _, err := upsert.WriteString(TableAPIKeyColumnCreatedBy); err != nil {
 				return "", nil, err
 			}
 			if _, err := upsert.WriteString("="); err != nil {
@@ -12132,7 +30742,7 @@ func (r *RefreshTokenRepositoryBase) UpsertQuery(e *RefreshTokenEntity, p *Refre
 					return "", nil, err
 				}
 			}
-			if _, err := upsert.WriteString(TableRefreshTokenColumnExpireAt); err != nil {
+			if _, err := upsert.WriteString(TableAPIKeyColumnExpireAt); err != nil {
 				return "", nil, err
 			}
 			if _, err := upsert.WriteString("="); err != nil {
@@ -12151,7 +30761,7 @@ func (r *RefreshTokenRepositoryBase) UpsertQuery(e *RefreshTokenEntity, p *Refre
 					return "", nil, err
 				}
 			}
-			if _, err := upsert.WriteString(TableRefreshTokenColumnLastUsedAt); err != nil {
+			if _, err := upsert.WriteString(TableAPIKeyColumnLastUsedAt); err != nil {
 				return "", nil, err
 			}
 			if _, err := upsert.WriteString("="); err != nil {
@@ -12170,7 +30780,7 @@ func (r *RefreshTokenRepositoryBase) UpsertQuery(e *RefreshTokenEntity, p *Refre
 					return "", nil, err
 				}
 			}
-			if _, err := upsert.WriteString(TableRefreshTokenColumnNotes); err != nil {
+			if _, err := upsert.WriteString(TableAPIKeyColumnNotes); err != nil {
 				return "", nil, err
 			}
 			if _, err := upsert.WriteString("="); err != nil {
@@ -12183,13 +30793,51 @@ func (r *RefreshTokenRepositoryBase) UpsertQuery(e *RefreshTokenEntity, p *Refre
 			upsert.Dirty = true
 		}
 
+		if p.Permissions.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableAPIKeyColumnPermissions); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Permissions)
+			upsert.Dirty = true
+		}
+
+		if p.Prefix.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableAPIKeyColumnPrefix); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Prefix)
+			upsert.Dirty = true
+		}
+
 		if p.Revoked.Valid {
 			if upsert.Dirty {
 				if _, err := upsert.WriteString(", "); err != nil {
 					return "", nil, err
 				}
 			}
-			if _, err := upsert.WriteString(TableRefreshTokenColumnRevoked); err != nil {
+			if _, err := upsert.WriteString(TableAPIKeyColumnRevoked); err != nil {
 				return "", nil, err
 			}
 			if _, err := upsert.WriteString("="); err != nil {
@@ -12202,13 +30850,13 @@ func (r *RefreshTokenRepositoryBase) UpsertQuery(e *RefreshTokenEntity, p *Refre
 			upsert.Dirty = true
 		}
 
-		if p.Token.Valid {
+		if p.Secret != nil {
 			if upsert.Dirty {
 				if _, err := upsert.WriteString(", "); err != nil {
 					return "", nil, err
 				}
 			}
-			if _, err := upsert.WriteString(TableRefreshTokenColumnToken); err != nil {
+			if _, err := upsert.WriteString(TableAPIKeyColumnSecret); err != nil {
 				return "", nil, err
 			}
 			if _, err := upsert.WriteString("="); err != nil {
@@ -12217,17 +30865,17 @@ func (r *RefreshTokenRepositoryBase) UpsertQuery(e *RefreshTokenEntity, p *Refre
 			if err := upsert.WritePlaceholder(); err != nil {
 				return "", nil, err
 			}
-			upsert.Add(p.Token)
+			upsert.Add(p.Secret)
 			upsert.Dirty = true
-		}
 
+		}
 		if p.UpdatedAt.Valid {
 			if upsert.Dirty {
 				if _, err := upsert.WriteString(", "); err != nil {
 					return "", nil, err
 				}
 			}
-			if _, err := upsert.WriteString(TableRefreshTokenColumnUpdatedAt); err != nil {
+			if _, err := upsert.WriteString(TableAPIKeyColumnUpdatedAt); err != nil {
 				return "", nil, err
 			}
 			if _, err := upsert.WriteString("="); err != nil {
@@ -12245,7 +30893,7 @@ func (r *RefreshTokenRepositoryBase) UpsertQuery(e *RefreshTokenEntity, p *Refre
 					return "", nil, err
 				}
 			}
-			if _, err := upsert.WriteString(TableRefreshTokenColumnUpdatedAt); err != nil {
+			if _, err := upsert.WriteString(TableAPIKeyColumnUpdatedAt); err != nil {
 				return "", nil, err
 			}
 			if _, err := upsert.WriteString("=NOW()"); err != nil {
@@ -12259,7 +30907,7 @@ func (r *RefreshTokenRepositoryBase) UpsertQuery(e *RefreshTokenEntity, p *Refre
 					return "", nil, err
 				}
 			}
-			if _, err := upsert.WriteString(TableRefreshTokenColumnUpdatedBy); err != nil {
+			if _, err := upsert.WriteString(TableAPIKeyColumnUpdatedBy); err != nil {
 				return "", nil, err
 			}
 			if _, err := upsert.WriteString("="); err != nil {
@@ -12278,7 +30926,7 @@ func (r *RefreshTokenRepositoryBase) UpsertQuery(e *RefreshTokenEntity, p *Refre
 					return "", nil, err
 				}
 			}
-			if _, err := upsert.WriteString(TableRefreshTokenColumnUserID); err != nil {
+			if _, err := upsert.WriteString(TableAPIKeyColumnUserID); err != nil {
 				return "", nil, err
 			}
 			if _, err := upsert.WriteString("="); err != nil {
@@ -12311,13 +30959,13 @@ func (r *RefreshTokenRepositoryBase) UpsertQuery(e *RefreshTokenEntity, p *Refre
 		if len(r.Columns) > 0 {
 			buf.WriteString(strings.Join(r.Columns, ", "))
 		} else {
-			buf.WriteString("created_at, created_by, expire_at, last_used_at, notes, revoked, token, updated_at, updated_by, user_id")
+			buf.WriteString("created_at, created_by, expire_at, id, last_used_at, notes, permissions, prefix, revoked, secret, updated_at, updated_by, user_id")
 		}
 	}
 	return buf.String(), upsert.Args(), nil
 }
 
-func (r *RefreshTokenRepositoryBase) upsert(ctx context.Context, tx *sql.Tx, e *RefreshTokenEntity, p *RefreshTokenPatch, inf ...string) (*RefreshTokenEntity, error) {
+func (r *APIKeyRepositoryBase) upsert(ctx context.Context, tx *sql.Tx, e *APIKeyEntity, p *APIKeyPatch, inf ...string) (*APIKeyEntity, error) {
 	query, args, err := r.UpsertQuery(e, p, inf...)
 	if err != nil {
 		return nil, err
@@ -12333,19 +30981,22 @@ func (r *RefreshTokenRepositoryBase) upsert(ctx context.Context, tx *sql.Tx, e *
 		&e.CreatedAt,
 		&e.CreatedBy,
 		&e.ExpireAt,
+		&e.ID,
 		&e.LastUsedAt,
 		&e.Notes,
+		&e.Permissions,
+		&e.Prefix,
 		&e.Revoked,
-		&e.Token,
+		&e.Secret,
 		&e.UpdatedAt,
 		&e.UpdatedBy,
 		&e.UserID,
 	)
 	if r.Log != nil {
 		if tx == nil {
-			r.Log(err, TableRefreshToken, "upsert", query, args...)
+			r.Log(err, TableAPIKey, "upsert", query, args...)
 		} else {
-			r.Log(err, TableRefreshToken, "upsert tx", query, args...)
+			r.Log(err, TableAPIKey, "upsert tx", query, args...)
 		}
 	}
 	if err != nil {
@@ -12354,12 +31005,12 @@ func (r *RefreshTokenRepositoryBase) upsert(ctx context.Context, tx *sql.Tx, e *
 	return e, nil
 }
 
-func (r *RefreshTokenRepositoryBase) Upsert(ctx context.Context, e *RefreshTokenEntity, p *RefreshTokenPatch, inf ...string) (*RefreshTokenEntity, error) {
+func (r *APIKeyRepositoryBase) Upsert(ctx context.Context, e *APIKeyEntity, p *APIKeyPatch, inf ...string) (*APIKeyEntity, error) {
 	return r.upsert(ctx, nil, e, p, inf...)
 }
 
-func (r *RefreshTokenRepositoryBase) count(ctx context.Context, tx *sql.Tx, exp *RefreshTokenCountExpr) (int64, error) {
-	query, args, err := r.FindQuery(&RefreshTokenFindExpr{
+func (r *APIKeyRepositoryBase) count(ctx context.Context, tx *sql.Tx, exp *APIKeyCountExpr) (int64, error) {
+	query, args, err := r.FindQuery(&APIKeyFindExpr{
 		Where:   exp.Where,
 		Columns: []string{"COUNT(*)"},
 
@@ -12378,9 +31029,9 @@ func (r *RefreshTokenRepositoryBase) count(ctx context.Context, tx *sql.Tx, exp
 	}
 	if r.Log != nil {
 		if tx == nil {
-			r.Log(err, TableRefreshToken, "count", query, args...)
+			r.Log(err, TableAPIKey, "count", query, args...)
 		} else {
-			r.Log(err, TableRefreshToken, "count tx", query, args...)
+			r.Log(err, TableAPIKey, "count tx", query, args...)
 		}
 	}
 	if err != nil {
@@ -12389,47 +31040,88 @@ func (r *RefreshTokenRepositoryBase) count(ctx context.Context, tx *sql.Tx, exp
 	return count, nil
 }
 
-func (r *RefreshTokenRepositoryBase) Count(ctx context.Context, exp *RefreshTokenCountExpr) (int64, error) {
+func (r *APIKeyRepositoryBase) Count(ctx context.Context, exp *APIKeyCountExpr) (int64, error) {
 	return r.count(ctx, nil, exp)
 }
 
-type RefreshTokenRepositoryBaseTx struct {
-	base *RefreshTokenRepositoryBase
+func (r *APIKeyRepositoryBase) deleteOneByID(ctx context.Context, tx *sql.Tx, pk int64) (int64, error) {
+	find := NewComposer(13)
+	find.WriteString("DELETE FROM ")
+	find.WriteString(TableAPIKey)
+	find.WriteString(" WHERE ")
+	find.WriteString(TableAPIKeyColumnID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(pk)
+	var (
+		err error
+		res sql.Result
+	)
+	if tx == nil {
+		res, err = r.DB.ExecContext(ctx, find.String(), find.Args()...)
+	} else {
+		res, err = tx.ExecContext(ctx, find.String(), find.Args()...)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+func (r *APIKeyRepositoryBase) DeleteOneByID(ctx context.Context, pk int64) (int64, error) {
+	return r.deleteOneByID(ctx, nil, pk)
+}
+
+type APIKeyRepositoryBaseTx struct {
+	base *APIKeyRepositoryBase
 	tx   *sql.Tx
 }
 
-func (r RefreshTokenRepositoryBaseTx) Commit() error {
+func (r APIKeyRepositoryBaseTx) Commit() error {
 	return r.tx.Commit()
 }
 
-func (r RefreshTokenRepositoryBaseTx) Rollback() error {
+func (r APIKeyRepositoryBaseTx) Rollback() error {
 	return r.tx.Rollback()
 }
 
-func (r *RefreshTokenRepositoryBaseTx) Insert(ctx context.Context, e *RefreshTokenEntity) (*RefreshTokenEntity, error) {
+func (r *APIKeyRepositoryBaseTx) Insert(ctx context.Context, e *APIKeyEntity) (*APIKeyEntity, error) {
 	return r.base.insert(ctx, r.tx, e)
 }
 
-func (r *RefreshTokenRepositoryBaseTx) Find(ctx context.Context, fe *RefreshTokenFindExpr) ([]*RefreshTokenEntity, error) {
+func (r *APIKeyRepositoryBaseTx) Find(ctx context.Context, fe *APIKeyFindExpr) ([]*APIKeyEntity, error) {
 	return r.base.find(ctx, r.tx, fe)
 }
 
-func (r *RefreshTokenRepositoryBaseTx) FindIter(ctx context.Context, fe *RefreshTokenFindExpr) (*RefreshTokenIterator, error) {
+func (r *APIKeyRepositoryBaseTx) FindIter(ctx context.Context, fe *APIKeyFindExpr) (*APIKeyIterator, error) {
 	return r.base.findIter(ctx, r.tx, fe)
 }
 
-func (r *RefreshTokenRepositoryBaseTx) UpdateOneByToken(ctx context.Context, refreshTokenToken string, p *RefreshTokenPatch) (*RefreshTokenEntity, error) {
-	return r.base.updateOneByToken(ctx, r.tx, refreshTokenToken, p)
+func (r *APIKeyRepositoryBaseTx) FindOneByID(ctx context.Context, pk int64) (*APIKeyEntity, error) {
+	return r.base.findOneByID(ctx, r.tx, pk)
+}
+
+func (r *APIKeyRepositoryBaseTx) UpdateOneByID(ctx context.Context, pk int64, p *APIKeyPatch) (*APIKeyEntity, error) {
+	return r.base.updateOneByID(ctx, r.tx, pk, p)
 }
 
-func (r *RefreshTokenRepositoryBaseTx) Upsert(ctx context.Context, e *RefreshTokenEntity, p *RefreshTokenPatch, inf ...string) (*RefreshTokenEntity, error) {
+func (r *APIKeyRepositoryBaseTx) UpdateOneByPrefix(ctx context.Context, apiKeyPrefix string, p *APIKeyPatch) (*APIKeyEntity, error) {
+	return r.base.updateOneByPrefix(ctx, r.tx, apiKeyPrefix, p)
+}
+
+func (r *APIKeyRepositoryBaseTx) Upsert(ctx context.Context, e *APIKeyEntity, p *APIKeyPatch, inf ...string) (*APIKeyEntity, error) {
 	return r.base.upsert(ctx, r.tx, e, p, inf...)
 }
 
-func (r *RefreshTokenRepositoryBaseTx) Count(ctx context.Context, exp *RefreshTokenCountExpr) (int64, error) {
+func (r *APIKeyRepositoryBaseTx) Count(ctx context.Context, exp *APIKeyCountExpr) (int64, error) {
 	return r.base.count(ctx, r.tx, exp)
 }
 
+func (r *APIKeyRepositoryBaseTx) DeleteOneByID(ctx context.Context, pk int64) (int64, error) {
+	return r.base.deleteOneByID(ctx, r.tx, pk)
+}
+
 const (
 	JoinInner = iota
 	JoinLeft
@@ -13951,17 +32643,29 @@ CREATE SCHEMA IF NOT EXISTS charon;
 
 CREATE TABLE IF NOT EXISTS charon.user (
 	confirmation_token BYTEA,
+	confirmation_token_expire_at TIMESTAMPTZ,
 	created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
 	created_by BIGINT,
+	deleted_at TIMESTAMPTZ,
 	first_name TEXT NOT NULL,
 	id BIGSERIAL,
 	is_active BOOL DEFAULT FALSE NOT NULL,
 	is_confirmed BOOL DEFAULT FALSE NOT NULL,
+	is_service_account BOOL DEFAULT FALSE NOT NULL,
 	is_staff BOOL DEFAULT FALSE NOT NULL,
 	is_superuser BOOL DEFAULT FALSE NOT NULL,
 	last_login_at TIMESTAMPTZ,
 	last_name TEXT NOT NULL,
+	locked_at TIMESTAMPTZ,
 	password BYTEA NOT NULL,
+	password_reset_token BYTEA,
+	password_reset_token_expire_at TIMESTAMPTZ,
+	two_factor_challenge BYTEA,
+	two_factor_challenge_expire_at TIMESTAMPTZ,
+	two_factor_confirmed_at TIMESTAMPTZ,
+	two_factor_last_step BIGINT,
+	two_factor_recovery_codes BYTEA,
+	two_factor_secret BYTEA,
 	updated_at TIMESTAMPTZ,
 	updated_by BIGINT,
 	username TEXT NOT NULL,
@@ -13978,11 +32682,13 @@ CREATE TABLE IF NOT EXISTS charon.group (
 	description TEXT,
 	id BIGSERIAL,
 	name TEXT NOT NULL,
+	parent_id BIGINT,
 	updated_at TIMESTAMPTZ,
 	updated_by BIGINT,
 
 	CONSTRAINT "charon.group_name_key" UNIQUE (name),
 	CONSTRAINT "charon.group_id_pkey" PRIMARY KEY (id),
+	CONSTRAINT "charon.group_parent_id_fkey" FOREIGN KEY (parent_id) REFERENCES charon.group (id),
 	CONSTRAINT "charon.group_created_by_fkey" FOREIGN KEY (created_by) REFERENCES charon.user (id),
 	CONSTRAINT "charon.group_updated_by_fkey" FOREIGN KEY (updated_by) REFERENCES charon.user (id)
 );
@@ -13995,17 +32701,20 @@ CREATE TABLE IF NOT EXISTS charon.permission (
 	subsystem TEXT NOT NULL,
 	updated_at TIMESTAMPTZ,
 
-	CONSTRAINT "charon.permission_subsystem_module_action_key" UNIQUE (subsystem, module, action),
-	CONSTRAINT "charon.permission_id_pkey" PRIMARY KEY (id)
+	CONSTRAINT "charon.permission_id_pkey" PRIMARY KEY (id),
+	CONSTRAINT "charon.permission_subsystem_module_action_key" UNIQUE (subsystem, module, action)
 );
 
 CREATE TABLE IF NOT EXISTS charon.user_groups (
 	created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
 	created_by BIGINT,
 	group_id BIGINT NOT NULL,
+	mapped_from TEXT,
 	updated_at TIMESTAMPTZ,
 	updated_by BIGINT,
 	user_id BIGINT NOT NULL,
+	valid_from TIMESTAMPTZ,
+	valid_until TIMESTAMPTZ,
 
 	CONSTRAINT "charon.user_groups_user_id_fkey" FOREIGN KEY (user_id) REFERENCES charon.user (id),
 	CONSTRAINT "charon.user_groups_group_id_fkey" FOREIGN KEY (group_id) REFERENCES charon.group (id),
@@ -14017,6 +32726,7 @@ CREATE TABLE IF NOT EXISTS charon.user_groups (
 CREATE TABLE IF NOT EXISTS charon.group_permissions (
 	created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
 	created_by BIGINT,
+	denied BOOL DEFAULT FALSE NOT NULL,
 	group_id BIGINT NOT NULL,
 	permission_action TEXT NOT NULL,
 	permission_module TEXT NOT NULL,
@@ -14034,12 +32744,15 @@ CREATE TABLE IF NOT EXISTS charon.group_permissions (
 CREATE TABLE IF NOT EXISTS charon.user_permissions (
 	created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
 	created_by BIGINT,
+	denied BOOL DEFAULT FALSE NOT NULL,
 	permission_action TEXT NOT NULL,
 	permission_module TEXT NOT NULL,
 	permission_subsystem TEXT NOT NULL,
 	updated_at TIMESTAMPTZ,
 	updated_by BIGINT,
 	user_id BIGINT NOT NULL,
+	valid_from TIMESTAMPTZ,
+	valid_until TIMESTAMPTZ,
 
 	CONSTRAINT "charon.user_permissions_user_id_fkey" FOREIGN KEY (user_id) REFERENCES charon.user (id),
 	CONSTRAINT "charon.user_permissions_subsystem_module_action_fkey" FOREIGN KEY (permission_subsystem, permission_module, permission_action) REFERENCES charon.permission (subsystem, module, action),
@@ -14048,23 +32761,169 @@ CREATE TABLE IF NOT EXISTS charon.user_permissions (
 	CONSTRAINT "charon.user_permissions_updated_by_fkey" FOREIGN KEY (updated_by) REFERENCES charon.user (id)
 );
 
+CREATE TABLE IF NOT EXISTS charon.user_resource_permissions (
+	created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+	created_by BIGINT,
+	permission_id BIGINT NOT NULL,
+	resource_id TEXT NOT NULL,
+	resource_type TEXT NOT NULL,
+	updated_at TIMESTAMPTZ,
+	updated_by BIGINT,
+	user_id BIGINT NOT NULL,
+
+	CONSTRAINT "charon.user_resource_permissions_user_id_fkey" FOREIGN KEY (user_id) REFERENCES charon.user (id),
+	CONSTRAINT "charon.user_resource_permissions_permission_id_fkey" FOREIGN KEY (permission_id) REFERENCES charon.permission (id),
+	CONSTRAINT "charon.user_resource_permissions_user_id_permission_id_resource_type_resource_id_key" UNIQUE (user_id, permission_id, resource_type, resource_id),
+	CONSTRAINT "charon.user_resource_permissions_created_by_fkey" FOREIGN KEY (created_by) REFERENCES charon.user (id),
+	CONSTRAINT "charon.user_resource_permissions_updated_by_fkey" FOREIGN KEY (updated_by) REFERENCES charon.user (id)
+);
+
 CREATE TABLE IF NOT EXISTS charon.refresh_token (
 	created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
 	created_by BIGINT,
 	expire_at TIMESTAMPTZ,
+	family TEXT NOT NULL,
 	last_used_at TIMESTAMPTZ,
 	notes TEXT,
+	prefix TEXT NOT NULL,
 	revoked BOOL DEFAULT false NOT NULL,
-	token TEXT NOT NULL,
+	rotated_at TIMESTAMPTZ,
+	secret BYTEA NOT NULL,
 	updated_at TIMESTAMPTZ,
 	updated_by BIGINT,
 	user_id BIGINT NOT NULL,
 
-	CONSTRAINT "charon.refresh_token_token_key" UNIQUE (token),
+	CONSTRAINT "charon.refresh_token_prefix_key" UNIQUE (prefix),
 	CONSTRAINT "charon.refresh_token_user_id_fkey" FOREIGN KEY (user_id) REFERENCES charon.user (id),
 	CONSTRAINT "charon.refresh_token_created_by_fkey" FOREIGN KEY (created_by) REFERENCES charon.user (id),
 	CONSTRAINT "charon.refresh_token_updated_by_fkey" FOREIGN KEY (updated_by) REFERENCES charon.user (id)
 );
 
+CREATE TABLE IF NOT EXISTS charon.login_failure (
+	created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+	failures BIGINT DEFAULT 0 NOT NULL,
+	id BIGSERIAL,
+	kind TEXT NOT NULL,
+	last_failure_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+	subject TEXT NOT NULL,
+	updated_at TIMESTAMPTZ,
+
+	CONSTRAINT "charon.login_failure_kind_subject_key" UNIQUE (kind, subject),
+	CONSTRAINT "charon.login_failure_id_pkey" PRIMARY KEY (id)
+);
+
+CREATE TABLE IF NOT EXISTS charon.audit_event (
+	actor_id BIGINT,
+	after BYTEA,
+	before BYTEA,
+	created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+	id BIGSERIAL,
+	peer TEXT DEFAULT '' NOT NULL,
+	rpc TEXT NOT NULL,
+	target_id BIGINT,
+	target_kind TEXT NOT NULL,
+
+	CONSTRAINT "charon.audit_event_id_pkey" PRIMARY KEY (id)
+);
+
+CREATE TABLE IF NOT EXISTS charon.session (
+	access_token TEXT NOT NULL,
+	bag BYTEA,
+	created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+	expire_at TIMESTAMPTZ NOT NULL,
+	id BIGSERIAL,
+	refresh_token TEXT DEFAULT '' NOT NULL,
+	subject_client TEXT DEFAULT '' NOT NULL,
+	subject_id TEXT NOT NULL,
+
+	CONSTRAINT "charon.session_access_token_key" UNIQUE (access_token),
+	CONSTRAINT "charon.session_id_pkey" PRIMARY KEY (id)
+);
+
+CREATE TABLE IF NOT EXISTS charon.oauth_client (
+	client_id TEXT NOT NULL,
+	created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+	created_by BIGINT,
+	id BIGSERIAL,
+	name TEXT NOT NULL,
+	redirect_uris TEXT NOT NULL,
+	secret BYTEA,
+	updated_at TIMESTAMPTZ,
+	updated_by BIGINT,
+
+	CONSTRAINT "charon.oauth_client_id_pkey" PRIMARY KEY (id),
+	CONSTRAINT "charon.oauth_client_client_id_key" UNIQUE (client_id),
+	CONSTRAINT "charon.oauth_client_created_by_fkey" FOREIGN KEY (created_by) REFERENCES charon.user (id),
+	CONSTRAINT "charon.oauth_client_updated_by_fkey" FOREIGN KEY (updated_by) REFERENCES charon.user (id)
+);
+
+CREATE TABLE IF NOT EXISTS charon.oauth_consent (
+	client_id BIGINT NOT NULL,
+	created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+	id BIGSERIAL,
+	scope TEXT NOT NULL,
+	updated_at TIMESTAMPTZ,
+	user_id BIGINT NOT NULL,
+
+	CONSTRAINT "charon.oauth_consent_user_id_fkey" FOREIGN KEY (user_id) REFERENCES charon.user (id),
+	CONSTRAINT "charon.oauth_consent_client_id_fkey" FOREIGN KEY (client_id) REFERENCES charon.oauth_client (id),
+	CONSTRAINT "charon.oauth_consent_user_id_client_id_key" UNIQUE (user_id, client_id),
+	CONSTRAINT "charon.oauth_consent_id_pkey" PRIMARY KEY (id)
+);
+
+CREATE TABLE IF NOT EXISTS charon.oauth_authorization_code (
+	auth_time TIMESTAMPTZ NOT NULL,
+	client_id BIGINT NOT NULL,
+	code TEXT NOT NULL,
+	code_challenge TEXT DEFAULT '' NOT NULL,
+	created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+	expire_at TIMESTAMPTZ NOT NULL,
+	id BIGSERIAL,
+	nonce TEXT DEFAULT '' NOT NULL,
+	redirect_uri TEXT NOT NULL,
+	scope TEXT NOT NULL,
+	user_id BIGINT NOT NULL,
+
+	CONSTRAINT "charon.oauth_authorization_code_code_key" UNIQUE (code),
+	CONSTRAINT "charon.oauth_authorization_code_client_id_fkey" FOREIGN KEY (client_id) REFERENCES charon.oauth_client (id),
+	CONSTRAINT "charon.oauth_authorization_code_user_id_fkey" FOREIGN KEY (user_id) REFERENCES charon.user (id),
+	CONSTRAINT "charon.oauth_authorization_code_id_pkey" PRIMARY KEY (id)
+);
+
+CREATE TABLE IF NOT EXISTS charon.user_identity (
+	created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+	id BIGSERIAL,
+	issuer TEXT NOT NULL,
+	subject TEXT NOT NULL,
+	updated_at TIMESTAMPTZ,
+	user_id BIGINT NOT NULL,
+
+	CONSTRAINT "charon.user_identity_user_id_fkey" FOREIGN KEY (user_id) REFERENCES charon.user (id),
+	CONSTRAINT "charon.user_identity_issuer_subject_key" UNIQUE (issuer, subject),
+	CONSTRAINT "charon.user_identity_id_pkey" PRIMARY KEY (id)
+);
+
+CREATE TABLE IF NOT EXISTS charon.api_key (
+	created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+	created_by BIGINT,
+	expire_at TIMESTAMPTZ,
+	id BIGSERIAL,
+	last_used_at TIMESTAMPTZ,
+	notes TEXT,
+	permissions TEXT DEFAULT '' NOT NULL,
+	prefix TEXT NOT NULL,
+	revoked BOOL DEFAULT false NOT NULL,
+	secret BYTEA NOT NULL,
+	updated_at TIMESTAMPTZ,
+	updated_by BIGINT,
+	user_id BIGINT NOT NULL,
+
+	CONSTRAINT "charon.api_key_prefix_key" UNIQUE (prefix),
+	CONSTRAINT "charon.api_key_user_id_fkey" FOREIGN KEY (user_id) REFERENCES charon.user (id),
+	CONSTRAINT "charon.api_key_id_pkey" PRIMARY KEY (id),
+	CONSTRAINT "charon.api_key_created_by_fkey" FOREIGN KEY (created_by) REFERENCES charon.user (id),
+	CONSTRAINT "charon.api_key_updated_by_fkey" FOREIGN KEY (updated_by) REFERENCES charon.user (id)
+);
+
 -- sql schema end
 `
@@ -0,0 +1,176 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SessionProvider ...
+type SessionProvider interface {
+	// Insert ...
+	Insert(ctx context.Context, ent *SessionEntity) (*SessionEntity, error)
+	// FindOneByAccessToken retrieves session for given access token, unless it expired.
+	FindOneByAccessToken(ctx context.Context, accessToken string) (*SessionEntity, error)
+	// List retrieves sessions that match all non empty criteria of the expression, expired ones are omitted.
+	List(ctx context.Context, expr *SessionListExpr) ([]*SessionEntity, error)
+	// SetBagValue sets single bag value of a session that has not expired yet and returns the whole bag.
+	SetBagValue(ctx context.Context, accessToken, key, value string) ([]byte, error)
+	// Delete removes sessions that match all non empty criteria of the expression.
+	Delete(ctx context.Context, expr *SessionDeleteExpr) (int64, error)
+}
+
+// SessionListExpr describes sessions to be listed, empty fields are ignored.
+type SessionListExpr struct {
+	SubjectID     string
+	ExpireAtFrom  time.Time
+	ExpireAtTo    time.Time
+	Offset, Limit int64
+}
+
+// SessionDeleteExpr describes sessions to be removed, empty fields are ignored.
+type SessionDeleteExpr struct {
+	AccessToken  string
+	SubjectID    string
+	RefreshToken string
+	ExpireAtFrom time.Time
+	ExpireAtTo   time.Time
+}
+
+// SessionRepository extends SessionRepositoryBase.
+type SessionRepository struct {
+	SessionRepositoryBase
+}
+
+// NewSessionRepository ...
+func NewSessionRepository(dbPool *sql.DB) SessionProvider {
+	return &SessionRepository{
+		SessionRepositoryBase: SessionRepositoryBase{
+			DB:      dbPool,
+			Table:   TableSession,
+			Columns: TableSessionColumns,
+		},
+	}
+}
+
+// FindOneByAccessToken implements SessionProvider interface.
+func (sr *SessionRepository) FindOneByAccessToken(ctx context.Context, accessToken string) (*SessionEntity, error) {
+	query := `
+		SELECT ` + strings.Join(TableSessionColumns, ",") + `
+		FROM ` + sr.Table + `
+		WHERE ` + TableSessionColumnAccessToken + ` = $1 AND ` + TableSessionColumnExpireAt + ` > NOW()
+	`
+
+	rows, err := conn(ctx, sr.DB).QueryContext(ctx, query, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ents, err := ScanSessionRows(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(ents) == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	return ents[0], nil
+}
+
+// List implements SessionProvider interface.
+func (sr *SessionRepository) List(ctx context.Context, expr *SessionListExpr) ([]*SessionEntity, error) {
+	args := []interface{}{}
+	where := []string{TableSessionColumnExpireAt + " > NOW()"}
+	add := func(clause string, arg interface{}) {
+		args = append(args, arg)
+		where = append(where, clause+" $"+strconv.Itoa(len(args)))
+	}
+	if expr.SubjectID != "" {
+		add(TableSessionColumnSubjectID+" =", expr.SubjectID)
+	}
+	if !expr.ExpireAtFrom.IsZero() {
+		add(TableSessionColumnExpireAt+" >=", expr.ExpireAtFrom)
+	}
+	if !expr.ExpireAtTo.IsZero() {
+		add(TableSessionColumnExpireAt+" <=", expr.ExpireAtTo)
+	}
+
+	query := `SELECT ` + strings.Join(TableSessionColumns, ",") + ` FROM ` + sr.Table +
+		` WHERE ` + strings.Join(where, " AND ") + ` ORDER BY ` + TableSessionColumnID
+	if expr.Offset > 0 {
+		args = append(args, expr.Offset)
+		query += ` OFFSET $` + strconv.Itoa(len(args))
+	}
+	if expr.Limit > 0 {
+		args = append(args, expr.Limit)
+		query += ` LIMIT $` + strconv.Itoa(len(args))
+	}
+
+	rows, err := conn(ctx, sr.DB).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return ScanSessionRows(rows)
+}
+
+// SetBagValue implements SessionProvider interface.
+// Bag is a JSON object, it is modified in place so that concurrent updates of different keys do not overwrite each other.
+func (sr *SessionRepository) SetBagValue(ctx context.Context, accessToken, key, value string) ([]byte, error) {
+	query := `
+		UPDATE ` + sr.Table + `
+		SET ` + TableSessionColumnBag + ` = convert_to(
+			(COALESCE(convert_from(` + TableSessionColumnBag + `, 'UTF8'), '{}')::jsonb || jsonb_build_object($2::text, $3::text))::text,
+			'UTF8'
+		)
+		WHERE ` + TableSessionColumnAccessToken + ` = $1 AND ` + TableSessionColumnExpireAt + ` > NOW()
+		RETURNING ` + TableSessionColumnBag
+
+	var bag []byte
+	if err := conn(ctx, sr.DB).QueryRowContext(ctx, query, accessToken, key, value).Scan(&bag); err != nil {
+		return nil, err
+	}
+	return bag, nil
+}
+
+// Delete implements SessionProvider interface.
+func (sr *SessionRepository) Delete(ctx context.Context, expr *SessionDeleteExpr) (int64, error) {
+	var (
+		where []string
+		args  []interface{}
+	)
+	add := func(clause string, arg interface{}) {
+		args = append(args, arg)
+		where = append(where, clause+" $"+strconv.Itoa(len(args)))
+	}
+	if expr.AccessToken != "" {
+		add(TableSessionColumnAccessToken+" =", expr.AccessToken)
+	}
+	if expr.SubjectID != "" {
+		add(TableSessionColumnSubjectID+" =", expr.SubjectID)
+	}
+	if expr.RefreshToken != "" {
+		add(TableSessionColumnRefreshToken+" =", expr.RefreshToken)
+	}
+	if !expr.ExpireAtFrom.IsZero() {
+		add(TableSessionColumnExpireAt+" >=", expr.ExpireAtFrom)
+	}
+	if !expr.ExpireAtTo.IsZero() {
+		add(TableSessionColumnExpireAt+" <=", expr.ExpireAtTo)
+	}
+	if len(where) == 0 {
+		return 0, nil
+	}
+
+	query := `DELETE FROM ` + sr.Table + ` WHERE ` + strings.Join(where, " AND ")
+
+	res, err := conn(ctx, sr.DB).ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
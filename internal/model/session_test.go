@@ -0,0 +1,95 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestSessionRepository(t *testing.T) {
+	suite := &postgresSuite{}
+	suite.setup(t)
+	defer suite.teardown(t)
+
+	ctx := context.TODO()
+	insert := func(token, subjectID, refreshToken string, expireAt time.Time) {
+		if _, err := suite.repository.session.Insert(ctx, &SessionEntity{
+			AccessToken:  token,
+			SubjectID:    subjectID,
+			RefreshToken: refreshToken,
+			ExpireAt:     expireAt,
+		}); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+	}
+	insert("a", "charon:user:1", "rt", time.Now().Add(time.Hour))
+	insert("b", "charon:user:1", "", time.Now().Add(time.Hour))
+	insert("c", "charon:user:2", "rt", time.Now().Add(time.Hour))
+	insert("d", "charon:user:2", "", time.Now().Add(-time.Hour))
+
+	got, err := suite.repository.session.FindOneByAccessToken(ctx, "a")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if got.SubjectID != "charon:user:1" {
+		t.Errorf("wrong subject id: %s", got.SubjectID)
+	}
+	if _, err = suite.repository.session.FindOneByAccessToken(ctx, "d"); err != sql.ErrNoRows {
+		t.Errorf("expired session should not be found, got: %v", err)
+	}
+
+	listed, err := suite.repository.session.List(ctx, &SessionListExpr{Offset: 1, Limit: 10})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(listed) != 2 || listed[0].AccessToken != "b" || listed[1].AccessToken != "c" {
+		t.Errorf("wrong sessions listed: %v", listed)
+	}
+	listed, err = suite.repository.session.List(ctx, &SessionListExpr{SubjectID: "charon:user:2"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(listed) != 1 || listed[0].AccessToken != "c" {
+		t.Errorf("wrong sessions listed for subject: %v", listed)
+	}
+
+	if _, err = suite.repository.session.SetBagValue(ctx, "a", "k1", "v1"); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	bag, err := suite.repository.session.SetBagValue(ctx, "a", "k2", "v2")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	var values map[string]string
+	if err = json.Unmarshal(bag, &values); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if values["k1"] != "v1" || values["k2"] != "v2" {
+		t.Errorf("wrong bag: %s", bag)
+	}
+	if _, err = suite.repository.session.SetBagValue(ctx, "d", "k1", "v1"); err != sql.ErrNoRows {
+		t.Errorf("bag of expired session should not be modified, got: %v", err)
+	}
+
+	cases := []struct {
+		expr     SessionDeleteExpr
+		affected int64
+	}{
+		{expr: SessionDeleteExpr{}, affected: 0},
+		{expr: SessionDeleteExpr{ExpireAtTo: time.Now()}, affected: 1},
+		{expr: SessionDeleteExpr{SubjectID: "charon:user:1", RefreshToken: "rt"}, affected: 1},
+		{expr: SessionDeleteExpr{AccessToken: "b"}, affected: 1},
+		{expr: SessionDeleteExpr{SubjectID: "charon:user:2"}, affected: 1},
+	}
+	for _, c := range cases {
+		affected, err := suite.repository.session.Delete(ctx, &c.expr)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		if affected != c.affected {
+			t.Errorf("wrong number of removed sessions for %v, expected %d but got %d", c.expr, c.affected, affected)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+)
+
+type txContextKey struct{}
+
+// Transactor wraps database transaction management into interface.
+type Transactor interface {
+	// Transaction calls given function within a transaction, that is committed if the function succeeds.
+	// Repositories called with the context passed to the function take part in the transaction.
+	// If the context already carries a transaction, it is reused.
+	Transaction(ctx context.Context, fn func(context.Context) error) error
+}
+
+// NewTransactor allocates new Transactor instance.
+func NewTransactor(dbPool *sql.DB) Transactor {
+	return &transactor{db: dbPool}
+}
+
+type transactor struct {
+	db *sql.DB
+}
+
+// Transaction implements Transactor interface.
+func (t *transactor) Transaction(ctx context.Context, fn func(context.Context) error) error {
+	if txFromContext(ctx) != nil {
+		return fn(ctx)
+	}
+	return RunInTransaction(ctx, t.db, func(tx *sql.Tx) error {
+		return fn(context.WithValue(ctx, txContextKey{}, tx))
+	}, 1)
+}
+
+// txFromContext returns transaction started by Transactor or nil if there is none.
+func txFromContext(ctx context.Context) *sql.Tx {
+	tx, _ := ctx.Value(txContextKey{}).(*sql.Tx)
+	return tx
+}
+
+// querier is implemented by both *sql.DB and *sql.Tx.
+type querier interface {
+	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+	PrepareContext(context.Context, string) (*sql.Stmt, error)
+	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
+}
+
+// conn returns transaction bound to the context, if any, or the database otherwise.
+func conn(ctx context.Context, db *sql.DB) querier {
+	if tx := txFromContext(ctx); tx != nil {
+		return tx
+	}
+	return db
+}
+
+// beginTx starts a new transaction, unless the context carries one already.
+// Returned function ends the transaction depending on given error, but only if it was started by beginTx.
+func beginTx(ctx context.Context, db *sql.DB) (*sql.Tx, func(error) error, error) {
+	if tx := txFromContext(ctx); tx != nil {
+		return tx, func(err error) error { return err }, nil
+	}
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tx, func(err error) error {
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+		return tx.Commit()
+	}, nil
+}
@@ -0,0 +1,71 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/piotrkowalczuk/ntypes"
+	"github.com/piotrkowalczuk/qtypes"
+)
+
+func TestTransactor_Transaction(t *testing.T) {
+	suite := &postgresSuite{}
+	suite.setup(t)
+	defer suite.teardown(t)
+
+	tr := NewTransactor(suite.db)
+	errRollback := errors.New("rollback")
+
+	for res := range loadUserFixtures(t, suite.repository.user, userTestFixtures) {
+		change := func(ctx context.Context) error {
+			if _, err := suite.repository.user.DeleteOneByID(ctx, res.got.ID); err != nil {
+				return err
+			}
+			_, err := suite.repository.auditEvent.Insert(ctx, &AuditEventEntity{
+				RPC:        "test",
+				TargetKind: AuditEventTargetUser,
+				TargetID:   ntypes.Int64{Int64: res.got.ID, Valid: true},
+			})
+			return err
+		}
+
+		err := tr.Transaction(context.TODO(), func(ctx context.Context) error {
+			if err := change(ctx); err != nil {
+				return err
+			}
+			return errRollback
+		})
+		if err != errRollback {
+			t.Fatalf("wrong error, expected %v but got %v", errRollback, err)
+		}
+		if _, err = suite.repository.user.FindOneByID(context.TODO(), res.got.ID); err != nil {
+			t.Errorf("user should not be deleted, unexpected error: %s", err.Error())
+		}
+		assertAuditEvents(t, suite, res.got.ID, 0)
+
+		if err = tr.Transaction(context.TODO(), change); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		if _, err = suite.repository.user.FindOneByID(context.TODO(), res.got.ID); err == nil {
+			t.Error("user should be deleted")
+		}
+		assertAuditEvents(t, suite, res.got.ID, 1)
+	}
+}
+
+func assertAuditEvents(t *testing.T, suite *postgresSuite, targetID int64, expected int) {
+	t.Helper()
+
+	ents, err := suite.repository.auditEvent.Find(context.TODO(), &AuditEventFindExpr{
+		Where: &AuditEventCriteria{
+			TargetID: qtypes.EqualInt64(targetID),
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(ents) != expected {
+		t.Errorf("wrong number of audit events, expected %d but got %d", expected, len(ents))
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"database/sql"
 	"strings"
+	"time"
 
+	"github.com/lib/pq"
 	"github.com/piotrkowalczuk/charon"
 )
 
@@ -16,6 +18,8 @@ const (
 var (
 	// ExternalPassword is a password that is set when external source of authentication is provided (e.g. LDAP).
 	ExternalPassword = []byte("!")
+	// NoPassword is a password that is set for service accounts and federated users, no password matches it.
+	NoPassword = []byte("*")
 )
 
 // String return concatenated first and last name of the user.
@@ -39,16 +43,56 @@ type UserProvider interface {
 	CreateSuperuser(ctx context.Context, username string, password []byte, FirstName, LastName string) (*UserEntity, error)
 	// Count retrieves number of all users.
 	Count(context.Context) (int64, error)
+	// CountBy retrieves number of users that match given expression.
+	CountBy(context.Context, *UserCountExpr) (int64, error)
 	UpdateLastLoginAt(ctx context.Context, id int64) (int64, error)
 	ChangePassword(ctx context.Context, id int64, password string) error
 	Find(context.Context, *UserFindExpr) ([]*UserEntity, error)
 	FindOneByID(context.Context, int64) (*UserEntity, error)
 	FindOneByUsername(context.Context, string) (*UserEntity, error)
 	DeleteOneByID(context.Context, int64) (int64, error)
+	// Disown clears references to the user as an author or modifier of any row, so that the user can be removed.
+	Disown(ctx context.Context, id int64) (int64, error)
 	UpdateOneByID(context.Context, int64, *UserPatch) (*UserEntity, error)
-	RegistrationConfirmation(ctx context.Context, id int64, confirmationToken string) (int64, error)
+	// RegistrationConfirmation confirms and activates user that given confirmation token was issued for.
+	// Token can be used only once and only before it expires.
+	RegistrationConfirmation(ctx context.Context, token []byte) (*UserEntity, error)
 	IsGranted(ctx context.Context, id int64, permission charon.Permission) (bool, error)
+	// IsGrantedMany checks all given permissions for all given users at once, results are keyed by user id.
+	IsGrantedMany(ctx context.Context, ids []int64, permissions charon.Permissions) (map[int64]map[charon.Permission]bool, error)
+	// IsGrantedOnResource works like IsGranted, but permission granted on given resource only is sufficient as well.
+	IsGrantedOnResource(ctx context.Context, id int64, permission charon.Permission, resourceType, resourceID string) (bool, error)
 	SetPermissions(ctx context.Context, id int64, permissions ...charon.Permission) (int64, int64, error)
+	// SetPermissionsWithDenials works like SetPermissions, but denied permissions are linked as well, with denied flag set.
+	SetPermissionsWithDenials(ctx context.Context, id int64, granted, denied charon.Permissions) (int64, int64, error)
+	// SetTimeBoundPermissions works like SetPermissionsWithDenials, but links of permissions present in validity
+	// are in effect only within given time window. Remaining links are permanent.
+	SetTimeBoundPermissions(ctx context.Context, id int64, granted, denied charon.Permissions, validity map[charon.Permission]Validity) (int64, int64, error)
+	// SetTwoFactorSecret starts two-factor authentication enrolment, it is not possible if enrolment is already confirmed.
+	SetTwoFactorSecret(ctx context.Context, id int64, secret []byte) (int64, error)
+	// ConfirmTwoFactor finishes enrolment started by SetTwoFactorSecret.
+	ConfirmTwoFactor(ctx context.Context, id, step int64, recoveryCodes []byte) (int64, error)
+	DisableTwoFactor(ctx context.Context, id int64) (int64, error)
+	// UseTwoFactorStep marks given time step as used, it succeeds only if step is greater than any used before.
+	UseTwoFactorStep(ctx context.Context, id, step int64) (int64, error)
+	// ReplaceTwoFactorRecoveryCodes replaces recovery codes only if they were not changed in the meantime.
+	ReplaceTwoFactorRecoveryCodes(ctx context.Context, id int64, previous, next []byte) (int64, error)
+	SetTwoFactorChallenge(ctx context.Context, id int64, challenge []byte, expireAt time.Time) (int64, error)
+	// ConsumeTwoFactorChallenge returns user that given challenge was issued for, challenge can be used only once.
+	ConsumeTwoFactorChallenge(ctx context.Context, challenge []byte) (*UserEntity, error)
+	// SetPasswordResetToken stores hash of a password reset token, replacing any token issued before.
+	SetPasswordResetToken(ctx context.Context, id int64, token []byte, expireAt time.Time) (int64, error)
+	// ConsumePasswordResetToken returns user that given reset token was issued for, token can be used only once.
+	ConsumePasswordResetToken(ctx context.Context, token []byte) (*UserEntity, error)
+	// Lock prevents user with given username from logging in, until Unlock is called.
+	Lock(ctx context.Context, username string) (int64, error)
+	Unlock(ctx context.Context, id int64) (int64, error)
+	// SoftDeleteOneByID marks user as deleted, the row is kept until it is purged. Already deleted user is not found.
+	SoftDeleteOneByID(ctx context.Context, id int64) (*UserEntity, error)
+	// RestoreOneByID reverts SoftDeleteOneByID, only deleted user is found.
+	RestoreOneByID(ctx context.Context, id int64) (*UserEntity, error)
+	// FindDeletedBefore retrieves users that were soft deleted before given point in time.
+	FindDeletedBefore(ctx context.Context, before time.Time) ([]*UserEntity, error)
 }
 
 // UserRepository extends UserRepositoryBase.
@@ -93,25 +137,46 @@ func (ur *UserRepository) CreateSuperuser(ctx context.Context, username string,
 
 // Count implements UserProvider interface.
 func (ur *UserRepository) Count(ctx context.Context) (n int64, err error) {
-	err = ur.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM "+TableUser).Scan(&n)
+	err = conn(ctx, ur.DB).QueryRowContext(ctx, "SELECT COUNT(*) FROM "+TableUser).Scan(&n)
 
 	return
 }
 
-// RegistrationConfirmation ...
-func (ur *UserRepository) RegistrationConfirmation(ctx context.Context, userID int64, confirmationToken string) (int64, error) {
+// CountBy implements UserProvider interface.
+func (ur *UserRepository) CountBy(ctx context.Context, exp *UserCountExpr) (int64, error) {
+	return ur.UserRepositoryBase.Count(ctx, exp)
+}
+
+// RegistrationConfirmation implements UserProvider interface.
+func (ur *UserRepository) RegistrationConfirmation(ctx context.Context, token []byte) (*UserEntity, error) {
 	query := `
 		UPDATE ` + ur.Table + `
-		SET is_confirmed = true, is_active = true, updated_at = NOW(), confirmation_token = $1
-		WHERE is_confirmed = false AND id = $2 AND confirmation_token = $3;
+		SET ` + TableUserColumnIsConfirmed + ` = TRUE,
+			` + TableUserColumnIsActive + ` = TRUE,
+			` + TableUserColumnConfirmationToken + ` = $2,
+			` + TableUserColumnConfirmationTokenExpireAt + ` = NULL,
+			` + TableUserColumnUpdatedAt + ` = NOW()
+		WHERE ` + TableUserColumnIsConfirmed + ` = FALSE
+			AND ` + TableUserColumnConfirmationToken + ` = $1
+			AND ` + TableUserColumnConfirmationTokenExpireAt + ` > NOW()
+		RETURNING ` + strings.Join(TableUserColumns, ",") + `
 	`
 
-	result, err := ur.DB.ExecContext(ctx, query, UserConfirmationTokenUsed, userID, confirmationToken)
+	rows, err := conn(ctx, ur.DB).QueryContext(ctx, query, token, []byte(UserConfirmationTokenUsed))
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
+	defer rows.Close()
 
-	return result.RowsAffected()
+	ents, err := ScanUserRows(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(ents) == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	return ents[0], nil
 }
 
 // ChangePassword ...
@@ -122,7 +187,7 @@ func (ur *UserRepository) ChangePassword(ctx context.Context, userID int64, pass
 		WHERE id = $1;
 	`
 
-	result, err := ur.DB.ExecContext(ctx, query, userID, password)
+	result, err := conn(ctx, ur.DB).ExecContext(ctx, query, userID, password)
 	if err != nil {
 		return err
 	}
@@ -146,19 +211,30 @@ func (ur *UserRepository) FindOneBy(ctx context.Context, fieldName string, value
 	`
 
 	var ent UserEntity
-	err := ur.DB.QueryRowContext(ctx, query, value).Scan(
+	err := conn(ctx, ur.DB).QueryRowContext(ctx, query, value).Scan(
 		&ent.ConfirmationToken,
+		&ent.ConfirmationTokenExpireAt,
 		&ent.CreatedAt,
 		&ent.CreatedBy,
 		&ent.FirstName,
 		&ent.ID,
 		&ent.IsActive,
 		&ent.IsConfirmed,
+		&ent.IsServiceAccount,
 		&ent.IsStaff,
 		&ent.IsSuperuser,
 		&ent.LastLoginAt,
 		&ent.LastName,
+		&ent.LockedAt,
 		&ent.Password,
+		&ent.PasswordResetToken,
+		&ent.PasswordResetTokenExpireAt,
+		&ent.TwoFactorChallenge,
+		&ent.TwoFactorChallengeExpireAt,
+		&ent.TwoFactorConfirmedAt,
+		&ent.TwoFactorLastStep,
+		&ent.TwoFactorRecoveryCodes,
+		&ent.TwoFactorSecret,
 		&ent.UpdatedAt,
 		&ent.UpdatedBy,
 		&ent.Username,
@@ -179,7 +255,7 @@ func (ur *UserRepository) UpdateLastLoginAt(ctx context.Context, userID int64) (
 		WHERE ` + TableUserColumnID + ` = $1
 	`
 
-	result, err := ur.DB.ExecContext(ctx,
+	result, err := conn(ctx, ur.DB).ExecContext(ctx,
 		query,
 		userID,
 	)
@@ -201,7 +277,7 @@ func (ur *UserRepository) Exists(ctx context.Context, userID int64) (bool, error
 	`
 
 	var exists bool
-	if err := ur.DB.QueryRowContext(ctx, query, userID).Scan(&exists); err != nil {
+	if err := conn(ctx, ur.DB).QueryRowContext(ctx, query, userID).Scan(&exists); err != nil {
 		return false, err
 	}
 
@@ -209,27 +285,473 @@ func (ur *UserRepository) Exists(ctx context.Context, userID int64) (bool, error
 }
 
 // IsGranted implements UserProvider interface.
+// Permission can be granted to the user directly or to any group they belong to, including parents of their groups.
 func (ur *UserRepository) IsGranted(ctx context.Context, id int64, p charon.Permission) (bool, error) {
 	var exists bool
 	subsystem, module, action := p.Split()
-	if err := ur.DB.QueryRowContext(ctx, isGrantedQuery(
-		TableUserPermissions,
-		TableUserPermissionsColumnUserID,
-		TableUserPermissionsColumnPermissionSubsystem,
-		TableUserPermissionsColumnPermissionModule,
-		TableUserPermissionsColumnPermissionAction,
-	), id, subsystem, module, action).Scan(&exists); err != nil {
+	if err := conn(ctx, ur.DB).QueryRowContext(ctx, isGrantedToUserQuery(""), id, subsystem, module, action).Scan(&exists); err != nil {
 		return false, err
 	}
 
 	return exists, nil
 }
 
-// SetPermissions implements UserProvider interface.
-func (ur *UserRepository) SetPermissions(ctx context.Context, id int64, p ...charon.Permission) (int64, int64, error) {
-	return setPermissions(ur.DB, ctx, TableUserPermissions,
-		TableUserPermissionsColumnUserID,
+// IsGrantedOnResource implements UserProvider interface.
+// Apart from grants checked by IsGranted, permission granted on the resource itself is taken into account.
+func (ur *UserRepository) IsGrantedOnResource(ctx context.Context, id int64, p charon.Permission, resourceType, resourceID string) (bool, error) {
+	query := isGrantedToUserQuery(`
+			UNION ALL
+			SELECT FALSE AS denied
+			FROM ` + TableUserResourcePermissions + ` AS urp
+			INNER JOIN ` + TablePermission + ` AS p ON p.` + TablePermissionColumnID + ` = urp.` + TableUserResourcePermissionsColumnPermissionID + `
+			WHERE urp.` + TableUserResourcePermissionsColumnUserID + ` = $1
+				AND urp.` + TableUserResourcePermissionsColumnResourceType + ` = $5
+				AND urp.` + TableUserResourcePermissionsColumnResourceID + ` = $6
+				AND ` + matchPermissionClause("p", TablePermissionColumnSubsystem, TablePermissionColumnModule, TablePermissionColumnAction))
+
+	var exists bool
+	subsystem, module, action := p.Split()
+	if err := conn(ctx, ur.DB).QueryRowContext(ctx, query, id, subsystem, module, action, resourceType, resourceID).Scan(&exists); err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+// IsGrantedMany implements UserProvider interface.
+// It works like IsGranted, but all given permissions are checked for all given users using a single query.
+func (ur *UserRepository) IsGrantedMany(ctx context.Context, ids []int64, permissions charon.Permissions) (map[int64]map[charon.Permission]bool, error) {
+	res := make(map[int64]map[charon.Permission]bool, len(ids))
+	if len(ids) == 0 || len(permissions) == 0 {
+		return res, nil
+	}
+
+	subsystems := make([]string, 0, len(permissions))
+	modules := make([]string, 0, len(permissions))
+	actions := make([]string, 0, len(permissions))
+	for _, p := range permissions {
+		subsystem, module, action := p.Split()
+		subsystems = append(subsystems, subsystem)
+		modules = append(modules, module)
+		actions = append(actions, action)
+	}
+
+	rows, err := conn(ctx, ur.DB).QueryContext(ctx, isGrantedManyQuery,
+		pq.Array(ids),
+		pq.Array(subsystems),
+		pq.Array(modules),
+		pq.Array(actions),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var (
+		id                        int64
+		subsystem, module, action string
+		granted                   bool
+	)
+	for rows.Next() {
+		if err = rows.Scan(&id, &subsystem, &module, &action, &granted); err != nil {
+			return nil, err
+		}
+		if _, ok := res[id]; !ok {
+			res[id] = make(map[charon.Permission]bool, len(permissions))
+		}
+		res[id][charon.Permission(subsystem+":"+module+":"+action)] = granted
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// isGrantedManyQuery works like isGrantedToUserQuery, but it expects an array of user ids as $1
+// and arrays of permission parts as $2, $3 and $4. Every user is paired with every permission.
+var isGrantedManyQuery = `
+	WITH RECURSIVE member_of (user_id, group_id) AS (
+		SELECT ug.` + TableUserGroupsColumnUserID + `, ug.` + TableUserGroupsColumnGroupID + `
+		FROM ` + TableUserGroups + ` AS ug
+		WHERE ug.` + TableUserGroupsColumnUserID + ` = ANY($1)
+			AND ` + activeLinkClause("ug", TableUserGroupsColumnValidFrom, TableUserGroupsColumnValidUntil) + `
+		UNION
+		SELECT mo.user_id, g.` + TableGroupColumnParentID + `
+		FROM ` + TableGroup + ` AS g
+		INNER JOIN member_of AS mo ON g.` + TableGroupColumnID + ` = mo.group_id
+		WHERE g.` + TableGroupColumnParentID + ` IS NOT NULL
+	), links (user_id, subsystem, module, action, denied) AS (
+		SELECT up.` + TableUserPermissionsColumnUserID + `,
+			up.` + TableUserPermissionsColumnPermissionSubsystem + `,
+			up.` + TableUserPermissionsColumnPermissionModule + `,
+			up.` + TableUserPermissionsColumnPermissionAction + `,
+			up.` + TableUserPermissionsColumnDenied + `
+		FROM ` + TableUserPermissions + ` AS up
+		WHERE up.` + TableUserPermissionsColumnUserID + ` = ANY($1)
+			AND ` + activeLinkClause("up", TableUserPermissionsColumnValidFrom, TableUserPermissionsColumnValidUntil) + `
+		UNION ALL
+		SELECT mo.user_id,
+			gp.` + TableGroupPermissionsColumnPermissionSubsystem + `,
+			gp.` + TableGroupPermissionsColumnPermissionModule + `,
+			gp.` + TableGroupPermissionsColumnPermissionAction + `,
+			gp.` + TableGroupPermissionsColumnDenied + `
+		FROM ` + TableGroupPermissions + ` AS gp
+		INNER JOIN member_of AS mo ON gp.` + TableGroupPermissionsColumnGroupID + ` = mo.group_id
+	)
+	SELECT u.id, p.subsystem, p.module, p.action, COALESCE(BOOL_AND(NOT l.denied), FALSE)
+	FROM UNNEST($1::BIGINT[]) AS u (id)
+	CROSS JOIN UNNEST($2::TEXT[], $3::TEXT[], $4::TEXT[]) AS p (subsystem, module, action)
+	LEFT JOIN links AS l ON l.user_id = u.id
+		AND l.subsystem IN (p.subsystem, '` + charon.PermissionWildcard + `')
+		AND l.module IN (p.module, '` + charon.PermissionWildcard + `')
+		AND l.action IN (p.action, '` + charon.PermissionWildcard + `')
+	GROUP BY u.id, p.subsystem, p.module, p.action
+`
+
+// isGrantedToUserQuery expects user id as $1 and permission parts as $2, $3 and $4.
+// Extra is a subquery that selects denied flag of additional links, it is appended using UNION ALL.
+func isGrantedToUserQuery(extra string) string {
+	// Permission is granted if there is at least one matching link and none of them is a denial.
+	return memberOfQuery + `
+		SELECT COALESCE(BOOL_AND(NOT l.denied), FALSE)
+		FROM (
+			SELECT up.` + TableUserPermissionsColumnDenied + ` AS denied
+			FROM ` + TableUserPermissions + ` AS up
+			WHERE up.` + TableUserPermissionsColumnUserID + ` = $1
+				AND ` + activeLinkClause("up", TableUserPermissionsColumnValidFrom, TableUserPermissionsColumnValidUntil) + `
+				AND ` + matchPermissionClause("up",
 		TableUserPermissionsColumnPermissionSubsystem,
 		TableUserPermissionsColumnPermissionModule,
-		TableUserPermissionsColumnPermissionAction, id, p)
+		TableUserPermissionsColumnPermissionAction,
+	) + `
+			UNION ALL
+			SELECT gp.` + TableGroupPermissionsColumnDenied + ` AS denied
+			FROM ` + TableGroupPermissions + ` AS gp
+			INNER JOIN member_of AS mo ON gp.` + TableGroupPermissionsColumnGroupID + ` = mo.group_id
+			WHERE ` + matchPermissionClause("gp",
+		TableGroupPermissionsColumnPermissionSubsystem,
+		TableGroupPermissionsColumnPermissionModule,
+		TableGroupPermissionsColumnPermissionAction,
+	) + extra + `
+		) AS l
+	`
+}
+
+// SetPermissions implements UserProvider interface.
+func (ur *UserRepository) SetPermissions(ctx context.Context, id int64, p ...charon.Permission) (int64, int64, error) {
+	return ur.SetPermissionsWithDenials(ctx, id, p, nil)
+}
+
+// SetPermissionsWithDenials implements UserProvider interface.
+func (ur *UserRepository) SetPermissionsWithDenials(ctx context.Context, id int64, granted, denied charon.Permissions) (int64, int64, error) {
+	return ur.SetTimeBoundPermissions(ctx, id, granted, denied, nil)
+}
+
+// SetTimeBoundPermissions implements UserProvider interface.
+func (ur *UserRepository) SetTimeBoundPermissions(ctx context.Context, id int64, granted, denied charon.Permissions, validity map[charon.Permission]Validity) (int64, int64, error) {
+	return setPermissions(ur.DB, ctx, permissionLinks{
+		table:            TableUserPermissions,
+		columnID:         TableUserPermissionsColumnUserID,
+		columnSubsystem:  TableUserPermissionsColumnPermissionSubsystem,
+		columnModule:     TableUserPermissionsColumnPermissionModule,
+		columnAction:     TableUserPermissionsColumnPermissionAction,
+		columnDenied:     TableUserPermissionsColumnDenied,
+		columnValidFrom:  TableUserPermissionsColumnValidFrom,
+		columnValidUntil: TableUserPermissionsColumnValidUntil,
+	}, id, granted, denied, validity)
+}
+
+// SetTwoFactorSecret implements UserProvider interface.
+func (ur *UserRepository) SetTwoFactorSecret(ctx context.Context, id int64, secret []byte) (int64, error) {
+	query := `
+		UPDATE ` + ur.Table + `
+		SET ` + TableUserColumnTwoFactorSecret + ` = $2,
+			` + TableUserColumnTwoFactorLastStep + ` = NULL,
+			` + TableUserColumnTwoFactorRecoveryCodes + ` = NULL,
+			` + TableUserColumnUpdatedAt + ` = NOW()
+		WHERE ` + TableUserColumnID + ` = $1 AND ` + TableUserColumnTwoFactorConfirmedAt + ` IS NULL
+	`
+
+	return ur.exec(ctx, query, id, secret)
+}
+
+// ConfirmTwoFactor implements UserProvider interface.
+func (ur *UserRepository) ConfirmTwoFactor(ctx context.Context, id, step int64, recoveryCodes []byte) (int64, error) {
+	query := `
+		UPDATE ` + ur.Table + `
+		SET ` + TableUserColumnTwoFactorConfirmedAt + ` = NOW(),
+			` + TableUserColumnTwoFactorLastStep + ` = $2,
+			` + TableUserColumnTwoFactorRecoveryCodes + ` = $3,
+			` + TableUserColumnUpdatedAt + ` = NOW()
+		WHERE ` + TableUserColumnID + ` = $1
+			AND ` + TableUserColumnTwoFactorSecret + ` IS NOT NULL
+			AND ` + TableUserColumnTwoFactorConfirmedAt + ` IS NULL
+	`
+
+	return ur.exec(ctx, query, id, step, recoveryCodes)
+}
+
+// DisableTwoFactor implements UserProvider interface.
+func (ur *UserRepository) DisableTwoFactor(ctx context.Context, id int64) (int64, error) {
+	query := `
+		UPDATE ` + ur.Table + `
+		SET ` + TableUserColumnTwoFactorSecret + ` = NULL,
+			` + TableUserColumnTwoFactorConfirmedAt + ` = NULL,
+			` + TableUserColumnTwoFactorLastStep + ` = NULL,
+			` + TableUserColumnTwoFactorRecoveryCodes + ` = NULL,
+			` + TableUserColumnTwoFactorChallenge + ` = NULL,
+			` + TableUserColumnTwoFactorChallengeExpireAt + ` = NULL,
+			` + TableUserColumnUpdatedAt + ` = NOW()
+		WHERE ` + TableUserColumnID + ` = $1 AND ` + TableUserColumnTwoFactorSecret + ` IS NOT NULL
+	`
+
+	return ur.exec(ctx, query, id)
+}
+
+// UseTwoFactorStep implements UserProvider interface.
+func (ur *UserRepository) UseTwoFactorStep(ctx context.Context, id, step int64) (int64, error) {
+	query := `
+		UPDATE ` + ur.Table + `
+		SET ` + TableUserColumnTwoFactorLastStep + ` = $2
+		WHERE ` + TableUserColumnID + ` = $1
+			AND (` + TableUserColumnTwoFactorLastStep + ` IS NULL OR ` + TableUserColumnTwoFactorLastStep + ` < $2)
+	`
+
+	return ur.exec(ctx, query, id, step)
+}
+
+// ReplaceTwoFactorRecoveryCodes implements UserProvider interface.
+func (ur *UserRepository) ReplaceTwoFactorRecoveryCodes(ctx context.Context, id int64, previous, next []byte) (int64, error) {
+	query := `
+		UPDATE ` + ur.Table + `
+		SET ` + TableUserColumnTwoFactorRecoveryCodes + ` = $3
+		WHERE ` + TableUserColumnID + ` = $1
+			AND ` + TableUserColumnTwoFactorConfirmedAt + ` IS NOT NULL
+			AND ` + TableUserColumnTwoFactorRecoveryCodes + ` IS NOT DISTINCT FROM $2
+	`
+
+	return ur.exec(ctx, query, id, previous, next)
+}
+
+// SetTwoFactorChallenge implements UserProvider interface.
+func (ur *UserRepository) SetTwoFactorChallenge(ctx context.Context, id int64, challenge []byte, expireAt time.Time) (int64, error) {
+	query := `
+		UPDATE ` + ur.Table + `
+		SET ` + TableUserColumnTwoFactorChallenge + ` = $2, ` + TableUserColumnTwoFactorChallengeExpireAt + ` = $3
+		WHERE ` + TableUserColumnID + ` = $1 AND ` + TableUserColumnTwoFactorConfirmedAt + ` IS NOT NULL
+	`
+
+	return ur.exec(ctx, query, id, challenge, expireAt)
+}
+
+// ConsumeTwoFactorChallenge implements UserProvider interface.
+func (ur *UserRepository) ConsumeTwoFactorChallenge(ctx context.Context, challenge []byte) (*UserEntity, error) {
+	query := `
+		UPDATE ` + ur.Table + `
+		SET ` + TableUserColumnTwoFactorChallenge + ` = NULL, ` + TableUserColumnTwoFactorChallengeExpireAt + ` = NULL
+		WHERE ` + TableUserColumnTwoFactorChallenge + ` = $1 AND ` + TableUserColumnTwoFactorChallengeExpireAt + ` > NOW()
+		RETURNING ` + strings.Join(TableUserColumns, ",") + `
+	`
+
+	rows, err := conn(ctx, ur.DB).QueryContext(ctx, query, challenge)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ents, err := ScanUserRows(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(ents) == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	return ents[0], nil
+}
+
+// SetPasswordResetToken implements UserProvider interface.
+func (ur *UserRepository) SetPasswordResetToken(ctx context.Context, id int64, token []byte, expireAt time.Time) (int64, error) {
+	query := `
+		UPDATE ` + ur.Table + `
+		SET ` + TableUserColumnPasswordResetToken + ` = $2, ` + TableUserColumnPasswordResetTokenExpireAt + ` = $3
+		WHERE ` + TableUserColumnID + ` = $1
+	`
+
+	return ur.exec(ctx, query, id, token, expireAt)
+}
+
+// ConsumePasswordResetToken implements UserProvider interface.
+func (ur *UserRepository) ConsumePasswordResetToken(ctx context.Context, token []byte) (*UserEntity, error) {
+	query := `
+		UPDATE ` + ur.Table + `
+		SET ` + TableUserColumnPasswordResetToken + ` = NULL, ` + TableUserColumnPasswordResetTokenExpireAt + ` = NULL
+		WHERE ` + TableUserColumnPasswordResetToken + ` = $1 AND ` + TableUserColumnPasswordResetTokenExpireAt + ` > NOW()
+		RETURNING ` + strings.Join(TableUserColumns, ",") + `
+	`
+
+	rows, err := conn(ctx, ur.DB).QueryContext(ctx, query, token)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ents, err := ScanUserRows(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(ents) == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	return ents[0], nil
+}
+
+func (ur *UserRepository) exec(ctx context.Context, query string, args ...interface{}) (int64, error) {
+	result, err := conn(ctx, ur.DB).ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+// Lock implements UserProvider interface.
+func (ur *UserRepository) Lock(ctx context.Context, username string) (int64, error) {
+	query := `
+		UPDATE ` + ur.Table + `
+		SET ` + TableUserColumnLockedAt + ` = NOW()
+		WHERE ` + TableUserColumnUsername + ` = $1 AND ` + TableUserColumnLockedAt + ` IS NULL
+	`
+
+	return ur.exec(ctx, query, username)
+}
+
+// Unlock implements UserProvider interface.
+func (ur *UserRepository) Unlock(ctx context.Context, id int64) (int64, error) {
+	query := `
+		UPDATE ` + ur.Table + `
+		SET ` + TableUserColumnLockedAt + ` = NULL, ` + TableUserColumnUpdatedAt + ` = NOW()
+		WHERE ` + TableUserColumnID + ` = $1 AND ` + TableUserColumnLockedAt + ` IS NOT NULL
+	`
+
+	return ur.exec(ctx, query, id)
+}
+
+// SoftDeleteOneByID implements UserProvider interface.
+func (ur *UserRepository) SoftDeleteOneByID(ctx context.Context, id int64) (*UserEntity, error) {
+	query := `
+		UPDATE ` + ur.Table + `
+		SET ` + TableUserColumnDeletedAt + ` = NOW(), ` + TableUserColumnUpdatedAt + ` = NOW()
+		WHERE ` + TableUserColumnID + ` = $1 AND ` + TableUserColumnDeletedAt + ` IS NULL
+		RETURNING ` + strings.Join(TableUserColumns, ",") + `
+	`
+
+	return ur.updateReturning(ctx, query, id)
+}
+
+// RestoreOneByID implements UserProvider interface.
+func (ur *UserRepository) RestoreOneByID(ctx context.Context, id int64) (*UserEntity, error) {
+	query := `
+		UPDATE ` + ur.Table + `
+		SET ` + TableUserColumnDeletedAt + ` = NULL, ` + TableUserColumnUpdatedAt + ` = NOW()
+		WHERE ` + TableUserColumnID + ` = $1 AND ` + TableUserColumnDeletedAt + ` IS NOT NULL
+		RETURNING ` + strings.Join(TableUserColumns, ",") + `
+	`
+
+	return ur.updateReturning(ctx, query, id)
+}
+
+// FindDeletedBefore implements UserProvider interface.
+func (ur *UserRepository) FindDeletedBefore(ctx context.Context, before time.Time) ([]*UserEntity, error) {
+	query := `
+		SELECT ` + strings.Join(TableUserColumns, ",") + `
+		FROM ` + ur.Table + `
+		WHERE ` + TableUserColumnDeletedAt + ` < $1
+		ORDER BY ` + TableUserColumnID + `
+	`
+
+	rows, err := conn(ctx, ur.DB).QueryContext(ctx, query, before)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return ScanUserRows(rows)
+}
+
+func (ur *UserRepository) updateReturning(ctx context.Context, query string, args ...interface{}) (*UserEntity, error) {
+	rows, err := conn(ctx, ur.DB).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ents, err := ScanUserRows(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(ents) == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	return ents[0], nil
+}
+
+// Insert implements UserProvider interface, it takes part in a transaction carried by the context.
+func (ur *UserRepository) Insert(ctx context.Context, ent *UserEntity) (*UserEntity, error) {
+	return ur.insert(ctx, txFromContext(ctx), ent)
+}
+
+// FindOneByID implements UserProvider interface, it takes part in a transaction carried by the context.
+func (ur *UserRepository) FindOneByID(ctx context.Context, id int64) (*UserEntity, error) {
+	return ur.findOneByID(ctx, txFromContext(ctx), id)
+}
+
+// UpdateOneByID implements UserProvider interface, it takes part in a transaction carried by the context.
+func (ur *UserRepository) UpdateOneByID(ctx context.Context, id int64, patch *UserPatch) (*UserEntity, error) {
+	return ur.updateOneByID(ctx, txFromContext(ctx), id, patch)
+}
+
+// DeleteOneByID implements UserProvider interface, it takes part in a transaction carried by the context.
+func (ur *UserRepository) DeleteOneByID(ctx context.Context, id int64) (int64, error) {
+	return ur.deleteOneByID(ctx, txFromContext(ctx), id)
+}
+
+// authoredTables lists tables that reference users as authors and modifiers of their rows.
+var authoredTables = [][3]string{
+	{TableUser, TableUserColumnCreatedBy, TableUserColumnUpdatedBy},
+	{TableGroup, TableGroupColumnCreatedBy, TableGroupColumnUpdatedBy},
+	{TableUserGroups, TableUserGroupsColumnCreatedBy, TableUserGroupsColumnUpdatedBy},
+	{TableGroupPermissions, TableGroupPermissionsColumnCreatedBy, TableGroupPermissionsColumnUpdatedBy},
+	{TableUserPermissions, TableUserPermissionsColumnCreatedBy, TableUserPermissionsColumnUpdatedBy},
+	{TableUserResourcePermissions, TableUserResourcePermissionsColumnCreatedBy, TableUserResourcePermissionsColumnUpdatedBy},
+	{TableRefreshToken, TableRefreshTokenColumnCreatedBy, TableRefreshTokenColumnUpdatedBy},
+	{TableOauthClient, TableOauthClientColumnCreatedBy, TableOauthClientColumnUpdatedBy},
+	{TableAPIKey, TableAPIKeyColumnCreatedBy, TableAPIKeyColumnUpdatedBy},
+}
+
+// Disown implements UserProvider interface, it takes part in a transaction carried by the context.
+func (ur *UserRepository) Disown(ctx context.Context, id int64) (int64, error) {
+	var affected int64
+	for _, t := range authoredTables {
+		table, createdBy, updatedBy := t[0], t[1], t[2]
+		res, err := conn(ctx, ur.DB).ExecContext(ctx, `
+			UPDATE `+table+`
+			SET
+				`+createdBy+` = NULLIF(`+createdBy+`, $1),
+				`+updatedBy+` = NULLIF(`+updatedBy+`, $1)
+			WHERE `+createdBy+` = $1 OR `+updatedBy+` = $1
+		`, id)
+		if err != nil {
+			return 0, err
+		}
+		aff, err := res.RowsAffected()
+		if err != nil {
+			return 0, err
+		}
+		affected += aff
+	}
+	return affected, nil
 }
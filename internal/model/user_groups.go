@@ -4,21 +4,35 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
 )
 
 // UserGroupsProvider ...
 type UserGroupsProvider interface {
 	Insert(ctx context.Context, ent *UserGroupsEntity) (*UserGroupsEntity, error)
 	Exists(ctx context.Context, userID, groupID int64) (bool, error)
+	// BelongsTo returns true if user is member of given group, either directly or through any of its subgroups.
+	BelongsTo(ctx context.Context, userID, groupID int64) (bool, error)
 	Find(ctx context.Context, expr *UserGroupsFindExpr) ([]*UserGroupsEntity, error)
 	Set(ctx context.Context, userID int64, groupIDs []int64) (int64, int64, error)
+	// SetTimeBound works like Set, but memberships of groups present in validity
+	// are in effect only within given time window. Remaining memberships are permanent.
+	SetTimeBound(ctx context.Context, userID int64, groupIDs []int64, validity map[int64]Validity) (int64, int64, error)
 	DeleteByUserID(ctx context.Context, id int64) (int64, error)
+	// DeleteMapped removes memberships created by group mapping of given issuer, except those of given groups.
+	DeleteMapped(ctx context.Context, userID int64, issuer string, keep []int64) (int64, error)
+	// DeleteExpired removes memberships that are no longer in effect and returns them.
+	DeleteExpired(ctx context.Context) ([]*UserGroupsEntity, error)
 }
 
 // UserGroupsRepository ...
 type UserGroupsRepository struct {
 	UserGroupsRepositoryBase
 	deleteByUserIDQuery string
+	deleteMappedQuery   string
+	deleteExpiredQuery  string
 }
 
 // NewUserGroupsRepository ...
@@ -30,29 +44,98 @@ func NewUserGroupsRepository(dbPool *sql.DB) UserGroupsProvider {
 			Columns: TableUserGroupsColumns,
 		},
 		deleteByUserIDQuery: fmt.Sprintf("DELETE FROM %s WHERE %s = $1", TableUserGroups, TableUserGroupsColumnUserID),
+		deleteMappedQuery: fmt.Sprintf("DELETE FROM %s WHERE %s = $1 AND %s = $2 AND NOT %s = ANY($3)",
+			TableUserGroups,
+			TableUserGroupsColumnUserID,
+			TableUserGroupsColumnMappedFrom,
+			TableUserGroupsColumnGroupID,
+		),
+		deleteExpiredQuery: fmt.Sprintf("DELETE FROM %s WHERE %s <= NOW() RETURNING %s",
+			TableUserGroups,
+			TableUserGroupsColumnValidUntil,
+			strings.Join(TableUserGroupsColumns, ", "),
+		),
 	}
 }
 
+// Insert implements UserGroupsProvider interface, it takes part in a transaction carried by the context.
+func (ugr *UserGroupsRepository) Insert(ctx context.Context, ent *UserGroupsEntity) (*UserGroupsEntity, error) {
+	return ugr.insert(ctx, txFromContext(ctx), ent)
+}
+
 // Exists implements UserGroupsProvider interface.
 func (ugr *UserGroupsRepository) Exists(ctx context.Context, userID, groupID int64) (bool, error) {
 	var exists bool
-	if err := ugr.DB.QueryRowContext(ctx, existsManyToManyQuery(ugr.Table, TableUserGroupsColumnUserID, TableUserGroupsColumnGroupID), userID, groupID).Scan(&exists); err != nil {
+	if err := conn(ctx, ugr.DB).QueryRowContext(ctx, existsManyToManyQuery(ugr.Table, TableUserGroupsColumnUserID, TableUserGroupsColumnGroupID), userID, groupID).Scan(&exists); err != nil {
 		return false, err
 	}
 
 	return exists, nil
 }
 
+// BelongsTo implements UserGroupsProvider interface.
+func (ugr *UserGroupsRepository) BelongsTo(ctx context.Context, userID, groupID int64) (bool, error) {
+	query := memberOfQuery + `
+		SELECT EXISTS(SELECT 1 FROM member_of WHERE group_id = $2)
+	`
+
+	var belongs bool
+	if err := conn(ctx, ugr.DB).QueryRowContext(ctx, query, userID, groupID).Scan(&belongs); err != nil {
+		return false, err
+	}
+
+	return belongs, nil
+}
+
 // Set implements UserGroupsProvider interface.
 func (ugr *UserGroupsRepository) Set(ctx context.Context, userID int64, groupIDs []int64) (int64, int64, error) {
-	return setManyToMany(ugr.DB, ctx, ugr.Table, TableUserGroupsColumnUserID, TableUserGroupsColumnGroupID, userID, groupIDs)
+	return ugr.SetTimeBound(ctx, userID, groupIDs, nil)
+}
+
+// SetTimeBound implements UserGroupsProvider interface.
+func (ugr *UserGroupsRepository) SetTimeBound(ctx context.Context, userID int64, groupIDs []int64, validity map[int64]Validity) (int64, int64, error) {
+	return setManyToMany(ugr.DB, ctx, ugr.Table,
+		TableUserGroupsColumnUserID,
+		TableUserGroupsColumnGroupID,
+		TableUserGroupsColumnValidFrom,
+		TableUserGroupsColumnValidUntil,
+		userID, groupIDs, validity)
 }
 
 // DeleteByUserID removes user from all groups he belongs to.
 func (ugr *UserGroupsRepository) DeleteByUserID(ctx context.Context, id int64) (int64, error) {
-	res, err := ugr.DB.ExecContext(ctx, ugr.deleteByUserIDQuery, id)
+	res, err := conn(ctx, ugr.DB).ExecContext(ctx, ugr.deleteByUserIDQuery, id)
 	if err != nil {
 		return 0, err
 	}
 	return res.RowsAffected()
 }
+
+// DeleteMapped implements UserGroupsProvider interface, it takes part in a transaction carried by the context.
+func (ugr *UserGroupsRepository) DeleteMapped(ctx context.Context, userID int64, issuer string, keep []int64) (int64, error) {
+	// Nil would be sent as NULL, and nothing compared with it is ever true.
+	if keep == nil {
+		keep = []int64{}
+	}
+	res, err := conn(ctx, ugr.DB).ExecContext(ctx, ugr.deleteMappedQuery, userID, issuer, pq.Array(keep))
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// Find implements UserGroupsProvider interface, it takes part in a transaction carried by the context.
+func (ugr *UserGroupsRepository) Find(ctx context.Context, fe *UserGroupsFindExpr) ([]*UserGroupsEntity, error) {
+	return ugr.find(ctx, txFromContext(ctx), fe)
+}
+
+// DeleteExpired implements UserGroupsProvider interface.
+func (ugr *UserGroupsRepository) DeleteExpired(ctx context.Context) ([]*UserGroupsEntity, error) {
+	rows, err := conn(ctx, ugr.DB).QueryContext(ctx, ugr.deleteExpiredQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return ScanUserGroupsRows(rows)
+}
@@ -2,7 +2,13 @@ package model
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/ntypes"
 )
 
 var (
@@ -65,6 +71,86 @@ func TestUserGroupsRepository_Exists(t *testing.T) {
 	}
 }
 
+func TestUserGroupsRepository_BelongsTo(t *testing.T) {
+	suite := &postgresSuite{}
+	suite.setup(t)
+	defer suite.teardown(t)
+
+	ctx := context.TODO()
+	usr, err := suite.repository.user.Create(ctx, &UserEntity{
+		Username:  "nested@example.com",
+		Password:  []byte("password"),
+		FirstName: "first_name",
+		LastName:  "last_name",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	engineering, err := suite.repository.group.Insert(ctx, &GroupEntity{Name: "engineering"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	backend, err := suite.repository.group.Insert(ctx, &GroupEntity{Name: "backend"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if _, err = suite.repository.group.SetParent(ctx, backend.ID, ntypes.Int64{Int64: engineering.ID, Valid: true}); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if _, err = suite.repository.userGroups.Insert(ctx, &UserGroupsEntity{UserID: usr.ID, GroupID: backend.ID}); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	permission := charon.Permission("charon:nested:test")
+	if _, err = suite.repository.permission.InsertMissing(ctx, charon.Permissions{permission}); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if _, _, err = suite.repository.group.SetPermissions(ctx, engineering.ID, permission); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	assert := func(t *testing.T) {
+		belongs, err := suite.repository.userGroups.BelongsTo(ctx, usr.ID, engineering.ID)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		if !belongs {
+			t.Error("user should belong to parent group")
+		}
+		granted, err := suite.repository.user.IsGranted(ctx, usr.ID, permission)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		if !granted {
+			t.Error("permission of parent group should be granted")
+		}
+		permissions, err := suite.repository.permission.FindByUserID(ctx, usr.ID)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		if len(permissions) != 1 || permissions[0].Permission() != permission {
+			t.Errorf("wrong permissions: %v", permissions)
+		}
+	}
+
+	t.Run("nested", assert)
+	t.Run("ancestors", func(t *testing.T) {
+		ids, err := suite.repository.group.FindAncestorIDs(ctx, backend.ID)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		if len(ids) != 1 || ids[0] != engineering.ID {
+			t.Errorf("wrong ancestors, expected [%d] but got %v", engineering.ID, ids)
+		}
+	})
+	t.Run("cycle", func(t *testing.T) {
+		// Cycles are prevented by the handler, queries are expected to terminate anyway.
+		if _, err = suite.repository.group.SetParent(ctx, engineering.ID, ntypes.Int64{Int64: backend.ID, Valid: true}); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		assert(t)
+	})
+}
+
 func TestUserGroupsRepository_Set(t *testing.T) {
 	suite := &postgresSuite{}
 	suite.setup(t)
@@ -139,3 +225,134 @@ func loadUserGroupsFixtures(t *testing.T, r UserGroupsProvider, f []*UserGroupsE
 
 	return data
 }
+
+func TestUserGroupsRepository_SetTimeBound(t *testing.T) {
+	suite := &postgresSuite{}
+	suite.setup(t)
+	defer suite.teardown(t)
+
+	ctx := context.TODO()
+	usr, err := suite.repository.user.Create(ctx, &UserEntity{
+		Username:  "oncall@example.com",
+		Password:  []byte("password"),
+		FirstName: "first_name",
+		LastName:  "last_name",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	var ids []int64
+	for _, name := range []string{"permanent", "current", "expired", "pending"} {
+		grp, err := suite.repository.group.Insert(ctx, &GroupEntity{Name: name})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		ids = append(ids, grp.ID)
+	}
+	now := time.Now()
+	validity := map[int64]Validity{
+		ids[1]: {Until: pq.NullTime{Time: now.Add(time.Hour), Valid: true}},
+		ids[2]: {Until: pq.NullTime{Time: now.Add(-time.Hour), Valid: true}},
+		ids[3]: {From: pq.NullTime{Time: now.Add(time.Hour), Valid: true}},
+	}
+
+	inserted, _, err := suite.repository.userGroups.SetTimeBound(ctx, usr.ID, ids, validity)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if inserted != 4 {
+		t.Errorf("wrong number of inserted rows, expected 4 but got %d", inserted)
+	}
+	inserted, _, err = suite.repository.userGroups.SetTimeBound(ctx, usr.ID, ids, validity)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if inserted != 0 {
+		t.Errorf("same windows should be untouched, but %d rows were inserted", inserted)
+	}
+
+	for id, expected := range map[int64]bool{ids[0]: true, ids[1]: true, ids[2]: false, ids[3]: false} {
+		belongs, err := suite.repository.userGroups.BelongsTo(ctx, usr.ID, id)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		if belongs != expected {
+			t.Errorf("wrong membership of group %d, expected %t but got %t", id, expected, belongs)
+		}
+	}
+	groups, err := suite.repository.group.FindByUserID(ctx, usr.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(groups) != 2 {
+		t.Errorf("wrong number of groups, expected 2 but got %d", len(groups))
+	}
+
+	expired, err := suite.repository.userGroups.DeleteExpired(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(expired) != 1 || expired[0].GroupID != ids[2] {
+		t.Errorf("only expired membership should be removed, got: %v", expired)
+	}
+}
+
+func TestUserGroupsRepository_DeleteMapped(t *testing.T) {
+	suite := &postgresSuite{}
+	suite.setup(t)
+	defer suite.teardown(t)
+
+	ctx := context.TODO()
+	usr, err := suite.repository.user.Create(ctx, &UserEntity{
+		Username:  "mapped@example.com",
+		Password:  []byte("password"),
+		FirstName: "first_name",
+		LastName:  "last_name",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	// Memberships: mapped and kept, mapped and no longer claimed, mapped by other issuer, assigned manually.
+	mappedFrom := []ntypes.String{
+		{Chars: "https://idp.example.com", Valid: true},
+		{Chars: "https://idp.example.com", Valid: true},
+		{Chars: "https://other.example.com", Valid: true},
+		{},
+	}
+	ids := make([]int64, 0, len(mappedFrom))
+	for i, from := range mappedFrom {
+		grp, err := suite.repository.group.Insert(ctx, &GroupEntity{Name: fmt.Sprintf("mapped-%d", i)})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		if _, err = suite.repository.userGroups.Insert(ctx, &UserGroupsEntity{UserID: usr.ID, GroupID: grp.ID, MappedFrom: from}); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		ids = append(ids, grp.ID)
+	}
+
+	deleted, err := suite.repository.userGroups.DeleteMapped(ctx, usr.ID, "https://idp.example.com", ids[:1])
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if deleted != 1 {
+		t.Errorf("wrong number of removed memberships, expected 1 but got %d", deleted)
+	}
+	for i, id := range ids {
+		exists, err := suite.repository.userGroups.Exists(ctx, usr.ID, id)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		if exists != (i != 1) {
+			t.Errorf("wrong membership of group %d, expected %t but got %t", id, i != 1, exists)
+		}
+	}
+
+	// Nothing claimed anymore, remaining membership of the issuer goes as well.
+	if deleted, err = suite.repository.userGroups.DeleteMapped(ctx, usr.ID, "https://idp.example.com", nil); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if deleted != 1 {
+		t.Errorf("wrong number of removed memberships, expected 1 but got %d", deleted)
+	}
+}
@@ -0,0 +1,60 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/piotrkowalczuk/qtypes"
+)
+
+// UserIdentityProvider ...
+type UserIdentityProvider interface {
+	// Insert ...
+	Insert(context.Context, *UserIdentityEntity) (*UserIdentityEntity, error)
+	// FindOneByIssuerAndSubject ...
+	FindOneByIssuerAndSubject(ctx context.Context, issuer, subject string) (*UserIdentityEntity, error)
+	// FindByUserID ...
+	FindByUserID(ctx context.Context, userID int64) ([]*UserIdentityEntity, error)
+	// DeleteByUserID ...
+	DeleteByUserID(ctx context.Context, userID int64) (int64, error)
+}
+
+// UserIdentityRepository extends UserIdentityRepositoryBase.
+type UserIdentityRepository struct {
+	UserIdentityRepositoryBase
+}
+
+// NewUserIdentityRepository ...
+func NewUserIdentityRepository(dbPool *sql.DB) UserIdentityProvider {
+	return &UserIdentityRepository{
+		UserIdentityRepositoryBase: UserIdentityRepositoryBase{
+			DB:      dbPool,
+			Table:   TableUserIdentity,
+			Columns: TableUserIdentityColumns,
+		},
+	}
+}
+
+// Insert implements UserIdentityProvider interface, it takes part in a transaction carried by the context.
+func (uir *UserIdentityRepository) Insert(ctx context.Context, ent *UserIdentityEntity) (*UserIdentityEntity, error) {
+	return uir.insert(ctx, txFromContext(ctx), ent)
+}
+
+// FindOneByIssuerAndSubject implements UserIdentityProvider interface, it takes part in a transaction carried by the context.
+func (uir *UserIdentityRepository) FindOneByIssuerAndSubject(ctx context.Context, issuer, subject string) (*UserIdentityEntity, error) {
+	return uir.findOneByIssuerAndSubject(ctx, txFromContext(ctx), issuer, subject)
+}
+
+// FindByUserID implements UserIdentityProvider interface.
+func (uir *UserIdentityRepository) FindByUserID(ctx context.Context, userID int64) ([]*UserIdentityEntity, error) {
+	return uir.find(ctx, txFromContext(ctx), &UserIdentityFindExpr{
+		Where: &UserIdentityCriteria{
+			UserID: qtypes.EqualInt64(userID),
+		},
+	})
+}
+
+// DeleteByUserID implements UserIdentityProvider interface.
+func (uir *UserIdentityRepository) DeleteByUserID(ctx context.Context, userID int64) (int64, error) {
+	return deleteBy(ctx, uir.DB, uir.Table, TableUserIdentityColumnUserID, userID)
+}
@@ -0,0 +1,58 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+)
+
+func TestUserIdentityRepository(t *testing.T) {
+	suite := &postgresSuite{}
+	suite.setup(t)
+	defer suite.teardown(t)
+
+	ctx := context.TODO()
+	usr, err := suite.repository.user.Create(ctx, &UserEntity{
+		Username:  "identity@example.com",
+		Password:  ExternalPassword,
+		FirstName: "first_name",
+		LastName:  "last_name",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if _, err = suite.repository.userIdentity.Insert(ctx, &UserIdentityEntity{
+		UserID:  usr.ID,
+		Issuer:  "https://example.com",
+		Subject: "123",
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	_, err = suite.repository.userIdentity.Insert(ctx, &UserIdentityEntity{
+		UserID:  usr.ID,
+		Issuer:  "https://example.com",
+		Subject: "123",
+	})
+	if ErrorConstraint(err) != TableUserIdentityConstraintIssuerSubjectUnique {
+		t.Errorf("unique constraint violation expected, got: %v", err)
+	}
+
+	got, err := suite.repository.userIdentity.FindOneByIssuerAndSubject(ctx, "https://example.com", "123")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if got.UserID != usr.ID {
+		t.Errorf("wrong user id, expected %d but got %d", usr.ID, got.UserID)
+	}
+	if _, err = suite.repository.userIdentity.FindOneByIssuerAndSubject(ctx, "https://other.example.com", "123"); err != sql.ErrNoRows {
+		t.Errorf("identity of other issuer should not be found, got: %v", err)
+	}
+
+	if affected, err := suite.repository.userIdentity.DeleteByUserID(ctx, usr.ID); err != nil || affected != 1 {
+		t.Errorf("expected single identity to be removed, got %d (%v)", affected, err)
+	}
+	if identities, err := suite.repository.userIdentity.FindByUserID(ctx, usr.ID); err != nil || len(identities) != 0 {
+		t.Errorf("identities should be removed, got %d (%v)", len(identities), err)
+	}
+}
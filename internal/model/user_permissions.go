@@ -4,18 +4,23 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 )
 
 // UserPermissionsProvider ...
 type UserPermissionsProvider interface {
 	Insert(context.Context, *UserPermissionsEntity) (*UserPermissionsEntity, error)
+	Find(context.Context, *UserPermissionsFindExpr) ([]*UserPermissionsEntity, error)
 	DeleteByUserID(context.Context, int64) (int64, error)
+	// DeleteExpired removes links that are no longer in effect and returns them.
+	DeleteExpired(context.Context) ([]*UserPermissionsEntity, error)
 }
 
 // UserPermissionsRepository extends UserPermissionsRepositoryBase
 type UserPermissionsRepository struct {
 	UserPermissionsRepositoryBase
 	deleteByUserIDQuery string
+	deleteExpiredQuery  string
 }
 
 // NewUserPermissionsRepository ...
@@ -27,14 +32,35 @@ func NewUserPermissionsRepository(dbPool *sql.DB) UserPermissionsProvider {
 			Columns: TableUserPermissionsColumns,
 		},
 		deleteByUserIDQuery: fmt.Sprintf("DELETE FROM %s WHERE %s = $1", TableUserPermissions, TableUserPermissionsColumnUserID),
+		deleteExpiredQuery: fmt.Sprintf("DELETE FROM %s WHERE %s <= NOW() RETURNING %s",
+			TableUserPermissions,
+			TableUserPermissionsColumnValidUntil,
+			strings.Join(TableUserPermissionsColumns, ", "),
+		),
 	}
 }
 
 // DeleteByUserID removes all permissions of given user.
 func (upr *UserPermissionsRepository) DeleteByUserID(ctx context.Context, id int64) (int64, error) {
-	res, err := upr.DB.ExecContext(ctx, upr.deleteByUserIDQuery, id)
+	res, err := conn(ctx, upr.DB).ExecContext(ctx, upr.deleteByUserIDQuery, id)
 	if err != nil {
 		return 0, err
 	}
 	return res.RowsAffected()
 }
+
+// Find implements UserPermissionsProvider interface, it takes part in a transaction carried by the context.
+func (upr *UserPermissionsRepository) Find(ctx context.Context, fe *UserPermissionsFindExpr) ([]*UserPermissionsEntity, error) {
+	return upr.find(ctx, txFromContext(ctx), fe)
+}
+
+// DeleteExpired implements UserPermissionsProvider interface.
+func (upr *UserPermissionsRepository) DeleteExpired(ctx context.Context) ([]*UserPermissionsEntity, error) {
+	rows, err := conn(ctx, upr.DB).QueryContext(ctx, upr.deleteExpiredQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return ScanUserPermissionsRows(rows)
+}
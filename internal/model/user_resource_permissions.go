@@ -0,0 +1,165 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+	"github.com/piotrkowalczuk/charon"
+)
+
+// UserResourcePermissionsProvider ...
+type UserResourcePermissionsProvider interface {
+	Find(context.Context, *UserResourcePermissionsFindExpr) ([]*UserResourcePermissionsEntity, error)
+	// Set replaces permissions the user is granted on given resource, empty collection revokes all of them.
+	Set(ctx context.Context, userID int64, resourceType, resourceID string, permissions charon.Permissions) (int64, int64, error)
+	// FindResourceIDs retrieves ids of resources of given type the user is granted given permission on.
+	// Stored wildcards are honoured, nothing is returned if the permission is denied to the user.
+	FindResourceIDs(ctx context.Context, userID int64, resourceType string, permission charon.Permission) ([]string, error)
+	// DeleteByUserID revokes all permissions the user is granted on any resource.
+	DeleteByUserID(ctx context.Context, userID int64) (int64, error)
+}
+
+// UserResourcePermissionsRepository extends UserResourcePermissionsRepositoryBase
+type UserResourcePermissionsRepository struct {
+	UserResourcePermissionsRepositoryBase
+	findResourceIDsQuery string
+}
+
+// NewUserResourcePermissionsRepository ...
+func NewUserResourcePermissionsRepository(dbPool *sql.DB) UserResourcePermissionsProvider {
+	return &UserResourcePermissionsRepository{
+		UserResourcePermissionsRepositoryBase: UserResourcePermissionsRepositoryBase{
+			DB:      dbPool,
+			Table:   TableUserResourcePermissions,
+			Columns: TableUserResourcePermissionsColumns,
+		},
+		findResourceIDsQuery: memberOfQuery + `
+		SELECT DISTINCT urp.` + TableUserResourcePermissionsColumnResourceID + `
+		FROM ` + TableUserResourcePermissions + ` AS urp
+		INNER JOIN ` + TablePermission + ` AS p ON p.` + TablePermissionColumnID + ` = urp.` + TableUserResourcePermissionsColumnPermissionID + `
+		WHERE urp.` + TableUserResourcePermissionsColumnUserID + ` = $1
+			AND urp.` + TableUserResourcePermissionsColumnResourceType + ` = $5
+			AND ` + matchPermissionClause("p", TablePermissionColumnSubsystem, TablePermissionColumnModule, TablePermissionColumnAction) + `
+			AND NOT EXISTS(
+				SELECT 1 FROM ` + TableUserPermissions + ` AS up
+				WHERE up.` + TableUserPermissionsColumnUserID + ` = $1
+					AND up.` + TableUserPermissionsColumnDenied + `
+					AND ` + activeLinkClause("up", TableUserPermissionsColumnValidFrom, TableUserPermissionsColumnValidUntil) + `
+					AND ` + matchPermissionClause("up",
+			TableUserPermissionsColumnPermissionSubsystem,
+			TableUserPermissionsColumnPermissionModule,
+			TableUserPermissionsColumnPermissionAction,
+		) + `
+			)
+			AND NOT EXISTS(
+				SELECT 1 FROM ` + TableGroupPermissions + ` AS gp
+				INNER JOIN member_of AS mo ON gp.` + TableGroupPermissionsColumnGroupID + ` = mo.group_id
+				WHERE gp.` + TableGroupPermissionsColumnDenied + `
+					AND ` + matchPermissionClause("gp",
+			TableGroupPermissionsColumnPermissionSubsystem,
+			TableGroupPermissionsColumnPermissionModule,
+			TableGroupPermissionsColumnPermissionAction,
+		) + `
+			)
+		ORDER BY 1
+	`,
+	}
+}
+
+// Find implements UserResourcePermissionsProvider interface, it takes part in a transaction carried by the context.
+func (urpr *UserResourcePermissionsRepository) Find(ctx context.Context, fe *UserResourcePermissionsFindExpr) ([]*UserResourcePermissionsEntity, error) {
+	return urpr.find(ctx, txFromContext(ctx), fe)
+}
+
+// Set implements UserResourcePermissionsProvider interface.
+func (urpr *UserResourcePermissionsRepository) Set(ctx context.Context, userID int64, resourceType, resourceID string, permissions charon.Permissions) (inserted, deleted int64, err error) {
+	tx, end, err := beginTx(ctx, urpr.DB)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer func() {
+		err = end(err)
+	}()
+
+	var (
+		id, aff int64
+		ids     = make([]int64, 0, len(permissions))
+		res     sql.Result
+	)
+	for _, p := range permissions {
+		subsystem, module, action := p.Split()
+		err = tx.QueryRowContext(ctx, `
+			SELECT `+TablePermissionColumnID+` FROM `+TablePermission+`
+			WHERE `+TablePermissionColumnSubsystem+` = $1 AND `+TablePermissionColumnModule+` = $2 AND `+TablePermissionColumnAction+` = $3
+		`, subsystem, module, action).Scan(&id)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return 0, 0, ErrPermissionNotFound
+			}
+			return 0, 0, err
+		}
+		res, err = tx.ExecContext(ctx, `
+			INSERT INTO `+TableUserResourcePermissions+` (
+				`+TableUserResourcePermissionsColumnUserID+`,
+				`+TableUserResourcePermissionsColumnPermissionID+`,
+				`+TableUserResourcePermissionsColumnResourceType+`,
+				`+TableUserResourcePermissionsColumnResourceID+`
+			) VALUES ($1, $2, $3, $4)
+			ON CONFLICT DO NOTHING
+		`, userID, id, resourceType, resourceID)
+		if err != nil {
+			return 0, 0, pqErrorPrefix(err, "error on resource permission insert")
+		}
+		if aff, err = res.RowsAffected(); err != nil {
+			return 0, 0, err
+		}
+		inserted += aff
+		ids = append(ids, id)
+	}
+
+	res, err = tx.ExecContext(ctx, `
+		DELETE FROM `+TableUserResourcePermissions+`
+		WHERE `+TableUserResourcePermissionsColumnUserID+` = $1
+			AND `+TableUserResourcePermissionsColumnResourceType+` = $2
+			AND `+TableUserResourcePermissionsColumnResourceID+` = $3
+			AND `+TableUserResourcePermissionsColumnPermissionID+` <> ALL($4)
+	`, userID, resourceType, resourceID, pq.Array(ids))
+	if err != nil {
+		return 0, 0, pqErrorPrefix(err, "error on redundant resource permission removal")
+	}
+	if deleted, err = res.RowsAffected(); err != nil {
+		return 0, 0, err
+	}
+
+	return inserted, deleted, nil
+}
+
+// FindResourceIDs implements UserResourcePermissionsProvider interface.
+func (urpr *UserResourcePermissionsRepository) FindResourceIDs(ctx context.Context, userID int64, resourceType string, permission charon.Permission) ([]string, error) {
+	subsystem, module, action := permission.Split()
+	rows, err := conn(ctx, urpr.DB).QueryContext(ctx, urpr.findResourceIDsQuery, userID, subsystem, module, action, resourceType)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err = rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// DeleteByUserID implements UserResourcePermissionsProvider interface.
+func (urpr *UserResourcePermissionsRepository) DeleteByUserID(ctx context.Context, userID int64) (int64, error) {
+	return deleteBy(ctx, urpr.DB, urpr.Table, TableUserResourcePermissionsColumnUserID, userID)
+}
@@ -0,0 +1,137 @@
+package model
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/piotrkowalczuk/charon"
+)
+
+func TestUserResourcePermissionsRepository_Set(t *testing.T) {
+	suite := &postgresSuite{}
+	suite.setup(t)
+	defer suite.teardown(t)
+
+	ctx := context.TODO()
+	usr, err := suite.repository.user.Create(ctx, &UserEntity{
+		Username:  "resource@example.com",
+		Password:  []byte("password"),
+		FirstName: "first_name",
+		LastName:  "last_name",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if _, _, err = suite.repository.userResources.Set(ctx, usr.ID, "comment", "1", charon.Permissions{"forumservice:comment:can edit"}); err != ErrPermissionNotFound {
+		t.Fatalf("expected %s, got %v", ErrPermissionNotFound, err)
+	}
+	if _, err = suite.repository.permission.InsertMissing(ctx, charon.Permissions{
+		"forumservice:comment:can edit",
+		"forumservice:comment:can delete",
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	inserted, deleted, err := suite.repository.userResources.Set(ctx, usr.ID, "comment", "1", charon.Permissions{
+		"forumservice:comment:can edit",
+		"forumservice:comment:can delete",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if inserted != 2 || deleted != 0 {
+		t.Errorf("wrong number of inserted/deleted rows, expected 2/0 but got %d/%d", inserted, deleted)
+	}
+	inserted, deleted, err = suite.repository.userResources.Set(ctx, usr.ID, "comment", "1", charon.Permissions{
+		"forumservice:comment:can edit",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if inserted != 0 || deleted != 1 {
+		t.Errorf("wrong number of inserted/deleted rows, expected 0/1 but got %d/%d", inserted, deleted)
+	}
+}
+
+func TestUserResourcePermissionsRepository_FindResourceIDs(t *testing.T) {
+	suite := &postgresSuite{}
+	suite.setup(t)
+	defer suite.teardown(t)
+
+	ctx := context.TODO()
+	usr, err := suite.repository.user.Create(ctx, &UserEntity{
+		Username:  "resources@example.com",
+		Password:  []byte("password"),
+		FirstName: "first_name",
+		LastName:  "last_name",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if _, err = suite.repository.permission.InsertMissing(ctx, charon.Permissions{
+		"forumservice:comment:can edit",
+		"forumservice:comment:can delete",
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	for _, id := range []string{"1", "2", "3"} {
+		if _, _, err = suite.repository.userResources.Set(ctx, usr.ID, "comment", id, charon.Permissions{
+			"forumservice:comment:can edit",
+			"forumservice:comment:can delete",
+		}); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+	}
+	if _, _, err = suite.repository.userResources.Set(ctx, usr.ID, "post", "4", charon.Permissions{
+		"forumservice:comment:can edit",
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	// A denial on the global level overrides any resource level grant.
+	if _, _, err = suite.repository.user.SetPermissionsWithDenials(ctx, usr.ID, nil, charon.Permissions{
+		"forumservice:comment:can delete",
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	ids, err := suite.repository.userResources.FindResourceIDs(ctx, usr.ID, "comment", "forumservice:comment:can edit")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	sort.Strings(ids)
+	if !reflect.DeepEqual(ids, []string{"1", "2", "3"}) {
+		t.Errorf("wrong resource ids: %v", ids)
+	}
+
+	ids, err = suite.repository.userResources.FindResourceIDs(ctx, usr.ID, "comment", "forumservice:comment:can delete")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(ids) != 0 {
+		t.Errorf("denied permission should not expose any resource, got: %v", ids)
+	}
+
+	cases := map[string]bool{
+		"1": true,
+		"4": false,
+	}
+	for id, expected := range cases {
+		granted, err := suite.repository.user.IsGrantedOnResource(ctx, usr.ID, "forumservice:comment:can edit", "comment", id)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		if granted != expected {
+			t.Errorf("wrong result for comment %s, expected %t but got %t", id, expected, granted)
+		}
+	}
+	granted, err := suite.repository.user.IsGrantedOnResource(ctx, usr.ID, "forumservice:comment:can delete", "comment", "1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if granted {
+		t.Error("denied permission should not be granted on a resource")
+	}
+}
@@ -2,10 +2,14 @@ package model
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
+	"github.com/lib/pq"
+	"github.com/piotrkowalczuk/charon"
 	"github.com/piotrkowalczuk/ntypes"
 	"github.com/piotrkowalczuk/qtypes"
 )
@@ -107,6 +111,116 @@ func TestUserRepository_DeleteOneByID(t *testing.T) {
 	}
 }
 
+func TestUserRepository_SoftDeleteOneByID(t *testing.T) {
+	suite := &postgresSuite{}
+	suite.setup(t)
+	defer suite.teardown(t)
+
+	for res := range loadUserFixtures(t, suite.repository.user, userTestFixtures) {
+		ent, err := suite.repository.user.SoftDeleteOneByID(context.TODO(), res.got.ID)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		if !ent.DeletedAt.Valid {
+			t.Errorf("user was not marked as deleted")
+		}
+		if _, err = suite.repository.user.SoftDeleteOneByID(context.TODO(), res.got.ID); err != sql.ErrNoRows {
+			t.Errorf("deleted user should not be deleted again, got: %v", err)
+		}
+
+		deleted, err := suite.repository.user.FindDeletedBefore(context.TODO(), time.Now().Add(time.Minute))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		if len(deleted) != 1 || deleted[0].ID != res.got.ID {
+			t.Errorf("deleted user not found: %v", deleted)
+		}
+		deleted, err = suite.repository.user.FindDeletedBefore(context.TODO(), time.Now().Add(-time.Hour))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		if len(deleted) != 0 {
+			t.Errorf("recently deleted user should not be found: %v", deleted)
+		}
+
+		ent, err = suite.repository.user.RestoreOneByID(context.TODO(), res.got.ID)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		if ent.DeletedAt.Valid {
+			t.Errorf("user was not restored")
+		}
+		if _, err = suite.repository.user.RestoreOneByID(context.TODO(), res.got.ID); err != sql.ErrNoRows {
+			t.Errorf("user that is not deleted should not be restored, got: %v", err)
+		}
+	}
+}
+
+func TestUserRepository_Disown(t *testing.T) {
+	suite := &postgresSuite{}
+	suite.setup(t)
+	defer suite.teardown(t)
+
+	ctx := context.TODO()
+	author, err := suite.repository.user.Create(ctx, &UserEntity{
+		Username:  "author@example.com",
+		Password:  []byte("password"),
+		FirstName: "first_name",
+		LastName:  "last_name",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	authored, err := suite.repository.user.Create(ctx, &UserEntity{
+		Username:  "authored@example.com",
+		Password:  []byte("password"),
+		FirstName: "first_name",
+		LastName:  "last_name",
+		CreatedBy: ntypes.Int64{Int64: author.ID, Valid: true},
+		UpdatedBy: ntypes.Int64{Int64: author.ID, Valid: true},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	grp, err := suite.repository.group.Insert(ctx, &GroupEntity{
+		Name:      "authored",
+		CreatedBy: ntypes.Int64{Int64: author.ID, Valid: true},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	// Otherwise references of rows the author created prevent its removal.
+	if _, err = suite.repository.user.DeleteOneByID(ctx, author.ID); err == nil {
+		t.Fatal("author should not be removable yet")
+	}
+	aff, err := suite.repository.user.Disown(ctx, author.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if aff != 2 {
+		t.Errorf("wrong number of disowned rows, expected 2 but got %d", aff)
+	}
+	if _, err = suite.repository.user.DeleteOneByID(ctx, author.ID); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	got, err := suite.repository.user.FindOneByID(ctx, authored.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if got.CreatedBy.Valid || got.UpdatedBy.Valid {
+		t.Errorf("author should be cleared, got created by %v and updated by %v", got.CreatedBy, got.UpdatedBy)
+	}
+	gotGroup, err := suite.repository.group.FindOneByID(ctx, grp.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if gotGroup.CreatedBy.Valid {
+		t.Errorf("author should be cleared, got created by %v", gotGroup.CreatedBy)
+	}
+}
+
 func TestUserRepository_ChangePassword(t *testing.T) {
 	suite := &postgresSuite{}
 	suite.setup(t)
@@ -121,6 +235,77 @@ func TestUserRepository_ChangePassword(t *testing.T) {
 	}
 }
 
+func TestUserRepository_ConsumePasswordResetToken(t *testing.T) {
+	suite := &postgresSuite{}
+	suite.setup(t)
+	defer suite.teardown(t)
+
+	for res := range loadUserFixtures(t, suite.repository.user, userTestFixtures) {
+		valid := []byte(fmt.Sprintf("valid-%d", res.got.ID))
+		expired := []byte(fmt.Sprintf("expired-%d", res.got.ID))
+
+		if _, err := suite.repository.user.SetPasswordResetToken(context.TODO(), res.got.ID, expired, time.Now().Add(-time.Minute)); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		if _, err := suite.repository.user.ConsumePasswordResetToken(context.TODO(), expired); err != sql.ErrNoRows {
+			t.Errorf("expired token should not be accepted, got: %v", err)
+		}
+
+		if _, err := suite.repository.user.SetPasswordResetToken(context.TODO(), res.got.ID, valid, time.Now().Add(time.Minute)); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		ent, err := suite.repository.user.ConsumePasswordResetToken(context.TODO(), valid)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		if ent.ID != res.got.ID {
+			t.Errorf("wrong user, expected %d but got %d", res.got.ID, ent.ID)
+		}
+		if _, err := suite.repository.user.ConsumePasswordResetToken(context.TODO(), valid); err != sql.ErrNoRows {
+			t.Errorf("token should be usable only once, got: %v", err)
+		}
+	}
+}
+
+func TestUserRepository_RegistrationConfirmation(t *testing.T) {
+	suite := &postgresSuite{}
+	suite.setup(t)
+	defer suite.teardown(t)
+
+	create := func(username string, token []byte, expireAt time.Time) {
+		_, err := suite.repository.user.Create(context.TODO(), &UserEntity{
+			Username:                  username,
+			Password:                  []byte("password"),
+			FirstName:                 "John",
+			LastName:                  "Snow",
+			ConfirmationToken:         token,
+			ConfirmationTokenExpireAt: pq.NullTime{Time: expireAt, Valid: true},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+	}
+	create("expired@example.com", []byte("expired"), time.Now().Add(-time.Minute))
+	create("valid@example.com", []byte("valid"), time.Now().Add(time.Minute))
+
+	if _, err := suite.repository.user.RegistrationConfirmation(context.TODO(), []byte("expired")); err != sql.ErrNoRows {
+		t.Errorf("expired token should not be accepted, got: %v", err)
+	}
+	ent, err := suite.repository.user.RegistrationConfirmation(context.TODO(), []byte("valid"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if ent.Username != "valid@example.com" {
+		t.Errorf("wrong user, expected valid@example.com but got %s", ent.Username)
+	}
+	if !ent.IsConfirmed || !ent.IsActive {
+		t.Errorf("user should be confirmed and active")
+	}
+	if _, err := suite.repository.user.RegistrationConfirmation(context.TODO(), []byte("valid")); err != sql.ErrNoRows {
+		t.Errorf("token should be usable only once, got: %v", err)
+	}
+}
+
 func TestUserRepository_Create(t *testing.T) {
 	suite := &postgresSuite{}
 	suite.setup(t)
@@ -304,6 +489,230 @@ func TestUserRepository_IsGranted(t *testing.T) {
 	}
 }
 
+func TestUserRepository_IsGranted_wildcard(t *testing.T) {
+	suite := &postgresSuite{}
+	suite.setup(t)
+	defer suite.teardown(t)
+
+	ctx := context.TODO()
+	usr, err := suite.repository.user.Create(ctx, &UserEntity{
+		Username:  "wildcard@example.com",
+		Password:  []byte("password"),
+		FirstName: "first_name",
+		LastName:  "last_name",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	grp, err := suite.repository.group.Insert(ctx, &GroupEntity{Name: "moderators"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if _, err = suite.repository.userGroups.Insert(ctx, &UserGroupsEntity{UserID: usr.ID, GroupID: grp.ID}); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if _, err = suite.repository.permission.InsertMissing(ctx, charon.Permissions{
+		"forumservice:comment:*",
+		"blogservice:*:*",
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if _, _, err = suite.repository.user.SetPermissions(ctx, usr.ID, "forumservice:comment:*"); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if _, _, err = suite.repository.group.SetPermissions(ctx, grp.ID, "blogservice:*:*"); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	cases := map[charon.Permission]bool{
+		"forumservice:comment:can create": true,
+		"forumservice:comment:can delete": true,
+		"forumservice:post:can create":    false,
+		"blogservice:post:can create":     true,
+		"blogservice:comment:can delete":  true,
+		"charon:user:can create":          false,
+	}
+	for permission, expected := range cases {
+		granted, err := suite.repository.user.IsGranted(ctx, usr.ID, permission)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		if granted != expected {
+			t.Errorf("wrong result for %s, expected %t but got %t", permission, expected, granted)
+		}
+	}
+
+	granted, err := suite.repository.group.IsGranted(ctx, grp.ID, "blogservice:post:can create")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if !granted {
+		t.Error("group should be granted by wildcard")
+	}
+}
+
+func TestUserRepository_IsGrantedMany(t *testing.T) {
+	suite := &postgresSuite{}
+	suite.setup(t)
+	defer suite.teardown(t)
+
+	ctx := context.TODO()
+	create := func(username string) *UserEntity {
+		usr, err := suite.repository.user.Create(ctx, &UserEntity{
+			Username:  username,
+			Password:  []byte("password"),
+			FirstName: "first_name",
+			LastName:  "last_name",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		return usr
+	}
+	usr1, usr2 := create("many1@example.com"), create("many2@example.com")
+
+	grp, err := suite.repository.group.Insert(ctx, &GroupEntity{Name: "moderators"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if _, err = suite.repository.userGroups.Insert(ctx, &UserGroupsEntity{UserID: usr2.ID, GroupID: grp.ID}); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if _, err = suite.repository.permission.InsertMissing(ctx, charon.Permissions{
+		"forumservice:comment:*",
+		"forumservice:comment:can delete",
+		"blogservice:post:can create",
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if _, _, err = suite.repository.user.SetPermissionsWithDenials(ctx, usr1.ID,
+		charon.Permissions{"forumservice:comment:*"},
+		charon.Permissions{"forumservice:comment:can delete"},
+	); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if _, _, err = suite.repository.group.SetPermissions(ctx, grp.ID, "blogservice:post:can create"); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	permissions := charon.Permissions{
+		"forumservice:comment:can create",
+		"forumservice:comment:can delete",
+		"blogservice:post:can create",
+	}
+	got, err := suite.repository.user.IsGrantedMany(ctx, []int64{usr1.ID, usr2.ID}, permissions)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	expected := map[int64]map[charon.Permission]bool{
+		usr1.ID: {
+			"forumservice:comment:can create": true,
+			"forumservice:comment:can delete": false,
+			"blogservice:post:can create":     false,
+		},
+		usr2.ID: {
+			"forumservice:comment:can create": false,
+			"forumservice:comment:can delete": false,
+			"blogservice:post:can create":     true,
+		},
+	}
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("wrong result, expected %v but got %v", expected, got)
+	}
+}
+
+func TestUserRepository_IsGranted_denial(t *testing.T) {
+	suite := &postgresSuite{}
+	suite.setup(t)
+	defer suite.teardown(t)
+
+	ctx := context.TODO()
+	usr, err := suite.repository.user.Create(ctx, &UserEntity{
+		Username:  "denial@example.com",
+		Password:  []byte("password"),
+		FirstName: "first_name",
+		LastName:  "last_name",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	grp, err := suite.repository.group.Insert(ctx, &GroupEntity{Name: "editors"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if _, err = suite.repository.userGroups.Insert(ctx, &UserGroupsEntity{UserID: usr.ID, GroupID: grp.ID}); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if _, err = suite.repository.permission.InsertMissing(ctx, charon.Permissions{
+		"forumservice:*:*",
+		"forumservice:comment:can delete",
+		"blogservice:post:can create",
+		"blogservice:post:*",
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	// Group grants broadly, but the user is denied a single action.
+	if _, _, err = suite.repository.group.SetPermissionsWithDenials(ctx, grp.ID,
+		charon.Permissions{"forumservice:*:*"},
+		charon.Permissions{"blogservice:post:*"},
+	); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if _, _, err = suite.repository.user.SetPermissionsWithDenials(ctx, usr.ID,
+		charon.Permissions{"blogservice:post:can create"},
+		charon.Permissions{"forumservice:comment:can delete"},
+	); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	cases := map[charon.Permission]bool{
+		"forumservice:comment:can create": true,
+		"forumservice:comment:can delete": false,
+		"blogservice:post:can create":     false,
+	}
+	for permission, expected := range cases {
+		granted, err := suite.repository.user.IsGranted(ctx, usr.ID, permission)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		if granted != expected {
+			t.Errorf("wrong result for %s, expected %t but got %t", permission, expected, granted)
+		}
+	}
+
+	denied, err := suite.repository.permission.FindDeniedByUserID(ctx, usr.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(denied) != 2 {
+		t.Errorf("wrong number of denied permissions, expected 2 but got %d", len(denied))
+	}
+	granted, err := suite.repository.permission.FindByUserID(ctx, usr.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(granted) != 2 {
+		t.Errorf("wrong number of granted permissions, expected 2 but got %d", len(granted))
+	}
+
+	// Flipping a denial into a grant.
+	created, removed, err := suite.repository.user.SetPermissionsWithDenials(ctx, usr.ID, charon.Permissions{"forumservice:comment:can delete"}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if created != 1 || removed != 1 {
+		t.Errorf("wrong number of created and removed links, got %d and %d", created, removed)
+	}
+	ok, err := suite.repository.user.IsGranted(ctx, usr.ID, "forumservice:comment:can delete")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if !ok {
+		t.Error("permission should be granted once denial is gone")
+	}
+}
+
 func TestUserRepository_SetPermissions(t *testing.T) {
 	t.Skip("not implemented")
 }
@@ -336,3 +745,81 @@ func loadUserFixtures(t *testing.T, r UserProvider, f []*UserEntity) chan userFi
 
 	return data
 }
+
+func TestUserRepository_SetTimeBoundPermissions(t *testing.T) {
+	suite := &postgresSuite{}
+	suite.setup(t)
+	defer suite.teardown(t)
+
+	ctx := context.TODO()
+	usr, err := suite.repository.user.Create(ctx, &UserEntity{
+		Username:  "contractor@example.com",
+		Password:  []byte("password"),
+		FirstName: "first_name",
+		LastName:  "last_name",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	permissions := charon.Permissions{
+		"forumservice:comment:can create",
+		"forumservice:comment:can edit",
+		"forumservice:comment:can delete",
+		"forumservice:comment:can restore",
+	}
+	if _, err = suite.repository.permission.InsertMissing(ctx, permissions); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	now := time.Now()
+	validity := map[charon.Permission]Validity{
+		"forumservice:comment:can edit":    {Until: pq.NullTime{Time: now.Add(time.Hour), Valid: true}},
+		"forumservice:comment:can delete":  {Until: pq.NullTime{Time: now.Add(-time.Hour), Valid: true}},
+		"forumservice:comment:can restore": {From: pq.NullTime{Time: now.Add(time.Hour), Valid: true}},
+	}
+	inserted, _, err := suite.repository.user.SetTimeBoundPermissions(ctx, usr.ID, permissions, nil, validity)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if inserted != 4 {
+		t.Errorf("wrong number of inserted rows, expected 4 but got %d", inserted)
+	}
+
+	cases := map[charon.Permission]bool{
+		"forumservice:comment:can create":  true,
+		"forumservice:comment:can edit":    true,
+		"forumservice:comment:can delete":  false,
+		"forumservice:comment:can restore": false,
+	}
+	for permission, expected := range cases {
+		granted, err := suite.repository.user.IsGranted(ctx, usr.ID, permission)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		if granted != expected {
+			t.Errorf("wrong result for %s, expected %t but got %t", permission, expected, granted)
+		}
+	}
+	found, err := suite.repository.permission.FindByUserID(ctx, usr.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(found) != 2 {
+		t.Errorf("wrong number of permissions, expected 2 but got %d", len(found))
+	}
+
+	// Without a window the link becomes permanent.
+	inserted, _, err = suite.repository.user.SetPermissionsWithDenials(ctx, usr.ID, permissions, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if inserted != 3 {
+		t.Errorf("wrong number of updated rows, expected 3 but got %d", inserted)
+	}
+	expired, err := suite.repository.userPermissions.DeleteExpired(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(expired) != 0 {
+		t.Errorf("nothing should expire, got: %v", expired)
+	}
+}
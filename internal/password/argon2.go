@@ -0,0 +1,133 @@
+package password
+
+import (
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// argon2idMaxMemory is the largest amount of memory, in kibibytes, that a single hash can take (4 GiB).
+// Parameters are read from stored hashes as well, so that a corrupted one cannot exhaust the memory.
+const argon2idMaxMemory = 4 * 1024 * 1024
+
+// Argon2idHasher hasher that use Argon2id algorithm to secure password.
+// Produced hashes are encoded using PHC string format, e.g.:
+//
+//	$argon2id$v=19$m=65536,t=1,p=4$<salt>$<key>
+type Argon2idHasher struct {
+	time, memory uint32
+	threads      uint8
+}
+
+// NewArgon2idHasher allocates new Argon2idHasher.
+// Memory is expressed in kibibytes.
+// If any of parameters is not acceptable it returns an error.
+func NewArgon2idHasher(time, memory uint32, threads uint8) (Hasher, error) {
+	if !argon2idParametersInRange(time, memory, threads) {
+		return nil, ErrArgon2idParametersOutOfRange
+	}
+
+	return &Argon2idHasher{time: time, memory: memory, threads: threads}, nil
+}
+
+// Hash implements Hasher interface.
+func (ah Argon2idHasher) Hash(plainPassword []byte) ([]byte, error) {
+	s, err := salt()
+	if err != nil {
+		return nil, err
+	}
+
+	return encodeArgon2id(argon2id{
+		version: argon2.Version,
+		time:    ah.time,
+		memory:  ah.memory,
+		threads: ah.threads,
+		salt:    s,
+		key:     argon2.IDKey(plainPassword, s, ah.time, ah.memory, ah.threads, keyLength),
+	}), nil
+}
+
+// Compare implements Hasher interface.
+func (ah Argon2idHasher) Compare(hashedPassword, plainPassword []byte) bool {
+	return compare(hashedPassword, plainPassword)
+}
+
+// NeedsRehash implements Hasher interface.
+func (ah Argon2idHasher) NeedsRehash(hashedPassword []byte) bool {
+	if strategy(hashedPassword) != StrategyArgon2id {
+		return true
+	}
+	h, err := decodeArgon2id(hashedPassword)
+	if err != nil {
+		return true
+	}
+	return h.version != argon2.Version || h.time != ah.time || h.memory != ah.memory || h.threads != ah.threads
+}
+
+type argon2id struct {
+	version      int
+	time, memory uint32
+	threads      uint8
+	salt, key    []byte
+}
+
+func encodeArgon2id(h argon2id) []byte {
+	return []byte(fmt.Sprintf(
+		"$%s$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		StrategyArgon2id,
+		h.version,
+		h.memory, h.time, h.threads,
+		base64.RawStdEncoding.EncodeToString(h.salt),
+		base64.RawStdEncoding.EncodeToString(h.key),
+	))
+}
+
+func decodeArgon2id(hashedPassword []byte) (*argon2id, error) {
+	parts := strings.Split(string(hashedPassword), "$")
+	if len(parts) != 6 || parts[1] != StrategyArgon2id {
+		return nil, fmt.Errorf("password: malformed argon2id hash")
+	}
+
+	var h argon2id
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &h.version); err != nil {
+		return nil, fmt.Errorf("password: malformed argon2id version: %s", err.Error())
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &h.memory, &h.time, &h.threads); err != nil {
+		return nil, fmt.Errorf("password: malformed argon2id parameters: %s", err.Error())
+	}
+
+	// Otherwise argon2.IDKey panics, or takes more memory than any hash should.
+	if !argon2idParametersInRange(h.time, h.memory, h.threads) {
+		return nil, ErrArgon2idParametersOutOfRange
+	}
+
+	var err error
+	if h.salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return nil, fmt.Errorf("password: malformed argon2id salt: %s", err.Error())
+	}
+	if h.key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil {
+		return nil, fmt.Errorf("password: malformed argon2id key: %s", err.Error())
+	}
+	if len(h.key) == 0 {
+		return nil, fmt.Errorf("password: malformed argon2id key: empty")
+	}
+
+	return &h, nil
+}
+
+func argon2idParametersInRange(time, memory uint32, threads uint8) bool {
+	return time >= 1 && threads >= 1 && memory >= 8*uint32(threads) && memory <= argon2idMaxMemory
+}
+
+func compareArgon2id(hashedPassword, plainPassword []byte) bool {
+	h, err := decodeArgon2id(hashedPassword)
+	if err != nil || h.version != argon2.Version {
+		return false
+	}
+	key := argon2.IDKey(plainPassword, h.salt, h.time, h.memory, h.threads, uint32(len(h.key)))
+
+	return subtle.ConstantTimeCompare(key, h.key) == 1
+}
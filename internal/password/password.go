@@ -1,6 +1,8 @@
 package password
 
 import (
+	"bytes"
+	"crypto/rand"
 	"errors"
 
 	"golang.org/x/crypto/bcrypt"
@@ -9,12 +11,36 @@ import (
 var (
 	// ErrBCryptCostOutOfRange can be returned by NewBCryptHasher if provided cost is not between min and max.
 	ErrBCryptCostOutOfRange = errors.New("password: bcrypt cost out of range")
+	// ErrArgon2idParametersOutOfRange can be returned by NewArgon2idHasher if provided parameters are not acceptable.
+	ErrArgon2idParametersOutOfRange = errors.New("password: argon2id parameters out of range")
+	// ErrSCryptParametersOutOfRange can be returned by NewSCryptHasher if provided parameters are not acceptable.
+	ErrSCryptParametersOutOfRange = errors.New("password: scrypt parameters out of range")
+)
+
+const (
+	// StrategyBCrypt identifies hasher that use BCrypt algorithm.
+	StrategyBCrypt = "bcrypt"
+	// StrategyArgon2id identifies hasher that use Argon2id algorithm.
+	StrategyArgon2id = "argon2id"
+	// StrategySCrypt identifies hasher that use scrypt algorithm.
+	StrategySCrypt = "scrypt"
+)
+
+const (
+	saltLength = 16
+	keyLength  = 32
 )
 
 // Hasher define set of methods that object needs to implement to be considered as a hasher.
+//
+// Every hasher produces self-describing hashes (algorithm and its parameters are encoded within),
+// and is able to compare passwords against hashes produced by any other hasher from this package.
+// It makes it possible to change the strategy without invalidating already stored passwords.
 type Hasher interface {
 	Hash([]byte) ([]byte, error)
 	Compare([]byte, []byte) bool
+	// NeedsRehash returns true if given hash was produced by different algorithm or with different parameters.
+	NeedsRehash([]byte) bool
 }
 
 // BCryptHasher hasher that use BCrypt algorithm to secure password.
@@ -39,6 +65,49 @@ func (bh BCryptHasher) Hash(plainPassword []byte) ([]byte, error) {
 
 // Compare implements Hasher interface.
 func (bh BCryptHasher) Compare(hashedPassword, plainPassword []byte) bool {
-	err := bcrypt.CompareHashAndPassword(hashedPassword, plainPassword)
-	return err == nil
+	return compare(hashedPassword, plainPassword)
+}
+
+// NeedsRehash implements Hasher interface.
+func (bh BCryptHasher) NeedsRehash(hashedPassword []byte) bool {
+	if strategy(hashedPassword) != StrategyBCrypt {
+		return true
+	}
+	cost, err := bcrypt.Cost(hashedPassword)
+	if err != nil {
+		return true
+	}
+	return cost != bh.cost
+}
+
+// strategy recognizes algorithm that was used to produce given hash.
+// BCrypt is assumed if hash does not match any other known format.
+func strategy(hashedPassword []byte) string {
+	switch {
+	case bytes.HasPrefix(hashedPassword, []byte("$"+StrategyArgon2id+"$")):
+		return StrategyArgon2id
+	case bytes.HasPrefix(hashedPassword, []byte("$"+StrategySCrypt+"$")):
+		return StrategySCrypt
+	default:
+		return StrategyBCrypt
+	}
+}
+
+func compare(hashedPassword, plainPassword []byte) bool {
+	switch strategy(hashedPassword) {
+	case StrategyArgon2id:
+		return compareArgon2id(hashedPassword, plainPassword)
+	case StrategySCrypt:
+		return compareSCrypt(hashedPassword, plainPassword)
+	default:
+		return bcrypt.CompareHashAndPassword(hashedPassword, plainPassword) == nil
+	}
+}
+
+func salt() ([]byte, error) {
+	s := make([]byte, saltLength)
+	if _, err := rand.Read(s); err != nil {
+		return nil, err
+	}
+	return s, nil
 }
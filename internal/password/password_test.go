@@ -12,34 +12,34 @@ import (
 func TestNewBCryptHasher_bellowMin(t *testing.T) {
 	ph, err := password.NewBCryptHasher(bcrypt.MinCost - 1)
 
-	testNewBCryptHasherFailure(t, ph, err)
+	testNewHasherFailure(t, ph, err)
 }
 
 func TestNewBCryptHasher_min(t *testing.T) {
 	ph, err := password.NewBCryptHasher(bcrypt.MinCost)
 
-	testNewBCryptHasherSuccess(t, ph, err)
+	testNewHasherSuccess(t, ph, err)
 }
 
 func TestNewBCryptHasher_between(t *testing.T) {
 	ph, err := password.NewBCryptHasher(25)
 
-	testNewBCryptHasherSuccess(t, ph, err)
+	testNewHasherSuccess(t, ph, err)
 }
 
 func TestNewBCryptHasher_max(t *testing.T) {
 	ph, err := password.NewBCryptHasher(bcrypt.MaxCost)
 
-	testNewBCryptHasherSuccess(t, ph, err)
+	testNewHasherSuccess(t, ph, err)
 }
 
 func TestNewBCryptHasher_aboveMax(t *testing.T) {
 	ph, err := password.NewBCryptHasher(bcrypt.MaxCost + 1)
 
-	testNewBCryptHasherFailure(t, ph, err)
+	testNewHasherFailure(t, ph, err)
 }
 
-func testNewBCryptHasherSuccess(t *testing.T, ph password.Hasher, err error) {
+func testNewHasherSuccess(t *testing.T, ph password.Hasher, err error) {
 	if err != nil {
 		t.Fatalf("unexpected error %s", err.Error())
 	}
@@ -48,7 +48,7 @@ func testNewBCryptHasherSuccess(t *testing.T, ph password.Hasher, err error) {
 	}
 }
 
-func testNewBCryptHasherFailure(t *testing.T, ph password.Hasher, err error) {
+func testNewHasherFailure(t *testing.T, ph password.Hasher, err error) {
 	if err == nil {
 		t.Error("error expected")
 	}
@@ -95,3 +95,219 @@ func TestBCryptHasher_Compare(t *testing.T) {
 		t.Error("password do not match")
 	}
 }
+
+func TestBCryptHasher_NeedsRehash(t *testing.T) {
+	given := []byte("123")
+
+	ph, err := password.NewBCryptHasher(bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	got, err := ph.Hash(given)
+	if err != nil {
+		t.Fatalf("hash returned unexpected error: %s", err.Error())
+	}
+	if ph.NeedsRehash(got) {
+		t.Error("hash produced by the same hasher should not require rehash")
+	}
+
+	stronger, err := password.NewBCryptHasher(bcrypt.MinCost + 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if !stronger.NeedsRehash(got) {
+		t.Error("hash produced with different cost should require rehash")
+	}
+}
+
+func TestNewArgon2idHasher(t *testing.T) {
+	cases := map[string]struct {
+		time, memory uint32
+		threads      uint8
+		ok           bool
+	}{
+		"valid":             {time: 1, memory: 64, threads: 1, ok: true},
+		"zero-time":         {time: 0, memory: 64, threads: 1},
+		"zero-threads":      {time: 1, memory: 64, threads: 0},
+		"not-enough-memory": {time: 1, memory: 8, threads: 2},
+		"too-much-memory":   {time: 1, memory: 8 * 1024 * 1024, threads: 1},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			ph, err := password.NewArgon2idHasher(c.time, c.memory, c.threads)
+			if c.ok {
+				testNewHasherSuccess(t, ph, err)
+			} else {
+				testNewHasherFailure(t, ph, err)
+			}
+		})
+	}
+}
+
+func TestArgon2idHasher_Hash(t *testing.T) {
+	ph, err := password.NewArgon2idHasher(1, 64, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	got, err := ph.Hash([]byte("123"))
+	if err != nil {
+		t.Fatalf("hash returned unexpected error: %s", err.Error())
+	}
+	if expected := []byte("$argon2id$v=19$m=64,t=1,p=1$"); !bytes.HasPrefix(got, expected) {
+		t.Errorf("hash should have prefix %s but does not: %s", expected, got)
+	}
+}
+
+func TestArgon2idHasher_Compare_parametersOutOfRange(t *testing.T) {
+	ph, err := password.NewArgon2idHasher(1, 64, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	// Parameters that argon2 cannot handle are rejected, instead of making it panic.
+	for _, hashed := range []string{
+		"$argon2id$v=19$m=64,t=0,p=1$c2FsdHNhbHQ$a2V5a2V5",
+		"$argon2id$v=19$m=64,t=1,p=0$c2FsdHNhbHQ$a2V5a2V5",
+		"$argon2id$v=19$m=8,t=1,p=2$c2FsdHNhbHQ$a2V5a2V5",
+		"$argon2id$v=19$m=4294967295,t=1,p=1$c2FsdHNhbHQ$a2V5a2V5",
+	} {
+		t.Run(hashed, func(t *testing.T) {
+			if ph.Compare([]byte(hashed), []byte("123")) {
+				t.Error("password should not match")
+			}
+			if !ph.NeedsRehash([]byte(hashed)) {
+				t.Error("hash should need rehash")
+			}
+		})
+	}
+}
+
+func TestNewSCryptHasher(t *testing.T) {
+	cases := map[string]struct {
+		cost, blockSize, parallelization int
+		ok                               bool
+	}{
+		"valid":                {cost: 4, blockSize: 8, parallelization: 1, ok: true},
+		"zero-cost":            {cost: 0, blockSize: 8, parallelization: 1},
+		"cost-too-big":         {cost: 31, blockSize: 8, parallelization: 1},
+		"zero-block-size":      {cost: 4, blockSize: 0, parallelization: 1},
+		"zero-parallelization": {cost: 4, blockSize: 8, parallelization: 0},
+		"memory-too-big":       {cost: 30, blockSize: 8, parallelization: 1},
+		"memory-limit":         {cost: 22, blockSize: 8, parallelization: 1, ok: true},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			ph, err := password.NewSCryptHasher(c.cost, c.blockSize, c.parallelization)
+			if c.ok {
+				testNewHasherSuccess(t, ph, err)
+			} else {
+				testNewHasherFailure(t, ph, err)
+			}
+		})
+	}
+}
+
+func TestSCryptHasher_Hash(t *testing.T) {
+	ph, err := password.NewSCryptHasher(4, 8, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	got, err := ph.Hash([]byte("123"))
+	if err != nil {
+		t.Fatalf("hash returned unexpected error: %s", err.Error())
+	}
+	if expected := []byte("$scrypt$ln=4,r=8,p=1$"); !bytes.HasPrefix(got, expected) {
+		t.Errorf("hash should have prefix %s but does not: %s", expected, got)
+	}
+}
+
+func TestSCryptHasher_Compare_parametersOutOfRange(t *testing.T) {
+	ph, err := password.NewSCryptHasher(4, 8, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	// Parameters that would make scrypt take more memory than any hash should are rejected before it allocates anything.
+	for _, hashed := range []string{
+		"$scrypt$ln=0,r=8,p=1$c2FsdHNhbHQ$a2V5a2V5",
+		"$scrypt$ln=4,r=0,p=1$c2FsdHNhbHQ$a2V5a2V5",
+		"$scrypt$ln=4,r=8,p=0$c2FsdHNhbHQ$a2V5a2V5",
+		"$scrypt$ln=30,r=8,p=1$c2FsdHNhbHQ$a2V5a2V5",
+		"$scrypt$ln=4,r=2147483647,p=1$c2FsdHNhbHQ$a2V5a2V5",
+		"$scrypt$ln=4,r=1,p=1073741823$c2FsdHNhbHQ$a2V5a2V5",
+	} {
+		t.Run(hashed, func(t *testing.T) {
+			if ph.Compare([]byte(hashed), []byte("123")) {
+				t.Error("password should not match")
+			}
+			if !ph.NeedsRehash([]byte(hashed)) {
+				t.Error("hash should need rehash")
+			}
+		})
+	}
+}
+
+func TestHasher_Compare(t *testing.T) {
+	bh, err := password.NewBCryptHasher(bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	ah, err := password.NewArgon2idHasher(1, 64, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	sh, err := password.NewSCryptHasher(4, 8, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	hashers := map[string]password.Hasher{
+		password.StrategyBCrypt:   bh,
+		password.StrategyArgon2id: ah,
+		password.StrategySCrypt:   sh,
+	}
+
+	given := []byte("123")
+	for producer, ph := range hashers {
+		hashed, err := ph.Hash(given)
+		if err != nil {
+			t.Fatalf("hash returned unexpected error: %s", err.Error())
+		}
+		for consumer, ch := range hashers {
+			t.Run(producer+"-"+consumer, func(t *testing.T) {
+				if !ch.Compare(hashed, given) {
+					t.Error("password do not match")
+				}
+				if ch.Compare(hashed, []byte("1234")) {
+					t.Error("wrong password should not match")
+				}
+				if needs := ch.NeedsRehash(hashed); needs != (producer != consumer) {
+					t.Errorf("wrong rehash decision, expected %t but got %t", producer != consumer, needs)
+				}
+			})
+		}
+	}
+}
+
+func TestGenerateToken(t *testing.T) {
+	token1, hash1, err := password.GenerateToken()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	token2, _, err := password.GenerateToken()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if token1 == token2 {
+		t.Error("tokens should be random")
+	}
+	if !bytes.Equal(hash1, password.HashToken(token1)) {
+		t.Error("hash does not match the token")
+	}
+	if bytes.Equal(hash1, password.HashToken(token2)) {
+		t.Error("hash matches different token")
+	}
+}
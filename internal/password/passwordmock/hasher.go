@@ -45,3 +45,17 @@ func (_m *Hasher) Hash(_a0 []byte) ([]byte, error) {
 
 	return r0, r1
 }
+
+// NeedsRehash provides a mock function with given fields: _a0
+func (_m *Hasher) NeedsRehash(_a0 []byte) bool {
+	ret := _m.Called(_a0)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func([]byte) bool); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
@@ -0,0 +1,137 @@
+package password
+
+import (
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+// scryptMaxMemory is the largest amount of memory, in bytes, that a single hash can take (4 GiB).
+// Like in case of argon2id, parameters are read from stored hashes as well and have to be bounded.
+const scryptMaxMemory = 4 * 1024 * 1024 * 1024
+
+// SCryptHasher hasher that use scrypt algorithm to secure password.
+// Produced hashes are encoded using PHC string format, e.g.:
+//
+//	$scrypt$ln=15,r=8,p=1$<salt>$<key>
+type SCryptHasher struct {
+	// cost is a base 2 logarithm of scrypt N parameter.
+	cost, blockSize, parallelization int
+}
+
+// NewSCryptHasher allocates new SCryptHasher.
+// Cost is a base 2 logarithm of CPU/memory cost parameter (N).
+// If any of parameters is not acceptable it returns an error.
+func NewSCryptHasher(cost, blockSize, parallelization int) (Hasher, error) {
+	if !scryptParametersInRange(cost, blockSize, parallelization) {
+		return nil, ErrSCryptParametersOutOfRange
+	}
+
+	return &SCryptHasher{cost: cost, blockSize: blockSize, parallelization: parallelization}, nil
+}
+
+// Hash implements Hasher interface.
+func (sh SCryptHasher) Hash(plainPassword []byte) ([]byte, error) {
+	s, err := salt()
+	if err != nil {
+		return nil, err
+	}
+	key, err := scrypt.Key(plainPassword, s, 1<<uint(sh.cost), sh.blockSize, sh.parallelization, keyLength)
+	if err != nil {
+		return nil, err
+	}
+
+	return encodeSCrypt(scryptHash{
+		cost:            sh.cost,
+		blockSize:       sh.blockSize,
+		parallelization: sh.parallelization,
+		salt:            s,
+		key:             key,
+	}), nil
+}
+
+// Compare implements Hasher interface.
+func (sh SCryptHasher) Compare(hashedPassword, plainPassword []byte) bool {
+	return compare(hashedPassword, plainPassword)
+}
+
+// NeedsRehash implements Hasher interface.
+func (sh SCryptHasher) NeedsRehash(hashedPassword []byte) bool {
+	if strategy(hashedPassword) != StrategySCrypt {
+		return true
+	}
+	h, err := decodeSCrypt(hashedPassword)
+	if err != nil {
+		return true
+	}
+	return h.cost != sh.cost || h.blockSize != sh.blockSize || h.parallelization != sh.parallelization
+}
+
+type scryptHash struct {
+	cost, blockSize, parallelization int
+	salt, key                        []byte
+}
+
+func encodeSCrypt(h scryptHash) []byte {
+	return []byte(fmt.Sprintf(
+		"$%s$ln=%d,r=%d,p=%d$%s$%s",
+		StrategySCrypt,
+		h.cost, h.blockSize, h.parallelization,
+		base64.RawStdEncoding.EncodeToString(h.salt),
+		base64.RawStdEncoding.EncodeToString(h.key),
+	))
+}
+
+func decodeSCrypt(hashedPassword []byte) (*scryptHash, error) {
+	parts := strings.Split(string(hashedPassword), "$")
+	if len(parts) != 5 || parts[1] != StrategySCrypt {
+		return nil, fmt.Errorf("password: malformed scrypt hash")
+	}
+
+	var h scryptHash
+	if _, err := fmt.Sscanf(parts[2], "ln=%d,r=%d,p=%d", &h.cost, &h.blockSize, &h.parallelization); err != nil {
+		return nil, fmt.Errorf("password: malformed scrypt parameters: %s", err.Error())
+	}
+	// Otherwise scrypt.Key fails only after it allocates more memory than any hash should.
+	if !scryptParametersInRange(h.cost, h.blockSize, h.parallelization) {
+		return nil, ErrSCryptParametersOutOfRange
+	}
+
+	var err error
+	if h.salt, err = base64.RawStdEncoding.DecodeString(parts[3]); err != nil {
+		return nil, fmt.Errorf("password: malformed scrypt salt: %s", err.Error())
+	}
+	if h.key, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return nil, fmt.Errorf("password: malformed scrypt key: %s", err.Error())
+	}
+	if len(h.key) == 0 {
+		return nil, fmt.Errorf("password: malformed scrypt key: empty")
+	}
+
+	return &h, nil
+}
+
+// scryptParametersInRange checks memory scrypt needs, 128·r·N bytes for the cost and 128·r·p bytes for the parallelization.
+// The latter keeps r·p below 2^30 as well, as scrypt requires.
+func scryptParametersInRange(cost, blockSize, parallelization int) bool {
+	if cost < 1 || cost > 30 || blockSize < 1 || parallelization < 1 {
+		return false
+	}
+	return uint64(blockSize) <= scryptMaxMemory/128>>uint(cost) && uint64(blockSize)*uint64(parallelization) <= scryptMaxMemory/128
+}
+
+func compareSCrypt(hashedPassword, plainPassword []byte) bool {
+	h, err := decodeSCrypt(hashedPassword)
+	if err != nil {
+		return false
+	}
+	key, err := scrypt.Key(plainPassword, h.salt, 1<<uint(h.cost), h.blockSize, h.parallelization, len(h.key))
+	if err != nil {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare(key, h.key) == 1
+}
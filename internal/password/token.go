@@ -0,0 +1,27 @@
+package password
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+const tokenLength = 32
+
+// GenerateToken returns random single-use token (e.g. password reset or registration confirmation token),
+// together with hash of it. Only the hash is meant to be stored.
+func GenerateToken() (string, []byte, error) {
+	buf := make([]byte, tokenLength)
+	if _, err := rand.Read(buf); err != nil {
+		return "", nil, err
+	}
+	token := hex.EncodeToString(buf)
+
+	return token, HashToken(token), nil
+}
+
+// HashToken returns hash of a token produced by GenerateToken.
+func HashToken(token string) []byte {
+	sum := sha256.Sum256([]byte(token))
+	return sum[:]
+}
@@ -1,7 +1,11 @@
+// Package refreshtoken generates long-lived tokens sessions can be started with.
+// Token is never stored, its prefix identifies it and its hash is used to verify it.
 package refreshtoken
 
 import (
 	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
 	"encoding/hex"
 	"io"
 
@@ -24,6 +28,29 @@ func Random() (string, error) {
 	return string(hash2), nil
 }
 
+// prefixLength is a number of leading characters of a token that are stored as is.
+const prefixLength = 16
+
+// Prefix returns part of given token that identifies it. It is not a secret, it can be listed and logged.
+func Prefix(token string) string {
+	if len(token) < prefixLength {
+		return token
+	}
+	return token[:prefixLength]
+}
+
+// Hash returns digest the token is persisted as.
+// Token is random and long enough, so it does not need to be salted nor stretched.
+func Hash(token string) []byte {
+	sum := sha256.Sum256([]byte(token))
+	return sum[:]
+}
+
+// Compare returns true if given token matches given hash, it takes constant time.
+func Compare(hash []byte, token string) bool {
+	return subtle.ConstantTimeCompare(hash, Hash(token)) == 1
+}
+
 func generateRandomBytes(length int) ([]byte, error) {
 	k := make([]byte, length)
 	if _, err := io.ReadFull(rand.Reader, k); err != nil {
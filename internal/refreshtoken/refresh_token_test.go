@@ -0,0 +1,46 @@
+package refreshtoken
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRandom(t *testing.T) {
+	token, err := Random()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(token) != 64 {
+		t.Errorf("wrong token length: %d", len(token))
+	}
+	if prefix := Prefix(token); len(prefix) != prefixLength || !strings.HasPrefix(token, prefix) {
+		t.Errorf("wrong prefix: %s", prefix)
+	}
+	if !Compare(Hash(token), token) {
+		t.Error("token should match its hash")
+	}
+	if Compare(Hash(token), token+"0") {
+		t.Error("other token should not match the hash")
+	}
+
+	other, err := Random()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if other == token {
+		t.Error("tokens should be unique")
+	}
+}
+
+func TestPrefix(t *testing.T) {
+	for token, exp := range map[string]string{
+		"":                   "",
+		"abc":                "abc",
+		"0123456789abcdef":   "0123456789abcdef",
+		"0123456789abcdef01": "0123456789abcdef",
+	} {
+		if got := Prefix(token); got != exp {
+			t.Errorf("wrong prefix of %q, expected %q but got %q", token, exp, got)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/piotrkowalczuk/charon/internal/apikey"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"google.golang.org/grpc/codes"
+)
+
+// ErrInvalidAPIKey is returned if API key does not exist, does not match, is revoked or expired.
+// Reason is not revealed, so that the key cannot be probed.
+var ErrInvalidAPIKey = grpcerr.E(codes.Unauthenticated, "invalid api key")
+
+type byAPIKeyUserFinder struct {
+	key              string
+	userRepository   model.UserProvider
+	apiKeyRepository model.APIKeyProvider
+}
+
+var _ UserFinder = &byAPIKeyUserFinder{}
+
+func (f *byAPIKeyUserFinder) FindUser(ctx context.Context) (*model.UserEntity, error) {
+	if f.key == "" {
+		return nil, grpcerr.E(codes.InvalidArgument, "empty api key")
+	}
+	ent, err := findAPIKey(ctx, f.apiKeyRepository, f.key)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = f.apiKeyRepository.UpdateLastUsedAt(ctx, ent.ID); err != nil {
+		return nil, grpcerr.E(codes.Internal, "api key last used at update failure", err)
+	}
+
+	usr, err := f.userRepository.FindOneByID(ctx, ent.UserID)
+	if err != nil {
+		return nil, grpcerr.E(codes.Internal, "api key owner fetch failure", err)
+	}
+	return usr, nil
+}
+
+// findAPIKey returns entity of given API key, if the key can be used.
+func findAPIKey(ctx context.Context, repository model.APIKeyProvider, key string) (*model.APIKeyEntity, error) {
+	prefix, secret, ok := apikey.Split(key)
+	if !ok {
+		return nil, ErrInvalidAPIKey
+	}
+	ent, err := repository.FindOneByPrefix(ctx, prefix)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrInvalidAPIKey
+		}
+		return nil, grpcerr.E(codes.Internal, "api key fetch failure", err)
+	}
+	if !apikey.Compare(ent.Secret, secret) || !ent.IsUsable(time.Now()) {
+		return nil, ErrInvalidAPIKey
+	}
+	return ent, nil
+}
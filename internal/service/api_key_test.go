@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/piotrkowalczuk/charon/internal/apikey"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/model/modelmock"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+)
+
+func TestByAPIKeyUserFinder_FindUser(t *testing.T) {
+	key, prefix, hash, err := apikey.Random()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	userMock := &modelmock.UserProvider{}
+	apiKeyMock := &modelmock.APIKeyProvider{}
+
+	cases := map[string]struct {
+		key  string
+		init func(*testing.T)
+		code codes.Code
+	}{
+		"empty": {
+			code: codes.InvalidArgument,
+		},
+		"malformed": {
+			key:  "abc",
+			code: codes.Unauthenticated,
+		},
+		"does-not-exists": {
+			key: key,
+			init: func(t *testing.T) {
+				apiKeyMock.On("FindOneByPrefix", mock.Anything, prefix).Return(nil, sql.ErrNoRows).Once()
+			},
+			code: codes.Unauthenticated,
+		},
+		"wrong-secret": {
+			key: key,
+			init: func(t *testing.T) {
+				apiKeyMock.On("FindOneByPrefix", mock.Anything, prefix).
+					Return(&model.APIKeyEntity{ID: 1, UserID: 2, Secret: apikey.Hash("other")}, nil).
+					Once()
+			},
+			code: codes.Unauthenticated,
+		},
+		"expired": {
+			key: key,
+			init: func(t *testing.T) {
+				apiKeyMock.On("FindOneByPrefix", mock.Anything, prefix).
+					Return(&model.APIKeyEntity{ID: 1, UserID: 2, Secret: hash, ExpireAt: pq.NullTime{Time: time.Now().Add(-time.Minute), Valid: true}}, nil).
+					Once()
+			},
+			code: codes.Unauthenticated,
+		},
+		"revoked": {
+			key: key,
+			init: func(t *testing.T) {
+				apiKeyMock.On("FindOneByPrefix", mock.Anything, prefix).
+					Return(&model.APIKeyEntity{ID: 1, UserID: 2, Secret: hash, Revoked: true}, nil).
+					Once()
+			},
+			code: codes.Unauthenticated,
+		},
+		"valid": {
+			key: key,
+			init: func(t *testing.T) {
+				apiKeyMock.On("FindOneByPrefix", mock.Anything, prefix).
+					Return(&model.APIKeyEntity{ID: 1, UserID: 2, Secret: hash}, nil).
+					Once()
+				apiKeyMock.On("UpdateLastUsedAt", mock.Anything, int64(1)).Return(int64(1), nil).Once()
+				userMock.On("FindOneByID", mock.Anything, int64(2)).Return(&model.UserEntity{ID: 2}, nil).Once()
+			},
+		},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			userMock.ExpectedCalls = []*mock.Call{}
+			apiKeyMock.ExpectedCalls = []*mock.Call{}
+			if c.init != nil {
+				c.init(t)
+			}
+
+			usr, err := (&byAPIKeyUserFinder{key: c.key, userRepository: userMock, apiKeyRepository: apiKeyMock}).FindUser(context.Background())
+			if c.code != codes.OK {
+				if e, ok := err.(*grpcerr.Error); !ok || e.Code != c.code {
+					t.Fatalf("expected %s error, got: %v", c.code, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if usr.ID != 2 {
+				t.Errorf("wrong user: %d", usr.ID)
+			}
+			mock.AssertExpectationsForObjects(t, userMock, apiKeyMock)
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package service
+
+import (
+	"context"
+)
+
+// ExternalAuthenticator verifies user credentials against an external source of authentication (e.g. LDAP).
+// It is used for users which password is set to model.ExternalPassword,
+// which means that their secret is not stored by charon.
+type ExternalAuthenticator interface {
+	// Authenticate returns true if given credentials are valid.
+	// An error is returned only if verification itself failed, not if credentials do not match.
+	Authenticate(ctx context.Context, username, password string) (bool, error)
+}
@@ -0,0 +1,288 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/pkg/jwt"
+	"github.com/piotrkowalczuk/ntypes"
+	"github.com/piotrkowalczuk/qtypes"
+	"google.golang.org/grpc/codes"
+)
+
+// IdentityProvider is an upstream OpenID Connect issuer whose ID tokens are accepted by Login.
+type IdentityProvider struct {
+	// Issuer has to be identical to the iss claim of a token.
+	Issuer string `json:"issuer"`
+	// ClientID is the audience a token has to be issued for.
+	ClientID string `json:"client_id"`
+	// JWKSURL is optional, if empty it is read from the issuer metadata.
+	JWKSURL string `json:"jwks_url,omitempty"`
+	// AutoProvision creates a user the first time an unknown identity logs in.
+	AutoProvision bool `json:"auto_provision,omitempty"`
+	// LinkExisting links an unknown identity with a user of the same username, instead of rejecting it.
+	// It should be enabled only for issuers that can be trusted to verify the claim the username is taken from.
+	LinkExisting bool `json:"link_existing,omitempty"`
+	// UsernameClaim is email by default.
+	UsernameClaim string `json:"username_claim,omitempty"`
+	// GroupsClaim is groups by default.
+	GroupsClaim string `json:"groups_claim,omitempty"`
+	// GroupMapping maps values of the groups claim to names of charon groups.
+	GroupMapping map[string][]string `json:"group_mapping,omitempty"`
+	// DefaultGroups are names of groups every provisioned user is assigned to.
+	DefaultGroups []string `json:"default_groups,omitempty"`
+
+	verifier *jwt.Verifier
+}
+
+// groups returns names of charon groups the user with given claims should belong to.
+func (ip *IdentityProvider) groups(claims *jwt.Claims) []string {
+	names := append([]string{}, ip.DefaultGroups...)
+	for _, upstream := range claims.StringsClaim(ip.GroupsClaim) {
+		names = append(names, ip.GroupMapping[upstream]...)
+	}
+	return names
+}
+
+// LoadIdentityProviders reads JSON encoded list of identity providers from given file.
+func LoadIdentityProviders(path string) ([]*IdentityProvider, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var providers []*IdentityProvider
+	if err = json.Unmarshal(b, &providers); err != nil {
+		return nil, fmt.Errorf("identity providers decoding failure: %s", err.Error())
+	}
+	return providers, nil
+}
+
+// Federation authenticates users with ID tokens issued by trusted identity providers.
+// Identities are linked with users using model.UserIdentityProvider.
+type Federation struct {
+	UserRepository         model.UserProvider
+	UserIdentityRepository model.UserIdentityProvider
+	GroupRepository        model.GroupProvider
+	UserGroupsRepository   model.UserGroupsProvider
+	Transactor             model.Transactor
+
+	providers map[string]*IdentityProvider
+}
+
+// NewFederation validates given providers and prepares their key sets.
+// Keys are fetched lazily and cached, interval limits how often they can be fetched again.
+func NewFederation(providers []*IdentityProvider, client *http.Client, interval time.Duration) (*Federation, error) {
+	f := &Federation{providers: make(map[string]*IdentityProvider, len(providers))}
+	for _, ip := range providers {
+		if ip.Issuer == "" || ip.ClientID == "" {
+			return nil, fmt.Errorf("identity provider requires issuer and client id")
+		}
+		if _, ok := f.providers[ip.Issuer]; ok {
+			return nil, fmt.Errorf("identity provider %s is configured more than once", ip.Issuer)
+		}
+		if ip.UsernameClaim == "" {
+			ip.UsernameClaim = "email"
+		}
+		if ip.GroupsClaim == "" {
+			ip.GroupsClaim = "groups"
+		}
+
+		var keys jwt.KeySource
+		if ip.JWKSURL != "" {
+			keys = jwt.NewRemoteKeySet(ip.JWKSURL, client, interval)
+		} else {
+			keys = jwt.NewDiscoveredKeySet(ip.Issuer, client, interval)
+		}
+		ip.verifier = &jwt.Verifier{
+			Keys:     keys,
+			Issuer:   ip.Issuer,
+			Audience: ip.ClientID,
+			IDToken:  true,
+			Leeway:   time.Minute,
+		}
+		f.providers[ip.Issuer] = ip
+	}
+	return f, nil
+}
+
+// FindUser returns user linked with the identity the token represents.
+func (f *Federation) FindUser(ctx context.Context, idToken string) (*model.UserEntity, error) {
+	if idToken == "" {
+		return nil, grpcerr.E(codes.InvalidArgument, "empty id token")
+	}
+	// Issuer is read before verification only to pick the provider, it is verified afterwards.
+	iss, err := jwt.UnverifiedIssuer(idToken)
+	if err != nil {
+		return nil, grpcerr.E(codes.Unauthenticated, "malformed id token")
+	}
+	ip, ok := f.providers[iss]
+	if !ok {
+		return nil, grpcerr.E(codes.Unauthenticated, "id token issued by unknown identity provider")
+	}
+	claims, err := ip.verifier.Verify(ctx, idToken)
+	if err != nil {
+		switch err {
+		case jwt.ErrMalformed, jwt.ErrUnsupportedAlgorithm, jwt.ErrUnknownKey, jwt.ErrInvalidSignature,
+			jwt.ErrExpired, jwt.ErrNotValidYet, jwt.ErrInvalidIssuer, jwt.ErrInvalidAudience:
+			return nil, grpcerr.E(codes.Unauthenticated, "invalid id token", err)
+		default:
+			return nil, grpcerr.E(codes.Unavailable, "id token verification failure", err)
+		}
+	}
+	if claims.Subject == "" {
+		return nil, grpcerr.E(codes.Unauthenticated, "id token without subject")
+	}
+
+	var usr *model.UserEntity
+	err = f.Transactor.Transaction(ctx, func(ctx context.Context) error {
+		usr, err = f.findOrProvision(ctx, ip, claims)
+		if err != nil {
+			return err
+		}
+		return f.assignGroups(ctx, usr.ID, ip.Issuer, ip.groups(claims))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return usr, nil
+}
+
+func (f *Federation) findOrProvision(ctx context.Context, ip *IdentityProvider, claims *jwt.Claims) (*model.UserEntity, error) {
+	identity, err := f.UserIdentityRepository.FindOneByIssuerAndSubject(ctx, ip.Issuer, claims.Subject)
+	switch {
+	case err == nil:
+		usr, err := f.UserRepository.FindOneByID(ctx, identity.UserID)
+		if err != nil {
+			return nil, grpcerr.E(codes.Internal, "linked user fetch failure", err)
+		}
+		return usr, nil
+	case err != sql.ErrNoRows:
+		return nil, grpcerr.E(codes.Internal, "user identity fetch failure", err)
+	}
+
+	if !ip.AutoProvision && !ip.LinkExisting {
+		return nil, grpcerr.E(codes.Unauthenticated, "identity is not linked with any user")
+	}
+	username, ok := claims.StringClaim(ip.UsernameClaim)
+	if !ok || username == "" {
+		return nil, grpcerr.E(codes.Unauthenticated, fmt.Sprintf("id token does not contain %s claim", ip.UsernameClaim))
+	}
+	if verified, ok := claims.Raw["email_verified"].(bool); ok && !verified && ip.UsernameClaim == "email" {
+		return nil, grpcerr.E(codes.Unauthenticated, "email address is not verified by identity provider")
+	}
+
+	usr, err := f.UserRepository.FindOneByUsername(ctx, username)
+	switch {
+	case err == nil:
+		if !ip.LinkExisting {
+			return nil, grpcerr.E(codes.FailedPrecondition, "user with such username already exists, identity has to be linked")
+		}
+		if usr.IsServiceAccount {
+			return nil, grpcerr.E(codes.FailedPrecondition, "identity cannot be linked with service account")
+		}
+		// Linked identity is as good as the password, accounts that are worth more are never linked automatically.
+		if usr.IsSuperuser || usr.IsStaff {
+			return nil, grpcerr.E(codes.FailedPrecondition, "identity cannot be linked with privileged user")
+		}
+		if usr.TwoFactorConfirmedAt.Valid {
+			return nil, grpcerr.E(codes.FailedPrecondition, "identity cannot be linked with user that has second factor enabled")
+		}
+		// Identity provider may let anybody claim any address, only a verified one proves that it belongs to the user.
+		if verified, _ := claims.Raw["email_verified"].(bool); !verified && ip.UsernameClaim == "email" {
+			return nil, grpcerr.E(codes.Unauthenticated, "email address is not verified by identity provider")
+		}
+	case err == sql.ErrNoRows:
+		if !ip.AutoProvision {
+			return nil, grpcerr.E(codes.Unauthenticated, "identity is not linked with any user")
+		}
+		givenName, _ := claims.StringClaim("given_name")
+		familyName, _ := claims.StringClaim("family_name")
+		usr, err = f.UserRepository.Create(ctx, &model.UserEntity{
+			Username: username,
+			// Password is not known to charon, the user can log in only through the identity provider.
+			// ExternalPassword would let anybody owning the same username in the external authenticator in.
+			Password:    model.NoPassword,
+			FirstName:   givenName,
+			LastName:    familyName,
+			IsActive:    true,
+			IsConfirmed: true,
+		})
+		if err != nil {
+			return nil, grpcerr.E(codes.Internal, "user provisioning failure", err)
+		}
+	default:
+		return nil, grpcerr.E(codes.Internal, "user fetch failure", err)
+	}
+
+	if _, err = f.UserIdentityRepository.Insert(ctx, &model.UserIdentityEntity{
+		UserID:  usr.ID,
+		Issuer:  ip.Issuer,
+		Subject: claims.Subject,
+	}); err != nil {
+		return nil, grpcerr.E(codes.Internal, "user identity persistence failure", err)
+	}
+	return usr, nil
+}
+
+// assignGroups reconciles memberships created by group mapping of given issuer with given groups.
+// The user is added to groups it does not belong to yet, and removed from mapped groups that are not given anymore.
+// Groups assigned by other means are left untouched.
+// Groups that do not exist are ignored, so that mapping can refer to groups created later.
+func (f *Federation) assignGroups(ctx context.Context, userID int64, issuer string, names []string) error {
+	var (
+		groups []*model.GroupEntity
+		err    error
+	)
+	if len(names) > 0 {
+		groups, err = f.GroupRepository.Find(ctx, &model.GroupFindExpr{
+			Where: &model.GroupCriteria{Name: qtypes.InString(names...)},
+		})
+		if err != nil && err != sql.ErrNoRows {
+			return grpcerr.E(codes.Internal, "mapped groups fetch failure", err)
+		}
+	}
+	keep := make([]int64, 0, len(groups))
+	for _, g := range groups {
+		keep = append(keep, g.ID)
+
+		exists, err := f.UserGroupsRepository.Exists(ctx, userID, g.ID)
+		if err != nil {
+			return grpcerr.E(codes.Internal, "group membership check failure", err)
+		}
+		if exists {
+			continue
+		}
+		if _, err = f.UserGroupsRepository.Insert(ctx, &model.UserGroupsEntity{
+			UserID:     userID,
+			GroupID:    g.ID,
+			MappedFrom: ntypes.String{Chars: issuer, Valid: true},
+		}); err != nil {
+			return grpcerr.E(codes.Internal, "mapped group assignment failure", err)
+		}
+	}
+	if _, err = f.UserGroupsRepository.DeleteMapped(ctx, userID, issuer, keep); err != nil {
+		return grpcerr.E(codes.Internal, "mapped group removal failure", err)
+	}
+	return nil
+}
+
+type byIDTokenUserFinder struct {
+	idToken    string
+	federation *Federation
+}
+
+var _ UserFinder = &byIDTokenUserFinder{}
+
+func (f *byIDTokenUserFinder) FindUser(ctx context.Context) (*model.UserEntity, error) {
+	if f.federation == nil {
+		return nil, grpcerr.E(codes.FailedPrecondition, "federated login is not configured")
+	}
+	return f.federation.FindUser(ctx, f.idToken)
+}
@@ -0,0 +1,351 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/model/modelmock"
+	"github.com/piotrkowalczuk/charon/pkg/jwt"
+	"github.com/piotrkowalczuk/ntypes"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+)
+
+func testIdentityProvider(t *testing.T) (*httptest.Server, *jwt.Signer) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	signer, err := jwt.NewSigner("1", key)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	jwk, err := signer.JWK()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var issuer string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(rw http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(rw).Encode(map[string]string{"issuer": issuer, "jwks_uri": issuer + "/keys"})
+	})
+	mux.HandleFunc("/keys", func(rw http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(rw).Encode(&jwt.JWKS{Keys: []jwt.JWK{*jwk}})
+	})
+	ts := httptest.NewServer(mux)
+	issuer = ts.URL
+
+	return ts, signer
+}
+
+func TestFederation_FindUser(t *testing.T) {
+	ts, signer := testIdentityProvider(t)
+	defer ts.Close()
+
+	sign := func(t *testing.T, claims *jwt.Claims) string {
+		if claims.Issuer == "" {
+			claims.Issuer = ts.URL
+		}
+		if claims.Audience == nil {
+			claims.Audience = jwt.Audience{"charon"}
+		}
+		claims.IssuedAt = time.Now().Unix()
+		claims.ExpiresAt = time.Now().Add(time.Minute).Unix()
+		token, err := signer.SignIDToken(claims)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		return token
+	}
+
+	userMock := &modelmock.UserProvider{}
+	userIdentityMock := &modelmock.UserIdentityProvider{}
+	groupMock := &modelmock.GroupProvider{}
+	userGroupsMock := &modelmock.UserGroupsProvider{}
+	transactorMock := &modelmock.Transactor{}
+	transactorMock.On("Transaction", mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+
+	newFederation := func(t *testing.T, ip *IdentityProvider) *Federation {
+		ip.Issuer = ts.URL
+		ip.ClientID = "charon"
+		f, err := NewFederation([]*IdentityProvider{ip}, nil, time.Hour)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		f.UserRepository = userMock
+		f.UserIdentityRepository = userIdentityMock
+		f.GroupRepository = groupMock
+		f.UserGroupsRepository = userGroupsMock
+		f.Transactor = transactorMock
+		return f
+	}
+
+	cases := map[string]struct {
+		provider IdentityProvider
+		claims   jwt.Claims
+		init     func(*testing.T)
+		code     codes.Code
+	}{
+		"linked": {
+			claims: jwt.Claims{Subject: "abc"},
+			init: func(t *testing.T) {
+				userIdentityMock.On("FindOneByIssuerAndSubject", mock.Anything, ts.URL, "abc").
+					Return(&model.UserIdentityEntity{UserID: 1}, nil).Once()
+				userMock.On("FindOneByID", mock.Anything, int64(1)).
+					Return(&model.UserEntity{ID: 1}, nil).Once()
+				userGroupsMock.On("DeleteMapped", mock.Anything, int64(1), ts.URL, []int64{}).
+					Return(int64(0), nil).Once()
+			},
+		},
+		// Groups the mapping gave before are taken away once the identity provider stops claiming them.
+		"linked-groups-revoked": {
+			provider: IdentityProvider{
+				GroupMapping: map[string][]string{"admins": {"admin"}},
+			},
+			claims: jwt.Claims{Subject: "abc", Groups: []string{"other"}},
+			init: func(t *testing.T) {
+				userIdentityMock.On("FindOneByIssuerAndSubject", mock.Anything, ts.URL, "abc").
+					Return(&model.UserIdentityEntity{UserID: 1}, nil).Once()
+				userMock.On("FindOneByID", mock.Anything, int64(1)).
+					Return(&model.UserEntity{ID: 1}, nil).Once()
+				userGroupsMock.On("DeleteMapped", mock.Anything, int64(1), ts.URL, []int64{}).
+					Return(int64(1), nil).Once()
+			},
+		},
+		"linked-groups-removal-failure": {
+			claims: jwt.Claims{Subject: "abc"},
+			init: func(t *testing.T) {
+				userIdentityMock.On("FindOneByIssuerAndSubject", mock.Anything, ts.URL, "abc").
+					Return(&model.UserIdentityEntity{UserID: 1}, nil).Once()
+				userMock.On("FindOneByID", mock.Anything, int64(1)).
+					Return(&model.UserEntity{ID: 1}, nil).Once()
+				userGroupsMock.On("DeleteMapped", mock.Anything, int64(1), ts.URL, []int64{}).
+					Return(int64(0), sql.ErrConnDone).Once()
+			},
+			code: codes.Internal,
+		},
+		"not-linked": {
+			claims: jwt.Claims{Subject: "abc", Username: "john@example.com"},
+			init: func(t *testing.T) {
+				userIdentityMock.On("FindOneByIssuerAndSubject", mock.Anything, ts.URL, "abc").
+					Return(nil, sql.ErrNoRows).Once()
+			},
+			code: codes.Unauthenticated,
+		},
+		"provisioned": {
+			provider: IdentityProvider{
+				AutoProvision: true,
+				UsernameClaim: "username",
+				GroupMapping:  map[string][]string{"admins": {"admin"}},
+				DefaultGroups: []string{"everyone"},
+			},
+			claims: jwt.Claims{Subject: "abc", Username: "john@example.com", GivenName: "John", Groups: []string{"admins", "other"}},
+			init: func(t *testing.T) {
+				userIdentityMock.On("FindOneByIssuerAndSubject", mock.Anything, ts.URL, "abc").
+					Return(nil, sql.ErrNoRows).Once()
+				userMock.On("FindOneByUsername", mock.Anything, "john@example.com").
+					Return(nil, sql.ErrNoRows).Once()
+				userMock.On("Create", mock.Anything, mock.MatchedBy(func(ent *model.UserEntity) bool {
+					return ent.Username == "john@example.com" && ent.FirstName == "John" && ent.IsActive && ent.IsConfirmed && bytes.Equal(ent.Password, model.NoPassword)
+				})).Return(&model.UserEntity{ID: 1}, nil).Once()
+				userIdentityMock.On("Insert", mock.Anything, &model.UserIdentityEntity{UserID: 1, Issuer: ts.URL, Subject: "abc"}).
+					Return(&model.UserIdentityEntity{}, nil).Once()
+				groupMock.On("Find", mock.Anything, mock.MatchedBy(func(expr *model.GroupFindExpr) bool {
+					return len(expr.Where.Name.Values) == 2
+				})).Return([]*model.GroupEntity{{ID: 10}, {ID: 20}}, nil).Once()
+				userGroupsMock.On("Exists", mock.Anything, int64(1), int64(10)).Return(true, nil).Once()
+				userGroupsMock.On("Exists", mock.Anything, int64(1), int64(20)).Return(false, nil).Once()
+				userGroupsMock.On("Insert", mock.Anything, &model.UserGroupsEntity{
+					UserID:     1,
+					GroupID:    20,
+					MappedFrom: ntypes.String{Chars: ts.URL, Valid: true},
+				}).Return(&model.UserGroupsEntity{}, nil).Once()
+				userGroupsMock.On("DeleteMapped", mock.Anything, int64(1), ts.URL, []int64{10, 20}).
+					Return(int64(0), nil).Once()
+			},
+		},
+		"username-taken": {
+			provider: IdentityProvider{AutoProvision: true, UsernameClaim: "username"},
+			claims:   jwt.Claims{Subject: "abc", Username: "john@example.com"},
+			init: func(t *testing.T) {
+				userIdentityMock.On("FindOneByIssuerAndSubject", mock.Anything, ts.URL, "abc").
+					Return(nil, sql.ErrNoRows).Once()
+				userMock.On("FindOneByUsername", mock.Anything, "john@example.com").
+					Return(&model.UserEntity{ID: 1}, nil).Once()
+			},
+			code: codes.FailedPrecondition,
+		},
+		"linked-existing": {
+			provider: IdentityProvider{LinkExisting: true, UsernameClaim: "username"},
+			claims:   jwt.Claims{Subject: "abc", Username: "john@example.com"},
+			init: func(t *testing.T) {
+				userIdentityMock.On("FindOneByIssuerAndSubject", mock.Anything, ts.URL, "abc").
+					Return(nil, sql.ErrNoRows).Once()
+				userMock.On("FindOneByUsername", mock.Anything, "john@example.com").
+					Return(&model.UserEntity{ID: 1}, nil).Once()
+				userIdentityMock.On("Insert", mock.Anything, &model.UserIdentityEntity{UserID: 1, Issuer: ts.URL, Subject: "abc"}).
+					Return(&model.UserIdentityEntity{}, nil).Once()
+				userGroupsMock.On("DeleteMapped", mock.Anything, int64(1), ts.URL, []int64{}).
+					Return(int64(0), nil).Once()
+			},
+		},
+		"link-superuser": {
+			provider: IdentityProvider{LinkExisting: true, UsernameClaim: "username"},
+			claims:   jwt.Claims{Subject: "abc", Username: "john@example.com"},
+			init: func(t *testing.T) {
+				userIdentityMock.On("FindOneByIssuerAndSubject", mock.Anything, ts.URL, "abc").
+					Return(nil, sql.ErrNoRows).Once()
+				userMock.On("FindOneByUsername", mock.Anything, "john@example.com").
+					Return(&model.UserEntity{ID: 1, IsSuperuser: true}, nil).Once()
+			},
+			code: codes.FailedPrecondition,
+		},
+		"link-staff": {
+			provider: IdentityProvider{LinkExisting: true, UsernameClaim: "username"},
+			claims:   jwt.Claims{Subject: "abc", Username: "john@example.com"},
+			init: func(t *testing.T) {
+				userIdentityMock.On("FindOneByIssuerAndSubject", mock.Anything, ts.URL, "abc").
+					Return(nil, sql.ErrNoRows).Once()
+				userMock.On("FindOneByUsername", mock.Anything, "john@example.com").
+					Return(&model.UserEntity{ID: 1, IsStaff: true}, nil).Once()
+			},
+			code: codes.FailedPrecondition,
+		},
+		"link-second-factor": {
+			provider: IdentityProvider{LinkExisting: true, UsernameClaim: "username"},
+			claims:   jwt.Claims{Subject: "abc", Username: "john@example.com"},
+			init: func(t *testing.T) {
+				userIdentityMock.On("FindOneByIssuerAndSubject", mock.Anything, ts.URL, "abc").
+					Return(nil, sql.ErrNoRows).Once()
+				userMock.On("FindOneByUsername", mock.Anything, "john@example.com").
+					Return(&model.UserEntity{ID: 1, TwoFactorConfirmedAt: pq.NullTime{Time: time.Now(), Valid: true}}, nil).Once()
+			},
+			code: codes.FailedPrecondition,
+		},
+		"wrong-audience": {
+			claims: jwt.Claims{Subject: "abc", Audience: jwt.Audience{"other"}},
+			code:   codes.Unauthenticated,
+		},
+		"unknown-issuer": {
+			claims: jwt.Claims{Subject: "abc", Issuer: "https://example.com"},
+			code:   codes.Unauthenticated,
+		},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			userMock.ExpectedCalls = []*mock.Call{}
+			userIdentityMock.ExpectedCalls = []*mock.Call{}
+			groupMock.ExpectedCalls = []*mock.Call{}
+			userGroupsMock.ExpectedCalls = []*mock.Call{}
+			if c.init != nil {
+				c.init(t)
+			}
+
+			usr, err := newFederation(t, &c.provider).FindUser(context.Background(), sign(t, &c.claims))
+			if c.code != codes.OK {
+				if e, ok := err.(*grpcerr.Error); !ok || e.Code != c.code {
+					t.Fatalf("expected %s error, got: %v", c.code, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if usr.ID != 1 {
+				t.Errorf("wrong user: %d", usr.ID)
+			}
+			mock.AssertExpectationsForObjects(t, userMock, userIdentityMock, groupMock, userGroupsMock)
+		})
+	}
+}
+
+func TestFederation_findOrProvision_emailVerified(t *testing.T) {
+	userMock := &modelmock.UserProvider{}
+	userIdentityMock := &modelmock.UserIdentityProvider{}
+	f := &Federation{
+		UserRepository:         userMock,
+		UserIdentityRepository: userIdentityMock,
+	}
+	ip := &IdentityProvider{Issuer: "https://example.com", LinkExisting: true, UsernameClaim: "email"}
+
+	cases := map[string]struct {
+		raw  map[string]interface{}
+		code codes.Code
+	}{
+		"missing": {
+			raw:  map[string]interface{}{"email": "john@example.com"},
+			code: codes.Unauthenticated,
+		},
+		"not-verified": {
+			raw:  map[string]interface{}{"email": "john@example.com", "email_verified": false},
+			code: codes.Unauthenticated,
+		},
+		"malformed": {
+			raw:  map[string]interface{}{"email": "john@example.com", "email_verified": "true"},
+			code: codes.Unauthenticated,
+		},
+		"verified": {
+			raw: map[string]interface{}{"email": "john@example.com", "email_verified": true},
+		},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			userMock.ExpectedCalls = []*mock.Call{}
+			userIdentityMock.ExpectedCalls = []*mock.Call{}
+
+			userIdentityMock.On("FindOneByIssuerAndSubject", mock.Anything, ip.Issuer, "abc").
+				Return(nil, sql.ErrNoRows).Once()
+			userMock.On("FindOneByUsername", mock.Anything, "john@example.com").
+				Return(&model.UserEntity{ID: 1}, nil).Once()
+			if c.code == codes.OK {
+				userIdentityMock.On("Insert", mock.Anything, &model.UserIdentityEntity{UserID: 1, Issuer: ip.Issuer, Subject: "abc"}).
+					Return(&model.UserIdentityEntity{}, nil).Once()
+			}
+
+			usr, err := f.findOrProvision(context.Background(), ip, &jwt.Claims{Subject: "abc", Raw: c.raw})
+			if c.code != codes.OK {
+				if e, ok := err.(*grpcerr.Error); !ok || e.Code != c.code {
+					t.Fatalf("expected %s error, got: %v", c.code, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if usr.ID != 1 {
+				t.Errorf("wrong user: %d", usr.ID)
+			}
+			mock.AssertExpectationsForObjects(t, userMock, userIdentityMock)
+		})
+	}
+}
+
+func TestNewFederation(t *testing.T) {
+	if _, err := NewFederation([]*IdentityProvider{{Issuer: "https://example.com"}}, nil, time.Hour); err == nil {
+		t.Error("missing client id expected")
+	}
+	if _, err := NewFederation([]*IdentityProvider{
+		{Issuer: "https://example.com", ClientID: "a"},
+		{Issuer: "https://example.com", ClientID: "b"},
+	}, nil, time.Hour); err == nil {
+		t.Error("duplicated issuer expected")
+	}
+}
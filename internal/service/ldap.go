@@ -0,0 +1,265 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+)
+
+const (
+	ldapVersion = 3
+
+	ldapResultSuccess            = 0
+	ldapResultInvalidCredentials = 49
+
+	berTagInteger     = 0x02
+	berTagOctetString = 0x04
+	berTagEnumerated  = 0x0a
+	berTagSequence    = 0x30
+
+	ldapTagBindRequest     = 0x60
+	ldapTagBindResponse    = 0x61
+	ldapTagUnbindRequest   = 0x42
+	ldapTagAuthSimple      = 0x80
+	ldapMaxMessageLength   = 1 << 20
+	ldapDefaultTimeout     = 10 * time.Second
+	ldapBindRequestMsgID   = 1
+	ldapUnbindRequestMsgID = 2
+)
+
+var errLDAPMalformedMessage = errors.New("ldap: malformed message")
+
+// LDAPAuthenticator implements ExternalAuthenticator interface.
+// It verifies credentials by performing simple bind operation as a given user.
+type LDAPAuthenticator struct {
+	// Address of the directory server in host:port format.
+	Address string
+	// BindDN is a template of the user distinguished name, e.g. "uid=%s,ou=people,dc=example,dc=org".
+	// Username is escaped before it is placed within.
+	BindDN string
+	// TLS, if not nil, makes authenticator to connect using LDAPS.
+	TLS *tls.Config
+	// Timeout limits whole bind operation if context has no deadline.
+	Timeout time.Duration
+}
+
+var _ ExternalAuthenticator = &LDAPAuthenticator{}
+
+// Authenticate implements ExternalAuthenticator interface.
+func (la *LDAPAuthenticator) Authenticate(ctx context.Context, username, password string) (bool, error) {
+	// Bind with an empty password is an unauthenticated bind (RFC 4513, section 5.1.2),
+	// that most servers accept regardless of the name given.
+	if username == "" || password == "" {
+		return false, nil
+	}
+
+	timeout := la.Timeout
+	if timeout == 0 {
+		timeout = ldapDefaultTimeout
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(timeout)
+	}
+
+	dialer := net.Dialer{Deadline: deadline}
+	conn, err := dialer.DialContext(ctx, "tcp", la.Address)
+	if err != nil {
+		return false, fmt.Errorf("ldap: dial failure: %s", err.Error())
+	}
+	if la.TLS != nil {
+		conn = tls.Client(conn, la.TLS)
+	}
+	defer conn.Close()
+
+	if err = conn.SetDeadline(deadline); err != nil {
+		return false, err
+	}
+
+	bindDN := fmt.Sprintf(la.BindDN, ldapEscapeDN(username))
+	if _, err = conn.Write(ldapBindRequest(ldapBindRequestMsgID, bindDN, password)); err != nil {
+		return false, fmt.Errorf("ldap: bind request failure: %s", err.Error())
+	}
+
+	code, msg, err := ldapReadBindResponse(bufio.NewReader(conn))
+	if err != nil {
+		return false, err
+	}
+
+	// Connection is closed anyway, unbind is just a courtesy to the server.
+	_, _ = conn.Write(berTLV(berTagSequence, append(
+		berTLV(berTagInteger, berInt(ldapUnbindRequestMsgID)),
+		berTLV(ldapTagUnbindRequest, nil)...,
+	)))
+
+	switch code {
+	case ldapResultSuccess:
+		return true, nil
+	case ldapResultInvalidCredentials:
+		return false, nil
+	default:
+		return false, fmt.Errorf("ldap: bind failure with result code %d: %s", code, msg)
+	}
+}
+
+func ldapBindRequest(msgID int64, name, password string) []byte {
+	req := berTLV(berTagInteger, berInt(ldapVersion))
+	req = append(req, berTLV(berTagOctetString, []byte(name))...)
+	req = append(req, berTLV(ldapTagAuthSimple, []byte(password))...)
+
+	msg := berTLV(berTagInteger, berInt(msgID))
+	msg = append(msg, berTLV(ldapTagBindRequest, req)...)
+
+	return berTLV(berTagSequence, msg)
+}
+
+func ldapReadBindResponse(r *bufio.Reader) (int64, string, error) {
+	tag, msg, err := berRead(r)
+	if err != nil {
+		return 0, "", fmt.Errorf("ldap: bind response failure: %s", err.Error())
+	}
+	if tag != berTagSequence {
+		return 0, "", errLDAPMalformedMessage
+	}
+
+	tag, id, msg, err := berParse(msg)
+	if err != nil || tag != berTagInteger || berParseInt(id) != ldapBindRequestMsgID {
+		return 0, "", errLDAPMalformedMessage
+	}
+	tag, res, _, err := berParse(msg)
+	if err != nil || tag != ldapTagBindResponse {
+		return 0, "", errLDAPMalformedMessage
+	}
+
+	tag, code, res, err := berParse(res)
+	if err != nil || tag != berTagEnumerated {
+		return 0, "", errLDAPMalformedMessage
+	}
+	// matchedDN
+	if _, _, res, err = berParse(res); err != nil {
+		return 0, "", errLDAPMalformedMessage
+	}
+	_, diagnostic, _, err := berParse(res)
+	if err != nil {
+		return 0, "", errLDAPMalformedMessage
+	}
+
+	return berParseInt(code), string(diagnostic), nil
+}
+
+// ldapEscapeDN escapes attribute value according to RFC 4514, section 2.4.
+func ldapEscapeDN(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == 0:
+			b.WriteString(`\00`)
+			continue
+		case strings.IndexByte(`"+,;<=>\`, c) >= 0,
+			i == 0 && (c == ' ' || c == '#'),
+			i == len(s)-1 && c == ' ':
+			b.WriteByte('\\')
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
+
+func berTLV(tag byte, value []byte) []byte {
+	res := []byte{tag}
+	if l := len(value); l < 0x80 {
+		res = append(res, byte(l))
+	} else {
+		var length []byte
+		for ; l > 0; l >>= 8 {
+			length = append([]byte{byte(l)}, length...)
+		}
+		res = append(res, 0x80|byte(len(length)))
+		res = append(res, length...)
+	}
+	return append(res, value...)
+}
+
+func berInt(i int64) []byte {
+	res := []byte{byte(i)}
+	for i >>= 8; !(i == 0 && res[0]&0x80 == 0) && !(i == -1 && res[0]&0x80 != 0); i >>= 8 {
+		res = append([]byte{byte(i)}, res...)
+	}
+	return res
+}
+
+func berParseInt(b []byte) int64 {
+	var i int64
+	for n, c := range b {
+		if n == 0 && c&0x80 != 0 {
+			i = -1
+		}
+		i = i<<8 | int64(c)
+	}
+	return i
+}
+
+// berRead reads single element, it supports definite length form only.
+func berRead(r *bufio.Reader) (byte, []byte, error) {
+	tag, err := r.ReadByte()
+	if err != nil {
+		return 0, nil, err
+	}
+	l, err := r.ReadByte()
+	if err != nil {
+		return 0, nil, err
+	}
+	length := int(l)
+	if l&0x80 != 0 {
+		n := int(l & 0x7f)
+		if n == 0 || n > 4 {
+			return 0, nil, errLDAPMalformedMessage
+		}
+		length = 0
+		for i := 0; i < n; i++ {
+			if l, err = r.ReadByte(); err != nil {
+				return 0, nil, err
+			}
+			length = length<<8 | int(l)
+		}
+	}
+	if length > ldapMaxMessageLength {
+		return 0, nil, errLDAPMalformedMessage
+	}
+
+	value := make([]byte, length)
+	if _, err = io.ReadFull(r, value); err != nil {
+		return 0, nil, err
+	}
+	return tag, value, nil
+}
+
+// berParse splits given buffer into tag and value of the first element and whatever is left.
+func berParse(b []byte) (byte, []byte, []byte, error) {
+	if len(b) < 2 {
+		return 0, nil, nil, errLDAPMalformedMessage
+	}
+	tag, length, offset := b[0], int(b[1]), 2
+	if b[1]&0x80 != 0 {
+		n := int(b[1] & 0x7f)
+		if n == 0 || n > 4 || len(b) < 2+n {
+			return 0, nil, nil, errLDAPMalformedMessage
+		}
+		length = 0
+		for _, c := range b[2 : 2+n] {
+			length = length<<8 | int(c)
+		}
+		offset += n
+	}
+	if length < 0 || len(b)-offset < length {
+		return 0, nil, nil, errLDAPMalformedMessage
+	}
+	return tag, b[offset : offset+length], b[offset+length:], nil
+}
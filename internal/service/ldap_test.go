@@ -0,0 +1,264 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net"
+	"testing"
+	"time"
+)
+
+// Messages below are encoded by hand according to RFC 4511, section 4.2 and 4.3.
+// They are spelled out byte by byte, so that the encoding is not verified using itself.
+var (
+	// LDAPMessage{messageID: 1, BindRequest{version: 3, name: "uid=john,ou=people,dc=example,dc=org", simple: "secret"}}
+	ldapVectorBindRequest = []byte("\x30\x36" +
+		"\x02\x01\x01" +
+		"\x60\x31" +
+		"\x02\x01\x03" +
+		"\x04\x24uid=john,ou=people,dc=example,dc=org" +
+		"\x80\x06secret")
+	// LDAPMessage{messageID: 1, BindRequest{version: 3, name: `uid=smith\, jr,ou=people,dc=example,dc=org`, simple: "secret"}}
+	ldapVectorBindRequestEscaped = []byte("\x30\x3c" +
+		"\x02\x01\x01" +
+		"\x60\x37" +
+		"\x02\x01\x03" +
+		"\x04\x2auid=smith\\, jr,ou=people,dc=example,dc=org" +
+		"\x80\x06secret")
+	// LDAPMessage{messageID: 2, UnbindRequest}
+	ldapVectorUnbindRequest = []byte("\x30\x05\x02\x01\x02\x42\x00")
+	// LDAPMessage{messageID: 1, BindResponse{resultCode: success}}
+	ldapVectorBindResponseSuccess = []byte("\x30\x0c\x02\x01\x01\x61\x07\x0a\x01\x00\x04\x00\x04\x00")
+	// Same as above, but with lengths in the long form.
+	ldapVectorBindResponseSuccessLong = []byte("\x30\x81\x0d\x02\x01\x01\x61\x81\x07\x0a\x01\x00\x04\x00\x04\x00")
+	// LDAPMessage{messageID: 1, BindResponse{resultCode: invalidCredentials}}
+	ldapVectorBindResponseInvalidCredentials = []byte("\x30\x0c\x02\x01\x01\x61\x07\x0a\x01\x31\x04\x00\x04\x00")
+	// LDAPMessage{messageID: 1, BindResponse{resultCode: unavailable, diagnosticMessage: "busy"}}
+	ldapVectorBindResponseUnavailable = []byte("\x30\x10\x02\x01\x01\x61\x0b\x0a\x01\x34\x04\x00\x04\x04busy")
+	// LDAPMessage{messageID: 2, BindResponse{resultCode: success}}
+	ldapVectorBindResponseWrongID = []byte("\x30\x0c\x02\x01\x02\x61\x07\x0a\x01\x00\x04\x00\x04\x00")
+)
+
+// ldapStandIn is a directory server stand-in that replays a single recorded exchange.
+// It does not decode anything, whatever client sends is reported back to the test as is.
+type ldapStandIn struct {
+	listener net.Listener
+	// response is written once client sends as many bytes as the expected request has.
+	response []byte
+	// received gets the request, and everything that followed it before the connection was closed.
+	received chan [2][]byte
+}
+
+func newLDAPStandIn(t *testing.T, request, response []byte) *ldapStandIn {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("ldap stand-in tcp listener setup error: %s", err.Error())
+	}
+	s := &ldapStandIn{listener: l, response: response, received: make(chan [2][]byte, 1)}
+	go s.serve(len(request))
+
+	return s
+}
+
+func (s *ldapStandIn) serve(n int) {
+	conn, err := s.listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	req := make([]byte, n)
+	n, err = io.ReadFull(conn, req)
+	if err == nil {
+		_, err = conn.Write(s.response)
+	}
+	var rest []byte
+	if err == nil {
+		rest, _ = ioutil.ReadAll(conn)
+	}
+	s.received <- [2][]byte{req[:n], rest}
+}
+
+func (s *ldapStandIn) Close() error {
+	return s.listener.Close()
+}
+
+func TestLDAPAuthenticator_Authenticate(t *testing.T) {
+	cases := map[string]struct {
+		username, password string
+		request, response  []byte
+		// unbind is expected if bind response was understood.
+		unbind bool
+		ok     bool
+		err    bool
+	}{
+		"valid": {
+			username: "john", password: "secret",
+			request:  ldapVectorBindRequest,
+			response: ldapVectorBindResponseSuccess,
+			unbind:   true,
+			ok:       true,
+		},
+		"valid-escaped": {
+			username: "smith, jr", password: "secret",
+			request:  ldapVectorBindRequestEscaped,
+			response: ldapVectorBindResponseSuccess,
+			unbind:   true,
+			ok:       true,
+		},
+		"valid-long-form-length": {
+			username: "john", password: "secret",
+			request:  ldapVectorBindRequest,
+			response: ldapVectorBindResponseSuccessLong,
+			unbind:   true,
+			ok:       true,
+		},
+		"invalid-credentials": {
+			username: "john", password: "secret",
+			request:  ldapVectorBindRequest,
+			response: ldapVectorBindResponseInvalidCredentials,
+			unbind:   true,
+		},
+		"unavailable": {
+			username: "john", password: "secret",
+			request:  ldapVectorBindRequest,
+			response: ldapVectorBindResponseUnavailable,
+			unbind:   true,
+			err:      true,
+		},
+		"wrong-message-id": {
+			username: "john", password: "secret",
+			request:  ldapVectorBindRequest,
+			response: ldapVectorBindResponseWrongID,
+			err:      true,
+		},
+		"truncated-response": {
+			username: "john", password: "secret",
+			request:  ldapVectorBindRequest,
+			response: ldapVectorBindResponseSuccess[:8],
+			err:      true,
+		},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			standIn := newLDAPStandIn(t, c.request, c.response)
+			defer standIn.Close()
+
+			auth := &LDAPAuthenticator{
+				Address: standIn.listener.Addr().String(),
+				BindDN:  "uid=%s,ou=people,dc=example,dc=org",
+				Timeout: time.Second,
+			}
+
+			ok, err := auth.Authenticate(context.Background(), c.username, c.password)
+			if c.err {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if ok != c.ok {
+				t.Errorf("wrong result, expected %t but got %t", c.ok, ok)
+			}
+
+			received := <-standIn.received
+			if !bytes.Equal(received[0], c.request) {
+				t.Errorf("wrong bind request, expected:\n\t% x\nbut got:\n\t% x", c.request, received[0])
+			}
+			var unbind []byte
+			if c.unbind {
+				unbind = ldapVectorUnbindRequest
+			}
+			if !bytes.Equal(received[1], unbind) {
+				t.Errorf("wrong unbind request, expected:\n\t% x\nbut got:\n\t% x", unbind, received[1])
+			}
+		})
+	}
+}
+
+func TestLDAPAuthenticator_Authenticate_emptyPassword(t *testing.T) {
+	// Address is not reachable, unauthenticated bind has to be refused before connection is made.
+	auth := &LDAPAuthenticator{
+		Address: "127.0.0.1:0",
+		BindDN:  "uid=%s,ou=people,dc=example,dc=org",
+		Timeout: time.Second,
+	}
+	ok, err := auth.Authenticate(context.Background(), "john", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if ok {
+		t.Error("empty password should not be accepted")
+	}
+}
+
+func TestLDAPAuthenticator_Authenticate_unavailable(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("tcp listener setup error: %s", err.Error())
+	}
+	addr := l.Addr().String()
+	l.Close()
+
+	auth := &LDAPAuthenticator{
+		Address: addr,
+		BindDN:  "uid=%s,ou=people,dc=example,dc=org",
+		Timeout: time.Second,
+	}
+	if _, err := auth.Authenticate(context.Background(), "john", "secret"); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestLDAPEscapeDN(t *testing.T) {
+	// Expectations follow RFC 4514, section 2.4 and examples from section 4.
+	cases := map[string]string{
+		"john":                             "john",
+		"smith, jr":                        `smith\, jr`,
+		"john,ou=people,dc=example,dc=org": `john\,ou\=people\,dc\=example\,dc\=org`,
+		"Sue, Grabbins":                    `Sue\, Grabbins`,
+		"before+after":                     `before\+after`,
+		`quote"and\back`:                   `quote\"and\\back`,
+		"<less;greater>":                   `\<less\;greater\>`,
+		"#leading":                         `\#leading`,
+		"in#side":                          "in#side",
+		" both ":                           `\ both\ `,
+		"in side":                          "in side",
+		"nul\x00":                          `nul\00`,
+	}
+
+	for given, expected := range cases {
+		if got := ldapEscapeDN(given); got != expected {
+			t.Errorf("wrong escaping of %q, expected %q but got %q", given, expected, got)
+		}
+	}
+}
+
+func TestBerInt(t *testing.T) {
+	// Two's complement, big-endian, in the minimum number of octets (X.690, section 8.3).
+	cases := map[int64][]byte{
+		0:     {0x00},
+		1:     {0x01},
+		127:   {0x7f},
+		128:   {0x00, 0x80},
+		255:   {0x00, 0xff},
+		256:   {0x01, 0x00},
+		65535: {0x00, 0xff, 0xff},
+		-1:    {0xff},
+		-128:  {0x80},
+		-129:  {0xff, 0x7f},
+	}
+
+	for i, expected := range cases {
+		if got := berInt(i); !bytes.Equal(got, expected) {
+			t.Errorf("wrong encoding of %d, expected % x but got % x", i, expected, got)
+		}
+		if got := berParseInt(expected); got != i {
+			t.Errorf("wrong decoding of % x, expected %d but got %d", expected, i, got)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"math"
+	"time"
+
+	"github.com/piotrkowalczuk/charon/internal/model"
+)
+
+// LoginThrottler slows down password guessing.
+// Failed attempts are counted per username and per remote address,
+// every failure above the free limit doubles the time before the next attempt is accepted.
+// Additionally, if lockout threshold is reached, the user gets locked until unlocked explicitly.
+type LoginThrottler struct {
+	Repository     model.LoginFailureProvider
+	UserRepository model.UserProvider
+	// FreeAttempts is the number of failures that do not cause any delay.
+	FreeAttempts int64
+	// Delay is the delay after the first failure above the free limit, zero disables throttling.
+	Delay time.Duration
+	// MaxDelay limits exponential growth of the delay.
+	MaxDelay time.Duration
+	// Window is a period of time after which failures are forgotten.
+	Window time.Duration
+	// LockoutThreshold is the number of failures that locks the user, zero disables lockout.
+	LockoutThreshold int64
+}
+
+// Check returns for how long login attempts using given username or coming from given address are not accepted.
+// Zero means that the attempt can proceed.
+func (lt *LoginThrottler) Check(ctx context.Context, username, peer string) (time.Duration, error) {
+	var wait time.Duration
+	for _, key := range loginThrottlerKeys(username, peer) {
+		ent, err := lt.Repository.FindOneByKindAndSubject(ctx, key[0], key[1])
+		if err != nil {
+			if err == sql.ErrNoRows {
+				continue
+			}
+			return 0, err
+		}
+		if w := time.Until(ent.LastFailureAt.Add(lt.delay(ent.Failures))); w > wait {
+			wait = w
+		}
+	}
+	return wait, nil
+}
+
+// Failure registers failed attempt. It returns true if the user got locked as a result of it.
+func (lt *LoginThrottler) Failure(ctx context.Context, username, peer string) (bool, error) {
+	var locked bool
+	for _, key := range loginThrottlerKeys(username, peer) {
+		ent, err := lt.Repository.Register(ctx, key[0], key[1], time.Now().Add(-lt.Window))
+		if err != nil {
+			return false, err
+		}
+		if key[0] != model.LoginFailureKindUsername || lt.LockoutThreshold <= 0 || ent.Failures < lt.LockoutThreshold {
+			continue
+		}
+		aff, err := lt.UserRepository.Lock(ctx, username)
+		if err != nil {
+			return false, err
+		}
+		locked = aff > 0
+	}
+	return locked, nil
+}
+
+// Success forgets failures of given username and address.
+func (lt *LoginThrottler) Success(ctx context.Context, username, peer string) error {
+	for _, key := range loginThrottlerKeys(username, peer) {
+		if _, err := lt.Repository.Reset(ctx, key[0], key[1]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (lt *LoginThrottler) delay(failures int64) time.Duration {
+	if lt.Delay <= 0 || failures <= lt.FreeAttempts {
+		return 0
+	}
+	delay := lt.Delay
+	for i := lt.FreeAttempts + 1; i < failures && delay < math.MaxInt64/2; i++ {
+		delay *= 2
+	}
+	if lt.MaxDelay > 0 && delay > lt.MaxDelay {
+		return lt.MaxDelay
+	}
+	return delay
+}
+
+func loginThrottlerKeys(username, peer string) [][2]string {
+	keys := make([][2]string, 0, 2)
+	if username != "" {
+		keys = append(keys, [2]string{model.LoginFailureKindUsername, username})
+	}
+	if peer != "" {
+		keys = append(keys, [2]string{model.LoginFailureKindPeer, peer})
+	}
+	return keys
+}
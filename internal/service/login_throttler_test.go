@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/model/modelmock"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestLoginThrottler_delay(t *testing.T) {
+	lt := &LoginThrottler{
+		FreeAttempts: 3,
+		Delay:        time.Second,
+		MaxDelay:     time.Minute,
+	}
+	cases := map[int64]time.Duration{
+		0:        0,
+		3:        0,
+		4:        time.Second,
+		5:        2 * time.Second,
+		6:        4 * time.Second,
+		9:        32 * time.Second,
+		10:       time.Minute,
+		1 << 40:  time.Minute,
+		-1 << 40: 0,
+	}
+	for failures, expected := range cases {
+		if got := lt.delay(failures); got != expected {
+			t.Errorf("wrong delay for %d failures, expected %s but got %s", failures, expected, got)
+		}
+	}
+
+	if got := (&LoginThrottler{Delay: time.Second}).delay(1 << 40); got <= 0 {
+		t.Errorf("unlimited delay should not overflow, got %s", got)
+	}
+	if got := (&LoginThrottler{MaxDelay: time.Minute}).delay(100); got != 0 {
+		t.Errorf("throttling should be disabled, got %s", got)
+	}
+}
+
+func TestLoginThrottler_Check(t *testing.T) {
+	repositoryMock := &modelmock.LoginFailureProvider{}
+	lt := &LoginThrottler{
+		Repository:   repositoryMock,
+		FreeAttempts: 1,
+		Delay:        time.Minute,
+		MaxDelay:     time.Hour,
+	}
+
+	repositoryMock.On("FindOneByKindAndSubject", mock.Anything, model.LoginFailureKindUsername, "john").
+		Return(&model.LoginFailureEntity{Failures: 1, LastFailureAt: time.Now()}, nil).
+		Once()
+	repositoryMock.On("FindOneByKindAndSubject", mock.Anything, model.LoginFailureKindPeer, "127.0.0.1").
+		Return(&model.LoginFailureEntity{Failures: 3, LastFailureAt: time.Now()}, nil).
+		Once()
+
+	wait, err := lt.Check(context.Background(), "john", "127.0.0.1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if wait <= time.Minute || wait > 2*time.Minute {
+		t.Errorf("wrong wait time, expected about 2m but got %s", wait)
+	}
+
+	mock.AssertExpectationsForObjects(t, repositoryMock)
+}
+
+func TestLoginThrottler_Failure(t *testing.T) {
+	repositoryMock := &modelmock.LoginFailureProvider{}
+	userRepositoryMock := &modelmock.UserProvider{}
+	lt := &LoginThrottler{
+		Repository:       repositoryMock,
+		UserRepository:   userRepositoryMock,
+		Window:           time.Hour,
+		LockoutThreshold: 5,
+	}
+
+	repositoryMock.On("Register", mock.Anything, model.LoginFailureKindUsername, "john", mock.Anything).
+		Return(&model.LoginFailureEntity{Failures: 5}, nil).
+		Once()
+	repositoryMock.On("Register", mock.Anything, model.LoginFailureKindPeer, "127.0.0.1", mock.Anything).
+		Return(&model.LoginFailureEntity{Failures: 10}, nil).
+		Once()
+	userRepositoryMock.On("Lock", mock.Anything, "john").
+		Return(int64(1), nil).
+		Once()
+
+	locked, err := lt.Failure(context.Background(), "john", "127.0.0.1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if !locked {
+		t.Error("user should be locked")
+	}
+
+	mock.AssertExpectationsForObjects(t, repositoryMock, userRepositoryMock)
+}
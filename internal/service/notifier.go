@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// NotificationPasswordReset identifies notifications that carry a password reset token.
+	NotificationPasswordReset = "password_reset"
+	// NotificationRegistrationConfirmation identifies notifications that carry a registration confirmation token.
+	NotificationRegistrationConfirmation = "registration_confirmation"
+)
+
+// Notification is a message addressed to a user, that carries a secret they asked for (e.g. a password reset or registration confirmation token).
+type Notification struct {
+	Kind     string    `json:"kind"`
+	UserID   int64     `json:"user_id"`
+	Username string    `json:"username"`
+	Token    string    `json:"token"`
+	ExpireAt time.Time `json:"expire_at"`
+}
+
+// Notifier delivers notifications to users, e.g. by email.
+// Charon does not know how to reach its users, so it is up to the implementation how it is done.
+type Notifier interface {
+	Notify(ctx context.Context, n *Notification) error
+}
+
+// FileNotifier implements Notifier interface.
+// It appends notifications to a local file, one JSON document per line.
+// It is meant for development and testing, where no delivery channel is available.
+type FileNotifier struct {
+	Path string
+
+	lock sync.Mutex
+}
+
+// Notify implements Notifier interface.
+func (fn *FileNotifier) Notify(_ context.Context, n *Notification) error {
+	fn.lock.Lock()
+	defer fn.lock.Unlock()
+
+	// Notifications carry secrets, so the file is readable by its owner only.
+	f, err := os.OpenFile(fn.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if err = json.NewEncoder(f).Encode(n); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// LogNotifier implements Notifier interface.
+// It writes notifications to the log, but the secrets they carry are redacted, so they never reach the user.
+// It is a fallback that lets an operator notice that notifications are requested, a real notifier should be configured.
+type LogNotifier struct {
+	Logger *zap.Logger
+}
+
+// Notify implements Notifier interface.
+func (ln *LogNotifier) Notify(_ context.Context, n *Notification) error {
+	ln.Logger.Info("notification",
+		zap.String("kind", n.Kind),
+		zap.Int64("user_id", n.UserID),
+		zap.String("username", n.Username),
+		zap.String("token", "REDACTED"),
+		zap.Time("expire_at", n.ExpireAt),
+	)
+	return nil
+}
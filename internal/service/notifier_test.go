@@ -0,0 +1,96 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestFileNotifier_Notify(t *testing.T) {
+	dir, err := ioutil.TempDir("", "charon-notifier")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	defer os.RemoveAll(dir)
+
+	fn := &FileNotifier{Path: filepath.Join(dir, "notifications")}
+	expireAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	for _, token := range []string{"first", "second"} {
+		err := fn.Notify(context.Background(), &Notification{
+			Kind:     NotificationPasswordReset,
+			UserID:   1,
+			Username: "john",
+			Token:    token,
+			ExpireAt: expireAt,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+	}
+
+	info, err := os.Stat(fn.Path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("wrong file permissions: %s", info.Mode().Perm())
+	}
+
+	f, err := os.Open(fn.Path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	defer f.Close()
+
+	var got []Notification
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var n Notification
+		if err := json.Unmarshal(scanner.Bytes(), &n); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		got = append(got, n)
+	}
+	if len(got) != 2 {
+		t.Fatalf("wrong number of notifications, expected 2 but got %d", len(got))
+	}
+	if got[0].Token != "first" || got[1].Token != "second" {
+		t.Errorf("wrong order of notifications: %v", got)
+	}
+	if !got[1].ExpireAt.Equal(expireAt) || got[1].Kind != NotificationPasswordReset || got[1].Username != "john" {
+		t.Errorf("wrong notification: %v", got[1])
+	}
+}
+
+func TestLogNotifier_Notify(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	ln := &LogNotifier{Logger: zap.New(core)}
+
+	err := ln.Notify(context.Background(), &Notification{
+		Kind:     NotificationPasswordReset,
+		UserID:   1,
+		Username: "john",
+		Token:    "secret-token",
+		ExpireAt: time.Now().Add(time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if logs.Len() != 1 {
+		t.Fatalf("wrong number of log entries, expected 1 but got %d", logs.Len())
+	}
+	for k, v := range logs.All()[0].ContextMap() {
+		if v == "secret-token" {
+			t.Errorf("token should be redacted, but it was logged under %q", k)
+		}
+	}
+}
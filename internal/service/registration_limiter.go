@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/piotrkowalczuk/charon/internal/model"
+)
+
+// RegistrationLimiter limits number of registrations coming from a single remote address.
+// It can limit other unauthenticated requests as well (e.g. password reset), if they are counted under a different kind.
+// Attempts are counted using the same storage as failed logins,
+// the counter starts over once no attempt was made for the duration of the window.
+type RegistrationLimiter struct {
+	Repository model.LoginFailureProvider
+	// Kind under which attempts are counted, registrations are assumed if empty.
+	Kind string
+	// Limit is the number of registrations accepted from a single address, zero disables limiting.
+	Limit int64
+	// Window is a period of time after which attempts are forgotten.
+	Window time.Duration
+}
+
+// Allow registers an attempt coming from given address and reports whether it is within the limit.
+func (rl *RegistrationLimiter) Allow(ctx context.Context, peer string) (bool, error) {
+	if rl.Limit <= 0 || peer == "" {
+		return true, nil
+	}
+	kind := rl.Kind
+	if kind == "" {
+		kind = model.LoginFailureKindRegistration
+	}
+	ent, err := rl.Repository.Register(ctx, kind, peer, time.Now().Add(-rl.Window))
+	if err != nil {
+		return false, err
+	}
+	return ent.Failures <= rl.Limit, nil
+}
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/model/modelmock"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRegistrationLimiter_Allow(t *testing.T) {
+	repositoryMock := &modelmock.LoginFailureProvider{}
+	rl := &RegistrationLimiter{
+		Repository: repositoryMock,
+		Limit:      2,
+		Window:     time.Hour,
+	}
+
+	for i, expected := range []bool{true, true, false} {
+		repositoryMock.On("Register", mock.Anything, model.LoginFailureKindRegistration, "127.0.0.1", mock.Anything).
+			Return(&model.LoginFailureEntity{Failures: int64(i + 1)}, nil).
+			Once()
+
+		ok, err := rl.Allow(context.Background(), "127.0.0.1")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		if ok != expected {
+			t.Errorf("attempt %d: expected %t but got %t", i+1, expected, ok)
+		}
+	}
+
+	if ok, err := (&RegistrationLimiter{Repository: repositoryMock}).Allow(context.Background(), "127.0.0.1"); err != nil || !ok {
+		t.Errorf("limiting should be disabled, got %t, %v", ok, err)
+	}
+
+	repositoryMock.On("Register", mock.Anything, model.LoginFailureKindPasswordReset, "127.0.0.1", mock.Anything).
+		Return(&model.LoginFailureEntity{Failures: 1}, nil).
+		Once()
+	rl.Kind = model.LoginFailureKindPasswordReset
+	if ok, err := rl.Allow(context.Background(), "127.0.0.1"); err != nil || !ok {
+		t.Errorf("attempts of a different kind should be counted separately, got %t, %v", ok, err)
+	}
+
+	mock.AssertExpectationsForObjects(t, repositoryMock)
+}
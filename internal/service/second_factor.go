@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/totp"
+)
+
+// VerifySecondFactor checks given code against two-factor authentication settings of the user.
+// Code can be either a time-based one-time password or one of recovery codes.
+// Both are single-use, once verified they are marked as used and cannot be accepted again.
+// Consumed recovery code is removed from the entity as well.
+func VerifySecondFactor(ctx context.Context, repo model.UserProvider, usr *model.UserEntity, code string) (bool, error) {
+	if !usr.TwoFactorConfirmedAt.Valid || code == "" {
+		return false, nil
+	}
+
+	if step, ok := totp.Validate(usr.TwoFactorSecret, code, time.Now()); ok {
+		n, err := repo.UseTwoFactorStep(ctx, usr.ID, step)
+		if err != nil {
+			return false, err
+		}
+		return n > 0, nil
+	}
+
+	left, ok := totp.MatchRecoveryCode(usr.TwoFactorRecoveryCodes, code)
+	if !ok {
+		return false, nil
+	}
+	n, err := repo.ReplaceTwoFactorRecoveryCodes(ctx, usr.ID, usr.TwoFactorRecoveryCodes, left)
+	if err != nil {
+		return false, err
+	}
+	if n == 0 {
+		return false, nil
+	}
+	usr.TwoFactorRecoveryCodes = left
+
+	return true, nil
+}
@@ -4,14 +4,22 @@ import (
 	"bytes"
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/piotrkowalczuk/charon/internal/password"
+	"github.com/piotrkowalczuk/charon/internal/refreshtoken"
+	"github.com/piotrkowalczuk/charon/internal/totp"
 
+	"github.com/lib/pq"
 	"github.com/piotrkowalczuk/charon/internal/grpcerr"
 	"github.com/piotrkowalczuk/charon/internal/model"
+	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 )
 
+// ErrUserLocked is returned if user was locked after too many failed login attempts.
+var ErrUserLocked = grpcerr.E(codes.Unauthenticated, "user is locked")
+
 type UserFinder interface {
 	FindUser(context.Context) (*model.UserEntity, error)
 }
@@ -19,7 +27,18 @@ type UserFinder interface {
 type UserFinderFactory struct {
 	UserRepository         model.UserProvider
 	RefreshTokenRepository model.RefreshTokenProvider
+	APIKeyRepository       model.APIKeyProvider
 	Hasher                 password.Hasher
+	// Transactor is required only if RefreshTokenRotation is enabled.
+	Transactor model.Transactor
+	// RefreshTokenRotation replaces refresh token with a new one every time it is used.
+	RefreshTokenRotation bool
+	// ExternalAuthenticator is optional, if nil users with external password are not able to authenticate.
+	ExternalAuthenticator ExternalAuthenticator
+	// Federation is optional, if nil ID tokens of identity providers are not accepted.
+	Federation *Federation
+	// Logger is optional, if nil failures that do not prevent authentication are not reported.
+	Logger *zap.Logger
 }
 
 func (f *UserFinderFactory) ByUsernameAndPassword(username, password string) UserFinder {
@@ -28,14 +47,46 @@ func (f *UserFinderFactory) ByUsernameAndPassword(username, password string) Use
 		password:       password,
 		userRepository: f.UserRepository,
 		hasher:         f.Hasher,
+		external:       f.ExternalAuthenticator,
+		logger:         f.Logger,
 	}
 }
 
-func (f *UserFinderFactory) ByRefreshToken(refreshToken string) UserFinder {
-	return &byRefreshTokenUserFinder{
+// ByRefreshToken returns finder that authenticates owner of given refresh token.
+func (f *UserFinderFactory) ByRefreshToken(refreshToken string) *RefreshTokenUserFinder {
+	return &RefreshTokenUserFinder{
 		refreshToken:           refreshToken,
+		rotation:               f.RefreshTokenRotation,
 		userRepository:         f.UserRepository,
 		refreshTokenRepository: f.RefreshTokenRepository,
+		transactor:             f.Transactor,
+	}
+}
+
+// ByTOTP returns finder that completes login of a user with two-factor authentication enabled.
+// Challenge is issued after successful authentication using username and password.
+func (f *UserFinderFactory) ByTOTP(challenge, code string) UserFinder {
+	return &byTOTPUserFinder{
+		challenge:      challenge,
+		code:           code,
+		userRepository: f.UserRepository,
+	}
+}
+
+// ByAPIKey returns finder that authenticates owner of given API key.
+func (f *UserFinderFactory) ByAPIKey(key string) UserFinder {
+	return &byAPIKeyUserFinder{
+		key:              key,
+		userRepository:   f.UserRepository,
+		apiKeyRepository: f.APIKeyRepository,
+	}
+}
+
+// ByIDToken returns finder that authenticates user using ID token issued by a trusted identity provider.
+func (f *UserFinderFactory) ByIDToken(idToken string) UserFinder {
+	return &byIDTokenUserFinder{
+		idToken:    idToken,
+		federation: f.Federation,
 	}
 }
 
@@ -43,6 +94,8 @@ type byUsernameAndPasswordUserFinder struct {
 	username, password string
 	userRepository     model.UserProvider
 	hasher             password.Hasher
+	external           ExternalAuthenticator
+	logger             *zap.Logger
 }
 
 var _ UserFinder = &byUsernameAndPasswordUserFinder{}
@@ -62,38 +115,232 @@ func (f *byUsernameAndPasswordUserFinder) FindUser(ctx context.Context) (*model.
 		}
 		return nil, err
 	}
+	// Checked before credentials, so that it does not reveal whether the password was right.
+	if usr.LockedAt.Valid {
+		return nil, ErrUserLocked
+	}
 
+	if usr.IsServiceAccount || bytes.Equal(usr.Password, model.NoPassword) {
+		return nil, grpcerr.E(codes.Unauthenticated, "user with such username or password does not exists")
+	}
 	if bytes.Equal(usr.Password, model.ExternalPassword) {
-		return nil, grpcerr.E(codes.FailedPrecondition, "authentication failure, external password manager not implemented")
+		if f.external == nil {
+			return nil, grpcerr.E(codes.FailedPrecondition, "authentication failure, external authenticator not configured")
+		}
+		ok, err := f.external.Authenticate(ctx, f.username, f.password)
+		if err != nil {
+			return nil, grpcerr.E(codes.Unavailable, "authentication failure, external authenticator error", err)
+		}
+		if !ok {
+			return nil, grpcerr.E(codes.Unauthenticated, "user with such username or password does not exists")
+		}
+		return usr, nil
 	}
 	if matches := f.hasher.Compare(usr.Password, []byte(f.password)); !matches {
 		return nil, grpcerr.E(codes.Unauthenticated, "user with such username or password does not exists")
 	}
+	// Password is right, failed rehash is not a reason to refuse the login, it is attempted again next time.
+	if f.hasher.NeedsRehash(usr.Password) {
+		if err = f.rehash(ctx, usr); err != nil && f.logger != nil {
+			f.logger.Error("password rehash failure", zap.Int64("user_id", usr.ID), zap.Error(err))
+		}
+	}
 
 	return usr, nil
 }
 
-type byRefreshTokenUserFinder struct {
+// rehash upgrades stored password hash to currently configured algorithm and its parameters.
+// It is possible only right after successful authentication, while plain password is known.
+func (f *byUsernameAndPasswordUserFinder) rehash(ctx context.Context, usr *model.UserEntity) error {
+	hashed, err := f.hasher.Hash([]byte(f.password))
+	if err != nil {
+		return err
+	}
+	if err = f.userRepository.ChangePassword(ctx, usr.ID, string(hashed)); err != nil {
+		return err
+	}
+	usr.Password = hashed
+
+	return nil
+}
+
+// errRefreshTokenReuse is returned if refresh token that was already rotated is used again.
+var errRefreshTokenReuse = grpcerr.E(codes.Unauthenticated, "refresh token reuse detected")
+
+// RefreshTokenUserFinder authenticates owner of a refresh token.
+// Once the user is found, it exposes the token the session should be bound with.
+type RefreshTokenUserFinder struct {
 	refreshToken           string
+	rotation               bool
 	userRepository         model.UserProvider
 	refreshTokenRepository model.RefreshTokenProvider
+	transactor             model.Transactor
+
+	prefix    string
+	successor string
+	revoked   []string
 }
 
-var _ UserFinder = &byRefreshTokenUserFinder{}
+var _ UserFinder = &RefreshTokenUserFinder{}
 
-func (f *byRefreshTokenUserFinder) FindUser(ctx context.Context) (*model.UserEntity, error) {
+// FindUser implements UserFinder interface.
+// If token that was already rotated is presented, it is considered stolen and the whole family gets revoked.
+func (f *RefreshTokenUserFinder) FindUser(ctx context.Context) (*model.UserEntity, error) {
 	if f.refreshToken == "" {
 		return nil, grpcerr.E(codes.InvalidArgument, "empty refresh token")
 	}
 
-	refreshToken, err := f.refreshTokenRepository.FindOneByToken(ctx, f.refreshToken)
+	refreshToken, err := f.refreshTokenRepository.FindOneByPrefix(ctx, refreshtoken.Prefix(f.refreshToken))
 	if err != nil {
-		return nil, err
+		if err == sql.ErrNoRows {
+			return nil, grpcerr.E(codes.Unauthenticated, "refresh token does not exist")
+		}
+		return nil, grpcerr.E(codes.Internal, "refresh token fetch failure", err)
+	}
+	if !refreshtoken.Compare(refreshToken.Secret, f.refreshToken) {
+		return nil, grpcerr.E(codes.Unauthenticated, "refresh token does not exist")
+	}
+	if refreshToken.Revoked {
+		return nil, grpcerr.E(codes.Unauthenticated, "refresh token is revoked")
+	}
+	now := time.Now()
+	if refreshToken.ExpireAt.Valid && !refreshToken.ExpireAt.Time.After(now) {
+		return nil, grpcerr.E(codes.Unauthenticated, "refresh token is expired")
+	}
+	if refreshToken.RotatedAt.Valid {
+		return nil, f.revokeFamily(ctx, refreshToken.Family)
 	}
+
+	// Checked before the token is used, otherwise it would be rotated for a user that cannot log in,
+	// and the successor would never reach the client.
 	user, err := f.userRepository.FindOneByID(ctx, refreshToken.UserID)
 	if err != nil {
 		return nil, err
 	}
+	switch {
+	case user.DeletedAt.Valid:
+		return nil, grpcerr.E(codes.Unauthenticated, "refresh token does not exist")
+	case user.LockedAt.Valid:
+		return nil, ErrUserLocked
+	case !user.IsConfirmed:
+		return nil, grpcerr.E(codes.Unauthenticated, "user is not confirmed")
+	case !user.IsActive:
+		return nil, grpcerr.E(codes.Unauthenticated, "user is not active")
+	}
+
+	if f.rotation {
+		err = f.rotate(ctx, refreshToken)
+	} else {
+		_, err = f.refreshTokenRepository.UpdateOneByPrefix(ctx, refreshToken.Prefix, &model.RefreshTokenPatch{
+			LastUsedAt: pq.NullTime{Time: now, Valid: true},
+		})
+		f.prefix = refreshToken.Prefix
+	}
+	switch {
+	case err == errRefreshTokenReuse:
+		return nil, f.revokeFamily(ctx, refreshToken.Family)
+	case err != nil:
+		return nil, grpcerr.E("refresh token usage registration failure", err)
+	}
 
 	return user, nil
 }
+
+// rotate replaces given token with a new member of the same family.
+func (f *RefreshTokenUserFinder) rotate(ctx context.Context, ent *model.RefreshTokenEntity) error {
+	if f.transactor == nil {
+		return grpcerr.E(codes.FailedPrecondition, "refresh token rotation requires transactor")
+	}
+	tkn, err := refreshtoken.Random()
+	if err != nil {
+		return grpcerr.E(codes.Internal, "refresh token generation failure", err)
+	}
+	return f.transactor.Transaction(ctx, func(ctx context.Context) error {
+		// Concurrent use of the same token is detected here, only one of them can mark it as rotated.
+		affected, err := f.refreshTokenRepository.MarkRotated(ctx, ent.Prefix)
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			return errRefreshTokenReuse
+		}
+		successor, err := f.refreshTokenRepository.Create(ctx, &model.RefreshTokenEntity{
+			UserID:    ent.UserID,
+			Prefix:    refreshtoken.Prefix(tkn),
+			Secret:    refreshtoken.Hash(tkn),
+			Family:    ent.Family,
+			Notes:     ent.Notes,
+			ExpireAt:  ent.ExpireAt,
+			CreatedBy: ent.CreatedBy,
+		})
+		if err != nil {
+			return err
+		}
+		f.prefix = successor.Prefix
+		f.successor = tkn
+		return nil
+	})
+}
+
+func (f *RefreshTokenUserFinder) revokeFamily(ctx context.Context, family string) error {
+	revoked, err := f.refreshTokenRepository.RevokeFamily(ctx, family)
+	if err != nil {
+		return grpcerr.E(codes.Internal, "refresh token family revocation failure", err)
+	}
+	f.revoked = revoked
+	return errRefreshTokenReuse
+}
+
+// Prefix returns prefix of the token the session should be bound with.
+// If rotation is enabled, it is prefix of the successor.
+func (f *RefreshTokenUserFinder) Prefix() string {
+	return f.prefix
+}
+
+// Successor returns token that replaced the one being used, it is empty if rotation is disabled.
+func (f *RefreshTokenUserFinder) Successor() string {
+	return f.successor
+}
+
+// Revoked returns prefixes of tokens revoked due to detected reuse.
+func (f *RefreshTokenUserFinder) Revoked() []string {
+	return f.revoked
+}
+
+type byTOTPUserFinder struct {
+	challenge, code string
+	userRepository  model.UserProvider
+}
+
+var _ UserFinder = &byTOTPUserFinder{}
+
+func (f *byTOTPUserFinder) FindUser(ctx context.Context) (*model.UserEntity, error) {
+	if f.challenge == "" {
+		return nil, grpcerr.E(codes.InvalidArgument, "empty challenge")
+	}
+	if f.code == "" {
+		return nil, grpcerr.E(codes.InvalidArgument, "empty code")
+	}
+
+	// Challenge is consumed even if the code is wrong, so every attempt requires the first factor again.
+	usr, err := f.userRepository.ConsumeTwoFactorChallenge(ctx, totp.HashChallenge(f.challenge))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, grpcerr.E(codes.Unauthenticated, "second factor challenge is invalid or expired")
+		}
+		return nil, err
+	}
+	if !usr.TwoFactorConfirmedAt.Valid {
+		return nil, grpcerr.E(codes.Unauthenticated, "two-factor authentication is not enabled")
+	}
+
+	ok, err := VerifySecondFactor(ctx, f.userRepository, usr, f.code)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, grpcerr.E(codes.Unauthenticated, "invalid second factor code")
+	}
+
+	return usr, nil
+}
@@ -0,0 +1,303 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/model/modelmock"
+	"github.com/piotrkowalczuk/charon/internal/password/passwordmock"
+	"github.com/piotrkowalczuk/charon/internal/refreshtoken"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc/codes"
+)
+
+func TestRefreshTokenUserFinder_FindUser(t *testing.T) {
+	token, err := refreshtoken.Random()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	prefix := refreshtoken.Prefix(token)
+	userMock := &modelmock.UserProvider{}
+	refreshTokenMock := &modelmock.RefreshTokenProvider{}
+	transactorMock := &modelmock.Transactor{}
+	transactorMock.On("Transaction", mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+
+	found := func(ent model.RefreshTokenEntity) {
+		ent.UserID = 2
+		ent.Prefix = prefix
+		ent.Family = "family"
+		if ent.Secret == nil {
+			ent.Secret = refreshtoken.Hash(token)
+		}
+		refreshTokenMock.On("FindOneByPrefix", mock.Anything, prefix).Return(&ent, nil).Once()
+	}
+	owner := func(usr model.UserEntity) {
+		usr.ID = 2
+		userMock.On("FindOneByID", mock.Anything, int64(2)).Return(&usr, nil).Once()
+	}
+	reused := func() {
+		refreshTokenMock.On("RevokeFamily", mock.Anything, "family").Return([]string{prefix, "successor"}, nil).Once()
+	}
+
+	cases := map[string]struct {
+		token    string
+		rotation bool
+		init     func(*testing.T)
+		code     codes.Code
+		revoked  int
+	}{
+		"empty": {
+			code: codes.InvalidArgument,
+		},
+		"does-not-exists": {
+			token: token,
+			init: func(t *testing.T) {
+				refreshTokenMock.On("FindOneByPrefix", mock.Anything, prefix).Return(nil, sql.ErrNoRows).Once()
+			},
+			code: codes.Unauthenticated,
+		},
+		"wrong-secret": {
+			token: token,
+			init: func(t *testing.T) {
+				found(model.RefreshTokenEntity{Secret: refreshtoken.Hash("other")})
+			},
+			code: codes.Unauthenticated,
+		},
+		"revoked": {
+			token: token,
+			init: func(t *testing.T) {
+				found(model.RefreshTokenEntity{Revoked: true})
+			},
+			code: codes.Unauthenticated,
+		},
+		"expired": {
+			token: token,
+			init: func(t *testing.T) {
+				found(model.RefreshTokenEntity{ExpireAt: pq.NullTime{Time: time.Now().Add(-time.Minute), Valid: true}})
+			},
+			code: codes.Unauthenticated,
+		},
+		"rotated": {
+			token: token,
+			init: func(t *testing.T) {
+				found(model.RefreshTokenEntity{RotatedAt: pq.NullTime{Time: time.Now(), Valid: true}})
+				reused()
+			},
+			code:    codes.Unauthenticated,
+			revoked: 2,
+		},
+		"rotated-concurrently": {
+			token:    token,
+			rotation: true,
+			init: func(t *testing.T) {
+				found(model.RefreshTokenEntity{})
+				owner(model.UserEntity{IsActive: true, IsConfirmed: true})
+				refreshTokenMock.On("MarkRotated", mock.Anything, prefix).Return(int64(0), nil).Once()
+				reused()
+			},
+			code:    codes.Unauthenticated,
+			revoked: 2,
+		},
+		// Token is not used up for a user that cannot log in, rotation would leave the client without any token.
+		"inactive-with-rotation": {
+			token:    token,
+			rotation: true,
+			init: func(t *testing.T) {
+				found(model.RefreshTokenEntity{})
+				owner(model.UserEntity{IsConfirmed: true})
+			},
+			code: codes.Unauthenticated,
+		},
+		"not-confirmed-with-rotation": {
+			token:    token,
+			rotation: true,
+			init: func(t *testing.T) {
+				found(model.RefreshTokenEntity{})
+				owner(model.UserEntity{IsActive: true})
+			},
+			code: codes.Unauthenticated,
+		},
+		"locked-with-rotation": {
+			token:    token,
+			rotation: true,
+			init: func(t *testing.T) {
+				found(model.RefreshTokenEntity{})
+				owner(model.UserEntity{IsActive: true, IsConfirmed: true, LockedAt: pq.NullTime{Time: time.Now(), Valid: true}})
+			},
+			code: codes.Unauthenticated,
+		},
+		"deleted": {
+			token: token,
+			init: func(t *testing.T) {
+				found(model.RefreshTokenEntity{})
+				owner(model.UserEntity{IsActive: true, IsConfirmed: true, DeletedAt: pq.NullTime{Time: time.Now(), Valid: true}})
+			},
+			code: codes.Unauthenticated,
+		},
+		"valid": {
+			token: token,
+			init: func(t *testing.T) {
+				found(model.RefreshTokenEntity{ExpireAt: pq.NullTime{Time: time.Now().Add(time.Minute), Valid: true}})
+				refreshTokenMock.On("UpdateOneByPrefix", mock.Anything, prefix, mock.MatchedBy(func(patch *model.RefreshTokenPatch) bool {
+					return patch.LastUsedAt.Valid
+				})).Return(&model.RefreshTokenEntity{}, nil).Once()
+				owner(model.UserEntity{IsActive: true, IsConfirmed: true})
+			},
+		},
+		"valid-with-rotation": {
+			token:    token,
+			rotation: true,
+			init: func(t *testing.T) {
+				found(model.RefreshTokenEntity{})
+				refreshTokenMock.On("MarkRotated", mock.Anything, prefix).Return(int64(1), nil).Once()
+				refreshTokenMock.On("Create", mock.Anything, mock.MatchedBy(func(ent *model.RefreshTokenEntity) bool {
+					return ent.UserID == 2 && ent.Family == "family" && ent.Prefix != prefix
+				})).
+					Return(func(_ context.Context, ent *model.RefreshTokenEntity) *model.RefreshTokenEntity {
+						return ent
+					}, nil).
+					Once()
+				owner(model.UserEntity{IsActive: true, IsConfirmed: true})
+			},
+		},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			userMock.ExpectedCalls = []*mock.Call{}
+			refreshTokenMock.ExpectedCalls = []*mock.Call{}
+			if c.init != nil {
+				c.init(t)
+			}
+
+			finder := (&UserFinderFactory{
+				UserRepository:         userMock,
+				RefreshTokenRepository: refreshTokenMock,
+				Transactor:             transactorMock,
+				RefreshTokenRotation:   c.rotation,
+			}).ByRefreshToken(c.token)
+			usr, err := finder.FindUser(context.Background())
+			if c.code != codes.OK {
+				if e, ok := err.(*grpcerr.Error); !ok || e.Code != c.code {
+					t.Fatalf("expected %s error, got: %v", c.code, err)
+				}
+				if len(finder.Revoked()) != c.revoked {
+					t.Errorf("wrong number of revoked tokens: %d", len(finder.Revoked()))
+				}
+				mock.AssertExpectationsForObjects(t, userMock, refreshTokenMock)
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if usr.ID != 2 {
+				t.Errorf("wrong user: %d", usr.ID)
+			}
+			if c.rotation {
+				successor := finder.Successor()
+				if successor == "" || successor == token || finder.Prefix() != refreshtoken.Prefix(successor) {
+					t.Errorf("wrong successor: %s", successor)
+				}
+			} else if finder.Prefix() != prefix || finder.Successor() != "" {
+				t.Errorf("session should be bound with the token used")
+			}
+			mock.AssertExpectationsForObjects(t, userMock, refreshTokenMock)
+		})
+	}
+}
+
+func TestUserFinderFactory_ByUsernameAndPassword_rehash(t *testing.T) {
+	userMock := &modelmock.UserProvider{}
+	hasherMock := &passwordmock.Hasher{}
+	core, logs := observer.New(zap.ErrorLevel)
+	factory := &UserFinderFactory{
+		UserRepository: userMock,
+		Hasher:         hasherMock,
+		Logger:         zap.New(core),
+	}
+
+	cases := map[string]struct {
+		err      error
+		password string
+	}{
+		"rehashed": {
+			password: "new-hash",
+		},
+		// Password is right, so the user is authenticated anyway and the old hash is kept.
+		"rehash-failure": {
+			err:      errors.New("connection reset"),
+			password: "old-hash",
+		},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			userMock.ExpectedCalls = []*mock.Call{}
+			hasherMock.ExpectedCalls = []*mock.Call{}
+			logs.TakeAll()
+
+			userMock.On("FindOneByUsername", mock.Anything, "john").
+				Return(&model.UserEntity{ID: 1, Username: "john", Password: []byte("old-hash")}, nil).
+				Once()
+			hasherMock.On("Compare", []byte("old-hash"), []byte("secret")).Return(true).Once()
+			hasherMock.On("NeedsRehash", []byte("old-hash")).Return(true).Once()
+			hasherMock.On("Hash", []byte("secret")).Return([]byte("new-hash"), nil).Once()
+			userMock.On("ChangePassword", mock.Anything, int64(1), "new-hash").Return(c.err).Once()
+
+			usr, err := factory.ByUsernameAndPassword("john", "secret").FindUser(context.Background())
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if string(usr.Password) != c.password {
+				t.Errorf("wrong password hash, expected %s but got %s", c.password, usr.Password)
+			}
+			if failed := logs.Len() > 0; failed != (c.err != nil) {
+				t.Errorf("rehash failure should be logged if and only if it happens, got %d log entries", logs.Len())
+			}
+
+			mock.AssertExpectationsForObjects(t, userMock, hasherMock)
+		})
+	}
+}
+
+type externalAuthenticatorFunc func(context.Context, string, string) (bool, error)
+
+func (f externalAuthenticatorFunc) Authenticate(ctx context.Context, username, password string) (bool, error) {
+	return f(ctx, username, password)
+}
+
+func TestUserFinderFactory_ByUsernameAndPassword_noPassword(t *testing.T) {
+	userMock := &modelmock.UserProvider{}
+	hasherMock := &passwordmock.Hasher{}
+	factory := &UserFinderFactory{
+		UserRepository: userMock,
+		Hasher:         hasherMock,
+		// Federated user may share the username with somebody known to the external authenticator.
+		ExternalAuthenticator: externalAuthenticatorFunc(func(context.Context, string, string) (bool, error) {
+			t.Error("external authenticator should not be asked")
+			return true, nil
+		}),
+	}
+
+	userMock.On("FindOneByUsername", mock.Anything, "john").
+		Return(&model.UserEntity{ID: 1, Username: "john", Password: model.NoPassword, IsActive: true, IsConfirmed: true}, nil).
+		Once()
+
+	_, err := factory.ByUsernameAndPassword("john", "*").FindUser(context.Background())
+	if !grpcerr.Match(grpcerr.E(codes.Unauthenticated), err) {
+		t.Fatalf("wrong error, expected unauthenticated but got: %v", err)
+	}
+
+	mock.AssertExpectationsForObjects(t, userMock, hasherMock)
+}
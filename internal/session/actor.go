@@ -12,3 +12,8 @@ type Actor struct {
 	Permissions charon.Permissions
 	IsLocal     bool
 }
+
+// ByAPIKey reports whether the actor acts within a session started with an API key.
+func (a *Actor) ByAPIKey() bool {
+	return a.Session != nil && a.Session.Bag[BagAPIKey] != ""
+}
@@ -0,0 +1,225 @@
+package session
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/mnemosyne"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/metadata"
+)
+
+// CachingActorProvider decorates ActorProvider with a cache keyed by access token.
+// Entries are evicted once they expire, once the cache is full (least recently used first)
+// or explicitly, if actor's user, group membership or permissions change.
+// Sessions abandoned elsewhere are noticed once the entry expires, so TTL should be kept short.
+// Actors are copied on the way in and out, so callers are free to modify them.
+type CachingActorProvider struct {
+	next ActorProvider
+	ttl  time.Duration
+	size int
+	now  func() time.Time
+
+	lock sync.Mutex
+	// generation changes on every invalidation,
+	// so that actors retrieved in the meantime are not put into the cache.
+	generation uint64
+	entries    map[string]*list.Element
+	byUser     map[int64]map[string]struct{}
+	recency    *list.List
+
+	hits   prometheus.Counter
+	misses prometheus.Counter
+}
+
+type actorCacheEntry struct {
+	token    string
+	actor    *Actor
+	expireAt time.Time
+}
+
+// NewCachingActorProvider allocates new CachingActorProvider that keeps given number of actors for given period of time.
+func NewCachingActorProvider(next ActorProvider, ttl time.Duration, size int) *CachingActorProvider {
+	return &CachingActorProvider{
+		next:    next,
+		ttl:     ttl,
+		size:    size,
+		now:     time.Now,
+		entries: make(map[string]*list.Element),
+		byUser:  make(map[int64]map[string]struct{}),
+		recency: list.New(),
+		hits: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "charond",
+			Subsystem: "actor_cache",
+			Name:      "hits_total",
+			Help:      "Total number of actors retrieved from the cache.",
+		}),
+		misses: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "charond",
+			Subsystem: "actor_cache",
+			Name:      "misses_total",
+			Help:      "Total number of actors that had to be retrieved from the underlying provider.",
+		}),
+	}
+}
+
+// Actor implements ActorProvider interface.
+// Requests without an access token are passed through.
+func (p *CachingActorProvider) Actor(ctx context.Context) (*Actor, error) {
+	token := accessToken(ctx)
+	if token == "" {
+		return p.next.Actor(ctx)
+	}
+
+	act, generation, ok := p.get(token)
+	if ok {
+		p.hits.Inc()
+		return act, nil
+	}
+	p.misses.Inc()
+
+	act, err := p.next.Actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !act.IsLocal && act.User != nil {
+		p.put(token, copyActor(act), generation)
+	}
+
+	return act, nil
+}
+
+// Invalidate evicts all actors of given user.
+func (p *CachingActorProvider) Invalidate(userID int64) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.generation++
+	for token := range p.byUser[userID] {
+		p.remove(p.entries[token])
+	}
+}
+
+// Forget evicts actor associated with given access token.
+func (p *CachingActorProvider) Forget(token string) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.generation++
+	if elem, ok := p.entries[token]; ok {
+		p.remove(elem)
+	}
+}
+
+// Purge evicts all actors.
+func (p *CachingActorProvider) Purge() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.generation++
+	p.entries = make(map[string]*list.Element)
+	p.byUser = make(map[int64]map[string]struct{})
+	p.recency.Init()
+}
+
+// Len returns number of cached actors.
+func (p *CachingActorProvider) Len() int {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	return p.recency.Len()
+}
+
+// Describe implements prometheus.Collector interface.
+func (p *CachingActorProvider) Describe(in chan<- *prometheus.Desc) {
+	p.hits.Describe(in)
+	p.misses.Describe(in)
+}
+
+// Collect implements prometheus.Collector interface.
+func (p *CachingActorProvider) Collect(in chan<- prometheus.Metric) {
+	p.hits.Collect(in)
+	p.misses.Collect(in)
+}
+
+func (p *CachingActorProvider) get(token string) (*Actor, uint64, bool) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	elem, ok := p.entries[token]
+	if !ok {
+		return nil, p.generation, false
+	}
+	entry := elem.Value.(*actorCacheEntry)
+	if !p.now().Before(entry.expireAt) {
+		p.remove(elem)
+		return nil, p.generation, false
+	}
+	p.recency.MoveToFront(elem)
+
+	return copyActor(entry.actor), p.generation, true
+}
+
+// put stores actor, unless cache was invalidated since given generation.
+func (p *CachingActorProvider) put(token string, act *Actor, generation uint64) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.generation != generation {
+		return
+	}
+
+	if elem, ok := p.entries[token]; ok {
+		p.remove(elem)
+	}
+	for p.size > 0 && p.recency.Len() >= p.size {
+		p.remove(p.recency.Back())
+	}
+
+	p.entries[token] = p.recency.PushFront(&actorCacheEntry{
+		token:    token,
+		actor:    act,
+		expireAt: p.now().Add(p.ttl),
+	})
+	tokens, ok := p.byUser[act.User.ID]
+	if !ok {
+		tokens = make(map[string]struct{})
+		p.byUser[act.User.ID] = tokens
+	}
+	tokens[token] = struct{}{}
+}
+
+// remove expects lock to be held.
+func (p *CachingActorProvider) remove(elem *list.Element) {
+	entry := p.recency.Remove(elem).(*actorCacheEntry)
+	delete(p.entries, entry.token)
+
+	userID := entry.actor.User.ID
+	delete(p.byUser[userID], entry.token)
+	if len(p.byUser[userID]) == 0 {
+		delete(p.byUser, userID)
+	}
+}
+
+// copyActor copies actor deep enough for the copy to be modified without affecting the original.
+// Slices of the user entity are not copied, they are replaced rather than modified in place.
+func copyActor(act *Actor) *Actor {
+	cp := *act
+	usr := *act.User
+	cp.User = &usr
+	cp.Permissions = append(charon.Permissions(nil), act.Permissions...)
+	return &cp
+}
+
+// accessToken returns token that is passed to mnemosyne.
+func accessToken(ctx context.Context) string {
+	if md, ok := metadata.FromOutgoingContext(ctx); ok {
+		if v := md[mnemosyne.AccessTokenMetadataKey]; len(v) > 0 {
+			return v[0]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,149 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/mnemosyne"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+type actorProviderStub struct {
+	calls  int
+	actors map[string]*Actor
+	// before is called before actor is returned.
+	before func()
+}
+
+func (aps *actorProviderStub) Actor(ctx context.Context) (*Actor, error) {
+	aps.calls++
+	if aps.before != nil {
+		aps.before()
+	}
+	if act, ok := aps.actors[accessToken(ctx)]; ok {
+		return act, nil
+	}
+	return nil, errors.New("session not found")
+}
+
+func tokenContext(token string) context.Context {
+	return mnemosyne.NewAccessTokenContext(context.Background(), token)
+}
+
+func counterValue(t *testing.T, c prometheus.Counter) float64 {
+	var m dto.Metric
+	if err := c.Write(&m); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	return m.GetCounter().GetValue()
+}
+
+func TestCachingActorProvider_Actor(t *testing.T) {
+	next := &actorProviderStub{actors: map[string]*Actor{
+		"a": {User: &model.UserEntity{ID: 1}},
+		"b": {User: &model.UserEntity{ID: 1}},
+		"c": {User: &model.UserEntity{ID: 2}},
+	}}
+	now := time.Now()
+	cache := NewCachingActorProvider(next, time.Minute, 2)
+	cache.now = func() time.Time { return now }
+
+	get := func(token string) *Actor {
+		act, err := cache.Actor(tokenContext(token))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		return act
+	}
+	assertCalls := func(expected int) {
+		t.Helper()
+		if next.calls != expected {
+			t.Errorf("wrong number of underlying calls, expected %d but got %d", expected, next.calls)
+		}
+	}
+
+	get("a").User.FirstName = "modified"
+	if act := get("a"); act.User.FirstName != "" {
+		t.Error("modification of returned actor should not affect the cached one")
+	}
+	assertCalls(1)
+	if hits, misses := counterValue(t, cache.hits), counterValue(t, cache.misses); hits != 1 || misses != 1 {
+		t.Errorf("wrong metrics, got %v hits and %v misses", hits, misses)
+	}
+
+	// least recently used entry is evicted once cache is full
+	get("b")
+	get("a")
+	get("c")
+	assertCalls(3)
+	if cache.Len() != 2 {
+		t.Errorf("wrong cache length: %d", cache.Len())
+	}
+	get("b")
+	assertCalls(4)
+
+	// expired entry is not used
+	now = now.Add(time.Minute)
+	get("b")
+	assertCalls(5)
+
+	cache.Invalidate(1)
+	if cache.Len() != 1 {
+		t.Errorf("only actor of other user expected, got %d", cache.Len())
+	}
+	cache.Forget("c")
+	if cache.Len() != 0 {
+		t.Errorf("empty cache expected, got %d", cache.Len())
+	}
+
+	get("a")
+	get("c")
+	cache.Purge()
+	if cache.Len() != 0 {
+		t.Errorf("empty cache expected, got %d", cache.Len())
+	}
+
+	if _, err := cache.Actor(tokenContext("unknown")); err == nil {
+		t.Error("error expected")
+	}
+	if cache.Len() != 0 {
+		t.Error("failures should not be cached")
+	}
+}
+
+func TestCachingActorProvider_Actor_withoutToken(t *testing.T) {
+	next := &actorProviderStub{actors: map[string]*Actor{
+		"": {User: &model.UserEntity{}, IsLocal: true},
+	}}
+	cache := NewCachingActorProvider(next, time.Minute, 0)
+
+	for i := 0; i < 2; i++ {
+		if _, err := cache.Actor(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+	}
+	if next.calls != 2 || cache.Len() != 0 {
+		t.Errorf("request without a token should bypass the cache, got %d calls", next.calls)
+	}
+}
+
+func TestCachingActorProvider_Actor_invalidatedMeanwhile(t *testing.T) {
+	next := &actorProviderStub{actors: map[string]*Actor{
+		"a": {User: &model.UserEntity{ID: 1}},
+	}}
+	cache := NewCachingActorProvider(next, time.Minute, 0)
+	next.before = func() {
+		cache.Invalidate(1)
+	}
+
+	if _, err := cache.Actor(tokenContext("a")); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if cache.Len() != 0 {
+		t.Error("actor retrieved before invalidation should not be cached")
+	}
+}
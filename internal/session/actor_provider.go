@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"strings"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/piotrkowalczuk/charon"
@@ -20,10 +21,27 @@ type ActorProvider interface {
 	Actor(context.Context) (*Actor, error)
 }
 
+const (
+	// BagAPIKey is a session bag key under which prefix of the API key the session was started with is stored.
+	BagAPIKey = "api_key"
+	// BagRemoteAddr is a session bag key under which address of the peer that started the session is stored.
+	BagRemoteAddr = "remote_addr"
+	// BagLastActivity is a session bag key under which time of the most recent use of the session is stored, in RFC 3339 format.
+	BagLastActivity = "last_activity"
+	// BagScope is a session bag key under which scope consented to an OAuth client is stored.
+	// Session that carries it was issued to the client, it can only be used to retrieve userinfo.
+	BagScope = "scope"
+)
+
+// activityResolution is how often activity of a single session is recorded at most.
+const activityResolution = time.Minute
+
 type MnemosyneActorProvider struct {
-	Client             mnemosynerpc.SessionManagerClient
+	Client             Store
 	UserProvider       model.UserProvider
 	PermissionProvider model.PermissionProvider
+	// APIKeyProvider is required to serve sessions started with an API key.
+	APIKeyProvider model.APIKeyProvider
 }
 
 func (p *MnemosyneActorProvider) Actor(ctx context.Context) (*Actor, error) {
@@ -31,6 +49,7 @@ func (p *MnemosyneActorProvider) Actor(ctx context.Context) (*Actor, error) {
 		act      *Actor
 		userID   int64
 		entities []*model.PermissionEntity
+		denied   []*model.PermissionEntity
 		res      *mnemosynerpc.ContextResponse
 	)
 
@@ -45,12 +64,16 @@ func (p *MnemosyneActorProvider) Actor(ctx context.Context) (*Actor, error) {
 		return nil, handleMnemosyneError(err)
 	}
 
+	if res.Session.Bag[BagScope] != "" {
+		return nil, grpcerr.E(codes.PermissionDenied, "access token issued to an oauth client can only be used to retrieve userinfo")
+	}
+
 	userID, err = ActorID(res.Session.SubjectId).UserID()
 	if err != nil {
 		return nil, grpcerr.E(codes.InvalidArgument, err)
 	}
 
-	act = &Actor{}
+	act = &Actor{Session: res.Session}
 	act.User, err = p.UserProvider.FindOneByID(ctx, userID)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -58,22 +81,88 @@ func (p *MnemosyneActorProvider) Actor(ctx context.Context) (*Actor, error) {
 		}
 		return nil, grpcerr.E(codes.Internal, "actor fetch failure", err)
 	}
+	if act.User.DeletedAt.Valid {
+		return nil, grpcerr.E(codes.PermissionDenied, "actor does not exists")
+	}
+	// Inactive or locked user cannot log in, sessions started before are not usable either.
+	if !act.User.IsActive {
+		return nil, grpcerr.E(codes.PermissionDenied, "actor is not active")
+	}
+	if act.User.LockedAt.Valid {
+		return nil, grpcerr.E(codes.PermissionDenied, "actor is locked")
+	}
 	entities, err = p.PermissionProvider.FindByUserID(ctx, userID)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return act, nil
-		}
+	if err != nil && err != sql.ErrNoRows {
 		return nil, grpcerr.E(codes.Internal, "permissions fetch failure", err)
 	}
 
-	act.Permissions = make(charon.Permissions, 0, len(entities))
+	denied, err = p.PermissionProvider.FindDeniedByUserID(ctx, userID)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, grpcerr.E(codes.Internal, "denied permissions fetch failure", err)
+	}
+
+	// Denials are part of the set, so that charon.Permissions.Match can let them win over grants.
+	act.Permissions = make(charon.Permissions, 0, len(entities)+len(denied))
 	for _, e := range entities {
 		act.Permissions = append(act.Permissions, e.Permission())
 	}
+	for _, e := range denied {
+		act.Permissions = append(act.Permissions, e.Permission().Deny())
+	}
+
+	if prefix := res.Session.Bag[BagAPIKey]; prefix != "" {
+		allowed, err := apiKeyPermissions(ctx, p.APIKeyProvider, userID, prefix)
+		if err != nil {
+			return nil, err
+		}
+		// Superuser acting through an API key is as powerful as the key, nothing more.
+		usr := *act.User
+		if usr.IsSuperuser {
+			usr.IsSuperuser = false
+			act.Permissions = allowed
+		} else {
+			act.Permissions = act.Permissions.Restrict(allowed)
+		}
+		act.User = &usr
+	}
+
+	p.touch(ctx, res.Session)
 
 	return act, nil
 }
 
+// touch records activity of the session, unless it was recorded recently.
+// It is best effort, session that cannot be modified is still a valid one.
+func (p *MnemosyneActorProvider) touch(ctx context.Context, ses *mnemosynerpc.Session) {
+	now := time.Now()
+	if last, err := time.Parse(time.RFC3339, ses.Bag[BagLastActivity]); err == nil && now.Sub(last) < activityResolution {
+		return
+	}
+	_, _ = p.Client.SetValue(ctx, &mnemosynerpc.SetValueRequest{
+		AccessToken: ses.AccessToken,
+		Key:         BagLastActivity,
+		Value:       now.UTC().Format(time.RFC3339),
+	})
+}
+
+// apiKeyPermissions returns permissions of the API key with given prefix, if it is still usable by given user.
+func apiKeyPermissions(ctx context.Context, provider model.APIKeyProvider, userID int64, prefix string) (charon.Permissions, *grpcerr.Error) {
+	if provider == nil {
+		return nil, grpcerr.E(codes.Unauthenticated, "api key sessions are not supported")
+	}
+	key, err := provider.FindOneByPrefix(ctx, prefix)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, grpcerr.E(codes.Unauthenticated, "api key no longer exists")
+		}
+		return nil, grpcerr.E(codes.Internal, "api key fetch failure", err)
+	}
+	if key.UserID != userID || !key.IsUsable(time.Now()) {
+		return nil, grpcerr.E(codes.Unauthenticated, "api key is revoked or expired")
+	}
+	return key.AllowedPermissions(), nil
+}
+
 func isLocal(ctx context.Context) bool {
 	if p, ok := peer.FromContext(ctx); ok {
 		if strings.HasPrefix(p.Addr.String(), "127.0.0.1") {
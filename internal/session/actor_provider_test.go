@@ -0,0 +1,90 @@
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/piotrkowalczuk/charon/internal/grpcerr"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/model/modelmock"
+	"github.com/piotrkowalczuk/mnemosyne/mnemosynerpc"
+	"github.com/piotrkowalczuk/mnemosyne/mnemosynetest"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+)
+
+func TestMnemosyneActorProvider_Actor(t *testing.T) {
+	sessionMock := &mnemosynetest.SessionManagerClient{}
+	userProviderMock := &modelmock.UserProvider{}
+	permissionProviderMock := &modelmock.PermissionProvider{}
+	p := &MnemosyneActorProvider{
+		Client:             sessionMock,
+		UserProvider:       userProviderMock,
+		PermissionProvider: permissionProviderMock,
+	}
+	now := time.Now()
+
+	cases := map[string]struct {
+		user model.UserEntity
+		err  error
+	}{
+		"active": {
+			user: model.UserEntity{ID: 1, IsActive: true},
+		},
+		"inactive": {
+			user: model.UserEntity{ID: 1},
+			err:  grpcerr.E(codes.PermissionDenied),
+		},
+		"locked": {
+			user: model.UserEntity{ID: 1, IsActive: true, LockedAt: pq.NullTime{Time: now, Valid: true}},
+			err:  grpcerr.E(codes.PermissionDenied),
+		},
+		"deleted": {
+			user: model.UserEntity{ID: 1, IsActive: true, DeletedAt: pq.NullTime{Time: now, Valid: true}},
+			err:  grpcerr.E(codes.PermissionDenied),
+		},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			sessionMock.ExpectedCalls = []*mock.Call{}
+			userProviderMock.ExpectedCalls = []*mock.Call{}
+			permissionProviderMock.ExpectedCalls = []*mock.Call{}
+
+			sessionMock.On("Context", mock.Anything, mock.Anything).
+				Return(&mnemosynerpc.ContextResponse{Session: &mnemosynerpc.Session{
+					AccessToken: "token",
+					SubjectId:   ActorIDFromInt64(1).String(),
+					Bag:         map[string]string{BagLastActivity: now.UTC().Format(time.RFC3339)},
+				}}, nil).
+				Once()
+			usr := c.user
+			userProviderMock.On("FindOneByID", mock.Anything, int64(1)).Return(&usr, nil).Once()
+			if c.err == nil {
+				permissionProviderMock.On("FindByUserID", mock.Anything, int64(1)).Return(nil, nil).Once()
+				permissionProviderMock.On("FindDeniedByUserID", mock.Anything, int64(1)).Return(nil, nil).Once()
+			}
+
+			act, err := p.Actor(context.Background())
+			if c.err != nil {
+				if !grpcerr.Match(c.err, err) {
+					t.Fatalf("errors do not match, got '%v'", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if act.User.ID != 1 {
+				t.Errorf("wrong actor: %d", act.User.ID)
+			}
+			if act.Session == nil || act.Session.AccessToken != "token" {
+				t.Errorf("actor should carry its session, got: %v", act.Session)
+			}
+
+			mock.AssertExpectationsForObjects(t, sessionMock, userProviderMock, permissionProviderMock)
+		})
+	}
+}
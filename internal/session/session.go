@@ -1,6 +1,8 @@
 package session
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"strconv"
@@ -34,3 +36,9 @@ func (ai ActorID) UserID() (int64, error) {
 
 	return strconv.ParseInt(string(ai)[12:], 10, 64)
 }
+
+// ID returns identifier of a session that does not reveal its access token.
+func ID(accessToken string) string {
+	sum := sha256.Sum256([]byte(accessToken))
+	return hex.EncodeToString(sum[:16])
+}
@@ -1,6 +1,7 @@
 package session_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/piotrkowalczuk/charon/internal/session"
@@ -45,3 +46,16 @@ func TestUserIDFromSessionActorID(t *testing.T) {
 		}
 	}
 }
+
+func TestID(t *testing.T) {
+	id := session.ID("token")
+	if len(id) != 32 {
+		t.Errorf("wrong length: %d", len(id))
+	}
+	if id != session.ID("token") {
+		t.Error("id should be deterministic")
+	}
+	if id == session.ID("other") || strings.Contains(id, "token") {
+		t.Errorf("id should identify and not reveal the access token: %s", id)
+	}
+}
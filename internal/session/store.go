@@ -0,0 +1,292 @@
+package session
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/mnemosyne/mnemosynerpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// StoreMnemosyne keeps sessions in mnemosyne daemon.
+	StoreMnemosyne = "mnemosyne"
+	// StorePostgres keeps sessions in the same database as the rest of the data.
+	StorePostgres = "postgres"
+)
+
+// Store is a session storage.
+// Method set is a subset of mnemosynerpc.SessionManagerClient, so that the client can be used directly,
+// other implementations follow its semantics, including status codes of returned errors.
+type Store interface {
+	// Context retrieves session for access token carried by the outgoing context metadata.
+	Context(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*mnemosynerpc.ContextResponse, error)
+	// Get retrieves session for given access token.
+	Get(ctx context.Context, in *mnemosynerpc.GetRequest, opts ...grpc.CallOption) (*mnemosynerpc.GetResponse, error)
+	// List retrieves sessions page by page, there is no way to narrow them down to a single subject, see SubjectLister.
+	List(ctx context.Context, in *mnemosynerpc.ListRequest, opts ...grpc.CallOption) (*mnemosynerpc.ListResponse, error)
+	// Start creates new session, access token is generated.
+	Start(ctx context.Context, in *mnemosynerpc.StartRequest, opts ...grpc.CallOption) (*mnemosynerpc.StartResponse, error)
+	// Abandon removes session for given access token.
+	Abandon(ctx context.Context, in *mnemosynerpc.AbandonRequest, opts ...grpc.CallOption) (*wrappers.BoolValue, error)
+	// SetValue sets single bag value of a session.
+	SetValue(ctx context.Context, in *mnemosynerpc.SetValueRequest, opts ...grpc.CallOption) (*mnemosynerpc.SetValueResponse, error)
+	// Delete removes all sessions that match given criteria.
+	Delete(ctx context.Context, in *mnemosynerpc.DeleteRequest, opts ...grpc.CallOption) (*wrappers.Int64Value, error)
+}
+
+// SubjectLister is implemented by stores that can narrow sessions down to a single subject.
+// Mnemosyne client does not, sessions kept by the daemon have to be visited page by page.
+type SubjectLister interface {
+	// ListBySubject retrieves all sessions of given subject.
+	ListBySubject(ctx context.Context, subjectID string) ([]*mnemosynerpc.Session, error)
+}
+
+// PostgresStore is a built-in Store, it makes mnemosyne daemon unnecessary.
+type PostgresStore struct {
+	Repository model.SessionProvider
+	// TTL is a period of time a session is valid for.
+	TTL time.Duration
+}
+
+// Context implements Store interface.
+func (ps *PostgresStore) Context(ctx context.Context, _ *empty.Empty, _ ...grpc.CallOption) (*mnemosynerpc.ContextResponse, error) {
+	token := accessToken(ctx)
+	if token == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing access token")
+	}
+
+	ses, err := ps.get(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	return &mnemosynerpc.ContextResponse{Session: ses}, nil
+}
+
+// Get implements Store interface.
+func (ps *PostgresStore) Get(ctx context.Context, in *mnemosynerpc.GetRequest, _ ...grpc.CallOption) (*mnemosynerpc.GetResponse, error) {
+	if in.AccessToken == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing access token")
+	}
+
+	ses, err := ps.get(ctx, in.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	return &mnemosynerpc.GetResponse{Session: ses}, nil
+}
+
+// List implements Store interface.
+func (ps *PostgresStore) List(ctx context.Context, in *mnemosynerpc.ListRequest, _ ...grpc.CallOption) (*mnemosynerpc.ListResponse, error) {
+	if in.Limit < 0 || in.Offset < 0 {
+		return nil, status.Error(codes.InvalidArgument, "offset and limit cannot be negative")
+	}
+	expr := &model.SessionListExpr{Offset: in.Offset, Limit: in.Limit}
+	if in.ExpireAtFrom != nil {
+		from, err := ptypes.Timestamp(in.ExpireAtFrom)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid expire at from: %s", err.Error())
+		}
+		expr.ExpireAtFrom = from
+	}
+	if in.ExpireAtTo != nil {
+		to, err := ptypes.Timestamp(in.ExpireAtTo)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid expire at to: %s", err.Error())
+		}
+		expr.ExpireAtTo = to
+	}
+
+	sessions, err := ps.list(ctx, expr)
+	if err != nil {
+		return nil, err
+	}
+	return &mnemosynerpc.ListResponse{Sessions: sessions}, nil
+}
+
+// ListBySubject implements SubjectLister interface.
+func (ps *PostgresStore) ListBySubject(ctx context.Context, subjectID string) ([]*mnemosynerpc.Session, error) {
+	if subjectID == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing subject id")
+	}
+	return ps.list(ctx, &model.SessionListExpr{SubjectID: subjectID})
+}
+
+func (ps *PostgresStore) list(ctx context.Context, expr *model.SessionListExpr) ([]*mnemosynerpc.Session, error) {
+	ents, err := ps.Repository.List(ctx, expr)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "sessions fetch failure: %s", err.Error())
+	}
+	sessions := make([]*mnemosynerpc.Session, 0, len(ents))
+	for _, ent := range ents {
+		ses, err := reverseSession(ent)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, ses)
+	}
+	return sessions, nil
+}
+
+// Start implements Store interface.
+// Expired sessions are removed along the way.
+func (ps *PostgresStore) Start(ctx context.Context, in *mnemosynerpc.StartRequest, _ ...grpc.CallOption) (*mnemosynerpc.StartResponse, error) {
+	if in.Session == nil || in.Session.SubjectId == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing subject id")
+	}
+
+	token, err := newAccessToken()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "access token generation failure: %s", err.Error())
+	}
+	var bag []byte
+	if len(in.Session.Bag) > 0 {
+		if bag, err = json.Marshal(in.Session.Bag); err != nil {
+			return nil, status.Errorf(codes.Internal, "session bag encoding failure: %s", err.Error())
+		}
+	}
+
+	if _, err = ps.Repository.Delete(ctx, &model.SessionDeleteExpr{ExpireAtTo: time.Now()}); err != nil {
+		return nil, status.Errorf(codes.Internal, "expired sessions removal failure: %s", err.Error())
+	}
+	ent, err := ps.Repository.Insert(ctx, &model.SessionEntity{
+		AccessToken:   token,
+		SubjectID:     in.Session.SubjectId,
+		SubjectClient: in.Session.SubjectClient,
+		RefreshToken:  in.Session.RefreshToken,
+		Bag:           bag,
+		ExpireAt:      time.Now().Add(ps.TTL),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "session insert failure: %s", err.Error())
+	}
+
+	ses, err := reverseSession(ent)
+	if err != nil {
+		return nil, err
+	}
+	return &mnemosynerpc.StartResponse{Session: ses}, nil
+}
+
+// Abandon implements Store interface.
+func (ps *PostgresStore) Abandon(ctx context.Context, in *mnemosynerpc.AbandonRequest, _ ...grpc.CallOption) (*wrappers.BoolValue, error) {
+	if in.AccessToken == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing access token")
+	}
+
+	affected, err := ps.Repository.Delete(ctx, &model.SessionDeleteExpr{AccessToken: in.AccessToken})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "session removal failure: %s", err.Error())
+	}
+	if affected == 0 {
+		return nil, status.Error(codes.NotFound, "session not found")
+	}
+	return &wrappers.BoolValue{Value: true}, nil
+}
+
+// SetValue implements Store interface.
+func (ps *PostgresStore) SetValue(ctx context.Context, in *mnemosynerpc.SetValueRequest, _ ...grpc.CallOption) (*mnemosynerpc.SetValueResponse, error) {
+	switch {
+	case in.AccessToken == "":
+		return nil, status.Error(codes.InvalidArgument, "missing access token")
+	case in.Key == "":
+		return nil, status.Error(codes.InvalidArgument, "missing key")
+	}
+
+	raw, err := ps.Repository.SetBagValue(ctx, in.AccessToken, in.Key, in.Value)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "session not found")
+		}
+		return nil, status.Errorf(codes.Internal, "session bag update failure: %s", err.Error())
+	}
+	var bag map[string]string
+	if err = json.Unmarshal(raw, &bag); err != nil {
+		return nil, status.Errorf(codes.Internal, "session bag decoding failure: %s", err.Error())
+	}
+	return &mnemosynerpc.SetValueResponse{Bag: bag}, nil
+}
+
+// Delete implements Store interface.
+func (ps *PostgresStore) Delete(ctx context.Context, in *mnemosynerpc.DeleteRequest, _ ...grpc.CallOption) (*wrappers.Int64Value, error) {
+	expr := &model.SessionDeleteExpr{
+		AccessToken:  in.AccessToken,
+		SubjectID:    in.SubjectId,
+		RefreshToken: in.RefreshToken,
+	}
+	if in.ExpireAtFrom != nil {
+		from, err := ptypes.Timestamp(in.ExpireAtFrom)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid expire at from: %s", err.Error())
+		}
+		expr.ExpireAtFrom = from
+	}
+	if in.ExpireAtTo != nil {
+		to, err := ptypes.Timestamp(in.ExpireAtTo)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid expire at to: %s", err.Error())
+		}
+		expr.ExpireAtTo = to
+	}
+	if *expr == (model.SessionDeleteExpr{}) {
+		return nil, status.Error(codes.InvalidArgument, "none of expected arguments was provided")
+	}
+
+	affected, err := ps.Repository.Delete(ctx, expr)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "sessions removal failure: %s", err.Error())
+	}
+	return &wrappers.Int64Value{Value: affected}, nil
+}
+
+func (ps *PostgresStore) get(ctx context.Context, token string) (*mnemosynerpc.Session, error) {
+	ent, err := ps.Repository.FindOneByAccessToken(ctx, token)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "session not found")
+		}
+		return nil, status.Errorf(codes.Internal, "session fetch failure: %s", err.Error())
+	}
+	return reverseSession(ent)
+}
+
+func reverseSession(ent *model.SessionEntity) (*mnemosynerpc.Session, error) {
+	expireAt, err := ptypes.TimestampProto(ent.ExpireAt)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "invalid expire at: %s", err.Error())
+	}
+	var bag map[string]string
+	if len(ent.Bag) > 0 {
+		if err = json.Unmarshal(ent.Bag, &bag); err != nil {
+			return nil, status.Errorf(codes.Internal, "session bag decoding failure: %s", err.Error())
+		}
+	}
+
+	return &mnemosynerpc.Session{
+		AccessToken:   ent.AccessToken,
+		SubjectId:     ent.SubjectID,
+		SubjectClient: ent.SubjectClient,
+		RefreshToken:  ent.RefreshToken,
+		Bag:           bag,
+		ExpireAt:      expireAt,
+	}, nil
+}
+
+func newAccessToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
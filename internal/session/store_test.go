@@ -0,0 +1,211 @@
+package session_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/model/modelmock"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	"github.com/piotrkowalczuk/mnemosyne"
+	"github.com/piotrkowalczuk/mnemosyne/mnemosynerpc"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PostgresStore has to be interchangeable with mnemosyne client.
+var (
+	_ session.Store = &session.PostgresStore{}
+	_ session.Store = mnemosynerpc.NewSessionManagerClient(nil)
+
+	_ session.SubjectLister = &session.PostgresStore{}
+)
+
+func TestPostgresStore_Start(t *testing.T) {
+	repositoryMock := &modelmock.SessionProvider{}
+	store := &session.PostgresStore{Repository: repositoryMock, TTL: time.Hour}
+
+	repositoryMock.On("Delete", mock.Anything, mock.MatchedBy(func(expr *model.SessionDeleteExpr) bool {
+		return !expr.ExpireAtTo.IsZero() && expr.SubjectID == ""
+	})).Return(int64(0), nil).Once()
+	repositoryMock.On("Insert", mock.Anything, mock.MatchedBy(func(ent *model.SessionEntity) bool {
+		return len(ent.AccessToken) == 64 &&
+			ent.SubjectID == "charon:user:1" &&
+			ent.RefreshToken == "rt" &&
+			string(ent.Bag) == `{"username":"john"}` &&
+			time.Until(ent.ExpireAt) > 59*time.Minute
+	})).Return(func(_ context.Context, ent *model.SessionEntity) *model.SessionEntity {
+		return ent
+	}, nil).Once()
+
+	res, err := store.Start(context.TODO(), &mnemosynerpc.StartRequest{
+		Session: &mnemosynerpc.Session{
+			SubjectId:    "charon:user:1",
+			RefreshToken: "rt",
+			Bag:          map[string]string{"username": "john"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if res.Session.AccessToken == "" || res.Session.Bag["username"] != "john" || res.Session.ExpireAt == nil {
+		t.Errorf("unexpected session: %v", res.Session)
+	}
+
+	if _, err = store.Start(context.TODO(), &mnemosynerpc.StartRequest{Session: &mnemosynerpc.Session{}}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected invalid argument, got: %v", err)
+	}
+
+	mock.AssertExpectationsForObjects(t, repositoryMock)
+}
+
+func TestPostgresStore_Context(t *testing.T) {
+	repositoryMock := &modelmock.SessionProvider{}
+	store := &session.PostgresStore{Repository: repositoryMock, TTL: time.Hour}
+
+	repositoryMock.On("FindOneByAccessToken", mock.Anything, "found").
+		Return(&model.SessionEntity{AccessToken: "found", SubjectID: "charon:user:1", ExpireAt: time.Now()}, nil).
+		Once()
+	repositoryMock.On("FindOneByAccessToken", mock.Anything, "missing").
+		Return(nil, sql.ErrNoRows).
+		Once()
+
+	res, err := store.Context(mnemosyne.NewAccessTokenContext(context.TODO(), "found"), &empty.Empty{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if res.Session.SubjectId != "charon:user:1" {
+		t.Errorf("wrong subject id: %s", res.Session.SubjectId)
+	}
+	if _, err = store.Context(mnemosyne.NewAccessTokenContext(context.TODO(), "missing"), &empty.Empty{}); status.Code(err) != codes.NotFound {
+		t.Errorf("expected not found, got: %v", err)
+	}
+	if _, err = store.Context(context.TODO(), &empty.Empty{}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected invalid argument, got: %v", err)
+	}
+
+	mock.AssertExpectationsForObjects(t, repositoryMock)
+}
+
+func TestPostgresStore_Abandon(t *testing.T) {
+	repositoryMock := &modelmock.SessionProvider{}
+	store := &session.PostgresStore{Repository: repositoryMock, TTL: time.Hour}
+
+	repositoryMock.On("Delete", mock.Anything, &model.SessionDeleteExpr{AccessToken: "found"}).Return(int64(1), nil).Once()
+	repositoryMock.On("Delete", mock.Anything, &model.SessionDeleteExpr{AccessToken: "missing"}).Return(int64(0), nil).Once()
+
+	if res, err := store.Abandon(context.TODO(), &mnemosynerpc.AbandonRequest{AccessToken: "found"}); err != nil || !res.Value {
+		t.Errorf("unexpected result: %v, %v", res, err)
+	}
+	if _, err := store.Abandon(context.TODO(), &mnemosynerpc.AbandonRequest{AccessToken: "missing"}); status.Code(err) != codes.NotFound {
+		t.Errorf("expected not found, got: %v", err)
+	}
+
+	mock.AssertExpectationsForObjects(t, repositoryMock)
+}
+
+func TestPostgresStore_Delete(t *testing.T) {
+	repositoryMock := &modelmock.SessionProvider{}
+	store := &session.PostgresStore{Repository: repositoryMock, TTL: time.Hour}
+
+	repositoryMock.On("Delete", mock.Anything, &model.SessionDeleteExpr{SubjectID: "charon:user:1", RefreshToken: "rt"}).
+		Return(int64(2), nil).
+		Once()
+
+	res, err := store.Delete(context.TODO(), &mnemosynerpc.DeleteRequest{SubjectId: "charon:user:1", RefreshToken: "rt"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if res.Value != 2 {
+		t.Errorf("wrong number of removed sessions: %d", res.Value)
+	}
+	if _, err = store.Delete(context.TODO(), &mnemosynerpc.DeleteRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected invalid argument, got: %v", err)
+	}
+
+	mock.AssertExpectationsForObjects(t, repositoryMock)
+}
+
+func TestPostgresStore_List(t *testing.T) {
+	repositoryMock := &modelmock.SessionProvider{}
+	store := &session.PostgresStore{Repository: repositoryMock, TTL: time.Hour}
+
+	repositoryMock.On("List", mock.Anything, &model.SessionListExpr{Offset: 10, Limit: 5}).
+		Return([]*model.SessionEntity{
+			{AccessToken: "a", SubjectID: "charon:user:1", Bag: []byte(`{"remote_addr":"10.0.0.1"}`), ExpireAt: time.Now()},
+			{AccessToken: "b", SubjectID: "charon:user:2", ExpireAt: time.Now()},
+		}, nil).
+		Once()
+
+	res, err := store.List(context.TODO(), &mnemosynerpc.ListRequest{Offset: 10, Limit: 5})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(res.Sessions) != 2 {
+		t.Fatalf("wrong number of sessions: %d", len(res.Sessions))
+	}
+	if res.Sessions[0].Bag[session.BagRemoteAddr] != "10.0.0.1" || res.Sessions[1].SubjectId != "charon:user:2" {
+		t.Errorf("unexpected sessions: %v", res.Sessions)
+	}
+	if _, err = store.List(context.TODO(), &mnemosynerpc.ListRequest{Limit: -1}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected invalid argument, got: %v", err)
+	}
+
+	mock.AssertExpectationsForObjects(t, repositoryMock)
+}
+
+func TestPostgresStore_ListBySubject(t *testing.T) {
+	repositoryMock := &modelmock.SessionProvider{}
+	store := &session.PostgresStore{Repository: repositoryMock, TTL: time.Hour}
+
+	repositoryMock.On("List", mock.Anything, &model.SessionListExpr{SubjectID: "charon:user:1"}).
+		Return([]*model.SessionEntity{
+			{AccessToken: "a", SubjectID: "charon:user:1", ExpireAt: time.Now()},
+		}, nil).
+		Once()
+
+	sessions, err := store.ListBySubject(context.TODO(), "charon:user:1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(sessions) != 1 || sessions[0].AccessToken != "a" {
+		t.Errorf("unexpected sessions: %v", sessions)
+	}
+	if _, err = store.ListBySubject(context.TODO(), ""); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected invalid argument, got: %v", err)
+	}
+
+	mock.AssertExpectationsForObjects(t, repositoryMock)
+}
+
+func TestPostgresStore_SetValue(t *testing.T) {
+	repositoryMock := &modelmock.SessionProvider{}
+	store := &session.PostgresStore{Repository: repositoryMock, TTL: time.Hour}
+
+	repositoryMock.On("SetBagValue", mock.Anything, "found", "key", "value").
+		Return([]byte(`{"key":"value","username":"john"}`), nil).
+		Once()
+	repositoryMock.On("SetBagValue", mock.Anything, "missing", "key", "value").
+		Return(nil, sql.ErrNoRows).
+		Once()
+
+	res, err := store.SetValue(context.TODO(), &mnemosynerpc.SetValueRequest{AccessToken: "found", Key: "key", Value: "value"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if res.Bag["key"] != "value" || res.Bag["username"] != "john" {
+		t.Errorf("unexpected bag: %v", res.Bag)
+	}
+	if _, err = store.SetValue(context.TODO(), &mnemosynerpc.SetValueRequest{AccessToken: "missing", Key: "key", Value: "value"}); status.Code(err) != codes.NotFound {
+		t.Errorf("expected not found, got: %v", err)
+	}
+	if _, err = store.SetValue(context.TODO(), &mnemosynerpc.SetValueRequest{AccessToken: "found"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected invalid argument, got: %v", err)
+	}
+
+	mock.AssertExpectationsForObjects(t, repositoryMock)
+}
@@ -0,0 +1,199 @@
+package session
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/pkg/jwt"
+	"github.com/piotrkowalczuk/mnemosyne/mnemosynerpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TokenKeys provides keys TokenStore signs and verifies tokens with.
+type TokenKeys interface {
+	jwt.KeySource
+	// Signer returns currently active signing key.
+	Signer() *jwt.Signer
+}
+
+// TokenStore is a Store that issues signed JWTs instead of opaque access tokens.
+// Tokens are self-contained, they are not persisted, cannot be abandoned and expire on their own.
+// Opaque tokens are still handled by the underlying store, so that sessions started before are not lost.
+type TokenStore struct {
+	Store
+	Keys               TokenKeys
+	PermissionProvider model.PermissionProvider
+	Issuer             string
+	// TTL is a period of time a token is valid for, it should be short.
+	TTL time.Duration
+	// APIKeyProvider is required to issue tokens for sessions started with an API key.
+	APIKeyProvider model.APIKeyProvider
+}
+
+// Context implements Store interface.
+func (ts *TokenStore) Context(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*mnemosynerpc.ContextResponse, error) {
+	token := accessToken(ctx)
+	if !jwt.LooksLike(token) {
+		return ts.Store.Context(ctx, in, opts...)
+	}
+
+	ses, err := ts.verify(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	return &mnemosynerpc.ContextResponse{Session: ses}, nil
+}
+
+// Get implements Store interface.
+func (ts *TokenStore) Get(ctx context.Context, in *mnemosynerpc.GetRequest, opts ...grpc.CallOption) (*mnemosynerpc.GetResponse, error) {
+	if !jwt.LooksLike(in.AccessToken) {
+		return ts.Store.Get(ctx, in, opts...)
+	}
+
+	ses, err := ts.verify(ctx, in.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	return &mnemosynerpc.GetResponse{Session: ses}, nil
+}
+
+// Start implements Store interface.
+// Token carries permissions effective at the time it is issued.
+func (ts *TokenStore) Start(ctx context.Context, in *mnemosynerpc.StartRequest, _ ...grpc.CallOption) (*mnemosynerpc.StartResponse, error) {
+	if in.Session == nil || in.Session.SubjectId == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing subject id")
+	}
+	userID, err := ActorID(in.Session.SubjectId).UserID()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid subject id: %s", err.Error())
+	}
+
+	var (
+		permissions []string
+		audience    jwt.Audience
+		apiKey      string
+		scope       = in.Session.Bag[BagScope]
+	)
+	// Token issued to an OAuth client is meant for the client only and grants no permissions,
+	// services that validate tokens locally would otherwise let the client act as the user.
+	if scope != "" {
+		audience = jwt.Audience{in.Session.SubjectClient}
+	} else {
+		if permissions, err = ts.permissions(ctx, userID); err != nil {
+			return nil, err
+		}
+		if apiKey = in.Session.Bag[BagAPIKey]; apiKey != "" {
+			allowed, err := apiKeyPermissions(ctx, ts.APIKeyProvider, userID, apiKey)
+			if err != nil {
+				return nil, status.Error(err.Code, err.Msg)
+			}
+			permissions = charon.NewPermissions(permissions...).Restrict(allowed).Strings()
+		}
+	}
+	id, err := newAccessToken()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "token id generation failure: %s", err.Error())
+	}
+
+	now := time.Now()
+	claims := &jwt.Claims{
+		Issuer:      ts.Issuer,
+		Subject:     in.Session.SubjectId,
+		IssuedAt:    now.Unix(),
+		ExpiresAt:   now.Add(ts.TTL).Unix(),
+		ID:          id,
+		UserID:      userID,
+		Username:    in.Session.Bag["username"],
+		Client:      in.Session.SubjectClient,
+		Permissions: permissions,
+		Scope:       scope,
+		APIKey:      apiKey,
+		Audience:    audience,
+	}
+	token, err := ts.Keys.Signer().Sign(claims)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "token signing failure: %s", err.Error())
+	}
+	expireAt, err := ptypes.TimestampProto(claims.ExpireAt())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "invalid expire at: %s", err.Error())
+	}
+
+	return &mnemosynerpc.StartResponse{Session: &mnemosynerpc.Session{
+		AccessToken:   token,
+		SubjectId:     in.Session.SubjectId,
+		SubjectClient: in.Session.SubjectClient,
+		RefreshToken:  in.Session.RefreshToken,
+		Bag:           in.Session.Bag,
+		ExpireAt:      expireAt,
+	}}, nil
+}
+
+// Abandon implements Store interface.
+func (ts *TokenStore) Abandon(ctx context.Context, in *mnemosynerpc.AbandonRequest, opts ...grpc.CallOption) (*wrappers.BoolValue, error) {
+	if jwt.LooksLike(in.AccessToken) {
+		return nil, status.Error(codes.FailedPrecondition, "self-contained access token cannot be abandoned, it expires on its own")
+	}
+	return ts.Store.Abandon(ctx, in, opts...)
+}
+
+// SetValue implements Store interface.
+func (ts *TokenStore) SetValue(ctx context.Context, in *mnemosynerpc.SetValueRequest, opts ...grpc.CallOption) (*mnemosynerpc.SetValueResponse, error) {
+	if jwt.LooksLike(in.AccessToken) {
+		return nil, status.Error(codes.FailedPrecondition, "self-contained access token cannot be modified")
+	}
+	return ts.Store.SetValue(ctx, in, opts...)
+}
+
+func (ts *TokenStore) verify(ctx context.Context, token string) (*mnemosynerpc.Session, error) {
+	// Tokens of OAuth clients are accepted, so that userinfo can be served, actor provider refuses them otherwise.
+	claims, err := (&jwt.Verifier{Keys: ts.Keys, Issuer: ts.Issuer, Scoped: true}).Verify(ctx, token)
+	if err != nil {
+		// Invalid token is reported the same way as a session that does not exist.
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	expireAt, err := ptypes.TimestampProto(claims.ExpireAt())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "invalid expire at: %s", err.Error())
+	}
+
+	return &mnemosynerpc.Session{
+		AccessToken:   token,
+		SubjectId:     claims.Subject,
+		SubjectClient: claims.Client,
+		Bag: map[string]string{
+			"username": claims.Username,
+			BagScope:   claims.Scope,
+			BagAPIKey:  claims.APIKey,
+		},
+		ExpireAt: expireAt,
+	}, nil
+}
+
+func (ts *TokenStore) permissions(ctx context.Context, userID int64) ([]string, error) {
+	granted, err := ts.PermissionProvider.FindByUserID(ctx, userID)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, status.Errorf(codes.Internal, "permissions fetch failure: %s", err.Error())
+	}
+	denied, err := ts.PermissionProvider.FindDeniedByUserID(ctx, userID)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, status.Errorf(codes.Internal, "denied permissions fetch failure: %s", err.Error())
+	}
+
+	permissions := make([]string, 0, len(granted)+len(denied))
+	for _, e := range granted {
+		permissions = append(permissions, e.Permission().String())
+	}
+	for _, e := range denied {
+		permissions = append(permissions, e.Permission().Deny().String())
+	}
+	return permissions, nil
+}
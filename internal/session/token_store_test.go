@@ -0,0 +1,271 @@
+package session_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/piotrkowalczuk/charon"
+	"github.com/piotrkowalczuk/charon/internal/model"
+	"github.com/piotrkowalczuk/charon/internal/model/modelmock"
+	"github.com/piotrkowalczuk/charon/internal/session"
+	"github.com/piotrkowalczuk/charon/pkg/jwt"
+	"github.com/piotrkowalczuk/mnemosyne"
+	"github.com/piotrkowalczuk/mnemosyne/mnemosynerpc"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ session.Store = &session.TokenStore{}
+
+type tokenKeysStub struct {
+	jwt.KeySet
+	signer *jwt.Signer
+}
+
+func (tks *tokenKeysStub) Signer() *jwt.Signer {
+	return tks.signer
+}
+
+func newTokenKeysStub(t *testing.T) *tokenKeysStub {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	signer, err := jwt.NewSigner("1", key)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	return &tokenKeysStub{
+		KeySet: jwt.KeySet{"1": key.Public()},
+		signer: signer,
+	}
+}
+
+func TestTokenStore(t *testing.T) {
+	keys := newTokenKeysStub(t)
+	sessionMock := &modelmock.SessionProvider{}
+	permissionMock := &modelmock.PermissionProvider{}
+	store := &session.TokenStore{
+		Store:              &session.PostgresStore{Repository: sessionMock, TTL: time.Hour},
+		Keys:               keys,
+		PermissionProvider: permissionMock,
+		Issuer:             "charond",
+		TTL:                time.Minute,
+	}
+
+	permissionMock.On("FindByUserID", mock.Anything, int64(1)).
+		Return([]*model.PermissionEntity{{Subsystem: "charon", Module: "user", Action: "can create"}}, nil).
+		Once()
+	permissionMock.On("FindDeniedByUserID", mock.Anything, int64(1)).
+		Return(nil, sql.ErrNoRows).
+		Once()
+
+	res, err := store.Start(context.TODO(), &mnemosynerpc.StartRequest{
+		Session: &mnemosynerpc.Session{
+			SubjectId:     "charon:user:1",
+			SubjectClient: "web",
+			RefreshToken:  "rt",
+			Bag:           map[string]string{"username": "john"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	token := res.Session.AccessToken
+
+	claims, err := (&jwt.Verifier{Keys: keys.KeySet, Issuer: "charond"}).Verify(context.TODO(), token)
+	if err != nil {
+		t.Fatalf("token should be verifiable with published keys: %s", err.Error())
+	}
+	if claims.UserID != 1 || claims.Username != "john" || claims.Client != "web" {
+		t.Errorf("wrong claims: %#v", claims)
+	}
+	if !claims.Granted(charon.UserCanCreate) {
+		t.Errorf("permission should be granted: %v", claims.Permissions)
+	}
+	if time.Until(claims.ExpireAt()) > time.Minute {
+		t.Errorf("token lives too long: %s", claims.ExpireAt())
+	}
+
+	ctxRes, err := store.Context(mnemosyne.NewAccessTokenContext(context.TODO(), token), &empty.Empty{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if ctxRes.Session.SubjectId != "charon:user:1" || ctxRes.Session.Bag["username"] != "john" {
+		t.Errorf("unexpected session: %v", ctxRes.Session)
+	}
+
+	getRes, err := store.Get(context.TODO(), &mnemosynerpc.GetRequest{AccessToken: token})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if getRes.Session.AccessToken != token {
+		t.Errorf("wrong access token: %s", getRes.Session.AccessToken)
+	}
+
+	if _, err = store.Get(context.TODO(), &mnemosynerpc.GetRequest{AccessToken: token + "x"}); status.Code(err) != codes.NotFound {
+		t.Errorf("expected not found for invalid signature, got: %v", err)
+	}
+	// ID token is signed by the same keys, but it is not an access token.
+	idToken, err := keys.Signer().SignIDToken(&jwt.Claims{
+		Issuer:    "charond",
+		Subject:   "charon:user:1",
+		Audience:  jwt.Audience{"web"},
+		IssuedAt:  time.Now().Unix(),
+		ExpiresAt: time.Now().Add(time.Minute).Unix(),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if _, err = store.Get(context.TODO(), &mnemosynerpc.GetRequest{AccessToken: idToken}); status.Code(err) != codes.NotFound {
+		t.Errorf("expected not found for id token, got: %v", err)
+	}
+	if _, err = store.Abandon(context.TODO(), &mnemosynerpc.AbandonRequest{AccessToken: token}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("expected failed precondition, got: %v", err)
+	}
+
+	mock.AssertExpectationsForObjects(t, permissionMock)
+}
+
+func TestTokenStore_opaque(t *testing.T) {
+	sessionMock := &modelmock.SessionProvider{}
+	store := &session.TokenStore{
+		Store:              &session.PostgresStore{Repository: sessionMock, TTL: time.Hour},
+		Keys:               newTokenKeysStub(t),
+		PermissionProvider: &modelmock.PermissionProvider{},
+		TTL:                time.Minute,
+	}
+
+	sessionMock.On("FindOneByAccessToken", mock.Anything, "opaque").
+		Return(&model.SessionEntity{AccessToken: "opaque", SubjectID: "charon:user:1", ExpireAt: time.Now()}, nil).
+		Once()
+	sessionMock.On("Delete", mock.Anything, &model.SessionDeleteExpr{AccessToken: "opaque"}).
+		Return(int64(1), nil).
+		Once()
+
+	res, err := store.Get(context.TODO(), &mnemosynerpc.GetRequest{AccessToken: "opaque"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if res.Session.SubjectId != "charon:user:1" {
+		t.Errorf("wrong subject id: %s", res.Session.SubjectId)
+	}
+	if _, err = store.Abandon(context.TODO(), &mnemosynerpc.AbandonRequest{AccessToken: "opaque"}); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	mock.AssertExpectationsForObjects(t, sessionMock)
+}
+
+func TestTokenStore_apiKey(t *testing.T) {
+	keys := newTokenKeysStub(t)
+	permissionMock := &modelmock.PermissionProvider{}
+	apiKeyMock := &modelmock.APIKeyProvider{}
+	store := &session.TokenStore{
+		Store:              &session.PostgresStore{Repository: &modelmock.SessionProvider{}, TTL: time.Hour},
+		Keys:               keys,
+		PermissionProvider: permissionMock,
+		APIKeyProvider:     apiKeyMock,
+		Issuer:             "charond",
+		TTL:                time.Minute,
+	}
+
+	permissionMock.On("FindByUserID", mock.Anything, int64(1)).
+		Return([]*model.PermissionEntity{
+			{Subsystem: "charon", Module: "user", Action: "can create"},
+			{Subsystem: "charon", Module: "group", Action: "can create"},
+		}, nil)
+	permissionMock.On("FindDeniedByUserID", mock.Anything, int64(1)).
+		Return(nil, sql.ErrNoRows)
+	start := func(prefix string) (*mnemosynerpc.StartResponse, error) {
+		return store.Start(context.TODO(), &mnemosynerpc.StartRequest{
+			Session: &mnemosynerpc.Session{
+				SubjectId: "charon:user:1",
+				Bag:       map[string]string{"username": "john", session.BagAPIKey: prefix},
+			},
+		})
+	}
+
+	apiKeyMock.On("FindOneByPrefix", mock.Anything, "abc").
+		Return(&model.APIKeyEntity{UserID: 1, Permissions: model.APIKeyPermissions(charon.Permissions{charon.UserCanCreate})}, nil).
+		Once()
+	res, err := start("abc")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	claims, err := (&jwt.Verifier{Keys: keys.KeySet, Issuer: "charond"}).Verify(context.TODO(), res.Session.AccessToken)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if claims.APIKey != "abc" {
+		t.Errorf("wrong api key: %s", claims.APIKey)
+	}
+	if !claims.Granted(charon.UserCanCreate) || claims.Granted(charon.GroupCanCreate) {
+		t.Errorf("permissions should be restricted to those of the key: %v", claims.Permissions)
+	}
+	ctxRes, err := store.Context(mnemosyne.NewAccessTokenContext(context.TODO(), res.Session.AccessToken), &empty.Empty{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if ctxRes.Session.Bag[session.BagAPIKey] != "abc" {
+		t.Errorf("api key should be carried by the session: %v", ctxRes.Session.Bag)
+	}
+
+	apiKeyMock.On("FindOneByPrefix", mock.Anything, "revoked").
+		Return(&model.APIKeyEntity{UserID: 1, Revoked: true}, nil).
+		Once()
+	if _, err = start("revoked"); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("expected unauthenticated, got: %v", err)
+	}
+
+	mock.AssertExpectationsForObjects(t, apiKeyMock)
+}
+
+func TestTokenStore_scope(t *testing.T) {
+	keys := newTokenKeysStub(t)
+	permissionMock := &modelmock.PermissionProvider{}
+	store := &session.TokenStore{
+		Store:              &session.PostgresStore{Repository: &modelmock.SessionProvider{}, TTL: time.Hour},
+		Keys:               keys,
+		PermissionProvider: permissionMock,
+		Issuer:             "charond",
+		TTL:                time.Minute,
+	}
+
+	res, err := store.Start(context.TODO(), &mnemosynerpc.StartRequest{
+		Session: &mnemosynerpc.Session{
+			SubjectId:     "charon:user:1",
+			SubjectClient: "client",
+			Bag:           map[string]string{"username": "john", session.BagScope: "openid profile"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if _, err = (&jwt.Verifier{Keys: keys.KeySet, Issuer: "charond"}).Verify(context.TODO(), res.Session.AccessToken); err != jwt.ErrScoped {
+		t.Errorf("token issued to an oauth client should be refused by other services, got: %v", err)
+	}
+	claims, err := (&jwt.Verifier{Keys: keys.KeySet, Issuer: "charond", Audience: "client", Scoped: true}).Verify(context.TODO(), res.Session.AccessToken)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(claims.Permissions) != 0 || claims.Scope != "openid profile" {
+		t.Errorf("token issued to an oauth client should carry scope and no permissions, got: %v", claims)
+	}
+	ctxRes, err := store.Context(mnemosyne.NewAccessTokenContext(context.TODO(), res.Session.AccessToken), &empty.Empty{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if ctxRes.Session.Bag[session.BagScope] != "openid profile" {
+		t.Errorf("scope should be carried by the session: %v", ctxRes.Session.Bag)
+	}
+
+	// Permissions are not even looked up.
+	mock.AssertExpectationsForObjects(t, permissionMock)
+}
@@ -0,0 +1,160 @@
+// Package totp implements time-based one-time passwords (RFC 6238) used as a second authentication factor.
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// Period is the length of a single time step.
+	Period = 30 * time.Second
+	// Digits is the length of a generated code.
+	Digits = 6
+	// Skew is the number of time steps before and after the current one that are accepted as well.
+	Skew = 1
+
+	secretLength       = 20
+	recoveryCodeLength = 10
+	challengeLength    = 32
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret returns random secret of length recommended by RFC 4226.
+func GenerateSecret() ([]byte, error) {
+	return random(secretLength)
+}
+
+// EncodeSecret returns secret in the form expected by authenticator applications.
+func EncodeSecret(secret []byte) string {
+	return encoding.EncodeToString(secret)
+}
+
+// URI returns key URI that can be presented as a QR code to the user.
+func URI(issuer, account string, secret []byte) string {
+	v := url.Values{}
+	v.Set("secret", EncodeSecret(secret))
+	v.Set("issuer", issuer)
+	v.Set("algorithm", "SHA1")
+	v.Set("digits", fmt.Sprintf("%d", Digits))
+	v.Set("period", fmt.Sprintf("%d", int(Period/time.Second)))
+
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + account,
+		RawQuery: v.Encode(),
+	}
+	return u.String()
+}
+
+// Step returns time step that given time belongs to.
+func Step(t time.Time) int64 {
+	return t.Unix() / int64(Period/time.Second)
+}
+
+// Code computes HOTP value (RFC 4226) for given secret and time step.
+func Code(secret []byte, step int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step))
+
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%0*d", Digits, value%1000000)
+}
+
+// Validate checks if given code is valid at given time.
+// If so, time step it was generated for is returned, so the caller can prevent it from being used again.
+func Validate(secret []byte, code string, t time.Time) (int64, bool) {
+	if len(code) != Digits {
+		return 0, false
+	}
+	current := Step(t)
+	for step := current - Skew; step <= current+Skew; step++ {
+		if subtle.ConstantTimeCompare([]byte(Code(secret, step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// GenerateRecoveryCodes returns n random recovery codes together with concatenated hashes of them.
+// Only hashes are meant to be stored.
+func GenerateRecoveryCodes(n int) ([]string, []byte, error) {
+	codes := make([]string, 0, n)
+	hashes := make([]byte, 0, n*sha256.Size)
+	for i := 0; i < n; i++ {
+		buf, err := random(recoveryCodeLength * 5 / 8)
+		if err != nil {
+			return nil, nil, err
+		}
+		code := strings.ToLower(encoding.EncodeToString(buf))
+		code = code[:recoveryCodeLength/2] + "-" + code[recoveryCodeLength/2:]
+		sum := sha256.Sum256([]byte(normalizeRecoveryCode(code)))
+
+		codes = append(codes, code)
+		hashes = append(hashes, sum[:]...)
+	}
+	return codes, hashes, nil
+}
+
+// MatchRecoveryCode looks for given recovery code within hashes produced by GenerateRecoveryCodes.
+// If it is found, hashes without the matching one are returned.
+func MatchRecoveryCode(hashes []byte, code string) ([]byte, bool) {
+	sum := sha256.Sum256([]byte(normalizeRecoveryCode(code)))
+	for i := 0; i+sha256.Size <= len(hashes); i += sha256.Size {
+		if subtle.ConstantTimeCompare(hashes[i:i+sha256.Size], sum[:]) == 1 {
+			left := make([]byte, 0, len(hashes)-sha256.Size)
+			left = append(left, hashes[:i]...)
+			return append(left, hashes[i+sha256.Size:]...), true
+		}
+	}
+	return nil, false
+}
+
+// GenerateChallenge returns random challenge that binds the first and the second step of authentication,
+// together with hash of it. Only the hash is meant to be stored.
+func GenerateChallenge() (string, []byte, error) {
+	buf, err := random(challengeLength)
+	if err != nil {
+		return "", nil, err
+	}
+	challenge := hex.EncodeToString(buf)
+
+	return challenge, HashChallenge(challenge), nil
+}
+
+// HashChallenge returns hash of a challenge produced by GenerateChallenge.
+func HashChallenge(challenge string) []byte {
+	sum := sha256.Sum256([]byte(challenge))
+	return sum[:]
+}
+
+func normalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.Replace(strings.TrimSpace(code), "-", "", -1))
+}
+
+func random(length int) ([]byte, error) {
+	buf := make([]byte, length)
+	if _, err := io.ReadFull(rand.Reader, buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
@@ -0,0 +1,142 @@
+package totp_test
+
+import (
+	"bytes"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/piotrkowalczuk/charon/internal/totp"
+)
+
+// RFC 6238, appendix B, SHA1 test vectors truncated to 6 digits.
+var rfcVectors = []struct {
+	unix int64
+	code string
+}{
+	{unix: 59, code: "287082"},
+	{unix: 1111111109, code: "081804"},
+	{unix: 1111111111, code: "050471"},
+	{unix: 1234567890, code: "005924"},
+	{unix: 2000000000, code: "279037"},
+	{unix: 20000000000, code: "353130"},
+}
+
+var rfcSecret = []byte("12345678901234567890")
+
+func TestCode(t *testing.T) {
+	for _, v := range rfcVectors {
+		if got := totp.Code(rfcSecret, totp.Step(time.Unix(v.unix, 0))); got != v.code {
+			t.Errorf("wrong code for %d, expected %s but got %s", v.unix, v.code, got)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	current := totp.Step(now)
+
+	cases := map[string]struct {
+		code string
+		step int64
+		ok   bool
+	}{
+		"current":   {code: totp.Code(rfcSecret, current), step: current, ok: true},
+		"previous":  {code: totp.Code(rfcSecret, current-1), step: current - 1, ok: true},
+		"next":      {code: totp.Code(rfcSecret, current+1), step: current + 1, ok: true},
+		"too-old":   {code: totp.Code(rfcSecret, current-2)},
+		"too-new":   {code: totp.Code(rfcSecret, current+2)},
+		"too-short": {code: "12345"},
+		"empty":     {code: ""},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			step, ok := totp.Validate(rfcSecret, c.code, now)
+			if ok != c.ok {
+				t.Fatalf("wrong result, expected %t but got %t", c.ok, ok)
+			}
+			if step != c.step {
+				t.Errorf("wrong step, expected %d but got %d", c.step, step)
+			}
+		})
+	}
+}
+
+func TestURI(t *testing.T) {
+	uri := totp.URI("charon", "john@example.com", rfcSecret)
+
+	u, err := url.Parse(uri)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if u.Scheme != "otpauth" || u.Host != "totp" {
+		t.Errorf("wrong scheme or type: %s", uri)
+	}
+	if u.Path != "/charon:john@example.com" {
+		t.Errorf("wrong label: %s", u.Path)
+	}
+	if got := u.Query().Get("secret"); got != "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ" {
+		t.Errorf("wrong secret: %s", got)
+	}
+	if got := u.Query().Get("issuer"); got != "charon" {
+		t.Errorf("wrong issuer: %s", got)
+	}
+}
+
+func TestGenerateSecret(t *testing.T) {
+	a, err := totp.GenerateSecret()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	b, err := totp.GenerateSecret()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(a) != 20 {
+		t.Errorf("wrong secret length: %d", len(a))
+	}
+	if string(a) == string(b) {
+		t.Error("secrets should be different")
+	}
+}
+
+func TestMatchRecoveryCode(t *testing.T) {
+	codes, hashes, err := totp.GenerateRecoveryCodes(3)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(codes) != 3 {
+		t.Fatalf("wrong number of codes: %d", len(codes))
+	}
+
+	left, ok := totp.MatchRecoveryCode(hashes, codes[1])
+	if !ok {
+		t.Fatal("recovery code should match")
+	}
+	if len(left) != len(hashes)/3*2 {
+		t.Fatalf("wrong number of hashes left: %d", len(left))
+	}
+	if _, ok = totp.MatchRecoveryCode(left, codes[1]); ok {
+		t.Error("used recovery code should not match")
+	}
+	if _, ok = totp.MatchRecoveryCode(left, " "+codes[0][:5]+codes[0][6:]+" "); !ok {
+		t.Error("recovery code without separator should match")
+	}
+	if _, ok = totp.MatchRecoveryCode(left, "aaaaa-aaaaa"); ok {
+		t.Error("unknown recovery code should not match")
+	}
+}
+
+func TestGenerateChallenge(t *testing.T) {
+	challenge, hash, err := totp.GenerateChallenge()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(challenge) != 64 {
+		t.Errorf("wrong challenge length: %d", len(challenge))
+	}
+	if !bytes.Equal(hash, totp.HashChallenge(challenge)) {
+		t.Error("hash does not match challenge")
+	}
+}
@@ -0,0 +1,907 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: github.com/piotrkowalczuk/charon/pb/rpc/charond/v1/api_key.proto
+
+package charond // import "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+import wrappers "github.com/golang/protobuf/ptypes/wrappers"
+import ntypes "github.com/piotrkowalczuk/ntypes"
+import qtypes "github.com/piotrkowalczuk/qtypes"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type APIKey struct {
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Prefix identifies the key, it is a part of the key that is not secret.
+	Prefix               string               `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	UserId               int64                `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Permissions          []string             `protobuf:"bytes,4,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	Notes                *ntypes.String       `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
+	Revoked              bool                 `protobuf:"varint,6,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	ExpireAt             *timestamp.Timestamp `protobuf:"bytes,7,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
+	LastUsedAt           *timestamp.Timestamp `protobuf:"bytes,8,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy            *ntypes.Int64        `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedBy            *ntypes.Int64        `protobuf:"bytes,12,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *APIKey) Reset()         { *m = APIKey{} }
+func (m *APIKey) String() string { return proto.CompactTextString(m) }
+func (*APIKey) ProtoMessage()    {}
+func (*APIKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_key_063dbc8328d16fdd, []int{0}
+}
+func (m *APIKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_APIKey.Unmarshal(m, b)
+}
+func (m *APIKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_APIKey.Marshal(b, m, deterministic)
+}
+func (dst *APIKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_APIKey.Merge(dst, src)
+}
+func (m *APIKey) XXX_Size() int {
+	return xxx_messageInfo_APIKey.Size(m)
+}
+func (m *APIKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_APIKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_APIKey proto.InternalMessageInfo
+
+func (m *APIKey) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *APIKey) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *APIKey) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *APIKey) GetPermissions() []string {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+func (m *APIKey) GetNotes() *ntypes.String {
+	if m != nil {
+		return m.Notes
+	}
+	return nil
+}
+
+func (m *APIKey) GetRevoked() bool {
+	if m != nil {
+		return m.Revoked
+	}
+	return false
+}
+
+func (m *APIKey) GetExpireAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpireAt
+	}
+	return nil
+}
+
+func (m *APIKey) GetLastUsedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastUsedAt
+	}
+	return nil
+}
+
+func (m *APIKey) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *APIKey) GetCreatedBy() *ntypes.Int64 {
+	if m != nil {
+		return m.CreatedBy
+	}
+	return nil
+}
+
+func (m *APIKey) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+func (m *APIKey) GetUpdatedBy() *ntypes.Int64 {
+	if m != nil {
+		return m.UpdatedBy
+	}
+	return nil
+}
+
+type APIKeyQuery struct {
+	UserId               *qtypes.Int64     `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Revoked              *ntypes.Bool      `protobuf:"bytes,2,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	ExpireAt             *qtypes.Timestamp `protobuf:"bytes,3,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
+	LastUsedAt           *qtypes.Timestamp `protobuf:"bytes,4,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	CreatedAt            *qtypes.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *APIKeyQuery) Reset()         { *m = APIKeyQuery{} }
+func (m *APIKeyQuery) String() string { return proto.CompactTextString(m) }
+func (*APIKeyQuery) ProtoMessage()    {}
+func (*APIKeyQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_key_063dbc8328d16fdd, []int{1}
+}
+func (m *APIKeyQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_APIKeyQuery.Unmarshal(m, b)
+}
+func (m *APIKeyQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_APIKeyQuery.Marshal(b, m, deterministic)
+}
+func (dst *APIKeyQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_APIKeyQuery.Merge(dst, src)
+}
+func (m *APIKeyQuery) XXX_Size() int {
+	return xxx_messageInfo_APIKeyQuery.Size(m)
+}
+func (m *APIKeyQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_APIKeyQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_APIKeyQuery proto.InternalMessageInfo
+
+func (m *APIKeyQuery) GetUserId() *qtypes.Int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return nil
+}
+
+func (m *APIKeyQuery) GetRevoked() *ntypes.Bool {
+	if m != nil {
+		return m.Revoked
+	}
+	return nil
+}
+
+func (m *APIKeyQuery) GetExpireAt() *qtypes.Timestamp {
+	if m != nil {
+		return m.ExpireAt
+	}
+	return nil
+}
+
+func (m *APIKeyQuery) GetLastUsedAt() *qtypes.Timestamp {
+	if m != nil {
+		return m.LastUsedAt
+	}
+	return nil
+}
+
+func (m *APIKeyQuery) GetCreatedAt() *qtypes.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+type CreateAPIKeyRequest struct {
+	// User id defaults to the id of the actor.
+	UserId *ntypes.Int64 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Permissions the key is restricted to, the actor has to be granted all of them.
+	Permissions          []string             `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	Notes                *ntypes.String       `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	ExpireAt             *timestamp.Timestamp `protobuf:"bytes,4,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CreateAPIKeyRequest) Reset()         { *m = CreateAPIKeyRequest{} }
+func (m *CreateAPIKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateAPIKeyRequest) ProtoMessage()    {}
+func (*CreateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_key_063dbc8328d16fdd, []int{2}
+}
+func (m *CreateAPIKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateAPIKeyRequest.Unmarshal(m, b)
+}
+func (m *CreateAPIKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateAPIKeyRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateAPIKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAPIKeyRequest.Merge(dst, src)
+}
+func (m *CreateAPIKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateAPIKeyRequest.Size(m)
+}
+func (m *CreateAPIKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAPIKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAPIKeyRequest proto.InternalMessageInfo
+
+func (m *CreateAPIKeyRequest) GetUserId() *ntypes.Int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return nil
+}
+
+func (m *CreateAPIKeyRequest) GetPermissions() []string {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+func (m *CreateAPIKeyRequest) GetNotes() *ntypes.String {
+	if m != nil {
+		return m.Notes
+	}
+	return nil
+}
+
+func (m *CreateAPIKeyRequest) GetExpireAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpireAt
+	}
+	return nil
+}
+
+type CreateAPIKeyResponse struct {
+	ApiKey *APIKey `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// Key is returned only once, only its hash is stored.
+	Key                  string   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateAPIKeyResponse) Reset()         { *m = CreateAPIKeyResponse{} }
+func (m *CreateAPIKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateAPIKeyResponse) ProtoMessage()    {}
+func (*CreateAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_key_063dbc8328d16fdd, []int{3}
+}
+func (m *CreateAPIKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateAPIKeyResponse.Unmarshal(m, b)
+}
+func (m *CreateAPIKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateAPIKeyResponse.Marshal(b, m, deterministic)
+}
+func (dst *CreateAPIKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAPIKeyResponse.Merge(dst, src)
+}
+func (m *CreateAPIKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateAPIKeyResponse.Size(m)
+}
+func (m *CreateAPIKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAPIKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAPIKeyResponse proto.InternalMessageInfo
+
+func (m *CreateAPIKeyResponse) GetApiKey() *APIKey {
+	if m != nil {
+		return m.ApiKey
+	}
+	return nil
+}
+
+func (m *CreateAPIKeyResponse) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type GetAPIKeyRequest struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAPIKeyRequest) Reset()         { *m = GetAPIKeyRequest{} }
+func (m *GetAPIKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAPIKeyRequest) ProtoMessage()    {}
+func (*GetAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_key_063dbc8328d16fdd, []int{4}
+}
+func (m *GetAPIKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAPIKeyRequest.Unmarshal(m, b)
+}
+func (m *GetAPIKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAPIKeyRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetAPIKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAPIKeyRequest.Merge(dst, src)
+}
+func (m *GetAPIKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAPIKeyRequest.Size(m)
+}
+func (m *GetAPIKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAPIKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAPIKeyRequest proto.InternalMessageInfo
+
+func (m *GetAPIKeyRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GetAPIKeyResponse struct {
+	ApiKey               *APIKey  `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAPIKeyResponse) Reset()         { *m = GetAPIKeyResponse{} }
+func (m *GetAPIKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAPIKeyResponse) ProtoMessage()    {}
+func (*GetAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_key_063dbc8328d16fdd, []int{5}
+}
+func (m *GetAPIKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAPIKeyResponse.Unmarshal(m, b)
+}
+func (m *GetAPIKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAPIKeyResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetAPIKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAPIKeyResponse.Merge(dst, src)
+}
+func (m *GetAPIKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_GetAPIKeyResponse.Size(m)
+}
+func (m *GetAPIKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAPIKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAPIKeyResponse proto.InternalMessageInfo
+
+func (m *GetAPIKeyResponse) GetApiKey() *APIKey {
+	if m != nil {
+		return m.ApiKey
+	}
+	return nil
+}
+
+type ListAPIKeysRequest struct {
+	Offset               *ntypes.Int64 `protobuf:"bytes,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                *ntypes.Int64 `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	OrderBy              []*Order      `protobuf:"bytes,3,rep,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Query                *APIKeyQuery  `protobuf:"bytes,11,opt,name=query,proto3" json:"query,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ListAPIKeysRequest) Reset()         { *m = ListAPIKeysRequest{} }
+func (m *ListAPIKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAPIKeysRequest) ProtoMessage()    {}
+func (*ListAPIKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_key_063dbc8328d16fdd, []int{6}
+}
+func (m *ListAPIKeysRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAPIKeysRequest.Unmarshal(m, b)
+}
+func (m *ListAPIKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAPIKeysRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListAPIKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAPIKeysRequest.Merge(dst, src)
+}
+func (m *ListAPIKeysRequest) XXX_Size() int {
+	return xxx_messageInfo_ListAPIKeysRequest.Size(m)
+}
+func (m *ListAPIKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAPIKeysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAPIKeysRequest proto.InternalMessageInfo
+
+func (m *ListAPIKeysRequest) GetOffset() *ntypes.Int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return nil
+}
+
+func (m *ListAPIKeysRequest) GetLimit() *ntypes.Int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return nil
+}
+
+func (m *ListAPIKeysRequest) GetOrderBy() []*Order {
+	if m != nil {
+		return m.OrderBy
+	}
+	return nil
+}
+
+func (m *ListAPIKeysRequest) GetQuery() *APIKeyQuery {
+	if m != nil {
+		return m.Query
+	}
+	return nil
+}
+
+type ListAPIKeysResponse struct {
+	ApiKeys              []*APIKey `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ListAPIKeysResponse) Reset()         { *m = ListAPIKeysResponse{} }
+func (m *ListAPIKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*ListAPIKeysResponse) ProtoMessage()    {}
+func (*ListAPIKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_key_063dbc8328d16fdd, []int{7}
+}
+func (m *ListAPIKeysResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAPIKeysResponse.Unmarshal(m, b)
+}
+func (m *ListAPIKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAPIKeysResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListAPIKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAPIKeysResponse.Merge(dst, src)
+}
+func (m *ListAPIKeysResponse) XXX_Size() int {
+	return xxx_messageInfo_ListAPIKeysResponse.Size(m)
+}
+func (m *ListAPIKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAPIKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAPIKeysResponse proto.InternalMessageInfo
+
+func (m *ListAPIKeysResponse) GetApiKeys() []*APIKey {
+	if m != nil {
+		return m.ApiKeys
+	}
+	return nil
+}
+
+type RevokeAPIKeyRequest struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeAPIKeyRequest) Reset()         { *m = RevokeAPIKeyRequest{} }
+func (m *RevokeAPIKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeAPIKeyRequest) ProtoMessage()    {}
+func (*RevokeAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_key_063dbc8328d16fdd, []int{8}
+}
+func (m *RevokeAPIKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeAPIKeyRequest.Unmarshal(m, b)
+}
+func (m *RevokeAPIKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeAPIKeyRequest.Marshal(b, m, deterministic)
+}
+func (dst *RevokeAPIKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeAPIKeyRequest.Merge(dst, src)
+}
+func (m *RevokeAPIKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeAPIKeyRequest.Size(m)
+}
+func (m *RevokeAPIKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeAPIKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeAPIKeyRequest proto.InternalMessageInfo
+
+func (m *RevokeAPIKeyRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type RevokeAPIKeyResponse struct {
+	ApiKey               *APIKey  `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeAPIKeyResponse) Reset()         { *m = RevokeAPIKeyResponse{} }
+func (m *RevokeAPIKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*RevokeAPIKeyResponse) ProtoMessage()    {}
+func (*RevokeAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_key_063dbc8328d16fdd, []int{9}
+}
+func (m *RevokeAPIKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeAPIKeyResponse.Unmarshal(m, b)
+}
+func (m *RevokeAPIKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeAPIKeyResponse.Marshal(b, m, deterministic)
+}
+func (dst *RevokeAPIKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeAPIKeyResponse.Merge(dst, src)
+}
+func (m *RevokeAPIKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_RevokeAPIKeyResponse.Size(m)
+}
+func (m *RevokeAPIKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeAPIKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeAPIKeyResponse proto.InternalMessageInfo
+
+func (m *RevokeAPIKeyResponse) GetApiKey() *APIKey {
+	if m != nil {
+		return m.ApiKey
+	}
+	return nil
+}
+
+type DeleteAPIKeyRequest struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteAPIKeyRequest) Reset()         { *m = DeleteAPIKeyRequest{} }
+func (m *DeleteAPIKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteAPIKeyRequest) ProtoMessage()    {}
+func (*DeleteAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_key_063dbc8328d16fdd, []int{10}
+}
+func (m *DeleteAPIKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteAPIKeyRequest.Unmarshal(m, b)
+}
+func (m *DeleteAPIKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteAPIKeyRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteAPIKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteAPIKeyRequest.Merge(dst, src)
+}
+func (m *DeleteAPIKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteAPIKeyRequest.Size(m)
+}
+func (m *DeleteAPIKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteAPIKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteAPIKeyRequest proto.InternalMessageInfo
+
+func (m *DeleteAPIKeyRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*APIKey)(nil), "charon.rpc.charond.v1.APIKey")
+	proto.RegisterType((*APIKeyQuery)(nil), "charon.rpc.charond.v1.APIKeyQuery")
+	proto.RegisterType((*CreateAPIKeyRequest)(nil), "charon.rpc.charond.v1.CreateAPIKeyRequest")
+	proto.RegisterType((*CreateAPIKeyResponse)(nil), "charon.rpc.charond.v1.CreateAPIKeyResponse")
+	proto.RegisterType((*GetAPIKeyRequest)(nil), "charon.rpc.charond.v1.GetAPIKeyRequest")
+	proto.RegisterType((*GetAPIKeyResponse)(nil), "charon.rpc.charond.v1.GetAPIKeyResponse")
+	proto.RegisterType((*ListAPIKeysRequest)(nil), "charon.rpc.charond.v1.ListAPIKeysRequest")
+	proto.RegisterType((*ListAPIKeysResponse)(nil), "charon.rpc.charond.v1.ListAPIKeysResponse")
+	proto.RegisterType((*RevokeAPIKeyRequest)(nil), "charon.rpc.charond.v1.RevokeAPIKeyRequest")
+	proto.RegisterType((*RevokeAPIKeyResponse)(nil), "charon.rpc.charond.v1.RevokeAPIKeyResponse")
+	proto.RegisterType((*DeleteAPIKeyRequest)(nil), "charon.rpc.charond.v1.DeleteAPIKeyRequest")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// APIKeyManagerClient is the client API for APIKeyManager service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type APIKeyManagerClient interface {
+	Create(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error)
+	Get(ctx context.Context, in *GetAPIKeyRequest, opts ...grpc.CallOption) (*GetAPIKeyResponse, error)
+	List(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error)
+	// Revoke makes the key unusable, sessions started with it cannot be used anymore either.
+	Revoke(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error)
+	Delete(ctx context.Context, in *DeleteAPIKeyRequest, opts ...grpc.CallOption) (*wrappers.BoolValue, error)
+}
+
+type aPIKeyManagerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAPIKeyManagerClient(cc *grpc.ClientConn) APIKeyManagerClient {
+	return &aPIKeyManagerClient{cc}
+}
+
+func (c *aPIKeyManagerClient) Create(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error) {
+	out := new(CreateAPIKeyResponse)
+	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.APIKeyManager/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIKeyManagerClient) Get(ctx context.Context, in *GetAPIKeyRequest, opts ...grpc.CallOption) (*GetAPIKeyResponse, error) {
+	out := new(GetAPIKeyResponse)
+	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.APIKeyManager/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIKeyManagerClient) List(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error) {
+	out := new(ListAPIKeysResponse)
+	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.APIKeyManager/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIKeyManagerClient) Revoke(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error) {
+	out := new(RevokeAPIKeyResponse)
+	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.APIKeyManager/Revoke", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIKeyManagerClient) Delete(ctx context.Context, in *DeleteAPIKeyRequest, opts ...grpc.CallOption) (*wrappers.BoolValue, error) {
+	out := new(wrappers.BoolValue)
+	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.APIKeyManager/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// APIKeyManagerServer is the server API for APIKeyManager service.
+type APIKeyManagerServer interface {
+	Create(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error)
+	Get(context.Context, *GetAPIKeyRequest) (*GetAPIKeyResponse, error)
+	List(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error)
+	// Revoke makes the key unusable, sessions started with it cannot be used anymore either.
+	Revoke(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error)
+	Delete(context.Context, *DeleteAPIKeyRequest) (*wrappers.BoolValue, error)
+}
+
+func RegisterAPIKeyManagerServer(s *grpc.Server, srv APIKeyManagerServer) {
+	s.RegisterService(&_APIKeyManager_serviceDesc, srv)
+}
+
+func _APIKeyManager_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIKeyManagerServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/charon.rpc.charond.v1.APIKeyManager/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIKeyManagerServer).Create(ctx, req.(*CreateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIKeyManager_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIKeyManagerServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/charon.rpc.charond.v1.APIKeyManager/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIKeyManagerServer).Get(ctx, req.(*GetAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIKeyManager_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAPIKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIKeyManagerServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/charon.rpc.charond.v1.APIKeyManager/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIKeyManagerServer).List(ctx, req.(*ListAPIKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIKeyManager_Revoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIKeyManagerServer).Revoke(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/charon.rpc.charond.v1.APIKeyManager/Revoke",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIKeyManagerServer).Revoke(ctx, req.(*RevokeAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIKeyManager_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIKeyManagerServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/charon.rpc.charond.v1.APIKeyManager/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIKeyManagerServer).Delete(ctx, req.(*DeleteAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _APIKeyManager_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "charon.rpc.charond.v1.APIKeyManager",
+	HandlerType: (*APIKeyManagerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _APIKeyManager_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _APIKeyManager_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _APIKeyManager_List_Handler,
+		},
+		{
+			MethodName: "Revoke",
+			Handler:    _APIKeyManager_Revoke_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _APIKeyManager_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1/api_key.proto",
+}
+
+func init() {
+	proto.RegisterFile("github.com/piotrkowalczuk/charon/pb/rpc/charond/v1/api_key.proto", fileDescriptor_api_key_063dbc8328d16fdd)
+}
+
+var fileDescriptor_api_key_063dbc8328d16fdd = []byte{
+	// 833 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xdd, 0x92, 0xdb, 0x34,
+	0x14, 0xae, 0xe3, 0xc4, 0x49, 0x4e, 0xb6, 0x9d, 0xad, 0x52, 0xc0, 0x93, 0xe1, 0xc7, 0x63, 0xd8,
+	0x12, 0x5a, 0xc6, 0xa6, 0x5b, 0xa6, 0x2d, 0x3f, 0x33, 0xb0, 0x81, 0x99, 0xce, 0x52, 0xa0, 0xc5,
+	0xfc, 0x5c, 0xf4, 0x26, 0x75, 0x6c, 0x6d, 0xaa, 0xd9, 0xd8, 0xd2, 0x4a, 0xf2, 0xb6, 0xe6, 0xe1,
+	0x78, 0x0f, 0x5e, 0x81, 0xeb, 0x3e, 0x00, 0x63, 0x4b, 0xde, 0xfc, 0xd4, 0x4e, 0x16, 0x7a, 0x95,
+	0x48, 0xfa, 0xbe, 0xf3, 0xe9, 0x9c, 0xef, 0x1c, 0x8d, 0xe1, 0xdb, 0x39, 0x91, 0xcf, 0xb3, 0x99,
+	0x17, 0xd1, 0xc4, 0x67, 0x84, 0x4a, 0x7e, 0x4a, 0x5f, 0x84, 0x8b, 0xe8, 0xcf, 0xec, 0xd4, 0x8f,
+	0x9e, 0x87, 0x9c, 0xa6, 0x3e, 0x9b, 0xf9, 0x9c, 0x45, 0x7a, 0x15, 0xfb, 0xe7, 0x77, 0xfc, 0x90,
+	0x91, 0xe9, 0x29, 0xce, 0x3d, 0xc6, 0xa9, 0xa4, 0xe8, 0x2d, 0x75, 0xe2, 0x71, 0x16, 0x79, 0x1a,
+	0xe4, 0x9d, 0xdf, 0x19, 0x7d, 0x30, 0xa7, 0x74, 0xbe, 0xc0, 0x7e, 0x09, 0x9a, 0x65, 0x27, 0xbe,
+	0x24, 0x09, 0x16, 0x32, 0x4c, 0x98, 0xe2, 0x8d, 0xde, 0xdf, 0x04, 0xbc, 0xe0, 0x21, 0x63, 0x98,
+	0x0b, 0x7d, 0xfe, 0xcd, 0xff, 0xb8, 0x59, 0x44, 0x93, 0x84, 0xa6, 0x3a, 0xc0, 0xf0, 0x4c, 0xe6,
+	0x0c, 0x0b, 0x5f, 0xfd, 0x54, 0x9b, 0xa9, 0xda, 0x4c, 0x57, 0x36, 0xdd, 0x57, 0x26, 0x58, 0x47,
+	0x4f, 0x8e, 0x1f, 0xe1, 0x1c, 0x5d, 0x83, 0x16, 0x89, 0x6d, 0xc3, 0x31, 0xc6, 0x66, 0xd0, 0x22,
+	0x31, 0x7a, 0x1b, 0x2c, 0xc6, 0xf1, 0x09, 0x79, 0x69, 0xb7, 0x1c, 0x63, 0xdc, 0x0f, 0xf4, 0x0a,
+	0xbd, 0x03, 0xdd, 0x4c, 0x60, 0x3e, 0x25, 0xb1, 0x6d, 0x96, 0x60, 0xab, 0x58, 0x1e, 0xc7, 0xc8,
+	0x81, 0x01, 0xc3, 0x3c, 0x21, 0x42, 0x10, 0x9a, 0x0a, 0xbb, 0xed, 0x98, 0xe3, 0x7e, 0xb0, 0xba,
+	0x85, 0x3e, 0x82, 0x4e, 0x4a, 0x25, 0x16, 0x76, 0xc7, 0x31, 0xc6, 0x83, 0xc3, 0x6b, 0x9e, 0xbe,
+	0xcb, 0xaf, 0x92, 0x93, 0x74, 0x1e, 0xa8, 0x43, 0x64, 0x43, 0x97, 0xe3, 0x73, 0x7a, 0x8a, 0x63,
+	0xdb, 0x72, 0x8c, 0x71, 0x2f, 0xa8, 0x96, 0xe8, 0x3e, 0xf4, 0xf1, 0x4b, 0x46, 0x38, 0x9e, 0x86,
+	0xd2, 0xee, 0x96, 0x31, 0x46, 0x9e, 0x2a, 0xa6, 0x57, 0x15, 0xd3, 0xfb, 0xad, 0xaa, 0x76, 0xd0,
+	0x53, 0xe0, 0x23, 0x89, 0xbe, 0x86, 0xbd, 0x45, 0x28, 0xe4, 0x34, 0x13, 0x38, 0x2e, 0xb8, 0xbd,
+	0x9d, 0x5c, 0x28, 0xf0, 0xbf, 0x0b, 0x1c, 0x1f, 0x49, 0xf4, 0x05, 0x40, 0xc4, 0x71, 0x28, 0x15,
+	0xb7, 0xbf, 0x93, 0xdb, 0xd7, 0xe8, 0x23, 0x89, 0x3e, 0x5d, 0x52, 0x67, 0xb9, 0x0d, 0x25, 0xf5,
+	0x6a, 0x95, 0xf6, 0x71, 0x2a, 0xef, 0x7d, 0x7e, 0x81, 0x9e, 0xe4, 0x85, 0x50, 0xc6, 0xe2, 0x4a,
+	0x68, 0xb0, 0x5b, 0x48, 0xa3, 0x95, 0x50, 0x45, 0x9d, 0xe5, 0xf6, 0x5e, 0xad, 0x90, 0x06, 0x4c,
+	0x72, 0xf7, 0x95, 0x01, 0x03, 0x65, 0xfb, 0x2f, 0x19, 0xe6, 0x39, 0xba, 0xb9, 0xf4, 0xd4, 0xd0,
+	0xd4, 0xb3, 0x55, 0x6a, 0x65, 0xf1, 0xcd, 0xa5, 0x35, 0xad, 0x12, 0xb7, 0x57, 0x49, 0x4c, 0x28,
+	0x5d, 0x2c, 0x8d, 0xf2, 0x56, 0x8d, 0x32, 0x4b, 0xe4, 0xf5, 0x2a, 0x62, 0x9d, 0x3f, 0x77, 0x37,
+	0xfc, 0x69, 0x37, 0x51, 0x56, 0x6d, 0xf9, 0x6c, 0xcd, 0x96, 0x4e, 0x13, 0x65, 0xe9, 0x86, 0xfb,
+	0x97, 0x01, 0xc3, 0xef, 0xca, 0x95, 0x4a, 0x3e, 0xc0, 0x67, 0x19, 0x16, 0xb2, 0x2e, 0xfd, 0xb4,
+	0x2e, 0xfd, 0x8d, 0x0e, 0x6f, 0x6d, 0xe9, 0x70, 0x73, 0x5b, 0x87, 0xaf, 0xf5, 0x71, 0xfb, 0xf2,
+	0x7d, 0xec, 0x3e, 0x83, 0x1b, 0xeb, 0xf7, 0x17, 0x8c, 0xa6, 0x02, 0xa3, 0x7b, 0xd0, 0xd5, 0x4f,
+	0x93, 0x4e, 0xe0, 0x3d, 0xaf, 0xf6, 0x6d, 0xf2, 0x34, 0xcf, 0x0a, 0x19, 0x29, 0x66, 0x7e, 0x1f,
+	0xcc, 0x82, 0xa3, 0x06, 0xbc, 0xf8, 0xeb, 0xba, 0xb0, 0xff, 0x10, 0xcb, 0xf5, 0xf2, 0x6c, 0xbc,
+	0x0c, 0xee, 0x23, 0xb8, 0xbe, 0x82, 0x79, 0xb3, 0x2b, 0xb8, 0x7f, 0x1b, 0x80, 0x7e, 0x24, 0x42,
+	0x87, 0x13, 0x95, 0xe6, 0x01, 0x58, 0xf4, 0xe4, 0x44, 0x60, 0xd9, 0xe0, 0x88, 0x3a, 0x44, 0x1f,
+	0x42, 0x67, 0x41, 0x12, 0x22, 0xed, 0x56, 0x1d, 0x4a, 0x9d, 0xa1, 0xfb, 0xd0, 0xa3, 0x3c, 0xc6,
+	0xbc, 0x98, 0x0c, 0xd3, 0x31, 0xc7, 0x83, 0xc3, 0x77, 0x1b, 0xee, 0xf6, 0xb8, 0x80, 0x05, 0xdd,
+	0x12, 0x3d, 0xc9, 0xd1, 0x03, 0xe8, 0x9c, 0x15, 0xf3, 0xa1, 0x47, 0xd1, 0xdd, 0x9a, 0x51, 0x39,
+	0x49, 0x81, 0x22, 0xfc, 0xd0, 0xee, 0xb5, 0xf7, 0x07, 0xee, 0x63, 0x18, 0xae, 0xa5, 0xa6, 0x4b,
+	0xf5, 0x00, 0x7a, 0xba, 0x54, 0xc2, 0x36, 0x1c, 0x73, 0x77, 0xad, 0xba, 0xaa, 0x56, 0xc2, 0x3d,
+	0x80, 0x61, 0x50, 0x8e, 0xd8, 0x76, 0x83, 0x7e, 0x86, 0x1b, 0xeb, 0xb0, 0x37, 0xf4, 0xe8, 0x00,
+	0x86, 0xdf, 0xe3, 0x05, 0x96, 0xdb, 0x65, 0x0f, 0xff, 0x31, 0xe1, 0xaa, 0x42, 0xfc, 0x14, 0xa6,
+	0xe1, 0x1c, 0x73, 0x14, 0x81, 0xa5, 0xfa, 0x15, 0xdd, 0x6a, 0x50, 0xaa, 0x19, 0xc7, 0xd1, 0xed,
+	0x4b, 0x61, 0x55, 0x4e, 0xee, 0x15, 0xf4, 0x14, 0xcc, 0x87, 0x58, 0xa2, 0x8f, 0x1b, 0x58, 0x9b,
+	0xed, 0x3c, 0x1a, 0xef, 0x06, 0x5e, 0xc4, 0x9e, 0x42, 0xbb, 0x70, 0x10, 0x7d, 0xd2, 0xc0, 0x79,
+	0xbd, 0x73, 0x47, 0xb7, 0x2e, 0x03, 0xbd, 0x10, 0x88, 0xc0, 0x52, 0x56, 0x35, 0x56, 0xa8, 0xc6,
+	0xf0, 0xd1, 0xed, 0x4b, 0x61, 0x2f, 0x44, 0x02, 0xb0, 0x94, 0x7f, 0x8d, 0x22, 0x35, 0xf6, 0x8e,
+	0x5e, 0x7f, 0x92, 0x8a, 0x47, 0xfe, 0x8f, 0x70, 0x91, 0x61, 0xf7, 0xca, 0xe4, 0x19, 0x38, 0x11,
+	0x4d, 0xbc, 0xea, 0x53, 0xa5, 0x2e, 0xea, 0x13, 0xe3, 0xe9, 0x97, 0xff, 0xfd, 0x53, 0xe6, 0x2b,
+	0xfd, 0x77, 0x66, 0x95, 0xba, 0x77, 0xff, 0x1d, 0x00, 0xc9, 0x26, 0x95, 0x93, 0xa9, 0x09, 0x00,
+	0x00,
+}
@@ -0,0 +1,433 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: github.com/piotrkowalczuk/charon/pb/rpc/charond/v1/audit.proto
+
+package charond // import "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+import ntypes "github.com/piotrkowalczuk/ntypes"
+import qtypes "github.com/piotrkowalczuk/qtypes"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+// AuditEvent describes a change made by a mutating RPC.
+// Before and after hold JSON documents with only those fields of the target that were changed.
+type AuditEvent struct {
+	Id                   int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActorId              *ntypes.Int64        `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Rpc                  string               `protobuf:"bytes,3,opt,name=rpc,proto3" json:"rpc,omitempty"`
+	TargetKind           string               `protobuf:"bytes,4,opt,name=target_kind,json=targetKind,proto3" json:"target_kind,omitempty"`
+	TargetId             *ntypes.Int64        `protobuf:"bytes,5,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Before               string               `protobuf:"bytes,6,opt,name=before,proto3" json:"before,omitempty"`
+	After                string               `protobuf:"bytes,7,opt,name=after,proto3" json:"after,omitempty"`
+	Peer                 string               `protobuf:"bytes,8,opt,name=peer,proto3" json:"peer,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *AuditEvent) Reset()         { *m = AuditEvent{} }
+func (m *AuditEvent) String() string { return proto.CompactTextString(m) }
+func (*AuditEvent) ProtoMessage()    {}
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_audit_05471fd93efcd8c9, []int{0}
+}
+func (m *AuditEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditEvent.Unmarshal(m, b)
+}
+func (m *AuditEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditEvent.Marshal(b, m, deterministic)
+}
+func (dst *AuditEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditEvent.Merge(dst, src)
+}
+func (m *AuditEvent) XXX_Size() int {
+	return xxx_messageInfo_AuditEvent.Size(m)
+}
+func (m *AuditEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditEvent proto.InternalMessageInfo
+
+func (m *AuditEvent) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *AuditEvent) GetActorId() *ntypes.Int64 {
+	if m != nil {
+		return m.ActorId
+	}
+	return nil
+}
+
+func (m *AuditEvent) GetRpc() string {
+	if m != nil {
+		return m.Rpc
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetTargetKind() string {
+	if m != nil {
+		return m.TargetKind
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetTargetId() *ntypes.Int64 {
+	if m != nil {
+		return m.TargetId
+	}
+	return nil
+}
+
+func (m *AuditEvent) GetBefore() string {
+	if m != nil {
+		return m.Before
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetAfter() string {
+	if m != nil {
+		return m.After
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetPeer() string {
+	if m != nil {
+		return m.Peer
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+type AuditEventQuery struct {
+	ActorId              *qtypes.Int64     `protobuf:"bytes,1,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Rpc                  *qtypes.String    `protobuf:"bytes,2,opt,name=rpc,proto3" json:"rpc,omitempty"`
+	TargetKind           *qtypes.String    `protobuf:"bytes,3,opt,name=target_kind,json=targetKind,proto3" json:"target_kind,omitempty"`
+	TargetId             *qtypes.Int64     `protobuf:"bytes,4,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Peer                 *qtypes.String    `protobuf:"bytes,5,opt,name=peer,proto3" json:"peer,omitempty"`
+	CreatedAt            *qtypes.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *AuditEventQuery) Reset()         { *m = AuditEventQuery{} }
+func (m *AuditEventQuery) String() string { return proto.CompactTextString(m) }
+func (*AuditEventQuery) ProtoMessage()    {}
+func (*AuditEventQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_audit_05471fd93efcd8c9, []int{1}
+}
+func (m *AuditEventQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditEventQuery.Unmarshal(m, b)
+}
+func (m *AuditEventQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditEventQuery.Marshal(b, m, deterministic)
+}
+func (dst *AuditEventQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditEventQuery.Merge(dst, src)
+}
+func (m *AuditEventQuery) XXX_Size() int {
+	return xxx_messageInfo_AuditEventQuery.Size(m)
+}
+func (m *AuditEventQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditEventQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditEventQuery proto.InternalMessageInfo
+
+func (m *AuditEventQuery) GetActorId() *qtypes.Int64 {
+	if m != nil {
+		return m.ActorId
+	}
+	return nil
+}
+
+func (m *AuditEventQuery) GetRpc() *qtypes.String {
+	if m != nil {
+		return m.Rpc
+	}
+	return nil
+}
+
+func (m *AuditEventQuery) GetTargetKind() *qtypes.String {
+	if m != nil {
+		return m.TargetKind
+	}
+	return nil
+}
+
+func (m *AuditEventQuery) GetTargetId() *qtypes.Int64 {
+	if m != nil {
+		return m.TargetId
+	}
+	return nil
+}
+
+func (m *AuditEventQuery) GetPeer() *qtypes.String {
+	if m != nil {
+		return m.Peer
+	}
+	return nil
+}
+
+func (m *AuditEventQuery) GetCreatedAt() *qtypes.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+type ListAuditEventsRequest struct {
+	Offset               *ntypes.Int64    `protobuf:"bytes,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                *ntypes.Int64    `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	OrderBy              []*Order         `protobuf:"bytes,3,rep,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Query                *AuditEventQuery `protobuf:"bytes,11,opt,name=query,proto3" json:"query,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListAuditEventsRequest) Reset()         { *m = ListAuditEventsRequest{} }
+func (m *ListAuditEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAuditEventsRequest) ProtoMessage()    {}
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_audit_05471fd93efcd8c9, []int{2}
+}
+func (m *ListAuditEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAuditEventsRequest.Unmarshal(m, b)
+}
+func (m *ListAuditEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAuditEventsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListAuditEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAuditEventsRequest.Merge(dst, src)
+}
+func (m *ListAuditEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListAuditEventsRequest.Size(m)
+}
+func (m *ListAuditEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAuditEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAuditEventsRequest proto.InternalMessageInfo
+
+func (m *ListAuditEventsRequest) GetOffset() *ntypes.Int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return nil
+}
+
+func (m *ListAuditEventsRequest) GetLimit() *ntypes.Int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return nil
+}
+
+func (m *ListAuditEventsRequest) GetOrderBy() []*Order {
+	if m != nil {
+		return m.OrderBy
+	}
+	return nil
+}
+
+func (m *ListAuditEventsRequest) GetQuery() *AuditEventQuery {
+	if m != nil {
+		return m.Query
+	}
+	return nil
+}
+
+type ListAuditEventsResponse struct {
+	AuditEvents          []*AuditEvent `protobuf:"bytes,1,rep,name=audit_events,json=auditEvents,proto3" json:"audit_events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ListAuditEventsResponse) Reset()         { *m = ListAuditEventsResponse{} }
+func (m *ListAuditEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListAuditEventsResponse) ProtoMessage()    {}
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_audit_05471fd93efcd8c9, []int{3}
+}
+func (m *ListAuditEventsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAuditEventsResponse.Unmarshal(m, b)
+}
+func (m *ListAuditEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAuditEventsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListAuditEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAuditEventsResponse.Merge(dst, src)
+}
+func (m *ListAuditEventsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListAuditEventsResponse.Size(m)
+}
+func (m *ListAuditEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAuditEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAuditEventsResponse proto.InternalMessageInfo
+
+func (m *ListAuditEventsResponse) GetAuditEvents() []*AuditEvent {
+	if m != nil {
+		return m.AuditEvents
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*AuditEvent)(nil), "charon.rpc.charond.v1.AuditEvent")
+	proto.RegisterType((*AuditEventQuery)(nil), "charon.rpc.charond.v1.AuditEventQuery")
+	proto.RegisterType((*ListAuditEventsRequest)(nil), "charon.rpc.charond.v1.ListAuditEventsRequest")
+	proto.RegisterType((*ListAuditEventsResponse)(nil), "charon.rpc.charond.v1.ListAuditEventsResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// AuditManagerClient is the client API for AuditManager service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AuditManagerClient interface {
+	List(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+}
+
+type auditManagerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAuditManagerClient(cc *grpc.ClientConn) AuditManagerClient {
+	return &auditManagerClient{cc}
+}
+
+func (c *auditManagerClient) List(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.AuditManager/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuditManagerServer is the server API for AuditManager service.
+type AuditManagerServer interface {
+	List(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+}
+
+func RegisterAuditManagerServer(s *grpc.Server, srv AuditManagerServer) {
+	s.RegisterService(&_AuditManager_serviceDesc, srv)
+}
+
+func _AuditManager_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditManagerServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/charon.rpc.charond.v1.AuditManager/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditManagerServer).List(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AuditManager_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "charon.rpc.charond.v1.AuditManager",
+	HandlerType: (*AuditManagerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _AuditManager_List_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1/audit.proto",
+}
+
+func init() {
+	proto.RegisterFile("github.com/piotrkowalczuk/charon/pb/rpc/charond/v1/audit.proto", fileDescriptor_audit_05471fd93efcd8c9)
+}
+
+var fileDescriptor_audit_05471fd93efcd8c9 = []byte{
+	// 583 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xed, 0x6e, 0xd3, 0x3c,
+	0x14, 0xc7, 0x9f, 0xb4, 0x69, 0xd7, 0x9e, 0xec, 0x19, 0xc3, 0xc0, 0xb0, 0x2a, 0xa4, 0x85, 0x22,
+	0x50, 0x85, 0x84, 0xc3, 0x06, 0x02, 0xf1, 0x22, 0xd0, 0x26, 0xf8, 0x30, 0x5e, 0x04, 0x04, 0x3e,
+	0xf1, 0x25, 0x38, 0xb1, 0x9b, 0x59, 0x5b, 0xe2, 0xd4, 0x71, 0x36, 0x95, 0xab, 0xe0, 0x1a, 0xb8,
+	0x33, 0xee, 0x04, 0xd5, 0x4e, 0x54, 0x06, 0xa9, 0x78, 0xf9, 0x54, 0xfb, 0x7f, 0xfe, 0xe7, 0x1c,
+	0xf7, 0x77, 0x62, 0xc3, 0x93, 0x54, 0xe8, 0xc3, 0x2a, 0x26, 0x89, 0xcc, 0x82, 0x42, 0x48, 0xad,
+	0x8e, 0xe4, 0x29, 0x3d, 0x4e, 0x3e, 0x57, 0x47, 0x41, 0x72, 0x48, 0x95, 0xcc, 0x83, 0x22, 0x0e,
+	0x54, 0x91, 0xd4, 0x3b, 0x16, 0x9c, 0xec, 0x04, 0xb4, 0x62, 0x42, 0x93, 0x42, 0x49, 0x2d, 0xd1,
+	0x25, 0xab, 0x13, 0x55, 0x24, 0xa4, 0xb6, 0x90, 0x93, 0x9d, 0xd1, 0x76, 0x2a, 0x65, 0x7a, 0xcc,
+	0x03, 0x63, 0x8a, 0xab, 0x69, 0xa0, 0x45, 0xc6, 0x4b, 0x4d, 0xb3, 0xc2, 0xe6, 0x8d, 0x9e, 0xfe,
+	0x43, 0xdf, 0x44, 0x66, 0x99, 0xcc, 0xeb, 0x02, 0x17, 0x66, 0x7a, 0x5e, 0xf0, 0x32, 0xb0, 0x3f,
+	0x8d, 0x98, 0x5b, 0x31, 0xff, 0x41, 0x1c, 0x7f, 0xed, 0x00, 0xec, 0x2d, 0x8e, 0xfc, 0xfc, 0x84,
+	0xe7, 0x1a, 0x6d, 0x40, 0x47, 0x30, 0xec, 0xf8, 0xce, 0xa4, 0x1b, 0x76, 0x04, 0x43, 0x13, 0x18,
+	0xd0, 0x44, 0x4b, 0x15, 0x09, 0x86, 0x3b, 0xbe, 0x33, 0xf1, 0x76, 0xff, 0x27, 0x75, 0xfe, 0x41,
+	0xae, 0xef, 0xdd, 0x0d, 0xd7, 0x4c, 0xf8, 0x80, 0xa1, 0x4d, 0xe8, 0xaa, 0x22, 0xc1, 0x5d, 0xdf,
+	0x99, 0x0c, 0xc3, 0xc5, 0x12, 0x6d, 0x83, 0xa7, 0xa9, 0x4a, 0xb9, 0x8e, 0x8e, 0x44, 0xce, 0xb0,
+	0x6b, 0x22, 0x60, 0xa5, 0x97, 0x22, 0x67, 0xe8, 0x26, 0x0c, 0x6b, 0x83, 0x60, 0xb8, 0xd7, 0x56,
+	0x7d, 0x60, 0xe3, 0x07, 0x0c, 0x6d, 0x41, 0x3f, 0xe6, 0x53, 0xa9, 0x38, 0xee, 0x9b, 0x3a, 0xf5,
+	0x0e, 0x5d, 0x84, 0x1e, 0x9d, 0x6a, 0xae, 0xf0, 0x9a, 0x91, 0xed, 0x06, 0x21, 0x70, 0x0b, 0xce,
+	0x15, 0x1e, 0x18, 0xd1, 0xac, 0xd1, 0x03, 0x80, 0x44, 0x71, 0xaa, 0x39, 0x8b, 0xa8, 0xc6, 0x43,
+	0xd3, 0x6e, 0x44, 0xec, 0x28, 0x48, 0x33, 0x0a, 0xf2, 0xa1, 0x19, 0x45, 0x38, 0xac, 0xdd, 0x7b,
+	0x7a, 0xfc, 0xa5, 0x03, 0xe7, 0x96, 0x90, 0xde, 0x55, 0x5c, 0xcd, 0xcf, 0x90, 0x71, 0xea, 0xb3,
+	0xcf, 0x5a, 0xc9, 0xf8, 0x96, 0x8c, 0xc5, 0xb7, 0xd1, 0x98, 0xde, 0x6b, 0x25, 0xf2, 0xd4, 0x92,
+	0x0a, 0xce, 0x92, 0xea, 0xb6, 0x3a, 0x57, 0x92, 0x73, 0xdb, 0xba, 0x2f, 0xc9, 0x8d, 0x6b, 0x16,
+	0xbd, 0xd6, 0xaa, 0x96, 0xcd, 0xed, 0x33, 0x6c, 0xfa, 0xc6, 0x79, 0xbe, 0x71, 0xb6, 0x22, 0xf9,
+	0xe6, 0xc0, 0xd6, 0x2b, 0x51, 0xea, 0x25, 0x96, 0x32, 0xe4, 0xb3, 0x8a, 0x97, 0x1a, 0x5d, 0x87,
+	0xbe, 0x9c, 0x4e, 0x4b, 0xae, 0xb1, 0xd3, 0x36, 0xd3, 0x3a, 0x88, 0xae, 0x41, 0xef, 0x58, 0x64,
+	0x42, 0xb7, 0x7f, 0x57, 0x36, 0x86, 0xee, 0xc3, 0x40, 0x2a, 0xc6, 0x55, 0x14, 0xcf, 0x71, 0xd7,
+	0xef, 0x4e, 0xbc, 0xdd, 0x2b, 0xa4, 0xf5, 0x52, 0x91, 0x37, 0x0b, 0x5b, 0xb8, 0x66, 0xdc, 0xfb,
+	0x73, 0xf4, 0x18, 0x7a, 0xb3, 0xc5, 0x9c, 0xb0, 0x67, 0xaa, 0xdf, 0x58, 0x91, 0xf5, 0xd3, 0x54,
+	0x43, 0x9b, 0xf4, 0xc2, 0x1d, 0xb8, 0x9b, 0xde, 0x38, 0x82, 0xcb, 0xbf, 0xfc, 0xc5, 0xb2, 0x90,
+	0x79, 0xc9, 0xd1, 0x33, 0x58, 0x37, 0x17, 0x3d, 0xe2, 0x46, 0xc7, 0x8e, 0x39, 0xdb, 0xd5, 0xdf,
+	0x76, 0x09, 0x3d, 0xba, 0xac, 0xb6, 0x7b, 0x0a, 0xeb, 0x26, 0xf4, 0x9a, 0xe6, 0x34, 0xe5, 0x0a,
+	0xa5, 0xe0, 0x2e, 0x1a, 0xa2, 0x5b, 0x2b, 0xea, 0xb4, 0x03, 0x1f, 0x91, 0x3f, 0xb5, 0xdb, 0xc3,
+	0x8f, 0xff, 0xdb, 0xff, 0x04, 0x7e, 0x22, 0x33, 0xd2, 0x3c, 0x33, 0x6d, 0xd9, 0x6f, 0x9d, 0x8f,
+	0x0f, 0xff, 0xfe, 0x19, 0x7a, 0x54, 0x2f, 0xe3, 0xbe, 0xb9, 0x51, 0x77, 0xbe, 0x0f, 0x00, 0x32,
+	0x1f, 0xc4, 0x18, 0x43, 0x05, 0x00, 0x00,
+}
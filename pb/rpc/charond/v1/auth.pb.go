@@ -25,6 +25,46 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type WatchEvent_Kind int32
+
+const (
+	WatchEvent_UNKNOWN WatchEvent_Kind = 0
+	// Permissions granted or denied to the user, directly or through a group, have changed.
+	WatchEvent_PERMISSIONS WatchEvent_Kind = 1
+	// Groups the user belongs to have changed.
+	WatchEvent_GROUPS WatchEvent_Kind = 2
+	// Active, superuser, staff or confirmed flag has changed, or the user was locked, unlocked, deleted or restored.
+	WatchEvent_FLAGS WatchEvent_Kind = 3
+	// One of user refresh tokens has been revoked.
+	WatchEvent_REFRESH_TOKEN_REVOKED WatchEvent_Kind = 4
+	// One of user API keys has been revoked or deleted.
+	WatchEvent_API_KEY_REVOKED WatchEvent_Kind = 5
+)
+
+var WatchEvent_Kind_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "PERMISSIONS",
+	2: "GROUPS",
+	3: "FLAGS",
+	4: "REFRESH_TOKEN_REVOKED",
+	5: "API_KEY_REVOKED",
+}
+var WatchEvent_Kind_value = map[string]int32{
+	"UNKNOWN":               0,
+	"PERMISSIONS":           1,
+	"GROUPS":                2,
+	"FLAGS":                 3,
+	"REFRESH_TOKEN_REVOKED": 4,
+	"API_KEY_REVOKED":       5,
+}
+
+func (x WatchEvent_Kind) String() string {
+	return proto.EnumName(WatchEvent_Kind_name, int32(x))
+}
+func (WatchEvent_Kind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_auth_2ba2a296f43f550f, []int{9, 0}
+}
+
 type LoginRequest struct {
 	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"` // Deprecated: Do not use.
 	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"` // Deprecated: Do not use.
@@ -32,6 +72,9 @@ type LoginRequest struct {
 	// Types that are valid to be assigned to Strategy:
 	//	*LoginRequest_UsernameAndPassword
 	//	*LoginRequest_RefreshToken
+	//	*LoginRequest_Totp
+	//	*LoginRequest_IdToken
+	//	*LoginRequest_ApiKey
 	Strategy             isLoginRequest_Strategy `protobuf_oneof:"strategy"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
 	XXX_unrecognized     []byte                  `json:"-"`
@@ -42,7 +85,7 @@ func (m *LoginRequest) Reset()         { *m = LoginRequest{} }
 func (m *LoginRequest) String() string { return proto.CompactTextString(m) }
 func (*LoginRequest) ProtoMessage()    {}
 func (*LoginRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_auth_e4bcd340412162f2, []int{0}
+	return fileDescriptor_auth_2ba2a296f43f550f, []int{0}
 }
 func (m *LoginRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LoginRequest.Unmarshal(m, b)
@@ -97,10 +140,28 @@ type LoginRequest_RefreshToken struct {
 	RefreshToken *RefreshTokenStrategy `protobuf:"bytes,12,opt,name=refresh_token,json=refreshToken,proto3,oneof"`
 }
 
+type LoginRequest_Totp struct {
+	Totp *TOTPStrategy `protobuf:"bytes,20,opt,name=totp,proto3,oneof"`
+}
+
+type LoginRequest_IdToken struct {
+	IdToken *IDTokenStrategy `protobuf:"bytes,21,opt,name=id_token,json=idToken,proto3,oneof"`
+}
+
+type LoginRequest_ApiKey struct {
+	ApiKey *APIKeyStrategy `protobuf:"bytes,22,opt,name=api_key,json=apiKey,proto3,oneof"`
+}
+
 func (*LoginRequest_UsernameAndPassword) isLoginRequest_Strategy() {}
 
 func (*LoginRequest_RefreshToken) isLoginRequest_Strategy() {}
 
+func (*LoginRequest_Totp) isLoginRequest_Strategy() {}
+
+func (*LoginRequest_IdToken) isLoginRequest_Strategy() {}
+
+func (*LoginRequest_ApiKey) isLoginRequest_Strategy() {}
+
 func (m *LoginRequest) GetStrategy() isLoginRequest_Strategy {
 	if m != nil {
 		return m.Strategy
@@ -122,11 +183,35 @@ func (m *LoginRequest) GetRefreshToken() *RefreshTokenStrategy {
 	return nil
 }
 
+func (m *LoginRequest) GetTotp() *TOTPStrategy {
+	if x, ok := m.GetStrategy().(*LoginRequest_Totp); ok {
+		return x.Totp
+	}
+	return nil
+}
+
+func (m *LoginRequest) GetIdToken() *IDTokenStrategy {
+	if x, ok := m.GetStrategy().(*LoginRequest_IdToken); ok {
+		return x.IdToken
+	}
+	return nil
+}
+
+func (m *LoginRequest) GetApiKey() *APIKeyStrategy {
+	if x, ok := m.GetStrategy().(*LoginRequest_ApiKey); ok {
+		return x.ApiKey
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*LoginRequest) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _LoginRequest_OneofMarshaler, _LoginRequest_OneofUnmarshaler, _LoginRequest_OneofSizer, []interface{}{
 		(*LoginRequest_UsernameAndPassword)(nil),
 		(*LoginRequest_RefreshToken)(nil),
+		(*LoginRequest_Totp)(nil),
+		(*LoginRequest_IdToken)(nil),
+		(*LoginRequest_ApiKey)(nil),
 	}
 }
 
@@ -144,6 +229,21 @@ func _LoginRequest_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.RefreshToken); err != nil {
 			return err
 		}
+	case *LoginRequest_Totp:
+		b.EncodeVarint(20<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Totp); err != nil {
+			return err
+		}
+	case *LoginRequest_IdToken:
+		b.EncodeVarint(21<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.IdToken); err != nil {
+			return err
+		}
+	case *LoginRequest_ApiKey:
+		b.EncodeVarint(22<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ApiKey); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("LoginRequest.Strategy has unexpected type %T", x)
@@ -170,6 +270,30 @@ func _LoginRequest_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.B
 		err := b.DecodeMessage(msg)
 		m.Strategy = &LoginRequest_RefreshToken{msg}
 		return true, err
+	case 20: // strategy.totp
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(TOTPStrategy)
+		err := b.DecodeMessage(msg)
+		m.Strategy = &LoginRequest_Totp{msg}
+		return true, err
+	case 21: // strategy.id_token
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(IDTokenStrategy)
+		err := b.DecodeMessage(msg)
+		m.Strategy = &LoginRequest_IdToken{msg}
+		return true, err
+	case 22: // strategy.api_key
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(APIKeyStrategy)
+		err := b.DecodeMessage(msg)
+		m.Strategy = &LoginRequest_ApiKey{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -189,6 +313,21 @@ func _LoginRequest_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *LoginRequest_Totp:
+		s := proto.Size(x.Totp)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *LoginRequest_IdToken:
+		s := proto.Size(x.IdToken)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *LoginRequest_ApiKey:
+		s := proto.Size(x.ApiKey)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -207,7 +346,7 @@ func (m *LogoutRequest) Reset()         { *m = LogoutRequest{} }
 func (m *LogoutRequest) String() string { return proto.CompactTextString(m) }
 func (*LogoutRequest) ProtoMessage()    {}
 func (*LogoutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_auth_e4bcd340412162f2, []int{1}
+	return fileDescriptor_auth_2ba2a296f43f550f, []int{1}
 }
 func (m *LogoutRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LogoutRequest.Unmarshal(m, b)
@@ -245,7 +384,7 @@ func (m *IsAuthenticatedRequest) Reset()         { *m = IsAuthenticatedRequest{}
 func (m *IsAuthenticatedRequest) String() string { return proto.CompactTextString(m) }
 func (*IsAuthenticatedRequest) ProtoMessage()    {}
 func (*IsAuthenticatedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_auth_e4bcd340412162f2, []int{2}
+	return fileDescriptor_auth_2ba2a296f43f550f, []int{2}
 }
 func (m *IsAuthenticatedRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IsAuthenticatedRequest.Unmarshal(m, b)
@@ -273,18 +412,21 @@ func (m *IsAuthenticatedRequest) GetAccessToken() string {
 }
 
 type IsGrantedRequest struct {
-	UserId               int64    `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Permission           string   `protobuf:"bytes,2,opt,name=permission,proto3" json:"permission,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	UserId     int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Permission string `protobuf:"bytes,2,opt,name=permission,proto3" json:"permission,omitempty"`
+	// Resource narrows the check down to a single object.
+	// Permission granted without a resource covers all of them.
+	Resource             *Resource `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
 func (m *IsGrantedRequest) Reset()         { *m = IsGrantedRequest{} }
 func (m *IsGrantedRequest) String() string { return proto.CompactTextString(m) }
 func (*IsGrantedRequest) ProtoMessage()    {}
 func (*IsGrantedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_auth_e4bcd340412162f2, []int{3}
+	return fileDescriptor_auth_2ba2a296f43f550f, []int{3}
 }
 func (m *IsGrantedRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IsGrantedRequest.Unmarshal(m, b)
@@ -318,6 +460,340 @@ func (m *IsGrantedRequest) GetPermission() string {
 	return ""
 }
 
+func (m *IsGrantedRequest) GetResource() *Resource {
+	if m != nil {
+		return m.Resource
+	}
+	return nil
+}
+
+type IsGrantedBatchRequest struct {
+	UserId      int64    `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Permissions []string `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	// User ids are checked against the same permissions, in addition to the user id.
+	UserIds              []int64  `protobuf:"varint,3,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IsGrantedBatchRequest) Reset()         { *m = IsGrantedBatchRequest{} }
+func (m *IsGrantedBatchRequest) String() string { return proto.CompactTextString(m) }
+func (*IsGrantedBatchRequest) ProtoMessage()    {}
+func (*IsGrantedBatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_auth_2ba2a296f43f550f, []int{4}
+}
+func (m *IsGrantedBatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IsGrantedBatchRequest.Unmarshal(m, b)
+}
+func (m *IsGrantedBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IsGrantedBatchRequest.Marshal(b, m, deterministic)
+}
+func (dst *IsGrantedBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IsGrantedBatchRequest.Merge(dst, src)
+}
+func (m *IsGrantedBatchRequest) XXX_Size() int {
+	return xxx_messageInfo_IsGrantedBatchRequest.Size(m)
+}
+func (m *IsGrantedBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IsGrantedBatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IsGrantedBatchRequest proto.InternalMessageInfo
+
+func (m *IsGrantedBatchRequest) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *IsGrantedBatchRequest) GetPermissions() []string {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+func (m *IsGrantedBatchRequest) GetUserIds() []int64 {
+	if m != nil {
+		return m.UserIds
+	}
+	return nil
+}
+
+type IsGrantedBatchResponse struct {
+	// Granted holds results of the user id, keyed by permission.
+	Granted map[string]bool `protobuf:"bytes,1,rep,name=granted,proto3" json:"granted,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// Users holds results of every user, keyed by user id.
+	Users                map[int64]*IsGrantedBatchResponse_Grants `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                                 `json:"-"`
+	XXX_unrecognized     []byte                                   `json:"-"`
+	XXX_sizecache        int32                                    `json:"-"`
+}
+
+func (m *IsGrantedBatchResponse) Reset()         { *m = IsGrantedBatchResponse{} }
+func (m *IsGrantedBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*IsGrantedBatchResponse) ProtoMessage()    {}
+func (*IsGrantedBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_auth_2ba2a296f43f550f, []int{5}
+}
+func (m *IsGrantedBatchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IsGrantedBatchResponse.Unmarshal(m, b)
+}
+func (m *IsGrantedBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IsGrantedBatchResponse.Marshal(b, m, deterministic)
+}
+func (dst *IsGrantedBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IsGrantedBatchResponse.Merge(dst, src)
+}
+func (m *IsGrantedBatchResponse) XXX_Size() int {
+	return xxx_messageInfo_IsGrantedBatchResponse.Size(m)
+}
+func (m *IsGrantedBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_IsGrantedBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IsGrantedBatchResponse proto.InternalMessageInfo
+
+func (m *IsGrantedBatchResponse) GetGranted() map[string]bool {
+	if m != nil {
+		return m.Granted
+	}
+	return nil
+}
+
+func (m *IsGrantedBatchResponse) GetUsers() map[int64]*IsGrantedBatchResponse_Grants {
+	if m != nil {
+		return m.Users
+	}
+	return nil
+}
+
+type IsGrantedBatchResponse_Grants struct {
+	Granted              map[string]bool `protobuf:"bytes,1,rep,name=granted,proto3" json:"granted,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *IsGrantedBatchResponse_Grants) Reset()         { *m = IsGrantedBatchResponse_Grants{} }
+func (m *IsGrantedBatchResponse_Grants) String() string { return proto.CompactTextString(m) }
+func (*IsGrantedBatchResponse_Grants) ProtoMessage()    {}
+func (*IsGrantedBatchResponse_Grants) Descriptor() ([]byte, []int) {
+	return fileDescriptor_auth_2ba2a296f43f550f, []int{5, 0}
+}
+func (m *IsGrantedBatchResponse_Grants) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IsGrantedBatchResponse_Grants.Unmarshal(m, b)
+}
+func (m *IsGrantedBatchResponse_Grants) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IsGrantedBatchResponse_Grants.Marshal(b, m, deterministic)
+}
+func (dst *IsGrantedBatchResponse_Grants) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IsGrantedBatchResponse_Grants.Merge(dst, src)
+}
+func (m *IsGrantedBatchResponse_Grants) XXX_Size() int {
+	return xxx_messageInfo_IsGrantedBatchResponse_Grants.Size(m)
+}
+func (m *IsGrantedBatchResponse_Grants) XXX_DiscardUnknown() {
+	xxx_messageInfo_IsGrantedBatchResponse_Grants.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IsGrantedBatchResponse_Grants proto.InternalMessageInfo
+
+func (m *IsGrantedBatchResponse_Grants) GetGranted() map[string]bool {
+	if m != nil {
+		return m.Granted
+	}
+	return nil
+}
+
+type ListResourcesRequest struct {
+	UserId               int64    `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Permission           string   `protobuf:"bytes,2,opt,name=permission,proto3" json:"permission,omitempty"`
+	ResourceType         string   `protobuf:"bytes,3,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListResourcesRequest) Reset()         { *m = ListResourcesRequest{} }
+func (m *ListResourcesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListResourcesRequest) ProtoMessage()    {}
+func (*ListResourcesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_auth_2ba2a296f43f550f, []int{6}
+}
+func (m *ListResourcesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListResourcesRequest.Unmarshal(m, b)
+}
+func (m *ListResourcesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListResourcesRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListResourcesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListResourcesRequest.Merge(dst, src)
+}
+func (m *ListResourcesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListResourcesRequest.Size(m)
+}
+func (m *ListResourcesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListResourcesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListResourcesRequest proto.InternalMessageInfo
+
+func (m *ListResourcesRequest) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *ListResourcesRequest) GetPermission() string {
+	if m != nil {
+		return m.Permission
+	}
+	return ""
+}
+
+func (m *ListResourcesRequest) GetResourceType() string {
+	if m != nil {
+		return m.ResourceType
+	}
+	return ""
+}
+
+type ListResourcesResponse struct {
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// All is true if permission is granted regardless of a resource.
+	All                  bool     `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListResourcesResponse) Reset()         { *m = ListResourcesResponse{} }
+func (m *ListResourcesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListResourcesResponse) ProtoMessage()    {}
+func (*ListResourcesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_auth_2ba2a296f43f550f, []int{7}
+}
+func (m *ListResourcesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListResourcesResponse.Unmarshal(m, b)
+}
+func (m *ListResourcesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListResourcesResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListResourcesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListResourcesResponse.Merge(dst, src)
+}
+func (m *ListResourcesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListResourcesResponse.Size(m)
+}
+func (m *ListResourcesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListResourcesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListResourcesResponse proto.InternalMessageInfo
+
+func (m *ListResourcesResponse) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+func (m *ListResourcesResponse) GetAll() bool {
+	if m != nil {
+		return m.All
+	}
+	return false
+}
+
+type WatchRequest struct {
+	// User ids to watch, the actor itself is watched if empty.
+	UserIds              []int64  `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchRequest) Reset()         { *m = WatchRequest{} }
+func (m *WatchRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchRequest) ProtoMessage()    {}
+func (*WatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_auth_2ba2a296f43f550f, []int{8}
+}
+func (m *WatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchRequest.Unmarshal(m, b)
+}
+func (m *WatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchRequest.Marshal(b, m, deterministic)
+}
+func (dst *WatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchRequest.Merge(dst, src)
+}
+func (m *WatchRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchRequest.Size(m)
+}
+func (m *WatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchRequest proto.InternalMessageInfo
+
+func (m *WatchRequest) GetUserIds() []int64 {
+	if m != nil {
+		return m.UserIds
+	}
+	return nil
+}
+
+type WatchEvent struct {
+	UserId               int64           `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Kind                 WatchEvent_Kind `protobuf:"varint,2,opt,name=kind,proto3,enum=charon.rpc.charond.v1.WatchEvent_Kind" json:"kind,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *WatchEvent) Reset()         { *m = WatchEvent{} }
+func (m *WatchEvent) String() string { return proto.CompactTextString(m) }
+func (*WatchEvent) ProtoMessage()    {}
+func (*WatchEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_auth_2ba2a296f43f550f, []int{9}
+}
+func (m *WatchEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchEvent.Unmarshal(m, b)
+}
+func (m *WatchEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchEvent.Marshal(b, m, deterministic)
+}
+func (dst *WatchEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchEvent.Merge(dst, src)
+}
+func (m *WatchEvent) XXX_Size() int {
+	return xxx_messageInfo_WatchEvent.Size(m)
+}
+func (m *WatchEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchEvent proto.InternalMessageInfo
+
+func (m *WatchEvent) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *WatchEvent) GetKind() WatchEvent_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return WatchEvent_UNKNOWN
+}
+
 type BelongsToRequest struct {
 	UserId               int64    `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	GroupId              int64    `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
@@ -330,7 +806,7 @@ func (m *BelongsToRequest) Reset()         { *m = BelongsToRequest{} }
 func (m *BelongsToRequest) String() string { return proto.CompactTextString(m) }
 func (*BelongsToRequest) ProtoMessage()    {}
 func (*BelongsToRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_auth_e4bcd340412162f2, []int{4}
+	return fileDescriptor_auth_2ba2a296f43f550f, []int{10}
 }
 func (m *BelongsToRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BelongsToRequest.Unmarshal(m, b)
@@ -365,10 +841,11 @@ func (m *BelongsToRequest) GetGroupId() int64 {
 }
 
 type ActorResponse struct {
-	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Username             string   `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	FirstName            string   `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName             string   `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Id        int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username  string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	FirstName string `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName  string `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	// Permissions explicitly denied to the actor are prefixed with "!".
 	Permissions          []string `protobuf:"bytes,5,rep,name=permissions,proto3" json:"permissions,omitempty"`
 	IsSuperuser          bool     `protobuf:"varint,6,opt,name=is_superuser,json=isSuperuser,proto3" json:"is_superuser,omitempty"`
 	IsActive             bool     `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
@@ -384,7 +861,7 @@ func (m *ActorResponse) Reset()         { *m = ActorResponse{} }
 func (m *ActorResponse) String() string { return proto.CompactTextString(m) }
 func (*ActorResponse) ProtoMessage()    {}
 func (*ActorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_auth_e4bcd340412162f2, []int{5}
+	return fileDescriptor_auth_2ba2a296f43f550f, []int{11}
 }
 func (m *ActorResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ActorResponse.Unmarshal(m, b)
@@ -487,7 +964,7 @@ func (m *UsernameAndPasswordStrategy) Reset()         { *m = UsernameAndPassword
 func (m *UsernameAndPasswordStrategy) String() string { return proto.CompactTextString(m) }
 func (*UsernameAndPasswordStrategy) ProtoMessage()    {}
 func (*UsernameAndPasswordStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_auth_e4bcd340412162f2, []int{6}
+	return fileDescriptor_auth_2ba2a296f43f550f, []int{12}
 }
 func (m *UsernameAndPasswordStrategy) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UsernameAndPasswordStrategy.Unmarshal(m, b)
@@ -521,6 +998,9 @@ func (m *UsernameAndPasswordStrategy) GetPassword() string {
 	return ""
 }
 
+// RefreshTokenStrategy authenticates owner of the token, if it is neither revoked nor expired.
+// If rotation is enabled, the token can be used only once. Its successor is sent back in charon-refresh-token header.
+// Token used again revokes all tokens of its family, since it means that one of them has leaked.
 type RefreshTokenStrategy struct {
 	RefreshToken         string   `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -532,7 +1012,7 @@ func (m *RefreshTokenStrategy) Reset()         { *m = RefreshTokenStrategy{} }
 func (m *RefreshTokenStrategy) String() string { return proto.CompactTextString(m) }
 func (*RefreshTokenStrategy) ProtoMessage()    {}
 func (*RefreshTokenStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_auth_e4bcd340412162f2, []int{7}
+	return fileDescriptor_auth_2ba2a296f43f550f, []int{13}
 }
 func (m *RefreshTokenStrategy) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RefreshTokenStrategy.Unmarshal(m, b)
@@ -559,15 +1039,198 @@ func (m *RefreshTokenStrategy) GetRefreshToken() string {
 	return ""
 }
 
+// TOTPStrategy completes login started with UsernameAndPasswordStrategy for users with two-factor authentication enabled.
+// Code can be either a time-based one-time password or one of recovery codes.
+type TOTPStrategy struct {
+	Challenge            string   `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	Code                 string   `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TOTPStrategy) Reset()         { *m = TOTPStrategy{} }
+func (m *TOTPStrategy) String() string { return proto.CompactTextString(m) }
+func (*TOTPStrategy) ProtoMessage()    {}
+func (*TOTPStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_auth_2ba2a296f43f550f, []int{14}
+}
+func (m *TOTPStrategy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TOTPStrategy.Unmarshal(m, b)
+}
+func (m *TOTPStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TOTPStrategy.Marshal(b, m, deterministic)
+}
+func (dst *TOTPStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TOTPStrategy.Merge(dst, src)
+}
+func (m *TOTPStrategy) XXX_Size() int {
+	return xxx_messageInfo_TOTPStrategy.Size(m)
+}
+func (m *TOTPStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_TOTPStrategy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TOTPStrategy proto.InternalMessageInfo
+
+func (m *TOTPStrategy) GetChallenge() string {
+	if m != nil {
+		return m.Challenge
+	}
+	return ""
+}
+
+func (m *TOTPStrategy) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+// IDTokenStrategy authenticates a user with an OpenID Connect ID token issued by one of configured upstream identity providers.
+// The token is matched against identities linked to users, unknown identities can be provisioned automatically.
+type IDTokenStrategy struct {
+	IdToken              string   `protobuf:"bytes,1,opt,name=id_token,json=idToken,proto3" json:"id_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IDTokenStrategy) Reset()         { *m = IDTokenStrategy{} }
+func (m *IDTokenStrategy) String() string { return proto.CompactTextString(m) }
+func (*IDTokenStrategy) ProtoMessage()    {}
+func (*IDTokenStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_auth_2ba2a296f43f550f, []int{15}
+}
+func (m *IDTokenStrategy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IDTokenStrategy.Unmarshal(m, b)
+}
+func (m *IDTokenStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IDTokenStrategy.Marshal(b, m, deterministic)
+}
+func (dst *IDTokenStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IDTokenStrategy.Merge(dst, src)
+}
+func (m *IDTokenStrategy) XXX_Size() int {
+	return xxx_messageInfo_IDTokenStrategy.Size(m)
+}
+func (m *IDTokenStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_IDTokenStrategy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IDTokenStrategy proto.InternalMessageInfo
+
+func (m *IDTokenStrategy) GetIdToken() string {
+	if m != nil {
+		return m.IdToken
+	}
+	return ""
+}
+
+// APIKeyStrategy authenticates owner of the key, usually a service account.
+// Session is restricted to permissions of the key that the owner is granted as well.
+type APIKeyStrategy struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *APIKeyStrategy) Reset()         { *m = APIKeyStrategy{} }
+func (m *APIKeyStrategy) String() string { return proto.CompactTextString(m) }
+func (*APIKeyStrategy) ProtoMessage()    {}
+func (*APIKeyStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_auth_2ba2a296f43f550f, []int{16}
+}
+func (m *APIKeyStrategy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_APIKeyStrategy.Unmarshal(m, b)
+}
+func (m *APIKeyStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_APIKeyStrategy.Marshal(b, m, deterministic)
+}
+func (dst *APIKeyStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_APIKeyStrategy.Merge(dst, src)
+}
+func (m *APIKeyStrategy) XXX_Size() int {
+	return xxx_messageInfo_APIKeyStrategy.Size(m)
+}
+func (m *APIKeyStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_APIKeyStrategy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_APIKeyStrategy proto.InternalMessageInfo
+
+func (m *APIKeyStrategy) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+// SecondFactorRequired is attached as a detail of Unauthenticated error returned by Login,
+// if the user has two-factor authentication enabled.
+type SecondFactorRequired struct {
+	Challenge            string   `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SecondFactorRequired) Reset()         { *m = SecondFactorRequired{} }
+func (m *SecondFactorRequired) String() string { return proto.CompactTextString(m) }
+func (*SecondFactorRequired) ProtoMessage()    {}
+func (*SecondFactorRequired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_auth_2ba2a296f43f550f, []int{17}
+}
+func (m *SecondFactorRequired) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SecondFactorRequired.Unmarshal(m, b)
+}
+func (m *SecondFactorRequired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SecondFactorRequired.Marshal(b, m, deterministic)
+}
+func (dst *SecondFactorRequired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecondFactorRequired.Merge(dst, src)
+}
+func (m *SecondFactorRequired) XXX_Size() int {
+	return xxx_messageInfo_SecondFactorRequired.Size(m)
+}
+func (m *SecondFactorRequired) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecondFactorRequired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SecondFactorRequired proto.InternalMessageInfo
+
+func (m *SecondFactorRequired) GetChallenge() string {
+	if m != nil {
+		return m.Challenge
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*LoginRequest)(nil), "charon.rpc.charond.v1.LoginRequest")
 	proto.RegisterType((*LogoutRequest)(nil), "charon.rpc.charond.v1.LogoutRequest")
 	proto.RegisterType((*IsAuthenticatedRequest)(nil), "charon.rpc.charond.v1.IsAuthenticatedRequest")
 	proto.RegisterType((*IsGrantedRequest)(nil), "charon.rpc.charond.v1.IsGrantedRequest")
+	proto.RegisterType((*IsGrantedBatchRequest)(nil), "charon.rpc.charond.v1.IsGrantedBatchRequest")
+	proto.RegisterType((*IsGrantedBatchResponse)(nil), "charon.rpc.charond.v1.IsGrantedBatchResponse")
+	proto.RegisterMapType((map[string]bool)(nil), "charon.rpc.charond.v1.IsGrantedBatchResponse.GrantedEntry")
+	proto.RegisterMapType((map[int64]*IsGrantedBatchResponse_Grants)(nil), "charon.rpc.charond.v1.IsGrantedBatchResponse.UsersEntry")
+	proto.RegisterType((*IsGrantedBatchResponse_Grants)(nil), "charon.rpc.charond.v1.IsGrantedBatchResponse.Grants")
+	proto.RegisterMapType((map[string]bool)(nil), "charon.rpc.charond.v1.IsGrantedBatchResponse.Grants.GrantedEntry")
+	proto.RegisterType((*ListResourcesRequest)(nil), "charon.rpc.charond.v1.ListResourcesRequest")
+	proto.RegisterType((*ListResourcesResponse)(nil), "charon.rpc.charond.v1.ListResourcesResponse")
+	proto.RegisterType((*WatchRequest)(nil), "charon.rpc.charond.v1.WatchRequest")
+	proto.RegisterType((*WatchEvent)(nil), "charon.rpc.charond.v1.WatchEvent")
 	proto.RegisterType((*BelongsToRequest)(nil), "charon.rpc.charond.v1.BelongsToRequest")
 	proto.RegisterType((*ActorResponse)(nil), "charon.rpc.charond.v1.ActorResponse")
 	proto.RegisterType((*UsernameAndPasswordStrategy)(nil), "charon.rpc.charond.v1.UsernameAndPasswordStrategy")
 	proto.RegisterType((*RefreshTokenStrategy)(nil), "charon.rpc.charond.v1.RefreshTokenStrategy")
+	proto.RegisterType((*TOTPStrategy)(nil), "charon.rpc.charond.v1.TOTPStrategy")
+	proto.RegisterType((*IDTokenStrategy)(nil), "charon.rpc.charond.v1.IDTokenStrategy")
+	proto.RegisterType((*APIKeyStrategy)(nil), "charon.rpc.charond.v1.APIKeyStrategy")
+	proto.RegisterType((*SecondFactorRequired)(nil), "charon.rpc.charond.v1.SecondFactorRequired")
+	proto.RegisterEnum("charon.rpc.charond.v1.WatchEvent_Kind", WatchEvent_Kind_name, WatchEvent_Kind_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -587,7 +1250,14 @@ type AuthClient interface {
 	IsAuthenticated(ctx context.Context, in *IsAuthenticatedRequest, opts ...grpc.CallOption) (*wrappers.BoolValue, error)
 	Actor(ctx context.Context, in *wrappers.StringValue, opts ...grpc.CallOption) (*ActorResponse, error)
 	IsGranted(ctx context.Context, in *IsGrantedRequest, opts ...grpc.CallOption) (*wrappers.BoolValue, error)
+	// IsGrantedBatch checks any number of permissions, for one or more users, at once.
+	IsGrantedBatch(ctx context.Context, in *IsGrantedBatchRequest, opts ...grpc.CallOption) (*IsGrantedBatchResponse, error)
 	BelongsTo(ctx context.Context, in *BelongsToRequest, opts ...grpc.CallOption) (*wrappers.BoolValue, error)
+	// ListResources answers which resources of given type the user can perform given action on.
+	ListResources(ctx context.Context, in *ListResourcesRequest, opts ...grpc.CallOption) (*ListResourcesResponse, error)
+	// Watch streams events every time authorization of a watched user changes.
+	// Events are not replayed, so the current state should be fetched again after (re)connecting.
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (Auth_WatchClient, error)
 }
 
 type authClient struct {
@@ -643,6 +1313,15 @@ func (c *authClient) IsGranted(ctx context.Context, in *IsGrantedRequest, opts .
 	return out, nil
 }
 
+func (c *authClient) IsGrantedBatch(ctx context.Context, in *IsGrantedBatchRequest, opts ...grpc.CallOption) (*IsGrantedBatchResponse, error) {
+	out := new(IsGrantedBatchResponse)
+	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.Auth/IsGrantedBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authClient) BelongsTo(ctx context.Context, in *BelongsToRequest, opts ...grpc.CallOption) (*wrappers.BoolValue, error) {
 	out := new(wrappers.BoolValue)
 	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.Auth/BelongsTo", in, out, opts...)
@@ -652,6 +1331,47 @@ func (c *authClient) BelongsTo(ctx context.Context, in *BelongsToRequest, opts .
 	return out, nil
 }
 
+func (c *authClient) ListResources(ctx context.Context, in *ListResourcesRequest, opts ...grpc.CallOption) (*ListResourcesResponse, error) {
+	out := new(ListResourcesResponse)
+	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.Auth/ListResources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (Auth_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Auth_serviceDesc.Streams[0], "/charon.rpc.charond.v1.Auth/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &authWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Auth_WatchClient interface {
+	Recv() (*WatchEvent, error)
+	grpc.ClientStream
+}
+
+type authWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *authWatchClient) Recv() (*WatchEvent, error) {
+	m := new(WatchEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // AuthServer is the server API for Auth service.
 type AuthServer interface {
 	Login(context.Context, *LoginRequest) (*wrappers.StringValue, error)
@@ -659,7 +1379,14 @@ type AuthServer interface {
 	IsAuthenticated(context.Context, *IsAuthenticatedRequest) (*wrappers.BoolValue, error)
 	Actor(context.Context, *wrappers.StringValue) (*ActorResponse, error)
 	IsGranted(context.Context, *IsGrantedRequest) (*wrappers.BoolValue, error)
+	// IsGrantedBatch checks any number of permissions, for one or more users, at once.
+	IsGrantedBatch(context.Context, *IsGrantedBatchRequest) (*IsGrantedBatchResponse, error)
 	BelongsTo(context.Context, *BelongsToRequest) (*wrappers.BoolValue, error)
+	// ListResources answers which resources of given type the user can perform given action on.
+	ListResources(context.Context, *ListResourcesRequest) (*ListResourcesResponse, error)
+	// Watch streams events every time authorization of a watched user changes.
+	// Events are not replayed, so the current state should be fetched again after (re)connecting.
+	Watch(*WatchRequest, Auth_WatchServer) error
 }
 
 func RegisterAuthServer(s *grpc.Server, srv AuthServer) {
@@ -756,6 +1483,24 @@ func _Auth_IsGranted_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Auth_IsGrantedBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsGrantedBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).IsGrantedBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/charon.rpc.charond.v1.Auth/IsGrantedBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).IsGrantedBatch(ctx, req.(*IsGrantedBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Auth_BelongsTo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BelongsToRequest)
 	if err := dec(in); err != nil {
@@ -774,6 +1519,45 @@ func _Auth_BelongsTo_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Auth_ListResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).ListResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/charon.rpc.charond.v1.Auth/ListResources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).ListResources(ctx, req.(*ListResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AuthServer).Watch(m, &authWatchServer{stream})
+}
+
+type Auth_WatchServer interface {
+	Send(*WatchEvent) error
+	grpc.ServerStream
+}
+
+type authWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *authWatchServer) Send(m *WatchEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Auth_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "charon.rpc.charond.v1.Auth",
 	HandlerType: (*AuthServer)(nil),
@@ -798,66 +1582,116 @@ var _Auth_serviceDesc = grpc.ServiceDesc{
 			MethodName: "IsGranted",
 			Handler:    _Auth_IsGranted_Handler,
 		},
+		{
+			MethodName: "IsGrantedBatch",
+			Handler:    _Auth_IsGrantedBatch_Handler,
+		},
 		{
 			MethodName: "BelongsTo",
 			Handler:    _Auth_BelongsTo_Handler,
 		},
+		{
+			MethodName: "ListResources",
+			Handler:    _Auth_ListResources_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _Auth_Watch_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1/auth.proto",
 }
 
 func init() {
-	proto.RegisterFile("github.com/piotrkowalczuk/charon/pb/rpc/charond/v1/auth.proto", fileDescriptor_auth_e4bcd340412162f2)
-}
-
-var fileDescriptor_auth_e4bcd340412162f2 = []byte{
-	// 738 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x5d, 0x6f, 0xdb, 0x36,
-	0x14, 0x9d, 0xed, 0xc4, 0x96, 0xaf, 0xed, 0x2d, 0xe0, 0x92, 0x4c, 0x53, 0x96, 0xc0, 0x73, 0x06,
-	0x2c, 0xc0, 0x30, 0x09, 0xf1, 0xde, 0x16, 0xec, 0xc1, 0x1e, 0x9a, 0xd6, 0x49, 0x50, 0x18, 0x72,
-	0xd2, 0x87, 0xa2, 0x80, 0x4b, 0x4b, 0x94, 0x4c, 0xc4, 0x16, 0x55, 0x92, 0x4a, 0x90, 0xfe, 0xb0,
-	0xfe, 0xa7, 0xfe, 0x8b, 0x82, 0x94, 0xe4, 0xaf, 0xd8, 0x2e, 0xf2, 0x66, 0x9e, 0xc3, 0x7b, 0x2e,
-	0x79, 0x7d, 0x0e, 0x05, 0xff, 0x85, 0x54, 0x8e, 0x93, 0x91, 0xed, 0xb1, 0xa9, 0x13, 0x53, 0x26,
-	0xf9, 0x3d, 0x7b, 0xc4, 0x13, 0xef, 0x73, 0x72, 0xef, 0x78, 0x63, 0xcc, 0x59, 0xe4, 0xc4, 0x23,
-	0x87, 0xc7, 0x5e, 0xb6, 0xf2, 0x9d, 0x87, 0x73, 0x07, 0x27, 0x72, 0x6c, 0xc7, 0x9c, 0x49, 0x86,
-	0x0e, 0x52, 0xd8, 0xe6, 0xb1, 0x67, 0x67, 0x3b, 0xec, 0x87, 0x73, 0xeb, 0x28, 0x64, 0x2c, 0x9c,
-	0x10, 0x47, 0x6f, 0x1a, 0x25, 0x81, 0x43, 0xa6, 0xb1, 0x7c, 0x4a, 0x6b, 0xac, 0x93, 0x55, 0xf2,
-	0x91, 0xe3, 0x38, 0x26, 0x5c, 0xa4, 0x7c, 0xeb, 0x4b, 0x11, 0xea, 0x37, 0x2c, 0xa4, 0x91, 0x4b,
-	0x3e, 0x25, 0x44, 0x48, 0x74, 0x02, 0x46, 0x22, 0x08, 0x8f, 0xf0, 0x94, 0x98, 0x85, 0x66, 0xe1,
-	0xac, 0xda, 0x2d, 0x9a, 0x05, 0x77, 0x86, 0x29, 0x3e, 0xc6, 0x42, 0x3c, 0x32, 0xee, 0x9b, 0xc5,
-	0x39, 0x9f, 0x63, 0xe8, 0x10, 0xca, 0xde, 0x84, 0x92, 0x48, 0x9a, 0x25, 0xc5, 0xba, 0xd9, 0x0a,
-	0x8d, 0xe1, 0x20, 0xd7, 0x18, 0xe2, 0xc8, 0x1f, 0xce, 0x44, 0x6a, 0xcd, 0xc2, 0x59, 0xad, 0xdd,
-	0xb6, 0xd7, 0x5e, 0xce, 0xbe, 0xcb, 0x6a, 0x3a, 0x91, 0xdf, 0xcf, 0x2a, 0x06, 0x92, 0x63, 0x49,
-	0xc2, 0xa7, 0x37, 0x3f, 0xb8, 0x3f, 0x27, 0xcf, 0x69, 0xe4, 0x42, 0x83, 0x93, 0x80, 0x13, 0x31,
-	0x1e, 0x4a, 0x76, 0x4f, 0x22, 0xb3, 0xae, 0x3b, 0xfc, 0xb5, 0xa1, 0x83, 0x9b, 0xee, 0xbd, 0x55,
-	0x5b, 0x17, 0xa4, 0xeb, 0x7c, 0x01, 0xef, 0x02, 0x18, 0x22, 0xe3, 0xae, 0x76, 0x8c, 0xc6, 0xde,
-	0x7e, 0xab, 0x0d, 0x8d, 0x1b, 0x16, 0xb2, 0x44, 0xe6, 0x83, 0xfb, 0x1d, 0xea, 0xd8, 0xf3, 0x88,
-	0x10, 0x59, 0x57, 0x3d, 0x3c, 0xb7, 0x96, 0x62, 0x5a, 0xa5, 0x75, 0x01, 0x87, 0x3d, 0xd1, 0x49,
-	0xe4, 0x98, 0x44, 0x92, 0x7a, 0x58, 0x12, 0xff, 0x05, 0xc5, 0xd7, 0xb0, 0xd7, 0x13, 0xaf, 0x39,
-	0x8e, 0x16, 0xca, 0x7e, 0x81, 0x8a, 0x9a, 0xc0, 0x90, 0xfa, 0xba, 0xa2, 0xe4, 0x96, 0xd5, 0xb2,
-	0xe7, 0xa3, 0x13, 0x80, 0x98, 0xf0, 0x29, 0x15, 0x82, 0xb2, 0x28, 0xfd, 0x9f, 0xdc, 0x05, 0xa4,
-	0x75, 0x09, 0x7b, 0x5d, 0x32, 0x61, 0x51, 0x28, 0x6e, 0xd9, 0x77, 0xc5, 0x7e, 0x05, 0x23, 0xe4,
-	0x2c, 0x89, 0x15, 0x53, 0xd4, 0x4c, 0x45, 0xaf, 0x7b, 0xbe, 0xb2, 0x4f, 0xa3, 0xe3, 0x49, 0xc6,
-	0x5d, 0x22, 0x62, 0x16, 0x09, 0x82, 0x7e, 0x84, 0xe2, 0x4c, 0xa0, 0x48, 0x7d, 0x64, 0x2d, 0xf8,
-	0x29, 0x3d, 0xc7, 0xdc, 0x4b, 0xc7, 0x00, 0x01, 0xe5, 0x42, 0x0e, 0x35, 0x9b, 0xfa, 0xa5, 0xaa,
-	0x91, 0xb7, 0x8a, 0x3e, 0x82, 0xea, 0x04, 0xe7, 0xec, 0x4e, 0x5a, 0xab, 0x00, 0x4d, 0x36, 0xa1,
-	0x36, 0xbf, 0x8f, 0x30, 0x77, 0x9b, 0x25, 0x35, 0xb0, 0x05, 0x48, 0xcd, 0x94, 0x8a, 0xa1, 0x48,
-	0x62, 0xc2, 0x55, 0x47, 0xb3, 0xdc, 0x2c, 0x9c, 0x19, 0x6e, 0x8d, 0x8a, 0x41, 0x0e, 0xa9, 0x0e,
-	0x54, 0x0c, 0xb1, 0x27, 0xe9, 0x03, 0x31, 0x2b, 0x9a, 0x37, 0xa8, 0xe8, 0xe8, 0x35, 0x3a, 0x06,
-	0x43, 0xd5, 0xcb, 0x24, 0x08, 0x4c, 0x43, 0x71, 0xda, 0xe9, 0x15, 0x2a, 0x06, 0x0a, 0xca, 0xe4,
-	0x3d, 0x16, 0x05, 0x94, 0x4f, 0x89, 0x6f, 0x56, 0x73, 0xf9, 0xff, 0x73, 0x48, 0x0d, 0x4e, 0x2b,
-	0xe0, 0x20, 0x30, 0x41, 0xd3, 0xba, 0x1a, 0x07, 0x41, 0xeb, 0x0e, 0x8e, 0xb6, 0x58, 0x7b, 0x69,
-	0x6a, 0x85, 0x95, 0xa9, 0x59, 0xab, 0x09, 0x9c, 0xa7, 0xaf, 0x75, 0x01, 0xfb, 0xeb, 0xfc, 0x8c,
-	0x4e, 0x57, 0x33, 0x91, 0x8a, 0x2e, 0x99, 0xbc, 0xfd, 0xb5, 0x04, 0x3b, 0xca, 0x9d, 0xe8, 0x0a,
-	0x76, 0xf5, 0x9b, 0x80, 0x4e, 0x37, 0x64, 0x66, 0xf1, 0xc5, 0xb0, 0x7e, 0xb3, 0xd3, 0x37, 0xc6,
-	0xce, 0xdf, 0x18, 0x7b, 0x20, 0x39, 0x8d, 0xc2, 0x77, 0x78, 0x92, 0x10, 0x74, 0x09, 0xe5, 0x34,
-	0x27, 0xe8, 0x8f, 0xcd, 0x62, 0xf3, 0x18, 0x59, 0x87, 0xcf, 0xd4, 0x5e, 0xa9, 0xe7, 0x0c, 0x7d,
-	0x80, 0x9f, 0x56, 0xb2, 0x83, 0xfe, 0xde, 0x20, 0xb8, 0x3e, 0x63, 0x96, 0xf5, 0x4c, 0xb9, 0xcb,
-	0xd8, 0x24, 0x3d, 0xe5, 0x35, 0xec, 0x6a, 0x1b, 0xa3, 0xad, 0x97, 0xb1, 0x36, 0x5d, 0x61, 0x39,
-	0x02, 0x7d, 0xa8, 0xce, 0x92, 0x8a, 0xfe, 0xdc, 0x78, 0xc8, 0xe5, 0x2c, 0x6f, 0x3d, 0x5e, 0x1f,
-	0xaa, 0xb3, 0xb8, 0x6e, 0x54, 0x5c, 0x0d, 0xf4, 0x36, 0xc5, 0xee, 0x47, 0x68, 0x7a, 0x6c, 0x6a,
-	0xe7, 0x1f, 0xa4, 0x75, 0x82, 0xfd, 0xc2, 0xfb, 0x7f, 0x5f, 0xfe, 0xc1, 0xba, 0xc8, 0x7e, 0x8e,
-	0xca, 0xba, 0xeb, 0x3f, 0xdf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xd4, 0xc0, 0x2c, 0x83, 0xf5, 0x06,
-	0x00, 0x00,
+	proto.RegisterFile("github.com/piotrkowalczuk/charon/pb/rpc/charond/v1/auth.proto", fileDescriptor_auth_2ba2a296f43f550f)
+}
+
+var fileDescriptor_auth_2ba2a296f43f550f = []byte{
+	// 1320 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xdf, 0x76, 0xda, 0x46,
+	0x13, 0x8f, 0x00, 0x03, 0x1e, 0xb0, 0xcd, 0xd9, 0xd8, 0xfe, 0x64, 0xf2, 0xe7, 0x73, 0x94, 0xef,
+	0x6b, 0xdd, 0xd3, 0x04, 0x1a, 0x9a, 0x8b, 0xd4, 0x3e, 0x3d, 0x0d, 0x4e, 0x70, 0x42, 0x70, 0x31,
+	0x15, 0x38, 0x39, 0xfd, 0x73, 0x8e, 0x2a, 0x4b, 0x0b, 0x6c, 0x0d, 0x5a, 0x45, 0x2b, 0x39, 0x87,
+	0x3e, 0x41, 0xaf, 0x7a, 0xdb, 0xeb, 0xbe, 0x44, 0x1f, 0xa3, 0xcf, 0xd3, 0xcb, 0x9e, 0xdd, 0x95,
+	0x40, 0xc6, 0xc8, 0x8e, 0x93, 0x3b, 0xed, 0xcc, 0xfe, 0x7e, 0x33, 0xb3, 0x3b, 0x3b, 0x33, 0x82,
+	0xaf, 0x07, 0xc4, 0x1f, 0x06, 0x27, 0x15, 0x8b, 0x8e, 0xab, 0x2e, 0xa1, 0xbe, 0x77, 0x4a, 0xdf,
+	0x99, 0x23, 0xeb, 0xd7, 0xe0, 0xb4, 0x6a, 0x0d, 0x4d, 0x8f, 0x3a, 0x55, 0xf7, 0xa4, 0xea, 0xb9,
+	0x56, 0xb8, 0xb2, 0xab, 0x67, 0x8f, 0xaa, 0x66, 0xe0, 0x0f, 0x2b, 0xae, 0x47, 0x7d, 0x8a, 0x36,
+	0xa4, 0xb8, 0xe2, 0xb9, 0x56, 0x25, 0xdc, 0x51, 0x39, 0x7b, 0x54, 0xbe, 0x35, 0xa0, 0x74, 0x30,
+	0xc2, 0x55, 0xb1, 0xe9, 0x24, 0xe8, 0x57, 0xf1, 0xd8, 0xf5, 0x27, 0x12, 0x53, 0xbe, 0x3b, 0xaf,
+	0x7c, 0xe7, 0x99, 0xae, 0x8b, 0x3d, 0x16, 0xea, 0xbf, 0xf9, 0x00, 0x97, 0x2c, 0x3a, 0x1e, 0x53,
+	0x47, 0x12, 0x68, 0xff, 0xa4, 0xa1, 0x78, 0x48, 0x07, 0xc4, 0xd1, 0xf1, 0xdb, 0x00, 0x33, 0x1f,
+	0xdd, 0x85, 0x7c, 0xc0, 0xb0, 0xe7, 0x98, 0x63, 0xac, 0x2a, 0xdb, 0xca, 0xce, 0xf2, 0x7e, 0x4a,
+	0x55, 0xf4, 0xa9, 0x8c, 0xeb, 0x5d, 0x93, 0xb1, 0x77, 0xd4, 0xb3, 0xd5, 0xd4, 0x4c, 0x1f, 0xc9,
+	0xd0, 0x26, 0x64, 0xad, 0x11, 0xc1, 0x8e, 0xaf, 0xa6, 0xb9, 0x56, 0x0f, 0x57, 0x68, 0x08, 0x1b,
+	0x11, 0x87, 0x61, 0x3a, 0xb6, 0x31, 0x25, 0x29, 0x6c, 0x2b, 0x3b, 0x85, 0x5a, 0xad, 0xb2, 0xf0,
+	0x74, 0x2a, 0xc7, 0x21, 0xa6, 0xee, 0xd8, 0x9d, 0x10, 0xd1, 0xf5, 0x3d, 0xd3, 0xc7, 0x83, 0xc9,
+	0xcb, 0x1b, 0xfa, 0xcd, 0xe0, 0xa2, 0x1a, 0xe9, 0xb0, 0xe2, 0xe1, 0xbe, 0x87, 0xd9, 0xd0, 0xf0,
+	0xe9, 0x29, 0x76, 0xd4, 0xa2, 0xb0, 0xf0, 0x79, 0x82, 0x05, 0x5d, 0xee, 0xed, 0xf1, 0xad, 0x31,
+	0xea, 0xa2, 0x17, 0x93, 0xa3, 0xaf, 0x20, 0xe3, 0x53, 0xdf, 0x55, 0xd7, 0x05, 0xd5, 0xfd, 0x04,
+	0xaa, 0xde, 0x51, 0xaf, 0x13, 0xa3, 0x10, 0x10, 0xf4, 0x0c, 0xf2, 0xc4, 0x0e, 0x3d, 0xd9, 0x10,
+	0xf0, 0x4f, 0x12, 0xe0, 0xcd, 0xe7, 0xf3, 0x4e, 0xe4, 0x88, 0x2d, 0xed, 0x3f, 0x85, 0x9c, 0xe9,
+	0x12, 0xe3, 0x14, 0x4f, 0xd4, 0x4d, 0xc1, 0xf1, 0xff, 0x04, 0x8e, 0x7a, 0xa7, 0xd9, 0xc2, 0x93,
+	0x18, 0x45, 0xd6, 0x74, 0x49, 0x0b, 0x4f, 0xf6, 0x01, 0xf2, 0x2c, 0x94, 0xbe, 0xca, 0xe4, 0x57,
+	0x4a, 0xeb, 0x5a, 0x0d, 0x56, 0x0e, 0xe9, 0x80, 0x06, 0x7e, 0x74, 0xf5, 0xf7, 0xa0, 0x68, 0x5a,
+	0x16, 0x66, 0x2c, 0xf4, 0x56, 0x5c, 0xbf, 0x5e, 0x90, 0x32, 0xe1, 0x87, 0xb6, 0x07, 0x9b, 0x4d,
+	0x56, 0x0f, 0xfc, 0x21, 0x76, 0x7c, 0x62, 0x99, 0x3e, 0xb6, 0xaf, 0x01, 0xfe, 0x4d, 0x81, 0x52,
+	0x93, 0xbd, 0xf0, 0x4c, 0x27, 0x86, 0xfb, 0x0f, 0xe4, 0xf8, 0x25, 0x1a, 0xc4, 0x16, 0x90, 0xb4,
+	0x9e, 0xe5, 0xcb, 0xa6, 0x8d, 0xee, 0x02, 0xb8, 0xd8, 0x1b, 0x13, 0xc6, 0x08, 0x75, 0x64, 0xaa,
+	0xe9, 0x31, 0x09, 0xda, 0x83, 0xbc, 0x87, 0x19, 0x0d, 0x3c, 0x0b, 0x8b, 0x54, 0x2b, 0xd4, 0xfe,
+	0x9b, 0x78, 0xc3, 0x72, 0x9b, 0x3e, 0x05, 0x68, 0x63, 0xd8, 0x98, 0x7a, 0xb2, 0x6f, 0xfa, 0xd6,
+	0xf0, 0x4a, 0x77, 0xb6, 0xa1, 0x30, 0x33, 0xce, 0xd4, 0xd4, 0x76, 0x9a, 0x87, 0x17, 0x13, 0xa1,
+	0x2d, 0xf9, 0x72, 0x0c, 0x62, 0x33, 0x35, 0xbd, 0x9d, 0xde, 0x49, 0xeb, 0x39, 0x89, 0x65, 0xda,
+	0xef, 0x19, 0xd8, 0x9c, 0xb7, 0xc7, 0x5c, 0xea, 0x30, 0x8c, 0x7a, 0x90, 0x1b, 0x48, 0xb9, 0xaa,
+	0x6c, 0xa7, 0x77, 0x0a, 0xb5, 0xdd, 0xa4, 0xec, 0x58, 0x88, 0xaf, 0x84, 0xc2, 0x86, 0xe3, 0x7b,
+	0x13, 0x3d, 0xa2, 0x42, 0x6d, 0x58, 0xe2, 0xb6, 0xa5, 0x9f, 0x85, 0xda, 0x93, 0xeb, 0x71, 0xf2,
+	0x47, 0xc7, 0x24, 0xa3, 0xa4, 0x29, 0xff, 0xa9, 0x40, 0x56, 0x6c, 0x65, 0xe8, 0xc7, 0x79, 0x87,
+	0xeb, 0x1f, 0xe0, 0x30, 0x5b, 0xec, 0x77, 0x79, 0x17, 0x8a, 0x71, 0x05, 0x2a, 0x41, 0x9a, 0xe7,
+	0xbc, 0x4c, 0x26, 0xfe, 0x89, 0xd6, 0x61, 0xe9, 0xcc, 0x1c, 0x05, 0x58, 0x64, 0x44, 0x5e, 0x97,
+	0x8b, 0xdd, 0xd4, 0x13, 0xe5, 0xa3, 0xb0, 0x0e, 0xc0, 0x2c, 0xe8, 0x38, 0x32, 0x2d, 0x91, 0xaf,
+	0xe2, 0xc8, 0x42, 0xed, 0xf1, 0x87, 0x84, 0x1c, 0xb3, 0xa7, 0xf9, 0xb0, 0x7e, 0x48, 0x98, 0x1f,
+	0x65, 0x26, 0xfb, 0xe8, 0xd7, 0x70, 0x9f, 0x17, 0x3d, 0x49, 0x66, 0xf8, 0x13, 0x17, 0x87, 0xd5,
+	0xb7, 0x18, 0x09, 0x7b, 0x13, 0x17, 0x6b, 0x7b, 0xb0, 0x31, 0x67, 0x35, 0x4c, 0xc2, 0x12, 0xa4,
+	0x79, 0xd6, 0x2a, 0x22, 0xa9, 0xf9, 0x27, 0x97, 0x98, 0xa3, 0x51, 0x78, 0x50, 0xfc, 0x53, 0xfb,
+	0x0c, 0x8a, 0x6f, 0xe2, 0x2f, 0x25, 0x9e, 0xee, 0xca, 0xf9, 0x74, 0xff, 0x5b, 0x01, 0x10, 0x7b,
+	0x1b, 0x67, 0xd8, 0xb9, 0x24, 0xa8, 0x5d, 0xc8, 0x9c, 0x12, 0x47, 0xf6, 0x91, 0xd5, 0xc4, 0xb2,
+	0x38, 0x63, 0xaa, 0xb4, 0x88, 0x63, 0xeb, 0x02, 0xa3, 0x9d, 0x42, 0x86, 0xaf, 0x50, 0x01, 0x72,
+	0xc7, 0xed, 0x56, 0xfb, 0xe8, 0x4d, 0xbb, 0x74, 0x03, 0xad, 0x41, 0xa1, 0xd3, 0xd0, 0xbf, 0x6d,
+	0x76, 0xbb, 0xcd, 0xa3, 0x76, 0xb7, 0xa4, 0x20, 0x80, 0xec, 0x0b, 0xfd, 0xe8, 0xb8, 0xd3, 0x2d,
+	0xa5, 0xd0, 0x32, 0x2c, 0x1d, 0x1c, 0xd6, 0x5f, 0x74, 0x4b, 0x69, 0xb4, 0x05, 0x1b, 0x7a, 0xe3,
+	0x40, 0x6f, 0x74, 0x5f, 0x1a, 0xbd, 0xa3, 0x56, 0xa3, 0x6d, 0xe8, 0x8d, 0xd7, 0x47, 0xad, 0xc6,
+	0xf3, 0x52, 0x06, 0xdd, 0x84, 0xb5, 0x7a, 0xa7, 0x69, 0xb4, 0x1a, 0xdf, 0x4f, 0x85, 0x4b, 0xda,
+	0x01, 0x94, 0xf6, 0xf1, 0x88, 0x3a, 0x03, 0xd6, 0xa3, 0x57, 0x5e, 0xd5, 0x16, 0xe4, 0x07, 0x1e,
+	0x0d, 0x5c, 0x83, 0xc8, 0xc8, 0xd2, 0x3c, 0xbd, 0x69, 0xe0, 0x36, 0x6d, 0xed, 0xaf, 0x14, 0xac,
+	0xd4, 0x2d, 0x9f, 0x7a, 0xd3, 0x93, 0x5f, 0x85, 0xd4, 0x94, 0x20, 0x45, 0x6c, 0x54, 0x8e, 0xb5,
+	0x5f, 0x79, 0xcb, 0xd3, 0x35, 0xba, 0x03, 0xd0, 0x27, 0x1e, 0xf3, 0x0d, 0xa1, 0x95, 0x17, 0xbc,
+	0x2c, 0x24, 0x6d, 0xae, 0xbe, 0x05, 0xcb, 0x23, 0x33, 0xd2, 0x66, 0x24, 0x76, 0x64, 0x86, 0xca,
+	0xb9, 0xf2, 0xb5, 0x74, 0xb1, 0x7c, 0xdd, 0x83, 0x22, 0x61, 0x06, 0x0b, 0x5c, 0xec, 0x71, 0x8b,
+	0x6a, 0x56, 0x5c, 0x7d, 0x81, 0xb0, 0x6e, 0x24, 0xe2, 0x16, 0x08, 0x33, 0x4c, 0xcb, 0x27, 0x67,
+	0x58, 0xcd, 0x09, 0x7d, 0x9e, 0xb0, 0xba, 0x58, 0xa3, 0x3b, 0x90, 0xe7, 0x78, 0x3f, 0xe8, 0xf7,
+	0xd5, 0x3c, 0xd7, 0x89, 0xc1, 0x20, 0x47, 0x58, 0x97, 0x8b, 0x42, 0x7a, 0x8b, 0x3a, 0x7d, 0xe2,
+	0x8d, 0xb1, 0xad, 0x2e, 0x47, 0xf4, 0xcf, 0x22, 0x11, 0xda, 0x0a, 0x19, 0xcc, 0x7e, 0x5f, 0x05,
+	0xa1, 0x16, 0x68, 0xb3, 0xdf, 0xd7, 0x8e, 0xe1, 0xd6, 0x25, 0x93, 0xc0, 0xb9, 0x53, 0x53, 0xe6,
+	0x4e, 0xad, 0x3c, 0x3f, 0xb0, 0xcc, 0x86, 0x15, 0x6d, 0x0f, 0xd6, 0x17, 0xb5, 0x7f, 0xf9, 0x9a,
+	0xe2, 0x23, 0x84, 0x12, 0xbd, 0xa6, 0xd9, 0x66, 0xed, 0x29, 0x14, 0xe3, 0x0d, 0x1f, 0xdd, 0x86,
+	0x65, 0x6b, 0x68, 0x8e, 0x46, 0xd8, 0x19, 0x44, 0x5e, 0xcc, 0x04, 0x08, 0x41, 0xc6, 0xa2, 0x76,
+	0x74, 0xa9, 0xe2, 0x5b, 0x7b, 0x00, 0x6b, 0x73, 0x3d, 0x1f, 0x6d, 0xc5, 0xa6, 0x05, 0xc9, 0x11,
+	0xcd, 0x00, 0x9a, 0x06, 0xab, 0xe7, 0xbb, 0xfb, 0xc5, 0x0a, 0xa7, 0x3d, 0x86, 0xf5, 0x2e, 0xb6,
+	0xa8, 0x63, 0x1f, 0x98, 0x32, 0xcd, 0xde, 0x06, 0xc4, 0xc3, 0xf6, 0xe5, 0xbe, 0xd5, 0xfe, 0xc8,
+	0x42, 0x86, 0x37, 0x75, 0x5e, 0xe6, 0xc4, 0x30, 0x88, 0x92, 0x26, 0x9c, 0xf8, 0xa8, 0x58, 0xbe,
+	0x5d, 0x91, 0xd3, 0x69, 0x25, 0x9a, 0x4e, 0x2b, 0x5d, 0xdf, 0x23, 0xce, 0xe0, 0x35, 0x2f, 0x73,
+	0xe8, 0x00, 0xb2, 0x72, 0xbc, 0x40, 0xff, 0x4b, 0x26, 0x9b, 0x4d, 0x1f, 0xe5, 0xcd, 0x0b, 0x6c,
+	0x0d, 0x3e, 0x08, 0xa3, 0x9f, 0x60, 0x6d, 0x6e, 0xe4, 0x40, 0x0f, 0x13, 0xcb, 0xef, 0xa2, 0xd1,
+	0xa4, 0x5c, 0xbe, 0xc0, 0xbc, 0x4f, 0xe9, 0x48, 0x7a, 0xd9, 0x82, 0x25, 0xf1, 0x20, 0xd1, 0xa5,
+	0xc1, 0x94, 0x93, 0x42, 0x38, 0xff, 0x98, 0x3b, 0xb0, 0x3c, 0xed, 0x00, 0xe8, 0xd3, 0xab, 0x7a,
+	0xc4, 0xfb, 0xb8, 0x37, 0x86, 0xd5, 0xf3, 0x3d, 0x05, 0x3d, 0x78, 0xcf, 0xd6, 0x23, 0xb9, 0x1f,
+	0x5e, 0xab, 0x51, 0xf1, 0x00, 0xa6, 0x75, 0x2e, 0x31, 0x80, 0xf9, 0x4a, 0x78, 0x69, 0x00, 0xbf,
+	0xc0, 0xca, 0xb9, 0x96, 0x83, 0x92, 0xc6, 0xf0, 0x45, 0xed, 0xb0, 0xfc, 0xe0, 0xfd, 0x36, 0x87,
+	0xde, 0x7f, 0x07, 0x4b, 0xa2, 0x57, 0x24, 0x66, 0x6f, 0xbc, 0x7f, 0x95, 0xef, 0x5d, 0xd9, 0x6e,
+	0xbe, 0x50, 0xf6, 0x7f, 0x86, 0x6d, 0x8b, 0x8e, 0x2b, 0xd1, 0x5f, 0xd6, 0x22, 0x40, 0x47, 0xf9,
+	0x61, 0xf7, 0xfa, 0x7f, 0x61, 0x7b, 0xe1, 0xe7, 0x49, 0x56, 0x1c, 0xda, 0x97, 0xff, 0x0e, 0x00,
+	0xb7, 0x6e, 0x29, 0xd1, 0x5d, 0x0e, 0x00, 0x00,
 }
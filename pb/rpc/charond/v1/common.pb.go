@@ -6,6 +6,7 @@ package charond // import "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
@@ -31,7 +32,7 @@ func (m *Order) Reset()         { *m = Order{} }
 func (m *Order) String() string { return proto.CompactTextString(m) }
 func (*Order) ProtoMessage()    {}
 func (*Order) Descriptor() ([]byte, []int) {
-	return fileDescriptor_common_6fa0804b816886fd, []int{0}
+	return fileDescriptor_common_5ba387e4ce00f4d7, []int{0}
 }
 func (m *Order) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Order.Unmarshal(m, b)
@@ -65,25 +66,128 @@ func (m *Order) GetDescending() bool {
 	return false
 }
 
+// Resource identifies a single object managed by a downstream service, e.g. comment 42.
+type Resource struct {
+	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Resource) Reset()         { *m = Resource{} }
+func (m *Resource) String() string { return proto.CompactTextString(m) }
+func (*Resource) ProtoMessage()    {}
+func (*Resource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_common_5ba387e4ce00f4d7, []int{1}
+}
+func (m *Resource) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Resource.Unmarshal(m, b)
+}
+func (m *Resource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Resource.Marshal(b, m, deterministic)
+}
+func (dst *Resource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Resource.Merge(dst, src)
+}
+func (m *Resource) XXX_Size() int {
+	return xxx_messageInfo_Resource.Size(m)
+}
+func (m *Resource) XXX_DiscardUnknown() {
+	xxx_messageInfo_Resource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Resource proto.InternalMessageInfo
+
+func (m *Resource) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Resource) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// Validity is a time window within which a grant is in effect, a missing bound leaves the window open on that side.
+type Validity struct {
+	ValidFrom            *timestamp.Timestamp `protobuf:"bytes,1,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	ValidUntil           *timestamp.Timestamp `protobuf:"bytes,2,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Validity) Reset()         { *m = Validity{} }
+func (m *Validity) String() string { return proto.CompactTextString(m) }
+func (*Validity) ProtoMessage()    {}
+func (*Validity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_common_5ba387e4ce00f4d7, []int{2}
+}
+func (m *Validity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Validity.Unmarshal(m, b)
+}
+func (m *Validity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Validity.Marshal(b, m, deterministic)
+}
+func (dst *Validity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Validity.Merge(dst, src)
+}
+func (m *Validity) XXX_Size() int {
+	return xxx_messageInfo_Validity.Size(m)
+}
+func (m *Validity) XXX_DiscardUnknown() {
+	xxx_messageInfo_Validity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Validity proto.InternalMessageInfo
+
+func (m *Validity) GetValidFrom() *timestamp.Timestamp {
+	if m != nil {
+		return m.ValidFrom
+	}
+	return nil
+}
+
+func (m *Validity) GetValidUntil() *timestamp.Timestamp {
+	if m != nil {
+		return m.ValidUntil
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Order)(nil), "charon.rpc.charond.v1.Order")
+	proto.RegisterType((*Resource)(nil), "charon.rpc.charond.v1.Resource")
+	proto.RegisterType((*Validity)(nil), "charon.rpc.charond.v1.Validity")
 }
 
 func init() {
-	proto.RegisterFile("github.com/piotrkowalczuk/charon/pb/rpc/charond/v1/common.proto", fileDescriptor_common_6fa0804b816886fd)
-}
-
-var fileDescriptor_common_6fa0804b816886fd = []byte{
-	// 174 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xb2, 0x4f, 0xcf, 0x2c, 0xc9,
-	0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x2f, 0xc8, 0xcc, 0x2f, 0x29, 0xca, 0xce, 0x2f, 0x4f,
-	0xcc, 0x49, 0xae, 0x2a, 0xcd, 0xd6, 0x4f, 0xce, 0x48, 0x2c, 0xca, 0xcf, 0xd3, 0x2f, 0x48, 0xd2,
-	0x2f, 0x2a, 0x48, 0x86, 0xf2, 0x52, 0xf4, 0xcb, 0x0c, 0xf5, 0x93, 0xf3, 0x73, 0x73, 0xf3, 0xf3,
-	0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x44, 0x21, 0x12, 0x7a, 0x45, 0x05, 0xc9, 0x7a, 0x50,
-	0x35, 0x7a, 0x65, 0x86, 0x4a, 0xd6, 0x5c, 0xac, 0xfe, 0x45, 0x29, 0xa9, 0x45, 0x42, 0x42, 0x5c,
-	0x2c, 0x79, 0x89, 0xb9, 0xa9, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x60, 0xb6, 0x90, 0x1c,
-	0x17, 0x57, 0x4a, 0x6a, 0x71, 0x72, 0x6a, 0x5e, 0x4a, 0x66, 0x5e, 0xba, 0x04, 0x93, 0x02, 0xa3,
-	0x06, 0x47, 0x10, 0x92, 0x88, 0x53, 0x02, 0x97, 0x42, 0x72, 0x7e, 0xae, 0x1e, 0xcc, 0x69, 0xd8,
-	0x2c, 0x08, 0x60, 0x8c, 0xb2, 0x22, 0xdd, 0xe9, 0xd6, 0x50, 0x66, 0x12, 0x1b, 0xd8, 0xf1, 0xc6,
-	0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb2, 0x79, 0xac, 0x27, 0xff, 0x00, 0x00, 0x00,
+	proto.RegisterFile("github.com/piotrkowalczuk/charon/pb/rpc/charond/v1/common.proto", fileDescriptor_common_5ba387e4ce00f4d7)
+}
+
+var fileDescriptor_common_5ba387e4ce00f4d7 = []byte{
+	// 284 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xb1, 0x4b, 0xfc, 0x30,
+	0x14, 0xc7, 0x69, 0xf9, 0xfd, 0xe4, 0xee, 0x1d, 0x38, 0x04, 0x84, 0xe3, 0x06, 0x2d, 0x9d, 0x6e,
+	0x4a, 0x38, 0x9d, 0xb4, 0x83, 0xe0, 0xe0, 0xaa, 0x14, 0x75, 0x70, 0xd1, 0x36, 0xc9, 0xf5, 0xc2,
+	0x35, 0x79, 0x21, 0x4d, 0x2b, 0xe7, 0xe8, 0x5f, 0x2e, 0x4d, 0x5b, 0x71, 0x10, 0xc4, 0xed, 0x9b,
+	0xe4, 0xfb, 0x21, 0x1f, 0xde, 0x83, 0xeb, 0x4a, 0xf9, 0x5d, 0x5b, 0x52, 0x8e, 0x9a, 0x59, 0x85,
+	0xde, 0xed, 0xf1, 0xad, 0xa8, 0xf9, 0x7b, 0xbb, 0x67, 0x7c, 0x57, 0x38, 0x34, 0xcc, 0x96, 0xcc,
+	0x59, 0x3e, 0x9e, 0x04, 0xeb, 0x36, 0x8c, 0xa3, 0xd6, 0x68, 0xa8, 0x75, 0xe8, 0x91, 0x9c, 0x0c,
+	0x0f, 0xd4, 0x59, 0x4e, 0xc7, 0x0e, 0xed, 0x36, 0xab, 0xb3, 0x0a, 0xb1, 0xaa, 0x25, 0x0b, 0xa5,
+	0xb2, 0xdd, 0x32, 0xaf, 0xb4, 0x6c, 0x7c, 0xa1, 0xed, 0xc0, 0xa5, 0x19, 0xfc, 0xbf, 0x73, 0x42,
+	0x3a, 0x42, 0xe0, 0x9f, 0x29, 0xb4, 0x5c, 0x46, 0x49, 0xb4, 0x9e, 0xe7, 0x21, 0x93, 0x53, 0x00,
+	0x21, 0x1b, 0x2e, 0x8d, 0x50, 0xa6, 0x5a, 0xc6, 0x49, 0xb4, 0x9e, 0xe5, 0xdf, 0x6e, 0x52, 0x0a,
+	0xb3, 0x5c, 0x36, 0xd8, 0x3a, 0x2e, 0x7b, 0xde, 0x1f, 0xec, 0x17, 0xdf, 0x67, 0x72, 0x0c, 0xb1,
+	0x12, 0x81, 0x9b, 0xe7, 0xb1, 0x12, 0xe9, 0x47, 0x04, 0xb3, 0xa7, 0xa2, 0x56, 0x42, 0xf9, 0x03,
+	0xb9, 0x04, 0xe8, 0xfa, 0xfc, 0xb2, 0x75, 0xa8, 0x03, 0xb6, 0x38, 0x5f, 0xd1, 0xc1, 0x97, 0x4e,
+	0xbe, 0xf4, 0x61, 0xf2, 0xcd, 0xe7, 0xa1, 0x7d, 0xeb, 0x50, 0x93, 0x0c, 0x16, 0x03, 0xda, 0x1a,
+	0xaf, 0xea, 0x65, 0xfc, 0x2b, 0x3b, 0xfc, 0xf4, 0xd8, 0xb7, 0x6f, 0x5e, 0x21, 0xe1, 0xa8, 0xe9,
+	0x34, 0xf0, 0x9f, 0xc6, 0x76, 0x1f, 0x3d, 0x5f, 0xfd, 0x7d, 0x21, 0xd9, 0x18, 0xcb, 0xa3, 0x60,
+	0x70, 0xf1, 0x39, 0x00, 0x4d, 0x4e, 0xec, 0x67, 0xd5, 0x01, 0x00, 0x00,
 }
@@ -27,23 +27,25 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
 type Group struct {
-	Id                   int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name                 string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description          string               `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	CreatedBy            *ntypes.Int64        `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	UpdatedBy            *ntypes.Int64        `protobuf:"bytes,7,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Id          int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string               `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt   *timestamp.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy   *ntypes.Int64        `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedAt   *timestamp.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedBy   *ntypes.Int64        `protobuf:"bytes,7,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	// Members of the group are members of its parent group as well.
+	ParentId             *ntypes.Int64 `protobuf:"bytes,8,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *Group) Reset()         { *m = Group{} }
 func (m *Group) String() string { return proto.CompactTextString(m) }
 func (*Group) ProtoMessage()    {}
 func (*Group) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_a1b9c6fb366f15a1, []int{0}
+	return fileDescriptor_group_76b75ca1bb2bcfd7, []int{0}
 }
 func (m *Group) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Group.Unmarshal(m, b)
@@ -112,6 +114,13 @@ func (m *Group) GetUpdatedBy() *ntypes.Int64 {
 	return nil
 }
 
+func (m *Group) GetParentId() *ntypes.Int64 {
+	if m != nil {
+		return m.ParentId
+	}
+	return nil
+}
+
 type CreateGroupRequest struct {
 	Name                 string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Description          *ntypes.String `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
@@ -124,7 +133,7 @@ func (m *CreateGroupRequest) Reset()         { *m = CreateGroupRequest{} }
 func (m *CreateGroupRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateGroupRequest) ProtoMessage()    {}
 func (*CreateGroupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_a1b9c6fb366f15a1, []int{1}
+	return fileDescriptor_group_76b75ca1bb2bcfd7, []int{1}
 }
 func (m *CreateGroupRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateGroupRequest.Unmarshal(m, b)
@@ -169,7 +178,7 @@ func (m *CreateGroupResponse) Reset()         { *m = CreateGroupResponse{} }
 func (m *CreateGroupResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateGroupResponse) ProtoMessage()    {}
 func (*CreateGroupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_a1b9c6fb366f15a1, []int{2}
+	return fileDescriptor_group_76b75ca1bb2bcfd7, []int{2}
 }
 func (m *CreateGroupResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateGroupResponse.Unmarshal(m, b)
@@ -207,7 +216,7 @@ func (m *GetGroupRequest) Reset()         { *m = GetGroupRequest{} }
 func (m *GetGroupRequest) String() string { return proto.CompactTextString(m) }
 func (*GetGroupRequest) ProtoMessage()    {}
 func (*GetGroupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_a1b9c6fb366f15a1, []int{3}
+	return fileDescriptor_group_76b75ca1bb2bcfd7, []int{3}
 }
 func (m *GetGroupRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetGroupRequest.Unmarshal(m, b)
@@ -245,7 +254,7 @@ func (m *GetGroupResponse) Reset()         { *m = GetGroupResponse{} }
 func (m *GetGroupResponse) String() string { return proto.CompactTextString(m) }
 func (*GetGroupResponse) ProtoMessage()    {}
 func (*GetGroupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_a1b9c6fb366f15a1, []int{4}
+	return fileDescriptor_group_76b75ca1bb2bcfd7, []int{4}
 }
 func (m *GetGroupResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetGroupResponse.Unmarshal(m, b)
@@ -273,19 +282,24 @@ func (m *GetGroupResponse) GetGroup() *Group {
 }
 
 type ListGroupsRequest struct {
-	Offset               *ntypes.Int64 `protobuf:"bytes,100,opt,name=offset,proto3" json:"offset,omitempty"`
-	Limit                *ntypes.Int64 `protobuf:"bytes,101,opt,name=limit,proto3" json:"limit,omitempty"`
-	OrderBy              []*Order      `protobuf:"bytes,102,rep,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	Offset  *ntypes.Int64 `protobuf:"bytes,100,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit   *ntypes.Int64 `protobuf:"bytes,101,opt,name=limit,proto3" json:"limit,omitempty"`
+	OrderBy []*Order      `protobuf:"bytes,102,rep,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// Page token continues the list where the previous page ended, it cannot be combined with offset.
+	// Order has to be the same as the one the token was issued for.
+	PageToken string `protobuf:"bytes,103,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Total number of matching rows is counted only on request.
+	IncludeTotal         bool     `protobuf:"varint,104,opt,name=include_total,json=includeTotal,proto3" json:"include_total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ListGroupsRequest) Reset()         { *m = ListGroupsRequest{} }
 func (m *ListGroupsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListGroupsRequest) ProtoMessage()    {}
 func (*ListGroupsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_a1b9c6fb366f15a1, []int{5}
+	return fileDescriptor_group_76b75ca1bb2bcfd7, []int{5}
 }
 func (m *ListGroupsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListGroupsRequest.Unmarshal(m, b)
@@ -326,18 +340,35 @@ func (m *ListGroupsRequest) GetOrderBy() []*Order {
 	return nil
 }
 
+func (m *ListGroupsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *ListGroupsRequest) GetIncludeTotal() bool {
+	if m != nil {
+		return m.IncludeTotal
+	}
+	return false
+}
+
 type ListGroupsResponse struct {
-	Groups               []*Group `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Groups []*Group `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	// Next page token is empty if there is nothing more to retrieve.
+	NextPageToken        string        `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Total                *ntypes.Int64 `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *ListGroupsResponse) Reset()         { *m = ListGroupsResponse{} }
 func (m *ListGroupsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListGroupsResponse) ProtoMessage()    {}
 func (*ListGroupsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_a1b9c6fb366f15a1, []int{6}
+	return fileDescriptor_group_76b75ca1bb2bcfd7, []int{6}
 }
 func (m *ListGroupsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListGroupsResponse.Unmarshal(m, b)
@@ -364,6 +395,20 @@ func (m *ListGroupsResponse) GetGroups() []*Group {
 	return nil
 }
 
+func (m *ListGroupsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *ListGroupsResponse) GetTotal() *ntypes.Int64 {
+	if m != nil {
+		return m.Total
+	}
+	return nil
+}
+
 type DeleteGroupRequest struct {
 	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -375,7 +420,7 @@ func (m *DeleteGroupRequest) Reset()         { *m = DeleteGroupRequest{} }
 func (m *DeleteGroupRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteGroupRequest) ProtoMessage()    {}
 func (*DeleteGroupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_a1b9c6fb366f15a1, []int{7}
+	return fileDescriptor_group_76b75ca1bb2bcfd7, []int{7}
 }
 func (m *DeleteGroupRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteGroupRequest.Unmarshal(m, b)
@@ -403,19 +448,22 @@ func (m *DeleteGroupRequest) GetId() int64 {
 }
 
 type ModifyGroupRequest struct {
-	Id                   int64          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name                 *ntypes.String `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description          *ntypes.String `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	Id          int64          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        *ntypes.String `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description *ntypes.String `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Valid parent_id nests the group in another group, zero makes it a top level group.
+	// Group cannot be nested in itself or in any of its subgroups.
+	ParentId             *ntypes.Int64 `protobuf:"bytes,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *ModifyGroupRequest) Reset()         { *m = ModifyGroupRequest{} }
 func (m *ModifyGroupRequest) String() string { return proto.CompactTextString(m) }
 func (*ModifyGroupRequest) ProtoMessage()    {}
 func (*ModifyGroupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_a1b9c6fb366f15a1, []int{8}
+	return fileDescriptor_group_76b75ca1bb2bcfd7, []int{8}
 }
 func (m *ModifyGroupRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ModifyGroupRequest.Unmarshal(m, b)
@@ -456,6 +504,13 @@ func (m *ModifyGroupRequest) GetDescription() *ntypes.String {
 	return nil
 }
 
+func (m *ModifyGroupRequest) GetParentId() *ntypes.Int64 {
+	if m != nil {
+		return m.ParentId
+	}
+	return nil
+}
+
 type ModifyGroupResponse struct {
 	Group                *Group   `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -467,7 +522,7 @@ func (m *ModifyGroupResponse) Reset()         { *m = ModifyGroupResponse{} }
 func (m *ModifyGroupResponse) String() string { return proto.CompactTextString(m) }
 func (*ModifyGroupResponse) ProtoMessage()    {}
 func (*ModifyGroupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_a1b9c6fb366f15a1, []int{9}
+	return fileDescriptor_group_76b75ca1bb2bcfd7, []int{9}
 }
 func (m *ModifyGroupResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ModifyGroupResponse.Unmarshal(m, b)
@@ -498,7 +553,10 @@ type SetGroupPermissionsRequest struct {
 	GroupId     int64    `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 	Permissions []string `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions,omitempty"`
 	// Force tells if permission should be created in case if it does not exists.
-	Force                bool     `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
+	Force bool `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
+	// Denied permissions are linked with the group as well, but they override any grant,
+	// so members of the group are denied them no matter where the grant comes from.
+	DeniedPermissions    []string `protobuf:"bytes,4,rep,name=denied_permissions,json=deniedPermissions,proto3" json:"denied_permissions,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -508,7 +566,7 @@ func (m *SetGroupPermissionsRequest) Reset()         { *m = SetGroupPermissionsR
 func (m *SetGroupPermissionsRequest) String() string { return proto.CompactTextString(m) }
 func (*SetGroupPermissionsRequest) ProtoMessage()    {}
 func (*SetGroupPermissionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_a1b9c6fb366f15a1, []int{10}
+	return fileDescriptor_group_76b75ca1bb2bcfd7, []int{10}
 }
 func (m *SetGroupPermissionsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetGroupPermissionsRequest.Unmarshal(m, b)
@@ -549,6 +607,13 @@ func (m *SetGroupPermissionsRequest) GetForce() bool {
 	return false
 }
 
+func (m *SetGroupPermissionsRequest) GetDeniedPermissions() []string {
+	if m != nil {
+		return m.DeniedPermissions
+	}
+	return nil
+}
+
 type SetGroupPermissionsResponse struct {
 	Created              int64    `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
 	Removed              int64    `protobuf:"varint,2,opt,name=removed,proto3" json:"removed,omitempty"`
@@ -562,7 +627,7 @@ func (m *SetGroupPermissionsResponse) Reset()         { *m = SetGroupPermissions
 func (m *SetGroupPermissionsResponse) String() string { return proto.CompactTextString(m) }
 func (*SetGroupPermissionsResponse) ProtoMessage()    {}
 func (*SetGroupPermissionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_a1b9c6fb366f15a1, []int{11}
+	return fileDescriptor_group_76b75ca1bb2bcfd7, []int{11}
 }
 func (m *SetGroupPermissionsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetGroupPermissionsResponse.Unmarshal(m, b)
@@ -614,7 +679,7 @@ func (m *ListGroupPermissionsRequest) Reset()         { *m = ListGroupPermission
 func (m *ListGroupPermissionsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListGroupPermissionsRequest) ProtoMessage()    {}
 func (*ListGroupPermissionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_a1b9c6fb366f15a1, []int{12}
+	return fileDescriptor_group_76b75ca1bb2bcfd7, []int{12}
 }
 func (m *ListGroupPermissionsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListGroupPermissionsRequest.Unmarshal(m, b)
@@ -652,7 +717,7 @@ func (m *ListGroupPermissionsResponse) Reset()         { *m = ListGroupPermissio
 func (m *ListGroupPermissionsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListGroupPermissionsResponse) ProtoMessage()    {}
 func (*ListGroupPermissionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_a1b9c6fb366f15a1, []int{13}
+	return fileDescriptor_group_76b75ca1bb2bcfd7, []int{13}
 }
 func (m *ListGroupPermissionsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListGroupPermissionsResponse.Unmarshal(m, b)
@@ -967,60 +1032,67 @@ var _GroupManager_serviceDesc = grpc.ServiceDesc{
 }
 
 func init() {
-	proto.RegisterFile("github.com/piotrkowalczuk/charon/pb/rpc/charond/v1/group.proto", fileDescriptor_group_a1b9c6fb366f15a1)
-}
-
-var fileDescriptor_group_a1b9c6fb366f15a1 = []byte{
-	// 802 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x6b, 0x4f, 0xdc, 0x46,
-	0x14, 0xc5, 0xeb, 0x7d, 0x71, 0x69, 0x81, 0x0e, 0xad, 0xe4, 0x1a, 0xd4, 0x6e, 0xdd, 0xd7, 0x52,
-	0xb5, 0x76, 0x59, 0x50, 0xab, 0x36, 0x52, 0x1e, 0x9b, 0x28, 0x08, 0x14, 0x14, 0x62, 0xa2, 0x28,
-	0x42, 0x8a, 0x88, 0xd7, 0x9e, 0x5d, 0x2c, 0xd6, 0x1e, 0x67, 0x3c, 0x06, 0x19, 0xe5, 0xb7, 0x24,
-	0xbf, 0x2b, 0xff, 0x26, 0xf2, 0x78, 0xec, 0x7d, 0xd8, 0xe6, 0x11, 0x3e, 0xb1, 0x33, 0x73, 0xee,
-	0x3d, 0xf7, 0xdc, 0x7b, 0x66, 0x30, 0xdc, 0x1f, 0xb9, 0xec, 0x34, 0x1a, 0xe8, 0x36, 0xf1, 0x8c,
-	0xc0, 0x25, 0x8c, 0x9e, 0x91, 0x0b, 0x6b, 0x6c, 0x5f, 0x46, 0x67, 0x86, 0x7d, 0x6a, 0x51, 0xe2,
-	0x1b, 0xc1, 0xc0, 0xa0, 0x81, 0x2d, 0x56, 0x8e, 0x71, 0xbe, 0x65, 0x8c, 0x28, 0x89, 0x02, 0x3d,
-	0xa0, 0x84, 0x11, 0xf4, 0x5d, 0xba, 0xaf, 0xd3, 0xc0, 0xd6, 0x05, 0x44, 0x3f, 0xdf, 0x52, 0x7f,
-	0x1c, 0x11, 0x32, 0x1a, 0x63, 0x83, 0x83, 0x06, 0xd1, 0xd0, 0x60, 0xae, 0x87, 0x43, 0x66, 0x79,
-	0x22, 0x4e, 0xfd, 0x61, 0x1e, 0x70, 0x41, 0xad, 0x20, 0xc0, 0x34, 0x14, 0xe7, 0x0f, 0xbe, 0xa0,
-	0x2e, 0x9b, 0x78, 0x1e, 0xf1, 0x45, 0x82, 0x35, 0x9f, 0xc5, 0x01, 0x0e, 0x8d, 0xf4, 0x4f, 0xba,
-	0xa9, 0x7d, 0xac, 0x41, 0x63, 0x37, 0xa9, 0x1e, 0x2d, 0x43, 0xcd, 0x75, 0x14, 0xa9, 0x23, 0x75,
-	0x65, 0xb3, 0xe6, 0x3a, 0x08, 0x41, 0xdd, 0xb7, 0x3c, 0xac, 0xd4, 0x3a, 0x52, 0x77, 0xd1, 0xe4,
-	0xbf, 0x51, 0x07, 0x96, 0x1c, 0x1c, 0xda, 0xd4, 0x0d, 0x98, 0x4b, 0x7c, 0x45, 0xe6, 0x47, 0xd3,
-	0x5b, 0xe8, 0x3f, 0x00, 0x9b, 0x62, 0x8b, 0x61, 0xe7, 0xc4, 0x62, 0x4a, 0xbd, 0x23, 0x75, 0x97,
-	0x7a, 0xaa, 0x9e, 0x4a, 0xd3, 0x33, 0x69, 0xfa, 0xcb, 0x4c, 0xbb, 0xb9, 0x28, 0xd0, 0x8f, 0x18,
-	0xfa, 0x73, 0x12, 0x3a, 0x88, 0x95, 0x06, 0x0f, 0xfd, 0x5a, 0x17, 0xd5, 0xee, 0xf9, 0xec, 0x9f,
-	0x9d, 0x1c, 0xdd, 0x8f, 0x13, 0xa2, 0x28, 0x70, 0x32, 0xa2, 0xe6, 0xf5, 0x44, 0x02, 0x9d, 0x12,
-	0x65, 0xa1, 0x83, 0x58, 0x69, 0x95, 0x12, 0x09, 0x40, 0x3f, 0xd6, 0x8e, 0x01, 0x3d, 0xe6, 0xac,
-	0xbc, 0x4d, 0x26, 0x7e, 0x17, 0xe1, 0x90, 0xe5, 0xdd, 0x91, 0xa6, 0xba, 0xf3, 0xf7, 0x6c, 0x77,
-	0x6a, 0x3c, 0xf1, 0x72, 0x96, 0xf8, 0x88, 0x51, 0xd7, 0x1f, 0xcd, 0x74, 0x4b, 0xdb, 0x83, 0xb5,
-	0x99, 0xdc, 0x61, 0x40, 0xfc, 0x10, 0xa3, 0x1e, 0x34, 0xb8, 0xa3, 0x78, 0xf6, 0xa5, 0xde, 0x86,
-	0x5e, 0x6a, 0x29, 0x3d, 0x0d, 0x4a, 0xa1, 0xda, 0x4f, 0xb0, 0xb2, 0x8b, 0xd9, 0x4c, 0x8d, 0x73,
-	0x13, 0xd5, 0x9e, 0xc2, 0xea, 0x04, 0x72, 0x07, 0xaa, 0x0f, 0x12, 0x7c, 0xf3, 0xcc, 0x0d, 0xd3,
-	0x4c, 0x61, 0xc6, 0xf6, 0x2b, 0x34, 0xc9, 0x70, 0x18, 0x62, 0xa6, 0x38, 0x65, 0x1d, 0x15, 0x87,
-	0xe8, 0x67, 0x68, 0x8c, 0x5d, 0xcf, 0x65, 0x0a, 0x2e, 0x43, 0xa5, 0x67, 0xe8, 0x5f, 0x68, 0x13,
-	0xea, 0x60, 0x9a, 0xcc, 0x67, 0xd8, 0x91, 0xaf, 0x28, 0xec, 0x79, 0x02, 0x33, 0x5b, 0x1c, 0xdd,
-	0x8f, 0xf7, 0xeb, 0x6d, 0x69, 0xd5, 0xd1, 0xf6, 0x01, 0x4d, 0xd7, 0x27, 0xa4, 0xee, 0x40, 0x93,
-	0xd7, 0x1f, 0x2a, 0xd2, 0x95, 0x29, 0x53, 0xad, 0x02, 0xab, 0xfd, 0x02, 0xe8, 0x09, 0x1e, 0xe3,
-	0xb9, 0xf1, 0xcf, 0xb7, 0xf6, 0x12, 0xd0, 0x01, 0x71, 0xdc, 0x61, 0x7c, 0x15, 0x0a, 0x69, 0x53,
-	0x57, 0xaa, 0xe8, 0x8c, 0x52, 0x13, 0xc9, 0x37, 0x32, 0xd1, 0x0c, 0xf7, 0x1d, 0x26, 0x4b, 0x40,
-	0x3d, 0x12, 0x0e, 0x39, 0xc4, 0xd4, 0x73, 0xc3, 0xd0, 0x25, 0x7e, 0x3e, 0xe1, 0xef, 0xa1, 0xcd,
-	0x61, 0x27, 0xb9, 0xa8, 0x16, 0x5f, 0xef, 0x39, 0xc9, 0xc3, 0x10, 0x4c, 0x02, 0x94, 0x5a, 0x47,
-	0x4e, 0x1e, 0x86, 0xa9, 0x2d, 0xf4, 0x2d, 0x34, 0x86, 0x84, 0xda, 0x98, 0x2b, 0x6a, 0x9b, 0xe9,
-	0x42, 0x23, 0xb0, 0x5e, 0x4a, 0x28, 0x34, 0x28, 0xd0, 0x12, 0x37, 0x3e, 0x23, 0x14, 0xcb, 0xe4,
-	0x84, 0x62, 0x8f, 0x9c, 0x63, 0x87, 0x77, 0x53, 0x36, 0xb3, 0x25, 0xda, 0x80, 0xc5, 0xc8, 0x67,
-	0x24, 0xb2, 0x4f, 0xb1, 0xc3, 0xc9, 0x64, 0x73, 0xb2, 0xa1, 0xfd, 0x05, 0xeb, 0xb9, 0x35, 0x4a,
-	0x24, 0xce, 0xcf, 0xf5, 0x21, 0x6c, 0x94, 0xc3, 0x45, 0x81, 0x73, 0xba, 0xa5, 0x82, 0xee, 0xde,
-	0xa7, 0x06, 0x7c, 0xc5, 0xc3, 0x0f, 0x2c, 0xdf, 0x1a, 0x61, 0x8a, 0x2c, 0x68, 0xa6, 0x77, 0x1e,
-	0x6d, 0x56, 0x8c, 0xa4, 0xf8, 0xdc, 0xa8, 0x7f, 0xdc, 0x04, 0x9a, 0xd6, 0xa4, 0x2d, 0x24, 0x14,
-	0xa9, 0x23, 0x2a, 0x29, 0x8a, 0x66, 0xad, 0xa4, 0x28, 0xf1, 0x96, 0xb6, 0x80, 0x5e, 0x83, 0xbc,
-	0x8b, 0x19, 0xfa, 0xad, 0xca, 0x55, 0xb3, 0x4f, 0x91, 0xfa, 0xfb, 0xb5, 0xb8, 0x3c, 0xf3, 0x1b,
-	0xa8, 0x27, 0x2d, 0x47, 0xdd, 0x8a, 0x90, 0xc2, 0xcb, 0xa3, 0x6e, 0xde, 0x00, 0x99, 0xa7, 0x7f,
-	0x01, 0xcd, 0xf4, 0x3e, 0x57, 0xf6, 0xa6, 0x78, 0xdd, 0xd5, 0xe2, 0x3f, 0x96, 0x3e, 0x21, 0xe3,
-	0x57, 0xd6, 0x38, 0x4a, 0x52, 0xbe, 0x87, 0x95, 0x84, 0x6a, 0xca, 0x1f, 0xa8, 0x77, 0x5d, 0x49,
-	0x45, 0xef, 0xa9, 0xdb, 0xb7, 0x8a, 0xc9, 0x05, 0xc5, 0xb0, 0x7c, 0x84, 0x67, 0xc8, 0xb7, 0x2a,
-	0x12, 0x55, 0x5f, 0x6d, 0xb5, 0x77, 0x9b, 0x90, 0x8c, 0xba, 0xff, 0x16, 0x3a, 0x36, 0xf1, 0xf4,
-	0xec, 0xc3, 0xa4, 0x2c, 0xc3, 0xa1, 0x74, 0xfc, 0xff, 0xed, 0x3f, 0x5c, 0xee, 0x89, 0x9f, 0x83,
-	0x26, 0x6f, 0xf8, 0xf6, 0xe7, 0x00, 0x00, 0x00, 0xff, 0xff, 0x61, 0x7a, 0x66, 0x78, 0x95, 0x09,
-	0x00, 0x00,
+	proto.RegisterFile("github.com/piotrkowalczuk/charon/pb/rpc/charond/v1/group.proto", fileDescriptor_group_76b75ca1bb2bcfd7)
+}
+
+var fileDescriptor_group_76b75ca1bb2bcfd7 = []byte{
+	// 919 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x6d, 0x6f, 0xe3, 0x44,
+	0x10, 0xc6, 0x79, 0x6b, 0x32, 0xbd, 0xb6, 0x77, 0x5b, 0x90, 0x8c, 0xaf, 0x40, 0xf0, 0xc1, 0x91,
+	0xab, 0x38, 0x9b, 0xe6, 0x4e, 0x20, 0x40, 0xe2, 0x25, 0x20, 0xaa, 0x20, 0x4e, 0x14, 0xb7, 0x42,
+	0xe8, 0x24, 0x14, 0x1c, 0xef, 0x26, 0x5d, 0x35, 0xf6, 0x9a, 0xf5, 0xba, 0x47, 0x10, 0xff, 0x83,
+	0x6f, 0x7c, 0xe4, 0xcf, 0xf0, 0x0b, 0xf8, 0xca, 0x2f, 0x41, 0xde, 0x5d, 0xbb, 0x4e, 0xe3, 0xa4,
+	0xed, 0xf5, 0x53, 0xb3, 0x33, 0xcf, 0xcc, 0x3c, 0xf3, 0xec, 0xec, 0xd4, 0xf0, 0xd9, 0x94, 0x8a,
+	0xd3, 0x74, 0xec, 0x04, 0x2c, 0x74, 0x63, 0xca, 0x04, 0x3f, 0x63, 0x2f, 0xfc, 0x59, 0xf0, 0x7b,
+	0x7a, 0xe6, 0x06, 0xa7, 0x3e, 0x67, 0x91, 0x1b, 0x8f, 0x5d, 0x1e, 0x07, 0xfa, 0x84, 0xdd, 0xf3,
+	0x03, 0x77, 0xca, 0x59, 0x1a, 0x3b, 0x31, 0x67, 0x82, 0xa1, 0xd7, 0x94, 0xdd, 0xe1, 0x71, 0xe0,
+	0x68, 0x88, 0x73, 0x7e, 0x60, 0xbd, 0x35, 0x65, 0x6c, 0x3a, 0x23, 0xae, 0x04, 0x8d, 0xd3, 0x89,
+	0x2b, 0x68, 0x48, 0x12, 0xe1, 0x87, 0x3a, 0xce, 0x7a, 0xf3, 0x32, 0xe0, 0x05, 0xf7, 0xe3, 0x98,
+	0xf0, 0x44, 0xfb, 0x3f, 0x7f, 0x09, 0x5e, 0x01, 0x0b, 0x43, 0x16, 0xe9, 0x04, 0xbb, 0x91, 0x98,
+	0xc7, 0x24, 0x71, 0xd5, 0x1f, 0x65, 0xb4, 0xff, 0xa9, 0x41, 0xf3, 0x30, 0x63, 0x8f, 0xb6, 0xa1,
+	0x46, 0xb1, 0x69, 0x74, 0x8d, 0x5e, 0xdd, 0xab, 0x51, 0x8c, 0x10, 0x34, 0x22, 0x3f, 0x24, 0x66,
+	0xad, 0x6b, 0xf4, 0x3a, 0x9e, 0xfc, 0x8d, 0xba, 0xb0, 0x89, 0x49, 0x12, 0x70, 0x1a, 0x0b, 0xca,
+	0x22, 0xb3, 0x2e, 0x5d, 0x65, 0x13, 0xfa, 0x18, 0x20, 0xe0, 0xc4, 0x17, 0x04, 0x8f, 0x7c, 0x61,
+	0x36, 0xba, 0x46, 0x6f, 0xb3, 0x6f, 0x39, 0xaa, 0x35, 0x27, 0x6f, 0xcd, 0x39, 0xc9, 0x7b, 0xf7,
+	0x3a, 0x1a, 0xfd, 0xa5, 0x40, 0xef, 0x5f, 0x84, 0x8e, 0xe7, 0x66, 0x53, 0x86, 0x6e, 0x39, 0x9a,
+	0xed, 0x30, 0x12, 0x1f, 0x3e, 0x2d, 0xd0, 0x83, 0x79, 0x56, 0x28, 0x8d, 0x71, 0x5e, 0xa8, 0x75,
+	0x75, 0x21, 0x8d, 0x56, 0x85, 0xf2, 0xd0, 0xf1, 0xdc, 0xdc, 0xa8, 0x2c, 0xa4, 0x01, 0x83, 0x39,
+	0xda, 0x87, 0x4e, 0xec, 0x73, 0x12, 0x89, 0x11, 0xc5, 0x66, 0xbb, 0x0a, 0xdc, 0x56, 0xfe, 0x21,
+	0xb6, 0x9f, 0x03, 0xfa, 0x4a, 0x32, 0x94, 0x92, 0x7a, 0xe4, 0xd7, 0x94, 0x24, 0xa2, 0x50, 0xd2,
+	0x28, 0x29, 0xf9, 0xc1, 0xa2, 0x92, 0x35, 0x99, 0x77, 0x3b, 0xcf, 0x7b, 0x2c, 0x38, 0x8d, 0xa6,
+	0x0b, 0xca, 0xda, 0x43, 0xd8, 0x5d, 0xc8, 0x9d, 0xc4, 0x2c, 0x4a, 0x08, 0xea, 0x43, 0x53, 0x4e,
+	0x9f, 0xcc, 0xbe, 0xd9, 0xdf, 0x73, 0x2a, 0xc7, 0xcf, 0x51, 0x41, 0x0a, 0x6a, 0xbf, 0x0d, 0x3b,
+	0x87, 0x44, 0x2c, 0x70, 0xbc, 0x74, 0xfb, 0xf6, 0x37, 0x70, 0xf7, 0x02, 0x72, 0x8b, 0x52, 0xff,
+	0x19, 0x70, 0xef, 0x3b, 0x9a, 0xa8, 0x4c, 0x49, 0x5e, 0xed, 0x5d, 0x68, 0xb1, 0xc9, 0x24, 0x21,
+	0xc2, 0xc4, 0x55, 0x82, 0x6a, 0x27, 0x7a, 0x00, 0xcd, 0x19, 0x0d, 0xa9, 0x30, 0x49, 0x15, 0x4a,
+	0xf9, 0xd0, 0x47, 0xd0, 0x66, 0x1c, 0x13, 0x9e, 0xdd, 0xe5, 0xa4, 0x5b, 0x5f, 0x43, 0xec, 0xfb,
+	0x0c, 0xe6, 0x6d, 0x48, 0xf4, 0x60, 0x8e, 0xde, 0x00, 0x88, 0xfd, 0x29, 0x19, 0x09, 0x76, 0x46,
+	0x22, 0x73, 0x2a, 0x2f, 0xa7, 0x93, 0x59, 0x4e, 0x32, 0x03, 0x7a, 0x00, 0x5b, 0x34, 0x0a, 0x66,
+	0x29, 0xce, 0x10, 0xc2, 0x9f, 0x99, 0xa7, 0x5d, 0xa3, 0xd7, 0xf6, 0xee, 0x68, 0xe3, 0x49, 0x66,
+	0xfb, 0xb6, 0xd1, 0x36, 0xee, 0x62, 0xfb, 0x4f, 0x03, 0x50, 0xb9, 0x49, 0xad, 0xd7, 0x53, 0x68,
+	0x49, 0x11, 0x12, 0xd3, 0x58, 0xcb, 0x4b, 0x09, 0xa6, 0xb1, 0xe8, 0x21, 0xec, 0x44, 0xe4, 0x37,
+	0x31, 0x2a, 0x71, 0x53, 0x4f, 0x70, 0x2b, 0x33, 0x1f, 0x95, 0xf8, 0x35, 0x15, 0xaf, 0x7a, 0xa5,
+	0x38, 0xd2, 0x67, 0xbf, 0x03, 0xe8, 0x6b, 0x32, 0x23, 0x82, 0xac, 0xbd, 0xec, 0xbf, 0x0d, 0x40,
+	0xcf, 0x18, 0xa6, 0x93, 0xf9, 0x3a, 0x18, 0xb2, 0x4b, 0x1b, 0x61, 0x79, 0x58, 0x2b, 0xe7, 0xba,
+	0x7e, 0xe5, 0x5c, 0x2f, 0xbe, 0xaf, 0xc6, 0xfa, 0xf7, 0x35, 0x84, 0xdd, 0x05, 0x9e, 0xb7, 0x18,
+	0xcc, 0xbf, 0x0c, 0xb0, 0x8e, 0xf5, 0x84, 0x1f, 0x11, 0x1e, 0xd2, 0x24, 0xa1, 0x2c, 0x2a, 0x26,
+	0xf4, 0x75, 0x68, 0x4b, 0xdc, 0xa8, 0x50, 0x60, 0x43, 0x9e, 0x87, 0x38, 0x5b, 0x82, 0xf1, 0x45,
+	0x80, 0x59, 0xeb, 0xd6, 0xb3, 0x25, 0x58, 0x32, 0xa1, 0x57, 0xa1, 0x39, 0x61, 0x3c, 0x20, 0xb2,
+	0xfd, 0xb6, 0xa7, 0x0e, 0xe8, 0x31, 0x20, 0x4c, 0x22, 0x4a, 0xf0, 0xa8, 0x1c, 0xde, 0x90, 0xe1,
+	0xf7, 0x94, 0xa7, 0x44, 0xc4, 0x66, 0x70, 0xbf, 0x92, 0x9f, 0xee, 0xd9, 0x84, 0x0d, 0xbd, 0x0c,
+	0x73, 0x7e, 0xfa, 0x98, 0x79, 0x38, 0x09, 0xd9, 0x39, 0xc1, 0xf2, 0xa6, 0xea, 0x5e, 0x7e, 0x44,
+	0x7b, 0xd0, 0x49, 0x23, 0xc1, 0xd2, 0xe0, 0x94, 0x60, 0xc9, 0xad, 0xee, 0x5d, 0x18, 0xec, 0xc7,
+	0x70, 0xbf, 0x18, 0xe2, 0x0a, 0x45, 0x2e, 0x0f, 0xcd, 0x17, 0xb0, 0x57, 0x0d, 0xd7, 0x04, 0x2f,
+	0xc9, 0x64, 0x2c, 0xc9, 0xd4, 0xff, 0xb7, 0x09, 0x77, 0x64, 0xf8, 0x33, 0x3f, 0xf2, 0xa7, 0x84,
+	0x23, 0x1f, 0x5a, 0x6a, 0xc5, 0xa1, 0x47, 0x2b, 0xae, 0x70, 0x79, 0xbb, 0x5a, 0xfb, 0xd7, 0x81,
+	0x2a, 0x4e, 0xf6, 0x2b, 0x59, 0x09, 0x35, 0x41, 0x2b, 0x4b, 0x2c, 0x3f, 0x04, 0x6b, 0xff, 0x3a,
+	0xd0, 0xa2, 0xc4, 0x4f, 0x50, 0x3f, 0x24, 0x02, 0x3d, 0x5c, 0x35, 0x85, 0x8b, 0x9b, 0xd7, 0x7a,
+	0xef, 0x4a, 0x5c, 0x91, 0xf9, 0x67, 0x68, 0x64, 0x92, 0xa3, 0xde, 0x8a, 0x90, 0xa5, 0x45, 0x6b,
+	0x3d, 0xba, 0x06, 0xb2, 0x48, 0xff, 0x03, 0xb4, 0xd4, 0xb2, 0x58, 0xa9, 0xcd, 0xf2, 0x2e, 0xb1,
+	0x96, 0xff, 0xe7, 0x0e, 0x18, 0x9b, 0xfd, 0xe8, 0xcf, 0xd2, 0x2c, 0xe5, 0x1f, 0xb0, 0x93, 0x95,
+	0x2a, 0xcd, 0x07, 0xea, 0x5f, 0x45, 0x69, 0x79, 0xf6, 0xac, 0x27, 0x37, 0x8a, 0x29, 0x1a, 0x9a,
+	0xc3, 0xf6, 0x31, 0x59, 0x28, 0x7e, 0xb0, 0x22, 0xd1, 0xea, 0x4d, 0x60, 0xf5, 0x6f, 0x12, 0x92,
+	0x97, 0x1e, 0xfc, 0x02, 0xdd, 0x80, 0x85, 0x4e, 0xfe, 0xcd, 0x56, 0x95, 0xe1, 0xc8, 0x78, 0xfe,
+	0xc9, 0xcd, 0xbf, 0xe9, 0x3e, 0xd5, 0x3f, 0xc7, 0x2d, 0x29, 0xf8, 0x93, 0xff, 0x07, 0x00, 0x5d,
+	0xfa, 0x5d, 0xa5, 0xb0, 0x0a, 0x00, 0x00,
 }
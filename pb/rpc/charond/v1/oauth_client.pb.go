@@ -0,0 +1,670 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: github.com/piotrkowalczuk/charon/pb/rpc/charond/v1/oauth_client.proto
+
+package charond // import "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+import wrappers "github.com/golang/protobuf/ptypes/wrappers"
+import ntypes "github.com/piotrkowalczuk/ntypes"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type OAuthClient struct {
+	Id           int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ClientId     string   `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Name         string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	RedirectUris []string `protobuf:"bytes,4,rep,name=redirect_uris,json=redirectUris,proto3" json:"redirect_uris,omitempty"`
+	// Confidential clients authenticate with a secret, public ones have to use PKCE.
+	Confidential         bool                 `protobuf:"varint,5,opt,name=confidential,proto3" json:"confidential,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy            *ntypes.Int64        `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedBy            *ntypes.Int64        `protobuf:"bytes,9,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *OAuthClient) Reset()         { *m = OAuthClient{} }
+func (m *OAuthClient) String() string { return proto.CompactTextString(m) }
+func (*OAuthClient) ProtoMessage()    {}
+func (*OAuthClient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_oauth_client_4141829d934e6a7c, []int{0}
+}
+func (m *OAuthClient) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OAuthClient.Unmarshal(m, b)
+}
+func (m *OAuthClient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OAuthClient.Marshal(b, m, deterministic)
+}
+func (dst *OAuthClient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OAuthClient.Merge(dst, src)
+}
+func (m *OAuthClient) XXX_Size() int {
+	return xxx_messageInfo_OAuthClient.Size(m)
+}
+func (m *OAuthClient) XXX_DiscardUnknown() {
+	xxx_messageInfo_OAuthClient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OAuthClient proto.InternalMessageInfo
+
+func (m *OAuthClient) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *OAuthClient) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *OAuthClient) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *OAuthClient) GetRedirectUris() []string {
+	if m != nil {
+		return m.RedirectUris
+	}
+	return nil
+}
+
+func (m *OAuthClient) GetConfidential() bool {
+	if m != nil {
+		return m.Confidential
+	}
+	return false
+}
+
+func (m *OAuthClient) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *OAuthClient) GetCreatedBy() *ntypes.Int64 {
+	if m != nil {
+		return m.CreatedBy
+	}
+	return nil
+}
+
+func (m *OAuthClient) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+func (m *OAuthClient) GetUpdatedBy() *ntypes.Int64 {
+	if m != nil {
+		return m.UpdatedBy
+	}
+	return nil
+}
+
+type CreateOAuthClientRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	RedirectUris         []string `protobuf:"bytes,2,rep,name=redirect_uris,json=redirectUris,proto3" json:"redirect_uris,omitempty"`
+	Confidential         bool     `protobuf:"varint,3,opt,name=confidential,proto3" json:"confidential,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateOAuthClientRequest) Reset()         { *m = CreateOAuthClientRequest{} }
+func (m *CreateOAuthClientRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateOAuthClientRequest) ProtoMessage()    {}
+func (*CreateOAuthClientRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_oauth_client_4141829d934e6a7c, []int{1}
+}
+func (m *CreateOAuthClientRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateOAuthClientRequest.Unmarshal(m, b)
+}
+func (m *CreateOAuthClientRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateOAuthClientRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateOAuthClientRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateOAuthClientRequest.Merge(dst, src)
+}
+func (m *CreateOAuthClientRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateOAuthClientRequest.Size(m)
+}
+func (m *CreateOAuthClientRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateOAuthClientRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateOAuthClientRequest proto.InternalMessageInfo
+
+func (m *CreateOAuthClientRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateOAuthClientRequest) GetRedirectUris() []string {
+	if m != nil {
+		return m.RedirectUris
+	}
+	return nil
+}
+
+func (m *CreateOAuthClientRequest) GetConfidential() bool {
+	if m != nil {
+		return m.Confidential
+	}
+	return false
+}
+
+type CreateOAuthClientResponse struct {
+	Client *OAuthClient `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	// Secret is returned only once, it is empty for public clients.
+	Secret               string   `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateOAuthClientResponse) Reset()         { *m = CreateOAuthClientResponse{} }
+func (m *CreateOAuthClientResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateOAuthClientResponse) ProtoMessage()    {}
+func (*CreateOAuthClientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_oauth_client_4141829d934e6a7c, []int{2}
+}
+func (m *CreateOAuthClientResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateOAuthClientResponse.Unmarshal(m, b)
+}
+func (m *CreateOAuthClientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateOAuthClientResponse.Marshal(b, m, deterministic)
+}
+func (dst *CreateOAuthClientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateOAuthClientResponse.Merge(dst, src)
+}
+func (m *CreateOAuthClientResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateOAuthClientResponse.Size(m)
+}
+func (m *CreateOAuthClientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateOAuthClientResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateOAuthClientResponse proto.InternalMessageInfo
+
+func (m *CreateOAuthClientResponse) GetClient() *OAuthClient {
+	if m != nil {
+		return m.Client
+	}
+	return nil
+}
+
+func (m *CreateOAuthClientResponse) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+type GetOAuthClientRequest struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetOAuthClientRequest) Reset()         { *m = GetOAuthClientRequest{} }
+func (m *GetOAuthClientRequest) String() string { return proto.CompactTextString(m) }
+func (*GetOAuthClientRequest) ProtoMessage()    {}
+func (*GetOAuthClientRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_oauth_client_4141829d934e6a7c, []int{3}
+}
+func (m *GetOAuthClientRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOAuthClientRequest.Unmarshal(m, b)
+}
+func (m *GetOAuthClientRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOAuthClientRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetOAuthClientRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOAuthClientRequest.Merge(dst, src)
+}
+func (m *GetOAuthClientRequest) XXX_Size() int {
+	return xxx_messageInfo_GetOAuthClientRequest.Size(m)
+}
+func (m *GetOAuthClientRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOAuthClientRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOAuthClientRequest proto.InternalMessageInfo
+
+func (m *GetOAuthClientRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GetOAuthClientResponse struct {
+	Client               *OAuthClient `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *GetOAuthClientResponse) Reset()         { *m = GetOAuthClientResponse{} }
+func (m *GetOAuthClientResponse) String() string { return proto.CompactTextString(m) }
+func (*GetOAuthClientResponse) ProtoMessage()    {}
+func (*GetOAuthClientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_oauth_client_4141829d934e6a7c, []int{4}
+}
+func (m *GetOAuthClientResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOAuthClientResponse.Unmarshal(m, b)
+}
+func (m *GetOAuthClientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOAuthClientResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetOAuthClientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOAuthClientResponse.Merge(dst, src)
+}
+func (m *GetOAuthClientResponse) XXX_Size() int {
+	return xxx_messageInfo_GetOAuthClientResponse.Size(m)
+}
+func (m *GetOAuthClientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOAuthClientResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOAuthClientResponse proto.InternalMessageInfo
+
+func (m *GetOAuthClientResponse) GetClient() *OAuthClient {
+	if m != nil {
+		return m.Client
+	}
+	return nil
+}
+
+type ListOAuthClientsRequest struct {
+	Offset               *ntypes.Int64 `protobuf:"bytes,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                *ntypes.Int64 `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	OrderBy              []*Order      `protobuf:"bytes,3,rep,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ListOAuthClientsRequest) Reset()         { *m = ListOAuthClientsRequest{} }
+func (m *ListOAuthClientsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListOAuthClientsRequest) ProtoMessage()    {}
+func (*ListOAuthClientsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_oauth_client_4141829d934e6a7c, []int{5}
+}
+func (m *ListOAuthClientsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListOAuthClientsRequest.Unmarshal(m, b)
+}
+func (m *ListOAuthClientsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListOAuthClientsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListOAuthClientsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOAuthClientsRequest.Merge(dst, src)
+}
+func (m *ListOAuthClientsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListOAuthClientsRequest.Size(m)
+}
+func (m *ListOAuthClientsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOAuthClientsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListOAuthClientsRequest proto.InternalMessageInfo
+
+func (m *ListOAuthClientsRequest) GetOffset() *ntypes.Int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return nil
+}
+
+func (m *ListOAuthClientsRequest) GetLimit() *ntypes.Int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return nil
+}
+
+func (m *ListOAuthClientsRequest) GetOrderBy() []*Order {
+	if m != nil {
+		return m.OrderBy
+	}
+	return nil
+}
+
+type ListOAuthClientsResponse struct {
+	Clients              []*OAuthClient `protobuf:"bytes,1,rep,name=clients,proto3" json:"clients,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListOAuthClientsResponse) Reset()         { *m = ListOAuthClientsResponse{} }
+func (m *ListOAuthClientsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListOAuthClientsResponse) ProtoMessage()    {}
+func (*ListOAuthClientsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_oauth_client_4141829d934e6a7c, []int{6}
+}
+func (m *ListOAuthClientsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListOAuthClientsResponse.Unmarshal(m, b)
+}
+func (m *ListOAuthClientsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListOAuthClientsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListOAuthClientsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOAuthClientsResponse.Merge(dst, src)
+}
+func (m *ListOAuthClientsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListOAuthClientsResponse.Size(m)
+}
+func (m *ListOAuthClientsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOAuthClientsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListOAuthClientsResponse proto.InternalMessageInfo
+
+func (m *ListOAuthClientsResponse) GetClients() []*OAuthClient {
+	if m != nil {
+		return m.Clients
+	}
+	return nil
+}
+
+type DeleteOAuthClientRequest struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteOAuthClientRequest) Reset()         { *m = DeleteOAuthClientRequest{} }
+func (m *DeleteOAuthClientRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteOAuthClientRequest) ProtoMessage()    {}
+func (*DeleteOAuthClientRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_oauth_client_4141829d934e6a7c, []int{7}
+}
+func (m *DeleteOAuthClientRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteOAuthClientRequest.Unmarshal(m, b)
+}
+func (m *DeleteOAuthClientRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteOAuthClientRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteOAuthClientRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteOAuthClientRequest.Merge(dst, src)
+}
+func (m *DeleteOAuthClientRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteOAuthClientRequest.Size(m)
+}
+func (m *DeleteOAuthClientRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteOAuthClientRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteOAuthClientRequest proto.InternalMessageInfo
+
+func (m *DeleteOAuthClientRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*OAuthClient)(nil), "charon.rpc.charond.v1.OAuthClient")
+	proto.RegisterType((*CreateOAuthClientRequest)(nil), "charon.rpc.charond.v1.CreateOAuthClientRequest")
+	proto.RegisterType((*CreateOAuthClientResponse)(nil), "charon.rpc.charond.v1.CreateOAuthClientResponse")
+	proto.RegisterType((*GetOAuthClientRequest)(nil), "charon.rpc.charond.v1.GetOAuthClientRequest")
+	proto.RegisterType((*GetOAuthClientResponse)(nil), "charon.rpc.charond.v1.GetOAuthClientResponse")
+	proto.RegisterType((*ListOAuthClientsRequest)(nil), "charon.rpc.charond.v1.ListOAuthClientsRequest")
+	proto.RegisterType((*ListOAuthClientsResponse)(nil), "charon.rpc.charond.v1.ListOAuthClientsResponse")
+	proto.RegisterType((*DeleteOAuthClientRequest)(nil), "charon.rpc.charond.v1.DeleteOAuthClientRequest")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// OAuthClientManagerClient is the client API for OAuthClientManager service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type OAuthClientManagerClient interface {
+	Create(ctx context.Context, in *CreateOAuthClientRequest, opts ...grpc.CallOption) (*CreateOAuthClientResponse, error)
+	Get(ctx context.Context, in *GetOAuthClientRequest, opts ...grpc.CallOption) (*GetOAuthClientResponse, error)
+	List(ctx context.Context, in *ListOAuthClientsRequest, opts ...grpc.CallOption) (*ListOAuthClientsResponse, error)
+	// Delete removes the client along with consents users gave it.
+	Delete(ctx context.Context, in *DeleteOAuthClientRequest, opts ...grpc.CallOption) (*wrappers.BoolValue, error)
+}
+
+type oAuthClientManagerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewOAuthClientManagerClient(cc *grpc.ClientConn) OAuthClientManagerClient {
+	return &oAuthClientManagerClient{cc}
+}
+
+func (c *oAuthClientManagerClient) Create(ctx context.Context, in *CreateOAuthClientRequest, opts ...grpc.CallOption) (*CreateOAuthClientResponse, error) {
+	out := new(CreateOAuthClientResponse)
+	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.OAuthClientManager/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oAuthClientManagerClient) Get(ctx context.Context, in *GetOAuthClientRequest, opts ...grpc.CallOption) (*GetOAuthClientResponse, error) {
+	out := new(GetOAuthClientResponse)
+	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.OAuthClientManager/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oAuthClientManagerClient) List(ctx context.Context, in *ListOAuthClientsRequest, opts ...grpc.CallOption) (*ListOAuthClientsResponse, error) {
+	out := new(ListOAuthClientsResponse)
+	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.OAuthClientManager/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oAuthClientManagerClient) Delete(ctx context.Context, in *DeleteOAuthClientRequest, opts ...grpc.CallOption) (*wrappers.BoolValue, error) {
+	out := new(wrappers.BoolValue)
+	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.OAuthClientManager/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OAuthClientManagerServer is the server API for OAuthClientManager service.
+type OAuthClientManagerServer interface {
+	Create(context.Context, *CreateOAuthClientRequest) (*CreateOAuthClientResponse, error)
+	Get(context.Context, *GetOAuthClientRequest) (*GetOAuthClientResponse, error)
+	List(context.Context, *ListOAuthClientsRequest) (*ListOAuthClientsResponse, error)
+	// Delete removes the client along with consents users gave it.
+	Delete(context.Context, *DeleteOAuthClientRequest) (*wrappers.BoolValue, error)
+}
+
+func RegisterOAuthClientManagerServer(s *grpc.Server, srv OAuthClientManagerServer) {
+	s.RegisterService(&_OAuthClientManager_serviceDesc, srv)
+}
+
+func _OAuthClientManager_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOAuthClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthClientManagerServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/charon.rpc.charond.v1.OAuthClientManager/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthClientManagerServer).Create(ctx, req.(*CreateOAuthClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OAuthClientManager_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOAuthClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthClientManagerServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/charon.rpc.charond.v1.OAuthClientManager/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthClientManagerServer).Get(ctx, req.(*GetOAuthClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OAuthClientManager_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOAuthClientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthClientManagerServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/charon.rpc.charond.v1.OAuthClientManager/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthClientManagerServer).List(ctx, req.(*ListOAuthClientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OAuthClientManager_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOAuthClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthClientManagerServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/charon.rpc.charond.v1.OAuthClientManager/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthClientManagerServer).Delete(ctx, req.(*DeleteOAuthClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _OAuthClientManager_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "charon.rpc.charond.v1.OAuthClientManager",
+	HandlerType: (*OAuthClientManagerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _OAuthClientManager_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _OAuthClientManager_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _OAuthClientManager_List_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _OAuthClientManager_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1/oauth_client.proto",
+}
+
+func init() {
+	proto.RegisterFile("github.com/piotrkowalczuk/charon/pb/rpc/charond/v1/oauth_client.proto", fileDescriptor_oauth_client_4141829d934e6a7c)
+}
+
+var fileDescriptor_oauth_client_4141829d934e6a7c = []byte{
+	// 648 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xef, 0x6e, 0xd3, 0x3e,
+	0x14, 0x5d, 0x9a, 0x2d, 0x6b, 0x6f, 0xb7, 0xdf, 0x07, 0xff, 0xb4, 0x11, 0x02, 0x82, 0x28, 0x13,
+	0xa2, 0x42, 0x23, 0x61, 0x05, 0x81, 0x18, 0x48, 0x68, 0x1d, 0x68, 0x9a, 0x04, 0x02, 0x45, 0xe3,
+	0x8f, 0xf8, 0x52, 0xd2, 0xc4, 0x6d, 0xad, 0x25, 0x71, 0xb0, 0x9d, 0x55, 0xe5, 0x61, 0x78, 0x03,
+	0x1e, 0x8c, 0xb7, 0x40, 0x8d, 0x9d, 0x52, 0xda, 0x86, 0x15, 0xf8, 0xd4, 0xe4, 0xfa, 0x1c, 0x9f,
+	0x7b, 0xce, 0xbd, 0x6a, 0xe0, 0xc5, 0x80, 0x88, 0x61, 0xde, 0x73, 0x43, 0x9a, 0x78, 0x19, 0xa1,
+	0x82, 0x9d, 0xd3, 0x51, 0x10, 0x87, 0x5f, 0xf2, 0x73, 0x2f, 0x1c, 0x06, 0x8c, 0xa6, 0x5e, 0xd6,
+	0xf3, 0x58, 0x16, 0xaa, 0xb7, 0xc8, 0xbb, 0x38, 0xf0, 0x68, 0x90, 0x8b, 0x61, 0x37, 0x8c, 0x09,
+	0x4e, 0x85, 0x9b, 0x31, 0x2a, 0x28, 0xda, 0x91, 0xc7, 0x2e, 0xcb, 0x42, 0x57, 0x21, 0xdd, 0x8b,
+	0x03, 0xeb, 0xe6, 0x80, 0xd2, 0x41, 0x8c, 0xbd, 0x02, 0xd4, 0xcb, 0xfb, 0x9e, 0x20, 0x09, 0xe6,
+	0x22, 0x48, 0x32, 0xc9, 0xb3, 0x6e, 0xcc, 0x03, 0x46, 0x2c, 0xc8, 0x32, 0xcc, 0xb8, 0x3a, 0x7f,
+	0xf6, 0x17, 0xed, 0x85, 0x34, 0x49, 0x68, 0xaa, 0x2e, 0xf8, 0x3f, 0x15, 0xe3, 0x0c, 0x73, 0x4f,
+	0xfe, 0xc8, 0xa2, 0xf3, 0xbd, 0x06, 0xcd, 0xd7, 0x47, 0xb9, 0x18, 0x1e, 0x17, 0x1e, 0xd0, 0x7f,
+	0x50, 0x23, 0x91, 0xa9, 0xd9, 0x5a, 0x4b, 0xf7, 0x6b, 0x24, 0x42, 0xd7, 0xa0, 0x21, 0xdd, 0x75,
+	0x49, 0x64, 0xd6, 0x6c, 0xad, 0xd5, 0xf0, 0xeb, 0xb2, 0x70, 0x1a, 0x21, 0x04, 0xeb, 0x69, 0x90,
+	0x60, 0x53, 0x2f, 0xea, 0xc5, 0x33, 0xda, 0x83, 0x6d, 0x86, 0x23, 0xc2, 0x70, 0x28, 0xba, 0x39,
+	0x23, 0xdc, 0x5c, 0xb7, 0xf5, 0x56, 0xc3, 0xdf, 0x2a, 0x8b, 0x6f, 0x19, 0xe1, 0xc8, 0x81, 0xad,
+	0x90, 0xa6, 0x7d, 0x12, 0xe1, 0x54, 0x90, 0x20, 0x36, 0x37, 0x6c, 0xad, 0x55, 0xf7, 0x7f, 0xa9,
+	0xa1, 0xc7, 0x00, 0x21, 0xc3, 0x81, 0xc0, 0x51, 0x37, 0x10, 0xa6, 0x61, 0x6b, 0xad, 0x66, 0xdb,
+	0x72, 0x65, 0x48, 0x6e, 0x19, 0x92, 0x7b, 0x56, 0xa6, 0xe8, 0x37, 0x14, 0xfa, 0x48, 0xa0, 0xfd,
+	0x9f, 0xd4, 0xde, 0xd8, 0xdc, 0x2c, 0xa8, 0xdb, 0xae, 0xf2, 0x7d, 0x9a, 0x8a, 0x87, 0x0f, 0xa6,
+	0xe8, 0xce, 0x78, 0x22, 0x94, 0x67, 0x51, 0x29, 0x54, 0xbf, 0x5c, 0x48, 0xa1, 0xa5, 0x50, 0x49,
+	0xed, 0x8d, 0xcd, 0xc6, 0x52, 0x21, 0x05, 0xe8, 0x8c, 0x9d, 0x11, 0x98, 0xc7, 0x85, 0xea, 0x4c,
+	0xe0, 0x3e, 0xfe, 0x9c, 0x63, 0x2e, 0xa6, 0x51, 0x6a, 0xbf, 0x8b, 0xb2, 0xb6, 0x42, 0x94, 0xfa,
+	0x62, 0x94, 0x0e, 0x85, 0xab, 0x4b, 0x84, 0x79, 0x46, 0x53, 0x8e, 0xd1, 0x21, 0x18, 0x72, 0xa0,
+	0x85, 0x76, 0xb3, 0xed, 0xb8, 0x4b, 0x17, 0xd8, 0x9d, 0xe5, 0x2a, 0x06, 0xda, 0x05, 0x83, 0xe3,
+	0x90, 0x61, 0xa1, 0x56, 0x43, 0xbd, 0x39, 0xb7, 0x61, 0xe7, 0x04, 0x8b, 0x25, 0x36, 0xe7, 0xd6,
+	0xcb, 0x39, 0x83, 0xdd, 0x79, 0xe0, 0xbf, 0xb7, 0xe5, 0x7c, 0xd5, 0xe0, 0xca, 0x4b, 0xc2, 0x67,
+	0xef, 0xe5, 0x65, 0x07, 0xb7, 0xc0, 0xa0, 0xfd, 0x3e, 0xc7, 0xe5, 0xbd, 0x73, 0xe3, 0x52, 0x87,
+	0x68, 0x0f, 0x36, 0x62, 0x92, 0x10, 0x69, 0x6c, 0x01, 0x25, 0xcf, 0xd0, 0x23, 0xa8, 0x53, 0x16,
+	0x61, 0x36, 0x19, 0xbe, 0x6e, 0xeb, 0xad, 0x66, 0xfb, 0x7a, 0x55, 0x97, 0x13, 0x98, 0xbf, 0x59,
+	0xa0, 0x3b, 0x63, 0xe7, 0x03, 0x98, 0x8b, 0xfd, 0x29, 0xe3, 0x4f, 0x61, 0x53, 0xda, 0xe0, 0xa6,
+	0x66, 0xeb, 0x2b, 0x3a, 0x2f, 0x29, 0xce, 0x1d, 0x30, 0x9f, 0xe3, 0x18, 0x0b, 0x7c, 0x79, 0xf8,
+	0xed, 0x6f, 0x3a, 0xa0, 0x19, 0xd8, 0xab, 0x20, 0x0d, 0x06, 0x98, 0xa1, 0x04, 0x0c, 0xb9, 0x2d,
+	0xc8, 0xab, 0x50, 0xae, 0xda, 0x62, 0xeb, 0xde, 0xea, 0x04, 0xe9, 0xd6, 0x59, 0x43, 0x11, 0xe8,
+	0x27, 0x58, 0xa0, 0xfd, 0x0a, 0xea, 0xd2, 0x3d, 0xb2, 0xee, 0xae, 0x88, 0x9e, 0xaa, 0x10, 0x58,
+	0x9f, 0x24, 0x8e, 0xdc, 0x0a, 0x62, 0xc5, 0xba, 0x58, 0xde, 0xca, 0xf8, 0xa9, 0xd4, 0x7b, 0x30,
+	0xe4, 0x08, 0x2a, 0xf3, 0xab, 0x9a, 0x90, 0xb5, 0xf8, 0xb7, 0xd3, 0xa1, 0x34, 0x7e, 0x17, 0xc4,
+	0x39, 0x76, 0xd6, 0x3a, 0x9f, 0xc0, 0x0e, 0x69, 0xe2, 0x96, 0xdf, 0x81, 0x65, 0x57, 0xbf, 0xd1,
+	0x3e, 0x1e, 0xfe, 0xf9, 0x77, 0xe2, 0x89, 0x7a, 0xec, 0x19, 0x85, 0xee, 0xfd, 0x1f, 0x03, 0x00,
+	0xfa, 0x8e, 0x58, 0x56, 0x0b, 0x07, 0x00, 0x00,
+}
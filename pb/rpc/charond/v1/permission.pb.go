@@ -36,7 +36,7 @@ func (m *RegisterPermissionsRequest) Reset()         { *m = RegisterPermissionsR
 func (m *RegisterPermissionsRequest) String() string { return proto.CompactTextString(m) }
 func (*RegisterPermissionsRequest) ProtoMessage()    {}
 func (*RegisterPermissionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_permission_466dba486a6a79c2, []int{0}
+	return fileDescriptor_permission_1155a690da2d028e, []int{0}
 }
 func (m *RegisterPermissionsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RegisterPermissionsRequest.Unmarshal(m, b)
@@ -76,7 +76,7 @@ func (m *RegisterPermissionsResponse) Reset()         { *m = RegisterPermissions
 func (m *RegisterPermissionsResponse) String() string { return proto.CompactTextString(m) }
 func (*RegisterPermissionsResponse) ProtoMessage()    {}
 func (*RegisterPermissionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_permission_466dba486a6a79c2, []int{1}
+	return fileDescriptor_permission_1155a690da2d028e, []int{1}
 }
 func (m *RegisterPermissionsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RegisterPermissionsResponse.Unmarshal(m, b)
@@ -118,25 +118,30 @@ func (m *RegisterPermissionsResponse) GetUntouched() int64 {
 }
 
 type ListPermissionsRequest struct {
-	Subsystem            *qtypes.String    `protobuf:"bytes,1,opt,name=subsystem,proto3" json:"subsystem,omitempty"`
-	Module               *qtypes.String    `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
-	Action               *qtypes.String    `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
-	CreatedAt            *qtypes.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	CreatedBy            *qtypes.Int64     `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	Offset               *ntypes.Int64     `protobuf:"bytes,100,opt,name=offset,proto3" json:"offset,omitempty"`
-	Limit                *ntypes.Int64     `protobuf:"bytes,101,opt,name=limit,proto3" json:"limit,omitempty"`
-	Sort                 map[string]bool   `protobuf:"bytes,102,rep,name=sort,proto3" json:"sort,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // Deprecated: Do not use.
-	OrderBy              []*Order          `protobuf:"bytes,103,rep,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Subsystem *qtypes.String    `protobuf:"bytes,1,opt,name=subsystem,proto3" json:"subsystem,omitempty"`
+	Module    *qtypes.String    `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
+	Action    *qtypes.String    `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	CreatedAt *qtypes.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy *qtypes.Int64     `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	Offset    *ntypes.Int64     `protobuf:"bytes,100,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit     *ntypes.Int64     `protobuf:"bytes,101,opt,name=limit,proto3" json:"limit,omitempty"`
+	Sort      map[string]bool   `protobuf:"bytes,102,rep,name=sort,proto3" json:"sort,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // Deprecated: Do not use.
+	OrderBy   []*Order          `protobuf:"bytes,103,rep,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// Page token continues the list where the previous page ended, it cannot be combined with offset.
+	// Order has to be the same as the one the token was issued for.
+	PageToken string `protobuf:"bytes,104,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Total number of matching rows is counted only on request.
+	IncludeTotal         bool     `protobuf:"varint,105,opt,name=include_total,json=includeTotal,proto3" json:"include_total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ListPermissionsRequest) Reset()         { *m = ListPermissionsRequest{} }
 func (m *ListPermissionsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListPermissionsRequest) ProtoMessage()    {}
 func (*ListPermissionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_permission_466dba486a6a79c2, []int{2}
+	return fileDescriptor_permission_1155a690da2d028e, []int{2}
 }
 func (m *ListPermissionsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListPermissionsRequest.Unmarshal(m, b)
@@ -220,18 +225,35 @@ func (m *ListPermissionsRequest) GetOrderBy() []*Order {
 	return nil
 }
 
+func (m *ListPermissionsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *ListPermissionsRequest) GetIncludeTotal() bool {
+	if m != nil {
+		return m.IncludeTotal
+	}
+	return false
+}
+
 type ListPermissionsResponse struct {
-	Permissions          []string `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Permissions []string `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	// Next page token is empty if there is nothing more to retrieve.
+	NextPageToken        string        `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Total                *ntypes.Int64 `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *ListPermissionsResponse) Reset()         { *m = ListPermissionsResponse{} }
 func (m *ListPermissionsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListPermissionsResponse) ProtoMessage()    {}
 func (*ListPermissionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_permission_466dba486a6a79c2, []int{3}
+	return fileDescriptor_permission_1155a690da2d028e, []int{3}
 }
 func (m *ListPermissionsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListPermissionsResponse.Unmarshal(m, b)
@@ -258,6 +280,20 @@ func (m *ListPermissionsResponse) GetPermissions() []string {
 	return nil
 }
 
+func (m *ListPermissionsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *ListPermissionsResponse) GetTotal() *ntypes.Int64 {
+	if m != nil {
+		return m.Total
+	}
+	return nil
+}
+
 type GetPermissionRequest struct {
 	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -269,7 +305,7 @@ func (m *GetPermissionRequest) Reset()         { *m = GetPermissionRequest{} }
 func (m *GetPermissionRequest) String() string { return proto.CompactTextString(m) }
 func (*GetPermissionRequest) ProtoMessage()    {}
 func (*GetPermissionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_permission_466dba486a6a79c2, []int{4}
+	return fileDescriptor_permission_1155a690da2d028e, []int{4}
 }
 func (m *GetPermissionRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetPermissionRequest.Unmarshal(m, b)
@@ -307,7 +343,7 @@ func (m *GetPermissionResponse) Reset()         { *m = GetPermissionResponse{} }
 func (m *GetPermissionResponse) String() string { return proto.CompactTextString(m) }
 func (*GetPermissionResponse) ProtoMessage()    {}
 func (*GetPermissionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_permission_466dba486a6a79c2, []int{5}
+	return fileDescriptor_permission_1155a690da2d028e, []int{5}
 }
 func (m *GetPermissionResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetPermissionResponse.Unmarshal(m, b)
@@ -483,47 +519,51 @@ var _PermissionManager_serviceDesc = grpc.ServiceDesc{
 }
 
 func init() {
-	proto.RegisterFile("github.com/piotrkowalczuk/charon/pb/rpc/charond/v1/permission.proto", fileDescriptor_permission_466dba486a6a79c2)
-}
-
-var fileDescriptor_permission_466dba486a6a79c2 = []byte{
-	// 601 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x6f, 0x6b, 0xd4, 0x4e,
-	0x10, 0xc7, 0x7f, 0x49, 0xae, 0xd7, 0xcb, 0x94, 0x5f, 0x69, 0xd7, 0x56, 0x43, 0x2c, 0x72, 0x44,
-	0x2c, 0x05, 0x6b, 0x62, 0x4f, 0xf1, 0xa4, 0x05, 0xc5, 0x13, 0x29, 0x8a, 0xd2, 0x92, 0xfa, 0xc8,
-	0x27, 0x9a, 0x3f, 0xdb, 0x74, 0xb9, 0xcb, 0x6e, 0xba, 0xbb, 0x39, 0x89, 0x6f, 0xc1, 0xd7, 0xe7,
-	0xfb, 0x91, 0x24, 0x9b, 0xbb, 0xc3, 0xe6, 0xb0, 0xe7, 0xa3, 0x64, 0x66, 0x3e, 0xdf, 0x99, 0xc9,
-	0xec, 0x64, 0xe1, 0x6d, 0x42, 0xe4, 0x55, 0x1e, 0xba, 0x11, 0x4b, 0xbd, 0x8c, 0x30, 0xc9, 0xc7,
-	0xec, 0x7b, 0x30, 0x89, 0x7e, 0xe4, 0x63, 0x2f, 0xba, 0x0a, 0x38, 0xa3, 0x5e, 0x16, 0x7a, 0x3c,
-	0x8b, 0x94, 0x15, 0x7b, 0xd3, 0x23, 0x2f, 0xc3, 0x3c, 0x25, 0x42, 0x10, 0x46, 0xdd, 0x8c, 0x33,
-	0xc9, 0xd0, 0x6e, 0x1d, 0x74, 0x79, 0x16, 0xb9, 0x8a, 0x73, 0xa7, 0x47, 0xf6, 0xeb, 0x7f, 0xc8,
-	0x1d, 0xb1, 0x34, 0x6d, 0xf2, 0xda, 0x77, 0xae, 0x65, 0x91, 0x61, 0xe1, 0xd5, 0x8f, 0xc6, 0x49,
-	0x6b, 0x27, 0x5d, 0x70, 0x3a, 0xaf, 0xc0, 0xf6, 0x71, 0x42, 0x84, 0xc4, 0xfc, 0x7c, 0xd6, 0x9d,
-	0xf0, 0xf1, 0x75, 0x8e, 0x85, 0x44, 0x7d, 0xd8, 0x98, 0xf7, 0x2c, 0x2c, 0xad, 0x6f, 0x1c, 0x98,
-	0xfe, 0xa2, 0xcb, 0x61, 0x70, 0xbf, 0x55, 0x2f, 0x32, 0x46, 0x05, 0x46, 0x16, 0xac, 0x47, 0x1c,
-	0x07, 0x12, 0xc7, 0x96, 0xd6, 0xd7, 0x0e, 0x0c, 0xbf, 0x31, 0xcb, 0x08, 0xc7, 0x29, 0x9b, 0xe2,
-	0xd8, 0xd2, 0xeb, 0x88, 0x32, 0xd1, 0x1e, 0x98, 0x39, 0x95, 0x2c, 0x8f, 0xae, 0x70, 0x6c, 0x19,
-	0x55, 0x6c, 0xee, 0x70, 0x7e, 0x76, 0xe0, 0xee, 0x47, 0x22, 0x64, 0x4b, 0xb7, 0x87, 0x60, 0x8a,
-	0x3c, 0x14, 0x85, 0x90, 0x38, 0xad, 0xca, 0x6d, 0x0c, 0x36, 0x5d, 0x35, 0x82, 0x0b, 0xc9, 0x09,
-	0x4d, 0xfc, 0x39, 0x80, 0xf6, 0xa1, 0x9b, 0xb2, 0x38, 0x9f, 0xe0, 0xaa, 0xfe, 0x4d, 0x54, 0x45,
-	0x4b, 0x2e, 0x88, 0x24, 0x61, 0xb4, 0xea, 0xa5, 0x85, 0xab, 0xa3, 0xe8, 0x29, 0x80, 0xfa, 0xb6,
-	0xaf, 0x81, 0xb4, 0x3a, 0x15, 0xbb, 0xdd, 0xb0, 0x9f, 0x49, 0x8a, 0x85, 0x0c, 0xd2, 0xcc, 0x37,
-	0x15, 0xf4, 0xa6, 0xec, 0x77, 0xa6, 0x08, 0x0b, 0x6b, 0xad, 0x52, 0xfc, 0xdf, 0x28, 0xde, 0x53,
-	0xf9, 0xe2, 0xf9, 0x8c, 0x1e, 0x15, 0xe8, 0x11, 0x74, 0xd9, 0xe5, 0xa5, 0xc0, 0xd2, 0x8a, 0x15,
-	0x49, 0x17, 0x49, 0x15, 0x44, 0x0f, 0x61, 0x6d, 0x42, 0x52, 0x22, 0x2d, 0xdc, 0x46, 0xd5, 0x31,
-	0x74, 0x06, 0x1d, 0xc1, 0xb8, 0xb4, 0x2e, 0xfb, 0xc6, 0xc1, 0xc6, 0x60, 0xe8, 0xb6, 0xae, 0xa1,
-	0xdb, 0x3e, 0x66, 0xf7, 0x82, 0x71, 0xf9, 0x8e, 0x4a, 0x5e, 0x8c, 0x74, 0x4b, 0xf3, 0xab, 0x44,
-	0x68, 0x08, 0x3d, 0xc6, 0x63, 0xcc, 0xcb, 0x0f, 0x49, 0xaa, 0xa4, 0x7b, 0x4b, 0x92, 0x9e, 0x95,
-	0x98, 0xbf, 0x5e, 0xd1, 0xa3, 0xc2, 0x1e, 0x82, 0x39, 0xcb, 0x87, 0xb6, 0xc0, 0x18, 0xe3, 0xa2,
-	0x3a, 0x3a, 0xd3, 0x2f, 0x5f, 0xd1, 0x0e, 0xac, 0x4d, 0x83, 0x49, 0x5e, 0x9f, 0x51, 0xcf, 0xaf,
-	0x8d, 0x63, 0xfd, 0xa5, 0xf6, 0xa1, 0xd3, 0xeb, 0x6e, 0xc5, 0xce, 0x09, 0xdc, 0xbb, 0xd1, 0xa5,
-	0x5a, 0xbd, 0xbf, 0xef, 0xee, 0x3e, 0xec, 0x9c, 0xe2, 0x05, 0x6d, 0xb3, 0x47, 0x9b, 0xa0, 0x93,
-	0x66, 0x5f, 0x75, 0x12, 0x3b, 0x43, 0xd8, 0xfd, 0x83, 0x53, 0x25, 0x1e, 0x00, 0xcc, 0xf3, 0xa9,
-	0xb6, 0x17, 0x3c, 0x83, 0x5f, 0x3a, 0x6c, 0xcf, 0x65, 0x9f, 0x02, 0x1a, 0x24, 0x98, 0x23, 0x01,
-	0xbd, 0xe6, 0x97, 0x41, 0x47, 0x4b, 0xa6, 0xb4, 0xfc, 0x9f, 0xb4, 0x07, 0xab, 0x48, 0xea, 0x46,
-	0x9d, 0xff, 0x50, 0x02, 0x9d, 0x72, 0x50, 0xe8, 0xc9, 0x4a, 0x67, 0x6d, 0xbb, 0xb7, 0xc5, 0x67,
-	0x85, 0x42, 0x30, 0x4e, 0xb1, 0x44, 0x8f, 0x97, 0x08, 0xdb, 0x06, 0x6e, 0x1f, 0xde, 0x0e, 0x6e,
-	0x6a, 0x8c, 0xbe, 0x41, 0x3f, 0x62, 0xa9, 0xdb, 0xdc, 0x92, 0x6d, 0xda, 0x73, 0xed, 0xcb, 0xf1,
-	0xea, 0xb7, 0xe8, 0x89, 0x7a, 0x0d, 0xbb, 0xd5, 0xed, 0xf8, 0xec, 0x77, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x3b, 0xa1, 0x83, 0x12, 0xe6, 0x05, 0x00, 0x00,
+	proto.RegisterFile("github.com/piotrkowalczuk/charon/pb/rpc/charond/v1/permission.proto", fileDescriptor_permission_1155a690da2d028e)
+}
+
+var fileDescriptor_permission_1155a690da2d028e = []byte{
+	// 668 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xdf, 0x6e, 0xd3, 0x30,
+	0x14, 0xc6, 0x49, 0xd2, 0x76, 0xcd, 0x19, 0x1b, 0x9b, 0xd9, 0xc0, 0x2a, 0x03, 0x55, 0x9d, 0x98,
+	0x2a, 0x31, 0x52, 0x56, 0x10, 0x45, 0x43, 0x02, 0x51, 0x84, 0x26, 0x10, 0x68, 0x53, 0xb6, 0x2b,
+	0x6e, 0x46, 0x9a, 0x78, 0xad, 0xd5, 0xc6, 0xce, 0x6c, 0xa7, 0x50, 0x9e, 0x80, 0xb7, 0xe3, 0x8a,
+	0xf7, 0x41, 0x49, 0x9c, 0x36, 0x82, 0x54, 0x6c, 0x5c, 0x25, 0xfe, 0xce, 0xef, 0xd8, 0xdf, 0x39,
+	0xfe, 0x03, 0x6f, 0x87, 0x54, 0x8d, 0xe2, 0x81, 0xe3, 0xf3, 0xb0, 0x13, 0x51, 0xae, 0xc4, 0x98,
+	0x7f, 0xf5, 0x26, 0xfe, 0xf7, 0x78, 0xdc, 0xf1, 0x47, 0x9e, 0xe0, 0xac, 0x13, 0x0d, 0x3a, 0x22,
+	0xf2, 0xf5, 0x28, 0xe8, 0x4c, 0x0f, 0x3a, 0x11, 0x11, 0x21, 0x95, 0x92, 0x72, 0xe6, 0x44, 0x82,
+	0x2b, 0x8e, 0xb6, 0xb3, 0xa0, 0x23, 0x22, 0xdf, 0xd1, 0x9c, 0x33, 0x3d, 0x68, 0xbc, 0xfe, 0x8f,
+	0xb9, 0x7d, 0x1e, 0x86, 0xf9, 0xbc, 0x8d, 0xdb, 0x97, 0x6a, 0x16, 0x11, 0xd9, 0xc9, 0x3e, 0xb9,
+	0xc8, 0x32, 0x91, 0x15, 0xc4, 0xd6, 0x2b, 0x68, 0xb8, 0x64, 0x48, 0xa5, 0x22, 0xe2, 0x64, 0xee,
+	0x4e, 0xba, 0xe4, 0x32, 0x26, 0x52, 0xa1, 0x26, 0xac, 0x2e, 0x3c, 0x4b, 0x6c, 0x34, 0xad, 0xb6,
+	0xed, 0x16, 0xa5, 0x16, 0x87, 0x7b, 0xa5, 0xf9, 0x32, 0xe2, 0x4c, 0x12, 0x84, 0x61, 0xc5, 0x17,
+	0xc4, 0x53, 0x24, 0xc0, 0x46, 0xd3, 0x68, 0x5b, 0x6e, 0x3e, 0x4c, 0x22, 0x82, 0x84, 0x7c, 0x4a,
+	0x02, 0x6c, 0x66, 0x11, 0x3d, 0x44, 0x3b, 0x60, 0xc7, 0x4c, 0xf1, 0xd8, 0x1f, 0x91, 0x00, 0x5b,
+	0x69, 0x6c, 0x21, 0xb4, 0x7e, 0x56, 0xe0, 0xce, 0x47, 0x2a, 0x55, 0x89, 0xdb, 0x7d, 0xb0, 0x65,
+	0x3c, 0x90, 0x33, 0xa9, 0x48, 0x98, 0x2e, 0xb7, 0xda, 0x5d, 0x77, 0x74, 0x0b, 0x4e, 0x95, 0xa0,
+	0x6c, 0xe8, 0x2e, 0x00, 0xb4, 0x07, 0xb5, 0x90, 0x07, 0xf1, 0x84, 0x60, 0xb3, 0x14, 0xd5, 0xd1,
+	0x84, 0xf3, 0x7c, 0x45, 0x39, 0xc3, 0x56, 0x39, 0x97, 0x45, 0xd1, 0x13, 0x00, 0x5d, 0xdb, 0xb9,
+	0xa7, 0x70, 0x25, 0x65, 0x37, 0x73, 0xf6, 0x8c, 0x86, 0x44, 0x2a, 0x2f, 0x8c, 0x5c, 0x5b, 0x43,
+	0x6f, 0x12, 0xbf, 0xf3, 0x8c, 0xc1, 0x0c, 0x57, 0xd3, 0x8c, 0xb5, 0x3c, 0xe3, 0x3d, 0x53, 0xcf,
+	0x9f, 0xcd, 0xe9, 0xfe, 0x0c, 0x3d, 0x84, 0x1a, 0xbf, 0xb8, 0x90, 0x44, 0xe1, 0x40, 0x93, 0xac,
+	0x48, 0xea, 0x20, 0xda, 0x85, 0xea, 0x84, 0x86, 0x54, 0x61, 0x52, 0x46, 0x65, 0x31, 0x74, 0x0c,
+	0x15, 0xc9, 0x85, 0xc2, 0x17, 0x4d, 0xab, 0xbd, 0xda, 0xed, 0x39, 0xa5, 0xc7, 0xd0, 0x29, 0x6f,
+	0xb3, 0x73, 0xca, 0x85, 0x7a, 0xc7, 0x94, 0x98, 0xf5, 0x4d, 0x6c, 0xb8, 0xe9, 0x44, 0xa8, 0x07,
+	0x75, 0x2e, 0x02, 0x22, 0x92, 0x42, 0x86, 0xe9, 0xa4, 0x3b, 0x4b, 0x26, 0x3d, 0x4e, 0x30, 0x77,
+	0x25, 0xa5, 0xfb, 0x33, 0x74, 0x1f, 0x20, 0xf2, 0x86, 0xe4, 0x5c, 0xf1, 0x31, 0x61, 0x78, 0xd4,
+	0x34, 0xda, 0xb6, 0x6b, 0x27, 0xca, 0x59, 0x22, 0xa0, 0x5d, 0x58, 0xa3, 0xcc, 0x9f, 0xc4, 0x41,
+	0x42, 0x28, 0x6f, 0x82, 0x69, 0xd3, 0x68, 0xd7, 0xdd, 0x9b, 0x5a, 0x3c, 0x4b, 0xb4, 0x46, 0x0f,
+	0xec, 0xb9, 0x27, 0xb4, 0x01, 0xd6, 0x98, 0xcc, 0xd2, 0xed, 0xb7, 0xdd, 0xe4, 0x17, 0x6d, 0x41,
+	0x75, 0xea, 0x4d, 0xe2, 0x6c, 0x9f, 0xeb, 0x6e, 0x36, 0x38, 0x34, 0x5f, 0x18, 0x1f, 0x2a, 0xf5,
+	0xda, 0x46, 0xd0, 0xfa, 0x61, 0xc0, 0xdd, 0xbf, 0x4a, 0xd5, 0xe7, 0xf7, 0x9f, 0x17, 0x00, 0xed,
+	0xc1, 0x2d, 0x46, 0xbe, 0xa9, 0xf3, 0x42, 0x15, 0x66, 0xba, 0xf6, 0x5a, 0x22, 0x9f, 0x14, 0x2a,
+	0xa9, 0x66, 0x15, 0x58, 0xa5, 0xfb, 0x92, 0xc6, 0x5a, 0x7b, 0xb0, 0x75, 0x44, 0x0a, 0x46, 0xf2,
+	0x93, 0xbd, 0x0e, 0x26, 0xcd, 0x6f, 0x90, 0x49, 0x83, 0x56, 0x0f, 0xb6, 0xff, 0xe0, 0xb4, 0xdf,
+	0x07, 0x00, 0x0b, 0x73, 0xba, 0x09, 0x05, 0xa5, 0xfb, 0xcb, 0x84, 0xcd, 0x45, 0xda, 0x27, 0x8f,
+	0x79, 0x43, 0x22, 0x90, 0x84, 0x7a, 0x7e, 0x89, 0xd1, 0xc1, 0x92, 0x7d, 0x5b, 0xfe, 0x4a, 0x34,
+	0xba, 0xd7, 0x49, 0xc9, 0x8c, 0xb6, 0x6e, 0xa0, 0x21, 0x54, 0x92, 0xae, 0xa3, 0xc7, 0xd7, 0x3a,
+	0x7d, 0x0d, 0xe7, 0xaa, 0xf8, 0x7c, 0xa1, 0x01, 0x58, 0x47, 0x44, 0xa1, 0x47, 0x4b, 0x12, 0xcb,
+	0x1a, 0xde, 0xd8, 0xbf, 0x1a, 0x9c, 0xaf, 0xd1, 0xff, 0x02, 0x4d, 0x9f, 0x87, 0x4e, 0xfe, 0x6e,
+	0x97, 0xe5, 0x9e, 0x18, 0x9f, 0x0f, 0xaf, 0xff, 0xae, 0xbf, 0xd4, 0xbf, 0x83, 0x5a, 0xfa, 0x5e,
+	0x3f, 0xfd, 0x3d, 0x00, 0xfc, 0x09, 0x62, 0x71, 0x78, 0x06, 0x00, 0x00,
 }
@@ -27,16 +27,23 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
 type RefreshToken struct {
-	Token                string               `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	Notes                *ntypes.String       `protobuf:"bytes,2,opt,name=notes,proto3" json:"notes,omitempty"`
-	UserId               int64                `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Revoked              bool                 `protobuf:"varint,4,opt,name=revoked,proto3" json:"revoked,omitempty"`
-	ExpireAt             *timestamp.Timestamp `protobuf:"bytes,5,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
-	LastUsedAt           *timestamp.Timestamp `protobuf:"bytes,6,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
-	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	CreatedBy            *ntypes.Int64        `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	UpdatedBy            *ntypes.Int64        `protobuf:"bytes,10,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	// Token is set only in response to Create, only its hash is stored.
+	Token      string               `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Notes      *ntypes.String       `protobuf:"bytes,2,opt,name=notes,proto3" json:"notes,omitempty"`
+	UserId     int64                `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Revoked    bool                 `protobuf:"varint,4,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	ExpireAt   *timestamp.Timestamp `protobuf:"bytes,5,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
+	LastUsedAt *timestamp.Timestamp `protobuf:"bytes,6,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	CreatedAt  *timestamp.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy  *ntypes.Int64        `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedAt  *timestamp.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedBy  *ntypes.Int64        `protobuf:"bytes,10,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	// Prefix identifies the token, it is a part of the token that is not secret.
+	Prefix string `protobuf:"bytes,11,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// Family is a prefix of the token the chain of rotated tokens started with.
+	Family string `protobuf:"bytes,12,opt,name=family,proto3" json:"family,omitempty"`
+	// Rotated at is set once the token is replaced by its successor, it cannot be used anymore.
+	RotatedAt            *timestamp.Timestamp `protobuf:"bytes,13,opt,name=rotated_at,json=rotatedAt,proto3" json:"rotated_at,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -46,7 +53,7 @@ func (m *RefreshToken) Reset()         { *m = RefreshToken{} }
 func (m *RefreshToken) String() string { return proto.CompactTextString(m) }
 func (*RefreshToken) ProtoMessage()    {}
 func (*RefreshToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_refresh_token_1e8e54ee3f1fef8c, []int{0}
+	return fileDescriptor_refresh_token_606510be34c0fb85, []int{0}
 }
 func (m *RefreshToken) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RefreshToken.Unmarshal(m, b)
@@ -136,6 +143,27 @@ func (m *RefreshToken) GetUpdatedBy() *ntypes.Int64 {
 	return nil
 }
 
+func (m *RefreshToken) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *RefreshToken) GetFamily() string {
+	if m != nil {
+		return m.Family
+	}
+	return ""
+}
+
+func (m *RefreshToken) GetRotatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.RotatedAt
+	}
+	return nil
+}
+
 type RefreshTokenQuery struct {
 	UserId               *qtypes.Int64     `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Notes                *qtypes.String    `protobuf:"bytes,2,opt,name=notes,proto3" json:"notes,omitempty"`
@@ -144,6 +172,7 @@ type RefreshTokenQuery struct {
 	LastUsedAt           *qtypes.Timestamp `protobuf:"bytes,5,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
 	CreatedAt            *qtypes.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt            *qtypes.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Family               *qtypes.String    `protobuf:"bytes,8,opt,name=family,proto3" json:"family,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -153,7 +182,7 @@ func (m *RefreshTokenQuery) Reset()         { *m = RefreshTokenQuery{} }
 func (m *RefreshTokenQuery) String() string { return proto.CompactTextString(m) }
 func (*RefreshTokenQuery) ProtoMessage()    {}
 func (*RefreshTokenQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_refresh_token_1e8e54ee3f1fef8c, []int{1}
+	return fileDescriptor_refresh_token_606510be34c0fb85, []int{1}
 }
 func (m *RefreshTokenQuery) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RefreshTokenQuery.Unmarshal(m, b)
@@ -222,19 +251,28 @@ func (m *RefreshTokenQuery) GetUpdatedAt() *qtypes.Timestamp {
 	return nil
 }
 
+func (m *RefreshTokenQuery) GetFamily() *qtypes.String {
+	if m != nil {
+		return m.Family
+	}
+	return nil
+}
+
 type CreateRefreshTokenRequest struct {
-	Notes                *ntypes.String       `protobuf:"bytes,1,opt,name=notes,proto3" json:"notes,omitempty"`
-	ExpireAt             *timestamp.Timestamp `protobuf:"bytes,2,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Notes    *ntypes.String       `protobuf:"bytes,1,opt,name=notes,proto3" json:"notes,omitempty"`
+	ExpireAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
+	// User id is optional, if not set the token is issued for the actor.
+	UserId               *ntypes.Int64 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *CreateRefreshTokenRequest) Reset()         { *m = CreateRefreshTokenRequest{} }
 func (m *CreateRefreshTokenRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateRefreshTokenRequest) ProtoMessage()    {}
 func (*CreateRefreshTokenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_refresh_token_1e8e54ee3f1fef8c, []int{2}
+	return fileDescriptor_refresh_token_606510be34c0fb85, []int{2}
 }
 func (m *CreateRefreshTokenRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateRefreshTokenRequest.Unmarshal(m, b)
@@ -268,6 +306,13 @@ func (m *CreateRefreshTokenRequest) GetExpireAt() *timestamp.Timestamp {
 	return nil
 }
 
+func (m *CreateRefreshTokenRequest) GetUserId() *ntypes.Int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return nil
+}
+
 type CreateRefreshTokenResponse struct {
 	RefreshToken         *RefreshToken `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
@@ -279,7 +324,7 @@ func (m *CreateRefreshTokenResponse) Reset()         { *m = CreateRefreshTokenRe
 func (m *CreateRefreshTokenResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateRefreshTokenResponse) ProtoMessage()    {}
 func (*CreateRefreshTokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_refresh_token_1e8e54ee3f1fef8c, []int{3}
+	return fileDescriptor_refresh_token_606510be34c0fb85, []int{3}
 }
 func (m *CreateRefreshTokenResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateRefreshTokenResponse.Unmarshal(m, b)
@@ -307,20 +352,25 @@ func (m *CreateRefreshTokenResponse) GetRefreshToken() *RefreshToken {
 }
 
 type ListRefreshTokensRequest struct {
-	Offset               *ntypes.Int64      `protobuf:"bytes,1,opt,name=offset,proto3" json:"offset,omitempty"`
-	Limit                *ntypes.Int64      `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	OrderBy              []*Order           `protobuf:"bytes,3,rep,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
-	Query                *RefreshTokenQuery `protobuf:"bytes,11,opt,name=query,proto3" json:"query,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	Offset  *ntypes.Int64      `protobuf:"bytes,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit   *ntypes.Int64      `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	OrderBy []*Order           `protobuf:"bytes,3,rep,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Query   *RefreshTokenQuery `protobuf:"bytes,11,opt,name=query,proto3" json:"query,omitempty"`
+	// Page token continues the list where the previous page ended, it cannot be combined with offset.
+	// Order has to be the same as the one the token was issued for.
+	PageToken string `protobuf:"bytes,12,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Total number of matching rows is counted only on request.
+	IncludeTotal         bool     `protobuf:"varint,13,opt,name=include_total,json=includeTotal,proto3" json:"include_total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ListRefreshTokensRequest) Reset()         { *m = ListRefreshTokensRequest{} }
 func (m *ListRefreshTokensRequest) String() string { return proto.CompactTextString(m) }
 func (*ListRefreshTokensRequest) ProtoMessage()    {}
 func (*ListRefreshTokensRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_refresh_token_1e8e54ee3f1fef8c, []int{4}
+	return fileDescriptor_refresh_token_606510be34c0fb85, []int{4}
 }
 func (m *ListRefreshTokensRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListRefreshTokensRequest.Unmarshal(m, b)
@@ -368,18 +418,35 @@ func (m *ListRefreshTokensRequest) GetQuery() *RefreshTokenQuery {
 	return nil
 }
 
+func (m *ListRefreshTokensRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *ListRefreshTokensRequest) GetIncludeTotal() bool {
+	if m != nil {
+		return m.IncludeTotal
+	}
+	return false
+}
+
 type ListRefreshTokensResponse struct {
-	RefreshTokens        []*RefreshToken `protobuf:"bytes,1,rep,name=refresh_tokens,json=refreshTokens,proto3" json:"refresh_tokens,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	RefreshTokens []*RefreshToken `protobuf:"bytes,1,rep,name=refresh_tokens,json=refreshTokens,proto3" json:"refresh_tokens,omitempty"`
+	// Next page token is empty if there is nothing more to retrieve.
+	NextPageToken        string        `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Total                *ntypes.Int64 `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *ListRefreshTokensResponse) Reset()         { *m = ListRefreshTokensResponse{} }
 func (m *ListRefreshTokensResponse) String() string { return proto.CompactTextString(m) }
 func (*ListRefreshTokensResponse) ProtoMessage()    {}
 func (*ListRefreshTokensResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_refresh_token_1e8e54ee3f1fef8c, []int{5}
+	return fileDescriptor_refresh_token_606510be34c0fb85, []int{5}
 }
 func (m *ListRefreshTokensResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListRefreshTokensResponse.Unmarshal(m, b)
@@ -406,9 +473,25 @@ func (m *ListRefreshTokensResponse) GetRefreshTokens() []*RefreshToken {
 	return nil
 }
 
+func (m *ListRefreshTokensResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *ListRefreshTokensResponse) GetTotal() *ntypes.Int64 {
+	if m != nil {
+		return m.Total
+	}
+	return nil
+}
+
 type RevokeRefreshTokenRequest struct {
+	// Either token or its prefix is required.
 	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	UserId               int64    `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Prefix               string   `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -418,7 +501,7 @@ func (m *RevokeRefreshTokenRequest) Reset()         { *m = RevokeRefreshTokenReq
 func (m *RevokeRefreshTokenRequest) String() string { return proto.CompactTextString(m) }
 func (*RevokeRefreshTokenRequest) ProtoMessage()    {}
 func (*RevokeRefreshTokenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_refresh_token_1e8e54ee3f1fef8c, []int{6}
+	return fileDescriptor_refresh_token_606510be34c0fb85, []int{6}
 }
 func (m *RevokeRefreshTokenRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RevokeRefreshTokenRequest.Unmarshal(m, b)
@@ -452,6 +535,13 @@ func (m *RevokeRefreshTokenRequest) GetUserId() int64 {
 	return 0
 }
 
+func (m *RevokeRefreshTokenRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
 type RevokeRefreshTokenResponse struct {
 	RefreshToken         *RefreshToken `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
@@ -463,7 +553,7 @@ func (m *RevokeRefreshTokenResponse) Reset()         { *m = RevokeRefreshTokenRe
 func (m *RevokeRefreshTokenResponse) String() string { return proto.CompactTextString(m) }
 func (*RevokeRefreshTokenResponse) ProtoMessage()    {}
 func (*RevokeRefreshTokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_refresh_token_1e8e54ee3f1fef8c, []int{7}
+	return fileDescriptor_refresh_token_606510be34c0fb85, []int{7}
 }
 func (m *RevokeRefreshTokenResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RevokeRefreshTokenResponse.Unmarshal(m, b)
@@ -490,6 +580,101 @@ func (m *RevokeRefreshTokenResponse) GetRefreshToken() *RefreshToken {
 	return nil
 }
 
+type RevokeAllRefreshTokensRequest struct {
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Query is optional, user id of the query is ignored.
+	// If not set, all sessions of the user are abandoned, otherwise only those started with revoked tokens.
+	Query                *RefreshTokenQuery `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *RevokeAllRefreshTokensRequest) Reset()         { *m = RevokeAllRefreshTokensRequest{} }
+func (m *RevokeAllRefreshTokensRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeAllRefreshTokensRequest) ProtoMessage()    {}
+func (*RevokeAllRefreshTokensRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_refresh_token_606510be34c0fb85, []int{8}
+}
+func (m *RevokeAllRefreshTokensRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeAllRefreshTokensRequest.Unmarshal(m, b)
+}
+func (m *RevokeAllRefreshTokensRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeAllRefreshTokensRequest.Marshal(b, m, deterministic)
+}
+func (dst *RevokeAllRefreshTokensRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeAllRefreshTokensRequest.Merge(dst, src)
+}
+func (m *RevokeAllRefreshTokensRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeAllRefreshTokensRequest.Size(m)
+}
+func (m *RevokeAllRefreshTokensRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeAllRefreshTokensRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeAllRefreshTokensRequest proto.InternalMessageInfo
+
+func (m *RevokeAllRefreshTokensRequest) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *RevokeAllRefreshTokensRequest) GetQuery() *RefreshTokenQuery {
+	if m != nil {
+		return m.Query
+	}
+	return nil
+}
+
+type RevokeAllRefreshTokensResponse struct {
+	// Prefixes of revoked tokens, tokens revoked before are not included.
+	Prefixes             []string `protobuf:"bytes,1,rep,name=prefixes,proto3" json:"prefixes,omitempty"`
+	AbandonedSessions    int64    `protobuf:"varint,2,opt,name=abandoned_sessions,json=abandonedSessions,proto3" json:"abandoned_sessions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeAllRefreshTokensResponse) Reset()         { *m = RevokeAllRefreshTokensResponse{} }
+func (m *RevokeAllRefreshTokensResponse) String() string { return proto.CompactTextString(m) }
+func (*RevokeAllRefreshTokensResponse) ProtoMessage()    {}
+func (*RevokeAllRefreshTokensResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_refresh_token_606510be34c0fb85, []int{9}
+}
+func (m *RevokeAllRefreshTokensResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeAllRefreshTokensResponse.Unmarshal(m, b)
+}
+func (m *RevokeAllRefreshTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeAllRefreshTokensResponse.Marshal(b, m, deterministic)
+}
+func (dst *RevokeAllRefreshTokensResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeAllRefreshTokensResponse.Merge(dst, src)
+}
+func (m *RevokeAllRefreshTokensResponse) XXX_Size() int {
+	return xxx_messageInfo_RevokeAllRefreshTokensResponse.Size(m)
+}
+func (m *RevokeAllRefreshTokensResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeAllRefreshTokensResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeAllRefreshTokensResponse proto.InternalMessageInfo
+
+func (m *RevokeAllRefreshTokensResponse) GetPrefixes() []string {
+	if m != nil {
+		return m.Prefixes
+	}
+	return nil
+}
+
+func (m *RevokeAllRefreshTokensResponse) GetAbandonedSessions() int64 {
+	if m != nil {
+		return m.AbandonedSessions
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*RefreshToken)(nil), "charon.rpc.charond.v1.RefreshToken")
 	proto.RegisterType((*RefreshTokenQuery)(nil), "charon.rpc.charond.v1.RefreshTokenQuery")
@@ -499,6 +684,8 @@ func init() {
 	proto.RegisterType((*ListRefreshTokensResponse)(nil), "charon.rpc.charond.v1.ListRefreshTokensResponse")
 	proto.RegisterType((*RevokeRefreshTokenRequest)(nil), "charon.rpc.charond.v1.RevokeRefreshTokenRequest")
 	proto.RegisterType((*RevokeRefreshTokenResponse)(nil), "charon.rpc.charond.v1.RevokeRefreshTokenResponse")
+	proto.RegisterType((*RevokeAllRefreshTokensRequest)(nil), "charon.rpc.charond.v1.RevokeAllRefreshTokensRequest")
+	proto.RegisterType((*RevokeAllRefreshTokensResponse)(nil), "charon.rpc.charond.v1.RevokeAllRefreshTokensResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -516,6 +703,8 @@ type RefreshTokenManagerClient interface {
 	Create(ctx context.Context, in *CreateRefreshTokenRequest, opts ...grpc.CallOption) (*CreateRefreshTokenResponse, error)
 	Revoke(ctx context.Context, in *RevokeRefreshTokenRequest, opts ...grpc.CallOption) (*RevokeRefreshTokenResponse, error)
 	List(ctx context.Context, in *ListRefreshTokensRequest, opts ...grpc.CallOption) (*ListRefreshTokensResponse, error)
+	// RevokeAll revokes every token of a user, that matches the query if given, and abandons corresponding sessions.
+	RevokeAll(ctx context.Context, in *RevokeAllRefreshTokensRequest, opts ...grpc.CallOption) (*RevokeAllRefreshTokensResponse, error)
 }
 
 type refreshTokenManagerClient struct {
@@ -553,11 +742,22 @@ func (c *refreshTokenManagerClient) List(ctx context.Context, in *ListRefreshTok
 	return out, nil
 }
 
+func (c *refreshTokenManagerClient) RevokeAll(ctx context.Context, in *RevokeAllRefreshTokensRequest, opts ...grpc.CallOption) (*RevokeAllRefreshTokensResponse, error) {
+	out := new(RevokeAllRefreshTokensResponse)
+	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.RefreshTokenManager/RevokeAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RefreshTokenManagerServer is the server API for RefreshTokenManager service.
 type RefreshTokenManagerServer interface {
 	Create(context.Context, *CreateRefreshTokenRequest) (*CreateRefreshTokenResponse, error)
 	Revoke(context.Context, *RevokeRefreshTokenRequest) (*RevokeRefreshTokenResponse, error)
 	List(context.Context, *ListRefreshTokensRequest) (*ListRefreshTokensResponse, error)
+	// RevokeAll revokes every token of a user, that matches the query if given, and abandons corresponding sessions.
+	RevokeAll(context.Context, *RevokeAllRefreshTokensRequest) (*RevokeAllRefreshTokensResponse, error)
 }
 
 func RegisterRefreshTokenManagerServer(s *grpc.Server, srv RefreshTokenManagerServer) {
@@ -618,6 +818,24 @@ func _RefreshTokenManager_List_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RefreshTokenManager_RevokeAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAllRefreshTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RefreshTokenManagerServer).RevokeAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/charon.rpc.charond.v1.RefreshTokenManager/RevokeAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RefreshTokenManagerServer).RevokeAll(ctx, req.(*RevokeAllRefreshTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _RefreshTokenManager_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "charon.rpc.charond.v1.RefreshTokenManager",
 	HandlerType: (*RefreshTokenManagerServer)(nil),
@@ -634,62 +852,78 @@ var _RefreshTokenManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _RefreshTokenManager_List_Handler,
 		},
+		{
+			MethodName: "RevokeAll",
+			Handler:    _RefreshTokenManager_RevokeAll_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1/refresh_token.proto",
 }
 
 func init() {
-	proto.RegisterFile("github.com/piotrkowalczuk/charon/pb/rpc/charond/v1/refresh_token.proto", fileDescriptor_refresh_token_1e8e54ee3f1fef8c)
-}
-
-var fileDescriptor_refresh_token_1e8e54ee3f1fef8c = []byte{
-	// 737 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xdd, 0x6e, 0xd3, 0x4a,
-	0x10, 0xae, 0xf3, 0x9f, 0x49, 0x5a, 0x9d, 0x6e, 0xcf, 0xd1, 0x71, 0x23, 0x24, 0x22, 0x17, 0xaa,
-	0x5c, 0x20, 0x3b, 0x6d, 0x11, 0x15, 0x3f, 0x02, 0x35, 0x48, 0x88, 0x56, 0x20, 0xc0, 0x94, 0x1b,
-	0x6e, 0x82, 0x63, 0x6f, 0x52, 0x2b, 0x89, 0xd7, 0xd9, 0x5d, 0x17, 0xdc, 0x07, 0xe4, 0x01, 0x78,
-	0x06, 0x78, 0x0f, 0x64, 0xaf, 0xb7, 0xb1, 0x8b, 0x4d, 0x53, 0xc4, 0x55, 0xb2, 0xbb, 0xdf, 0x37,
-	0xb3, 0x33, 0xdf, 0xb7, 0x63, 0x78, 0x31, 0x71, 0xf9, 0x59, 0x30, 0xd2, 0x6d, 0x32, 0x37, 0x7c,
-	0x97, 0x70, 0x3a, 0x25, 0x9f, 0xad, 0x99, 0x7d, 0x11, 0x4c, 0x0d, 0xfb, 0xcc, 0xa2, 0xc4, 0x33,
-	0xfc, 0x91, 0x41, 0x7d, 0x3b, 0x59, 0x39, 0xc6, 0xf9, 0x9e, 0x41, 0xf1, 0x98, 0x62, 0x76, 0x36,
-	0xe4, 0x64, 0x8a, 0x3d, 0xdd, 0xa7, 0x84, 0x13, 0xf4, 0x9f, 0x38, 0xd7, 0xa9, 0x6f, 0xeb, 0x09,
-	0x54, 0x3f, 0xdf, 0xeb, 0xdc, 0x9e, 0x10, 0x32, 0x99, 0x61, 0x23, 0x06, 0x8d, 0x82, 0xb1, 0xc1,
-	0xdd, 0x39, 0x66, 0xdc, 0x9a, 0xfb, 0x82, 0xd7, 0x79, 0xf6, 0x07, 0xf9, 0x6d, 0x32, 0x9f, 0x93,
-	0x24, 0x71, 0x67, 0x6b, 0xc1, 0x43, 0x1f, 0x33, 0x43, 0xfc, 0xc8, 0x4d, 0x4f, 0x6c, 0x7a, 0xa9,
-	0x4d, 0xed, 0x6b, 0x19, 0xda, 0xa6, 0xb8, 0xfa, 0x69, 0x74, 0x73, 0xf4, 0x2f, 0x54, 0xe3, 0x12,
-	0x54, 0xa5, 0xab, 0xf4, 0x9a, 0xa6, 0x58, 0xa0, 0x3b, 0x50, 0xf5, 0x08, 0xc7, 0x4c, 0x2d, 0x75,
-	0x95, 0x5e, 0x6b, 0x7f, 0x43, 0x4f, 0x82, 0xbc, 0xe7, 0xd4, 0xf5, 0x26, 0xa6, 0x38, 0x44, 0xff,
-	0x43, 0x3d, 0x60, 0x98, 0x0e, 0x5d, 0x47, 0x2d, 0x77, 0x95, 0x5e, 0xd9, 0xac, 0x45, 0xcb, 0x63,
-	0x07, 0xa9, 0x50, 0xa7, 0xf8, 0x9c, 0x4c, 0xb1, 0xa3, 0x56, 0xba, 0x4a, 0xaf, 0x61, 0xca, 0x25,
-	0x3a, 0x84, 0x26, 0xfe, 0xe2, 0xbb, 0x14, 0x0f, 0x2d, 0xae, 0x56, 0xe3, 0xe0, 0x1d, 0x5d, 0xf4,
-	0x47, 0x97, 0xfd, 0xd1, 0x4f, 0x65, 0x7f, 0xcc, 0x86, 0x00, 0x1f, 0x71, 0xf4, 0x04, 0xda, 0x33,
-	0x8b, 0xf1, 0x61, 0xc0, 0xb0, 0x13, 0x71, 0x6b, 0xd7, 0x72, 0x21, 0xc2, 0x7f, 0x60, 0xd8, 0x39,
-	0xe2, 0xe8, 0x21, 0x80, 0x4d, 0xb1, 0xc5, 0x05, 0xb7, 0x7e, 0x2d, 0xb7, 0x99, 0xa0, 0x8f, 0x38,
-	0xba, 0xb7, 0xa4, 0x8e, 0x42, 0xb5, 0x11, 0x53, 0xd7, 0x65, 0x3f, 0x8e, 0x3d, 0xfe, 0xe0, 0xfe,
-	0x25, 0x7a, 0x10, 0x46, 0x89, 0x02, 0xdf, 0x91, 0x89, 0x9a, 0xd7, 0x27, 0x4a, 0xd0, 0x22, 0x91,
-	0xa4, 0x8e, 0x42, 0x15, 0x72, 0x13, 0x25, 0x80, 0x41, 0xa8, 0x7d, 0x2b, 0xc1, 0x66, 0x5a, 0xc8,
-	0x77, 0x01, 0xa6, 0x21, 0xda, 0x5d, 0x2a, 0xa2, 0x24, 0x01, 0x16, 0xe9, 0x00, 0x52, 0xa0, 0x5f,
-	0xf4, 0x5d, 0xe4, 0xe9, 0xbb, 0xbb, 0x94, 0xb1, 0x1c, 0xe3, 0xda, 0xf2, 0x3a, 0x03, 0x42, 0x66,
-	0x4b, 0x51, 0xf5, 0xb4, 0xa8, 0x95, 0x18, 0xb9, 0x29, 0x23, 0xe6, 0x69, 0x79, 0x70, 0x45, 0xcb,
-	0x6a, 0x11, 0x25, 0x2d, 0x61, 0x3f, 0x23, 0x61, 0xad, 0x88, 0x92, 0x52, 0xae, 0x9f, 0xd1, 0xa2,
-	0x5e, 0xc8, 0xb8, 0x94, 0x40, 0xbb, 0x80, 0xed, 0xe7, 0x31, 0x3d, 0xdd, 0x59, 0x13, 0x2f, 0x02,
-	0xcc, 0xf8, 0xb2, 0x67, 0xca, 0xef, 0xde, 0x44, 0xc6, 0xe0, 0xa5, 0xd5, 0x0d, 0xae, 0x8d, 0xa1,
-	0x93, 0x97, 0x9b, 0xf9, 0xc4, 0x63, 0x18, 0xbd, 0x84, 0xf5, 0xcc, 0xc4, 0x49, 0x2e, 0xb1, 0xa3,
-	0xe7, 0x8e, 0x1c, 0x3d, 0x13, 0xa3, 0x4d, 0x53, 0x2b, 0xed, 0x87, 0x02, 0xea, 0x2b, 0x97, 0xf1,
-	0x34, 0x84, 0xc9, 0x1a, 0xef, 0x42, 0x8d, 0x8c, 0xc7, 0x0c, 0xf3, 0x4b, 0xfb, 0x64, 0xfc, 0x97,
-	0x1c, 0xa2, 0x1d, 0xa8, 0xce, 0xdc, 0xb9, 0x2b, 0x0b, 0xbc, 0x82, 0x12, 0x67, 0xe8, 0x10, 0x1a,
-	0x84, 0x3a, 0x98, 0x46, 0x6e, 0x2e, 0x77, 0xcb, 0xbd, 0xd6, 0xfe, 0xad, 0x82, 0xdb, 0xbe, 0x89,
-	0x60, 0x66, 0x3d, 0x46, 0x0f, 0x42, 0xf4, 0x14, 0xaa, 0x8b, 0xc8, 0xcd, 0x6a, 0x2b, 0x8e, 0xde,
-	0x5b, 0xa1, 0xc6, 0xd8, 0xfd, 0xa6, 0xa0, 0x9d, 0x54, 0x1a, 0x95, 0x7f, 0x5a, 0xda, 0x04, 0xb6,
-	0x73, 0xca, 0x4c, 0xda, 0x79, 0x02, 0x1b, 0x99, 0x76, 0x46, 0xa2, 0x96, 0x57, 0xed, 0xe7, 0x7a,
-	0xba, 0x9f, 0x4c, 0x3b, 0x81, 0x6d, 0x33, 0x7e, 0x08, 0x79, 0xa6, 0xc9, 0x1f, 0xaf, 0xa9, 0xc1,
-	0x59, 0x4a, 0x0f, 0xce, 0xc8, 0x04, 0x79, 0xb1, 0xfe, 0xb6, 0x09, 0xf6, 0xbf, 0x97, 0x60, 0x2b,
-	0x7d, 0xfc, 0xda, 0xf2, 0xac, 0x09, 0xa6, 0x88, 0x40, 0x4d, 0x98, 0x10, 0xf5, 0x0b, 0x82, 0x16,
-	0xbe, 0x8f, 0xce, 0xde, 0x0d, 0x18, 0xa2, 0x20, 0x6d, 0x2d, 0x4a, 0x28, 0x0a, 0x2e, 0x4c, 0x58,
-	0xd8, 0xdb, 0xc2, 0x84, 0xc5, 0x1d, 0xd4, 0xd6, 0xd0, 0x14, 0x2a, 0x91, 0x2d, 0x90, 0x51, 0x40,
-	0x2e, 0x7a, 0x1a, 0x9d, 0xfe, 0xea, 0x04, 0x99, 0x6c, 0xf0, 0x09, 0xba, 0x36, 0x99, 0xeb, 0xf2,
-	0xf3, 0x9e, 0xc7, 0x7f, 0xab, 0x7c, 0x7c, 0x74, 0xf3, 0xcf, 0xff, 0xe3, 0xe4, 0xef, 0xa8, 0x16,
-	0xcf, 0x94, 0x83, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xbf, 0x47, 0x0d, 0x45, 0xc3, 0x08, 0x00,
-	0x00,
+	proto.RegisterFile("github.com/piotrkowalczuk/charon/pb/rpc/charond/v1/refresh_token.proto", fileDescriptor_refresh_token_606510be34c0fb85)
+}
+
+var fileDescriptor_refresh_token_606510be34c0fb85 = []byte{
+	// 942 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x5d, 0x8f, 0xdb, 0x44,
+	0x14, 0xc5, 0xf9, 0xce, 0x4d, 0x52, 0xd8, 0x29, 0x50, 0xaf, 0x45, 0x21, 0xf2, 0xc2, 0x2a, 0x0f,
+	0x60, 0xef, 0x6e, 0x0b, 0x15, 0x1f, 0x02, 0x25, 0x48, 0x88, 0x56, 0x20, 0x8a, 0xbb, 0xbc, 0xf0,
+	0x12, 0x1c, 0x7b, 0x92, 0xb5, 0xe2, 0x78, 0x9c, 0x99, 0xf1, 0xb2, 0xe9, 0xcf, 0xe1, 0x8d, 0x47,
+	0x24, 0x7e, 0x10, 0xbf, 0x83, 0x27, 0x34, 0x9e, 0x71, 0x62, 0xb7, 0x76, 0xb3, 0xa9, 0x78, 0xda,
+	0x9d, 0x99, 0x7b, 0xe6, 0x9c, 0xb9, 0xf7, 0xdc, 0xeb, 0xc0, 0x77, 0x8b, 0x80, 0x5f, 0x25, 0x33,
+	0xcb, 0x23, 0x2b, 0x3b, 0x0e, 0x08, 0xa7, 0x4b, 0xf2, 0xbb, 0x1b, 0x7a, 0xcf, 0x93, 0xa5, 0xed,
+	0x5d, 0xb9, 0x94, 0x44, 0x76, 0x3c, 0xb3, 0x69, 0xec, 0xa9, 0x95, 0x6f, 0x5f, 0x9f, 0xdb, 0x14,
+	0xcf, 0x29, 0x66, 0x57, 0x53, 0x4e, 0x96, 0x38, 0xb2, 0x62, 0x4a, 0x38, 0x41, 0xef, 0xc8, 0x73,
+	0x8b, 0xc6, 0x9e, 0xa5, 0x42, 0xad, 0xeb, 0x73, 0xe3, 0x83, 0x05, 0x21, 0x8b, 0x10, 0xdb, 0x69,
+	0xd0, 0x2c, 0x99, 0xdb, 0x3c, 0x58, 0x61, 0xc6, 0xdd, 0x55, 0x2c, 0x71, 0xc6, 0x37, 0xaf, 0xc1,
+	0xef, 0x91, 0xd5, 0x8a, 0x28, 0x62, 0xe3, 0xee, 0x9a, 0x6f, 0x62, 0xcc, 0x6c, 0xf9, 0x27, 0xdb,
+	0x8c, 0xe4, 0x66, 0x94, 0xdb, 0x34, 0xff, 0x6a, 0x40, 0xdf, 0x91, 0xd2, 0x2f, 0x85, 0x72, 0xf4,
+	0x36, 0x34, 0xd3, 0x27, 0xe8, 0xda, 0x50, 0x1b, 0x75, 0x1d, 0xb9, 0x40, 0x1f, 0x42, 0x33, 0x22,
+	0x1c, 0x33, 0xbd, 0x36, 0xd4, 0x46, 0xbd, 0x8b, 0x3b, 0x96, 0xba, 0xe4, 0x19, 0xa7, 0x41, 0xb4,
+	0x70, 0xe4, 0x21, 0xba, 0x07, 0xed, 0x84, 0x61, 0x3a, 0x0d, 0x7c, 0xbd, 0x3e, 0xd4, 0x46, 0x75,
+	0xa7, 0x25, 0x96, 0x8f, 0x7d, 0xa4, 0x43, 0x9b, 0xe2, 0x6b, 0xb2, 0xc4, 0xbe, 0xde, 0x18, 0x6a,
+	0xa3, 0x8e, 0x93, 0x2d, 0xd1, 0x23, 0xe8, 0xe2, 0x9b, 0x38, 0xa0, 0x78, 0xea, 0x72, 0xbd, 0x99,
+	0x5e, 0x6e, 0x58, 0x32, 0x3f, 0x56, 0x96, 0x1f, 0xeb, 0x32, 0xcb, 0x8f, 0xd3, 0x91, 0xc1, 0x63,
+	0x8e, 0xbe, 0x82, 0x7e, 0xe8, 0x32, 0x3e, 0x4d, 0x18, 0xf6, 0x05, 0xb6, 0xb5, 0x17, 0x0b, 0x22,
+	0xfe, 0x17, 0x86, 0xfd, 0x31, 0x47, 0x9f, 0x03, 0x78, 0x14, 0xbb, 0x5c, 0x62, 0xdb, 0x7b, 0xb1,
+	0x5d, 0x15, 0x3d, 0xe6, 0xe8, 0xe3, 0x1d, 0x74, 0xb6, 0xd1, 0x3b, 0x29, 0x74, 0x90, 0xe5, 0xe3,
+	0x71, 0xc4, 0x3f, 0x7b, 0xb8, 0x8d, 0x9e, 0x6c, 0x04, 0x51, 0x12, 0xfb, 0x19, 0x51, 0x77, 0x3f,
+	0x91, 0x8a, 0x96, 0x44, 0x19, 0x74, 0xb6, 0xd1, 0xa1, 0x94, 0x48, 0x05, 0x4c, 0x36, 0xe8, 0x5d,
+	0x68, 0xc5, 0x14, 0xcf, 0x83, 0x1b, 0xbd, 0x97, 0x16, 0x4e, 0xad, 0xc4, 0xfe, 0xdc, 0x5d, 0x05,
+	0xe1, 0x46, 0xef, 0xcb, 0x7d, 0xb9, 0x12, 0xc2, 0x28, 0xe1, 0x99, 0xb0, 0xc1, 0x7e, 0x61, 0x2a,
+	0x7a, 0xcc, 0xcd, 0x7f, 0x6b, 0x70, 0x94, 0xf7, 0xcc, 0xcf, 0x09, 0xa6, 0x1b, 0x74, 0xba, 0x2b,
+	0xbe, 0xa6, 0xb4, 0xae, 0xf3, 0x5a, 0x33, 0x2f, 0xbc, 0x64, 0xa5, 0x75, 0x99, 0x95, 0x4e, 0x77,
+	0x8e, 0xa9, 0xa7, 0x71, 0xfd, 0xec, 0xe5, 0x13, 0x42, 0xc2, 0x9d, 0x7f, 0xac, 0xbc, 0x7f, 0x1a,
+	0x69, 0xe4, 0x51, 0x76, 0x63, 0x99, 0x6d, 0x1e, 0xbc, 0x60, 0x9b, 0x66, 0x15, 0x24, 0xef, 0x96,
+	0xb3, 0x82, 0x5b, 0x5a, 0x55, 0x90, 0x9c, 0x49, 0xce, 0x0a, 0x65, 0x6f, 0x57, 0x22, 0x76, 0xd5,
+	0x3e, 0xdd, 0xd6, 0xa9, 0x53, 0x9a, 0x17, 0x75, 0x6a, 0xfe, 0xa1, 0xc1, 0xf1, 0xb7, 0x29, 0x4f,
+	0xbe, 0x04, 0x0e, 0x5e, 0x27, 0x98, 0xf1, 0x5d, 0x72, 0xb5, 0x57, 0xf5, 0x69, 0xa1, 0xe9, 0x6a,
+	0x07, 0x34, 0xdd, 0x69, 0xb1, 0xc1, 0x5f, 0xf2, 0xa3, 0xaa, 0xb1, 0x39, 0x07, 0xa3, 0x4c, 0x23,
+	0x8b, 0x49, 0xc4, 0x30, 0xfa, 0x1e, 0x06, 0x85, 0x69, 0xa9, 0xc4, 0x9e, 0x58, 0xa5, 0xe3, 0xd2,
+	0x2a, 0xdc, 0xd1, 0xa7, 0xb9, 0x95, 0xf9, 0x67, 0x0d, 0xf4, 0x1f, 0x02, 0xc6, 0xf3, 0x21, 0x2c,
+	0xcb, 0xc5, 0x47, 0xd0, 0x22, 0xf3, 0x39, 0xc3, 0x5c, 0xd7, 0x4a, 0xb5, 0xca, 0x43, 0x74, 0x02,
+	0xcd, 0x30, 0x58, 0x05, 0x59, 0x22, 0x5e, 0x88, 0x92, 0x67, 0xe8, 0x11, 0x74, 0x08, 0xf5, 0x31,
+	0x15, 0x9d, 0x58, 0x1f, 0xd6, 0x47, 0xbd, 0x8b, 0xf7, 0x2a, 0xd4, 0xfe, 0x24, 0xc2, 0x9c, 0x76,
+	0x1a, 0x3d, 0xd9, 0xa0, 0xaf, 0xa1, 0xb9, 0x16, 0xed, 0x91, 0x76, 0x65, 0xef, 0x62, 0x74, 0x8b,
+	0x37, 0xa6, 0xed, 0xe4, 0x48, 0x18, 0xba, 0x0f, 0x10, 0xbb, 0x0b, 0xac, 0x12, 0x25, 0x5b, 0xb8,
+	0x2b, 0x76, 0xe4, 0xb4, 0x3e, 0x81, 0x41, 0x10, 0x79, 0x61, 0xe2, 0x8b, 0x08, 0xee, 0x86, 0x69,
+	0x23, 0x77, 0x9c, 0xbe, 0xda, 0xbc, 0x14, 0x7b, 0x4f, 0x1a, 0x9d, 0xc6, 0x5b, 0x3d, 0xf3, 0x6f,
+	0x0d, 0x8e, 0x4b, 0x72, 0xa5, 0x6a, 0xf2, 0x04, 0xee, 0x14, 0x6a, 0x22, 0x1c, 0x54, 0xbf, 0x6d,
+	0x51, 0x06, 0xf9, 0xa2, 0x88, 0xde, 0x7d, 0x33, 0xc2, 0x37, 0x7c, 0x9a, 0x13, 0x5e, 0x4b, 0x85,
+	0x0f, 0xc4, 0xf6, 0xd3, 0x9c, 0xf8, 0xa6, 0x14, 0x5d, 0xea, 0x25, 0x79, 0x66, 0xce, 0xe0, 0xd8,
+	0x49, 0x7b, 0xbd, 0xcc, 0xee, 0xe5, 0x1f, 0xab, 0xdc, 0x67, 0xa8, 0x56, 0xf8, 0x0c, 0xed, 0x66,
+	0x64, 0x3d, 0x3f, 0x23, 0x85, 0x5d, 0xcb, 0x38, 0xfe, 0x77, 0xbb, 0xde, 0xc0, 0x7d, 0xc9, 0x33,
+	0x0e, 0xc3, 0x52, 0xcb, 0xde, 0x2b, 0xce, 0xd0, 0x9d, 0xf2, 0xad, 0x8d, 0x6a, 0xaf, 0x65, 0x23,
+	0x73, 0x09, 0xef, 0x57, 0x31, 0xab, 0x57, 0x1a, 0xd0, 0x91, 0xd9, 0xc0, 0xb2, 0xf4, 0x5d, 0x67,
+	0xbb, 0x46, 0x9f, 0x00, 0x72, 0x67, 0x6e, 0xe4, 0x93, 0x08, 0xfb, 0x53, 0x86, 0x19, 0x0b, 0x48,
+	0xc4, 0x54, 0x6e, 0x8f, 0xb6, 0x27, 0xcf, 0xd4, 0xc1, 0xc5, 0x3f, 0x75, 0xb8, 0x9b, 0x27, 0xf9,
+	0xd1, 0x8d, 0xdc, 0x05, 0xa6, 0x88, 0x40, 0x4b, 0x4e, 0x05, 0x74, 0x56, 0xa1, 0xbf, 0x72, 0xb0,
+	0x19, 0xe7, 0x07, 0x20, 0xe4, 0x8b, 0xcc, 0x37, 0x04, 0xa1, 0x7c, 0x75, 0x25, 0x61, 0xa5, 0xb5,
+	0x8c, 0xf3, 0x03, 0x10, 0x5b, 0xc2, 0x25, 0x34, 0x44, 0x8b, 0x21, 0xbb, 0x02, 0x5c, 0x35, 0xab,
+	0x8c, 0xb3, 0xdb, 0x03, 0xb6, 0x64, 0xcf, 0xa1, 0xbb, 0xad, 0x29, 0x7a, 0xf8, 0x4a, 0xb9, 0x15,
+	0x7e, 0x33, 0x3e, 0x3d, 0x10, 0x95, 0x71, 0x4f, 0x7e, 0x83, 0xa1, 0x47, 0x56, 0x56, 0xf6, 0x3b,
+	0xb5, 0xec, 0x92, 0xa7, 0xda, 0xaf, 0x5f, 0x1c, 0xfe, 0x3b, 0xf6, 0x4b, 0xf5, 0xef, 0xac, 0x95,
+	0x7e, 0x88, 0x1e, 0xfc, 0x37, 0x00, 0x1f, 0x0c, 0x8f, 0xc2, 0x8c, 0x0b, 0x00, 0x00,
 }
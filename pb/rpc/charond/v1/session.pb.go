@@ -0,0 +1,450 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: github.com/piotrkowalczuk/charon/pb/rpc/charond/v1/session.proto
+
+package charond // import "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Session struct {
+	// Id identifies the session without revealing its access token.
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId int64  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Client string `protobuf:"bytes,3,opt,name=client,proto3" json:"client,omitempty"`
+	// Remote address is an address the session was started from.
+	RemoteAddr     string               `protobuf:"bytes,4,opt,name=remote_addr,json=remoteAddr,proto3" json:"remote_addr,omitempty"`
+	LastActivityAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=last_activity_at,json=lastActivityAt,proto3" json:"last_activity_at,omitempty"`
+	ExpireAt       *timestamp.Timestamp `protobuf:"bytes,6,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
+	// Refresh token is a prefix of the refresh token the session was started with.
+	RefreshToken string `protobuf:"bytes,7,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	// API key is a prefix of the API key the session is restricted to.
+	ApiKey string `protobuf:"bytes,8,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// Current is true for the session of the caller.
+	Current              bool     `protobuf:"varint,9,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Session) Reset()         { *m = Session{} }
+func (m *Session) String() string { return proto.CompactTextString(m) }
+func (*Session) ProtoMessage()    {}
+func (*Session) Descriptor() ([]byte, []int) {
+	return fileDescriptor_session_6b852ac968dcf2db, []int{0}
+}
+func (m *Session) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Session.Unmarshal(m, b)
+}
+func (m *Session) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Session.Marshal(b, m, deterministic)
+}
+func (dst *Session) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Session.Merge(dst, src)
+}
+func (m *Session) XXX_Size() int {
+	return xxx_messageInfo_Session.Size(m)
+}
+func (m *Session) XXX_DiscardUnknown() {
+	xxx_messageInfo_Session.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Session proto.InternalMessageInfo
+
+func (m *Session) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Session) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *Session) GetClient() string {
+	if m != nil {
+		return m.Client
+	}
+	return ""
+}
+
+func (m *Session) GetRemoteAddr() string {
+	if m != nil {
+		return m.RemoteAddr
+	}
+	return ""
+}
+
+func (m *Session) GetLastActivityAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastActivityAt
+	}
+	return nil
+}
+
+func (m *Session) GetExpireAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpireAt
+	}
+	return nil
+}
+
+func (m *Session) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
+	}
+	return ""
+}
+
+func (m *Session) GetApiKey() string {
+	if m != nil {
+		return m.ApiKey
+	}
+	return ""
+}
+
+func (m *Session) GetCurrent() bool {
+	if m != nil {
+		return m.Current
+	}
+	return false
+}
+
+type ListSessionsRequest struct {
+	UserId               int64    `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListSessionsRequest) Reset()         { *m = ListSessionsRequest{} }
+func (m *ListSessionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListSessionsRequest) ProtoMessage()    {}
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_session_6b852ac968dcf2db, []int{1}
+}
+func (m *ListSessionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSessionsRequest.Unmarshal(m, b)
+}
+func (m *ListSessionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSessionsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListSessionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSessionsRequest.Merge(dst, src)
+}
+func (m *ListSessionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListSessionsRequest.Size(m)
+}
+func (m *ListSessionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSessionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSessionsRequest proto.InternalMessageInfo
+
+func (m *ListSessionsRequest) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+type ListSessionsResponse struct {
+	Sessions             []*Session `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ListSessionsResponse) Reset()         { *m = ListSessionsResponse{} }
+func (m *ListSessionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListSessionsResponse) ProtoMessage()    {}
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_session_6b852ac968dcf2db, []int{2}
+}
+func (m *ListSessionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSessionsResponse.Unmarshal(m, b)
+}
+func (m *ListSessionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSessionsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListSessionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSessionsResponse.Merge(dst, src)
+}
+func (m *ListSessionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListSessionsResponse.Size(m)
+}
+func (m *ListSessionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSessionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSessionsResponse proto.InternalMessageInfo
+
+func (m *ListSessionsResponse) GetSessions() []*Session {
+	if m != nil {
+		return m.Sessions
+	}
+	return nil
+}
+
+type TerminateSessionsRequest struct {
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Ids narrow down sessions to terminate, all of them are terminated otherwise.
+	Ids                  []string `protobuf:"bytes,2,rep,name=ids,proto3" json:"ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TerminateSessionsRequest) Reset()         { *m = TerminateSessionsRequest{} }
+func (m *TerminateSessionsRequest) String() string { return proto.CompactTextString(m) }
+func (*TerminateSessionsRequest) ProtoMessage()    {}
+func (*TerminateSessionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_session_6b852ac968dcf2db, []int{3}
+}
+func (m *TerminateSessionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TerminateSessionsRequest.Unmarshal(m, b)
+}
+func (m *TerminateSessionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TerminateSessionsRequest.Marshal(b, m, deterministic)
+}
+func (dst *TerminateSessionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TerminateSessionsRequest.Merge(dst, src)
+}
+func (m *TerminateSessionsRequest) XXX_Size() int {
+	return xxx_messageInfo_TerminateSessionsRequest.Size(m)
+}
+func (m *TerminateSessionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TerminateSessionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TerminateSessionsRequest proto.InternalMessageInfo
+
+func (m *TerminateSessionsRequest) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TerminateSessionsRequest) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+type TerminateSessionsResponse struct {
+	// Ids of terminated sessions, unknown if the session store cannot list sessions of a single user.
+	Ids                  []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TerminateSessionsResponse) Reset()         { *m = TerminateSessionsResponse{} }
+func (m *TerminateSessionsResponse) String() string { return proto.CompactTextString(m) }
+func (*TerminateSessionsResponse) ProtoMessage()    {}
+func (*TerminateSessionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_session_6b852ac968dcf2db, []int{4}
+}
+func (m *TerminateSessionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TerminateSessionsResponse.Unmarshal(m, b)
+}
+func (m *TerminateSessionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TerminateSessionsResponse.Marshal(b, m, deterministic)
+}
+func (dst *TerminateSessionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TerminateSessionsResponse.Merge(dst, src)
+}
+func (m *TerminateSessionsResponse) XXX_Size() int {
+	return xxx_messageInfo_TerminateSessionsResponse.Size(m)
+}
+func (m *TerminateSessionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TerminateSessionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TerminateSessionsResponse proto.InternalMessageInfo
+
+func (m *TerminateSessionsResponse) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Session)(nil), "charon.rpc.charond.v1.Session")
+	proto.RegisterType((*ListSessionsRequest)(nil), "charon.rpc.charond.v1.ListSessionsRequest")
+	proto.RegisterType((*ListSessionsResponse)(nil), "charon.rpc.charond.v1.ListSessionsResponse")
+	proto.RegisterType((*TerminateSessionsRequest)(nil), "charon.rpc.charond.v1.TerminateSessionsRequest")
+	proto.RegisterType((*TerminateSessionsResponse)(nil), "charon.rpc.charond.v1.TerminateSessionsResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// SessionManagerClient is the client API for SessionManager service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SessionManagerClient interface {
+	// List returns active sessions of a user.
+	List(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	// Terminate abandons sessions of a user, session of the caller is kept unless explicitly listed.
+	Terminate(ctx context.Context, in *TerminateSessionsRequest, opts ...grpc.CallOption) (*TerminateSessionsResponse, error)
+}
+
+type sessionManagerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSessionManagerClient(cc *grpc.ClientConn) SessionManagerClient {
+	return &sessionManagerClient{cc}
+}
+
+func (c *sessionManagerClient) List(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.SessionManager/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionManagerClient) Terminate(ctx context.Context, in *TerminateSessionsRequest, opts ...grpc.CallOption) (*TerminateSessionsResponse, error) {
+	out := new(TerminateSessionsResponse)
+	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.SessionManager/Terminate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SessionManagerServer is the server API for SessionManager service.
+type SessionManagerServer interface {
+	// List returns active sessions of a user.
+	List(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	// Terminate abandons sessions of a user, session of the caller is kept unless explicitly listed.
+	Terminate(context.Context, *TerminateSessionsRequest) (*TerminateSessionsResponse, error)
+}
+
+func RegisterSessionManagerServer(s *grpc.Server, srv SessionManagerServer) {
+	s.RegisterService(&_SessionManager_serviceDesc, srv)
+}
+
+func _SessionManager_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionManagerServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/charon.rpc.charond.v1.SessionManager/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionManagerServer).List(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionManager_Terminate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TerminateSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionManagerServer).Terminate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/charon.rpc.charond.v1.SessionManager/Terminate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionManagerServer).Terminate(ctx, req.(*TerminateSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _SessionManager_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "charon.rpc.charond.v1.SessionManager",
+	HandlerType: (*SessionManagerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _SessionManager_List_Handler,
+		},
+		{
+			MethodName: "Terminate",
+			Handler:    _SessionManager_Terminate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1/session.proto",
+}
+
+func init() {
+	proto.RegisterFile("github.com/piotrkowalczuk/charon/pb/rpc/charond/v1/session.proto", fileDescriptor_session_6b852ac968dcf2db)
+}
+
+var fileDescriptor_session_6b852ac968dcf2db = []byte{
+	// 485 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcd, 0x8e, 0xd3, 0x30,
+	0x14, 0x85, 0x49, 0x3b, 0xf4, 0xe7, 0x16, 0xaa, 0x91, 0xf9, 0x33, 0x5d, 0x30, 0x51, 0xd8, 0x44,
+	0x20, 0x1c, 0xa6, 0x2c, 0x90, 0x86, 0x0d, 0x45, 0xb0, 0x40, 0x80, 0x84, 0x42, 0x57, 0x6c, 0x82,
+	0x9b, 0xdc, 0x69, 0xad, 0x36, 0xb1, 0xb1, 0x9d, 0x42, 0x79, 0x3c, 0xde, 0x85, 0xf7, 0x40, 0x69,
+	0x9c, 0x6a, 0x90, 0x5a, 0xd1, 0xd9, 0xf9, 0x5e, 0x7f, 0xc7, 0x39, 0xf7, 0x38, 0x86, 0xd7, 0x73,
+	0x61, 0x17, 0xe5, 0x8c, 0xa5, 0x32, 0x8f, 0x94, 0x90, 0x56, 0x2f, 0xe5, 0x0f, 0xbe, 0x4a, 0x7f,
+	0x95, 0xcb, 0x28, 0x5d, 0x70, 0x2d, 0x8b, 0x48, 0xcd, 0x22, 0xad, 0x52, 0x57, 0x65, 0xd1, 0xfa,
+	0x3c, 0x32, 0x68, 0x8c, 0x90, 0x05, 0x53, 0x5a, 0x5a, 0x49, 0xee, 0xd5, 0x3b, 0x4c, 0xab, 0x94,
+	0x39, 0x88, 0xad, 0xcf, 0x47, 0x67, 0x73, 0x29, 0xe7, 0x2b, 0x8c, 0xb6, 0xd0, 0xac, 0xbc, 0x8c,
+	0xac, 0xc8, 0xd1, 0x58, 0x9e, 0xab, 0x5a, 0x17, 0xfc, 0x6e, 0x41, 0xf7, 0x4b, 0x7d, 0x12, 0x19,
+	0x42, 0x4b, 0x64, 0xd4, 0xf3, 0xbd, 0xb0, 0x1f, 0xb7, 0x44, 0x46, 0x1e, 0x40, 0xb7, 0x34, 0xa8,
+	0x13, 0x91, 0xd1, 0x96, 0xef, 0x85, 0xed, 0xb8, 0x53, 0x95, 0xef, 0x33, 0x72, 0x1f, 0x3a, 0xe9,
+	0x4a, 0x60, 0x61, 0x69, 0x7b, 0x0b, 0xbb, 0x8a, 0x9c, 0xc1, 0x40, 0x63, 0x2e, 0x2d, 0x26, 0x3c,
+	0xcb, 0x34, 0x3d, 0xd9, 0x6e, 0x42, 0xdd, 0x9a, 0x64, 0x99, 0x26, 0x6f, 0xe1, 0x74, 0xc5, 0x8d,
+	0x4d, 0x78, 0x6a, 0xc5, 0x5a, 0xd8, 0x4d, 0xc2, 0x2d, 0xbd, 0xe9, 0x7b, 0xe1, 0x60, 0x3c, 0x62,
+	0xb5, 0x53, 0xd6, 0x38, 0x65, 0xd3, 0xc6, 0x69, 0x3c, 0xac, 0x34, 0x13, 0x27, 0x99, 0x58, 0xf2,
+	0x12, 0xfa, 0xf8, 0x53, 0x09, 0x8d, 0x95, 0xbc, 0xf3, 0x5f, 0x79, 0xaf, 0x86, 0x27, 0x96, 0x3c,
+	0x86, 0xdb, 0x1a, 0x2f, 0x35, 0x9a, 0x45, 0x62, 0xe5, 0x12, 0x0b, 0xda, 0xdd, 0x3a, 0xbc, 0xe5,
+	0x9a, 0xd3, 0xaa, 0x57, 0x4d, 0xcd, 0x95, 0x48, 0x96, 0xb8, 0xa1, 0xbd, 0x7a, 0x3a, 0xae, 0xc4,
+	0x07, 0xdc, 0x10, 0x0a, 0xdd, 0xb4, 0xd4, 0xba, 0x1a, 0xbb, 0xef, 0x7b, 0x61, 0x2f, 0x6e, 0xca,
+	0x80, 0xc1, 0x9d, 0x8f, 0xc2, 0x58, 0x97, 0xa3, 0x89, 0xf1, 0x7b, 0x89, 0xc6, 0x5e, 0xcd, 0xcf,
+	0xbb, 0x9a, 0x5f, 0x10, 0xc3, 0xdd, 0x7f, 0x79, 0xa3, 0x64, 0x61, 0x90, 0x5c, 0x40, 0xcf, 0xdd,
+	0xaa, 0xa1, 0x9e, 0xdf, 0x0e, 0x07, 0xe3, 0x47, 0x6c, 0xef, 0xbd, 0x32, 0x27, 0x8d, 0x77, 0x7c,
+	0xf0, 0x0e, 0xe8, 0x14, 0x75, 0x2e, 0x0a, 0x6e, 0xf1, 0x58, 0x23, 0xe4, 0x14, 0xda, 0x22, 0x33,
+	0xb4, 0xe5, 0xb7, 0xc3, 0x7e, 0x5c, 0x2d, 0x83, 0x67, 0xf0, 0x70, 0xcf, 0x31, 0xce, 0x9f, 0xc3,
+	0xbd, 0x1d, 0x3e, 0xfe, 0xe3, 0xc1, 0xd0, 0x61, 0x9f, 0x78, 0xc1, 0xe7, 0xa8, 0x09, 0x87, 0x93,
+	0x6a, 0x38, 0xf2, 0xe4, 0x80, 0xf5, 0x3d, 0x49, 0x8d, 0x9e, 0x1e, 0xc5, 0xd6, 0x2e, 0x82, 0x1b,
+	0x44, 0x41, 0x7f, 0x67, 0x92, 0x44, 0x07, 0xb4, 0x87, 0xd2, 0x18, 0x3d, 0x3f, 0x5e, 0xd0, 0x7c,
+	0xf1, 0xcd, 0x37, 0xf0, 0x53, 0x99, 0xb3, 0xe6, 0x99, 0xee, 0xd3, 0x7f, 0xf6, 0xbe, 0x5e, 0x5c,
+	0xff, 0x19, 0xbf, 0x72, 0xcb, 0x59, 0x67, 0xfb, 0xe7, 0xbe, 0xf8, 0x3b, 0x00, 0x09, 0xf1, 0x0e,
+	0x10, 0x0b, 0x04, 0x00, 0x00,
+}
@@ -6,6 +6,7 @@ package charond // import "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
+import empty "github.com/golang/protobuf/ptypes/empty"
 import timestamp "github.com/golang/protobuf/ptypes/timestamp"
 import wrappers "github.com/golang/protobuf/ptypes/wrappers"
 import ntypes "github.com/piotrkowalczuk/ntypes"
@@ -28,18 +29,24 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
 type User struct {
-	Id                   int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Username             string               `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	FirstName            string               `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName             string               `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	IsSuperuser          bool                 `protobuf:"varint,5,opt,name=is_superuser,json=isSuperuser,proto3" json:"is_superuser,omitempty"`
-	IsActive             bool                 `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	IsStaff              bool                 `protobuf:"varint,7,opt,name=is_staff,json=isStaff,proto3" json:"is_staff,omitempty"`
-	IsConfirmed          bool                 `protobuf:"varint,8,opt,name=is_confirmed,json=isConfirmed,proto3" json:"is_confirmed,omitempty"`
-	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	CreatedBy            *ntypes.Int64        `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	UpdatedBy            *ntypes.Int64        `protobuf:"bytes,12,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Id                 int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username           string               `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	FirstName          string               `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName           string               `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	IsSuperuser        bool                 `protobuf:"varint,5,opt,name=is_superuser,json=isSuperuser,proto3" json:"is_superuser,omitempty"`
+	IsActive           bool                 `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	IsStaff            bool                 `protobuf:"varint,7,opt,name=is_staff,json=isStaff,proto3" json:"is_staff,omitempty"`
+	IsConfirmed        bool                 `protobuf:"varint,8,opt,name=is_confirmed,json=isConfirmed,proto3" json:"is_confirmed,omitempty"`
+	CreatedAt          *timestamp.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy          *ntypes.Int64        `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedAt          *timestamp.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedBy          *ntypes.Int64        `protobuf:"bytes,12,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	IsTwoFactorEnabled bool                 `protobuf:"varint,13,opt,name=is_two_factor_enabled,json=isTwoFactorEnabled,proto3" json:"is_two_factor_enabled,omitempty"`
+	IsLocked           bool                 `protobuf:"varint,14,opt,name=is_locked,json=isLocked,proto3" json:"is_locked,omitempty"`
+	// Service account does not have a password, it authenticates with API keys.
+	IsServiceAccount bool `protobuf:"varint,15,opt,name=is_service_account,json=isServiceAccount,proto3" json:"is_service_account,omitempty"`
+	// Deleted at is set only if the user is soft deleted.
+	DeletedAt            *timestamp.Timestamp `protobuf:"bytes,16,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -49,7 +56,7 @@ func (m *User) Reset()         { *m = User{} }
 func (m *User) String() string { return proto.CompactTextString(m) }
 func (*User) ProtoMessage()    {}
 func (*User) Descriptor() ([]byte, []int) {
-	return fileDescriptor_user_ddd072e15a450679, []int{0}
+	return fileDescriptor_user_ed810dfda6844d7d, []int{0}
 }
 func (m *User) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_User.Unmarshal(m, b)
@@ -153,16 +160,46 @@ func (m *User) GetUpdatedBy() *ntypes.Int64 {
 	return nil
 }
 
+func (m *User) GetIsTwoFactorEnabled() bool {
+	if m != nil {
+		return m.IsTwoFactorEnabled
+	}
+	return false
+}
+
+func (m *User) GetIsLocked() bool {
+	if m != nil {
+		return m.IsLocked
+	}
+	return false
+}
+
+func (m *User) GetIsServiceAccount() bool {
+	if m != nil {
+		return m.IsServiceAccount
+	}
+	return false
+}
+
+func (m *User) GetDeletedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.DeletedAt
+	}
+	return nil
+}
+
 type CreateUserRequest struct {
-	Username             string       `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	PlainPassword        string       `protobuf:"bytes,2,opt,name=plain_password,json=plainPassword,proto3" json:"plain_password,omitempty"`
-	SecurePassword       []byte       `protobuf:"bytes,3,opt,name=secure_password,json=securePassword,proto3" json:"secure_password,omitempty"`
-	FirstName            string       `protobuf:"bytes,4,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName             string       `protobuf:"bytes,5,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	IsSuperuser          *ntypes.Bool `protobuf:"bytes,6,opt,name=is_superuser,json=isSuperuser,proto3" json:"is_superuser,omitempty"`
-	IsActive             *ntypes.Bool `protobuf:"bytes,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	IsStaff              *ntypes.Bool `protobuf:"bytes,8,opt,name=is_staff,json=isStaff,proto3" json:"is_staff,omitempty"`
-	IsConfirmed          *ntypes.Bool `protobuf:"bytes,9,opt,name=is_confirmed,json=isConfirmed,proto3" json:"is_confirmed,omitempty"`
+	Username       string       `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	PlainPassword  string       `protobuf:"bytes,2,opt,name=plain_password,json=plainPassword,proto3" json:"plain_password,omitempty"`
+	SecurePassword []byte       `protobuf:"bytes,3,opt,name=secure_password,json=securePassword,proto3" json:"secure_password,omitempty"`
+	FirstName      string       `protobuf:"bytes,4,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName       string       `protobuf:"bytes,5,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	IsSuperuser    *ntypes.Bool `protobuf:"bytes,6,opt,name=is_superuser,json=isSuperuser,proto3" json:"is_superuser,omitempty"`
+	IsActive       *ntypes.Bool `protobuf:"bytes,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	IsStaff        *ntypes.Bool `protobuf:"bytes,8,opt,name=is_staff,json=isStaff,proto3" json:"is_staff,omitempty"`
+	IsConfirmed    *ntypes.Bool `protobuf:"bytes,9,opt,name=is_confirmed,json=isConfirmed,proto3" json:"is_confirmed,omitempty"`
+	// Password has to be empty if service account is requested.
+	IsServiceAccount     *ntypes.Bool `protobuf:"bytes,10,opt,name=is_service_account,json=isServiceAccount,proto3" json:"is_service_account,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
@@ -172,7 +209,7 @@ func (m *CreateUserRequest) Reset()         { *m = CreateUserRequest{} }
 func (m *CreateUserRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateUserRequest) ProtoMessage()    {}
 func (*CreateUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_user_ddd072e15a450679, []int{1}
+	return fileDescriptor_user_ed810dfda6844d7d, []int{1}
 }
 func (m *CreateUserRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateUserRequest.Unmarshal(m, b)
@@ -255,6 +292,13 @@ func (m *CreateUserRequest) GetIsConfirmed() *ntypes.Bool {
 	return nil
 }
 
+func (m *CreateUserRequest) GetIsServiceAccount() *ntypes.Bool {
+	if m != nil {
+		return m.IsServiceAccount
+	}
+	return nil
+}
+
 type CreateUserResponse struct {
 	User                 *User    `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -266,7 +310,7 @@ func (m *CreateUserResponse) Reset()         { *m = CreateUserResponse{} }
 func (m *CreateUserResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateUserResponse) ProtoMessage()    {}
 func (*CreateUserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_user_ddd072e15a450679, []int{2}
+	return fileDescriptor_user_ed810dfda6844d7d, []int{2}
 }
 func (m *CreateUserResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateUserResponse.Unmarshal(m, b)
@@ -294,7 +338,9 @@ func (m *CreateUserResponse) GetUser() *User {
 }
 
 type GetUserRequest struct {
-	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Soft deleted user is not found, unless requested.
+	IncludeDeleted       bool     `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -304,7 +350,7 @@ func (m *GetUserRequest) Reset()         { *m = GetUserRequest{} }
 func (m *GetUserRequest) String() string { return proto.CompactTextString(m) }
 func (*GetUserRequest) ProtoMessage()    {}
 func (*GetUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_user_ddd072e15a450679, []int{3}
+	return fileDescriptor_user_ed810dfda6844d7d, []int{3}
 }
 func (m *GetUserRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetUserRequest.Unmarshal(m, b)
@@ -331,6 +377,13 @@ func (m *GetUserRequest) GetId() int64 {
 	return 0
 }
 
+func (m *GetUserRequest) GetIncludeDeleted() bool {
+	if m != nil {
+		return m.IncludeDeleted
+	}
+	return false
+}
+
 type GetUserResponse struct {
 	User                 *User    `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -342,7 +395,7 @@ func (m *GetUserResponse) Reset()         { *m = GetUserResponse{} }
 func (m *GetUserResponse) String() string { return proto.CompactTextString(m) }
 func (*GetUserResponse) ProtoMessage()    {}
 func (*GetUserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_user_ddd072e15a450679, []int{4}
+	return fileDescriptor_user_ed810dfda6844d7d, []int{4}
 }
 func (m *GetUserResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetUserResponse.Unmarshal(m, b)
@@ -370,23 +423,31 @@ func (m *GetUserResponse) GetUser() *User {
 }
 
 type ListUsersRequest struct {
-	IsSuperuser          *ntypes.Bool    `protobuf:"bytes,1,opt,name=is_superuser,json=isSuperuser,proto3" json:"is_superuser,omitempty"`
-	IsStaff              *ntypes.Bool    `protobuf:"bytes,2,opt,name=is_staff,json=isStaff,proto3" json:"is_staff,omitempty"`
-	CreatedBy            *qtypes.Int64   `protobuf:"bytes,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	Offset               *ntypes.Int64   `protobuf:"bytes,100,opt,name=offset,proto3" json:"offset,omitempty"`
-	Limit                *ntypes.Int64   `protobuf:"bytes,101,opt,name=limit,proto3" json:"limit,omitempty"`
-	Sort                 map[string]bool `protobuf:"bytes,102,rep,name=sort,proto3" json:"sort,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // Deprecated: Do not use.
-	OrderBy              []*Order        `protobuf:"bytes,103,rep,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	IsSuperuser      *ntypes.Bool  `protobuf:"bytes,1,opt,name=is_superuser,json=isSuperuser,proto3" json:"is_superuser,omitempty"`
+	IsStaff          *ntypes.Bool  `protobuf:"bytes,2,opt,name=is_staff,json=isStaff,proto3" json:"is_staff,omitempty"`
+	CreatedBy        *qtypes.Int64 `protobuf:"bytes,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	IsServiceAccount *ntypes.Bool  `protobuf:"bytes,4,opt,name=is_service_account,json=isServiceAccount,proto3" json:"is_service_account,omitempty"`
+	// Soft deleted users are left out by default, if true only they are listed.
+	IsDeleted *ntypes.Bool    `protobuf:"bytes,5,opt,name=is_deleted,json=isDeleted,proto3" json:"is_deleted,omitempty"`
+	Offset    *ntypes.Int64   `protobuf:"bytes,100,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit     *ntypes.Int64   `protobuf:"bytes,101,opt,name=limit,proto3" json:"limit,omitempty"`
+	Sort      map[string]bool `protobuf:"bytes,102,rep,name=sort,proto3" json:"sort,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // Deprecated: Do not use.
+	OrderBy   []*Order        `protobuf:"bytes,103,rep,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// Page token continues the list where the previous page ended, it cannot be combined with offset.
+	// Order has to be the same as the one the token was issued for.
+	PageToken string `protobuf:"bytes,104,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Total number of matching rows is counted only on request.
+	IncludeTotal         bool     `protobuf:"varint,105,opt,name=include_total,json=includeTotal,proto3" json:"include_total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ListUsersRequest) Reset()         { *m = ListUsersRequest{} }
 func (m *ListUsersRequest) String() string { return proto.CompactTextString(m) }
 func (*ListUsersRequest) ProtoMessage()    {}
 func (*ListUsersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_user_ddd072e15a450679, []int{5}
+	return fileDescriptor_user_ed810dfda6844d7d, []int{5}
 }
 func (m *ListUsersRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListUsersRequest.Unmarshal(m, b)
@@ -427,6 +488,20 @@ func (m *ListUsersRequest) GetCreatedBy() *qtypes.Int64 {
 	return nil
 }
 
+func (m *ListUsersRequest) GetIsServiceAccount() *ntypes.Bool {
+	if m != nil {
+		return m.IsServiceAccount
+	}
+	return nil
+}
+
+func (m *ListUsersRequest) GetIsDeleted() *ntypes.Bool {
+	if m != nil {
+		return m.IsDeleted
+	}
+	return nil
+}
+
 func (m *ListUsersRequest) GetOffset() *ntypes.Int64 {
 	if m != nil {
 		return m.Offset
@@ -456,18 +531,35 @@ func (m *ListUsersRequest) GetOrderBy() []*Order {
 	return nil
 }
 
+func (m *ListUsersRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *ListUsersRequest) GetIncludeTotal() bool {
+	if m != nil {
+		return m.IncludeTotal
+	}
+	return false
+}
+
 type ListUsersResponse struct {
-	Users                []*User  `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Users []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	// Next page token is empty if there is nothing more to retrieve.
+	NextPageToken        string        `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Total                *ntypes.Int64 `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *ListUsersResponse) Reset()         { *m = ListUsersResponse{} }
 func (m *ListUsersResponse) String() string { return proto.CompactTextString(m) }
 func (*ListUsersResponse) ProtoMessage()    {}
 func (*ListUsersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_user_ddd072e15a450679, []int{6}
+	return fileDescriptor_user_ed810dfda6844d7d, []int{6}
 }
 func (m *ListUsersResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListUsersResponse.Unmarshal(m, b)
@@ -494,8 +586,27 @@ func (m *ListUsersResponse) GetUsers() []*User {
 	return nil
 }
 
+func (m *ListUsersResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *ListUsersResponse) GetTotal() *ntypes.Int64 {
+	if m != nil {
+		return m.Total
+	}
+	return nil
+}
+
 type DeleteUserRequest struct {
-	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Soft deleted user is hidden and cannot log in, but can be restored until it is purged.
+	Soft bool `protobuf:"varint,2,opt,name=soft,proto3" json:"soft,omitempty"`
+	// Cascade removes groups, permissions and refresh tokens of the user as well,
+	// otherwise user that has any of them assigned cannot be hard deleted.
+	Cascade              bool     `protobuf:"varint,3,opt,name=cascade,proto3" json:"cascade,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -505,7 +616,7 @@ func (m *DeleteUserRequest) Reset()         { *m = DeleteUserRequest{} }
 func (m *DeleteUserRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteUserRequest) ProtoMessage()    {}
 func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_user_ddd072e15a450679, []int{7}
+	return fileDescriptor_user_ed810dfda6844d7d, []int{7}
 }
 func (m *DeleteUserRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteUserRequest.Unmarshal(m, b)
@@ -532,6 +643,134 @@ func (m *DeleteUserRequest) GetId() int64 {
 	return 0
 }
 
+func (m *DeleteUserRequest) GetSoft() bool {
+	if m != nil {
+		return m.Soft
+	}
+	return false
+}
+
+func (m *DeleteUserRequest) GetCascade() bool {
+	if m != nil {
+		return m.Cascade
+	}
+	return false
+}
+
+type RestoreUserRequest struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreUserRequest) Reset()         { *m = RestoreUserRequest{} }
+func (m *RestoreUserRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreUserRequest) ProtoMessage()    {}
+func (*RestoreUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_user_ed810dfda6844d7d, []int{8}
+}
+func (m *RestoreUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreUserRequest.Unmarshal(m, b)
+}
+func (m *RestoreUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreUserRequest.Marshal(b, m, deterministic)
+}
+func (dst *RestoreUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreUserRequest.Merge(dst, src)
+}
+func (m *RestoreUserRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreUserRequest.Size(m)
+}
+func (m *RestoreUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreUserRequest proto.InternalMessageInfo
+
+func (m *RestoreUserRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type RestoreUserResponse struct {
+	User                 *User    `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreUserResponse) Reset()         { *m = RestoreUserResponse{} }
+func (m *RestoreUserResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreUserResponse) ProtoMessage()    {}
+func (*RestoreUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_user_ed810dfda6844d7d, []int{9}
+}
+func (m *RestoreUserResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreUserResponse.Unmarshal(m, b)
+}
+func (m *RestoreUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreUserResponse.Marshal(b, m, deterministic)
+}
+func (dst *RestoreUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreUserResponse.Merge(dst, src)
+}
+func (m *RestoreUserResponse) XXX_Size() int {
+	return xxx_messageInfo_RestoreUserResponse.Size(m)
+}
+func (m *RestoreUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreUserResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreUserResponse proto.InternalMessageInfo
+
+func (m *RestoreUserResponse) GetUser() *User {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+type UnlockUserRequest struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnlockUserRequest) Reset()         { *m = UnlockUserRequest{} }
+func (m *UnlockUserRequest) String() string { return proto.CompactTextString(m) }
+func (*UnlockUserRequest) ProtoMessage()    {}
+func (*UnlockUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_user_ed810dfda6844d7d, []int{10}
+}
+func (m *UnlockUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnlockUserRequest.Unmarshal(m, b)
+}
+func (m *UnlockUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnlockUserRequest.Marshal(b, m, deterministic)
+}
+func (dst *UnlockUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockUserRequest.Merge(dst, src)
+}
+func (m *UnlockUserRequest) XXX_Size() int {
+	return xxx_messageInfo_UnlockUserRequest.Size(m)
+}
+func (m *UnlockUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnlockUserRequest proto.InternalMessageInfo
+
+func (m *UnlockUserRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
 type ModifyUserRequest struct {
 	Id                   int64          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Username             *ntypes.String `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
@@ -552,7 +791,7 @@ func (m *ModifyUserRequest) Reset()         { *m = ModifyUserRequest{} }
 func (m *ModifyUserRequest) String() string { return proto.CompactTextString(m) }
 func (*ModifyUserRequest) ProtoMessage()    {}
 func (*ModifyUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_user_ddd072e15a450679, []int{8}
+	return fileDescriptor_user_ed810dfda6844d7d, []int{11}
 }
 func (m *ModifyUserRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ModifyUserRequest.Unmarshal(m, b)
@@ -653,7 +892,7 @@ func (m *ModifyUserResponse) Reset()         { *m = ModifyUserResponse{} }
 func (m *ModifyUserResponse) String() string { return proto.CompactTextString(m) }
 func (*ModifyUserResponse) ProtoMessage()    {}
 func (*ModifyUserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_user_ddd072e15a450679, []int{9}
+	return fileDescriptor_user_ed810dfda6844d7d, []int{12}
 }
 func (m *ModifyUserResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ModifyUserResponse.Unmarshal(m, b)
@@ -691,7 +930,7 @@ func (m *ListUserPermissionsRequest) Reset()         { *m = ListUserPermissionsR
 func (m *ListUserPermissionsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListUserPermissionsRequest) ProtoMessage()    {}
 func (*ListUserPermissionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_user_ddd072e15a450679, []int{10}
+	return fileDescriptor_user_ed810dfda6844d7d, []int{13}
 }
 func (m *ListUserPermissionsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListUserPermissionsRequest.Unmarshal(m, b)
@@ -719,17 +958,19 @@ func (m *ListUserPermissionsRequest) GetId() int64 {
 }
 
 type ListUserPermissionsResponse struct {
-	Permissions          []string `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Permissions []string `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	// Validity of time-bound permissions granted directly to the user, keyed by permission.
+	Validity             map[string]*Validity `protobuf:"bytes,2,rep,name=validity,proto3" json:"validity,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *ListUserPermissionsResponse) Reset()         { *m = ListUserPermissionsResponse{} }
 func (m *ListUserPermissionsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListUserPermissionsResponse) ProtoMessage()    {}
 func (*ListUserPermissionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_user_ddd072e15a450679, []int{11}
+	return fileDescriptor_user_ed810dfda6844d7d, []int{14}
 }
 func (m *ListUserPermissionsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListUserPermissionsResponse.Unmarshal(m, b)
@@ -756,21 +997,34 @@ func (m *ListUserPermissionsResponse) GetPermissions() []string {
 	return nil
 }
 
+func (m *ListUserPermissionsResponse) GetValidity() map[string]*Validity {
+	if m != nil {
+		return m.Validity
+	}
+	return nil
+}
+
 type SetUserPermissionsRequest struct {
 	UserId      int64    `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Permissions []string `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions,omitempty"`
 	// Force tells if permission should be created in case if it does not exists.
-	Force                bool     `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Force bool `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
+	// Denied permissions are linked with the user as well, but they override any grant,
+	// including those inherited from groups.
+	DeniedPermissions []string `protobuf:"bytes,4,rep,name=denied_permissions,json=deniedPermissions,proto3" json:"denied_permissions,omitempty"`
+	// Validity makes links of given permissions, either granted or denied, time-bound.
+	// Permissions missing from the map are linked permanently.
+	Validity             map[string]*Validity `protobuf:"bytes,5,rep,name=validity,proto3" json:"validity,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *SetUserPermissionsRequest) Reset()         { *m = SetUserPermissionsRequest{} }
 func (m *SetUserPermissionsRequest) String() string { return proto.CompactTextString(m) }
 func (*SetUserPermissionsRequest) ProtoMessage()    {}
 func (*SetUserPermissionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_user_ddd072e15a450679, []int{12}
+	return fileDescriptor_user_ed810dfda6844d7d, []int{15}
 }
 func (m *SetUserPermissionsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetUserPermissionsRequest.Unmarshal(m, b)
@@ -811,6 +1065,20 @@ func (m *SetUserPermissionsRequest) GetForce() bool {
 	return false
 }
 
+func (m *SetUserPermissionsRequest) GetDeniedPermissions() []string {
+	if m != nil {
+		return m.DeniedPermissions
+	}
+	return nil
+}
+
+func (m *SetUserPermissionsRequest) GetValidity() map[string]*Validity {
+	if m != nil {
+		return m.Validity
+	}
+	return nil
+}
+
 type SetUserPermissionsResponse struct {
 	Created              int64    `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
 	Removed              int64    `protobuf:"varint,2,opt,name=removed,proto3" json:"removed,omitempty"`
@@ -824,7 +1092,7 @@ func (m *SetUserPermissionsResponse) Reset()         { *m = SetUserPermissionsRe
 func (m *SetUserPermissionsResponse) String() string { return proto.CompactTextString(m) }
 func (*SetUserPermissionsResponse) ProtoMessage()    {}
 func (*SetUserPermissionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_user_ddd072e15a450679, []int{13}
+	return fileDescriptor_user_ed810dfda6844d7d, []int{16}
 }
 func (m *SetUserPermissionsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetUserPermissionsResponse.Unmarshal(m, b)
@@ -865,6 +1133,123 @@ func (m *SetUserPermissionsResponse) GetUntouched() int64 {
 	return 0
 }
 
+type SetUserResourcePermissionsRequest struct {
+	UserId      int64     `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Resource    *Resource `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty"`
+	Permissions []string  `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	// Force tells if permission should be created in case if it does not exists.
+	Force                bool     `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetUserResourcePermissionsRequest) Reset()         { *m = SetUserResourcePermissionsRequest{} }
+func (m *SetUserResourcePermissionsRequest) String() string { return proto.CompactTextString(m) }
+func (*SetUserResourcePermissionsRequest) ProtoMessage()    {}
+func (*SetUserResourcePermissionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_user_ed810dfda6844d7d, []int{17}
+}
+func (m *SetUserResourcePermissionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetUserResourcePermissionsRequest.Unmarshal(m, b)
+}
+func (m *SetUserResourcePermissionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetUserResourcePermissionsRequest.Marshal(b, m, deterministic)
+}
+func (dst *SetUserResourcePermissionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetUserResourcePermissionsRequest.Merge(dst, src)
+}
+func (m *SetUserResourcePermissionsRequest) XXX_Size() int {
+	return xxx_messageInfo_SetUserResourcePermissionsRequest.Size(m)
+}
+func (m *SetUserResourcePermissionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetUserResourcePermissionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetUserResourcePermissionsRequest proto.InternalMessageInfo
+
+func (m *SetUserResourcePermissionsRequest) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *SetUserResourcePermissionsRequest) GetResource() *Resource {
+	if m != nil {
+		return m.Resource
+	}
+	return nil
+}
+
+func (m *SetUserResourcePermissionsRequest) GetPermissions() []string {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+func (m *SetUserResourcePermissionsRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
+type SetUserResourcePermissionsResponse struct {
+	Created              int64    `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
+	Removed              int64    `protobuf:"varint,2,opt,name=removed,proto3" json:"removed,omitempty"`
+	Untouched            int64    `protobuf:"varint,3,opt,name=untouched,proto3" json:"untouched,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetUserResourcePermissionsResponse) Reset()         { *m = SetUserResourcePermissionsResponse{} }
+func (m *SetUserResourcePermissionsResponse) String() string { return proto.CompactTextString(m) }
+func (*SetUserResourcePermissionsResponse) ProtoMessage()    {}
+func (*SetUserResourcePermissionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_user_ed810dfda6844d7d, []int{18}
+}
+func (m *SetUserResourcePermissionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetUserResourcePermissionsResponse.Unmarshal(m, b)
+}
+func (m *SetUserResourcePermissionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetUserResourcePermissionsResponse.Marshal(b, m, deterministic)
+}
+func (dst *SetUserResourcePermissionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetUserResourcePermissionsResponse.Merge(dst, src)
+}
+func (m *SetUserResourcePermissionsResponse) XXX_Size() int {
+	return xxx_messageInfo_SetUserResourcePermissionsResponse.Size(m)
+}
+func (m *SetUserResourcePermissionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetUserResourcePermissionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetUserResourcePermissionsResponse proto.InternalMessageInfo
+
+func (m *SetUserResourcePermissionsResponse) GetCreated() int64 {
+	if m != nil {
+		return m.Created
+	}
+	return 0
+}
+
+func (m *SetUserResourcePermissionsResponse) GetRemoved() int64 {
+	if m != nil {
+		return m.Removed
+	}
+	return 0
+}
+
+func (m *SetUserResourcePermissionsResponse) GetUntouched() int64 {
+	if m != nil {
+		return m.Untouched
+	}
+	return 0
+}
+
 type ListUserGroupsRequest struct {
 	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -876,7 +1261,7 @@ func (m *ListUserGroupsRequest) Reset()         { *m = ListUserGroupsRequest{} }
 func (m *ListUserGroupsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListUserGroupsRequest) ProtoMessage()    {}
 func (*ListUserGroupsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_user_ddd072e15a450679, []int{14}
+	return fileDescriptor_user_ed810dfda6844d7d, []int{19}
 }
 func (m *ListUserGroupsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListUserGroupsRequest.Unmarshal(m, b)
@@ -904,17 +1289,19 @@ func (m *ListUserGroupsRequest) GetId() int64 {
 }
 
 type ListUserGroupsResponse struct {
-	Groups               []*Group `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Groups []*Group `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	// Validity of time-bound memberships, keyed by group id.
+	Validity             map[int64]*Validity `protobuf:"bytes,2,rep,name=validity,proto3" json:"validity,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *ListUserGroupsResponse) Reset()         { *m = ListUserGroupsResponse{} }
 func (m *ListUserGroupsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListUserGroupsResponse) ProtoMessage()    {}
 func (*ListUserGroupsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_user_ddd072e15a450679, []int{15}
+	return fileDescriptor_user_ed810dfda6844d7d, []int{20}
 }
 func (m *ListUserGroupsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListUserGroupsResponse.Unmarshal(m, b)
@@ -941,19 +1328,29 @@ func (m *ListUserGroupsResponse) GetGroups() []*Group {
 	return nil
 }
 
+func (m *ListUserGroupsResponse) GetValidity() map[int64]*Validity {
+	if m != nil {
+		return m.Validity
+	}
+	return nil
+}
+
 type SetUserGroupsRequest struct {
-	UserId               int64    `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Groups               []int64  `protobuf:"varint,2,rep,packed,name=groups,proto3" json:"groups,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	UserId int64   `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Groups []int64 `protobuf:"varint,2,rep,packed,name=groups,proto3" json:"groups,omitempty"`
+	// Validity makes memberships of given groups time-bound.
+	// Groups missing from the map are joined permanently.
+	Validity             map[int64]*Validity `protobuf:"bytes,3,rep,name=validity,proto3" json:"validity,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *SetUserGroupsRequest) Reset()         { *m = SetUserGroupsRequest{} }
 func (m *SetUserGroupsRequest) String() string { return proto.CompactTextString(m) }
 func (*SetUserGroupsRequest) ProtoMessage()    {}
 func (*SetUserGroupsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_user_ddd072e15a450679, []int{16}
+	return fileDescriptor_user_ed810dfda6844d7d, []int{21}
 }
 func (m *SetUserGroupsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetUserGroupsRequest.Unmarshal(m, b)
@@ -987,6 +1384,13 @@ func (m *SetUserGroupsRequest) GetGroups() []int64 {
 	return nil
 }
 
+func (m *SetUserGroupsRequest) GetValidity() map[int64]*Validity {
+	if m != nil {
+		return m.Validity
+	}
+	return nil
+}
+
 type SetUserGroupsResponse struct {
 	Created              int64    `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
 	Removed              int64    `protobuf:"varint,2,opt,name=removed,proto3" json:"removed,omitempty"`
@@ -1000,7 +1404,7 @@ func (m *SetUserGroupsResponse) Reset()         { *m = SetUserGroupsResponse{} }
 func (m *SetUserGroupsResponse) String() string { return proto.CompactTextString(m) }
 func (*SetUserGroupsResponse) ProtoMessage()    {}
 func (*SetUserGroupsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_user_ddd072e15a450679, []int{17}
+	return fileDescriptor_user_ed810dfda6844d7d, []int{22}
 }
 func (m *SetUserGroupsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetUserGroupsResponse.Unmarshal(m, b)
@@ -1041,134 +1445,827 @@ func (m *SetUserGroupsResponse) GetUntouched() int64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*User)(nil), "charon.rpc.charond.v1.User")
-	proto.RegisterType((*CreateUserRequest)(nil), "charon.rpc.charond.v1.CreateUserRequest")
-	proto.RegisterType((*CreateUserResponse)(nil), "charon.rpc.charond.v1.CreateUserResponse")
+type GenerateTOTPSecretRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GenerateTOTPSecretRequest) Reset()         { *m = GenerateTOTPSecretRequest{} }
+func (m *GenerateTOTPSecretRequest) String() string { return proto.CompactTextString(m) }
+func (*GenerateTOTPSecretRequest) ProtoMessage()    {}
+func (*GenerateTOTPSecretRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_user_ed810dfda6844d7d, []int{23}
+}
+func (m *GenerateTOTPSecretRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GenerateTOTPSecretRequest.Unmarshal(m, b)
+}
+func (m *GenerateTOTPSecretRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GenerateTOTPSecretRequest.Marshal(b, m, deterministic)
+}
+func (dst *GenerateTOTPSecretRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenerateTOTPSecretRequest.Merge(dst, src)
+}
+func (m *GenerateTOTPSecretRequest) XXX_Size() int {
+	return xxx_messageInfo_GenerateTOTPSecretRequest.Size(m)
+}
+func (m *GenerateTOTPSecretRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenerateTOTPSecretRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenerateTOTPSecretRequest proto.InternalMessageInfo
+
+type GenerateTOTPSecretResponse struct {
+	Secret               string   `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	Uri                  string   `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GenerateTOTPSecretResponse) Reset()         { *m = GenerateTOTPSecretResponse{} }
+func (m *GenerateTOTPSecretResponse) String() string { return proto.CompactTextString(m) }
+func (*GenerateTOTPSecretResponse) ProtoMessage()    {}
+func (*GenerateTOTPSecretResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_user_ed810dfda6844d7d, []int{24}
+}
+func (m *GenerateTOTPSecretResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GenerateTOTPSecretResponse.Unmarshal(m, b)
+}
+func (m *GenerateTOTPSecretResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GenerateTOTPSecretResponse.Marshal(b, m, deterministic)
+}
+func (dst *GenerateTOTPSecretResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenerateTOTPSecretResponse.Merge(dst, src)
+}
+func (m *GenerateTOTPSecretResponse) XXX_Size() int {
+	return xxx_messageInfo_GenerateTOTPSecretResponse.Size(m)
+}
+func (m *GenerateTOTPSecretResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenerateTOTPSecretResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenerateTOTPSecretResponse proto.InternalMessageInfo
+
+func (m *GenerateTOTPSecretResponse) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+func (m *GenerateTOTPSecretResponse) GetUri() string {
+	if m != nil {
+		return m.Uri
+	}
+	return ""
+}
+
+type ConfirmTOTPRequest struct {
+	Code                 string   `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConfirmTOTPRequest) Reset()         { *m = ConfirmTOTPRequest{} }
+func (m *ConfirmTOTPRequest) String() string { return proto.CompactTextString(m) }
+func (*ConfirmTOTPRequest) ProtoMessage()    {}
+func (*ConfirmTOTPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_user_ed810dfda6844d7d, []int{25}
+}
+func (m *ConfirmTOTPRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfirmTOTPRequest.Unmarshal(m, b)
+}
+func (m *ConfirmTOTPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfirmTOTPRequest.Marshal(b, m, deterministic)
+}
+func (dst *ConfirmTOTPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmTOTPRequest.Merge(dst, src)
+}
+func (m *ConfirmTOTPRequest) XXX_Size() int {
+	return xxx_messageInfo_ConfirmTOTPRequest.Size(m)
+}
+func (m *ConfirmTOTPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmTOTPRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfirmTOTPRequest proto.InternalMessageInfo
+
+func (m *ConfirmTOTPRequest) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+type ConfirmTOTPResponse struct {
+	RecoveryCodes        []string `protobuf:"bytes,1,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConfirmTOTPResponse) Reset()         { *m = ConfirmTOTPResponse{} }
+func (m *ConfirmTOTPResponse) String() string { return proto.CompactTextString(m) }
+func (*ConfirmTOTPResponse) ProtoMessage()    {}
+func (*ConfirmTOTPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_user_ed810dfda6844d7d, []int{26}
+}
+func (m *ConfirmTOTPResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfirmTOTPResponse.Unmarshal(m, b)
+}
+func (m *ConfirmTOTPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfirmTOTPResponse.Marshal(b, m, deterministic)
+}
+func (dst *ConfirmTOTPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmTOTPResponse.Merge(dst, src)
+}
+func (m *ConfirmTOTPResponse) XXX_Size() int {
+	return xxx_messageInfo_ConfirmTOTPResponse.Size(m)
+}
+func (m *ConfirmTOTPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmTOTPResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfirmTOTPResponse proto.InternalMessageInfo
+
+func (m *ConfirmTOTPResponse) GetRecoveryCodes() []string {
+	if m != nil {
+		return m.RecoveryCodes
+	}
+	return nil
+}
+
+type DisableTOTPRequest struct {
+	// user_id, if not set, points to the current user.
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// code is required if two-factor authentication is disabled by the user itself.
+	Code                 string   `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DisableTOTPRequest) Reset()         { *m = DisableTOTPRequest{} }
+func (m *DisableTOTPRequest) String() string { return proto.CompactTextString(m) }
+func (*DisableTOTPRequest) ProtoMessage()    {}
+func (*DisableTOTPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_user_ed810dfda6844d7d, []int{27}
+}
+func (m *DisableTOTPRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisableTOTPRequest.Unmarshal(m, b)
+}
+func (m *DisableTOTPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisableTOTPRequest.Marshal(b, m, deterministic)
+}
+func (dst *DisableTOTPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisableTOTPRequest.Merge(dst, src)
+}
+func (m *DisableTOTPRequest) XXX_Size() int {
+	return xxx_messageInfo_DisableTOTPRequest.Size(m)
+}
+func (m *DisableTOTPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisableTOTPRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisableTOTPRequest proto.InternalMessageInfo
+
+func (m *DisableTOTPRequest) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *DisableTOTPRequest) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+type GenerateRecoveryCodesRequest struct {
+	Code                 string   `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GenerateRecoveryCodesRequest) Reset()         { *m = GenerateRecoveryCodesRequest{} }
+func (m *GenerateRecoveryCodesRequest) String() string { return proto.CompactTextString(m) }
+func (*GenerateRecoveryCodesRequest) ProtoMessage()    {}
+func (*GenerateRecoveryCodesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_user_ed810dfda6844d7d, []int{28}
+}
+func (m *GenerateRecoveryCodesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GenerateRecoveryCodesRequest.Unmarshal(m, b)
+}
+func (m *GenerateRecoveryCodesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GenerateRecoveryCodesRequest.Marshal(b, m, deterministic)
+}
+func (dst *GenerateRecoveryCodesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenerateRecoveryCodesRequest.Merge(dst, src)
+}
+func (m *GenerateRecoveryCodesRequest) XXX_Size() int {
+	return xxx_messageInfo_GenerateRecoveryCodesRequest.Size(m)
+}
+func (m *GenerateRecoveryCodesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenerateRecoveryCodesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenerateRecoveryCodesRequest proto.InternalMessageInfo
+
+func (m *GenerateRecoveryCodesRequest) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+type GenerateRecoveryCodesResponse struct {
+	RecoveryCodes        []string `protobuf:"bytes,1,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GenerateRecoveryCodesResponse) Reset()         { *m = GenerateRecoveryCodesResponse{} }
+func (m *GenerateRecoveryCodesResponse) String() string { return proto.CompactTextString(m) }
+func (*GenerateRecoveryCodesResponse) ProtoMessage()    {}
+func (*GenerateRecoveryCodesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_user_ed810dfda6844d7d, []int{29}
+}
+func (m *GenerateRecoveryCodesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GenerateRecoveryCodesResponse.Unmarshal(m, b)
+}
+func (m *GenerateRecoveryCodesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GenerateRecoveryCodesResponse.Marshal(b, m, deterministic)
+}
+func (dst *GenerateRecoveryCodesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenerateRecoveryCodesResponse.Merge(dst, src)
+}
+func (m *GenerateRecoveryCodesResponse) XXX_Size() int {
+	return xxx_messageInfo_GenerateRecoveryCodesResponse.Size(m)
+}
+func (m *GenerateRecoveryCodesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenerateRecoveryCodesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenerateRecoveryCodesResponse proto.InternalMessageInfo
+
+func (m *GenerateRecoveryCodesResponse) GetRecoveryCodes() []string {
+	if m != nil {
+		return m.RecoveryCodes
+	}
+	return nil
+}
+
+type ChangePasswordRequest struct {
+	CurrentPassword      string   `protobuf:"bytes,1,opt,name=current_password,json=currentPassword,proto3" json:"current_password,omitempty"`
+	NewPassword          string   `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChangePasswordRequest) Reset()         { *m = ChangePasswordRequest{} }
+func (m *ChangePasswordRequest) String() string { return proto.CompactTextString(m) }
+func (*ChangePasswordRequest) ProtoMessage()    {}
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_user_ed810dfda6844d7d, []int{30}
+}
+func (m *ChangePasswordRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangePasswordRequest.Unmarshal(m, b)
+}
+func (m *ChangePasswordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangePasswordRequest.Marshal(b, m, deterministic)
+}
+func (dst *ChangePasswordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangePasswordRequest.Merge(dst, src)
+}
+func (m *ChangePasswordRequest) XXX_Size() int {
+	return xxx_messageInfo_ChangePasswordRequest.Size(m)
+}
+func (m *ChangePasswordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangePasswordRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangePasswordRequest proto.InternalMessageInfo
+
+func (m *ChangePasswordRequest) GetCurrentPassword() string {
+	if m != nil {
+		return m.CurrentPassword
+	}
+	return ""
+}
+
+func (m *ChangePasswordRequest) GetNewPassword() string {
+	if m != nil {
+		return m.NewPassword
+	}
+	return ""
+}
+
+type RequestPasswordResetRequest struct {
+	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RequestPasswordResetRequest) Reset()         { *m = RequestPasswordResetRequest{} }
+func (m *RequestPasswordResetRequest) String() string { return proto.CompactTextString(m) }
+func (*RequestPasswordResetRequest) ProtoMessage()    {}
+func (*RequestPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_user_ed810dfda6844d7d, []int{31}
+}
+func (m *RequestPasswordResetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestPasswordResetRequest.Unmarshal(m, b)
+}
+func (m *RequestPasswordResetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestPasswordResetRequest.Marshal(b, m, deterministic)
+}
+func (dst *RequestPasswordResetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestPasswordResetRequest.Merge(dst, src)
+}
+func (m *RequestPasswordResetRequest) XXX_Size() int {
+	return xxx_messageInfo_RequestPasswordResetRequest.Size(m)
+}
+func (m *RequestPasswordResetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestPasswordResetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestPasswordResetRequest proto.InternalMessageInfo
+
+func (m *RequestPasswordResetRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+type ResetPasswordRequest struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	NewPassword          string   `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResetPasswordRequest) Reset()         { *m = ResetPasswordRequest{} }
+func (m *ResetPasswordRequest) String() string { return proto.CompactTextString(m) }
+func (*ResetPasswordRequest) ProtoMessage()    {}
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_user_ed810dfda6844d7d, []int{32}
+}
+func (m *ResetPasswordRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResetPasswordRequest.Unmarshal(m, b)
+}
+func (m *ResetPasswordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResetPasswordRequest.Marshal(b, m, deterministic)
+}
+func (dst *ResetPasswordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetPasswordRequest.Merge(dst, src)
+}
+func (m *ResetPasswordRequest) XXX_Size() int {
+	return xxx_messageInfo_ResetPasswordRequest.Size(m)
+}
+func (m *ResetPasswordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetPasswordRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetPasswordRequest proto.InternalMessageInfo
+
+func (m *ResetPasswordRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *ResetPasswordRequest) GetNewPassword() string {
+	if m != nil {
+		return m.NewPassword
+	}
+	return ""
+}
+
+type RegisterUserRequest struct {
+	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	PlainPassword        string   `protobuf:"bytes,2,opt,name=plain_password,json=plainPassword,proto3" json:"plain_password,omitempty"`
+	FirstName            string   `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName             string   `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegisterUserRequest) Reset()         { *m = RegisterUserRequest{} }
+func (m *RegisterUserRequest) String() string { return proto.CompactTextString(m) }
+func (*RegisterUserRequest) ProtoMessage()    {}
+func (*RegisterUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_user_ed810dfda6844d7d, []int{33}
+}
+func (m *RegisterUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterUserRequest.Unmarshal(m, b)
+}
+func (m *RegisterUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterUserRequest.Marshal(b, m, deterministic)
+}
+func (dst *RegisterUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterUserRequest.Merge(dst, src)
+}
+func (m *RegisterUserRequest) XXX_Size() int {
+	return xxx_messageInfo_RegisterUserRequest.Size(m)
+}
+func (m *RegisterUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterUserRequest proto.InternalMessageInfo
+
+func (m *RegisterUserRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *RegisterUserRequest) GetPlainPassword() string {
+	if m != nil {
+		return m.PlainPassword
+	}
+	return ""
+}
+
+func (m *RegisterUserRequest) GetFirstName() string {
+	if m != nil {
+		return m.FirstName
+	}
+	return ""
+}
+
+func (m *RegisterUserRequest) GetLastName() string {
+	if m != nil {
+		return m.LastName
+	}
+	return ""
+}
+
+type RegisterUserResponse struct {
+	User                 *User    `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegisterUserResponse) Reset()         { *m = RegisterUserResponse{} }
+func (m *RegisterUserResponse) String() string { return proto.CompactTextString(m) }
+func (*RegisterUserResponse) ProtoMessage()    {}
+func (*RegisterUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_user_ed810dfda6844d7d, []int{34}
+}
+func (m *RegisterUserResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterUserResponse.Unmarshal(m, b)
+}
+func (m *RegisterUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterUserResponse.Marshal(b, m, deterministic)
+}
+func (dst *RegisterUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterUserResponse.Merge(dst, src)
+}
+func (m *RegisterUserResponse) XXX_Size() int {
+	return xxx_messageInfo_RegisterUserResponse.Size(m)
+}
+func (m *RegisterUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterUserResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterUserResponse proto.InternalMessageInfo
+
+func (m *RegisterUserResponse) GetUser() *User {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+type ConfirmUserRequest struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConfirmUserRequest) Reset()         { *m = ConfirmUserRequest{} }
+func (m *ConfirmUserRequest) String() string { return proto.CompactTextString(m) }
+func (*ConfirmUserRequest) ProtoMessage()    {}
+func (*ConfirmUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_user_ed810dfda6844d7d, []int{35}
+}
+func (m *ConfirmUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfirmUserRequest.Unmarshal(m, b)
+}
+func (m *ConfirmUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfirmUserRequest.Marshal(b, m, deterministic)
+}
+func (dst *ConfirmUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmUserRequest.Merge(dst, src)
+}
+func (m *ConfirmUserRequest) XXX_Size() int {
+	return xxx_messageInfo_ConfirmUserRequest.Size(m)
+}
+func (m *ConfirmUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfirmUserRequest proto.InternalMessageInfo
+
+func (m *ConfirmUserRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*User)(nil), "charon.rpc.charond.v1.User")
+	proto.RegisterType((*CreateUserRequest)(nil), "charon.rpc.charond.v1.CreateUserRequest")
+	proto.RegisterType((*CreateUserResponse)(nil), "charon.rpc.charond.v1.CreateUserResponse")
 	proto.RegisterType((*GetUserRequest)(nil), "charon.rpc.charond.v1.GetUserRequest")
 	proto.RegisterType((*GetUserResponse)(nil), "charon.rpc.charond.v1.GetUserResponse")
 	proto.RegisterType((*ListUsersRequest)(nil), "charon.rpc.charond.v1.ListUsersRequest")
 	proto.RegisterMapType((map[string]bool)(nil), "charon.rpc.charond.v1.ListUsersRequest.SortEntry")
 	proto.RegisterType((*ListUsersResponse)(nil), "charon.rpc.charond.v1.ListUsersResponse")
 	proto.RegisterType((*DeleteUserRequest)(nil), "charon.rpc.charond.v1.DeleteUserRequest")
+	proto.RegisterType((*RestoreUserRequest)(nil), "charon.rpc.charond.v1.RestoreUserRequest")
+	proto.RegisterType((*RestoreUserResponse)(nil), "charon.rpc.charond.v1.RestoreUserResponse")
+	proto.RegisterType((*UnlockUserRequest)(nil), "charon.rpc.charond.v1.UnlockUserRequest")
 	proto.RegisterType((*ModifyUserRequest)(nil), "charon.rpc.charond.v1.ModifyUserRequest")
 	proto.RegisterType((*ModifyUserResponse)(nil), "charon.rpc.charond.v1.ModifyUserResponse")
 	proto.RegisterType((*ListUserPermissionsRequest)(nil), "charon.rpc.charond.v1.ListUserPermissionsRequest")
 	proto.RegisterType((*ListUserPermissionsResponse)(nil), "charon.rpc.charond.v1.ListUserPermissionsResponse")
+	proto.RegisterMapType((map[string]*Validity)(nil), "charon.rpc.charond.v1.ListUserPermissionsResponse.ValidityEntry")
 	proto.RegisterType((*SetUserPermissionsRequest)(nil), "charon.rpc.charond.v1.SetUserPermissionsRequest")
+	proto.RegisterMapType((map[string]*Validity)(nil), "charon.rpc.charond.v1.SetUserPermissionsRequest.ValidityEntry")
 	proto.RegisterType((*SetUserPermissionsResponse)(nil), "charon.rpc.charond.v1.SetUserPermissionsResponse")
+	proto.RegisterType((*SetUserResourcePermissionsRequest)(nil), "charon.rpc.charond.v1.SetUserResourcePermissionsRequest")
+	proto.RegisterType((*SetUserResourcePermissionsResponse)(nil), "charon.rpc.charond.v1.SetUserResourcePermissionsResponse")
 	proto.RegisterType((*ListUserGroupsRequest)(nil), "charon.rpc.charond.v1.ListUserGroupsRequest")
 	proto.RegisterType((*ListUserGroupsResponse)(nil), "charon.rpc.charond.v1.ListUserGroupsResponse")
+	proto.RegisterMapType((map[int64]*Validity)(nil), "charon.rpc.charond.v1.ListUserGroupsResponse.ValidityEntry")
 	proto.RegisterType((*SetUserGroupsRequest)(nil), "charon.rpc.charond.v1.SetUserGroupsRequest")
+	proto.RegisterMapType((map[int64]*Validity)(nil), "charon.rpc.charond.v1.SetUserGroupsRequest.ValidityEntry")
 	proto.RegisterType((*SetUserGroupsResponse)(nil), "charon.rpc.charond.v1.SetUserGroupsResponse")
+	proto.RegisterType((*GenerateTOTPSecretRequest)(nil), "charon.rpc.charond.v1.GenerateTOTPSecretRequest")
+	proto.RegisterType((*GenerateTOTPSecretResponse)(nil), "charon.rpc.charond.v1.GenerateTOTPSecretResponse")
+	proto.RegisterType((*ConfirmTOTPRequest)(nil), "charon.rpc.charond.v1.ConfirmTOTPRequest")
+	proto.RegisterType((*ConfirmTOTPResponse)(nil), "charon.rpc.charond.v1.ConfirmTOTPResponse")
+	proto.RegisterType((*DisableTOTPRequest)(nil), "charon.rpc.charond.v1.DisableTOTPRequest")
+	proto.RegisterType((*GenerateRecoveryCodesRequest)(nil), "charon.rpc.charond.v1.GenerateRecoveryCodesRequest")
+	proto.RegisterType((*GenerateRecoveryCodesResponse)(nil), "charon.rpc.charond.v1.GenerateRecoveryCodesResponse")
+	proto.RegisterType((*ChangePasswordRequest)(nil), "charon.rpc.charond.v1.ChangePasswordRequest")
+	proto.RegisterType((*RequestPasswordResetRequest)(nil), "charon.rpc.charond.v1.RequestPasswordResetRequest")
+	proto.RegisterType((*ResetPasswordRequest)(nil), "charon.rpc.charond.v1.ResetPasswordRequest")
+	proto.RegisterType((*RegisterUserRequest)(nil), "charon.rpc.charond.v1.RegisterUserRequest")
+	proto.RegisterType((*RegisterUserResponse)(nil), "charon.rpc.charond.v1.RegisterUserResponse")
+	proto.RegisterType((*ConfirmUserRequest)(nil), "charon.rpc.charond.v1.ConfirmUserRequest")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
 var _ grpc.ClientConn
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// UserManagerClient is the client API for UserManager service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type UserManagerClient interface {
+	Create(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error)
+	Modify(ctx context.Context, in *ModifyUserRequest, opts ...grpc.CallOption) (*ModifyUserResponse, error)
+	Get(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
+	List(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	Delete(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*wrappers.BoolValue, error)
+	Unlock(ctx context.Context, in *UnlockUserRequest, opts ...grpc.CallOption) (*wrappers.BoolValue, error)
+	// Restore brings back a soft deleted user, it is possible only until the user is purged.
+	Restore(ctx context.Context, in *RestoreUserRequest, opts ...grpc.CallOption) (*RestoreUserResponse, error)
+	ListPermissions(ctx context.Context, in *ListUserPermissionsRequest, opts ...grpc.CallOption) (*ListUserPermissionsResponse, error)
+	SetPermissions(ctx context.Context, in *SetUserPermissionsRequest, opts ...grpc.CallOption) (*SetUserPermissionsResponse, error)
+	// SetResourcePermissions sets permissions the user is granted on a single resource.
+	SetResourcePermissions(ctx context.Context, in *SetUserResourcePermissionsRequest, opts ...grpc.CallOption) (*SetUserResourcePermissionsResponse, error)
+	ListGroups(ctx context.Context, in *ListUserGroupsRequest, opts ...grpc.CallOption) (*ListUserGroupsResponse, error)
+	SetGroups(ctx context.Context, in *SetUserGroupsRequest, opts ...grpc.CallOption) (*SetUserGroupsResponse, error)
+	GenerateTOTPSecret(ctx context.Context, in *GenerateTOTPSecretRequest, opts ...grpc.CallOption) (*GenerateTOTPSecretResponse, error)
+	ConfirmTOTP(ctx context.Context, in *ConfirmTOTPRequest, opts ...grpc.CallOption) (*ConfirmTOTPResponse, error)
+	DisableTOTP(ctx context.Context, in *DisableTOTPRequest, opts ...grpc.CallOption) (*wrappers.BoolValue, error)
+	GenerateRecoveryCodes(ctx context.Context, in *GenerateRecoveryCodesRequest, opts ...grpc.CallOption) (*GenerateRecoveryCodesResponse, error)
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*wrappers.BoolValue, error)
+	// RequestPasswordReset responds the same way whether or not such user exists,
+	// the token is delivered to the user by the configured notifier.
+	RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*wrappers.BoolValue, error)
+	// Register is available without authentication, if enabled.
+	// Created user is neither confirmed nor active until Confirm is called
+	// with the token delivered to the user by the configured notifier.
+	Register(ctx context.Context, in *RegisterUserRequest, opts ...grpc.CallOption) (*RegisterUserResponse, error)
+	Confirm(ctx context.Context, in *ConfirmUserRequest, opts ...grpc.CallOption) (*wrappers.BoolValue, error)
+}
+
+type userManagerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewUserManagerClient(cc *grpc.ClientConn) UserManagerClient {
+	return &userManagerClient{cc}
+}
+
+func (c *userManagerClient) Create(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error) {
+	out := new(CreateUserResponse)
+	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.UserManager/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagerClient) Modify(ctx context.Context, in *ModifyUserRequest, opts ...grpc.CallOption) (*ModifyUserResponse, error) {
+	out := new(ModifyUserResponse)
+	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.UserManager/Modify", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagerClient) Get(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error) {
+	out := new(GetUserResponse)
+	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.UserManager/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagerClient) List(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error) {
+	out := new(ListUsersResponse)
+	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.UserManager/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagerClient) Delete(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*wrappers.BoolValue, error) {
+	out := new(wrappers.BoolValue)
+	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.UserManager/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagerClient) Unlock(ctx context.Context, in *UnlockUserRequest, opts ...grpc.CallOption) (*wrappers.BoolValue, error) {
+	out := new(wrappers.BoolValue)
+	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.UserManager/Unlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagerClient) Restore(ctx context.Context, in *RestoreUserRequest, opts ...grpc.CallOption) (*RestoreUserResponse, error) {
+	out := new(RestoreUserResponse)
+	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.UserManager/Restore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagerClient) ListPermissions(ctx context.Context, in *ListUserPermissionsRequest, opts ...grpc.CallOption) (*ListUserPermissionsResponse, error) {
+	out := new(ListUserPermissionsResponse)
+	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.UserManager/ListPermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagerClient) SetPermissions(ctx context.Context, in *SetUserPermissionsRequest, opts ...grpc.CallOption) (*SetUserPermissionsResponse, error) {
+	out := new(SetUserPermissionsResponse)
+	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.UserManager/SetPermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-// UserManagerClient is the client API for UserManager service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type UserManagerClient interface {
-	Create(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error)
-	Modify(ctx context.Context, in *ModifyUserRequest, opts ...grpc.CallOption) (*ModifyUserResponse, error)
-	Get(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
-	List(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
-	Delete(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*wrappers.BoolValue, error)
-	ListPermissions(ctx context.Context, in *ListUserPermissionsRequest, opts ...grpc.CallOption) (*ListUserPermissionsResponse, error)
-	SetPermissions(ctx context.Context, in *SetUserPermissionsRequest, opts ...grpc.CallOption) (*SetUserPermissionsResponse, error)
-	ListGroups(ctx context.Context, in *ListUserGroupsRequest, opts ...grpc.CallOption) (*ListUserGroupsResponse, error)
-	SetGroups(ctx context.Context, in *SetUserGroupsRequest, opts ...grpc.CallOption) (*SetUserGroupsResponse, error)
+func (c *userManagerClient) SetResourcePermissions(ctx context.Context, in *SetUserResourcePermissionsRequest, opts ...grpc.CallOption) (*SetUserResourcePermissionsResponse, error) {
+	out := new(SetUserResourcePermissionsResponse)
+	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.UserManager/SetResourcePermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type userManagerClient struct {
-	cc *grpc.ClientConn
+func (c *userManagerClient) ListGroups(ctx context.Context, in *ListUserGroupsRequest, opts ...grpc.CallOption) (*ListUserGroupsResponse, error) {
+	out := new(ListUserGroupsResponse)
+	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.UserManager/ListGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func NewUserManagerClient(cc *grpc.ClientConn) UserManagerClient {
-	return &userManagerClient{cc}
+func (c *userManagerClient) SetGroups(ctx context.Context, in *SetUserGroupsRequest, opts ...grpc.CallOption) (*SetUserGroupsResponse, error) {
+	out := new(SetUserGroupsResponse)
+	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.UserManager/SetGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (c *userManagerClient) Create(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error) {
-	out := new(CreateUserResponse)
-	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.UserManager/Create", in, out, opts...)
+func (c *userManagerClient) GenerateTOTPSecret(ctx context.Context, in *GenerateTOTPSecretRequest, opts ...grpc.CallOption) (*GenerateTOTPSecretResponse, error) {
+	out := new(GenerateTOTPSecretResponse)
+	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.UserManager/GenerateTOTPSecret", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *userManagerClient) Modify(ctx context.Context, in *ModifyUserRequest, opts ...grpc.CallOption) (*ModifyUserResponse, error) {
-	out := new(ModifyUserResponse)
-	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.UserManager/Modify", in, out, opts...)
+func (c *userManagerClient) ConfirmTOTP(ctx context.Context, in *ConfirmTOTPRequest, opts ...grpc.CallOption) (*ConfirmTOTPResponse, error) {
+	out := new(ConfirmTOTPResponse)
+	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.UserManager/ConfirmTOTP", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *userManagerClient) Get(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error) {
-	out := new(GetUserResponse)
-	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.UserManager/Get", in, out, opts...)
+func (c *userManagerClient) DisableTOTP(ctx context.Context, in *DisableTOTPRequest, opts ...grpc.CallOption) (*wrappers.BoolValue, error) {
+	out := new(wrappers.BoolValue)
+	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.UserManager/DisableTOTP", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *userManagerClient) List(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error) {
-	out := new(ListUsersResponse)
-	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.UserManager/List", in, out, opts...)
+func (c *userManagerClient) GenerateRecoveryCodes(ctx context.Context, in *GenerateRecoveryCodesRequest, opts ...grpc.CallOption) (*GenerateRecoveryCodesResponse, error) {
+	out := new(GenerateRecoveryCodesResponse)
+	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.UserManager/GenerateRecoveryCodes", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *userManagerClient) Delete(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*wrappers.BoolValue, error) {
+func (c *userManagerClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*wrappers.BoolValue, error) {
 	out := new(wrappers.BoolValue)
-	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.UserManager/Delete", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.UserManager/ChangePassword", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *userManagerClient) ListPermissions(ctx context.Context, in *ListUserPermissionsRequest, opts ...grpc.CallOption) (*ListUserPermissionsResponse, error) {
-	out := new(ListUserPermissionsResponse)
-	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.UserManager/ListPermissions", in, out, opts...)
+func (c *userManagerClient) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.UserManager/RequestPasswordReset", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *userManagerClient) SetPermissions(ctx context.Context, in *SetUserPermissionsRequest, opts ...grpc.CallOption) (*SetUserPermissionsResponse, error) {
-	out := new(SetUserPermissionsResponse)
-	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.UserManager/SetPermissions", in, out, opts...)
+func (c *userManagerClient) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*wrappers.BoolValue, error) {
+	out := new(wrappers.BoolValue)
+	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.UserManager/ResetPassword", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *userManagerClient) ListGroups(ctx context.Context, in *ListUserGroupsRequest, opts ...grpc.CallOption) (*ListUserGroupsResponse, error) {
-	out := new(ListUserGroupsResponse)
-	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.UserManager/ListGroups", in, out, opts...)
+func (c *userManagerClient) Register(ctx context.Context, in *RegisterUserRequest, opts ...grpc.CallOption) (*RegisterUserResponse, error) {
+	out := new(RegisterUserResponse)
+	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.UserManager/Register", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *userManagerClient) SetGroups(ctx context.Context, in *SetUserGroupsRequest, opts ...grpc.CallOption) (*SetUserGroupsResponse, error) {
-	out := new(SetUserGroupsResponse)
-	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.UserManager/SetGroups", in, out, opts...)
+func (c *userManagerClient) Confirm(ctx context.Context, in *ConfirmUserRequest, opts ...grpc.CallOption) (*wrappers.BoolValue, error) {
+	out := new(wrappers.BoolValue)
+	err := c.cc.Invoke(ctx, "/charon.rpc.charond.v1.UserManager/Confirm", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1182,10 +2279,29 @@ type UserManagerServer interface {
 	Get(context.Context, *GetUserRequest) (*GetUserResponse, error)
 	List(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
 	Delete(context.Context, *DeleteUserRequest) (*wrappers.BoolValue, error)
+	Unlock(context.Context, *UnlockUserRequest) (*wrappers.BoolValue, error)
+	// Restore brings back a soft deleted user, it is possible only until the user is purged.
+	Restore(context.Context, *RestoreUserRequest) (*RestoreUserResponse, error)
 	ListPermissions(context.Context, *ListUserPermissionsRequest) (*ListUserPermissionsResponse, error)
 	SetPermissions(context.Context, *SetUserPermissionsRequest) (*SetUserPermissionsResponse, error)
+	// SetResourcePermissions sets permissions the user is granted on a single resource.
+	SetResourcePermissions(context.Context, *SetUserResourcePermissionsRequest) (*SetUserResourcePermissionsResponse, error)
 	ListGroups(context.Context, *ListUserGroupsRequest) (*ListUserGroupsResponse, error)
 	SetGroups(context.Context, *SetUserGroupsRequest) (*SetUserGroupsResponse, error)
+	GenerateTOTPSecret(context.Context, *GenerateTOTPSecretRequest) (*GenerateTOTPSecretResponse, error)
+	ConfirmTOTP(context.Context, *ConfirmTOTPRequest) (*ConfirmTOTPResponse, error)
+	DisableTOTP(context.Context, *DisableTOTPRequest) (*wrappers.BoolValue, error)
+	GenerateRecoveryCodes(context.Context, *GenerateRecoveryCodesRequest) (*GenerateRecoveryCodesResponse, error)
+	ChangePassword(context.Context, *ChangePasswordRequest) (*wrappers.BoolValue, error)
+	// RequestPasswordReset responds the same way whether or not such user exists,
+	// the token is delivered to the user by the configured notifier.
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*empty.Empty, error)
+	ResetPassword(context.Context, *ResetPasswordRequest) (*wrappers.BoolValue, error)
+	// Register is available without authentication, if enabled.
+	// Created user is neither confirmed nor active until Confirm is called
+	// with the token delivered to the user by the configured notifier.
+	Register(context.Context, *RegisterUserRequest) (*RegisterUserResponse, error)
+	Confirm(context.Context, *ConfirmUserRequest) (*wrappers.BoolValue, error)
 }
 
 func RegisterUserManagerServer(s *grpc.Server, srv UserManagerServer) {
@@ -1282,6 +2398,42 @@ func _UserManager_Delete_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserManager_Unlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagerServer).Unlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/charon.rpc.charond.v1.UserManager/Unlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagerServer).Unlock(ctx, req.(*UnlockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserManager_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagerServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/charon.rpc.charond.v1.UserManager/Restore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagerServer).Restore(ctx, req.(*RestoreUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserManager_ListPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListUserPermissionsRequest)
 	if err := dec(in); err != nil {
@@ -1318,6 +2470,24 @@ func _UserManager_SetPermissions_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserManager_SetResourcePermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserResourcePermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagerServer).SetResourcePermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/charon.rpc.charond.v1.UserManager/SetResourcePermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagerServer).SetResourcePermissions(ctx, req.(*SetUserResourcePermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserManager_ListGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListUserGroupsRequest)
 	if err := dec(in); err != nil {
@@ -1354,6 +2524,168 @@ func _UserManager_SetGroups_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserManager_GenerateTOTPSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateTOTPSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagerServer).GenerateTOTPSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/charon.rpc.charond.v1.UserManager/GenerateTOTPSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagerServer).GenerateTOTPSecret(ctx, req.(*GenerateTOTPSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserManager_ConfirmTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagerServer).ConfirmTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/charon.rpc.charond.v1.UserManager/ConfirmTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagerServer).ConfirmTOTP(ctx, req.(*ConfirmTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserManager_DisableTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagerServer).DisableTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/charon.rpc.charond.v1.UserManager/DisableTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagerServer).DisableTOTP(ctx, req.(*DisableTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserManager_GenerateRecoveryCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateRecoveryCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagerServer).GenerateRecoveryCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/charon.rpc.charond.v1.UserManager/GenerateRecoveryCodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagerServer).GenerateRecoveryCodes(ctx, req.(*GenerateRecoveryCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserManager_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagerServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/charon.rpc.charond.v1.UserManager/ChangePassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagerServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserManager_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagerServer).RequestPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/charon.rpc.charond.v1.UserManager/RequestPasswordReset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagerServer).RequestPasswordReset(ctx, req.(*RequestPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserManager_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagerServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/charon.rpc.charond.v1.UserManager/ResetPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagerServer).ResetPassword(ctx, req.(*ResetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserManager_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagerServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/charon.rpc.charond.v1.UserManager/Register",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagerServer).Register(ctx, req.(*RegisterUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserManager_Confirm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagerServer).Confirm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/charon.rpc.charond.v1.UserManager/Confirm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagerServer).Confirm(ctx, req.(*ConfirmUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _UserManager_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "charon.rpc.charond.v1.UserManager",
 	HandlerType: (*UserManagerServer)(nil),
@@ -1378,6 +2710,14 @@ var _UserManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _UserManager_Delete_Handler,
 		},
+		{
+			MethodName: "Unlock",
+			Handler:    _UserManager_Unlock_Handler,
+		},
+		{
+			MethodName: "Restore",
+			Handler:    _UserManager_Restore_Handler,
+		},
 		{
 			MethodName: "ListPermissions",
 			Handler:    _UserManager_ListPermissions_Handler,
@@ -1386,6 +2726,10 @@ var _UserManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetPermissions",
 			Handler:    _UserManager_SetPermissions_Handler,
 		},
+		{
+			MethodName: "SetResourcePermissions",
+			Handler:    _UserManager_SetResourcePermissions_Handler,
+		},
 		{
 			MethodName: "ListGroups",
 			Handler:    _UserManager_ListGroups_Handler,
@@ -1394,90 +2738,179 @@ var _UserManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetGroups",
 			Handler:    _UserManager_SetGroups_Handler,
 		},
+		{
+			MethodName: "GenerateTOTPSecret",
+			Handler:    _UserManager_GenerateTOTPSecret_Handler,
+		},
+		{
+			MethodName: "ConfirmTOTP",
+			Handler:    _UserManager_ConfirmTOTP_Handler,
+		},
+		{
+			MethodName: "DisableTOTP",
+			Handler:    _UserManager_DisableTOTP_Handler,
+		},
+		{
+			MethodName: "GenerateRecoveryCodes",
+			Handler:    _UserManager_GenerateRecoveryCodes_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _UserManager_ChangePassword_Handler,
+		},
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _UserManager_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _UserManager_ResetPassword_Handler,
+		},
+		{
+			MethodName: "Register",
+			Handler:    _UserManager_Register_Handler,
+		},
+		{
+			MethodName: "Confirm",
+			Handler:    _UserManager_Confirm_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1/user.proto",
 }
 
 func init() {
-	proto.RegisterFile("github.com/piotrkowalczuk/charon/pb/rpc/charond/v1/user.proto", fileDescriptor_user_ddd072e15a450679)
-}
-
-var fileDescriptor_user_ddd072e15a450679 = []byte{
-	// 1198 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x6d, 0x73, 0xdb, 0x44,
-	0x10, 0xae, 0x2c, 0xbf, 0xae, 0x53, 0x27, 0x39, 0x9a, 0xa2, 0x2a, 0x05, 0x8c, 0x3a, 0x21, 0x2e,
-	0x4d, 0x65, 0x12, 0x0a, 0x85, 0x32, 0xd0, 0xa9, 0x4b, 0xc9, 0x84, 0xa1, 0x25, 0x23, 0x43, 0x3f,
-	0xc0, 0x07, 0x23, 0x4b, 0x67, 0x47, 0x13, 0x5b, 0xa7, 0xdc, 0x49, 0xc9, 0xb8, 0x3f, 0x87, 0x6f,
-	0xfc, 0x09, 0x66, 0xf8, 0x49, 0xfc, 0x03, 0xe6, 0x4e, 0x2f, 0x91, 0x65, 0x29, 0x76, 0x32, 0xc3,
-	0xa7, 0xe4, 0xf6, 0x9e, 0xdd, 0x67, 0x6f, 0x77, 0xef, 0x39, 0x19, 0xbe, 0x1d, 0x3b, 0xfe, 0x49,
-	0x30, 0xd4, 0x2d, 0x32, 0xed, 0x7a, 0x0e, 0xf1, 0xe9, 0x29, 0xb9, 0x30, 0x27, 0xd6, 0xbb, 0xe0,
-	0xb4, 0x6b, 0x9d, 0x98, 0x94, 0xb8, 0x5d, 0x6f, 0xd8, 0xa5, 0x9e, 0x15, 0xad, 0xec, 0xee, 0xf9,
-	0x7e, 0x37, 0x60, 0x98, 0xea, 0x1e, 0x25, 0x3e, 0x41, 0x5b, 0xa1, 0x59, 0xa7, 0x9e, 0xa5, 0x47,
-	0x08, 0xfd, 0x7c, 0x5f, 0xfd, 0x68, 0x4c, 0xc8, 0x78, 0x82, 0xbb, 0x02, 0x34, 0x0c, 0x46, 0x5d,
-	0xdf, 0x99, 0x62, 0xe6, 0x9b, 0x53, 0x2f, 0xf4, 0x53, 0x3f, 0xcc, 0x02, 0x2e, 0xa8, 0xe9, 0x79,
-	0x98, 0xb2, 0x68, 0xff, 0xf9, 0x0d, 0xd2, 0xb2, 0xc8, 0x74, 0x4a, 0xdc, 0x28, 0xc0, 0x77, 0x37,
-	0x08, 0x30, 0xa6, 0x24, 0x88, 0x13, 0x7c, 0xef, 0xcc, 0x9f, 0x79, 0x98, 0x75, 0xc3, 0x3f, 0xb1,
-	0xd1, 0x0d, 0x8d, 0x6e, 0xca, 0xa8, 0xfd, 0x2d, 0x43, 0xf9, 0x57, 0x86, 0x29, 0x6a, 0x41, 0xc9,
-	0xb1, 0x15, 0xa9, 0x2d, 0x75, 0x64, 0xa3, 0xe4, 0xd8, 0x48, 0x85, 0x3a, 0xaf, 0x94, 0x6b, 0x4e,
-	0xb1, 0x52, 0x6a, 0x4b, 0x9d, 0x86, 0x91, 0xac, 0xd1, 0x07, 0x00, 0x23, 0x87, 0x32, 0x7f, 0x20,
-	0x76, 0x65, 0xb1, 0xdb, 0x10, 0x96, 0x37, 0x7c, 0x7b, 0x1b, 0x1a, 0x13, 0x33, 0xde, 0x2d, 0x87,
-	0xbe, 0xdc, 0x20, 0x36, 0x3f, 0x86, 0x35, 0x87, 0x0d, 0x58, 0xe0, 0x61, 0xca, 0xe3, 0x29, 0x95,
-	0xb6, 0xd4, 0xa9, 0x1b, 0x4d, 0x87, 0xf5, 0x63, 0x13, 0xf7, 0x77, 0xd8, 0xc0, 0xb4, 0x7c, 0xe7,
-	0x1c, 0x2b, 0x55, 0xb1, 0x5f, 0x77, 0xd8, 0x0b, 0xb1, 0x46, 0xf7, 0xa0, 0xce, 0xfd, 0x7d, 0x73,
-	0x34, 0x52, 0x6a, 0x62, 0xaf, 0xe6, 0xb0, 0x3e, 0x5f, 0x46, 0xa1, 0x2d, 0xe2, 0x8e, 0x1c, 0x3a,
-	0xc5, 0xb6, 0x52, 0x8f, 0x43, 0xbf, 0x8c, 0x4d, 0xe8, 0x6b, 0x00, 0x8b, 0x62, 0xd3, 0xc7, 0xf6,
-	0xc0, 0xf4, 0x95, 0x46, 0x5b, 0xea, 0x34, 0x0f, 0x54, 0x3d, 0x6c, 0xa7, 0x1e, 0xb7, 0x53, 0xff,
-	0x25, 0xee, 0xb7, 0xd1, 0x88, 0xd0, 0x2f, 0x7c, 0xb4, 0x77, 0xe9, 0x3a, 0x9c, 0x29, 0x20, 0x5c,
-	0x6f, 0xeb, 0x51, 0x31, 0x8f, 0x5c, 0xff, 0xcb, 0x27, 0x09, 0xba, 0x37, 0xe3, 0x44, 0x81, 0x67,
-	0xc7, 0x44, 0xcd, 0xe5, 0x44, 0x11, 0x3a, 0x24, 0x8a, 0x5d, 0x87, 0x33, 0x65, 0x2d, 0x97, 0x28,
-	0x02, 0xf4, 0x66, 0xda, 0xbf, 0x25, 0xd8, 0x7c, 0x29, 0x68, 0x79, 0x1b, 0x0d, 0x7c, 0x16, 0x60,
-	0xe6, 0xcf, 0x75, 0x4f, 0xca, 0x74, 0x6f, 0x07, 0x5a, 0xde, 0xc4, 0x74, 0xdc, 0x81, 0x67, 0x32,
-	0x76, 0x41, 0xa8, 0x1d, 0xf5, 0xf7, 0xb6, 0xb0, 0x1e, 0x47, 0x46, 0xb4, 0x0b, 0xeb, 0x0c, 0x5b,
-	0x01, 0xc5, 0x97, 0x38, 0xde, 0xe9, 0x35, 0xa3, 0x15, 0x9a, 0x13, 0xe0, 0xfc, 0x34, 0x94, 0xaf,
-	0x9c, 0x86, 0x4a, 0x66, 0x1a, 0xba, 0x99, 0x69, 0xa8, 0x8a, 0xd3, 0xae, 0xc5, 0xa7, 0xed, 0x11,
-	0x32, 0x99, 0x9f, 0x8d, 0x87, 0xe9, 0xd9, 0xa8, 0xe5, 0xa0, 0x2f, 0x27, 0x65, 0x37, 0x35, 0x29,
-	0xf5, 0x1c, 0x64, 0x32, 0x37, 0xdd, 0xcc, 0xdc, 0x34, 0xf2, 0x93, 0x48, 0xa6, 0x48, 0x7b, 0x05,
-	0x28, 0x5d, 0x72, 0xe6, 0x11, 0x97, 0xf1, 0xb3, 0x94, 0xc5, 0x19, 0x24, 0xe1, 0xbe, 0xad, 0xe7,
-	0x8a, 0x8b, 0x2e, 0x5c, 0x04, 0x50, 0x6b, 0x43, 0xeb, 0x10, 0xfb, 0xe9, 0xb6, 0x65, 0x2e, 0xa1,
-	0xd6, 0x83, 0xf5, 0x04, 0x71, 0x53, 0x96, 0xbf, 0x64, 0xd8, 0xf8, 0xc9, 0x61, 0x22, 0x0a, 0x8b,
-	0x89, 0xb2, 0x75, 0x97, 0x96, 0xd5, 0x3d, 0x5d, 0xcc, 0xd2, 0x55, 0xc5, 0x9c, 0xbf, 0x26, 0x72,
-	0x34, 0xbd, 0x67, 0x05, 0xd7, 0x64, 0x07, 0xaa, 0x64, 0x34, 0x62, 0xd8, 0x57, 0xec, 0xbc, 0x39,
-	0x8f, 0x36, 0xd1, 0x03, 0xa8, 0x4c, 0x9c, 0xa9, 0xe3, 0x2b, 0x38, 0x0f, 0x15, 0xee, 0xa1, 0x23,
-	0x28, 0x33, 0x42, 0x7d, 0x65, 0xd4, 0x96, 0x3b, 0xcd, 0x83, 0xfd, 0x82, 0xca, 0x64, 0x4b, 0xa1,
-	0xf7, 0x09, 0xf5, 0x5f, 0xb9, 0x3e, 0x9d, 0xf5, 0x4a, 0x8a, 0x64, 0x88, 0x10, 0xe8, 0x29, 0xd4,
-	0x09, 0xb5, 0x31, 0xe5, 0x47, 0x18, 0x8b, 0x70, 0xf7, 0x0b, 0xc2, 0xfd, 0xcc, 0x61, 0x46, 0x4d,
-	0xa0, 0x7b, 0x33, 0xf5, 0x29, 0x34, 0x92, 0x78, 0x68, 0x03, 0xe4, 0x53, 0x3c, 0x8b, 0xee, 0x1f,
-	0xff, 0x17, 0xdd, 0x81, 0xca, 0xb9, 0x39, 0x09, 0x42, 0x45, 0xad, 0x1b, 0xe1, 0xe2, 0x59, 0xe9,
-	0x2b, 0xe9, 0xc7, 0x72, 0xbd, 0xbc, 0x61, 0x6b, 0x3f, 0xc0, 0x66, 0x2a, 0xbf, 0xa8, 0xe3, 0xfb,
-	0x50, 0xe1, 0x2d, 0x60, 0x8a, 0x24, 0x32, 0xb9, 0xb2, 0xe5, 0x21, 0x52, 0x7b, 0x00, 0x9b, 0xdf,
-	0xe3, 0x09, 0x9e, 0xd7, 0x84, 0xec, 0x70, 0xfd, 0x23, 0xc3, 0xe6, 0x6b, 0x62, 0x3b, 0xa3, 0xd9,
-	0x15, 0x28, 0xf4, 0x69, 0xe6, 0x1d, 0x68, 0x1e, 0xb4, 0xe2, 0xea, 0xf7, 0x7d, 0xea, 0xb8, 0xe3,
-	0x94, 0xb2, 0x7c, 0xb1, 0xa0, 0x2c, 0x72, 0xae, 0xc7, 0x72, 0xa5, 0x29, 0xe7, 0x2a, 0xcd, 0xe3,
-	0x39, 0xa5, 0xa9, 0xe4, 0xc6, 0x4e, 0x29, 0xcf, 0xa3, 0xb4, 0xf2, 0x54, 0xf3, 0x73, 0x2f, 0x54,
-	0xa2, 0xda, 0xb5, 0x94, 0xa8, 0xbe, 0xb2, 0x12, 0x35, 0xae, 0xa3, 0x44, 0xb0, 0x82, 0x12, 0xa5,
-	0x5b, 0x78, 0x53, 0x8d, 0xd8, 0x03, 0x35, 0x9e, 0xbb, 0x63, 0x4c, 0xa7, 0x0e, 0x63, 0x0e, 0x71,
-	0x59, 0xd1, 0xe0, 0x3c, 0x87, 0xed, 0x5c, 0x74, 0xc4, 0xde, 0x86, 0xa6, 0x77, 0x69, 0x16, 0x53,
-	0xdb, 0x30, 0xd2, 0x26, 0x6d, 0x02, 0xf7, 0xfa, 0xb8, 0x88, 0xed, 0x7d, 0xa8, 0xf1, 0x9c, 0x06,
-	0x09, 0x65, 0x95, 0x2f, 0x8f, 0xec, 0x6c, 0xdc, 0xd2, 0x42, 0x5c, 0x7e, 0xbd, 0x46, 0x84, 0x5a,
-	0xe1, 0x27, 0x49, 0xdd, 0x08, 0x17, 0x9a, 0x0b, 0x6a, 0x1e, 0x5b, 0x94, 0xad, 0x02, 0xb5, 0x48,
-	0x8e, 0x22, 0xba, 0x78, 0xc9, 0x77, 0x28, 0x9e, 0x92, 0x73, 0x1c, 0x3e, 0x90, 0xb2, 0x11, 0x2f,
-	0xd1, 0x7d, 0x68, 0x04, 0xae, 0x4f, 0x02, 0xeb, 0x04, 0x87, 0x23, 0x2e, 0x1b, 0x97, 0x06, 0x6d,
-	0x17, 0xb6, 0xe2, 0xf2, 0x1c, 0xf2, 0x6f, 0xb2, 0xc2, 0x3a, 0xbe, 0x81, 0xbb, 0x59, 0x60, 0x94,
-	0xd4, 0x13, 0xa8, 0x8a, 0xcf, 0xb9, 0xf8, 0xce, 0x17, 0xa9, 0x8f, 0x70, 0x33, 0x22, 0xac, 0x76,
-	0x08, 0x77, 0xa2, 0x83, 0xce, 0xf3, 0x16, 0x56, 0xf4, 0x6e, 0x42, 0xc3, 0x8b, 0x29, 0x27, 0x81,
-	0x1c, 0xd8, 0xca, 0x04, 0xfa, 0xbf, 0x8a, 0x75, 0xf0, 0x67, 0x0d, 0x9a, 0x9c, 0xe8, 0xb5, 0xe9,
-	0x9a, 0x63, 0x4c, 0xd1, 0x00, 0xaa, 0xe1, 0xd3, 0x8a, 0x3a, 0x05, 0x67, 0x5e, 0xf8, 0xd8, 0x51,
-	0x1f, 0xae, 0x80, 0x0c, 0x0f, 0xa0, 0xdd, 0xe2, 0x04, 0xe1, 0x8d, 0x29, 0x24, 0x58, 0xd0, 0xc4,
-	0x42, 0x82, 0xc5, 0xab, 0xa7, 0xdd, 0x42, 0x6f, 0x41, 0x3e, 0xc4, 0x3e, 0xda, 0x29, 0x6a, 0xd9,
-	0xdc, 0x8b, 0xaf, 0x7e, 0xb2, 0x0c, 0x96, 0xc4, 0xfd, 0x1d, 0xca, 0x7c, 0x5a, 0xd0, 0xee, 0x8a,
-	0x0f, 0x9b, 0xda, 0x59, 0x0e, 0x4c, 0x82, 0x1f, 0x43, 0x35, 0x7c, 0x30, 0x0a, 0xab, 0xb2, 0xf0,
-	0x9e, 0xa8, 0x8b, 0x9f, 0xb3, 0x5c, 0x9f, 0xde, 0xf2, 0x27, 0x4d, 0xbb, 0x85, 0xde, 0xc1, 0x3a,
-	0x27, 0x4a, 0x5d, 0x39, 0xb4, 0xec, 0x49, 0x5e, 0x14, 0x03, 0xf5, 0xe0, 0x3a, 0x2e, 0xc9, 0x69,
-	0x2e, 0xa0, 0xd5, 0xc7, 0x73, 0xd4, 0x9f, 0x15, 0xc4, 0x29, 0x94, 0x21, 0x75, 0xff, 0x1a, 0x1e,
-	0x09, 0xf1, 0x29, 0x00, 0xcf, 0x2c, 0xbc, 0x35, 0x68, 0x6f, 0x49, 0xf2, 0x73, 0xb7, 0x54, 0x7d,
-	0xbc, 0x22, 0x3a, 0x21, 0x3b, 0x81, 0x46, 0x1f, 0xc7, 0x5c, 0x8f, 0xae, 0x4e, 0x77, 0x9e, 0x6a,
-	0x6f, 0x35, 0x70, 0xcc, 0xd4, 0xfb, 0x03, 0xda, 0x16, 0x99, 0xea, 0xf1, 0x8f, 0xd2, 0x3c, 0xdf,
-	0x63, 0xe9, 0xb7, 0x67, 0xd7, 0xff, 0xd1, 0xfa, 0x4d, 0xf4, 0xef, 0xb0, 0x2a, 0x66, 0xe8, 0xf3,
-	0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x49, 0xe1, 0xfd, 0xc5, 0xd1, 0x0f, 0x00, 0x00,
+	proto.RegisterFile("github.com/piotrkowalczuk/charon/pb/rpc/charond/v1/user.proto", fileDescriptor_user_ed810dfda6844d7d)
+}
+
+var fileDescriptor_user_ed810dfda6844d7d = []byte{
+	// 2044 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x19, 0x4d, 0x73, 0xdb, 0xc6,
+	0xd5, 0xe0, 0x97, 0xc8, 0x47, 0x89, 0x92, 0xd6, 0x92, 0x0a, 0x43, 0x4e, 0xa3, 0xc0, 0x75, 0x24,
+	0xd9, 0x32, 0x59, 0x29, 0x4e, 0x13, 0xdb, 0x6d, 0x5a, 0xc9, 0xb1, 0x35, 0xee, 0x24, 0xb5, 0x0a,
+	0xca, 0xee, 0x8c, 0xeb, 0x19, 0x16, 0x02, 0x96, 0x14, 0x46, 0x24, 0x96, 0xde, 0x5d, 0x52, 0x65,
+	0x7a, 0x6d, 0x0f, 0x3d, 0xf5, 0xd0, 0xfe, 0x8d, 0x1e, 0xfa, 0x13, 0xfa, 0x23, 0xda, 0xbf, 0xd2,
+	0x43, 0x7b, 0xe8, 0xec, 0x62, 0x01, 0x81, 0x24, 0xc0, 0x0f, 0x25, 0x19, 0x9f, 0xc4, 0x7d, 0xdf,
+	0x5f, 0xfb, 0xde, 0x5b, 0x08, 0x7e, 0xd6, 0xf2, 0xf8, 0x79, 0xef, 0xac, 0xea, 0x90, 0x4e, 0xad,
+	0xeb, 0x11, 0x4e, 0x2f, 0xc8, 0xa5, 0xdd, 0x76, 0xbe, 0xe9, 0x5d, 0xd4, 0x9c, 0x73, 0x9b, 0x12,
+	0xbf, 0xd6, 0x3d, 0xab, 0xd1, 0xae, 0xa3, 0x4e, 0x6e, 0xad, 0xbf, 0x5f, 0xeb, 0x31, 0x4c, 0xab,
+	0x5d, 0x4a, 0x38, 0x41, 0xeb, 0x01, 0xb8, 0x4a, 0xbb, 0x4e, 0x55, 0x51, 0x54, 0xfb, 0xfb, 0xc6,
+	0x66, 0x8b, 0x90, 0x56, 0x1b, 0xd7, 0x24, 0xd1, 0x59, 0xaf, 0x59, 0xc3, 0x9d, 0x2e, 0x1f, 0x04,
+	0x3c, 0xc6, 0x87, 0xa3, 0x48, 0xee, 0x75, 0x30, 0xe3, 0x76, 0xa7, 0xab, 0x08, 0x7e, 0x38, 0x4a,
+	0x70, 0x49, 0xed, 0x6e, 0x17, 0x53, 0xa6, 0xf0, 0x3f, 0xbf, 0x86, 0xcd, 0x0e, 0xe9, 0x74, 0x88,
+	0xaf, 0x04, 0x7c, 0x71, 0x0d, 0x01, 0x2d, 0x4a, 0x7a, 0xa1, 0x81, 0x37, 0xdf, 0xf1, 0x41, 0x17,
+	0xb3, 0x5a, 0xf0, 0x27, 0x04, 0xfa, 0x01, 0xd0, 0x8f, 0x01, 0xcd, 0xff, 0xe4, 0x20, 0xf7, 0x8a,
+	0x61, 0x8a, 0x2a, 0x90, 0xf1, 0x5c, 0x5d, 0xdb, 0xd2, 0x76, 0xb2, 0x56, 0xc6, 0x73, 0x91, 0x01,
+	0x45, 0x11, 0x46, 0xdf, 0xee, 0x60, 0x3d, 0xb3, 0xa5, 0xed, 0x94, 0xac, 0xe8, 0x8c, 0x3e, 0x00,
+	0x68, 0x7a, 0x94, 0xf1, 0x86, 0xc4, 0x66, 0x25, 0xb6, 0x24, 0x21, 0xbf, 0x12, 0xe8, 0x4d, 0x28,
+	0xb5, 0xed, 0x10, 0x9b, 0x0b, 0x78, 0xdb, 0xb6, 0x42, 0x7e, 0x04, 0x8b, 0x1e, 0x6b, 0xb0, 0x5e,
+	0x17, 0x53, 0x21, 0x4f, 0xcf, 0x6f, 0x69, 0x3b, 0x45, 0xab, 0xec, 0xb1, 0x7a, 0x08, 0x12, 0xfc,
+	0x1e, 0x6b, 0xd8, 0x0e, 0xf7, 0xfa, 0x58, 0x2f, 0x48, 0x7c, 0xd1, 0x63, 0x87, 0xf2, 0x8c, 0x6e,
+	0x41, 0x51, 0xf0, 0x73, 0xbb, 0xd9, 0xd4, 0x17, 0x24, 0x6e, 0xc1, 0x63, 0x75, 0x71, 0x54, 0xa2,
+	0x1d, 0xe2, 0x37, 0x3d, 0xda, 0xc1, 0xae, 0x5e, 0x0c, 0x45, 0x3f, 0x0d, 0x41, 0xe8, 0x11, 0x80,
+	0x43, 0xb1, 0xcd, 0xb1, 0xdb, 0xb0, 0xb9, 0x5e, 0xda, 0xd2, 0x76, 0xca, 0x07, 0x46, 0x35, 0x48,
+	0x67, 0x35, 0x4c, 0x67, 0xf5, 0x34, 0xcc, 0xb7, 0x55, 0x52, 0xd4, 0x87, 0x1c, 0xed, 0x5d, 0xb1,
+	0x9e, 0x0d, 0x74, 0x90, 0xac, 0x4b, 0x55, 0x15, 0xcc, 0x17, 0x3e, 0xff, 0xc9, 0xc3, 0x88, 0xfa,
+	0x68, 0x20, 0x14, 0xf5, 0xba, 0x6e, 0xa8, 0xa8, 0x3c, 0x5d, 0x91, 0xa2, 0x0e, 0x14, 0x85, 0xac,
+	0x67, 0x03, 0x7d, 0x31, 0x51, 0x91, 0x22, 0x38, 0x1a, 0xa0, 0x7d, 0x58, 0xf7, 0x58, 0x83, 0x5f,
+	0x92, 0x46, 0xd3, 0x76, 0x38, 0xa1, 0x0d, 0xec, 0xdb, 0x67, 0x6d, 0xec, 0xea, 0x4b, 0xd2, 0x7b,
+	0xe4, 0xb1, 0xd3, 0x4b, 0xf2, 0x5c, 0xa2, 0x9e, 0x05, 0x18, 0x15, 0xdf, 0x36, 0x71, 0x2e, 0xb0,
+	0xab, 0x57, 0xc2, 0xf8, 0x7e, 0x25, 0xcf, 0x68, 0x0f, 0x90, 0x88, 0x2f, 0xa6, 0x7d, 0xcf, 0xc1,
+	0x0d, 0xdb, 0x71, 0x48, 0xcf, 0xe7, 0xfa, 0xb2, 0xa4, 0x5a, 0xf1, 0x58, 0x3d, 0x40, 0x1c, 0x06,
+	0x70, 0xe1, 0xa6, 0x8b, 0xdb, 0x58, 0xb9, 0xb9, 0x32, 0xdd, 0x4d, 0x45, 0x7d, 0xc8, 0xcd, 0xbf,
+	0x67, 0x61, 0xf5, 0xa9, 0x8c, 0x97, 0xa8, 0x3f, 0x0b, 0xbf, 0xeb, 0x61, 0xc6, 0x87, 0xca, 0x4e,
+	0x1b, 0x29, 0xbb, 0xbb, 0x50, 0xe9, 0xb6, 0x6d, 0xcf, 0x6f, 0x74, 0x6d, 0xc6, 0x2e, 0x09, 0x75,
+	0x55, 0x61, 0x2e, 0x49, 0xe8, 0x89, 0x02, 0xa2, 0x6d, 0x58, 0x66, 0xd8, 0xe9, 0x51, 0x7c, 0x45,
+	0x27, 0x4a, 0x74, 0xd1, 0xaa, 0x04, 0xe0, 0x88, 0x70, 0xb8, 0x8c, 0x73, 0x13, 0xcb, 0x38, 0x3f,
+	0x52, 0xc6, 0xb5, 0x91, 0x32, 0x2e, 0x48, 0xd7, 0x17, 0xc3, 0x34, 0x1d, 0x11, 0xd2, 0x1e, 0x2e,
+	0xea, 0xdd, 0x78, 0x51, 0x2f, 0x24, 0x50, 0x5f, 0x95, 0xf8, 0x76, 0xac, 0xc4, 0x8b, 0x09, 0x94,
+	0x51, 0xc1, 0xd7, 0x46, 0x0a, 0xbe, 0x94, 0x6c, 0xc4, 0x55, 0xf9, 0x3f, 0x4e, 0x4c, 0x2e, 0x24,
+	0xb0, 0x8d, 0xa5, 0xda, 0x7c, 0x06, 0x28, 0x9e, 0x2e, 0xd6, 0x25, 0x3e, 0x13, 0x71, 0xc8, 0x49,
+	0xff, 0x35, 0x29, 0x63, 0xb3, 0x9a, 0xd8, 0x6e, 0xab, 0x92, 0x45, 0x12, 0x9a, 0x2f, 0xa0, 0x72,
+	0x8c, 0x79, 0x3c, 0xe5, 0xa3, 0x9d, 0x67, 0x1b, 0x96, 0x3d, 0xdf, 0x69, 0xf7, 0x5c, 0xdc, 0x50,
+	0xd5, 0x22, 0xf3, 0x5c, 0xb4, 0x2a, 0x0a, 0xfc, 0x65, 0x00, 0x35, 0x8f, 0x60, 0x39, 0x12, 0x75,
+	0x5d, 0x73, 0xfe, 0x9d, 0x83, 0x95, 0xaf, 0x3c, 0x26, 0xa5, 0xb0, 0xd0, 0xa2, 0xd1, 0xe4, 0x6a,
+	0xd3, 0x92, 0x1b, 0xcf, 0x58, 0x66, 0x52, 0xc6, 0x86, 0x9b, 0x48, 0x56, 0xdd, 0xed, 0x77, 0x29,
+	0x4d, 0x24, 0x39, 0x5d, 0xb9, 0x59, 0xd2, 0x85, 0xee, 0x03, 0x78, 0x2c, 0x0a, 0x60, 0x3e, 0x81,
+	0xa7, 0xe4, 0x31, 0x15, 0x49, 0x74, 0x17, 0x0a, 0xa4, 0xd9, 0x64, 0x98, 0xeb, 0x6e, 0x52, 0xbb,
+	0x51, 0x48, 0x74, 0x07, 0xf2, 0x6d, 0xaf, 0xe3, 0x71, 0x1d, 0x27, 0x51, 0x05, 0x38, 0xf4, 0x02,
+	0x72, 0x8c, 0x50, 0xae, 0x37, 0xb7, 0xb2, 0x3b, 0xe5, 0x83, 0xfd, 0x94, 0x14, 0x8c, 0xc6, 0xbc,
+	0x5a, 0x27, 0x94, 0x3f, 0xf3, 0x39, 0x1d, 0x1c, 0x65, 0x74, 0xcd, 0x92, 0x22, 0xd0, 0x67, 0x50,
+	0x24, 0xd4, 0xc5, 0x54, 0xc4, 0xaa, 0x25, 0xc5, 0xdd, 0x4e, 0x11, 0xf7, 0x52, 0x90, 0x59, 0x0b,
+	0x92, 0xfa, 0x68, 0x20, 0x6e, 0x76, 0xd7, 0x6e, 0xe1, 0x06, 0x27, 0x17, 0xd8, 0xd7, 0xcf, 0x83,
+	0x9b, 0x2d, 0x20, 0xa7, 0x02, 0x80, 0xee, 0xc0, 0x52, 0x58, 0x61, 0x9c, 0x70, 0xbb, 0xad, 0x7b,
+	0xb2, 0xbe, 0x16, 0x15, 0xf0, 0x54, 0xc0, 0x8c, 0xcf, 0xa0, 0x14, 0xd9, 0x84, 0x56, 0x20, 0x7b,
+	0x81, 0x07, 0xaa, 0x23, 0x89, 0x9f, 0x68, 0x0d, 0xf2, 0x7d, 0xbb, 0xdd, 0xc3, 0xaa, 0x36, 0x83,
+	0xc3, 0xe3, 0xcc, 0xe7, 0xda, 0x2f, 0x73, 0xc5, 0xc2, 0x8a, 0x6b, 0xfe, 0x55, 0x83, 0xd5, 0x98,
+	0x93, 0xaa, 0x3e, 0xf7, 0x21, 0x2f, 0x0a, 0x86, 0xe9, 0xda, 0x56, 0x76, 0x5a, 0x81, 0x06, 0x94,
+	0xe8, 0x63, 0x58, 0xf6, 0xf1, 0xef, 0x79, 0x23, 0xe6, 0x90, 0x6a, 0x7b, 0x02, 0x7c, 0x12, 0x73,
+	0x2a, 0x1f, 0x38, 0x93, 0x4d, 0x4c, 0x8e, 0xc4, 0x99, 0xbf, 0x86, 0xd5, 0x20, 0xe7, 0x93, 0x2e,
+	0x20, 0x12, 0x19, 0x6c, 0x72, 0xe5, 0x99, 0xfc, 0x8d, 0x74, 0x58, 0x70, 0x6c, 0xe6, 0xd8, 0x6e,
+	0x30, 0xef, 0x8b, 0x56, 0x78, 0x34, 0x7f, 0x04, 0xc8, 0xc2, 0x8c, 0x13, 0x3a, 0x49, 0xa6, 0xf9,
+	0x1c, 0x6e, 0x0e, 0x51, 0x5d, 0xf7, 0xbe, 0xde, 0x81, 0xd5, 0x57, 0xbe, 0x18, 0x5d, 0x93, 0x94,
+	0xfd, 0x33, 0x0b, 0xab, 0x5f, 0x13, 0xd7, 0x6b, 0x0e, 0x26, 0xb9, 0x79, 0x6f, 0x64, 0xc3, 0x29,
+	0x1f, 0x54, 0xc2, 0x98, 0xd5, 0x39, 0xf5, 0xfc, 0x56, 0x6c, 0xf4, 0x7c, 0x3a, 0x36, 0x7a, 0xb2,
+	0x89, 0x1c, 0xd3, 0x47, 0x51, 0x2e, 0x71, 0x14, 0x3d, 0x18, 0x1a, 0x45, 0xf9, 0x44, 0xd9, 0xb1,
+	0xd1, 0x74, 0x3f, 0x3e, 0x9a, 0x0a, 0xc9, 0xb6, 0xa7, 0x8e, 0xaa, 0x85, 0xb9, 0x46, 0x55, 0x71,
+	0xe6, 0x51, 0x55, 0x9a, 0x67, 0x54, 0xc1, 0x94, 0x51, 0x25, 0xc6, 0x4d, 0x3c, 0x85, 0xd7, 0xad,
+	0x97, 0x3d, 0x30, 0xc2, 0x5b, 0x78, 0x82, 0x69, 0xc7, 0x63, 0xcc, 0x23, 0x3e, 0x4b, 0x2b, 0x9c,
+	0xff, 0x6a, 0xb0, 0x99, 0x48, 0xae, 0xd4, 0x6f, 0x41, 0xb9, 0x7b, 0x05, 0x96, 0x97, 0xb8, 0x64,
+	0xc5, 0x41, 0xe8, 0x2d, 0x14, 0xfb, 0x76, 0xdb, 0x73, 0x3d, 0x3e, 0xd0, 0x33, 0xf2, 0x8e, 0xff,
+	0x62, 0x4a, 0x07, 0x4c, 0xd0, 0x53, 0x7d, 0xad, 0x44, 0xc8, 0xe6, 0x63, 0x45, 0x12, 0x8d, 0xb7,
+	0xb0, 0x34, 0x84, 0x4a, 0xe8, 0x4b, 0x9f, 0xc6, 0xfb, 0x52, 0xf9, 0xe0, 0xc3, 0x14, 0xed, 0xa1,
+	0x98, 0x58, 0xe3, 0x32, 0xff, 0x95, 0x81, 0x5b, 0x75, 0x9c, 0x16, 0xab, 0x1f, 0xc0, 0x82, 0x88,
+	0x68, 0x23, 0x0a, 0x58, 0x41, 0x1c, 0x5f, 0xb8, 0xa3, 0x41, 0xc9, 0x8c, 0x07, 0x65, 0x0d, 0xf2,
+	0x4d, 0x42, 0x9d, 0xb0, 0x75, 0x04, 0x07, 0xf4, 0x00, 0x90, 0x8b, 0x7d, 0x0f, 0xbb, 0x8d, 0x38,
+	0x7b, 0x4e, 0xb2, 0xaf, 0x06, 0x98, 0x98, 0x19, 0xe8, 0x4d, 0x2c, 0xb2, 0x79, 0x19, 0xd9, 0x2f,
+	0x52, 0x7c, 0x4b, 0xf5, 0xe1, 0x3d, 0xc5, 0xd5, 0x07, 0xa3, 0x8e, 0xd3, 0x72, 0x2d, 0x3b, 0x6b,
+	0x30, 0xf1, 0x55, 0x5c, 0xc3, 0xa3, 0xc0, 0x50, 0xdc, 0x21, 0x7d, 0xb5, 0x00, 0x65, 0xad, 0xf0,
+	0x88, 0x6e, 0x43, 0xa9, 0xe7, 0x73, 0xd2, 0x73, 0xce, 0x71, 0xd0, 0x89, 0xb2, 0xd6, 0x15, 0xc0,
+	0xfc, 0x87, 0x06, 0x1f, 0xd5, 0xa3, 0xc5, 0x88, 0xf4, 0xa8, 0x83, 0xe7, 0xc9, 0xe7, 0x13, 0x28,
+	0x52, 0xc5, 0x36, 0xc5, 0xd9, 0x50, 0xba, 0x15, 0x31, 0x8c, 0x16, 0x43, 0x76, 0x42, 0x31, 0xe4,
+	0x62, 0xc5, 0x60, 0xf6, 0xc1, 0x9c, 0x64, 0xf2, 0xf7, 0x16, 0xab, 0x6d, 0x58, 0x0f, 0x2f, 0xe2,
+	0xb1, 0x78, 0x40, 0xa7, 0xb6, 0x86, 0x3f, 0x65, 0x60, 0x63, 0x94, 0x52, 0x59, 0xf5, 0x10, 0x0a,
+	0xf2, 0xf1, 0x1d, 0x4e, 0xf5, 0xb4, 0x25, 0x45, 0xb2, 0x59, 0x8a, 0x16, 0xfd, 0x66, 0xac, 0x53,
+	0x3c, 0x99, 0xd2, 0x29, 0x86, 0xd5, 0x5e, 0xab, 0x98, 0xb3, 0xdf, 0xba, 0x98, 0xff, 0xa7, 0xc1,
+	0x5a, 0x1d, 0x0f, 0xd9, 0x33, 0xa5, 0x9e, 0x36, 0xa2, 0xf0, 0x08, 0x37, 0xb3, 0x51, 0x00, 0x5e,
+	0xc5, 0x02, 0x90, 0x95, 0x01, 0x78, 0x34, 0xf9, 0x42, 0x0f, 0xe9, 0x7b, 0x4f, 0xee, 0x7b, 0xb0,
+	0x5e, 0xc7, 0x09, 0xd9, 0xf8, 0x1e, 0x4a, 0x73, 0x13, 0x6e, 0x1d, 0x63, 0x1f, 0x53, 0x9b, 0xe3,
+	0xd3, 0x97, 0xa7, 0x27, 0x75, 0xec, 0x50, 0xcc, 0x95, 0xf7, 0xe6, 0x73, 0x30, 0x92, 0x90, 0xca,
+	0x98, 0x0d, 0x28, 0x30, 0x09, 0x51, 0x1d, 0x4c, 0x9d, 0x44, 0x28, 0x7a, 0xd4, 0x53, 0xfb, 0xa3,
+	0xf8, 0x69, 0xee, 0x00, 0x52, 0x33, 0x57, 0x88, 0x09, 0x73, 0x89, 0x20, 0xe7, 0x10, 0x37, 0x7c,
+	0x81, 0xcb, 0xdf, 0xe6, 0x4f, 0xe1, 0xe6, 0x10, 0xa5, 0x52, 0x75, 0x17, 0x2a, 0x14, 0x3b, 0xa4,
+	0x8f, 0xe9, 0xa0, 0x21, 0xe8, 0xc2, 0xa9, 0xb8, 0x14, 0x42, 0x9f, 0x0a, 0xa0, 0x79, 0x08, 0xe8,
+	0x4b, 0x8f, 0x89, 0xef, 0x0f, 0x71, 0x3d, 0xa9, 0x35, 0x13, 0x1a, 0x90, 0x89, 0x19, 0x70, 0x00,
+	0xb7, 0x43, 0x97, 0xad, 0xb8, 0xec, 0x49, 0x46, 0x3f, 0x87, 0x0f, 0x52, 0x78, 0xe6, 0x33, 0x1f,
+	0xc3, 0xfa, 0xd3, 0x73, 0xdb, 0x6f, 0x45, 0x1b, 0x5b, 0xa8, 0x74, 0x17, 0x56, 0x9c, 0x1e, 0xa5,
+	0xd8, 0xe7, 0x57, 0x2b, 0x5e, 0x60, 0xc0, 0xb2, 0x82, 0x87, 0x1c, 0xe2, 0xf3, 0x94, 0x8f, 0x2f,
+	0x47, 0x3f, 0x5e, 0x94, 0x7d, 0x7c, 0x19, 0x92, 0x98, 0x8f, 0x60, 0x53, 0x09, 0xbe, 0xd2, 0xc3,
+	0x30, 0x9f, 0xe1, 0xe3, 0x88, 0xf9, 0x12, 0xd6, 0x24, 0xed, 0xa8, 0x81, 0x6b, 0x90, 0x0f, 0x1e,
+	0x0d, 0x01, 0x43, 0x70, 0x98, 0xc5, 0x96, 0xbf, 0x69, 0x62, 0x65, 0x6f, 0x79, 0x8c, 0x63, 0xfa,
+	0x1d, 0x7f, 0xa1, 0xf9, 0x16, 0xdf, 0x0f, 0xcd, 0x63, 0x58, 0x1b, 0xb6, 0xea, 0xba, 0x9b, 0xe1,
+	0xbd, 0xa8, 0xf2, 0xe3, 0xde, 0x25, 0x86, 0xeb, 0xe0, 0xcf, 0xab, 0x50, 0x16, 0x54, 0x5f, 0xdb,
+	0xbe, 0xdd, 0xc2, 0x14, 0x35, 0xa0, 0x10, 0x7c, 0x0b, 0x41, 0x3b, 0x29, 0x8a, 0xc6, 0xbe, 0x6c,
+	0x19, 0xbb, 0x33, 0x50, 0x06, 0xbe, 0x98, 0x37, 0x84, 0x82, 0x60, 0xfb, 0x4d, 0x55, 0x30, 0xf6,
+	0xbe, 0x31, 0x76, 0x67, 0xa0, 0x8c, 0x14, 0xbc, 0x86, 0xec, 0x31, 0xe6, 0xe8, 0x6e, 0xda, 0xa8,
+	0x1a, 0xfa, 0x44, 0x63, 0x7c, 0x3c, 0x8d, 0x2c, 0x92, 0xfb, 0x5b, 0xc8, 0x89, 0x71, 0x85, 0xb6,
+	0x67, 0x7c, 0xf7, 0x1b, 0x3b, 0xd3, 0x09, 0x23, 0xe1, 0x27, 0x50, 0x08, 0x5e, 0xaf, 0xa9, 0x51,
+	0x19, 0x7b, 0xdc, 0x1a, 0xe3, 0x5f, 0x23, 0xc5, 0x5b, 0xe3, 0xb5, 0x68, 0xe8, 0x81, 0xc4, 0xe0,
+	0x39, 0x99, 0x2a, 0x71, 0xec, 0xb5, 0x39, 0x45, 0xe2, 0x19, 0x2c, 0xa8, 0x87, 0x2e, 0xda, 0x4d,
+	0x5f, 0x9b, 0x46, 0x9e, 0xcb, 0xc6, 0xbd, 0x59, 0x48, 0xa3, 0x38, 0x7c, 0x03, 0xcb, 0x22, 0x3c,
+	0xf1, 0xed, 0x78, 0x7f, 0x9e, 0x57, 0x46, 0xa0, 0xf3, 0x60, 0xfe, 0x87, 0x89, 0x79, 0x03, 0x5d,
+	0x42, 0xa5, 0x8e, 0x87, 0x54, 0xff, 0x78, 0xde, 0x35, 0xdc, 0xd8, 0x9f, 0x83, 0x23, 0x52, 0xfc,
+	0x17, 0x0d, 0x36, 0xea, 0x98, 0x27, 0xac, 0x87, 0xe8, 0xf3, 0xc9, 0xf2, 0xd2, 0x97, 0x60, 0xe3,
+	0xd1, 0x35, 0x38, 0x23, 0x8b, 0x2e, 0x00, 0x44, 0xac, 0x82, 0x45, 0x00, 0xed, 0xcd, 0xb8, 0xbd,
+	0x05, 0x8a, 0x1f, 0xcc, 0xb5, 0xeb, 0x99, 0x37, 0xd0, 0x39, 0x94, 0xea, 0x38, 0xd4, 0x75, 0x7f,
+	0x8e, 0x45, 0xc9, 0xd8, 0x9b, 0x8d, 0x38, 0xd2, 0xf4, 0x07, 0x40, 0xe3, 0xab, 0x45, 0x6a, 0x96,
+	0x53, 0x57, 0x14, 0x63, 0x7f, 0x0e, 0x8e, 0x48, 0x79, 0x13, 0xca, 0xb1, 0x2d, 0x23, 0xf5, 0x0a,
+	0x8d, 0xef, 0x2c, 0xc6, 0xbd, 0x59, 0x48, 0x63, 0xfd, 0xaf, 0x1c, 0xdb, 0x47, 0x52, 0xf5, 0x8c,
+	0xef, 0x2c, 0x53, 0xae, 0xff, 0x1f, 0x35, 0x58, 0x4f, 0xdc, 0x38, 0xd0, 0x27, 0x53, 0xc2, 0x91,
+	0xb4, 0xd3, 0x18, 0x0f, 0xe7, 0x63, 0x8a, 0xdc, 0x7b, 0x0b, 0x95, 0xe1, 0x7d, 0x25, 0xb5, 0x3c,
+	0x13, 0xd7, 0x9a, 0x29, 0x4e, 0xba, 0xb0, 0xa6, 0x08, 0x87, 0xd6, 0x14, 0x74, 0x90, 0xda, 0xc5,
+	0x52, 0x77, 0x1a, 0x63, 0x63, 0x4c, 0xd3, 0x33, 0xf1, 0xaf, 0x58, 0xf3, 0x06, 0x7a, 0x03, 0x4b,
+	0x43, 0x1b, 0x4d, 0x6a, 0xd5, 0x27, 0xed, 0x3d, 0x53, 0x3c, 0xc0, 0x50, 0x0c, 0xb7, 0x08, 0x94,
+	0xde, 0x7b, 0xc7, 0x96, 0x1f, 0xe3, 0xfe, 0x4c, 0xb4, 0x51, 0x1a, 0x2c, 0x58, 0x50, 0xe5, 0x37,
+	0xad, 0x92, 0x67, 0x1e, 0x30, 0x47, 0xbf, 0x83, 0x2d, 0x87, 0x74, 0xaa, 0xe1, 0x7f, 0x88, 0x93,
+	0x84, 0x9e, 0x68, 0x6f, 0x1e, 0xcf, 0xff, 0x1f, 0xe4, 0x27, 0xea, 0xe7, 0x59, 0x41, 0xea, 0xfd,
+	0xe4, 0xff, 0x03, 0x00, 0x4f, 0xc0, 0xb0, 0xa0, 0x7b, 0x1f, 0x00, 0x00,
 }
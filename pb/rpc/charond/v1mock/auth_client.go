@@ -134,6 +134,66 @@ func (_m *AuthClient) IsGranted(ctx context.Context, in *charond.IsGrantedReques
 	return r0, r1
 }
 
+// IsGrantedBatch provides a mock function with given fields: ctx, in, opts
+func (_m *AuthClient) IsGrantedBatch(ctx context.Context, in *charond.IsGrantedBatchRequest, opts ...grpc.CallOption) (*charond.IsGrantedBatchResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *charond.IsGrantedBatchResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *charond.IsGrantedBatchRequest, ...grpc.CallOption) *charond.IsGrantedBatchResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*charond.IsGrantedBatchResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *charond.IsGrantedBatchRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListResources provides a mock function with given fields: ctx, in, opts
+func (_m *AuthClient) ListResources(ctx context.Context, in *charond.ListResourcesRequest, opts ...grpc.CallOption) (*charond.ListResourcesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *charond.ListResourcesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *charond.ListResourcesRequest, ...grpc.CallOption) *charond.ListResourcesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*charond.ListResourcesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *charond.ListResourcesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Login provides a mock function with given fields: ctx, in, opts
 func (_m *AuthClient) Login(ctx context.Context, in *charond.LoginRequest, opts ...grpc.CallOption) (*wrappers.StringValue, error) {
 	_va := make([]interface{}, len(opts))
@@ -193,3 +253,33 @@ func (_m *AuthClient) Logout(ctx context.Context, in *charond.LogoutRequest, opt
 
 	return r0, r1
 }
+
+// Watch provides a mock function with given fields: ctx, in, opts
+func (_m *AuthClient) Watch(ctx context.Context, in *charond.WatchRequest, opts ...grpc.CallOption) (charond.Auth_WatchClient, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 charond.Auth_WatchClient
+	if rf, ok := ret.Get(0).(func(context.Context, *charond.WatchRequest, ...grpc.CallOption) charond.Auth_WatchClient); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(charond.Auth_WatchClient)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *charond.WatchRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -105,6 +105,52 @@ func (_m *AuthServer) IsGranted(_a0 context.Context, _a1 *charond.IsGrantedReque
 	return r0, r1
 }
 
+// IsGrantedBatch provides a mock function with given fields: _a0, _a1
+func (_m *AuthServer) IsGrantedBatch(_a0 context.Context, _a1 *charond.IsGrantedBatchRequest) (*charond.IsGrantedBatchResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *charond.IsGrantedBatchResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *charond.IsGrantedBatchRequest) *charond.IsGrantedBatchResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*charond.IsGrantedBatchResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *charond.IsGrantedBatchRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListResources provides a mock function with given fields: _a0, _a1
+func (_m *AuthServer) ListResources(_a0 context.Context, _a1 *charond.ListResourcesRequest) (*charond.ListResourcesResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *charond.ListResourcesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *charond.ListResourcesRequest) *charond.ListResourcesResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*charond.ListResourcesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *charond.ListResourcesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Login provides a mock function with given fields: _a0, _a1
 func (_m *AuthServer) Login(_a0 context.Context, _a1 *charond.LoginRequest) (*wrappers.StringValue, error) {
 	ret := _m.Called(_a0, _a1)
@@ -150,3 +196,17 @@ func (_m *AuthServer) Logout(_a0 context.Context, _a1 *charond.LogoutRequest) (*
 
 	return r0, r1
 }
+
+// Watch provides a mock function with given fields: _a0, _a1
+func (_m *AuthServer) Watch(_a0 *charond.WatchRequest, _a1 charond.Auth_WatchServer) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*charond.WatchRequest, charond.Auth_WatchServer) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -101,3 +101,33 @@ func (_m *RefreshTokenManagerClient) Revoke(ctx context.Context, in *charond.Rev
 
 	return r0, r1
 }
+
+// RevokeAll provides a mock function with given fields: ctx, in, opts
+func (_m *RefreshTokenManagerClient) RevokeAll(ctx context.Context, in *charond.RevokeAllRefreshTokensRequest, opts ...grpc.CallOption) (*charond.RevokeAllRefreshTokensResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *charond.RevokeAllRefreshTokensResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *charond.RevokeAllRefreshTokensRequest, ...grpc.CallOption) *charond.RevokeAllRefreshTokensResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*charond.RevokeAllRefreshTokensResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *charond.RevokeAllRefreshTokensRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
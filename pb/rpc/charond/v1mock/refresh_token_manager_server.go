@@ -79,3 +79,26 @@ func (_m *RefreshTokenManagerServer) Revoke(_a0 context.Context, _a1 *charond.Re
 
 	return r0, r1
 }
+
+// RevokeAll provides a mock function with given fields: _a0, _a1
+func (_m *RefreshTokenManagerServer) RevokeAll(_a0 context.Context, _a1 *charond.RevokeAllRefreshTokensRequest) (*charond.RevokeAllRefreshTokensResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *charond.RevokeAllRefreshTokensResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *charond.RevokeAllRefreshTokensRequest) *charond.RevokeAllRefreshTokensResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*charond.RevokeAllRefreshTokensResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *charond.RevokeAllRefreshTokensRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -4,6 +4,7 @@ package charondmock
 
 import charond "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
 import context "context"
+import empty "github.com/golang/protobuf/ptypes/empty"
 import grpc "google.golang.org/grpc"
 import mock "github.com/stretchr/testify/mock"
 import wrappers "github.com/golang/protobuf/ptypes/wrappers"
@@ -13,6 +14,96 @@ type UserManagerClient struct {
 	mock.Mock
 }
 
+// ChangePassword provides a mock function with given fields: ctx, in, opts
+func (_m *UserManagerClient) ChangePassword(ctx context.Context, in *charond.ChangePasswordRequest, opts ...grpc.CallOption) (*wrappers.BoolValue, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *wrappers.BoolValue
+	if rf, ok := ret.Get(0).(func(context.Context, *charond.ChangePasswordRequest, ...grpc.CallOption) *wrappers.BoolValue); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*wrappers.BoolValue)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *charond.ChangePasswordRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Confirm provides a mock function with given fields: ctx, in, opts
+func (_m *UserManagerClient) Confirm(ctx context.Context, in *charond.ConfirmUserRequest, opts ...grpc.CallOption) (*wrappers.BoolValue, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *wrappers.BoolValue
+	if rf, ok := ret.Get(0).(func(context.Context, *charond.ConfirmUserRequest, ...grpc.CallOption) *wrappers.BoolValue); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*wrappers.BoolValue)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *charond.ConfirmUserRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConfirmTOTP provides a mock function with given fields: ctx, in, opts
+func (_m *UserManagerClient) ConfirmTOTP(ctx context.Context, in *charond.ConfirmTOTPRequest, opts ...grpc.CallOption) (*charond.ConfirmTOTPResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *charond.ConfirmTOTPResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *charond.ConfirmTOTPRequest, ...grpc.CallOption) *charond.ConfirmTOTPResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*charond.ConfirmTOTPResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *charond.ConfirmTOTPRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Create provides a mock function with given fields: ctx, in, opts
 func (_m *UserManagerClient) Create(ctx context.Context, in *charond.CreateUserRequest, opts ...grpc.CallOption) (*charond.CreateUserResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -73,6 +164,96 @@ func (_m *UserManagerClient) Delete(ctx context.Context, in *charond.DeleteUserR
 	return r0, r1
 }
 
+// DisableTOTP provides a mock function with given fields: ctx, in, opts
+func (_m *UserManagerClient) DisableTOTP(ctx context.Context, in *charond.DisableTOTPRequest, opts ...grpc.CallOption) (*wrappers.BoolValue, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *wrappers.BoolValue
+	if rf, ok := ret.Get(0).(func(context.Context, *charond.DisableTOTPRequest, ...grpc.CallOption) *wrappers.BoolValue); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*wrappers.BoolValue)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *charond.DisableTOTPRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GenerateRecoveryCodes provides a mock function with given fields: ctx, in, opts
+func (_m *UserManagerClient) GenerateRecoveryCodes(ctx context.Context, in *charond.GenerateRecoveryCodesRequest, opts ...grpc.CallOption) (*charond.GenerateRecoveryCodesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *charond.GenerateRecoveryCodesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *charond.GenerateRecoveryCodesRequest, ...grpc.CallOption) *charond.GenerateRecoveryCodesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*charond.GenerateRecoveryCodesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *charond.GenerateRecoveryCodesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GenerateTOTPSecret provides a mock function with given fields: ctx, in, opts
+func (_m *UserManagerClient) GenerateTOTPSecret(ctx context.Context, in *charond.GenerateTOTPSecretRequest, opts ...grpc.CallOption) (*charond.GenerateTOTPSecretResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *charond.GenerateTOTPSecretResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *charond.GenerateTOTPSecretRequest, ...grpc.CallOption) *charond.GenerateTOTPSecretResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*charond.GenerateTOTPSecretResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *charond.GenerateTOTPSecretRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Get provides a mock function with given fields: ctx, in, opts
 func (_m *UserManagerClient) Get(ctx context.Context, in *charond.GetUserRequest, opts ...grpc.CallOption) (*charond.GetUserResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -223,6 +404,126 @@ func (_m *UserManagerClient) Modify(ctx context.Context, in *charond.ModifyUserR
 	return r0, r1
 }
 
+// Register provides a mock function with given fields: ctx, in, opts
+func (_m *UserManagerClient) Register(ctx context.Context, in *charond.RegisterUserRequest, opts ...grpc.CallOption) (*charond.RegisterUserResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *charond.RegisterUserResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *charond.RegisterUserRequest, ...grpc.CallOption) *charond.RegisterUserResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*charond.RegisterUserResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *charond.RegisterUserRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RequestPasswordReset provides a mock function with given fields: ctx, in, opts
+func (_m *UserManagerClient) RequestPasswordReset(ctx context.Context, in *charond.RequestPasswordResetRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *empty.Empty
+	if rf, ok := ret.Get(0).(func(context.Context, *charond.RequestPasswordResetRequest, ...grpc.CallOption) *empty.Empty); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*empty.Empty)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *charond.RequestPasswordResetRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResetPassword provides a mock function with given fields: ctx, in, opts
+func (_m *UserManagerClient) ResetPassword(ctx context.Context, in *charond.ResetPasswordRequest, opts ...grpc.CallOption) (*wrappers.BoolValue, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *wrappers.BoolValue
+	if rf, ok := ret.Get(0).(func(context.Context, *charond.ResetPasswordRequest, ...grpc.CallOption) *wrappers.BoolValue); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*wrappers.BoolValue)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *charond.ResetPasswordRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Restore provides a mock function with given fields: ctx, in, opts
+func (_m *UserManagerClient) Restore(ctx context.Context, in *charond.RestoreUserRequest, opts ...grpc.CallOption) (*charond.RestoreUserResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *charond.RestoreUserResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *charond.RestoreUserRequest, ...grpc.CallOption) *charond.RestoreUserResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*charond.RestoreUserResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *charond.RestoreUserRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetGroups provides a mock function with given fields: ctx, in, opts
 func (_m *UserManagerClient) SetGroups(ctx context.Context, in *charond.SetUserGroupsRequest, opts ...grpc.CallOption) (*charond.SetUserGroupsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -282,3 +583,63 @@ func (_m *UserManagerClient) SetPermissions(ctx context.Context, in *charond.Set
 
 	return r0, r1
 }
+
+// SetResourcePermissions provides a mock function with given fields: ctx, in, opts
+func (_m *UserManagerClient) SetResourcePermissions(ctx context.Context, in *charond.SetUserResourcePermissionsRequest, opts ...grpc.CallOption) (*charond.SetUserResourcePermissionsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *charond.SetUserResourcePermissionsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *charond.SetUserResourcePermissionsRequest, ...grpc.CallOption) *charond.SetUserResourcePermissionsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*charond.SetUserResourcePermissionsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *charond.SetUserResourcePermissionsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Unlock provides a mock function with given fields: ctx, in, opts
+func (_m *UserManagerClient) Unlock(ctx context.Context, in *charond.UnlockUserRequest, opts ...grpc.CallOption) (*wrappers.BoolValue, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *wrappers.BoolValue
+	if rf, ok := ret.Get(0).(func(context.Context, *charond.UnlockUserRequest, ...grpc.CallOption) *wrappers.BoolValue); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*wrappers.BoolValue)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *charond.UnlockUserRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -4,6 +4,7 @@ package charondmock
 
 import charond "github.com/piotrkowalczuk/charon/pb/rpc/charond/v1"
 import context "context"
+import empty "github.com/golang/protobuf/ptypes/empty"
 import mock "github.com/stretchr/testify/mock"
 import wrappers "github.com/golang/protobuf/ptypes/wrappers"
 
@@ -12,6 +13,75 @@ type UserManagerServer struct {
 	mock.Mock
 }
 
+// ChangePassword provides a mock function with given fields: _a0, _a1
+func (_m *UserManagerServer) ChangePassword(_a0 context.Context, _a1 *charond.ChangePasswordRequest) (*wrappers.BoolValue, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *wrappers.BoolValue
+	if rf, ok := ret.Get(0).(func(context.Context, *charond.ChangePasswordRequest) *wrappers.BoolValue); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*wrappers.BoolValue)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *charond.ChangePasswordRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Confirm provides a mock function with given fields: _a0, _a1
+func (_m *UserManagerServer) Confirm(_a0 context.Context, _a1 *charond.ConfirmUserRequest) (*wrappers.BoolValue, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *wrappers.BoolValue
+	if rf, ok := ret.Get(0).(func(context.Context, *charond.ConfirmUserRequest) *wrappers.BoolValue); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*wrappers.BoolValue)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *charond.ConfirmUserRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConfirmTOTP provides a mock function with given fields: _a0, _a1
+func (_m *UserManagerServer) ConfirmTOTP(_a0 context.Context, _a1 *charond.ConfirmTOTPRequest) (*charond.ConfirmTOTPResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *charond.ConfirmTOTPResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *charond.ConfirmTOTPRequest) *charond.ConfirmTOTPResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*charond.ConfirmTOTPResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *charond.ConfirmTOTPRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Create provides a mock function with given fields: _a0, _a1
 func (_m *UserManagerServer) Create(_a0 context.Context, _a1 *charond.CreateUserRequest) (*charond.CreateUserResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -58,6 +128,75 @@ func (_m *UserManagerServer) Delete(_a0 context.Context, _a1 *charond.DeleteUser
 	return r0, r1
 }
 
+// DisableTOTP provides a mock function with given fields: _a0, _a1
+func (_m *UserManagerServer) DisableTOTP(_a0 context.Context, _a1 *charond.DisableTOTPRequest) (*wrappers.BoolValue, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *wrappers.BoolValue
+	if rf, ok := ret.Get(0).(func(context.Context, *charond.DisableTOTPRequest) *wrappers.BoolValue); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*wrappers.BoolValue)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *charond.DisableTOTPRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GenerateRecoveryCodes provides a mock function with given fields: _a0, _a1
+func (_m *UserManagerServer) GenerateRecoveryCodes(_a0 context.Context, _a1 *charond.GenerateRecoveryCodesRequest) (*charond.GenerateRecoveryCodesResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *charond.GenerateRecoveryCodesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *charond.GenerateRecoveryCodesRequest) *charond.GenerateRecoveryCodesResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*charond.GenerateRecoveryCodesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *charond.GenerateRecoveryCodesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GenerateTOTPSecret provides a mock function with given fields: _a0, _a1
+func (_m *UserManagerServer) GenerateTOTPSecret(_a0 context.Context, _a1 *charond.GenerateTOTPSecretRequest) (*charond.GenerateTOTPSecretResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *charond.GenerateTOTPSecretResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *charond.GenerateTOTPSecretRequest) *charond.GenerateTOTPSecretResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*charond.GenerateTOTPSecretResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *charond.GenerateTOTPSecretRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Get provides a mock function with given fields: _a0, _a1
 func (_m *UserManagerServer) Get(_a0 context.Context, _a1 *charond.GetUserRequest) (*charond.GetUserResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -173,6 +312,98 @@ func (_m *UserManagerServer) Modify(_a0 context.Context, _a1 *charond.ModifyUser
 	return r0, r1
 }
 
+// Register provides a mock function with given fields: _a0, _a1
+func (_m *UserManagerServer) Register(_a0 context.Context, _a1 *charond.RegisterUserRequest) (*charond.RegisterUserResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *charond.RegisterUserResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *charond.RegisterUserRequest) *charond.RegisterUserResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*charond.RegisterUserResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *charond.RegisterUserRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RequestPasswordReset provides a mock function with given fields: _a0, _a1
+func (_m *UserManagerServer) RequestPasswordReset(_a0 context.Context, _a1 *charond.RequestPasswordResetRequest) (*empty.Empty, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *empty.Empty
+	if rf, ok := ret.Get(0).(func(context.Context, *charond.RequestPasswordResetRequest) *empty.Empty); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*empty.Empty)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *charond.RequestPasswordResetRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResetPassword provides a mock function with given fields: _a0, _a1
+func (_m *UserManagerServer) ResetPassword(_a0 context.Context, _a1 *charond.ResetPasswordRequest) (*wrappers.BoolValue, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *wrappers.BoolValue
+	if rf, ok := ret.Get(0).(func(context.Context, *charond.ResetPasswordRequest) *wrappers.BoolValue); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*wrappers.BoolValue)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *charond.ResetPasswordRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Restore provides a mock function with given fields: _a0, _a1
+func (_m *UserManagerServer) Restore(_a0 context.Context, _a1 *charond.RestoreUserRequest) (*charond.RestoreUserResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *charond.RestoreUserResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *charond.RestoreUserRequest) *charond.RestoreUserResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*charond.RestoreUserResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *charond.RestoreUserRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetGroups provides a mock function with given fields: _a0, _a1
 func (_m *UserManagerServer) SetGroups(_a0 context.Context, _a1 *charond.SetUserGroupsRequest) (*charond.SetUserGroupsResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -218,3 +449,49 @@ func (_m *UserManagerServer) SetPermissions(_a0 context.Context, _a1 *charond.Se
 
 	return r0, r1
 }
+
+// SetResourcePermissions provides a mock function with given fields: _a0, _a1
+func (_m *UserManagerServer) SetResourcePermissions(_a0 context.Context, _a1 *charond.SetUserResourcePermissionsRequest) (*charond.SetUserResourcePermissionsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *charond.SetUserResourcePermissionsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *charond.SetUserResourcePermissionsRequest) *charond.SetUserResourcePermissionsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*charond.SetUserResourcePermissionsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *charond.SetUserResourcePermissionsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Unlock provides a mock function with given fields: _a0, _a1
+func (_m *UserManagerServer) Unlock(_a0 context.Context, _a1 *charond.UnlockUserRequest) (*wrappers.BoolValue, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *wrappers.BoolValue
+	if rf, ok := ret.Get(0).(func(context.Context, *charond.UnlockUserRequest) *wrappers.BoolValue); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*wrappers.BoolValue)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *charond.UnlockUserRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
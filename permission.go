@@ -24,6 +24,8 @@ const (
 	UserCanRetrieveStaffAsOwner    Permission = "charon:user:can retrieve staff as owner"
 	UserCanRetrieveStaffAsStranger Permission = "charon:user:can retrieve staff as stranger"
 
+	UserCanUnlock Permission = "charon:user:can unlock"
+
 	UserPermissionCanCreate                  Permission = "charon:user_permission:can create"
 	UserPermissionCanDelete                  Permission = "charon:user_permission:can delete"
 	UserPermissionCanModify                  Permission = "charon:user_permission:can modify"
@@ -52,12 +54,33 @@ const (
 	GroupPermissionCanRetrieve Permission = "charon:group_permission:can retrieve"
 
 	RefreshTokenCanCreate             Permission = "charon:refresh-token:can create"
+	RefreshTokenCanCreateAsStranger   Permission = "charon:refresh-token:can create as stranger"
 	RefreshTokenCanRevokeAsStranger   Permission = "charon:refresh-token:can revoke as stranger"
 	RefreshTokenCanRevokeAsOwner      Permission = "charon:refresh-token:can revoke as owner"
 	RefreshTokenCanModifyAsStranger   Permission = "charon:refresh-token:can modify as stranger"
 	RefreshTokenCanModifyAsOwner      Permission = "charon:refresh-token:can modify as owner"
 	RefreshTokenCanRetrieveAsOwner    Permission = "charon:refresh-token:can retrieve as owner"
 	RefreshTokenCanRetrieveAsStranger Permission = "charon:refresh-token:can retrieve as stranger"
+
+	AuditEventCanRetrieve Permission = "charon:audit_event:can retrieve"
+
+	OAuthClientCanCreate   Permission = "charon:oauth_client:can create"
+	OAuthClientCanRetrieve Permission = "charon:oauth_client:can retrieve"
+	OAuthClientCanDelete   Permission = "charon:oauth_client:can delete"
+
+	APIKeyCanCreateAsOwner      Permission = "charon:api_key:can create as owner"
+	APIKeyCanCreateAsStranger   Permission = "charon:api_key:can create as stranger"
+	APIKeyCanRetrieveAsOwner    Permission = "charon:api_key:can retrieve as owner"
+	APIKeyCanRetrieveAsStranger Permission = "charon:api_key:can retrieve as stranger"
+	APIKeyCanRevokeAsOwner      Permission = "charon:api_key:can revoke as owner"
+	APIKeyCanRevokeAsStranger   Permission = "charon:api_key:can revoke as stranger"
+	APIKeyCanDeleteAsOwner      Permission = "charon:api_key:can delete as owner"
+	APIKeyCanDeleteAsStranger   Permission = "charon:api_key:can delete as stranger"
+
+	SessionCanRetrieveAsOwner    Permission = "charon:session:can retrieve as owner"
+	SessionCanRetrieveAsStranger Permission = "charon:session:can retrieve as stranger"
+	SessionCanDeleteAsOwner      Permission = "charon:session:can delete as owner"
+	SessionCanDeleteAsStranger   Permission = "charon:session:can delete as stranger"
 )
 
 var (
@@ -77,6 +100,7 @@ var (
 		UserCanRetrieveAsStranger,
 		UserCanRetrieveStaffAsOwner,
 		UserCanRetrieveStaffAsStranger,
+		UserCanUnlock,
 		UserPermissionCanCreate,
 		UserPermissionCanDelete,
 		UserPermissionCanModify,
@@ -100,15 +124,44 @@ var (
 		GroupPermissionCanRetrieve,
 		// RefreshToken
 		RefreshTokenCanCreate,
+		RefreshTokenCanCreateAsStranger,
 		RefreshTokenCanRevokeAsStranger,
 		RefreshTokenCanRevokeAsOwner,
 		RefreshTokenCanModifyAsStranger,
 		RefreshTokenCanModifyAsOwner,
 		RefreshTokenCanRetrieveAsOwner,
 		RefreshTokenCanRetrieveAsStranger,
+		// AuditEvent
+		AuditEventCanRetrieve,
+		// OAuthClient
+		OAuthClientCanCreate,
+		OAuthClientCanRetrieve,
+		OAuthClientCanDelete,
+		// APIKey
+		APIKeyCanCreateAsOwner,
+		APIKeyCanCreateAsStranger,
+		APIKeyCanRetrieveAsOwner,
+		APIKeyCanRetrieveAsStranger,
+		APIKeyCanRevokeAsOwner,
+		APIKeyCanRevokeAsStranger,
+		APIKeyCanDeleteAsOwner,
+		APIKeyCanDeleteAsStranger,
+		// Session
+		SessionCanRetrieveAsOwner,
+		SessionCanRetrieveAsStranger,
+		SessionCanDeleteAsOwner,
+		SessionCanDeleteAsStranger,
 	}
 )
 
+// PermissionWildcard can take place of subsystem, module or action.
+// It matches any value of given part, e.g. "forumservice:comment:*" matches every action within comment module.
+const PermissionWildcard = "*"
+
+// PermissionDenialPrefix marks permission as explicitly denied, e.g. "!forumservice:comment:can delete".
+// Denial takes precedence over any grant, no matter if it comes from a user or a group.
+const PermissionDenialPrefix = "!"
+
 // Permission is a string that consist of subsystem, module/content type and an action.
 type Permission string
 
@@ -156,6 +209,75 @@ func (p Permission) Action() (action string) {
 	return
 }
 
+// IsWildcard returns true if any part of the permission is a wildcard.
+func (p Permission) IsWildcard() bool {
+	subsystem, module, action := p.Split()
+
+	return subsystem == PermissionWildcard || module == PermissionWildcard || action == PermissionWildcard
+}
+
+// Match returns true if given permission is covered by p.
+// Each part of p that is a wildcard matches any value of corresponding part of given permission.
+func (p Permission) Match(permission Permission) bool {
+	if p == permission {
+		return true
+	}
+
+	s1, m1, a1 := p.Split()
+	s2, m2, a2 := permission.Split()
+
+	return matchPermissionPart(s1, s2) && matchPermissionPart(m1, m2) && matchPermissionPart(a1, a2)
+}
+
+func matchPermissionPart(pattern, value string) bool {
+	return pattern == PermissionWildcard || pattern == value
+}
+
+// intersect returns the narrowest permission matched by both p and permission, if there is any.
+func (p Permission) intersect(permission Permission) (Permission, bool) {
+	s1, m1, a1 := p.Split()
+	s2, m2, a2 := permission.Split()
+
+	s, ok1 := intersectPermissionPart(s1, s2)
+	m, ok2 := intersectPermissionPart(m1, m2)
+	a, ok3 := intersectPermissionPart(a1, a2)
+	if !ok1 || !ok2 || !ok3 {
+		return "", false
+	}
+	return Permission(s + ":" + m + ":" + a), true
+}
+
+func intersectPermissionPart(a, b string) (string, bool) {
+	switch {
+	case a == b:
+		return a, true
+	case a == PermissionWildcard:
+		return b, true
+	case b == PermissionWildcard:
+		return a, true
+	default:
+		return "", false
+	}
+}
+
+// IsDenial returns true if permission is prefixed with PermissionDenialPrefix.
+func (p Permission) IsDenial() bool {
+	return strings.HasPrefix(string(p), PermissionDenialPrefix)
+}
+
+// Deny returns denial of the permission.
+func (p Permission) Deny() Permission {
+	if p.IsDenial() {
+		return p
+	}
+	return PermissionDenialPrefix + p
+}
+
+// Allow is the opposite of Deny, it returns permission stripped of the denial prefix.
+func (p Permission) Allow() Permission {
+	return Permission(strings.TrimPrefix(string(p), PermissionDenialPrefix))
+}
+
 // Permission implements Permission interface.
 func (p Permission) Permission() string {
 	return string(p)
@@ -212,6 +334,62 @@ func (p Permissions) Contains(permissions ...Permission) bool {
 	return false
 }
 
+// Match works like Contains, but honours wildcards and denials present in the collection.
+// It returns true if at least one of given permissions is matched by any permission from the collection
+// and is not matched by any denial.
+// A query containing wildcards stands for every permission it covers,
+// so it is rejected if any denial overlaps it, even partially.
+// If none is provided returns false.
+func (p Permissions) Match(permissions ...Permission) bool {
+	for _, pp := range permissions {
+		if p.match(pp) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p Permissions) match(permission Permission) bool {
+	var granted bool
+	for _, perm := range p {
+		if perm.IsDenial() {
+			if _, ok := perm.Allow().intersect(permission); ok {
+				return false
+			}
+			continue
+		}
+		if perm.Match(permission) {
+			granted = true
+		}
+	}
+
+	return granted
+}
+
+// Restrict returns permissions granted by both the collection and given subset.
+// Denials present in the collection are kept, denials present in the subset are ignored.
+// It makes it possible to limit what a credential can do to a part of what its owner can do.
+func (p Permissions) Restrict(subset Permissions) Permissions {
+	restricted := make(Permissions, 0, len(p))
+	for _, perm := range p {
+		if perm.IsDenial() {
+			restricted = append(restricted, perm)
+			continue
+		}
+		for _, sub := range subset {
+			if sub.IsDenial() {
+				continue
+			}
+			if narrowed, ok := perm.intersect(sub); ok && !restricted.Contains(narrowed) {
+				restricted = append(restricted, narrowed)
+			}
+		}
+	}
+
+	return restricted
+}
+
 // Strings maps Permissions into slice of strings.
 func (p Permissions) Strings() (s []string) {
 	s = make([]string, 0, len(p))
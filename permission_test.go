@@ -175,6 +175,155 @@ func TestPermissions_Contains_many(t *testing.T) {
 	}
 }
 
+func TestPermission_IsWildcard(t *testing.T) {
+	cases := map[Permission]bool{
+		"forumservice:*:*":             true,
+		"forumservice:comment:*":       true,
+		"*:comment:can create":         true,
+		"forumservice:comment:create*": false,
+		UserCanCreate:                  false,
+		"":                             false,
+	}
+
+	for given, expected := range cases {
+		if got := given.IsWildcard(); got != expected {
+			t.Errorf("wrong result for %s, expected %t but got %t", given, expected, got)
+		}
+	}
+}
+
+func TestPermission_Match(t *testing.T) {
+	cases := []struct {
+		pattern, given Permission
+		expected       bool
+	}{
+		{pattern: UserCanCreate, given: UserCanCreate, expected: true},
+		{pattern: UserCanCreate, given: UserCanDeleteAsOwner, expected: false},
+		{pattern: "forumservice:*:*", given: "forumservice:comment:can create", expected: true},
+		{pattern: "forumservice:*:*", given: "forumservice:post:can delete", expected: true},
+		{pattern: "forumservice:*:*", given: "charon:user:can create", expected: false},
+		{pattern: "forumservice:comment:*", given: "forumservice:comment:can create", expected: true},
+		{pattern: "forumservice:comment:*", given: "forumservice:post:can create", expected: false},
+		{pattern: "*:*:can create", given: "forumservice:comment:can create", expected: true},
+		{pattern: "*:*:*", given: UserCanCreate, expected: true},
+		{pattern: "forumservice:comment:can*", given: "forumservice:comment:can create", expected: false},
+		{pattern: "comment:*", given: "forumservice:comment:can create", expected: false},
+		{pattern: "forumservice:comment:can create", given: "forumservice:comment:*", expected: false},
+	}
+
+	for _, c := range cases {
+		if got := c.pattern.Match(c.given); got != c.expected {
+			t.Errorf("wrong result for %s matched against %s, expected %t but got %t", c.pattern, c.given, c.expected, got)
+		}
+	}
+}
+
+func TestPermissions_Match(t *testing.T) {
+	permissions := Permissions{"forumservice:comment:*", UserCanCreate}
+	if !permissions.Match("forumservice:comment:can delete") {
+		t.Errorf("permission should be matched by wildcard")
+	}
+	if !permissions.Match(UserCanDeleteAsOwner, UserCanCreate) {
+		t.Errorf("at least one of provided permission is there")
+	}
+	if permissions.Match("forumservice:post:can delete", UserCanDeleteAsOwner) {
+		t.Errorf("none of provided permission should be matched")
+	}
+	if permissions.Match() {
+		t.Errorf("empty input should not be matched")
+	}
+	if (Permissions{}).Match(UserCanCreate) {
+		t.Errorf("empty collection should not match anything")
+	}
+}
+
+func TestPermission_Deny(t *testing.T) {
+	denial := UserCanCreate.Deny()
+	if denial != "!"+UserCanCreate {
+		t.Errorf("wrong denial: %s", denial)
+	}
+	if !denial.IsDenial() {
+		t.Errorf("denial expected")
+	}
+	if denial.Deny() != denial {
+		t.Errorf("denial should not be prefixed twice, got %s", denial.Deny())
+	}
+	if denial.Allow() != UserCanCreate {
+		t.Errorf("wrong permission: %s", denial.Allow())
+	}
+	if UserCanCreate.IsDenial() {
+		t.Errorf("denial not expected")
+	}
+}
+
+func TestPermissions_Match_denial(t *testing.T) {
+	permissions := Permissions{"forumservice:*:*", Permission("forumservice:comment:can delete").Deny()}
+	if !permissions.Match("forumservice:comment:can create") {
+		t.Errorf("permission should be matched by wildcard")
+	}
+	if permissions.Match("forumservice:comment:can delete") {
+		t.Errorf("denial should win over a grant")
+	}
+	if !permissions.Match("forumservice:comment:can delete", "forumservice:post:can delete") {
+		t.Errorf("at least one of provided permission is granted")
+	}
+
+	permissions = Permissions{Permission("forumservice:comment:*").Deny(), "forumservice:comment:can create"}
+	if permissions.Match("forumservice:comment:can create") {
+		t.Errorf("wildcard denial should win over a grant")
+	}
+	if (Permissions{UserCanCreate.Deny()}).Match(UserCanCreate) {
+		t.Errorf("denial alone should not grant anything")
+	}
+
+	permissions = Permissions{"forumservice:*:*", Permission("forumservice:post:can delete").Deny()}
+	if permissions.Match("forumservice:*:*") {
+		t.Errorf("wildcard should not be matched if any overlapping denial exists")
+	}
+	if permissions.Match("forumservice:post:*") {
+		t.Errorf("partial wildcard should not be matched if any overlapping denial exists")
+	}
+	if !permissions.Match("forumservice:comment:*") {
+		t.Errorf("wildcard should be matched if denial does not overlap it")
+	}
+}
+
+func TestPermissions_Restrict(t *testing.T) {
+	permissions := Permissions{
+		"forumservice:*:*",
+		"forumservice:comment:can create",
+		"charon:user:can create",
+		Permission("forumservice:comment:can delete").Deny(),
+	}
+	got := permissions.Restrict(Permissions{
+		"forumservice:comment:*",
+		"forumservice:post:can create",
+		"charon:group:can create",
+		Permission("charon:user:can create").Deny(),
+	})
+	expected := Permissions{
+		"forumservice:comment:*",
+		"forumservice:post:can create",
+		"forumservice:comment:can create",
+		Permission("forumservice:comment:can delete").Deny(),
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("wrong permissions, expected %v but got %v", expected, got)
+	}
+	if !got.Match("forumservice:comment:can modify") {
+		t.Errorf("permission granted by both should be matched")
+	}
+	if got.Match("forumservice:comment:can delete") {
+		t.Errorf("denial should be kept")
+	}
+	if got.Match("charon:user:can create") || got.Match("charon:group:can create") {
+		t.Errorf("permission granted by only one should not be matched")
+	}
+	if len(permissions.Restrict(nil)) != 1 {
+		t.Errorf("empty subset should leave only denials")
+	}
+}
+
 func TestPermissions_Strings(t *testing.T) {
 	got := Permissions{UserCanCreate, UserCanDeleteAsOwner}.Strings()
 	expected := []string{UserCanCreate.String(), UserCanDeleteAsOwner.String()}
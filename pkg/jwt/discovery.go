@@ -0,0 +1,101 @@
+package jwt
+
+import (
+	"context"
+	"crypto"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrDiscovery is returned if metadata of an issuer cannot be fetched or is invalid.
+var ErrDiscovery = errors.New("jwt: issuer discovery failure")
+
+// DiscoveredKeySet is a KeySource of an OpenID Connect issuer.
+// Location of the keys is read from the issuer metadata, keys themselves are cached by RemoteKeySet.
+type DiscoveredKeySet struct {
+	issuer   string
+	client   *http.Client
+	interval time.Duration
+
+	lock         sync.Mutex
+	keys         *RemoteKeySet
+	discoveredAt time.Time
+}
+
+// NewDiscoveredKeySet allocates new DiscoveredKeySet, metadata is fetched lazily.
+// If client is nil, a client with 10 seconds timeout is used.
+func NewDiscoveredKeySet(issuer string, client *http.Client, interval time.Duration) *DiscoveredKeySet {
+	if client == nil {
+		client = defaultClient
+	}
+	return &DiscoveredKeySet{
+		issuer:   issuer,
+		client:   client,
+		interval: interval,
+	}
+}
+
+// PublicKey implements KeySource interface.
+func (dks *DiscoveredKeySet) PublicKey(ctx context.Context, keyID string) (crypto.PublicKey, error) {
+	keys, err := dks.remote(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return keys.PublicKey(ctx, keyID)
+}
+
+func (dks *DiscoveredKeySet) remote(ctx context.Context) (*RemoteKeySet, error) {
+	dks.lock.Lock()
+	defer dks.lock.Unlock()
+
+	if dks.keys != nil {
+		return dks.keys, nil
+	}
+	if !dks.discoveredAt.IsZero() && time.Since(dks.discoveredAt) < dks.interval {
+		return nil, ErrDiscovery
+	}
+	dks.discoveredAt = time.Now()
+
+	jwksURI, err := dks.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	dks.keys = NewRemoteKeySet(jwksURI, dks.client, dks.interval)
+	return dks.keys, nil
+}
+
+func (dks *DiscoveredKeySet) discover(ctx context.Context) (string, error) {
+	req, err := http.NewRequest(http.MethodGet, strings.TrimSuffix(dks.issuer, "/")+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return "", err
+	}
+	res, err := dks.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return "", fmt.Errorf("%s: %s", ErrDiscovery.Error(), err.Error())
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("%s: unexpected status code %d", ErrDiscovery.Error(), res.StatusCode)
+	}
+	var metadata struct {
+		Issuer  string `json:"issuer"`
+		JWKSURI string `json:"jwks_uri"`
+	}
+	if err = json.NewDecoder(res.Body).Decode(&metadata); err != nil {
+		return "", fmt.Errorf("%s: %s", ErrDiscovery.Error(), err.Error())
+	}
+	// Issuer has to be identical, otherwise metadata could be used to impersonate another issuer.
+	if metadata.Issuer != dks.issuer {
+		return "", fmt.Errorf("%s: issuer mismatch %s", ErrDiscovery.Error(), metadata.Issuer)
+	}
+	if metadata.JWKSURI == "" {
+		return "", fmt.Errorf("%s: missing jwks_uri", ErrDiscovery.Error())
+	}
+	return metadata.JWKSURI, nil
+}
@@ -0,0 +1,233 @@
+// Package jwt implements self-contained access tokens issued by charond.
+// Services can validate them locally, using keys published by charond in JWKS format,
+// instead of asking charond or mnemosyne about every request.
+package jwt
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/piotrkowalczuk/charon"
+)
+
+const (
+	// AlgorithmRS256 is RSASSA-PKCS1-v1_5 using SHA-256.
+	AlgorithmRS256 = "RS256"
+	// AlgorithmES256 is ECDSA using P-256 and SHA-256.
+	AlgorithmES256 = "ES256"
+	// AlgorithmEdDSA is EdDSA using Ed25519.
+	AlgorithmEdDSA = "EdDSA"
+
+	// TypeAccessToken is a type of access tokens, as defined by RFC 9068.
+	// It tells them apart from ID tokens signed by the same keys.
+	TypeAccessToken = "at+jwt"
+	// TypeIDToken is a type of OpenID Connect ID tokens issued by charond.
+	TypeIDToken = "JWT"
+)
+
+var (
+	// ErrMalformed is returned if token cannot be decoded.
+	ErrMalformed = errors.New("jwt: malformed token")
+	// ErrUnsupportedAlgorithm is returned if token or key uses an algorithm other than RS256, ES256 or EdDSA.
+	ErrUnsupportedAlgorithm = errors.New("jwt: unsupported algorithm")
+	// ErrUnknownKey is returned if token was signed by a key that is not known to the verifier.
+	ErrUnknownKey = errors.New("jwt: unknown key")
+	// ErrInvalidSignature is returned if signature does not match the token.
+	ErrInvalidSignature = errors.New("jwt: invalid signature")
+	// ErrExpired is returned if token is past its expiration time.
+	ErrExpired = errors.New("jwt: token has expired")
+	// ErrNotValidYet is returned if token was issued in the future.
+	ErrNotValidYet = errors.New("jwt: token is not valid yet")
+	// ErrInvalidIssuer is returned if token was issued by someone else than expected.
+	ErrInvalidIssuer = errors.New("jwt: invalid issuer")
+	// ErrInvalidAudience is returned if token was not issued for the expected recipient.
+	ErrInvalidAudience = errors.New("jwt: invalid audience")
+	// ErrInvalidType is returned if token is of a different type than expected, e.g. ID token is used as an access token.
+	ErrInvalidType = errors.New("jwt: invalid token type")
+	// ErrScoped is returned if access token was issued to an OAuth client, such token does not grant any permission.
+	ErrScoped = errors.New("jwt: token is issued to an oauth client")
+)
+
+// Claims is a payload of an access token.
+type Claims struct {
+	Issuer string `json:"iss,omitempty"`
+	// Subject is an actor id, in format "charon:user:<user_id>".
+	Subject   string `json:"sub"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+	ID        string `json:"jti,omitempty"`
+	UserID    int64  `json:"uid"`
+	Username  string `json:"username,omitempty"`
+	Client    string `json:"client,omitempty"`
+	// Permissions are effective at the time token was issued, denials are prefixed with charon.PermissionDenialPrefix.
+	Permissions []string `json:"permissions,omitempty"`
+	// Scope is a space separated list of scopes the token was granted by the user, set only for OAuth clients.
+	// Such token carries no permissions and its audience is the client.
+	Scope string `json:"scope,omitempty"`
+	// APIKey is a prefix of the API key the token was issued for, permissions are restricted to those of the key.
+	APIKey string `json:"api_key,omitempty"`
+
+	// Claims below are used by OpenID Connect ID tokens.
+	Audience   Audience `json:"aud,omitempty"`
+	Nonce      string   `json:"nonce,omitempty"`
+	AuthTime   int64    `json:"auth_time,omitempty"`
+	GivenName  string   `json:"given_name,omitempty"`
+	FamilyName string   `json:"family_name,omitempty"`
+	Groups     []string `json:"groups,omitempty"`
+
+	// Raw holds all claims of a verified token, including those that are not represented by the fields above.
+	// It makes it possible to read claims of tokens issued by someone else than charond.
+	Raw map[string]interface{} `json:"-"`
+}
+
+// Audience is a list of recipients the token is intended for.
+// A single recipient is encoded as a string, as most of the relying parties expect.
+type Audience []string
+
+// Contains returns true if given recipient is within the audience.
+func (a Audience) Contains(recipient string) bool {
+	for _, r := range a {
+		if r == recipient {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalJSON implements json.Marshaler interface.
+func (a Audience) MarshalJSON() ([]byte, error) {
+	if len(a) == 1 {
+		return json.Marshal(a[0])
+	}
+	return json.Marshal([]string(a))
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface, it accepts both a string and an array.
+func (a *Audience) UnmarshalJSON(b []byte) error {
+	var single string
+	if err := json.Unmarshal(b, &single); err == nil {
+		*a = Audience{single}
+		return nil
+	}
+	var many []string
+	if err := json.Unmarshal(b, &many); err != nil {
+		return err
+	}
+	*a = many
+	return nil
+}
+
+// Granted returns true if at least one of given permissions is granted by the claims.
+// It honours wildcards and denials, the same way charon.Permissions.Match does.
+// Token issued to an OAuth client grants nothing.
+func (c *Claims) Granted(permissions ...charon.Permission) bool {
+	if c.Scope != "" {
+		return false
+	}
+	return charon.NewPermissions(c.Permissions...).Match(permissions...)
+}
+
+// ExpireAt returns expiration time of the token.
+func (c *Claims) ExpireAt() time.Time {
+	return time.Unix(c.ExpiresAt, 0)
+}
+
+type header struct {
+	Algorithm string `json:"alg"`
+	Type      string `json:"typ,omitempty"`
+	KeyID     string `json:"kid,omitempty"`
+}
+
+// LooksLike returns true if given token has the shape of JWT.
+// It makes it possible to tell JWT apart from an opaque token, it does not validate anything.
+func LooksLike(token string) bool {
+	return strings.Count(token, ".") == 2
+}
+
+// UnverifiedIssuer returns issuer of given token without verifying it.
+// It makes it possible to pick a verifier if tokens of many issuers are accepted, the result cannot be trusted.
+func UnverifiedIssuer(token string) (string, error) {
+	_, payload, _, _, err := split(token)
+	if err != nil {
+		return "", err
+	}
+	claims, err := decodeClaims(payload)
+	if err != nil {
+		return "", err
+	}
+	return claims.Issuer, nil
+}
+
+// split decodes token into its parts, signing input is what the signature is computed over.
+func split(token string) (hdr *header, payload []byte, signingInput string, signature []byte, err error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, nil, "", nil, ErrMalformed
+	}
+
+	rawHeader, err := decodeSegment(parts[0])
+	if err != nil {
+		return nil, nil, "", nil, ErrMalformed
+	}
+	hdr = &header{}
+	if err = json.Unmarshal(rawHeader, hdr); err != nil {
+		return nil, nil, "", nil, ErrMalformed
+	}
+	if payload, err = decodeSegment(parts[1]); err != nil {
+		return nil, nil, "", nil, ErrMalformed
+	}
+	if signature, err = decodeSegment(parts[2]); err != nil {
+		return nil, nil, "", nil, ErrMalformed
+	}
+
+	return hdr, payload, parts[0] + "." + parts[1], signature, nil
+}
+
+func decodeClaims(payload []byte) (*Claims, error) {
+	var claims Claims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, ErrMalformed
+	}
+	if err := json.Unmarshal(payload, &claims.Raw); err != nil {
+		return nil, ErrMalformed
+	}
+	return &claims, nil
+}
+
+// StringClaim returns value of given claim if it is a string.
+func (c *Claims) StringClaim(name string) (string, bool) {
+	s, ok := c.Raw[name].(string)
+	return s, ok
+}
+
+// StringsClaim returns value of given claim if it is a string or an array of strings.
+func (c *Claims) StringsClaim(name string) []string {
+	switch v := c.Raw[name].(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		out := make([]string, 0, len(v))
+		for _, e := range v {
+			if s, ok := e.(string); ok {
+				out = append(out, s)
+			}
+		}
+		return out
+	}
+	return nil
+}
+
+func encodeSegment(v interface{}) (string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func decodeSegment(s string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(s)
+}
@@ -0,0 +1,506 @@
+package jwt
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/piotrkowalczuk/charon"
+)
+
+func testKeys(t *testing.T) map[string]crypto.Signer {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	return map[string]crypto.Signer{
+		AlgorithmRS256: rsaKey,
+		AlgorithmES256: ecKey,
+		AlgorithmEdDSA: edKey,
+	}
+}
+
+func testClaims(now time.Time) *Claims {
+	return &Claims{
+		Issuer:      "charond",
+		Subject:     "charon:user:1",
+		IssuedAt:    now.Unix(),
+		ExpiresAt:   now.Add(time.Minute).Unix(),
+		UserID:      1,
+		Username:    "john@example.com",
+		Permissions: []string{"charon:user:*", "!charon:user:can delete as stranger"},
+	}
+}
+
+func TestSigner_Sign(t *testing.T) {
+	now := time.Now()
+
+	for alg, key := range testKeys(t) {
+		t.Run(alg, func(t *testing.T) {
+			signer, err := NewSigner("key-"+alg, key)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if signer.Algorithm() != alg {
+				t.Fatalf("wrong algorithm, expected %s but got %s", alg, signer.Algorithm())
+			}
+			jwk, err := signer.JWK()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			// Keys are published as JSON, so verification should go through the encoding.
+			buf, err := json.Marshal(&JWKS{Keys: []JWK{*jwk}})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			var jwks JWKS
+			if err = json.Unmarshal(buf, &jwks); err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			keys, err := NewKeySet(&jwks)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			token, err := signer.Sign(testClaims(now))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if !LooksLike(token) {
+				t.Fatalf("token should look like jwt: %s", token)
+			}
+
+			claims, err := (&Verifier{Keys: keys, Issuer: "charond"}).Verify(context.Background(), token)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if claims.UserID != 1 || claims.Subject != "charon:user:1" {
+				t.Errorf("wrong claims: %#v", claims)
+			}
+			if !claims.Granted(charon.UserCanCreate) {
+				t.Error("permission should be granted")
+			}
+			if claims.Granted(charon.UserCanDeleteAsStranger) {
+				t.Error("permission should be denied")
+			}
+		})
+	}
+}
+
+func TestVerifier_Verify(t *testing.T) {
+	now := time.Now()
+	keys := testKeys(t)
+	signer, err := NewSigner("1", keys[AlgorithmES256])
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	other, err := NewSigner("1", keys[AlgorithmRS256])
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	keySet := KeySet{"1": keys[AlgorithmES256].Public()}
+
+	sign := func(t *testing.T, s *Signer, c *Claims) string {
+		token, err := s.Sign(c)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		return token
+	}
+
+	cases := map[string]struct {
+		token    func(t *testing.T) string
+		verifier Verifier
+		err      error
+	}{
+		"valid": {
+			token: func(t *testing.T) string {
+				return sign(t, signer, testClaims(now))
+			},
+			verifier: Verifier{Keys: keySet},
+		},
+		"expired": {
+			token: func(t *testing.T) string {
+				return sign(t, signer, testClaims(now.Add(-time.Hour)))
+			},
+			verifier: Verifier{Keys: keySet},
+			err:      ErrExpired,
+		},
+		"expired-within-leeway": {
+			token: func(t *testing.T) string {
+				return sign(t, signer, testClaims(now.Add(-61*time.Second)))
+			},
+			verifier: Verifier{Keys: keySet, Leeway: 5 * time.Second},
+		},
+		"not-valid-yet": {
+			token: func(t *testing.T) string {
+				return sign(t, signer, testClaims(now.Add(time.Hour)))
+			},
+			verifier: Verifier{Keys: keySet},
+			err:      ErrNotValidYet,
+		},
+		"wrong-issuer": {
+			token: func(t *testing.T) string {
+				return sign(t, signer, testClaims(now))
+			},
+			verifier: Verifier{Keys: keySet, Issuer: "someone else"},
+			err:      ErrInvalidIssuer,
+		},
+		"audience": {
+			token: func(t *testing.T) string {
+				c := testClaims(now)
+				c.Audience = Audience{"client", "other"}
+				return sign(t, signer, c)
+			},
+			verifier: Verifier{Keys: keySet, Audience: "other"},
+		},
+		"wrong-audience": {
+			token: func(t *testing.T) string {
+				c := testClaims(now)
+				c.Audience = Audience{"client"}
+				return sign(t, signer, c)
+			},
+			verifier: Verifier{Keys: keySet, Audience: "other"},
+			err:      ErrInvalidAudience,
+		},
+		"scoped": {
+			token: func(t *testing.T) string {
+				c := testClaims(now)
+				c.Scope = "openid profile"
+				return sign(t, signer, c)
+			},
+			verifier: Verifier{Keys: keySet},
+			err:      ErrScoped,
+		},
+		"scoped-accepted": {
+			token: func(t *testing.T) string {
+				c := testClaims(now)
+				c.Scope = "openid profile"
+				return sign(t, signer, c)
+			},
+			verifier: Verifier{Keys: keySet, Scoped: true},
+		},
+		"unknown-key": {
+			token: func(t *testing.T) string {
+				return sign(t, signer, testClaims(now))
+			},
+			verifier: Verifier{Keys: KeySet{}},
+			err:      ErrUnknownKey,
+		},
+		"signed-by-other-key": {
+			token: func(t *testing.T) string {
+				return sign(t, other, testClaims(now))
+			},
+			verifier: Verifier{Keys: keySet},
+			err:      ErrInvalidSignature,
+		},
+		"tampered-payload": {
+			token: func(t *testing.T) string {
+				token := sign(t, signer, testClaims(now))
+				forged := testClaims(now)
+				forged.UserID = 2
+				payload, err := encodeSegment(forged)
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err.Error())
+				}
+				parts := strings.Split(token, ".")
+				return parts[0] + "." + payload + "." + parts[2]
+			},
+			verifier: Verifier{Keys: keySet},
+			err:      ErrInvalidSignature,
+		},
+		"id-token": {
+			token: func(t *testing.T) string {
+				token, err := signer.SignIDToken(testClaims(now))
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err.Error())
+				}
+				return token
+			},
+			verifier: Verifier{Keys: keySet, IDToken: true},
+		},
+		"id-token-as-access-token": {
+			token: func(t *testing.T) string {
+				token, err := signer.SignIDToken(testClaims(now))
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err.Error())
+				}
+				return token
+			},
+			verifier: Verifier{Keys: keySet},
+			err:      ErrInvalidType,
+		},
+		"access-token-as-id-token": {
+			token: func(t *testing.T) string {
+				return sign(t, signer, testClaims(now))
+			},
+			verifier: Verifier{Keys: keySet, IDToken: true},
+			err:      ErrInvalidType,
+		},
+		"untyped": {
+			token: func(t *testing.T) string {
+				hdr, _ := encodeSegment(&header{Algorithm: AlgorithmES256, KeyID: "1"})
+				payload, _ := encodeSegment(testClaims(now))
+				return hdr + "." + payload + "."
+			},
+			verifier: Verifier{Keys: keySet},
+			err:      ErrInvalidType,
+		},
+		"none-algorithm": {
+			token: func(t *testing.T) string {
+				hdr, _ := encodeSegment(&header{Algorithm: "none", KeyID: "1"})
+				payload, _ := encodeSegment(testClaims(now))
+				return hdr + "." + payload + "."
+			},
+			verifier: Verifier{Keys: keySet},
+			err:      ErrUnsupportedAlgorithm,
+		},
+		"opaque": {
+			token: func(t *testing.T) string {
+				return "0000000001some hash"
+			},
+			verifier: Verifier{Keys: keySet},
+			err:      ErrMalformed,
+		},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			_, err := c.verifier.Verify(context.Background(), c.token(t))
+			if err != c.err {
+				t.Fatalf("wrong error, expected %v but got %v", c.err, err)
+			}
+		})
+	}
+}
+
+func TestRemoteKeySet_PublicKey(t *testing.T) {
+	keys := testKeys(t)
+	jwks := &JWKS{}
+	add := func(kid string, key crypto.Signer) {
+		jwk, err := NewJWK(kid, key.Public())
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		jwks.Keys = append(jwks.Keys, *jwk)
+	}
+	add("1", keys[AlgorithmRS256])
+
+	var requests int
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		requests++
+		json.NewEncoder(rw).Encode(jwks)
+	}))
+	defer ts.Close()
+
+	rks := NewRemoteKeySet(ts.URL, nil, time.Hour)
+	ctx := context.Background()
+
+	if _, err := rks.PublicKey(ctx, "1"); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if _, err := rks.PublicKey(ctx, "1"); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if requests != 1 {
+		t.Fatalf("keys should be fetched once, got %d requests", requests)
+	}
+
+	add("2", keys[AlgorithmEdDSA])
+	if _, err := rks.PublicKey(ctx, "2"); err != ErrUnknownKey {
+		t.Fatalf("keys should not be fetched again so soon, got: %v", err)
+	}
+
+	rks.interval = 0
+	if _, err := rks.PublicKey(ctx, "2"); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if requests != 2 {
+		t.Fatalf("keys should be fetched again, got %d requests", requests)
+	}
+}
+
+func TestRemoteKeySet_PublicKey_concurrent(t *testing.T) {
+	keys := testKeys(t)
+	jwks := &JWKS{}
+	for kid, alg := range map[string]string{"1": AlgorithmRS256, "2": AlgorithmEdDSA} {
+		jwk, err := NewJWK(kid, keys[alg].Public())
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		jwks.Keys = append(jwks.Keys, *jwk)
+	}
+
+	var (
+		requests int32
+		entered  = make(chan struct{}, 2)
+		release  = make(chan struct{})
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		// The first request is served at once, the next one hangs until released.
+		if atomic.AddInt32(&requests, 1) > 1 {
+			entered <- struct{}{}
+			<-release
+		}
+		json.NewEncoder(rw).Encode(jwks)
+	}))
+	defer ts.Close()
+
+	rks := NewRemoteKeySet(ts.URL, nil, 0)
+	ctx := context.Background()
+	if _, err := rks.PublicKey(ctx, "1"); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	// Second key is forgotten, as if it was published after the first fetch.
+	rks.keys = KeySet{"1": rks.keys["1"]}
+
+	errs := make(chan error, 2)
+	lookup := func() {
+		_, err := rks.PublicKey(ctx, "2")
+		errs <- err
+	}
+	go lookup()
+	<-entered
+
+	// Known key is available while the fetch is in progress.
+	if _, err := rks.PublicKey(ctx, "1"); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	go lookup()
+	waitCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if _, err := rks.PublicKey(waitCtx, "2"); err != context.DeadlineExceeded {
+		t.Fatalf("lookup should wait for the fetch in progress, got: %v", err)
+	}
+
+	close(release)
+	for i := 0; i < 2; i++ {
+		if err := <-errs; err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+		}
+	}
+	if n := atomic.LoadInt32(&requests); n != 2 {
+		t.Errorf("keys should be fetched once more, got %d requests", n)
+	}
+}
+
+func TestDiscoveredKeySet_PublicKey(t *testing.T) {
+	key := testKeys(t)[AlgorithmES256]
+	jwk, err := NewJWK("1", key.Public())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var issuer string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(rw http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(rw).Encode(map[string]string{"issuer": issuer, "jwks_uri": issuer + "/keys"})
+	})
+	mux.HandleFunc("/keys", func(rw http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(rw).Encode(&JWKS{Keys: []JWK{*jwk}})
+	})
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+	issuer = ts.URL
+
+	if _, err = NewDiscoveredKeySet(ts.URL, nil, time.Hour).PublicKey(context.Background(), "1"); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	// Metadata served under a different issuer cannot be trusted.
+	dks := NewDiscoveredKeySet(ts.URL+"/", nil, time.Hour)
+	if _, err = dks.PublicKey(context.Background(), "1"); err == nil {
+		t.Fatal("issuer mismatch expected")
+	}
+	if _, err = dks.PublicKey(context.Background(), "1"); err != ErrDiscovery {
+		t.Fatalf("discovery should not be retried so soon, got: %v", err)
+	}
+}
+
+func TestParsePrivateKey(t *testing.T) {
+	for alg, key := range testKeys(t) {
+		t.Run(alg, func(t *testing.T) {
+			der, err := x509.MarshalPKCS8PrivateKey(key)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			got, err := ParsePrivateKey(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if got, err := algorithm(got.Public()); err != nil || got != alg {
+				t.Fatalf("wrong algorithm, expected %s but got %s (%v)", alg, got, err)
+			}
+		})
+	}
+
+	if _, err := ParsePrivateKey([]byte("not a key")); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestClaims(t *testing.T) {
+	for given, exp := range map[string]Audience{
+		`{"aud":"a"}`:       {"a"},
+		`{"aud":["a","b"]}`: {"a", "b"},
+		`{}`:                nil,
+	} {
+		var c Claims
+		if err := json.Unmarshal([]byte(given), &c); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		if !reflect.DeepEqual(c.Audience, exp) {
+			t.Errorf("wrong audience decoded from %s: %v", given, c.Audience)
+		}
+	}
+
+	var c Claims
+	if err := json.Unmarshal([]byte(`{"email":"john@example.com","groups":["a",1,"b"],"role":"c"}`), &c.Raw); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if email, ok := c.StringClaim("email"); !ok || email != "john@example.com" {
+		t.Errorf("wrong email claim: %s", email)
+	}
+	if groups := c.StringsClaim("groups"); !reflect.DeepEqual(groups, []string{"a", "b"}) {
+		t.Errorf("wrong groups claim: %v", groups)
+	}
+	if roles := c.StringsClaim("role"); !reflect.DeepEqual(roles, []string{"c"}) {
+		t.Errorf("wrong role claim: %v", roles)
+	}
+
+	scoped := Claims{Permissions: []string{"charon:user:can create"}, Scope: "openid"}
+	if scoped.Granted(charon.Permission("charon:user:can create")) {
+		t.Error("token issued to an oauth client should not grant any permission")
+	}
+
+	b, err := json.Marshal(&Claims{Audience: Audience{"a"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if !strings.Contains(string(b), `"aud":"a"`) {
+		t.Errorf("single recipient should be encoded as a string: %s", b)
+	}
+}
@@ -0,0 +1,183 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// JWK is a public key in JSON Web Key format.
+type JWK struct {
+	KeyType   string `json:"kty"`
+	Use       string `json:"use,omitempty"`
+	Algorithm string `json:"alg,omitempty"`
+	KeyID     string `json:"kid,omitempty"`
+	// RSA
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// EC and OKP
+	Curve string `json:"crv,omitempty"`
+	X     string `json:"x,omitempty"`
+	Y     string `json:"y,omitempty"`
+}
+
+// JWKS is a JSON Web Key Set.
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// NewJWK encodes given public key, it has to be RSA, ECDSA P-256 or Ed25519 key.
+func NewJWK(keyID string, key crypto.PublicKey) (*JWK, error) {
+	alg, err := algorithm(key)
+	if err != nil {
+		return nil, err
+	}
+
+	jwk := &JWK{
+		Use:       "sig",
+		Algorithm: alg,
+		KeyID:     keyID,
+	}
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		jwk.KeyType = "RSA"
+		jwk.N = encodeInt(k.N.Bytes())
+		jwk.E = encodeInt(big.NewInt(int64(k.E)).Bytes())
+	case *ecdsa.PublicKey:
+		jwk.KeyType = "EC"
+		jwk.Curve = "P-256"
+		jwk.X = encodeInt(pad(k.X.Bytes(), 32))
+		jwk.Y = encodeInt(pad(k.Y.Bytes(), 32))
+	case ed25519.PublicKey:
+		jwk.KeyType = "OKP"
+		jwk.Curve = "Ed25519"
+		jwk.X = encodeInt(k)
+	}
+
+	return jwk, nil
+}
+
+// PublicKey decodes the key.
+func (j *JWK) PublicKey() (crypto.PublicKey, error) {
+	switch j.KeyType {
+	case "RSA":
+		n, err := decodeSegment(j.N)
+		if err != nil {
+			return nil, fmt.Errorf("jwt: invalid rsa modulus: %s", err.Error())
+		}
+		e, err := decodeSegment(j.E)
+		if err != nil {
+			return nil, fmt.Errorf("jwt: invalid rsa exponent: %s", err.Error())
+		}
+		return &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}, nil
+	case "EC":
+		if j.Curve != "P-256" {
+			return nil, ErrUnsupportedAlgorithm
+		}
+		x, err := decodeSegment(j.X)
+		if err != nil {
+			return nil, fmt.Errorf("jwt: invalid ec x coordinate: %s", err.Error())
+		}
+		y, err := decodeSegment(j.Y)
+		if err != nil {
+			return nil, fmt.Errorf("jwt: invalid ec y coordinate: %s", err.Error())
+		}
+		key := &ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		}
+		if !key.Curve.IsOnCurve(key.X, key.Y) {
+			return nil, errors.New("jwt: ec point is not on the curve")
+		}
+		return key, nil
+	case "OKP":
+		if j.Curve != "Ed25519" {
+			return nil, ErrUnsupportedAlgorithm
+		}
+		x, err := decodeSegment(j.X)
+		if err != nil {
+			return nil, fmt.Errorf("jwt: invalid ed25519 key: %s", err.Error())
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("jwt: invalid ed25519 key size")
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, ErrUnsupportedAlgorithm
+	}
+}
+
+// ParsePrivateKey decodes PEM encoded private key.
+// PKCS #8 keys of all supported types are accepted, as well as PKCS #1 RSA and SEC 1 EC keys.
+func ParsePrivateKey(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("jwt: private key is not pem encoded")
+	}
+
+	var (
+		key interface{}
+		err error
+	)
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("jwt: private key parsing failure: %s", err.Error())
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, ErrUnsupportedAlgorithm
+	}
+	if _, err = algorithm(signer.Public()); err != nil {
+		return nil, err
+	}
+	return signer, nil
+}
+
+// algorithm returns signing algorithm appropriate for given public key.
+func algorithm(key crypto.PublicKey) (string, error) {
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		return AlgorithmRS256, nil
+	case *ecdsa.PublicKey:
+		if k.Curve != elliptic.P256() {
+			return "", ErrUnsupportedAlgorithm
+		}
+		return AlgorithmES256, nil
+	case ed25519.PublicKey:
+		return AlgorithmEdDSA, nil
+	default:
+		return "", ErrUnsupportedAlgorithm
+	}
+}
+
+func encodeInt(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// pad left pads big-endian integer with zeros to the given size.
+func pad(b []byte, size int) []byte {
+	if len(b) >= size {
+		return b
+	}
+	return append(make([]byte, size-len(b)), b...)
+}
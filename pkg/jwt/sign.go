@@ -0,0 +1,131 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"math/big"
+)
+
+// Signer issues tokens signed by a private key.
+type Signer struct {
+	keyID     string
+	algorithm string
+	key       crypto.Signer
+}
+
+// NewSigner allocates new Signer, algorithm is chosen based on the type of given key.
+func NewSigner(keyID string, key crypto.Signer) (*Signer, error) {
+	alg, err := algorithm(key.Public())
+	if err != nil {
+		return nil, err
+	}
+	return &Signer{
+		keyID:     keyID,
+		algorithm: alg,
+		key:       key,
+	}, nil
+}
+
+// KeyID returns identifier that is put into header of each token.
+func (s *Signer) KeyID() string {
+	return s.keyID
+}
+
+// Algorithm returns name of the signing algorithm.
+func (s *Signer) Algorithm() string {
+	return s.algorithm
+}
+
+// JWK returns public part of the signing key.
+func (s *Signer) JWK() (*JWK, error) {
+	return NewJWK(s.keyID, s.key.Public())
+}
+
+// Sign encodes given claims and signs them as an access token.
+func (s *Signer) Sign(claims *Claims) (string, error) {
+	return s.signAs(TypeAccessToken, claims)
+}
+
+// SignIDToken encodes given claims and signs them as an OpenID Connect ID token.
+// Verifier does not accept it as an access token.
+func (s *Signer) SignIDToken(claims *Claims) (string, error) {
+	return s.signAs(TypeIDToken, claims)
+}
+
+func (s *Signer) signAs(typ string, claims *Claims) (string, error) {
+	hdr, err := encodeSegment(&header{
+		Algorithm: s.algorithm,
+		Type:      typ,
+		KeyID:     s.keyID,
+	})
+	if err != nil {
+		return "", err
+	}
+	payload, err := encodeSegment(claims)
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := hdr + "." + payload
+	signature, err := s.sign([]byte(signingInput))
+	if err != nil {
+		return "", err
+	}
+
+	return signingInput + "." + encodeInt(signature), nil
+}
+
+func (s *Signer) sign(input []byte) ([]byte, error) {
+	switch k := s.key.(type) {
+	case *ecdsa.PrivateKey:
+		// ES256 signature is a concatenation of r and s, not ASN.1 structure that crypto.Signer returns.
+		digest := sha256.Sum256(input)
+		r, ss, err := ecdsa.Sign(rand.Reader, k, digest[:])
+		if err != nil {
+			return nil, err
+		}
+		return append(pad(r.Bytes(), 32), pad(ss.Bytes(), 32)...), nil
+	case ed25519.PrivateKey:
+		return ed25519.Sign(k, input), nil
+	default:
+		digest := sha256.Sum256(input)
+		return s.key.Sign(rand.Reader, digest[:], crypto.SHA256)
+	}
+}
+
+// verify checks signature of the input using given public key, it has to match the algorithm.
+func verify(alg string, key crypto.PublicKey, input, signature []byte) error {
+	expected, err := algorithm(key)
+	if err != nil {
+		return err
+	}
+	// Algorithm is dictated by the key, never by the token, otherwise it could be downgraded.
+	if alg != expected {
+		return ErrInvalidSignature
+	}
+
+	digest := sha256.Sum256(input)
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		if rsa.VerifyPKCS1v15(k, crypto.SHA256, digest[:], signature) != nil {
+			return ErrInvalidSignature
+		}
+	case *ecdsa.PublicKey:
+		if len(signature) != 64 {
+			return ErrInvalidSignature
+		}
+		r, s := new(big.Int).SetBytes(signature[:32]), new(big.Int).SetBytes(signature[32:])
+		if !ecdsa.Verify(k, digest[:], r, s) {
+			return ErrInvalidSignature
+		}
+	case ed25519.PublicKey:
+		if !ed25519.Verify(k, input, signature) {
+			return ErrInvalidSignature
+		}
+	}
+	return nil
+}
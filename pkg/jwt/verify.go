@@ -0,0 +1,222 @@
+package jwt
+
+import (
+	"context"
+	"crypto"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// KeySource provides public keys that tokens are verified with.
+type KeySource interface {
+	// PublicKey returns key for given key id, or ErrUnknownKey.
+	PublicKey(ctx context.Context, keyID string) (crypto.PublicKey, error)
+}
+
+// Verifier validates tokens locally.
+type Verifier struct {
+	Keys KeySource
+	// Issuer, if not empty, has to match issuer of the token.
+	Issuer string
+	// Audience, if not empty, has to be one of the recipients of the token.
+	Audience string
+	// IDToken makes the verifier accept ID tokens instead of access tokens.
+	// Identity providers are not consistent about type of ID tokens, so it is only checked that it is not an access token.
+	IDToken bool
+	// Scoped makes the verifier accept access tokens issued to OAuth clients.
+	// They are meant for the userinfo endpoint of charond only, other services should leave it false.
+	Scoped bool
+	// Leeway is a tolerance for clock skew between the issuer and the verifier.
+	Leeway time.Duration
+	// Now defaults to time.Now.
+	Now func() time.Time
+}
+
+// Verify checks type, signature, expiration time, issuer, audience and scope of given token and returns its claims.
+func (v *Verifier) Verify(ctx context.Context, token string) (*Claims, error) {
+	hdr, payload, signingInput, signature, err := split(token)
+	if err != nil {
+		return nil, err
+	}
+	switch hdr.Algorithm {
+	case AlgorithmRS256, AlgorithmES256, AlgorithmEdDSA:
+	default:
+		return nil, ErrUnsupportedAlgorithm
+	}
+	if (hdr.Type == TypeAccessToken) == v.IDToken {
+		return nil, ErrInvalidType
+	}
+
+	key, err := v.Keys.PublicKey(ctx, hdr.KeyID)
+	if err != nil {
+		return nil, err
+	}
+	if err = verify(hdr.Algorithm, key, []byte(signingInput), signature); err != nil {
+		return nil, err
+	}
+
+	claims, err := decodeClaims(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now
+	if v.Now != nil {
+		now = v.Now
+	}
+	t := now()
+	if !t.Before(time.Unix(claims.ExpiresAt, 0).Add(v.Leeway)) {
+		return nil, ErrExpired
+	}
+	if t.Add(v.Leeway).Before(time.Unix(claims.IssuedAt, 0)) {
+		return nil, ErrNotValidYet
+	}
+	if v.Issuer != "" && v.Issuer != claims.Issuer {
+		return nil, ErrInvalidIssuer
+	}
+	if v.Audience != "" && !claims.Audience.Contains(v.Audience) {
+		return nil, ErrInvalidAudience
+	}
+	if !v.IDToken && !v.Scoped && claims.Scope != "" {
+		return nil, ErrScoped
+	}
+
+	return claims, nil
+}
+
+// KeySet is a static KeySource.
+type KeySet map[string]crypto.PublicKey
+
+// NewKeySet decodes all keys of given set.
+func NewKeySet(jwks *JWKS) (KeySet, error) {
+	ks := make(KeySet, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.PublicKey()
+		if err != nil {
+			return nil, fmt.Errorf("jwt: key %s: %s", jwk.KeyID, err.Error())
+		}
+		ks[jwk.KeyID] = key
+	}
+	return ks, nil
+}
+
+// PublicKey implements KeySource interface.
+func (ks KeySet) PublicKey(_ context.Context, keyID string) (crypto.PublicKey, error) {
+	key, ok := ks[keyID]
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+	return key, nil
+}
+
+// RemoteKeySet is a KeySource that fetches keys from a JWKS endpoint, e.g. the one of charond.
+// Keys are fetched again if a token is signed by an unknown key, which is what happens after rotation.
+type RemoteKeySet struct {
+	url    string
+	client *http.Client
+	// interval limits how often keys can be fetched, so that tokens signed by unknown keys cannot flood the endpoint.
+	interval time.Duration
+
+	lock      sync.Mutex
+	keys      KeySet
+	fetchedAt time.Time
+	// fetching is closed once the fetch in progress is done, it is nil if keys are not being fetched.
+	fetching chan struct{}
+}
+
+// defaultClient is used if no client is given, so that an unresponsive endpoint cannot hold verification forever.
+var defaultClient = &http.Client{Timeout: 10 * time.Second}
+
+// NewRemoteKeySet allocates new RemoteKeySet, keys are fetched lazily.
+// If client is nil, a client with 10 seconds timeout is used.
+func NewRemoteKeySet(url string, client *http.Client, interval time.Duration) *RemoteKeySet {
+	if client == nil {
+		client = defaultClient
+	}
+	return &RemoteKeySet{
+		url:      url,
+		client:   client,
+		interval: interval,
+	}
+}
+
+// PublicKey implements KeySource interface.
+// Keys are fetched without holding the lock, lookups of known keys are not blocked in the meantime.
+// Concurrent lookups of unknown keys wait for the fetch in progress, instead of starting another one.
+func (rks *RemoteKeySet) PublicKey(ctx context.Context, keyID string) (crypto.PublicKey, error) {
+	rks.lock.Lock()
+	if key, ok := rks.keys[keyID]; ok {
+		rks.lock.Unlock()
+		return key, nil
+	}
+	if wait := rks.fetching; wait != nil {
+		rks.lock.Unlock()
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		return rks.known(keyID)
+	}
+	if !rks.fetchedAt.IsZero() && time.Since(rks.fetchedAt) < rks.interval {
+		rks.lock.Unlock()
+		return nil, ErrUnknownKey
+	}
+	done := make(chan struct{})
+	rks.fetching = done
+	rks.lock.Unlock()
+
+	keys, err := rks.fetch(ctx)
+
+	rks.lock.Lock()
+	rks.fetchedAt = time.Now()
+	if err == nil {
+		rks.keys = keys
+	}
+	rks.fetching = nil
+	close(done)
+	rks.lock.Unlock()
+
+	if err != nil {
+		return nil, err
+	}
+	return rks.known(keyID)
+}
+
+func (rks *RemoteKeySet) known(keyID string) (crypto.PublicKey, error) {
+	rks.lock.Lock()
+	defer rks.lock.Unlock()
+
+	if key, ok := rks.keys[keyID]; ok {
+		return key, nil
+	}
+	return nil, ErrUnknownKey
+}
+
+func (rks *RemoteKeySet) fetch(ctx context.Context) (KeySet, error) {
+	req, err := http.NewRequest(http.MethodGet, rks.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := rks.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("jwt: keys fetch failure: %s", err.Error())
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("jwt: keys fetch failure: unexpected status code %d", res.StatusCode)
+	}
+	var jwks JWKS
+	if err = json.NewDecoder(res.Body).Decode(&jwks); err != nil {
+		return nil, fmt.Errorf("jwt: keys decoding failure: %s", err.Error())
+	}
+
+	return NewKeySet(&jwks)
+}